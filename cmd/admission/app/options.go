@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 	"github.com/spf13/pflag"
 
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
@@ -25,6 +26,9 @@ type Options struct {
 	FakeGPUNodes                bool
 	GPUSharingEnabled           bool
 	GPUPodRuntimeClassName      string
+	NodePoolLabelKey            string
+	NodeCapacityValidation      string
+	AcceleratorResourceNames    []string
 }
 
 func InitOptions() *Options {
@@ -70,6 +74,17 @@ func InitOptions() *Options {
 		"gpu-pod-runtime-class-name", constants.DefaultRuntimeClassName,
 		fmt.Sprintf("Runtime class to be set for GPU pods (defaults to %s) Set to empty string to disable", constants.DefaultRuntimeClassName))
 
+	fs.StringVar(&options.NodePoolLabelKey,
+		"nodepool-label-key", constants.DefaultNodePoolLabelKey,
+		"The label key for node pools")
+	fs.StringVar(&options.NodeCapacityValidation,
+		"node-capacity-validation", "warn",
+		"Validation of pods requesting more resources than available in any single node of their node pool. "+
+			"One of: disabled, warn, reject")
+	fs.StringSliceVar(&options.AcceleratorResourceNames,
+		"accelerator-resource-names", resources.DefaultAcceleratorResourceNames(),
+		"The accelerator resources that are validated like GPUs")
+
 	utilfeature.DefaultMutableFeatureGate.AddFlag(fs)
 
 	return options
@@ -11,8 +11,18 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/cmd/admission/app"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/admission/plugins"
+	"github.com/NVIDIA/KAI-scheduler/pkg/admission/webhook/v1alpha2/consolidation"
+	"github.com/NVIDIA/KAI-scheduler/pkg/admission/webhook/v1alpha2/gpurequest"
 	"github.com/NVIDIA/KAI-scheduler/pkg/admission/webhook/v1alpha2/gpusharing"
+	"github.com/NVIDIA/KAI-scheduler/pkg/admission/webhook/v1alpha2/maxgpusperpod"
+	"github.com/NVIDIA/KAI-scheduler/pkg/admission/webhook/v1alpha2/metadatakeys"
+	"github.com/NVIDIA/KAI-scheduler/pkg/admission/webhook/v1alpha2/nodecapacity"
+	"github.com/NVIDIA/KAI-scheduler/pkg/admission/webhook/v1alpha2/preemptibility"
+	"github.com/NVIDIA/KAI-scheduler/pkg/admission/webhook/v1alpha2/queueassignment"
+	"github.com/NVIDIA/KAI-scheduler/pkg/admission/webhook/v1alpha2/resourcedefaults"
 	"github.com/NVIDIA/KAI-scheduler/pkg/admission/webhook/v1alpha2/runtimeenforcement"
+	"github.com/NVIDIA/KAI-scheduler/pkg/admission/webhook/v1alpha2/schedulingconstraints"
+	"github.com/NVIDIA/KAI-scheduler/pkg/admission/webhook/v1alpha2/workloadclass"
 )
 
 var (
@@ -42,6 +52,10 @@ func main() {
 func registerPlugins(app *app.App) error {
 	admissionPlugins := plugins.New()
 
+	// GpuRequests are translated to GPU sharing annotations before the GPU sharing plugin reads them
+	admissionGpuRequestPlugin := gpurequest.New(app.Client)
+	admissionPlugins.RegisterPlugin(admissionGpuRequestPlugin)
+
 	admissionGpuSharingPlugin := gpusharing.New(app.Client, app.Options.GPUSharingEnabled)
 	admissionPlugins.RegisterPlugin(admissionGpuSharingPlugin)
 
@@ -50,6 +64,38 @@ func registerPlugins(app *app.App) error {
 		admissionPlugins.RegisterPlugin(admissionRuntimeEnforcementPlugin)
 	}
 
+	// Queues are assigned before the scheduling constraints of the queue are applied
+	admissionQueueAssignmentPlugin := queueassignment.New(app.Client)
+	admissionPlugins.RegisterPlugin(admissionQueueAssignmentPlugin)
+
+	admissionSchedulingConstraintsPlugin := schedulingconstraints.New(app.Client)
+	admissionPlugins.RegisterPlugin(admissionSchedulingConstraintsPlugin)
+
+	admissionResourceDefaultsPlugin := resourcedefaults.New(app.Client)
+	admissionPlugins.RegisterPlugin(admissionResourceDefaultsPlugin)
+
+	admissionPreemptibilityPlugin := preemptibility.New(app.Client)
+	admissionPlugins.RegisterPlugin(admissionPreemptibilityPlugin)
+
+	admissionWorkloadClassPlugin := workloadclass.New(app.Client)
+	admissionPlugins.RegisterPlugin(admissionWorkloadClassPlugin)
+
+	admissionConsolidationPlugin := consolidation.New(app.Client)
+	admissionPlugins.RegisterPlugin(admissionConsolidationPlugin)
+
+	admissionMaxGPUsPerPodPlugin := maxgpusperpod.New(app.Client)
+	admissionPlugins.RegisterPlugin(admissionMaxGPUsPerPodPlugin)
+
+	admissionMetadataKeysPlugin := metadatakeys.New(app.Options.NodePoolLabelKey)
+	admissionPlugins.RegisterPlugin(admissionMetadataKeysPlugin)
+
+	nodeCapacityValidationMode, err := nodecapacity.ParseValidationMode(app.Options.NodeCapacityValidation)
+	if err != nil {
+		return err
+	}
+	admissionNodeCapacityPlugin := nodecapacity.New(app.Client, app.Options.NodePoolLabelKey, nodeCapacityValidationMode)
+	admissionPlugins.RegisterPlugin(admissionNodeCapacityPlugin)
+
 	app.RegisterPlugins(admissionPlugins)
 	return nil
 }
@@ -20,19 +20,24 @@ import (
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	schedulingv2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	draversionawareclient "github.com/NVIDIA/KAI-scheduler/pkg/common/resources/dra_version_aware_client"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/binding"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/binding/resourcereservation"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/common"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/controllers"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins"
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/tracing"
 )
 
 var (
@@ -44,6 +49,7 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(schedulingv1alpha2.AddToScheme(scheme))
+	utilruntime.Must(schedulingv2alpha2.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -61,9 +67,12 @@ type App struct {
 func New(options *Options, config *rest.Config) (*App, error) {
 	config.QPS = float32(options.QPS)
 	config.Burst = options.Burst
+	resources.SetAcceleratorResourceNames(options.AcceleratorResourceNames)
 
+	gracefulShutdownTimeout := time.Duration(options.GracefulShutdownTimeoutSeconds) * time.Second
 	mgr, err := ctrl.NewManager(config, ctrl.Options{
-		Scheme: scheme,
+		Scheme:                  scheme,
+		GracefulShutdownTimeout: &gracefulShutdownTimeout,
 		Metrics: server.Options{
 			BindAddress: options.MetricsAddr,
 		},
@@ -116,10 +125,10 @@ func New(options *Options, config *rest.Config) (*App, error) {
 	}
 
 	rrs := resourcereservation.NewService(options.FakeGPUNodes, clientWithWatch, options.ResourceReservationPodImage,
-		time.Duration(options.ResourceReservationAllocationTimeout)*time.Second,
+		options.ResourceReservationArchImages, time.Duration(options.ResourceReservationAllocationTimeout)*time.Second,
 		options.ResourceReservationNamespace, options.ResourceReservationServiceAccount,
 		options.ResourceReservationAppLabel, options.ScalingPodNamespace, options.RuntimeClassName,
-		podResources)
+		podResources, options.GPUSharingReleaseFinalizer)
 
 	reconcilerParams := &controllers.ReconcilerParams{
 		MaxConcurrentReconciles:     options.MaxConcurrentReconciles,
@@ -147,7 +156,13 @@ func (app *App) RegisterPlugins(plugins *plugins.BinderPlugins) {
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch;create;update;patch;delete
 
 func (app *App) Run(ctx context.Context) error {
-	var err error
+	shutdownTracing, err := tracing.Setup(ctx, "binder", app.Options.OTLPEndpoint)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing, continuing without it")
+	} else {
+		defer shutdownTracing(context.Background())
+	}
+
 	go func() {
 		app.manager.GetCache().WaitForCacheSync(context.Background())
 		setupLog.Info("syncing resource reservation")
@@ -168,7 +183,15 @@ func (app *App) Run(ctx context.Context) error {
 		return err
 	}
 
-	binder := binding.NewBinder(app.Client, app.rrs, app.plugins)
+	if err = (&controllers.DedicatedNodeReconciler{
+		Client: app.manager.GetClient(),
+		Clock:  clock.RealClock{},
+	}).SetupWithManager(app.manager); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DedicatedNode")
+		return err
+	}
+
+	binder := binding.NewBinder(app.Client, app.rrs, app.plugins, app.Options.GPUBindClaims)
 
 	app.InformerFactory.Start(ctx.Done())
 	app.InformerFactory.WaitForCacheSync(ctx.Done())
@@ -223,5 +246,20 @@ func createIndexesForResourceReservation(mgr manager.Manager) error {
 		return err
 	}
 
+	if err := mgr.GetFieldIndexer().IndexField(
+		context.Background(), &corev1.Pod{}, common.PodGroupIndexField, common.PodGroupIndexer,
+	); err != nil {
+		setupLog.Error(err, "failed to create index for pod group name")
+		return err
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(
+		context.Background(), &schedulingv1alpha2.BindRequest{}, common.BindRequestPodNameIndexField,
+		common.BindRequestPodNameIndexer,
+	); err != nil {
+		setupLog.Error(err, "failed to create index for spec.podName")
+		return err
+	}
+
 	return nil
 }
@@ -5,6 +5,7 @@ package app
 
 import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 	"github.com/spf13/pflag"
 
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
@@ -15,6 +16,7 @@ type Options struct {
 	ResourceReservationNamespace         string
 	ResourceReservationServiceAccount    string
 	ResourceReservationPodImage          string
+	ResourceReservationArchImages        map[string]string
 	ResourceReservationAppLabel          string
 	ResourceReservationAllocationTimeout int
 	ResourceReservationPodResourcesJSON  string
@@ -31,6 +33,13 @@ type Options struct {
 	GpuCdiEnabled                        bool
 	VolumeBindingTimeoutSeconds          int
 	RuntimeClassName                     string
+	OTLPEndpoint                         string
+	GPUBindClaims                        bool
+	GPUSharingReleaseFinalizer           bool
+	GracefulShutdownTimeoutSeconds       int
+	AcceleratorResourceNames             []string
+	NodePoolLabelKey                     string
+	BindTimeSecretsJSON                  string
 }
 
 func InitOptions(fs *pflag.FlagSet) *Options {
@@ -52,6 +61,11 @@ func InitOptions(fs *pflag.FlagSet) *Options {
 	fs.StringVar(&options.ResourceReservationPodImage,
 		"resource-reservation-pod-image", "registry/local/kai-scheduler/resource-reservation",
 		"Container image for the resource reservation pod")
+	fs.StringToStringVar(&options.ResourceReservationArchImages,
+		"resource-reservation-arch-images", nil,
+		"Container images for the resource reservation pods on nodes of specific architectures, "+
+			"as arch=image pairs (e.g. arm64=registry/resource-reservation-arm64). "+
+			"Nodes of other architectures use the resource reservation pod image")
 	fs.StringVar(&options.ResourceReservationAppLabel,
 		"resource-reservation-app-label", constants.DefaultResourceReservationName,
 		"App label value of resource reservation pods")
@@ -101,6 +115,28 @@ func InitOptions(fs *pflag.FlagSet) *Options {
 	fs.StringVar(&options.RuntimeClassName,
 		"runtime-class-name", "",
 		"Runtime class for reservation pods")
+	fs.StringVar(&options.OTLPEndpoint,
+		"otlp-endpoint", "",
+		"The OTLP/gRPC collector endpoint to export binding traces to. Tracing is disabled when empty")
+	fs.BoolVar(&options.GPUBindClaims,
+		"gpu-bind-claims", false,
+		"Claim the whole GPUs of a pod group's pods with reservation pods until the pods are bound")
+	fs.BoolVar(&options.GPUSharingReleaseFinalizer,
+		"gpu-sharing-release-finalizer", false,
+		"Add a finalizer to GPU sharing pods, keeping them until their GPU reservation is released after they terminate")
+	fs.IntVar(&options.GracefulShutdownTimeoutSeconds,
+		"graceful-shutdown-timeout-seconds", 25,
+		"The maximum time to wait on shutdown for in-flight bind requests to be bound or rolled back")
+	fs.StringSliceVar(&options.AcceleratorResourceNames,
+		"accelerator-resource-names", resources.DefaultAcceleratorResourceNames(),
+		"The accelerator resources that are shared and reserved like GPUs")
+	fs.StringVar(&options.NodePoolLabelKey,
+		"nodepool-label-key", constants.DefaultNodePoolLabelKey,
+		"The label key for node pools")
+	fs.StringVar(&options.BindTimeSecretsJSON,
+		"bind-time-secrets", "",
+		"JSON-serialized bind-time secrets by node pool, filled on bind in the secret named by the "+
+			"kai.scheduler/bind-time-secret annotation of pods (optional, empty means not set)")
 
 	utilfeature.DefaultMutableFeatureGate.AddFlag(fs)
 
@@ -4,7 +4,9 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
+	"fmt"
 	"os"
 
 	"github.com/spf13/pflag"
@@ -15,6 +17,7 @@ import (
 
 	"github.com/NVIDIA/KAI-scheduler/cmd/binder/app"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/bindtimesecret"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/gpusharing"
 	k8s_plugins "github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/k8s-plugins"
 )
@@ -67,6 +70,19 @@ func registerPlugins(app *app.App) error {
 	bindingGpuSharingPlugin := gpusharing.New(app.Client, app.Options.GpuCdiEnabled)
 
 	binderPlugins.RegisterPlugin(bindingGpuSharingPlugin)
+
+	if app.Options.BindTimeSecretsJSON != "" {
+		nodePoolSecrets := map[string]bindtimesecret.NodePoolSecret{}
+		if err := json.Unmarshal([]byte(app.Options.BindTimeSecretsJSON), &nodePoolSecrets); err != nil {
+			return fmt.Errorf("failed to unmarshal bind-time secrets: %w", err)
+		}
+		bindTimeSecretPlugin, err := bindtimesecret.New(app.K8sInterface, app.Options.NodePoolLabelKey,
+			nodePoolSecrets)
+		if err != nil {
+			return err
+		}
+		binderPlugins.RegisterPlugin(bindTimeSecretPlugin)
+	}
 	app.RegisterPlugins(binderPlugins)
 	return nil
 }
@@ -0,0 +1,93 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
+	// to ensure that exec-entrypoint and run can make use of them.
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/eventaggregator"
+)
+
+const (
+	schedulerNameField = "spec.schedulerName"
+)
+
+var (
+	scheme   = runtime.NewScheme()
+	setupLog = ctrl.Log.WithName("setup")
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(v2alpha2.AddToScheme(scheme))
+}
+
+func Run(options *Options, config *rest.Config, ctx context.Context) error {
+	config.QPS = float32(options.Qps)
+	config.Burst = options.Burst
+
+	schedulerSelector := fields.Set{schedulerNameField: options.SchedulerName}.AsSelector()
+	cacheOptions := cache.Options{}
+	cacheOptions.ByObject = map[client.Object]cache.ByObject{
+		&v1.Pod{}:            {Field: schedulerSelector},
+		&v1.Event{}:          {},
+		&v2alpha2.PodGroup{}: {},
+	}
+
+	mgr, err := ctrl.NewManager(config, ctrl.Options{
+		Scheme:                 scheme,
+		Cache:                  cacheOptions,
+		Metrics:                metricsserver.Options{BindAddress: "0"},
+		HealthProbeBindAddress: options.ProbeAddr,
+	})
+	if err != nil {
+		setupLog.Error(err, "unable to start manager")
+		return err
+	}
+
+	broker := eventaggregator.NewBroker(options.BufferSize)
+	if err = mgr.Add(eventaggregator.NewEventWatcher(mgr.GetCache(), mgr.GetClient(), broker)); err != nil {
+		setupLog.Error(err, "unable to add event watcher")
+		return err
+	}
+	if err = mgr.Add(eventaggregator.NewServer(options.ListenAddress, options.CertDir, broker,
+		eventaggregator.NewAuthorizer(mgr.GetClient()))); err != nil {
+		setupLog.Error(err, "unable to add event stream server")
+		return err
+	}
+
+	if err = mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
+		setupLog.Error(err, "unable to set up health check")
+		return err
+	}
+	if err = mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
+		setupLog.Error(err, "unable to set up ready check")
+		return err
+	}
+
+	setupLog.Info("starting manager")
+	if err = mgr.Start(ctx); err != nil {
+		setupLog.Error(err, "problem running manager")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"flag"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+type Options struct {
+	ListenAddress string
+	CertDir       string
+	ProbeAddr     string
+	Qps           int
+	Burst         int
+	BufferSize    int
+	LogLevel      int
+	SchedulerName string
+}
+
+func InitOptions(fs *flag.FlagSet) *Options {
+	options := &Options{}
+
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+
+	fs.StringVar(&options.ListenAddress, "listen-address", ":8080",
+		"The address the event stream endpoint binds to.")
+	fs.StringVar(&options.CertDir, "cert-dir", "/tmp/event-aggregator/serving-certs",
+		"The directory of the tls.crt and tls.key files the event stream is served with.")
+	fs.StringVar(&options.ProbeAddr, "health-probe-bind-address", ":8081",
+		"The address the probe endpoint binds to.")
+	fs.IntVar(&options.Qps, "qps", 50,
+		"Queries per second to the K8s API server")
+	fs.IntVar(&options.Burst, "burst", 300,
+		"Burst to the K8s API server")
+	fs.IntVar(&options.BufferSize, "buffer-size", 100,
+		"Number of events buffered for each client before events are dropped for it")
+	fs.IntVar(&options.LogLevel, "log-level", 3,
+		"Log level")
+	fs.StringVar(&options.SchedulerName, "scheduler-name", constants.DefaultSchedulerName,
+		"The name of the scheduler whose pods' events are streamed")
+
+	return options
+}
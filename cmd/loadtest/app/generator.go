@@ -0,0 +1,205 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"math/rand"
+
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+const (
+	workloadNamespace  = "loadtest"
+	rootQueueName      = "loadtest-root"
+	priorityClassName  = "loadtest-train"
+	priorityClassValue = 50
+	nodeMemory         = "512Gi"
+
+	gpuResource = v1.ResourceName(commonconstants.GpuResource)
+)
+
+// Workload is a synthetic set of cluster objects to run the scheduler against
+type Workload struct {
+	Nodes           []*v1.Node
+	Queues          []*v2.Queue
+	PodGroups       []*v2alpha2.PodGroup
+	Pods            []*v1.Pod
+	PriorityClasses []*schedulingv1.PriorityClass
+}
+
+// GenerateWorkload creates nodes, a two level queue tree and gang pod groups according to the options.
+// The same options and seed always generate the same workload.
+func GenerateWorkload(opts *Options) (*Workload, error) {
+	gpuChoices, err := opts.gpusPerPod()
+	if err != nil {
+		return nil, err
+	}
+	random := rand.New(rand.NewSource(opts.Seed))
+
+	workload := &Workload{
+		PriorityClasses: []*schedulingv1.PriorityClass{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: priorityClassName},
+				Value:      priorityClassValue,
+			},
+		},
+	}
+
+	for i := 0; i < opts.Nodes; i++ {
+		workload.Nodes = append(workload.Nodes, newNode(fmt.Sprintf("node-%d", i), opts.CPUsPerNode, opts.GPUsPerNode))
+	}
+
+	totalGPUs := float64(opts.Nodes * opts.GPUsPerNode)
+	workload.Queues = append(workload.Queues, newQueue(rootQueueName, "", totalGPUs))
+	for i := 0; i < opts.Queues; i++ {
+		workload.Queues = append(workload.Queues,
+			newQueue(fmt.Sprintf("queue-%d", i), rootQueueName, totalGPUs/float64(opts.Queues)))
+	}
+
+	for i := 0; i < opts.PodGroups; i++ {
+		queueName := fmt.Sprintf("queue-%d", i%opts.Queues)
+		podGroupName := fmt.Sprintf("pg-%d", i)
+		numPods := random.Intn(opts.MaxPodsPerGroup) + 1
+		gpusPerPod := gpuChoices[random.Intn(len(gpuChoices))]
+
+		workload.PodGroups = append(workload.PodGroups, newPodGroup(podGroupName, queueName, int32(numPods)))
+		for j := 0; j < numPods; j++ {
+			workload.Pods = append(workload.Pods,
+				newPod(fmt.Sprintf("%s-%d", podGroupName, j), podGroupName, queueName, gpusPerPod))
+		}
+	}
+
+	return workload, nil
+}
+
+func newNode(name string, cpus, gpus int) *v1.Node {
+	allocatable := v1.ResourceList{
+		v1.ResourceCPU:    *resource.NewQuantity(int64(cpus), resource.DecimalSI),
+		v1.ResourceMemory: resource.MustParse(nodeMemory),
+		v1.ResourcePods:   resource.MustParse("110"),
+		gpuResource:       *resource.NewQuantity(int64(gpus), resource.DecimalSI),
+	}
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			UID:  types.UID(name),
+		},
+		Status: v1.NodeStatus{
+			Capacity:    allocatable.DeepCopy(),
+			Allocatable: allocatable,
+			Conditions: []v1.NodeCondition{
+				{
+					Type:   v1.NodeReady,
+					Status: v1.ConditionTrue,
+				},
+			},
+		},
+	}
+}
+
+func newQueue(name, parent string, deservedGPUs float64) *v2.Queue {
+	return &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v2.QueueSpec{
+			ParentQueue: parent,
+			Resources: &v2.QueueResources{
+				GPU: v2.QueueResource{
+					Quota:           deservedGPUs,
+					Limit:           -1,
+					OverQuotaWeight: 1,
+				},
+				CPU: v2.QueueResource{
+					Quota:           -1,
+					Limit:           -1,
+					OverQuotaWeight: 1,
+				},
+				Memory: v2.QueueResource{
+					Quota:           -1,
+					Limit:           -1,
+					OverQuotaWeight: 1,
+				},
+			},
+		},
+	}
+}
+
+func newPodGroup(name, queueName string, minMember int32) *v2alpha2.PodGroup {
+	return &v2alpha2.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: workloadNamespace,
+			UID:       types.UID(name),
+			Labels: map[string]string{
+				commonconstants.DefaultQueueLabel: queueName,
+			},
+		},
+		Spec: v2alpha2.PodGroupSpec{
+			MinMember:         minMember,
+			Queue:             queueName,
+			PriorityClassName: priorityClassName,
+			MarkUnschedulable: ptr.To(true),
+		},
+	}
+}
+
+func newPod(name, podGroupName, queueName string, gpus int) *v1.Pod {
+	// One CPU per requested GPU, and at least one CPU for CPU-only pods
+	requests := v1.ResourceList{
+		v1.ResourceCPU: *resource.NewQuantity(int64(max(gpus, 1)), resource.DecimalSI),
+	}
+	if gpus > 0 {
+		requests[gpuResource] = *resource.NewQuantity(int64(gpus), resource.DecimalSI)
+	}
+
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: workloadNamespace,
+			UID:       types.UID(name),
+			Labels: map[string]string{
+				commonconstants.DefaultQueueLabel: queueName,
+			},
+			Annotations: map[string]string{
+				commonconstants.PodGroupAnnotationForPod: podGroupName,
+			},
+		},
+		Spec: v1.PodSpec{
+			SchedulerName:     commonconstants.DefaultSchedulerName,
+			PriorityClassName: priorityClassName,
+			Containers: []v1.Container{
+				{
+					Name:  "main",
+					Image: "ubuntu",
+					Resources: v1.ResourceRequirements{
+						Requests: requests,
+						Limits:   limitsFromRequests(requests),
+					},
+				},
+			},
+		},
+		Status: v1.PodStatus{
+			Phase: v1.PodPending,
+		},
+	}
+}
+
+func limitsFromRequests(requests v1.ResourceList) v1.ResourceList {
+	limits := v1.ResourceList{}
+	if gpus, found := requests[gpuResource]; found {
+		limits[gpuResource] = gpus
+	}
+	return limits
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+func newTestOptions(args ...string) *Options {
+	fs := flag.NewFlagSet("loadtest", flag.ContinueOnError)
+	opts := InitOptions(fs)
+	_ = fs.Parse(args)
+	return opts
+}
+
+func TestGenerateWorkload(t *testing.T) {
+	opts := newTestOptions("--nodes=3", "--gpus-per-node=4", "--queues=2", "--podgroups=5",
+		"--max-pods-per-podgroup=3", "--gpus-per-pod=1,2")
+	assert.Nil(t, opts.Validate())
+
+	workload, err := GenerateWorkload(opts)
+	assert.Nil(t, err)
+	assert.Len(t, workload.Nodes, 3)
+	assert.Len(t, workload.Queues, 3)
+	assert.Len(t, workload.PodGroups, 5)
+	assert.Equal(t, float64(6), workload.Queues[1].Spec.Resources.GPU.Quota)
+	assert.Equal(t, rootQueueName, workload.Queues[1].Spec.ParentQueue)
+
+	podsPerGroup := map[string]int32{}
+	for _, pod := range workload.Pods {
+		podsPerGroup[pod.Annotations[commonconstants.PodGroupAnnotationForPod]]++
+		gpus := podGPUs(pod)
+		assert.Contains(t, []float64{1, 2}, gpus)
+	}
+	for _, podGroup := range workload.PodGroups {
+		assert.Equal(t, podGroup.Spec.MinMember, podsPerGroup[podGroup.Name])
+		assert.LessOrEqual(t, podGroup.Spec.MinMember, int32(3))
+	}
+}
+
+func TestGenerateWorkloadIsDeterministic(t *testing.T) {
+	opts := newTestOptions("--podgroups=20", "--seed=7")
+
+	first, err := GenerateWorkload(opts)
+	assert.Nil(t, err)
+	second, err := GenerateWorkload(opts)
+	assert.Nil(t, err)
+	assert.Equal(t, first.Pods, second.Pods)
+}
+
+func TestOptionsValidate(t *testing.T) {
+	assert.NotNil(t, newTestOptions("--nodes=0").Validate())
+	assert.NotNil(t, newTestOptions("--gpus-per-pod=1,x").Validate())
+	assert.Nil(t, newTestOptions().Validate())
+}
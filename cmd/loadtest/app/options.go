@@ -0,0 +1,74 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Options struct {
+	Nodes             int
+	GPUsPerNode       int
+	CPUsPerNode       int
+	Queues            int
+	PodGroups         int
+	MaxPodsPerGroup   int
+	GPUsPerPodChoices string
+	Seed              int64
+
+	Cycles        int
+	CycleInterval time.Duration
+	SchedulerConf string
+
+	Output     string
+	Verbosity  int
+	CPUProfile string
+}
+
+func InitOptions(fs *flag.FlagSet) *Options {
+	o := &Options{}
+
+	fs.IntVar(&o.Nodes, "nodes", 100, "Number of synthetic nodes")
+	fs.IntVar(&o.GPUsPerNode, "gpus-per-node", 8, "Number of GPUs on every synthetic node")
+	fs.IntVar(&o.CPUsPerNode, "cpus-per-node", 64, "Number of CPUs on every synthetic node")
+	fs.IntVar(&o.Queues, "queues", 10, "Number of leaf queues, sharing the cluster GPUs equally as deserved quota")
+	fs.IntVar(&o.PodGroups, "podgroups", 500, "Number of synthetic pod groups")
+	fs.IntVar(&o.MaxPodsPerGroup, "max-pods-per-podgroup", 4, "Maximal number of pods in a pod group, the actual number is drawn uniformly")
+	fs.StringVar(&o.GPUsPerPodChoices, "gpus-per-pod", "1,2,4,8", "Comma separated GPU counts a pod can request, drawn uniformly")
+	fs.Int64Var(&o.Seed, "seed", 1, "Random seed for the workload generator")
+	fs.IntVar(&o.Cycles, "cycles", 10, "Number of scheduling cycles to run")
+	fs.DurationVar(&o.CycleInterval, "cycle-interval", 200*time.Millisecond, "Time to wait between cycles for the informers to observe the simulated binds")
+	fs.StringVar(&o.SchedulerConf, "scheduler-conf", "", "Path to a scheduler configuration file, the default configuration is used when empty")
+	fs.StringVar(&o.Output, "output", "-", "Path of the JSON report (use '-' for stdout)")
+	fs.IntVar(&o.Verbosity, "verbosity", 0, "Scheduler logging verbosity")
+	fs.StringVar(&o.CPUProfile, "cpuprofile", "", "Write cpu profile of the scheduling cycles to file")
+
+	return o
+}
+
+func (o *Options) Validate() error {
+	if o.Nodes <= 0 || o.Queues <= 0 || o.PodGroups <= 0 || o.MaxPodsPerGroup <= 0 || o.Cycles <= 0 {
+		return fmt.Errorf("nodes, queues, podgroups, max-pods-per-podgroup and cycles must be positive")
+	}
+	if _, err := o.gpusPerPod(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *Options) gpusPerPod() ([]int, error) {
+	var choices []int
+	for _, value := range strings.Split(o.GPUsPerPodChoices, ",") {
+		gpus, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || gpus < 0 {
+			return nil, fmt.Errorf("invalid gpus-per-pod value %q", value)
+		}
+		choices = append(choices, gpus)
+	}
+	return choices, nil
+}
@@ -0,0 +1,193 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+// Report holds the metrics collected during a load test run
+type Report struct {
+	Workload WorkloadSummary `json:"workload"`
+	Cycles   []CycleMetrics  `json:"cycles"`
+	Latency  LatencySummary  `json:"latency"`
+	Quality  QualitySummary  `json:"quality"`
+
+	queueDeservedGPUs map[string]float64
+}
+
+type WorkloadSummary struct {
+	Nodes     int     `json:"nodes"`
+	GPUs      float64 `json:"gpus"`
+	Queues    int     `json:"queues"`
+	PodGroups int     `json:"podGroups"`
+	Pods      int     `json:"pods"`
+	Seed      int64   `json:"seed"`
+}
+
+type CycleMetrics struct {
+	Cycle       int     `json:"cycle"`
+	DurationMs  float64 `json:"durationMs"`
+	BoundPods   int     `json:"boundPods"`
+	EvictedPods int     `json:"evictedPods"`
+}
+
+type LatencySummary struct {
+	MinMs  float64 `json:"minMs"`
+	MeanMs float64 `json:"meanMs"`
+	P50Ms  float64 `json:"p50Ms"`
+	P95Ms  float64 `json:"p95Ms"`
+	P99Ms  float64 `json:"p99Ms"`
+	MaxMs  float64 `json:"maxMs"`
+}
+
+type QualitySummary struct {
+	RunningPods               int                `json:"runningPods"`
+	PendingPods               int                `json:"pendingPods"`
+	ScheduledPodGroups        int                `json:"scheduledPodGroups"`
+	PartiallyRunningPodGroups int                `json:"partiallyRunningPodGroups"`
+	TotalEvictions            int                `json:"totalEvictions"`
+	GPUAllocationRatio        float64            `json:"gpuAllocationRatio"`
+	QueueFairnessIndex        float64            `json:"queueFairnessIndex"`
+	QueueAllocatedGPUs        map[string]float64 `json:"queueAllocatedGPUs"`
+}
+
+func newReport(opts *Options, workload *Workload) *Report {
+	report := &Report{
+		Workload: WorkloadSummary{
+			Nodes:     len(workload.Nodes),
+			GPUs:      float64(opts.Nodes * opts.GPUsPerNode),
+			Queues:    opts.Queues,
+			PodGroups: len(workload.PodGroups),
+			Pods:      len(workload.Pods),
+			Seed:      opts.Seed,
+		},
+		queueDeservedGPUs: map[string]float64{},
+	}
+	for _, queue := range workload.Queues {
+		if queue.Spec.ParentQueue == "" {
+			continue
+		}
+		report.queueDeservedGPUs[queue.Name] = queue.Spec.Resources.GPU.Quota
+	}
+	return report
+}
+
+func (r *Report) addCycle(duration time.Duration, boundPods, evictedPods int) {
+	r.Cycles = append(r.Cycles, CycleMetrics{
+		Cycle:       len(r.Cycles),
+		DurationMs:  float64(duration.Microseconds()) / 1000,
+		BoundPods:   boundPods,
+		EvictedPods: evictedPods,
+	})
+	r.Quality.TotalEvictions += evictedPods
+}
+
+func (r *Report) finalize(pods []v1.Pod) {
+	r.Latency = summarizeLatency(r.Cycles)
+
+	type podGroupState struct{ running, total int }
+	podGroups := map[string]*podGroupState{}
+	queueAllocatedGPUs := map[string]float64{}
+	for queueName := range r.queueDeservedGPUs {
+		queueAllocatedGPUs[queueName] = 0
+	}
+	allocatedGPUs := float64(0)
+
+	for _, pod := range pods {
+		podGroupName := pod.Annotations[commonconstants.PodGroupAnnotationForPod]
+		if podGroups[podGroupName] == nil {
+			podGroups[podGroupName] = &podGroupState{}
+		}
+		podGroups[podGroupName].total++
+
+		if pod.Status.Phase != v1.PodRunning {
+			r.Quality.PendingPods++
+			continue
+		}
+		r.Quality.RunningPods++
+		podGroups[podGroupName].running++
+
+		gpus := podGPUs(&pod)
+		allocatedGPUs += gpus
+		queueAllocatedGPUs[pod.Labels[commonconstants.DefaultQueueLabel]] += gpus
+	}
+
+	for _, state := range podGroups {
+		if state.running == state.total {
+			r.Quality.ScheduledPodGroups++
+		} else if state.running > 0 {
+			r.Quality.PartiallyRunningPodGroups++
+		}
+	}
+	if r.Workload.GPUs > 0 {
+		r.Quality.GPUAllocationRatio = allocatedGPUs / r.Workload.GPUs
+	}
+	r.Quality.QueueAllocatedGPUs = queueAllocatedGPUs
+	r.Quality.QueueFairnessIndex = jainFairnessIndex(queueAllocatedGPUs, r.queueDeservedGPUs)
+}
+
+func podGPUs(pod *v1.Pod) float64 {
+	gpus := float64(0)
+	for _, container := range pod.Spec.Containers {
+		if quantity, found := container.Resources.Requests[gpuResource]; found {
+			gpus += float64(quantity.Value())
+		}
+	}
+	return gpus
+}
+
+func summarizeLatency(cycles []CycleMetrics) LatencySummary {
+	if len(cycles) == 0 {
+		return LatencySummary{}
+	}
+	durations := make([]float64, 0, len(cycles))
+	total := float64(0)
+	for _, cycle := range cycles {
+		durations = append(durations, cycle.DurationMs)
+		total += cycle.DurationMs
+	}
+	slices.Sort(durations)
+
+	return LatencySummary{
+		MinMs:  durations[0],
+		MeanMs: total / float64(len(durations)),
+		P50Ms:  percentile(durations, 0.5),
+		P95Ms:  percentile(durations, 0.95),
+		P99Ms:  percentile(durations, 0.99),
+		MaxMs:  durations[len(durations)-1],
+	}
+}
+
+// percentile returns the nearest-rank percentile of sorted values
+func percentile(sortedValues []float64, p float64) float64 {
+	rank := int(math.Ceil(p*float64(len(sortedValues)))) - 1
+	return sortedValues[max(rank, 0)]
+}
+
+// jainFairnessIndex returns Jain's fairness index of the queues allocation relative to their deserved share.
+// 1 means every queue got the same fraction of its deserved share.
+func jainFairnessIndex(allocated, deserved map[string]float64) float64 {
+	sum, sumOfSquares := float64(0), float64(0)
+	count := 0
+	for queueName, deservedGPUs := range deserved {
+		if deservedGPUs <= 0 {
+			continue
+		}
+		share := allocated[queueName] / deservedGPUs
+		sum += share
+		sumOfSquares += share * share
+		count++
+	}
+	if sumOfSquares == 0 {
+		return 1
+	}
+	return sum * sum / (float64(count) * sumOfSquares)
+}
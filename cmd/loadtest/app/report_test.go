@@ -0,0 +1,34 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeLatency(t *testing.T) {
+	var cycles []CycleMetrics
+	for _, durationMs := range []float64{40, 10, 30, 20} {
+		cycles = append(cycles, CycleMetrics{DurationMs: durationMs})
+	}
+
+	assert.Equal(t, LatencySummary{
+		MinMs:  10,
+		MeanMs: 25,
+		P50Ms:  20,
+		P95Ms:  40,
+		P99Ms:  40,
+		MaxMs:  40,
+	}, summarizeLatency(cycles))
+}
+
+func TestJainFairnessIndex(t *testing.T) {
+	deserved := map[string]float64{"q1": 10, "q2": 20}
+
+	assert.Equal(t, float64(1), jainFairnessIndex(map[string]float64{"q1": 5, "q2": 10}, deserved))
+	assert.Equal(t, 0.5, jainFairnessIndex(map[string]float64{"q1": 10, "q2": 0}, deserved))
+	assert.Equal(t, float64(1), jainFairnessIndex(map[string]float64{}, deserved))
+}
@@ -0,0 +1,220 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"runtime/pprof"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	kaischedulerfake "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/fake"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf_util"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins"
+)
+
+const numOfStatusRecordingWorkers = 5
+
+// Run generates the synthetic workload, drives the scheduler against it for the configured number of cycles,
+// simulating the binder between cycles, and returns the collected metrics.
+func Run(ctx context.Context, opts *Options) (*Report, error) {
+	workload, err := GenerateWorkload(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	actions.InitDefaultActions()
+	plugins.InitDefaultPlugins()
+
+	schedulerConf, err := conf_util.ResolveConfigurationFromFile(opts.SchedulerConf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve scheduler configuration: %w", err)
+	}
+
+	kubeClient, kaiClient, err := newClientsWithWorkload(ctx, workload)
+	if err != nil {
+		return nil, err
+	}
+
+	schedulerParams := &conf.SchedulerParams{
+		SchedulerName:               commonconstants.DefaultSchedulerName,
+		PartitionParams:             &conf.SchedulingNodePoolParams{},
+		NumOfStatusRecordingWorkers: numOfStatusRecordingWorkers,
+		QueueLabelKey:               commonconstants.DefaultQueueLabel,
+	}
+	schedulerCache := cache.New(&cache.SchedulerCacheParams{
+		KubeClient:                  kubeClient,
+		KAISchedulerClient:          kaiClient,
+		SchedulerName:               schedulerParams.SchedulerName,
+		NodePoolParams:              schedulerParams.PartitionParams,
+		NumOfStatusRecordingWorkers: schedulerParams.NumOfStatusRecordingWorkers,
+		DiscoveryClient:             kubeClient.Discovery(),
+	})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	schedulerCache.Run(stopCh)
+	schedulerCache.WaitForCacheSync(stopCh)
+
+	if opts.CPUProfile != "" {
+		f, err := os.Create(opts.CPUProfile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create CPU profile file: %w", err)
+		}
+		defer f.Close()
+		if err = pprof.StartCPUProfile(f); err != nil {
+			return nil, fmt.Errorf("failed to start CPU profile: %w", err)
+		}
+		defer pprof.StopCPUProfile()
+	}
+
+	schedulerActions, err := conf_util.GetActionsFromConfig(schedulerConf)
+	if err != nil {
+		return nil, err
+	}
+
+	report := newReport(opts, workload)
+	pluginStates := framework.NewPluginStates()
+	for cycle := 0; cycle < opts.Cycles; cycle++ {
+		log.InfraLogger.SetSessionID(fmt.Sprintf("loadtest-%d", cycle))
+		cycleStart := time.Now()
+		ssn, err := framework.OpenSession(ctx, schedulerCache, schedulerConf, schedulerParams, "", &http.ServeMux{},
+			framework.SessionOptions{PluginStates: pluginStates})
+		if err != nil {
+			return nil, fmt.Errorf("failed to open session: %w", err)
+		}
+		for _, action := range schedulerActions {
+			log.InfraLogger.SetAction(string(action.Name()))
+			action.Execute(ssn)
+		}
+		framework.CloseSession(ssn)
+		cycleDuration := time.Since(cycleStart)
+		schedulerCache.WaitForWorkers(stopCh)
+
+		boundPods, evictedPods, err := simulateBinder(ctx, kubeClient, kaiClient, workload.Pods)
+		if err != nil {
+			return nil, err
+		}
+		report.addCycle(cycleDuration, boundPods, evictedPods)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(opts.CycleInterval):
+		}
+	}
+
+	pods, err := kubeClient.CoreV1().Pods(workloadNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	report.finalize(pods.Items)
+
+	return report, nil
+}
+
+func newClientsWithWorkload(ctx context.Context, workload *Workload) (*fake.Clientset, *kaischedulerfake.Clientset, error) {
+	kubeClient := fake.NewSimpleClientset()
+	kaiClient := kaischedulerfake.NewSimpleClientset()
+
+	for _, priorityClass := range workload.PriorityClasses {
+		if _, err := kubeClient.SchedulingV1().PriorityClasses().Create(ctx, priorityClass, metav1.CreateOptions{}); err != nil {
+			return nil, nil, fmt.Errorf("failed to create priority class: %w", err)
+		}
+	}
+	for _, node := range workload.Nodes {
+		if _, err := kubeClient.CoreV1().Nodes().Create(ctx, node, metav1.CreateOptions{}); err != nil {
+			return nil, nil, fmt.Errorf("failed to create node: %w", err)
+		}
+	}
+	for _, queue := range workload.Queues {
+		if _, err := kaiClient.SchedulingV2().Queues("").Create(ctx, queue, metav1.CreateOptions{}); err != nil {
+			return nil, nil, fmt.Errorf("failed to create queue: %w", err)
+		}
+	}
+	for _, podGroup := range workload.PodGroups {
+		if _, err := kaiClient.SchedulingV2alpha2().PodGroups(podGroup.Namespace).Create(ctx, podGroup, metav1.CreateOptions{}); err != nil {
+			return nil, nil, fmt.Errorf("failed to create pod group: %w", err)
+		}
+	}
+	for _, pod := range workload.Pods {
+		if _, err := kubeClient.CoreV1().Pods(pod.Namespace).Create(ctx, pod, metav1.CreateOptions{}); err != nil {
+			return nil, nil, fmt.Errorf("failed to create pod: %w", err)
+		}
+	}
+
+	return kubeClient, kaiClient, nil
+}
+
+// simulateBinder plays the role of the binder and the workload controllers: pods with a bind request start running
+// on the selected node, and pods that were evicted by the scheduler are recreated as pending.
+func simulateBinder(
+	ctx context.Context, kubeClient *fake.Clientset, kaiClient *kaischedulerfake.Clientset, workloadPods []*v1.Pod,
+) (int, int, error) {
+	bindRequests, err := kaiClient.SchedulingV1alpha2().BindRequests(workloadNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to list bind requests: %w", err)
+	}
+
+	boundPods := 0
+	for _, bindRequest := range bindRequests.Items {
+		pod, err := kubeClient.CoreV1().Pods(workloadNamespace).Get(ctx, bindRequest.Spec.PodName, metav1.GetOptions{})
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to get pod %s: %w", bindRequest.Spec.PodName, err)
+		}
+		pod.Spec.NodeName = bindRequest.Spec.SelectedNode
+		pod.Status.Phase = v1.PodRunning
+		pod.Status.StartTime = &metav1.Time{Time: time.Now()}
+		if _, err = kubeClient.CoreV1().Pods(workloadNamespace).Update(ctx, pod, metav1.UpdateOptions{}); err != nil {
+			return 0, 0, fmt.Errorf("failed to update pod %s: %w", pod.Name, err)
+		}
+		err = kaiClient.SchedulingV1alpha2().BindRequests(workloadNamespace).Delete(
+			ctx, bindRequest.Name, metav1.DeleteOptions{})
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to delete bind request %s: %w", bindRequest.Name, err)
+		}
+		boundPods++
+	}
+
+	evictedPods, err := recreateEvictedPods(ctx, kubeClient, workloadPods)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return boundPods, evictedPods, nil
+}
+
+func recreateEvictedPods(ctx context.Context, kubeClient *fake.Clientset, workloadPods []*v1.Pod) (int, error) {
+	pods, err := kubeClient.CoreV1().Pods(workloadNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list pods: %w", err)
+	}
+	existingPods := map[string]bool{}
+	for _, pod := range pods.Items {
+		existingPods[pod.Name] = true
+	}
+
+	evictedPods := 0
+	for _, pod := range workloadPods {
+		if existingPods[pod.Name] {
+			continue
+		}
+		if _, err = kubeClient.CoreV1().Pods(workloadNamespace).Create(ctx, pod, metav1.CreateOptions{}); err != nil {
+			return 0, fmt.Errorf("failed to recreate evicted pod %s: %w", pod.Name, err)
+		}
+		evictedPods++
+	}
+	return evictedPods, nil
+}
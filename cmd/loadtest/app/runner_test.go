@@ -0,0 +1,30 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+func TestRun(t *testing.T) {
+	opts := newTestOptions("--nodes=2", "--gpus-per-node=8", "--queues=2", "--podgroups=4",
+		"--max-pods-per-podgroup=2", "--gpus-per-pod=1,2", "--cycles=2", "--cycle-interval=50ms")
+
+	assert.Nil(t, log.InitLoggers(0))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	report, err := Run(ctx, opts)
+	assert.Nil(t, err)
+	assert.Len(t, report.Cycles, 2)
+	assert.Equal(t, report.Workload.Pods, report.Quality.RunningPods)
+	assert.Equal(t, 4, report.Quality.ScheduledPodGroups)
+	assert.Equal(t, 0, report.Quality.PartiallyRunningPodGroups)
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/NVIDIA/KAI-scheduler/cmd/loadtest/app"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+func main() {
+	fs := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	opts := app.InitOptions(fs)
+	_ = fs.Parse(os.Args[1:])
+
+	if err := run(opts); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(opts *app.Options) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	if err := log.InitLoggers(opts.Verbosity); err != nil {
+		return fmt.Errorf("failed to initialize logger: %w", err)
+	}
+
+	report, err := app.Run(context.Background(), opts)
+	if err != nil {
+		return err
+	}
+
+	var output io.Writer = os.Stdout
+	if opts.Output != "-" {
+		f, err := os.Create(opts.Output)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer f.Close()
+		output = f
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
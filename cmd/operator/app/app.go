@@ -49,9 +49,10 @@ func init() {
 }
 
 type App struct {
-	manager          manager.Manager
-	configReconciler *controller.ConfigReconciler
-	shardReconciler  *controller.SchedulingShardReconciler
+	manager                 manager.Manager
+	configReconciler        *controller.ConfigReconciler
+	shardReconciler         *controller.SchedulingShardReconciler
+	nodePoolLabelReconciler *controller.NodePoolLabelReconciler
 }
 
 func New() (*App, error) {
@@ -100,10 +101,15 @@ func New() (*App, error) {
 		mgr.GetClient(), mgr.GetScheme(),
 	)
 
+	nodePoolLabelReconciler := &controller.NodePoolLabelReconciler{
+		Client: mgr.GetClient(),
+	}
+
 	return &App{
-		manager:          mgr,
-		configReconciler: configReconciler,
-		shardReconciler:  shardReconciler,
+		manager:                 mgr,
+		configReconciler:        configReconciler,
+		shardReconciler:         shardReconciler,
+		nodePoolLabelReconciler: nodePoolLabelReconciler,
 	}, nil
 }
 
@@ -125,6 +131,10 @@ func (app *App) Run() error {
 		setupLog.Error(err, "unable to create controller", "controller", "SchedulingShard")
 		os.Exit(1)
 	}
+	if err = app.nodePoolLabelReconciler.SetupWithManager(app.manager); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NodePoolLabel")
+		os.Exit(1)
+	}
 
 	if err := app.manager.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
@@ -5,8 +5,11 @@ package app
 
 import (
 	"context"
+	"strings"
 
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgroupcontroller/controllers"
 
 	v1 "k8s.io/api/core/v1"
@@ -43,6 +46,7 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(v2alpha2.AddToScheme(scheme))
+	utilruntime.Must(v1alpha2.AddToScheme(scheme))
 
 	// +kubebuilder:scaffold:scheme
 }
@@ -53,6 +57,7 @@ func init() {
 func Run(options *Options, config *rest.Config, ctx context.Context) error {
 	config.QPS = float32(options.Qps)
 	config.Burst = options.Burst
+	resources.SetAcceleratorResourceNames(strings.Split(options.AcceleratorResourceNames, ","))
 
 	schedulerSelector := fields.Set{schedulerNameField: options.SchedulerName}.AsSelector()
 	cacheOptions := cache.Options{}
@@ -61,6 +66,7 @@ func Run(options *Options, config *rest.Config, ctx context.Context) error {
 		&v1.Node{}:                    {},
 		&schedulingv1.PriorityClass{}: {},
 		&v2alpha2.PodGroup{}:          {},
+		&v1alpha2.BindRequest{}:       {},
 	}
 
 	mgr, err := ctrl.NewManager(config, ctrl.Options{
@@ -95,6 +101,8 @@ func Run(options *Options, config *rest.Config, ctx context.Context) error {
 
 	configs := controllers.Configs{
 		MaxConcurrentReconciles: options.MaxConcurrentReconciles,
+		NodePoolLabelKey:        options.NodePoolLabelKey,
+		FallbackNodePool:        options.FallbackNodePool,
 	}
 	if err = (&controllers.PodGroupReconciler{
 		Client: mgr.GetClient(),
@@ -5,8 +5,10 @@ package app
 
 import (
 	"flag"
+	"strings"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 )
 
 type Options struct {
@@ -20,6 +22,9 @@ type Options struct {
 	LogLevel                     int
 	SchedulerName                string
 	EnablePodGroupWebhook        bool
+	AcceleratorResourceNames     string
+	NodePoolLabelKey             string
+	FallbackNodePool             string
 }
 
 func InitOptions(fs *flag.FlagSet) *Options {
@@ -49,6 +54,14 @@ func InitOptions(fs *flag.FlagSet) *Options {
 		"The name of the scheduler used to schedule pod groups")
 	fs.BoolVar(&options.EnablePodGroupWebhook, "enable-podgroup-webhook", true,
 		"Enable podgroup webhook")
+	fs.StringVar(&options.AcceleratorResourceNames, "accelerator-resource-names",
+		strings.Join(resources.DefaultAcceleratorResourceNames(), ","),
+		"Comma separated list of the accelerator resources that are accounted as GPUs")
+	fs.StringVar(&options.NodePoolLabelKey, "nodepool-label-key", constants.DefaultNodePoolLabelKey,
+		"The label key of the node pools, used to check whether pod groups fit the nodes of their node pool")
+	fs.StringVar(&options.FallbackNodePool, "fallback-node-pool", "",
+		"The node pool that pending pod groups are moved to when their node pool has no ready nodes. "+
+			"Pod groups are not moved if empty")
 
 	return options
 }
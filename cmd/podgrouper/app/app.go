@@ -4,6 +4,7 @@
 package app
 
 import (
+	"context"
 	"flag"
 
 	"go.uber.org/zap/zapcore"
@@ -24,8 +25,10 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
 	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 	kubeAiSchedulerV2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/tracing"
 	controllers "github.com/NVIDIA/KAI-scheduler/pkg/podgrouper"
 	pluginshub "github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/hub"
 	// +kubebuilder:scaffold:imports
@@ -45,6 +48,7 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(v2.AddToScheme(scheme))
 	utilruntime.Must(kubeAiSchedulerV2alpha2.AddToScheme(scheme))
+	utilruntime.Must(kaiv1alpha1.AddToScheme(scheme))
 
 	// +kubebuilder:scaffold:scheme
 }
@@ -53,8 +57,9 @@ type App struct {
 	Mgr               manager.Manager
 	DefaultPluginsHub *pluginshub.DefaultPluginsHub
 
-	configs    controllers.Configs
-	pluginsHub pluginshub.PluginsHub
+	configs      controllers.Configs
+	pluginsHub   pluginshub.PluginsHub
+	otlpEndpoint string
 }
 
 func Run() error {
@@ -123,6 +128,7 @@ func NewWithScheme(mgrScheme *runtime.Scheme) (*App, error) {
 		DefaultPluginsHub: defaultPluginsHub,
 		configs:           configs,
 		pluginsHub:        nil,
+		otlpEndpoint:      opts.OTLPEndpoint,
 	}
 	return app, nil
 }
@@ -146,6 +152,13 @@ func (app *App) Run() error {
 	}).SetupWithManager(app.Mgr, app.configs, pluginsHub); err != nil {
 		return err
 	}
+	if app.configs.CoschedulingPodGroups {
+		if err := (&controllers.CoschedulingPodGroupReconciler{
+			Client: app.Mgr.GetClient(),
+		}).SetupWithManager(app.Mgr); err != nil {
+			return err
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := app.Mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -155,8 +168,16 @@ func (app *App) Run() error {
 		return err
 	}
 
+	ctx := ctrl.SetupSignalHandler()
+	shutdownTracing, err := tracing.Setup(ctx, "podgrouper", app.otlpEndpoint)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing, continuing without it")
+	} else {
+		defer shutdownTracing(context.Background())
+	}
+
 	setupLog.Info("starting manager")
-	return app.Mgr.Start(ctrl.SetupSignalHandler())
+	return app.Mgr.Start(ctx)
 }
 
 func initLogger() {
@@ -21,12 +21,17 @@ type Options struct {
 	MaxConcurrentReconciles                int
 	SearchForLegacyPodGroups               bool
 	KnativeGangSchedule                    bool
+	KueueWorkloads                         bool
+	PriorityAssignmentRules                bool
+	CoschedulingPodGroups                  bool
 	SchedulerName                          string
 	SchedulingQueueLabelKey                string
+	SubGroupLabelKey                       string
 	PodLabelSelectorStr                    string
 	NamespaceLabelSelectorStr              string
 	DefaultConfigPerTypeConfigMapName      string
 	DefaultConfigPerTypeConfigMapNamespace string
+	OTLPEndpoint                           string
 }
 
 func (o *Options) AddFlags(fs *flag.FlagSet) {
@@ -39,10 +44,15 @@ func (o *Options) AddFlags(fs *flag.FlagSet) {
 	fs.IntVar(&o.MaxConcurrentReconciles, "max-concurrent-reconciles", 10, "Max concurrent reconciles")
 	fs.BoolVar(&o.SearchForLegacyPodGroups, "search-legacy-pg", true, "If this flag is enabled, try to find pod groups with legacy name format. If they exist, use the found pod groups instead of creating new once with current name format")
 	fs.BoolVar(&o.KnativeGangSchedule, "knative-gang-schedule", true, "Schedule knative revision as a gang. Defaults to true")
+	fs.BoolVar(&o.KueueWorkloads, "kueue-workloads", false, "Put the pod groups of jobs admitted by Kueue in the queue named after the admitting ClusterQueue, with the min members of the Kueue workload")
+	fs.BoolVar(&o.PriorityAssignmentRules, "priority-assignment-rules", false, "Derive the priority class of pod groups from the PriorityAssignmentRules of the cluster. Requires the kai.scheduler PriorityAssignmentRule CRD")
+	fs.BoolVar(&o.CoschedulingPodGroups, "coscheduling-pod-groups", false, "Gang schedule pods labeled with a scheduler-plugins coscheduling PodGroup in a pod group of the same name, and keep the status of the coscheduling PodGroups in sync. Requires the scheduling.x-k8s.io PodGroup CRD")
 	fs.StringVar(&o.SchedulerName, "scheduler-name", constants.DefaultSchedulerName, "The name of the scheduler used to schedule pod groups")
 	fs.StringVar(&o.SchedulingQueueLabelKey, "queue-label-key", constants.DefaultQueueLabel, "Scheduling queue label key name")
+	fs.StringVar(&o.SubGroupLabelKey, "subgroup-label-key", "", "Derive the subgroups of pod groups whose workloads don't define subgroups from the values of this pod label key, such as training.kubeflow.org/replica-type. Disabled when empty")
 	fs.StringVar(&o.DefaultConfigPerTypeConfigMapName, "default-priorities-configmap-name", "", "The name of the configmap that contains default configs (priorities and preemptibility) for pod groups")
 	fs.StringVar(&o.DefaultConfigPerTypeConfigMapNamespace, "default-priorities-configmap-namespace", "", "The namespace of the configmap that contains default configs (priorities and preemptibility) for pod groups")
+	fs.StringVar(&o.OTLPEndpoint, "otlp-endpoint", "", "The OTLP/gRPC collector endpoint to export pod grouping traces to. Tracing is disabled when empty")
 	flag.StringVar(&o.PodLabelSelectorStr, "pod-label-selector", "", "Pod label selector in key=value comma-separated format")
 	flag.StringVar(&o.NamespaceLabelSelectorStr, "namespace-label-selector", "", "Namespace label selector in key=value comma-separated format")
 }
@@ -53,8 +63,12 @@ func (o *Options) Configs() controllers.Configs {
 		MaxConcurrentReconciles:                o.MaxConcurrentReconciles,
 		SearchForLegacyPodGroups:               o.SearchForLegacyPodGroups,
 		KnativeGangSchedule:                    o.KnativeGangSchedule,
+		KueueWorkloads:                         o.KueueWorkloads,
+		PriorityAssignmentRules:                o.PriorityAssignmentRules,
+		CoschedulingPodGroups:                  o.CoschedulingPodGroups,
 		SchedulerName:                          o.SchedulerName,
 		SchedulingQueueLabelKey:                o.SchedulingQueueLabelKey,
+		SubGroupLabelKey:                       o.SubGroupLabelKey,
 		PodLabelSelector:                       parseLabelSelector(o.PodLabelSelectorStr),
 		NamespaceLabelSelector:                 parseLabelSelector(o.NamespaceLabelSelectorStr),
 		DefaultConfigPerTypeConfigMapName:      o.DefaultConfigPerTypeConfigMapName,
@@ -7,11 +7,13 @@ import (
 	"context"
 	"fmt"
 
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
 	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
+	"k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	"k8s.io/client-go/rest"
 
@@ -23,7 +25,10 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/queuecontroller/controllers"
+	"github.com/NVIDIA/KAI-scheduler/pkg/queuecontroller/controllers/namespaced_queues"
+	"github.com/NVIDIA/KAI-scheduler/pkg/queuecontroller/custommetrics"
 	"github.com/NVIDIA/KAI-scheduler/pkg/queuecontroller/metrics"
+	"github.com/NVIDIA/KAI-scheduler/pkg/queuecontroller/sharding"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -33,12 +38,14 @@ var (
 )
 
 // +kubebuilder:webhook:path=/validate--v1-queue,mutating=false,failurePolicy=fail,sideEffects=None,resources=queues.scheduling.run.ai,verbs=create;update,groups=core,versions=v2,name=queuecontroller.run.ai,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-kai-scheduler-v1alpha1-namespacedqueue,mutating=false,failurePolicy=fail,sideEffects=None,resources=namespacedqueues.kai.scheduler,verbs=create;update,groups=kai.scheduler,versions=v1alpha1,name=namespacedqueue.kai.scheduler,admissionReviewVersions=v1
 
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(v2.AddToScheme(scheme))
 	utilruntime.Must(v2alpha2.AddToScheme(scheme))
+	utilruntime.Must(kaiv1alpha1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -75,13 +82,55 @@ func Run(opts *Options, clientConfig *rest.Config, ctx context.Context) error {
 		}
 	}
 
+	var shards *sharding.Shards
+	if opts.ReconciliationShards > 1 {
+		if shards, err = setupShards(mgr, clientConfig, opts); err != nil {
+			setupLog.Error(err, "unable to set up queue reconciliation shards")
+			return err
+		}
+	}
+
 	if err = (&controllers.QueueReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:                 mgr.GetClient(),
+		Scheme:                 mgr.GetScheme(),
+		StarvationThreshold:    opts.StarvationThreshold,
+		BudgetMeteringInterval: opts.BudgetMeteringInterval,
+		Shards:                 shards,
 	}).SetupWithManager(mgr, opts.SchedulingQueueLabelKey, opts.SkipControllerNameValidation); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Queue")
 		return nil
 	}
+	if opts.EnableNamespaceQueues {
+		if err = (&controllers.NamespaceQueueReconciler{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+		}).SetupWithManager(mgr, opts.SkipControllerNameValidation); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "NamespaceQueue")
+			return nil
+		}
+	}
+	if opts.EnableNamespacedQueues {
+		if err = (&controllers.NamespacedQueueReconciler{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+		}).SetupWithManager(mgr, opts.SkipControllerNameValidation); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "NamespacedQueue")
+			return nil
+		}
+		if opts.EnableWebhook {
+			if err = (&namespaced_queues.Validator{Reader: mgr.GetClient()}).SetupWebhookWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create webhook for namespaced queues", "webhook", "NamespacedQueue")
+				return nil
+			}
+		}
+	}
+	if opts.CustomMetricsAPIAddress != "" {
+		if err = mgr.Add(custommetrics.NewServer(mgr.GetClient(), opts.CustomMetricsAPIAddress,
+			opts.CustomMetricsAPICertDir, opts.CustomMetricsAPIClientCAFile)); err != nil {
+			setupLog.Error(err, "unable to add custom metrics API server")
+			return err
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -101,3 +150,20 @@ func Run(opts *Options, clientConfig *rest.Config, ctx context.Context) error {
 
 	return nil
 }
+
+func setupShards(mgr ctrl.Manager, clientConfig *rest.Config, opts *Options) (*sharding.Shards, error) {
+	kubeClient, err := kubernetes.NewForConfig(clientConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes client: %v", err)
+	}
+
+	shards := sharding.NewShards(mgr.GetClient(), opts.ReconciliationShards)
+	elector, err := sharding.NewElector(shards, opts.ShardLeaseNamespace, kubeClient.CoordinationV1())
+	if err != nil {
+		return nil, err
+	}
+	if err = mgr.Add(elector); err != nil {
+		return nil, fmt.Errorf("failed to add shards elector: %v", err)
+	}
+	return shards, nil
+}
@@ -5,13 +5,17 @@ package app
 
 import (
 	"flag"
+	"time"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	kaiflags "github.com/NVIDIA/KAI-scheduler/pkg/common/flags"
+	"github.com/NVIDIA/KAI-scheduler/pkg/queuecontroller/controllers/metering"
 )
 
 const (
-	defaultMetricsAddress = ":8080"
+	defaultMetricsAddress       = ":8080"
+	defaultCustomMetricsCertDir = "/tmp/k8s-custom-metrics-server/serving-certs"
+	defaultStarvationThreshold  = 5 * time.Minute
 )
 
 type Options struct {
@@ -19,12 +23,22 @@ type Options struct {
 	SchedulingQueueLabelKey      string
 	EnableWebhook                bool
 	SkipControllerNameValidation bool // Set true for env tests
+	EnableNamespaceQueues        bool
+	EnableNamespacedQueues       bool
+	StarvationThreshold          time.Duration
+	BudgetMeteringInterval       time.Duration
+	ReconciliationShards         int
+	ShardLeaseNamespace          string
 
 	MetricsAddress                 string
 	MetricsNamespace               string
 	QueueLabelToMetricLabel        kaiflags.StringMapFlag
 	QueueLabelToDefaultMetricValue kaiflags.StringMapFlag
 
+	CustomMetricsAPIAddress      string
+	CustomMetricsAPICertDir      string
+	CustomMetricsAPIClientCAFile string
+
 	// k8s client options
 	Qps   int
 	Burst int
@@ -37,10 +51,19 @@ func InitOptions(fs *flag.FlagSet) *Options {
 	fs.StringVar(&o.SchedulingQueueLabelKey, "queue-label-key", constants.DefaultQueueLabel, "Scheduling queue label key name.")
 	fs.BoolVar(&o.EnableWebhook, "enable-webhook", true, "Enable webhook for controller manager.")
 	fs.BoolVar(&o.SkipControllerNameValidation, "skip-controller-name-validation", false, "Skip controller name validation.")
+	fs.BoolVar(&o.EnableNamespaceQueues, "enable-namespace-queues", false, "Create and sync a leaf queue for every namespace annotated with kai.scheduler/auto-queue=true.")
+	fs.BoolVar(&o.EnableNamespacedQueues, "enable-namespaced-queues", false, "Sync a cluster-scoped leaf queue for every NamespacedQueue, and validate NamespacedQueues against the bounds of their parent queue.")
+	fs.DurationVar(&o.StarvationThreshold, "starvation-threshold", defaultStarvationThreshold, "How long a queue must have unallocated requests within its deserved quota before it is marked as starved.")
+	fs.DurationVar(&o.BudgetMeteringInterval, "budget-metering-interval", metering.DefaultInterval, "The longest time between two meterings of the GPU hours consumed by a queue with a budget.")
+	fs.IntVar(&o.ReconciliationShards, "reconciliation-shards", 1, "Number of shards, by queue hierarchy subtree, that the reconciliation of queues is split into between the replicas. Every shard is reconciled by the replica holding its lease.")
+	fs.StringVar(&o.ShardLeaseNamespace, "shard-lease-namespace", constants.DefaultKAINamespace, "Namespace of the leases of the queue reconciliation shards.")
 	fs.StringVar(&o.MetricsAddress, "metrics-listen-address", defaultMetricsAddress, "The address the metrics endpoint binds to.")
 	fs.StringVar(&o.MetricsNamespace, "metrics-namespace", constants.DefaultMetricsNamespace, "Metrics namespace.")
 	fs.Var(&o.QueueLabelToMetricLabel, "queue-label-to-metric-label", "Map of queue label keys to metric label keys, e.g. 'foo=bar,baz=qux'.")
 	fs.Var(&o.QueueLabelToDefaultMetricValue, "queue-label-to-default-metric-value", "Map of queue label keys to default metric values, in case the label doesn't exist on the queue, e.g. 'foo=1,baz=0'.")
+	fs.StringVar(&o.CustomMetricsAPIAddress, "custom-metrics-api-address", "", "The address the custom metrics API of queues binds to. The API is disabled when empty.")
+	fs.StringVar(&o.CustomMetricsAPICertDir, "custom-metrics-api-cert-dir", defaultCustomMetricsCertDir, "The directory of the custom metrics API serving certificate (tls.crt and tls.key).")
+	fs.StringVar(&o.CustomMetricsAPIClientCAFile, "custom-metrics-api-client-ca-file", "", "CA file verifying the client certificates of custom metrics API requests, usually the API server's requestheader client CA. Client certificates are not required when empty.")
 	fs.IntVar(&o.Qps, "qps", 50, "Queries per second to the K8s API server")
 	fs.IntVar(&o.Burst, "burst", 300, "Burst to the K8s API server")
 
@@ -20,18 +20,22 @@ limitations under the License.
 package options
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/spf13/pflag"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
 )
 
 const (
 	defaultSchedulerPeriod             = time.Second
 	defaultStalenessGracePeriod        = 60 * time.Second
+	defaultGracefulShutdownTimeout     = 25 * time.Second
 	defaultListenAddress               = ":8080"
 	defaultProfilerApiPort             = "8182"
 	defaultVerbosityLevel              = 3
@@ -48,6 +52,7 @@ type ServerOption struct {
 	ResourceReservationAppLabel       string
 	SchedulerConf                     string
 	SchedulePeriod                    time.Duration
+	GracefulShutdownTimeout           time.Duration
 	EnableLeaderElection              bool
 	PrintVersion                      bool
 	MetricsNamespace                  string
@@ -60,6 +65,7 @@ type ServerOption struct {
 	PyroscopeAddress                  string
 	PyroscopeMutexProfilerRate        int
 	PyroscopeBlockProfilerRate        int
+	OTLPEndpoint                      string
 	Verbosity                         int
 	MaxNumberConsolidationPreemptees  int
 	DetailedFitErrors                 bool
@@ -75,7 +81,12 @@ type ServerOption struct {
 	GPUWorkerNodeLabelKey             string
 	MIGWorkerNodeLabelKey             string
 	QueueLabelKey                     string
+	ElasticReclaimStrategy            string
 	Namspace                          string
+	AcceleratorResourceNames          []string
+	DryRun                            bool
+	ReleasingHorizon                  time.Duration
+	StatsEndpoint                     bool
 
 	QPS   int
 	Burst int
@@ -100,6 +111,8 @@ func (s *ServerOption) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.NodePoolLabelValue, "partition-label-value", "", "The label value by which to filter scheduling partition")
 	fs.StringVar(&s.SchedulerConf, "scheduler-conf", "", "The absolute path of scheduler configuration file")
 	fs.DurationVar(&s.SchedulePeriod, "schedule-period", defaultSchedulerPeriod, "The period between each scheduling cycle")
+	fs.DurationVar(&s.GracefulShutdownTimeout, "graceful-shutdown-timeout", defaultGracefulShutdownTimeout,
+		"The maximum time to wait on shutdown for the running scheduling cycle to finish committing its bind requests")
 	fs.BoolVar(&s.EnableLeaderElection, "leader-elect", false,
 		"Start a leader election client and gain leadership before "+
 			"executing the main loop. Enable this when running replicated kai-scheduler for high availability")
@@ -110,22 +123,30 @@ func (s *ServerOption) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.PyroscopeAddress, "pyroscope-address", "", "The url of pyroscope")
 	fs.IntVar(&s.PyroscopeMutexProfilerRate, "pyroscope-mutex-profiler-rate", DefaultPyroscopeMutexProfilerRate, "Mutex Profiler rate")
 	fs.IntVar(&s.PyroscopeBlockProfilerRate, "pyroscope-block-profiler-rate", DefaultPyroscopeBlockProfilerRate, "Block Profiler rate")
+	fs.StringVar(&s.OTLPEndpoint, "otlp-endpoint", "", "The OTLP/gRPC collector endpoint to export scheduling traces to. Tracing is disabled when empty")
 	fs.IntVar(&s.Verbosity, "v", defaultVerbosityLevel, "Verbosity level")
 	fs.IntVar(&s.MaxNumberConsolidationPreemptees, "max-consolidation-preemptees", defaultMaxConsolidationPreemptees, "Maximum number of consolidation preemptees. Defaults to 16")
 	fs.IntVar(&s.QPS, "qps", 50, "Queries per second to the K8s API server")
 	fs.IntVar(&s.Burst, "burst", 300, "Burst to the K8s API server")
 	fs.BoolVar(&s.DetailedFitErrors, "detailed-fit-errors", defaultDetailedFitError, "Write detailed fit errors for every node on every podgroup")
 	fs.BoolVar(&s.UpdatePodEvictionCondition, "update-pod-eviction-condition", false, "Update pod eviction condition to reflect the pod's eviction status")
+	fs.BoolVar(&s.DryRun, "dry-run", false, "Run full scheduling cycles without creating bind requests, evicting pods or updating statuses, and log and export the intended placements and evictions instead")
 	fs.BoolVar(&s.ScheduleCSIStorage, "schedule-csi-storage", false, "Enables advanced scheduling (preempt, reclaim) for csi storage objects")
 	fs.BoolVar(&s.UseSchedulingSignatures, "use-scheduling-signatures", true, "Use scheduling signatures to avoid duplicate scheduling attempts for identical jobs")
 	fs.BoolVar(&s.FullHierarchyFairness, "full-hierarchy-fairness", true, "Fairness across project and department levels")
 	fs.BoolVar(&s.AllowConsolidatingReclaim, "allow-consolidating-reclaim", true, "Do not count pipelined pods towards 'reclaimed' resources")
+	fs.StringVar(&s.ElasticReclaimStrategy, "elastic-reclaim-strategy", conf.ElasticReclaimStrategyEvict,
+		fmt.Sprintf("The way reclaim treats elastic jobs. %s considers them like any other victims, %s downscales them to their minAvailable before evicting any job",
+			conf.ElasticReclaimStrategyEvict, conf.ElasticReclaimStrategyDownscaleFirst))
 	fs.IntVar(&s.NumOfStatusRecordingWorkers, "num-of-status-recording-workers", defaultNumOfStatusRecordingWorkers, "specifies the max number of go routines spawned to update pod and podgroups conditions and events. Defaults to 5")
+	fs.DurationVar(&s.ReleasingHorizon, "releasing-horizon", 0, "Pipeline pods in the allocate action only on resources of terminating pods that are expected to be released within this duration, based on their grace period. 0 pipelines pods on all the resources of terminating pods")
+	fs.BoolVar(&s.StatsEndpoint, "stats-endpoint", false, "Serve a JSON snapshot of the queues, node pool, actions, top pending jobs and recent evictions of the last scheduling cycle on the /stats path of the plugin server port")
 	fs.DurationVar(&s.GlobalDefaultStalenessGracePeriod, "default-staleness-grace-period", defaultStalenessGracePeriod, "Global default staleness grace period duration. Negative values means infinite. Defaults to 60s")
 	fs.IntVar(&s.PluginServerPort, "plugin-server-port", 8081, "The port to bind for plugin server requests")
 	fs.StringVar(&s.CPUWorkerNodeLabelKey, "cpu-worker-node-label-key", constants.DefaultCPUWorkerNodeLabelKey, "The label key for CPU worker nodes")
 	fs.StringVar(&s.GPUWorkerNodeLabelKey, "gpu-worker-node-label-key", constants.DefaultGPUWorkerNodeLabelKey, "The label key for GPU worker nodes")
 	fs.StringVar(&s.MIGWorkerNodeLabelKey, "mig-worker-node-label-key", constants.DefaultMIGWorkerNodeLabelKey, "The label key for MIG enabled worker nodes")
+	fs.StringSliceVar(&s.AcceleratorResourceNames, "accelerator-resource-names", resources.DefaultAcceleratorResourceNames(), "The accelerator resources that are accounted and shared as GPUs")
 
 	utilfeature.DefaultMutableFeatureGate.AddFlag(fs)
 }
@@ -135,5 +156,11 @@ func (so *ServerOption) ValidateOptions() error {
 	pflag.VisitAll(func(flag *pflag.Flag) {
 		log.InfraLogger.V(1).Infof("FLAG: --%s=%q", flag.Name, flag.Value)
 	})
+
+	if so.ElasticReclaimStrategy != conf.ElasticReclaimStrategyEvict &&
+		so.ElasticReclaimStrategy != conf.ElasticReclaimStrategyDownscaleFirst {
+		return fmt.Errorf("invalid elastic reclaim strategy %q, must be %s or %s", so.ElasticReclaimStrategy,
+			conf.ElasticReclaimStrategyEvict, conf.ElasticReclaimStrategyDownscaleFirst)
+	}
 	return nil
 }
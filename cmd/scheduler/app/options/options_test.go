@@ -25,6 +25,8 @@ import (
 	"time"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
 
 	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/util/diff"
@@ -50,6 +52,7 @@ func TestAddFlags(t *testing.T) {
 		MetricsNamespace:                  constants.DefaultMetricsNamespace,
 		ResourceReservationAppLabel:       constants.DefaultResourceReservationName,
 		SchedulePeriod:                    5 * time.Minute,
+		GracefulShutdownTimeout:           defaultGracefulShutdownTimeout,
 		PrintVersion:                      true,
 		ListenAddress:                     defaultListenAddress,
 		ProfilerApiPort:                   defaultProfilerApiPort,
@@ -62,6 +65,7 @@ func TestAddFlags(t *testing.T) {
 		UpdatePodEvictionCondition:        false,
 		UseSchedulingSignatures:           true,
 		AllowConsolidatingReclaim:         true,
+		ElasticReclaimStrategy:            conf.ElasticReclaimStrategyEvict,
 		PyroscopeBlockProfilerRate:        DefaultPyroscopeBlockProfilerRate,
 		PyroscopeMutexProfilerRate:        DefaultPyroscopeMutexProfilerRate,
 		GlobalDefaultStalenessGracePeriod: defaultStalenessGracePeriod,
@@ -72,6 +76,7 @@ func TestAddFlags(t *testing.T) {
 		CPUWorkerNodeLabelKey:             constants.DefaultCPUWorkerNodeLabelKey,
 		GPUWorkerNodeLabelKey:             constants.DefaultGPUWorkerNodeLabelKey,
 		MIGWorkerNodeLabelKey:             constants.DefaultMIGWorkerNodeLabelKey,
+		AcceleratorResourceNames:          resources.DefaultAcceleratorResourceNames(),
 	}
 
 	if !reflect.DeepEqual(expected, s) {
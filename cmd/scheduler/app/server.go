@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"os/signal"
 	"syscall"
 	"time"
 
@@ -46,6 +47,8 @@ import (
 
 	"github.com/NVIDIA/KAI-scheduler/cmd/scheduler/app/options"
 	"github.com/NVIDIA/KAI-scheduler/cmd/scheduler/profiling"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/tracing"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
@@ -93,6 +96,10 @@ func BuildSchedulerParams(opt *options.ServerOption) *conf.SchedulerParams {
 		DetailedFitErrors:                 opt.DetailedFitErrors,
 		UpdatePodEvictionCondition:        opt.UpdatePodEvictionCondition,
 		QueueLabelKey:                     opt.QueueLabelKey,
+		ElasticReclaimStrategy:            opt.ElasticReclaimStrategy,
+		DryRun:                            opt.DryRun,
+		ReleasingHorizon:                  opt.ReleasingHorizon,
+		StatsEndpoint:                     opt.StatsEndpoint,
 	}
 }
 
@@ -115,6 +122,12 @@ func RunApp() error {
 	} else {
 		defer flushLogs()
 	}
+	shutdownTracing, err := tracing.Setup(context.Background(), so.SchedulerName, so.OTLPEndpoint)
+	if err != nil {
+		log.InfraLogger.Errorf("Failed to initialize tracing, continuing without it: %v", err)
+	} else {
+		defer shutdownTracing(context.Background())
+	}
 	setConfig(so)
 
 	config := clientconfig.GetConfigOrDie()
@@ -153,6 +166,7 @@ func setConfig(so *options.ServerOption) {
 	config.CPUWorkerNodeLabelKey = so.CPUWorkerNodeLabelKey
 	config.GPUWorkerNodeLabelKey = so.GPUWorkerNodeLabelKey
 	config.MIGWorkerNodeLabelKey = so.MIGWorkerNodeLabelKey
+	resources.SetAcceleratorResourceNames(so.AcceleratorResourceNames)
 }
 
 // +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
@@ -187,14 +201,23 @@ func Run(opt *options.ServerOption, config *restclient.Config, mux *http.ServeMu
 		glog.Fatalf("Prometheus Http Server failed %s", http.ListenAndServe(opt.ListenAddress, nil))
 	}()
 
+	// On SIGTERM, stop starting new scheduling cycles and let the running one finish committing its bind
+	// requests, so that upgrades don't leave gangs partially bound
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+
 	run := func(ctx context.Context) {
 		scheduler.Run(ctx.Done())
 		<-ctx.Done()
 	}
+	shutdown := func() error {
+		log.InfraLogger.V(1).Infof("Shutting down scheduler")
+		return scheduler.Shutdown(opt.GracefulShutdownTimeout)
+	}
 
 	if !opt.EnableLeaderElection {
-		run(context.TODO())
-		return fmt.Errorf("finished without leader elect")
+		run(ctx)
+		return shutdown()
 	}
 
 	leaderElectionClient, err := clientset.NewForConfig(restclient.AddUserAgent(config, "leader-election"))
@@ -231,7 +254,9 @@ func Run(opt *options.ServerOption, config *restclient.Config, mux *http.ServeMu
 		return fmt.Errorf("couldn't create resource lock: %v", err)
 	}
 
-	leaderelection.RunOrDie(context.TODO(), leaderelection.LeaderElectionConfig{
+	// The lease is not released on shutdown, so no other replica starts scheduling before the running cycle
+	// finished committing its statements
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
 		Lock:          rl,
 		LeaseDuration: leaseDuration,
 		RenewDeadline: renewDeadline,
@@ -239,9 +264,14 @@ func Run(opt *options.ServerOption, config *restclient.Config, mux *http.ServeMu
 		Callbacks: leaderelection.LeaderCallbacks{
 			OnStartedLeading: run,
 			OnStoppedLeading: func() {
-				glog.Fatalf("leaderelection lost")
+				if ctx.Err() == nil {
+					glog.Fatalf("leaderelection lost")
+				}
 			},
 		},
 	})
-	return fmt.Errorf("lost lease")
+	if ctx.Err() == nil {
+		return fmt.Errorf("lost lease")
+	}
+	return shutdown()
 }
@@ -102,7 +102,8 @@ func main() {
 	}
 
 	ssn, err := framework.OpenSession(
-		schedulerCache, snapshot.Config, snapshot.SchedulerParams, "", &http.ServeMux{},
+		context.Background(), schedulerCache, snapshot.Config, snapshot.SchedulerParams, "", &http.ServeMux{},
+		framework.SessionOptions{},
 	)
 	if err != nil {
 		log.InfraLogger.Fatalf(err.Error(), err)
@@ -228,6 +229,13 @@ func loadClientsWithSnapshot(rawObjects *snapshot.RawKubernetesObjects) (*fake.C
 		}
 	}
 
+	for _, csiNode := range rawObjects.CSINodes {
+		_, err := kubeClient.StorageV1().CSINodes().Create(context.TODO(), csiNode, v1.CreateOptions{})
+		if err != nil {
+			log.InfraLogger.Errorf("Failed to create CSI node: %v", err)
+		}
+	}
+
 	for _, topology := range rawObjects.Topologies {
 		_, err := kaiClient.KaiV1alpha1().Topologies().Create(context.TODO(), topology, v1.CreateOptions{})
 		if err != nil {
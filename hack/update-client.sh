@@ -21,6 +21,7 @@ source ${CODEGEN_PKG}/kube_codegen.sh
 kube::codegen::gen_client \
   --boilerplate ${SDK_HACK_DIR}/boilerplate.go.kb.txt \
   --with-watch \
+  --with-applyconfig \
   --output-dir ${SDK_HACK_DIR}/../pkg/apis/client \
   --output-pkg github.com/NVIDIA/KAI-scheduler/pkg/apis/client \
   ${SDK_HACK_DIR}/../pkg/apis
@@ -28,6 +29,7 @@ kube::codegen::gen_client \
 rm -f generate-dep.go && go mod tidy
 
 changed_files=$(git diff --name-only | grep pkg/apis/client | grep v1alpha2)
+new_files=$(git ls-files --others --exclude-standard pkg/apis/client)
 ${SDK_HACK_DIR}/replace_headers.sh \
   ${SDK_HACK_DIR}/boilerplate.go.txt \
-  ${changed_files}
+  ${changed_files} ${new_files}
@@ -6,6 +6,7 @@ package plugins
 import (
 	"context"
 
+	authenticationv1 "k8s.io/api/authentication/v1"
 	v1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -16,6 +17,18 @@ type Plugin interface {
 	Mutate(*v1.Pod) error
 }
 
+// CreateValidator is implemented by plugins that validate pods only when they are created. Warnings are returned to
+// the user without rejecting the pod.
+type CreateValidator interface {
+	ValidateCreate(*v1.Pod) (warnings []string, err error)
+}
+
+// UserCreateValidator is implemented by plugins that validate pods when they are created, depending on the user that
+// creates them.
+type UserCreateValidator interface {
+	ValidateCreateByUser(pod *v1.Pod, user authenticationv1.UserInfo) (warnings []string, err error)
+}
+
 type KaiAdmissionPlugins struct {
 	plugins []Plugin
 }
@@ -45,6 +58,34 @@ func (bp *KaiAdmissionPlugins) Validate(pod *v1.Pod) error {
 	return nil
 }
 
+func (bp *KaiAdmissionPlugins) ValidateCreate(pod *v1.Pod, user authenticationv1.UserInfo) ([]string, error) {
+	if err := bp.Validate(pod); err != nil {
+		return nil, err
+	}
+
+	var warnings []string
+	for _, p := range bp.plugins {
+		var pluginWarnings []string
+		var err error
+		switch createValidator := p.(type) {
+		case CreateValidator:
+			pluginWarnings, err = createValidator.ValidateCreate(pod)
+		case UserCreateValidator:
+			pluginWarnings, err = createValidator.ValidateCreateByUser(pod, user)
+		default:
+			continue
+		}
+		if err != nil {
+			logger := log.FromContext(context.Background())
+			logger.Error(err, "pod validation failed for pod",
+				"namespace", pod.Namespace, "name", pod.Name, "plugin", p.Name())
+			return warnings, err
+		}
+		warnings = append(warnings, pluginWarnings...)
+	}
+	return warnings, nil
+}
+
 func (bp *KaiAdmissionPlugins) Mutate(pod *v1.Pod) error {
 	for _, p := range bp.plugins {
 		err := p.Mutate(pod)
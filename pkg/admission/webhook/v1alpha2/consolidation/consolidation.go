@@ -0,0 +1,91 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package consolidation
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+// Consolidation rejects pods that opt out of consolidation, when their queue (or its closest ancestor that sets
+// consolidation settings) doesn't allow opting out.
+type Consolidation struct {
+	kubeClient client.Client
+}
+
+func New(kubeClient client.Client) *Consolidation {
+	return &Consolidation{
+		kubeClient: kubeClient,
+	}
+}
+
+func (c *Consolidation) Name() string {
+	return "consolidation"
+}
+
+func (c *Consolidation) Validate(pod *v1.Pod) error {
+	return nil
+}
+
+func (c *Consolidation) Mutate(pod *v1.Pod) error {
+	return nil
+}
+
+// +kubebuilder:rbac:groups=scheduling.run.ai,resources=queues,verbs=get;list;watch
+
+func (c *Consolidation) ValidateCreate(pod *v1.Pod) ([]string, error) {
+	value, found := pod.Annotations[constants.DoNotConsolidate]
+	if !found {
+		return nil, nil
+	}
+	doNotConsolidate, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %q of annotation %s: %w", value, constants.DoNotConsolidate, err)
+	}
+	if !doNotConsolidate {
+		return nil, nil
+	}
+
+	queueName := pod.Labels[constants.DefaultQueueLabel]
+	queueConsolidation, err := c.getQueueConsolidation(context.Background(), queueName)
+	if err != nil {
+		return nil, err
+	}
+	if queueConsolidation == nil || queueConsolidation.IsOptOutAllowed() {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("queue %s does not allow workloads to opt out of consolidation", queueName)
+}
+
+// getQueueConsolidation returns the consolidation settings of the queue or of its closest ancestor that sets them
+func (c *Consolidation) getQueueConsolidation(
+	ctx context.Context, queueName string,
+) (*v2.QueueConsolidation, error) {
+	visited := map[string]bool{}
+	for queueName != "" && !visited[queueName] {
+		visited[queueName] = true
+		queue := &v2.Queue{}
+		err := c.kubeClient.Get(ctx, types.NamespacedName{Name: queueName}, queue)
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get queue %s: %w", queueName, err)
+		}
+		if queue.Spec.Consolidation != nil {
+			return queue.Spec.Consolidation, nil
+		}
+		queueName = queue.Spec.ParentQueue
+	}
+	return nil, nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package consolidation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+func TestValidateCreate(t *testing.T) {
+	department := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "department"},
+		Spec: v2.QueueSpec{
+			Consolidation: &v2.QueueConsolidation{AllowOptOut: ptr.To(false)},
+		},
+	}
+	team := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "team"},
+		Spec:       v2.QueueSpec{ParentQueue: "department"},
+	}
+	stateful := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "stateful"},
+		Spec: v2.QueueSpec{
+			ParentQueue:   "department",
+			Consolidation: &v2.QueueConsolidation{AllowOptOut: ptr.To(true)},
+		},
+	}
+	open := &v2.Queue{ObjectMeta: metav1.ObjectMeta{Name: "open"}}
+	objects := []client.Object{department, team, stateful, open}
+
+	tests := []struct {
+		name             string
+		queue            string
+		doNotConsolidate string
+		expectError      bool
+	}{
+		{name: "pod without annotation", queue: "department"},
+		{name: "opt out not allowed", queue: "department", doNotConsolidate: "true", expectError: true},
+		{name: "opt out not allowed by parent queue", queue: "team", doNotConsolidate: "true", expectError: true},
+		{name: "opt out allowed by queue", queue: "stateful", doNotConsolidate: "true"},
+		{name: "queue without consolidation settings", queue: "open", doNotConsolidate: "true"},
+		{name: "missing queue", queue: "missing", doNotConsolidate: "true"},
+		{name: "annotation set to false", queue: "department", doNotConsolidate: "false"},
+		{name: "invalid annotation value", queue: "open", doNotConsolidate: "always", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(objects...).Build()
+			plugin := New(kubeClient)
+
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:      "pod",
+				Namespace: "ns",
+				Labels:    map[string]string{constants.DefaultQueueLabel: tt.queue},
+			}}
+			if tt.doNotConsolidate != "" {
+				pod.Annotations = map[string]string{constants.DoNotConsolidate: tt.doNotConsolidate}
+			}
+
+			_, err := plugin.ValidateCreate(pod)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(v2.AddToScheme(scheme))
+	return scheme
+}
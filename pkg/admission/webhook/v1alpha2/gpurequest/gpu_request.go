@@ -0,0 +1,115 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gpurequest
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+const mib = 1024 * 1024
+
+var logger = logf.Log.WithName("gpu-request")
+
+// sharingAnnotations are the pod annotations a GpuRequest is translated to
+var sharingAnnotations = []string{constants.GpuFraction, constants.GpuMemory, constants.GpuFractionsNumDevices}
+
+// GpuRequest applies the GpuRequest referenced by a pod to the pod, by translating it to the GPU sharing
+// annotations that the rest of the scheduler reads.
+type GpuRequest struct {
+	kubeClient client.Client
+}
+
+func New(kubeClient client.Client) *GpuRequest {
+	return &GpuRequest{
+		kubeClient: kubeClient,
+	}
+}
+
+func (p *GpuRequest) Name() string {
+	return "gpurequest"
+}
+
+func (p *GpuRequest) Validate(pod *v1.Pod) error {
+	return nil
+}
+
+// +kubebuilder:rbac:groups=kai.scheduler,resources=gpurequests,verbs=get;list;watch
+
+func (p *GpuRequest) Mutate(pod *v1.Pod) error {
+	name, found := pod.Annotations[constants.GpuRequestAnnotation]
+	if !found {
+		return nil
+	}
+	if name == "" {
+		return fmt.Errorf("the %s annotation of pod %s/%s is empty", constants.GpuRequestAnnotation,
+			pod.Namespace, pod.Name)
+	}
+	for _, annotation := range sharingAnnotations {
+		if _, found := pod.Annotations[annotation]; found {
+			return fmt.Errorf("pod %s/%s references GpuRequest %s and has the %s annotation, only one of them can be set",
+				pod.Namespace, pod.Name, name, annotation)
+		}
+	}
+
+	gpuRequest := &kaiv1alpha1.GpuRequest{}
+	err := p.kubeClient.Get(context.Background(), types.NamespacedName{Namespace: pod.Namespace, Name: name}, gpuRequest)
+	if errors.IsNotFound(err) {
+		return fmt.Errorf("GpuRequest %s referenced by pod %s/%s was not found", name, pod.Namespace, pod.Name)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get GpuRequest %s/%s: %w", pod.Namespace, name, err)
+	}
+
+	annotations, err := toAnnotations(&gpuRequest.Spec)
+	if err != nil {
+		return fmt.Errorf("invalid GpuRequest %s/%s: %w", pod.Namespace, name, err)
+	}
+	for key, value := range annotations {
+		pod.Annotations[key] = value
+	}
+	logger.V(1).Info("applied gpu request to pod", "namespace", pod.Namespace, "name", pod.Name,
+		"gpuRequest", name)
+	return nil
+}
+
+// toAnnotations translates a GpuRequest to the GPU sharing annotations of a pod
+func toAnnotations(spec *kaiv1alpha1.GpuRequestSpec) (map[string]string, error) {
+	if (spec.Fraction == nil) == (spec.Memory == nil) {
+		return nil, fmt.Errorf("exactly one of fraction or memory must be set")
+	}
+
+	annotations := map[string]string{}
+	if spec.Fraction != nil {
+		fraction, err := strconv.ParseFloat(*spec.Fraction, 64)
+		if err != nil || fraction <= 0 || fraction >= 1 {
+			return nil, fmt.Errorf("fraction must be a number between 0 and 1 exclusive, got %q", *spec.Fraction)
+		}
+		annotations[constants.GpuFraction] = *spec.Fraction
+	}
+	if spec.Memory != nil {
+		if spec.Memory.Sign() <= 0 {
+			return nil, fmt.Errorf("memory must be positive, got %s", spec.Memory.String())
+		}
+		memoryMiB := (spec.Memory.Value() + mib - 1) / mib
+		annotations[constants.GpuMemory] = strconv.FormatInt(memoryMiB, 10)
+	}
+	if spec.Devices != nil {
+		if *spec.Devices < 1 {
+			return nil, fmt.Errorf("devices must be at least 1, got %d", *spec.Devices)
+		}
+		annotations[constants.GpuFractionsNumDevices] = strconv.Itoa(int(*spec.Devices))
+	}
+	return annotations, nil
+}
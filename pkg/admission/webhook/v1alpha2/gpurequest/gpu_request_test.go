@@ -0,0 +1,159 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gpurequest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+func TestMutate(t *testing.T) {
+	halfGpu := newGpuRequest("half", kaiv1alpha1.GpuRequestSpec{Fraction: ptr.To("0.5")})
+	memoryOnTwoGpus := newGpuRequest("memory", kaiv1alpha1.GpuRequestSpec{
+		Memory:  ptr.To(resource.MustParse("4Gi")),
+		Devices: ptr.To(int32(2)),
+	})
+	unroundedMemory := newGpuRequest("unrounded", kaiv1alpha1.GpuRequestSpec{
+		Memory: ptr.To(resource.MustParse("1500000")),
+	})
+	invalidFraction := newGpuRequest("invalid", kaiv1alpha1.GpuRequestSpec{Fraction: ptr.To("1.5")})
+
+	tests := []struct {
+		name                string
+		annotations         map[string]string
+		objects             []client.Object
+		expectedAnnotations map[string]string
+		expectErr           bool
+	}{
+		{
+			name:                "pod without gpu request",
+			annotations:         map[string]string{constants.GpuFraction: "0.3"},
+			objects:             []client.Object{halfGpu},
+			expectedAnnotations: map[string]string{constants.GpuFraction: "0.3"},
+		},
+		{
+			name:        "fraction",
+			annotations: map[string]string{constants.GpuRequestAnnotation: "half"},
+			objects:     []client.Object{halfGpu},
+			expectedAnnotations: map[string]string{
+				constants.GpuRequestAnnotation: "half",
+				constants.GpuFraction:          "0.5",
+			},
+		},
+		{
+			name:        "memory on multiple devices",
+			annotations: map[string]string{constants.GpuRequestAnnotation: "memory"},
+			objects:     []client.Object{memoryOnTwoGpus},
+			expectedAnnotations: map[string]string{
+				constants.GpuRequestAnnotation:   "memory",
+				constants.GpuMemory:              "4096",
+				constants.GpuFractionsNumDevices: "2",
+			},
+		},
+		{
+			name:        "memory is rounded up to MiB",
+			annotations: map[string]string{constants.GpuRequestAnnotation: "unrounded"},
+			objects:     []client.Object{unroundedMemory},
+			expectedAnnotations: map[string]string{
+				constants.GpuRequestAnnotation: "unrounded",
+				constants.GpuMemory:            "2",
+			},
+		},
+		{
+			name:        "gpu request not found",
+			annotations: map[string]string{constants.GpuRequestAnnotation: "missing"},
+			objects:     []client.Object{halfGpu},
+			expectErr:   true,
+		},
+		{
+			name: "gpu request together with a sharing annotation",
+			annotations: map[string]string{
+				constants.GpuRequestAnnotation: "half",
+				constants.GpuMemory:            "1000",
+			},
+			objects:   []client.Object{halfGpu},
+			expectErr: true,
+		},
+		{
+			name:        "invalid gpu request",
+			annotations: map[string]string{constants.GpuRequestAnnotation: "invalid"},
+			objects:     []client.Object{invalidFraction},
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(tt.objects...).Build()
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns", Annotations: tt.annotations},
+			}
+
+			err := New(kubeClient).Mutate(pod)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedAnnotations, pod.Annotations)
+		})
+	}
+}
+
+func TestToAnnotations(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      kaiv1alpha1.GpuRequestSpec
+		expectErr bool
+	}{
+		{name: "fraction", spec: kaiv1alpha1.GpuRequestSpec{Fraction: ptr.To("0.25")}},
+		{name: "neither fraction nor memory", spec: kaiv1alpha1.GpuRequestSpec{Devices: ptr.To(int32(2))},
+			expectErr: true},
+		{name: "both fraction and memory", spec: kaiv1alpha1.GpuRequestSpec{
+			Fraction: ptr.To("0.25"), Memory: ptr.To(resource.MustParse("1Gi"))}, expectErr: true},
+		{name: "zero fraction", spec: kaiv1alpha1.GpuRequestSpec{Fraction: ptr.To("0")}, expectErr: true},
+		{name: "zero memory", spec: kaiv1alpha1.GpuRequestSpec{Memory: ptr.To(resource.MustParse("0"))},
+			expectErr: true},
+		{name: "zero devices", spec: kaiv1alpha1.GpuRequestSpec{
+			Fraction: ptr.To("0.25"), Devices: ptr.To(int32(0))}, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := toAnnotations(&tt.spec)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func newGpuRequest(name string, spec kaiv1alpha1.GpuRequestSpec) *kaiv1alpha1.GpuRequest {
+	return &kaiv1alpha1.GpuRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+		Spec:       spec,
+	}
+}
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(kaiv1alpha1.AddToScheme(scheme))
+	return scheme
+}
@@ -44,6 +44,12 @@ func (p *GPUSharing) Validate(pod *v1.Pod) error {
 			pod.Namespace, pod.Name,
 		)
 	}
+	if !p.gpuSharingEnabled && resources.RequestsGPUCountRange(pod) {
+		return fmt.Errorf(
+			"attempting to create a pod %s/%s with gpu count range request, while GPU sharing is disabled",
+			pod.Namespace, pod.Name,
+		)
+	}
 	return gpurequesthandler.ValidateGpuRequests(pod)
 }
 
@@ -52,7 +58,8 @@ func (p *GPUSharing) Mutate(pod *v1.Pod) error {
 		return nil
 	}
 
-	if !resources.RequestsGPUFraction(pod) {
+	// GPUs of pods requesting a range of GPUs are exposed to the pod the same way as shared GPUs
+	if !resources.RequestsGPUFraction(pod) && !resources.RequestsGPUCountRange(pod) {
 		return nil
 	}
 
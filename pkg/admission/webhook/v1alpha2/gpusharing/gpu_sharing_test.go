@@ -160,6 +160,55 @@ func TestValidate(t *testing.T) {
 			GPUSharingEnabled: true,
 			error:             nil,
 		},
+		{
+			name: "GPU sharing disabled, GPU count range pod",
+			pod: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-pod",
+					Namespace: "test-namespace",
+					Annotations: map[string]string{
+						constants.GpuCountMin: "4",
+						constants.GpuCountMax: "8",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Resources: v1.ResourceRequirements{
+								Limits: v1.ResourceList{},
+							},
+						},
+					},
+				},
+			},
+			GPUSharingEnabled: false,
+			error: fmt.Errorf("attempting to create a pod test-namespace/test-pod with gpu " +
+				"count range request, while GPU sharing is disabled"),
+		},
+		{
+			name: "GPU sharing enabled, GPU count range pod",
+			pod: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-pod",
+					Namespace: "test-namespace",
+					Annotations: map[string]string{
+						constants.GpuCountMin: "4",
+						constants.GpuCountMax: "8",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Resources: v1.ResourceRequirements{
+								Limits: v1.ResourceList{},
+							},
+						},
+					},
+				},
+			},
+			GPUSharingEnabled: true,
+			error:             nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,173 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package maxgpusperpod
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	resourcehelper "k8s.io/component-helpers/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
+)
+
+// OverrideVerb is the verb on a queue that allows a user to create pods exceeding its maximum GPUs per pod with the
+// override annotation
+const OverrideVerb = "override-max-gpus-per-pod"
+
+// MaxGPUsPerPod rejects pods that request more GPUs than the maximum GPUs per pod of their queue or of its ancestors,
+// unless the user that creates them is allowed to override the limit.
+type MaxGPUsPerPod struct {
+	kubeClient client.Client
+}
+
+func New(kubeClient client.Client) *MaxGPUsPerPod {
+	return &MaxGPUsPerPod{
+		kubeClient: kubeClient,
+	}
+}
+
+func (p *MaxGPUsPerPod) Name() string {
+	return "maxgpusperpod"
+}
+
+func (p *MaxGPUsPerPod) Validate(pod *v1.Pod) error {
+	return nil
+}
+
+func (p *MaxGPUsPerPod) Mutate(pod *v1.Pod) error {
+	return nil
+}
+
+// +kubebuilder:rbac:groups=scheduling.run.ai,resources=queues,verbs=get;list;watch
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+func (p *MaxGPUsPerPod) ValidateCreateByUser(pod *v1.Pod, user authenticationv1.UserInfo) ([]string, error) {
+	if !resources.RequestsGPU(pod) {
+		return nil, nil
+	}
+
+	ctx := context.Background()
+	limitingQueue, maxGPUs, err := p.getMaxGPUsPerPod(ctx, pod.Labels[constants.DefaultQueueLabel])
+	if err != nil || limitingQueue == "" {
+		return nil, err
+	}
+	gpus, err := podGPUs(pod)
+	if err != nil {
+		return nil, err
+	}
+	if gpus <= maxGPUs {
+		return nil, nil
+	}
+
+	message := fmt.Sprintf("the pod %s/%s requests %v GPUs, more than the maximum of %v GPUs per pod of queue %s",
+		pod.Namespace, pod.Name, gpus, maxGPUs, limitingQueue)
+	value, found := pod.Annotations[constants.MaxGPUsPerPodOverride]
+	if !found {
+		return nil, fmt.Errorf("%s", message)
+	}
+	override, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %q of annotation %s: %w", value, constants.MaxGPUsPerPodOverride, err)
+	}
+	if !override {
+		return nil, fmt.Errorf("%s", message)
+	}
+
+	allowed, err := p.canOverride(ctx, user, limitingQueue)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, fmt.Errorf("%s, and user %s is not allowed to %s of queue %s", message, user.Username,
+			OverrideVerb, limitingQueue)
+	}
+	return []string{fmt.Sprintf("%s, admitted with the %s annotation", message, constants.MaxGPUsPerPodOverride)},
+		nil
+}
+
+// getMaxGPUsPerPod returns the smallest maximum GPUs per pod of the queue and of its ancestors, and the queue that sets
+// it. The returned queue is empty if none of them sets a maximum.
+func (p *MaxGPUsPerPod) getMaxGPUsPerPod(ctx context.Context, queueName string) (string, float64, error) {
+	limitingQueue := ""
+	maxGPUs := 0.0
+	visited := map[string]bool{}
+	for queueName != "" && !visited[queueName] {
+		visited[queueName] = true
+		queue := &v2.Queue{}
+		err := p.kubeClient.Get(ctx, types.NamespacedName{Name: queueName}, queue)
+		if errors.IsNotFound(err) {
+			break
+		}
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to get queue %s: %w", queueName, err)
+		}
+		if queue.Spec.MaxGPUsPerPod != nil && (limitingQueue == "" || *queue.Spec.MaxGPUsPerPod < maxGPUs) {
+			limitingQueue = queue.Name
+			maxGPUs = *queue.Spec.MaxGPUsPerPod
+		}
+		queueName = queue.Spec.ParentQueue
+	}
+	return limitingQueue, maxGPUs, nil
+}
+
+// canOverride checks with a SubjectAccessReview whether the user may override the maximum GPUs per pod of the queue
+func (p *MaxGPUsPerPod) canOverride(ctx context.Context, user authenticationv1.UserInfo, queueName string) (
+	bool, error) {
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for key, value := range user.Extra {
+		extra[key] = authorizationv1.ExtraValue(value)
+	}
+	review := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			Groups: user.Groups,
+			UID:    user.UID,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:    v2.GroupVersion.Group,
+				Resource: "queues",
+				Verb:     OverrideVerb,
+				Name:     queueName,
+			},
+		},
+	}
+	if err := p.kubeClient.Create(ctx, review); err != nil {
+		return false, fmt.Errorf("failed to review access of user %s to queue %s: %w", user.Username, queueName, err)
+	}
+	return review.Status.Allowed, nil
+}
+
+// podGPUs returns the number of GPUs the pod requests. A pod requesting a range of GPUs is counted with its maximum,
+// and a pod requesting GPU memory is counted with a whole GPU per device, as the portion of a device it gets depends
+// on the node.
+func podGPUs(pod *v1.Pod) (float64, error) {
+	if resources.RequestsGPUCountRange(pod) {
+		_, maxCount, err := resources.GetGPUCountRange(pod)
+		return float64(maxCount), err
+	}
+	if resources.RequestsGPUFraction(pod) {
+		numDevices, err := resources.GetNumGPUFractionDevices(pod)
+		if err != nil {
+			return 0, err
+		}
+		if _, found := pod.Annotations[constants.GpuFraction]; !found {
+			return float64(numDevices), nil
+		}
+		fraction, err := resources.GetGPUFraction(pod)
+		return fraction * float64(numDevices), err
+	}
+	requests := resourcehelper.PodRequests(pod, resourcehelper.PodResourcesOptions{})
+	gpus := resources.AcceleratorQuantity(requests)
+	return gpus.AsApproximateFloat64(), nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package maxgpusperpod
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+const adminUser = "admin"
+
+func TestValidateCreateByUser(t *testing.T) {
+	department := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "department"},
+		Spec:       v2.QueueSpec{MaxGPUsPerPod: ptr.To(8.0)},
+	}
+	interactive := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "interactive"},
+		Spec:       v2.QueueSpec{ParentQueue: "department", MaxGPUsPerPod: ptr.To(1.0)},
+	}
+	training := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "training"},
+		Spec:       v2.QueueSpec{ParentQueue: "department"},
+	}
+	relaxed := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "relaxed"},
+		Spec:       v2.QueueSpec{ParentQueue: "department", MaxGPUsPerPod: ptr.To(16.0)},
+	}
+	open := &v2.Queue{ObjectMeta: metav1.ObjectMeta{Name: "open"}}
+	objects := []client.Object{department, interactive, training, relaxed, open}
+
+	tests := []struct {
+		name          string
+		queue         string
+		gpus          int64
+		annotations   map[string]string
+		user          string
+		expectError   bool
+		expectWarning bool
+	}{
+		{name: "pod within the limit", queue: "interactive", gpus: 1},
+		{name: "pod exceeding the limit", queue: "interactive", gpus: 2, expectError: true},
+		{name: "pod exceeding the limit of the parent queue", queue: "training", gpus: 9, expectError: true},
+		{name: "smallest limit of the ancestors applies", queue: "relaxed", gpus: 9, expectError: true},
+		{name: "queue without a limit", queue: "open", gpus: 64},
+		{name: "missing queue", queue: "missing", gpus: 64},
+		{name: "pod without GPUs", queue: "interactive"},
+		{
+			name: "fraction within the limit", queue: "interactive",
+			annotations: map[string]string{constants.GpuFraction: "0.5"},
+		},
+		{
+			name: "fractions over several devices exceeding the limit", queue: "interactive",
+			annotations: map[string]string{constants.GpuFraction: "0.5", constants.GpuFractionsNumDevices: "4"},
+			expectError: true,
+		},
+		{
+			name: "GPU memory counted as a whole GPU per device", queue: "interactive",
+			annotations: map[string]string{constants.GpuMemory: "2000", constants.GpuFractionsNumDevices: "2"},
+			expectError: true,
+		},
+		{
+			name: "GPU count range counted with its maximum", queue: "interactive",
+			annotations: map[string]string{constants.GpuCountMin: "1", constants.GpuCountMax: "2"},
+			expectError: true,
+		},
+		{
+			name: "override by an allowed user", queue: "interactive", gpus: 2, user: adminUser,
+			annotations:   map[string]string{constants.MaxGPUsPerPodOverride: "true"},
+			expectWarning: true,
+		},
+		{
+			name: "override by a user that isn't allowed", queue: "interactive", gpus: 2, user: "developer",
+			annotations: map[string]string{constants.MaxGPUsPerPodOverride: "true"},
+			expectError: true,
+		},
+		{
+			name: "override set to false", queue: "interactive", gpus: 2, user: adminUser,
+			annotations: map[string]string{constants.MaxGPUsPerPodOverride: "false"},
+			expectError: true,
+		},
+		{
+			name: "invalid override value", queue: "interactive", gpus: 2, user: adminUser,
+			annotations: map[string]string{constants.MaxGPUsPerPodOverride: "always"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := newFakeClient(objects...)
+			plugin := New(kubeClient)
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "pod",
+					Namespace:   "team",
+					Labels:      map[string]string{constants.DefaultQueueLabel: tt.queue},
+					Annotations: tt.annotations,
+				},
+				Spec: v1.PodSpec{Containers: []v1.Container{{Name: "main"}}},
+			}
+			if tt.gpus > 0 {
+				pod.Spec.Containers[0].Resources.Requests = v1.ResourceList{
+					constants.GpuResource: *resource.NewQuantity(tt.gpus, resource.DecimalSI),
+				}
+			}
+
+			warnings, err := plugin.ValidateCreateByUser(pod, authenticationv1.UserInfo{Username: tt.user})
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectWarning, len(warnings) > 0)
+		})
+	}
+}
+
+// newFakeClient returns a client that allows only the admin user to override the maximum GPUs per pod of the
+// interactive queue
+func newFakeClient(objects ...client.Object) client.Client {
+	testScheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(testScheme))
+	utilruntime.Must(v2.AddToScheme(testScheme))
+	return fake.NewClientBuilder().WithScheme(testScheme).WithObjects(objects...).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				review, ok := obj.(*authorizationv1.SubjectAccessReview)
+				if !ok {
+					return c.Create(ctx, obj, opts...)
+				}
+				attributes := review.Spec.ResourceAttributes
+				review.Status.Allowed = review.Spec.User == adminUser && attributes.Verb == OverrideVerb &&
+					attributes.Resource == "queues" && attributes.Name == "interactive"
+				return nil
+			},
+		}).Build()
+}
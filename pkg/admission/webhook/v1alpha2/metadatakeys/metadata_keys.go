@@ -0,0 +1,188 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package metadatakeys
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	podgrouperconstants "github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants/labels"
+)
+
+const (
+	kaiPrefixMarker = "kai"
+	// Keys shorter than this are matched against typos of a single character only, to avoid matching unrelated keys
+	shortKeyLength = 10
+)
+
+// deprecatedLabels are labels that the scheduler no longer reads, and the labels that replaced them
+var deprecatedLabels = map[string]string{
+	"runai/queue": constants.DefaultQueueLabel,
+}
+
+// internalKeys are set on pods by KAI components, and are never reported
+var internalKeys = []string{
+	constants.GPUGroup,
+	constants.GpuSharingConfigMapAnnotation,
+	constants.GpuCountGranted,
+	constants.ReceivedResourceType,
+	constants.MpsAnnotation,
+	constants.TopOwnerMetadataKey,
+}
+
+// MetadataKeys warns about pod labels and annotations that look like misspelled or deprecated KAI scheduler keys.
+// Misspelled keys are silently ignored by the scheduler, and could make workloads run in the wrong queue.
+type MetadataKeys struct {
+	labelKeys      []string
+	annotationKeys []string
+}
+
+func New(nodePoolLabelKey string) *MetadataKeys {
+	return &MetadataKeys{
+		labelKeys: []string{
+			constants.DefaultQueueLabel,
+			nodePoolLabelKey,
+			constants.SubGroupLabelKey,
+			podgrouperconstants.PreemptibilityLabelKey,
+			podgrouperconstants.PriorityLabelKey,
+			podgrouperconstants.WorkloadClassLabelKey,
+			labels.TaskOrderLabelKey,
+		},
+		annotationKeys: []string{
+			constants.PodGroupAnnotationForPod,
+			constants.MinMemberAnnotationForPod,
+			constants.GpuFraction,
+			constants.GpuMemory,
+			constants.GpuFractionsNumDevices,
+			constants.GpuFractionContainerName,
+			constants.GpuComputeFraction,
+			constants.GpuCountMin,
+			constants.GpuCountMax,
+			constants.GpuRequestAnnotation,
+			constants.MinGpuMemory,
+			constants.MinGpuComputeCapability,
+			constants.DedicatedNodes,
+			constants.DoNotConsolidate,
+			constants.BindTimeSecret,
+			constants.MaxGPUsPerPodOverride,
+			constants.Datasets,
+			constants.QuotaReservationTimeout,
+			podgrouperconstants.TopologyKey,
+			podgrouperconstants.TopologyRequiredPlacementKey,
+			podgrouperconstants.TopologyPreferredPlacementKey,
+		},
+	}
+}
+
+func (p *MetadataKeys) Name() string {
+	return "metadatakeys"
+}
+
+func (p *MetadataKeys) Validate(pod *v1.Pod) error {
+	return nil
+}
+
+func (p *MetadataKeys) Mutate(pod *v1.Pod) error {
+	return nil
+}
+
+func (p *MetadataKeys) ValidateCreate(pod *v1.Pod) ([]string, error) {
+	var warnings []string
+	for _, key := range sortedKeys(pod.Labels) {
+		if warning := p.checkKey(key, "label", p.labelKeys, p.annotationKeys); warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+	for _, key := range sortedKeys(pod.Annotations) {
+		if warning := p.checkKey(key, "annotation", p.annotationKeys, p.labelKeys); warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+	return warnings, nil
+}
+
+// checkKey returns a warning if the key is deprecated, belongs in the other kind of pod metadata, or is a near miss
+// of a known key of its kind
+func (p *MetadataKeys) checkKey(key, kind string, knownKeys, otherKindKeys []string) string {
+	if slices.Contains(knownKeys, key) || slices.Contains(internalKeys, key) {
+		return ""
+	}
+	if replacement, found := deprecatedLabels[key]; found && kind == "label" {
+		return fmt.Sprintf("label %s is deprecated and ignored by the scheduler, use %s instead", key, replacement)
+	}
+	if slices.Contains(otherKindKeys, key) {
+		return fmt.Sprintf("%s is a scheduler %s, and is ignored as a pod %s", key, otherKind(kind), kind)
+	}
+	for _, knownKey := range knownKeys {
+		if isNearMiss(key, knownKey) {
+			return fmt.Sprintf("%s %s is not known to the scheduler and is ignored, did you mean %s?", kind, key,
+				knownKey)
+		}
+	}
+	return ""
+}
+
+// isNearMiss returns true if the key differs from the known key by a typo, or has the name of the known key with a
+// wrong KAI prefix
+func isNearMiss(key, knownKey string) bool {
+	if levenshteinDistance(key, knownKey) <= maxTypoDistance(knownKey) {
+		return true
+	}
+
+	prefix, name, prefixed := strings.Cut(key, "/")
+	knownPrefix, knownName, knownPrefixed := strings.Cut(knownKey, "/")
+	if !prefixed || !knownPrefixed || prefix == knownPrefix {
+		return false
+	}
+	return strings.Contains(strings.ToLower(prefix), kaiPrefixMarker) &&
+		levenshteinDistance(name, knownName) <= maxTypoDistance(knownName)
+}
+
+func maxTypoDistance(knownKey string) int {
+	if len(knownKey) < shortKeyLength {
+		return 1
+	}
+	return 2
+}
+
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			substitution := previous[j-1]
+			if a[i-1] != b[j-1] {
+				substitution++
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, substitution)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func otherKind(kind string) string {
+	if kind == "label" {
+		return "annotation"
+	}
+	return "label"
+}
+
+func sortedKeys(metadata map[string]string) []string {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package metadatakeys
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateCreate(t *testing.T) {
+	tests := []struct {
+		name             string
+		labels           map[string]string
+		annotations      map[string]string
+		expectedWarnings []string
+	}{
+		{
+			name: "known keys",
+			labels: map[string]string{
+				"kai.scheduler/queue":     "team-a",
+				"kai.scheduler/node-pool": "pool-a",
+				"app":                     "trainer",
+			},
+			annotations: map[string]string{
+				"gpu-fraction":                "0.5",
+				"kai.scheduler/gpu-count-min": "2",
+			},
+		},
+		{
+			name:   "misspelled queue label",
+			labels: map[string]string{"kai.scheduler/queeu": "team-a"},
+			expectedWarnings: []string{
+				"label kai.scheduler/queeu is not known to the scheduler and is ignored, " +
+					"did you mean kai.scheduler/queue?",
+			},
+		},
+		{
+			name:   "wrong KAI prefix",
+			labels: map[string]string{"kai.scheduler.io/queue": "team-a"},
+			expectedWarnings: []string{
+				"label kai.scheduler.io/queue is not known to the scheduler and is ignored, " +
+					"did you mean kai.scheduler/queue?",
+			},
+		},
+		{
+			name:        "misspelled annotation",
+			annotations: map[string]string{"gpu-fracton": "0.5"},
+			expectedWarnings: []string{
+				"annotation gpu-fracton is not known to the scheduler and is ignored, did you mean gpu-fraction?",
+			},
+		},
+		{
+			name:        "label set as an annotation",
+			annotations: map[string]string{"kai.scheduler/queue": "team-a"},
+			expectedWarnings: []string{
+				"kai.scheduler/queue is a scheduler label, and is ignored as a pod annotation",
+			},
+		},
+		{
+			name:   "deprecated queue label",
+			labels: map[string]string{"runai/queue": "team-a"},
+			expectedWarnings: []string{
+				"label runai/queue is deprecated and ignored by the scheduler, use kai.scheduler/queue instead",
+			},
+		},
+		{
+			name:   "custom node pool label key",
+			labels: map[string]string{"example.com/pool": "pool-a"},
+		},
+		{
+			name: "unrelated keys",
+			labels: map[string]string{
+				"queue-type":                  "batch",
+				"kai.scheduler/image-prepull": "true",
+			},
+			annotations: map[string]string{"kai.scheduler/trace-id": "1234"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := New("example.com/pool")
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name:        "pod",
+				Namespace:   "ns",
+				Labels:      tt.labels,
+				Annotations: tt.annotations,
+			}}
+
+			warnings, err := plugin.ValidateCreate(pod)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedWarnings, warnings)
+		})
+	}
+}
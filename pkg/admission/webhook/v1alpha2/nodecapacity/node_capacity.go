@@ -0,0 +1,310 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package nodecapacity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	resourcehelper "k8s.io/component-helpers/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	schedulingv2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
+)
+
+type ValidationMode string
+
+const (
+	// Disabled skips the validation
+	Disabled ValidationMode = "disabled"
+	// Warn admits the pod and returns a warning to the user
+	Warn ValidationMode = "warn"
+	// Reject rejects the pod
+	Reject ValidationMode = "reject"
+
+	defaultNodePoolName = "default"
+	megabytes           = 1000 * 1000
+)
+
+var ValidationModes = []ValidationMode{Disabled, Warn, Reject}
+
+// NodeCapacity validates that a pod's requests fit in a single node of its node pool, and within the limits of its
+// queue. Such pods would otherwise pend forever with generic unschedulable events.
+type NodeCapacity struct {
+	kubeClient       client.Client
+	nodePoolLabelKey string
+	mode             ValidationMode
+}
+
+func New(kubeClient client.Client, nodePoolLabelKey string, mode ValidationMode) *NodeCapacity {
+	return &NodeCapacity{
+		kubeClient:       kubeClient,
+		nodePoolLabelKey: nodePoolLabelKey,
+		mode:             mode,
+	}
+}
+
+func (p *NodeCapacity) Name() string {
+	return "nodecapacity"
+}
+
+func (p *NodeCapacity) Validate(pod *v1.Pod) error {
+	return nil
+}
+
+func (p *NodeCapacity) Mutate(pod *v1.Pod) error {
+	return nil
+}
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=scheduling.run.ai,resources=podgroups,verbs=get;list;watch
+// +kubebuilder:rbac:groups=scheduling.run.ai,resources=queues,verbs=get;list;watch
+
+// ValidateCreate is only called for new pods, so that updates of pods that were admitted before the node pool shrank
+// are not rejected. Pods exceeding the limits of a queue that rejects exceeding limits are rejected in any mode.
+func (p *NodeCapacity) ValidateCreate(pod *v1.Pod) ([]string, error) {
+	message, reject, err := p.exceedingRequestsMessage(pod)
+	if err != nil || message == "" {
+		return nil, err
+	}
+	if reject || p.mode == Reject {
+		return nil, fmt.Errorf("%s", message)
+	}
+	if p.mode == Warn {
+		return []string{message}, nil
+	}
+	return nil, nil
+}
+
+// exceedingRequestsMessage returns a message if the pod can never be scheduled, and whether the pod must be rejected
+// regardless of the validation mode.
+func (p *NodeCapacity) exceedingRequestsMessage(pod *v1.Pod) (string, bool, error) {
+	requests := resourcehelper.PodRequests(pod, resourcehelper.PodResourcesOptions{})
+	if len(requests) == 0 {
+		return "", false, nil
+	}
+
+	ctx := context.Background()
+	queue, err := p.podQueue(ctx, pod)
+	if err != nil {
+		return "", false, err
+	}
+	message, reject, err := p.exceedingQueueLimitsMessage(ctx, pod, queue, requests)
+	if err != nil || message != "" {
+		return message, reject, err
+	}
+	if p.mode != Warn && p.mode != Reject {
+		return "", false, nil
+	}
+
+	nodePool, nodes, err := p.nodePoolNodes(ctx, pod, queue)
+	if err != nil {
+		return "", false, err
+	}
+	return exceedingNodesMessage(pod, nodePool, nodes, requests), false, nil
+}
+
+// exceedingNodesMessage checks that all the requests of the pod fit together in one of the nodes
+func exceedingNodesMessage(pod *v1.Pod, nodePool string, nodes []v1.Node, requests v1.ResourceList) string {
+	if len(nodes) == 0 {
+		// The node pool may still be provisioned, so there is nothing to compare against
+		return ""
+	}
+	for _, node := range nodes {
+		if fitsInNode(requests, node.Status.Allocatable) {
+			return ""
+		}
+	}
+
+	maxAllocatable := maxNodeAllocatable(nodes)
+	var exceeding, requested []string
+	for _, resourceName := range sortedResourceNames(requests) {
+		request := requests[resourceName]
+		if request.IsZero() {
+			continue
+		}
+		requested = append(requested, fmt.Sprintf("%s %s", request.String(), resourceName))
+		available := maxAllocatable[resourceName]
+		if request.Cmp(available) > 0 {
+			exceeding = append(exceeding, fmt.Sprintf("%s %s (max %s)",
+				request.String(), resourceName, available.String()))
+		}
+	}
+
+	details := strings.Join(exceeding, ", ")
+	if len(exceeding) == 0 {
+		details = fmt.Sprintf("no node has %s together", strings.Join(requested, ", "))
+	}
+	return fmt.Sprintf("The pod %s/%s requests more resources than are available in any single node of the %s "+
+		"node pool, and will not be scheduled: %s", pod.Namespace, pod.Name, nodePool, details)
+}
+
+func fitsInNode(requests, allocatable v1.ResourceList) bool {
+	for resourceName, request := range requests {
+		if request.IsZero() {
+			continue
+		}
+		available := allocatable[resourceName]
+		if request.Cmp(available) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// exceedingQueueLimitsMessage checks the requests of the pod against the limits of its queue and of its ancestors,
+// which no single pod of the queue can exceed even if all other workloads are reclaimed. Such pods are rejected
+// regardless of the validation mode if the queue or one of its ancestors rejects exceeding limits.
+func (p *NodeCapacity) exceedingQueueLimitsMessage(
+	ctx context.Context, pod *v1.Pod, queue *schedulingv2.Queue, requests v1.ResourceList,
+) (string, bool, error) {
+	message := ""
+	reject := false
+	visited := map[string]bool{}
+	for queue != nil && !visited[queue.Name] {
+		visited[queue.Name] = true
+		reject = reject || queue.Spec.RejectExceedingLimits
+		if exceeding := exceedingQueueLimits(queue, requests); len(exceeding) > 0 && message == "" {
+			message = fmt.Sprintf("The pod %s/%s requests more resources than the limit of the %s queue, and will "+
+				"not be scheduled: %s", pod.Namespace, pod.Name, queue.Name, strings.Join(exceeding, ", "))
+		}
+		if message != "" && reject {
+			break
+		}
+
+		parent, err := p.getQueue(ctx, queue.Spec.ParentQueue)
+		if err != nil {
+			return "", false, err
+		}
+		queue = parent
+	}
+	return message, message != "" && reject, nil
+}
+
+func exceedingQueueLimits(queue *schedulingv2.Queue, requests v1.ResourceList) []string {
+	if queue.Spec.Resources == nil {
+		return nil
+	}
+
+	var exceeding []string
+	checkLimit := func(resourceName v1.ResourceName, requested float64, limit float64, unit string) {
+		// Unset and unlimited limits are not checked
+		if limit <= 0 || requested <= limit {
+			return
+		}
+		exceeding = append(exceeding, fmt.Sprintf("%v%s %s (limit %v%s)", requested, unit, resourceName, limit, unit))
+	}
+	cpu := requests[v1.ResourceCPU]
+	checkLimit(v1.ResourceCPU, float64(cpu.MilliValue()), queue.Spec.Resources.CPU.Limit, "m")
+	memory := requests[v1.ResourceMemory]
+	checkLimit(v1.ResourceMemory, float64(memory.Value())/megabytes, queue.Spec.Resources.Memory.Limit, "MB")
+	// Accelerators of all vendors are accounted together against the GPU limit
+	gpuResourceName := v1.ResourceName(constants.GpuResource)
+	for _, resourceName := range resources.AcceleratorResourceNames() {
+		if request, found := requests[resourceName]; found && !request.IsZero() {
+			gpuResourceName = resourceName
+			break
+		}
+	}
+	gpu := resources.AcceleratorQuantity(requests)
+	checkLimit(gpuResourceName, float64(gpu.Value()), queue.Spec.Resources.GPU.Limit, "")
+	return exceeding
+}
+
+// nodePoolNodes returns the nodes of the pod's node pool, which is taken from the pod's label or else from its queue's
+// label. Pods without a node pool are scheduled to the nodes that are not labeled with a node pool.
+func (p *NodeCapacity) nodePoolNodes(ctx context.Context, pod *v1.Pod, queue *schedulingv2.Queue) (
+	string, []v1.Node, error) {
+	nodes := &v1.NodeList{}
+
+	nodePool, found := pod.Labels[p.nodePoolLabelKey]
+	if !found && queue != nil {
+		nodePool, found = queue.Labels[p.nodePoolLabelKey]
+	}
+	if found {
+		if err := p.kubeClient.List(ctx, nodes, client.MatchingLabels{p.nodePoolLabelKey: nodePool}); err != nil {
+			return "", nil, fmt.Errorf("failed to list nodes of node pool %s: %w", nodePool, err)
+		}
+		return nodePool, nodes.Items, nil
+	}
+
+	if err := p.kubeClient.List(ctx, nodes); err != nil {
+		return "", nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	var defaultPoolNodes []v1.Node
+	for _, node := range nodes.Items {
+		if _, found := node.Labels[p.nodePoolLabelKey]; !found {
+			defaultPoolNodes = append(defaultPoolNodes, node)
+		}
+	}
+	return defaultNodePoolName, defaultPoolNodes, nil
+}
+
+// podQueue returns the queue of the pod's PodGroup, or else the queue the pod is labeled with
+func (p *NodeCapacity) podQueue(ctx context.Context, pod *v1.Pod) (*schedulingv2.Queue, error) {
+	queueName := pod.Labels[constants.DefaultQueueLabel]
+	if podGroupName := pod.Annotations[constants.PodGroupAnnotationForPod]; podGroupName != "" {
+		podGroup := &v2alpha2.PodGroup{}
+		err := p.kubeClient.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: podGroupName}, podGroup)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get podgroup %s/%s: %w", pod.Namespace, podGroupName, err)
+		}
+		if err == nil && podGroup.Spec.Queue != "" {
+			queueName = podGroup.Spec.Queue
+		}
+	}
+	return p.getQueue(ctx, queueName)
+}
+
+func (p *NodeCapacity) getQueue(ctx context.Context, queueName string) (*schedulingv2.Queue, error) {
+	if queueName == "" {
+		return nil, nil
+	}
+	queue := &schedulingv2.Queue{}
+	if err := p.kubeClient.Get(ctx, types.NamespacedName{Name: queueName}, queue); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get queue %s: %w", queueName, err)
+	}
+	return queue, nil
+}
+
+func maxNodeAllocatable(nodes []v1.Node) v1.ResourceList {
+	maxAllocatable := v1.ResourceList{}
+	for _, node := range nodes {
+		for resourceName, quantity := range node.Status.Allocatable {
+			current, found := maxAllocatable[resourceName]
+			if !found || quantity.Cmp(current) > 0 {
+				maxAllocatable[resourceName] = quantity.DeepCopy()
+			}
+		}
+	}
+	return maxAllocatable
+}
+
+func sortedResourceNames(resources v1.ResourceList) []v1.ResourceName {
+	names := make([]v1.ResourceName, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// ParseValidationMode returns the validation mode matching the given name
+func ParseValidationMode(mode string) (ValidationMode, error) {
+	if slices.Contains(ValidationModes, ValidationMode(mode)) {
+		return ValidationMode(mode), nil
+	}
+	return "", fmt.Errorf("unknown node capacity validation mode %q, expected one of %v", mode, ValidationModes)
+}
@@ -0,0 +1,303 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package nodecapacity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	schedulingv2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+const nodePoolLabelKey = constants.DefaultNodePoolLabelKey
+
+func TestValidateCreate(t *testing.T) {
+	objects := []client.Object{
+		newNode("node-1", "", "8", "64"),
+		newNode("node-2", "", "4", "128"),
+		newNode("pool-a-node", "pool-a", "2", "32"),
+		&schedulingv2.Queue{ObjectMeta: metav1.ObjectMeta{
+			Name: "pool-a-queue", Labels: map[string]string{nodePoolLabelKey: "pool-a"},
+		}},
+		&schedulingv2.Queue{ObjectMeta: metav1.ObjectMeta{Name: "default-queue"}},
+		&schedulingv2.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: "limited-queue"},
+			Spec: schedulingv2.QueueSpec{Resources: &schedulingv2.QueueResources{
+				GPU: schedulingv2.QueueResource{Quota: 1, Limit: 2},
+				CPU: schedulingv2.QueueResource{Quota: 1000, Limit: -1},
+			}},
+		},
+		&schedulingv2.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: "limited-child-queue"},
+			Spec:       schedulingv2.QueueSpec{ParentQueue: "limited-queue"},
+		},
+		&schedulingv2.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: "fail-fast-queue"},
+			Spec: schedulingv2.QueueSpec{
+				Resources: &schedulingv2.QueueResources{
+					GPU: schedulingv2.QueueResource{Quota: 1, Limit: 2},
+				},
+				RejectExceedingLimits: true,
+			},
+		},
+		&schedulingv2.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: "fail-fast-child-queue"},
+			Spec:       schedulingv2.QueueSpec{ParentQueue: "fail-fast-queue"},
+		},
+		&v2alpha2.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool-a-pg", Namespace: "ns"},
+			Spec:       v2alpha2.PodGroupSpec{Queue: "pool-a-queue"},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mode             ValidationMode
+		pod              *v1.Pod
+		objects          []client.Object
+		expectedWarnings []string
+		expectedError    string
+	}{
+		{
+			name:    "pod fits in a node",
+			mode:    Reject,
+			pod:     newPod("", "8", "64"),
+			objects: objects,
+		},
+		{
+			name:    "pod fits in different nodes per resource",
+			mode:    Reject,
+			pod:     newPod("", "4", "128"),
+			objects: objects,
+		},
+		{
+			name:    "pod not fitting with all of its requests in any node",
+			mode:    Reject,
+			pod:     newPod("", "8", "128"),
+			objects: objects,
+			expectedError: "The pod ns/pod requests more resources than are available in any single node of the " +
+				"default node pool, and will not be scheduled: no node has 128 cpu, 8 nvidia.com/gpu together",
+		},
+		{
+			name:    "pod exceeding the node capacity with validation disabled",
+			mode:    Disabled,
+			pod:     newPod("", "12", "8"),
+			objects: objects,
+		},
+		{
+			name:    "pod exceeding the node capacity is warned",
+			mode:    Warn,
+			pod:     newPod("", "12", "8"),
+			objects: objects,
+			expectedWarnings: []string{"The pod ns/pod requests more resources than are available in any single " +
+				"node of the default node pool, and will not be scheduled: 12 nvidia.com/gpu (max 8)"},
+		},
+		{
+			name:    "pod exceeding the node capacity is rejected",
+			mode:    Reject,
+			pod:     newPod("", "12", "256"),
+			objects: objects,
+			expectedError: "The pod ns/pod requests more resources than are available in any single node of the " +
+				"default node pool, and will not be scheduled: 256 cpu (max 128), 12 nvidia.com/gpu (max 8)",
+		},
+		{
+			name:    "pod exceeding the node capacity of its node pool",
+			mode:    Reject,
+			pod:     newPod("pool-a", "4", "8"),
+			objects: objects,
+			expectedError: "The pod ns/pod requests more resources than are available in any single node of the " +
+				"pool-a node pool, and will not be scheduled: 4 nvidia.com/gpu (max 2)",
+		},
+		{
+			name:    "pod exceeding the node capacity of its queue's node pool",
+			mode:    Reject,
+			pod:     withQueue(newPod("", "4", "8"), "pool-a-queue"),
+			objects: objects,
+			expectedError: "The pod ns/pod requests more resources than are available in any single node of the " +
+				"pool-a node pool, and will not be scheduled: 4 nvidia.com/gpu (max 2)",
+		},
+		{
+			name:    "pod exceeding the node capacity of its podgroup's queue's node pool",
+			mode:    Reject,
+			pod:     withPodGroup(withQueue(newPod("", "4", "8"), "default-queue"), "pool-a-pg"),
+			objects: objects,
+			expectedError: "The pod ns/pod requests more resources than are available in any single node of the " +
+				"pool-a node pool, and will not be scheduled: 4 nvidia.com/gpu (max 2)",
+		},
+		{
+			name:    "pod exceeding the limit of its queue",
+			mode:    Reject,
+			pod:     withQueue(newPod("", "4", "8"), "limited-queue"),
+			objects: objects,
+			expectedError: "The pod ns/pod requests more resources than the limit of the limited-queue queue, and " +
+				"will not be scheduled: 4 nvidia.com/gpu (limit 2)",
+		},
+		{
+			name:    "pod exceeding the limit of its parent queue",
+			mode:    Reject,
+			pod:     withQueue(newPod("", "4", "8"), "limited-child-queue"),
+			objects: objects,
+			expectedError: "The pod ns/pod requests more resources than the limit of the limited-queue queue, and " +
+				"will not be scheduled: 4 nvidia.com/gpu (limit 2)",
+		},
+		{
+			name:    "pod exceeding the limit of its queue with validation disabled",
+			mode:    Disabled,
+			pod:     withQueue(newPod("", "4", "8"), "limited-queue"),
+			objects: objects,
+		},
+		{
+			name:    "pod exceeding the limit of a queue that rejects exceeding limits with validation disabled",
+			mode:    Disabled,
+			pod:     withQueue(newPod("", "4", "8"), "fail-fast-queue"),
+			objects: objects,
+			expectedError: "The pod ns/pod requests more resources than the limit of the fail-fast-queue queue, and " +
+				"will not be scheduled: 4 nvidia.com/gpu (limit 2)",
+		},
+		{
+			name:    "pod exceeding the limit of a parent queue that rejects exceeding limits in warn mode",
+			mode:    Warn,
+			pod:     withQueue(newPod("", "4", "8"), "fail-fast-child-queue"),
+			objects: objects,
+			expectedError: "The pod ns/pod requests more resources than the limit of the fail-fast-queue queue, and " +
+				"will not be scheduled: 4 nvidia.com/gpu (limit 2)",
+		},
+		{
+			name:    "pod within the limits of a queue that rejects exceeding limits with validation disabled",
+			mode:    Disabled,
+			pod:     withQueue(newPod("", "2", "128"), "fail-fast-queue"),
+			objects: objects,
+		},
+		{
+			name:    "pod within the limits of its queue",
+			mode:    Reject,
+			pod:     withQueue(newPod("", "2", "64"), "limited-queue"),
+			objects: objects,
+		},
+		{
+			name:    "pod in a queue without a node pool",
+			mode:    Reject,
+			pod:     withQueue(newPod("", "4", "8"), "default-queue"),
+			objects: objects,
+		},
+		{
+			name:    "pod in a queue that does not exist",
+			mode:    Reject,
+			pod:     withQueue(newPod("", "4", "8"), "missing-queue"),
+			objects: objects,
+		},
+		{
+			name:    "node pool without nodes",
+			mode:    Reject,
+			pod:     newPod("pool-b", "4", "8"),
+			objects: objects,
+		},
+		{
+			name:    "pod requesting a resource that no node has",
+			mode:    Reject,
+			pod:     newPod("", "1", "8"),
+			objects: []client.Object{newNode("node-1", "", "", "64")},
+			expectedError: "The pod ns/pod requests more resources than are available in any single node of the " +
+				"default node pool, and will not be scheduled: 1 nvidia.com/gpu (max 0)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(tt.objects...).Build()
+			plugin := New(kubeClient, nodePoolLabelKey, tt.mode)
+
+			warnings, err := plugin.ValidateCreate(tt.pod)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedWarnings, warnings)
+		})
+	}
+}
+
+func TestParseValidationMode(t *testing.T) {
+	mode, err := ParseValidationMode("reject")
+	assert.NoError(t, err)
+	assert.Equal(t, Reject, mode)
+
+	_, err = ParseValidationMode("unknown")
+	assert.Error(t, err)
+}
+
+func newNode(name, nodePool, gpus, cpus string) *v1.Node {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU: resource.MustParse(cpus),
+			},
+		},
+	}
+	if nodePool != "" {
+		node.Labels = map[string]string{nodePoolLabelKey: nodePool}
+	}
+	if gpus != "" {
+		node.Status.Allocatable[constants.GpuResource] = resource.MustParse(gpus)
+	}
+	return node
+}
+
+func newPod(nodePool, gpus, cpus string) *v1.Pod {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns"},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Name: "container",
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{
+						v1.ResourceCPU:        resource.MustParse(cpus),
+						constants.GpuResource: resource.MustParse(gpus),
+					},
+					Limits: v1.ResourceList{
+						constants.GpuResource: resource.MustParse(gpus),
+					},
+				},
+			}},
+		},
+	}
+	if nodePool != "" {
+		pod.Labels = map[string]string{nodePoolLabelKey: nodePool}
+	}
+	return pod
+}
+
+func withQueue(pod *v1.Pod, queue string) *v1.Pod {
+	if pod.Labels == nil {
+		pod.Labels = map[string]string{}
+	}
+	pod.Labels[constants.DefaultQueueLabel] = queue
+	return pod
+}
+
+func withPodGroup(pod *v1.Pod, podGroup string) *v1.Pod {
+	pod.Annotations = map[string]string{constants.PodGroupAnnotationForPod: podGroup}
+	return pod
+}
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(schedulingv2.AddToScheme(scheme))
+	utilruntime.Must(v2alpha2.AddToScheme(scheme))
+	return scheme
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -50,7 +51,7 @@ func NewPodValidator(kubeClient client.Client, plugins *plugins.KaiAdmissionPlug
 	}
 }
 
-func (v *podValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+func (v *podValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	validatorlog.Info("pod validator", "kind", obj.GetObjectKind().GroupVersionKind().Kind)
 	pod, ok := obj.(*corev1.Pod)
 	if !ok {
@@ -60,7 +61,12 @@ func (v *podValidator) ValidateCreate(_ context.Context, obj runtime.Object) (ad
 		return nil, nil
 	}
 
-	return nil, v.plugins.Validate(pod)
+	// The user is only known for requests that come through the webhook server
+	var user authenticationv1.UserInfo
+	if admissionRequest, err := admission.RequestFromContext(ctx); err == nil {
+		user = admissionRequest.UserInfo
+	}
+	return v.plugins.ValidateCreate(pod, user)
 }
 
 func (v *podValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (
@@ -0,0 +1,88 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package preemptibility
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	podgrouperconstants "github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/constants"
+)
+
+// Preemptibility rejects pods that set a preemptibility other than the default of their queue, when the queue (or its
+// closest ancestor that sets preemptibility settings) doesn't allow overriding it.
+type Preemptibility struct {
+	kubeClient client.Client
+}
+
+func New(kubeClient client.Client) *Preemptibility {
+	return &Preemptibility{
+		kubeClient: kubeClient,
+	}
+}
+
+func (p *Preemptibility) Name() string {
+	return "preemptibility"
+}
+
+func (p *Preemptibility) Validate(pod *v1.Pod) error {
+	return nil
+}
+
+func (p *Preemptibility) Mutate(pod *v1.Pod) error {
+	return nil
+}
+
+// +kubebuilder:rbac:groups=scheduling.run.ai,resources=queues,verbs=get;list;watch
+
+func (p *Preemptibility) ValidateCreate(pod *v1.Pod) ([]string, error) {
+	// invalid values are ignored by the podgrouper, and so can't override the default
+	preemptibility, err := v2alpha2.ParsePreemptibility(pod.Labels[podgrouperconstants.PreemptibilityLabelKey])
+	if err != nil || preemptibility == "" {
+		return nil, nil
+	}
+
+	queueName := pod.Labels[constants.DefaultQueueLabel]
+	queuePreemptibility, err := p.getQueuePreemptibility(context.Background(), queueName)
+	if err != nil {
+		return nil, err
+	}
+	if queuePreemptibility == nil || queuePreemptibility.IsOverrideAllowed() ||
+		preemptibility == queuePreemptibility.Default {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("queue %s does not allow workloads to override its %s preemptibility",
+		queueName, queuePreemptibility.Default)
+}
+
+// getQueuePreemptibility returns the preemptibility settings of the queue or of its closest ancestor that sets them
+func (p *Preemptibility) getQueuePreemptibility(
+	ctx context.Context, queueName string,
+) (*v2.QueuePreemptibility, error) {
+	visited := map[string]bool{}
+	for queueName != "" && !visited[queueName] {
+		visited[queueName] = true
+		queue := &v2.Queue{}
+		err := p.kubeClient.Get(ctx, types.NamespacedName{Name: queueName}, queue)
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get queue %s: %w", queueName, err)
+		}
+		if queue.Spec.Preemptibility != nil {
+			return queue.Spec.Preemptibility, nil
+		}
+		queueName = queue.Spec.ParentQueue
+	}
+	return nil, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package preemptibility
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	podgrouperconstants "github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/constants"
+)
+
+func TestValidateCreate(t *testing.T) {
+	department := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "department"},
+		Spec: v2.QueueSpec{
+			Preemptibility: &v2.QueuePreemptibility{Default: v2alpha2.Preemptible, AllowOverride: ptr.To(false)},
+		},
+	}
+	team := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "team"},
+		Spec:       v2.QueueSpec{ParentQueue: "department"},
+	}
+	open := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "open"},
+		Spec: v2.QueueSpec{
+			ParentQueue:    "department",
+			Preemptibility: &v2.QueuePreemptibility{Default: v2alpha2.Preemptible},
+		},
+	}
+	objects := []client.Object{department, team, open}
+
+	tests := []struct {
+		name           string
+		queue          string
+		preemptibility string
+		expectError    bool
+	}{
+		{name: "pod without preemptibility", queue: "department"},
+		{name: "pod with the default preemptibility", queue: "department", preemptibility: "preemptible"},
+		{name: "override not allowed", queue: "department", preemptibility: "non-preemptible", expectError: true},
+		{name: "override not allowed by parent queue", queue: "team", preemptibility: "non-preemptible",
+			expectError: true},
+		{name: "override allowed", queue: "open", preemptibility: "non-preemptible"},
+		{name: "missing queue", queue: "missing", preemptibility: "non-preemptible"},
+		{name: "invalid preemptibility is ignored", queue: "department", preemptibility: "sometimes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(objects...).Build()
+			plugin := New(kubeClient)
+
+			labels := map[string]string{constants.DefaultQueueLabel: tt.queue}
+			if tt.preemptibility != "" {
+				labels[podgrouperconstants.PreemptibilityLabelKey] = tt.preemptibility
+			}
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns", Labels: labels}}
+
+			_, err := plugin.ValidateCreate(pod)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(v2.AddToScheme(scheme))
+	return scheme
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package queueassignment
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	podgrouperconstants "github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/constants"
+)
+
+var logger = logf.Log.WithName("queue-assignment")
+
+// QueueAssignment labels pods that are submitted without a queue with the queue of the QueueAssignmentRule with the
+// highest precedence that matches them.
+type QueueAssignment struct {
+	kubeClient client.Client
+}
+
+func New(kubeClient client.Client) *QueueAssignment {
+	return &QueueAssignment{
+		kubeClient: kubeClient,
+	}
+}
+
+func (p *QueueAssignment) Name() string {
+	return "queueassignment"
+}
+
+func (p *QueueAssignment) Validate(pod *v1.Pod) error {
+	return nil
+}
+
+// +kubebuilder:rbac:groups=kai.scheduler,resources=queueassignmentrules,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+func (p *QueueAssignment) Mutate(pod *v1.Pod) error {
+	if hasExplicitQueue(pod) {
+		return nil
+	}
+
+	ctx := context.Background()
+	rules := &kaiv1alpha1.QueueAssignmentRuleList{}
+	if err := p.kubeClient.List(ctx, rules); err != nil {
+		return fmt.Errorf("failed to list queue assignment rules: %w", err)
+	}
+	if len(rules.Items) == 0 {
+		return nil
+	}
+	sortByPrecedence(rules.Items)
+
+	var namespaceLabels labels.Set
+	for _, rule := range rules.Items {
+		if rule.Spec.NamespaceSelector != nil && namespaceLabels == nil {
+			namespace := &v1.Namespace{}
+			if err := p.kubeClient.Get(ctx, types.NamespacedName{Name: pod.Namespace}, namespace); err != nil {
+				return fmt.Errorf("failed to get namespace %s: %w", pod.Namespace, err)
+			}
+			namespaceLabels = labels.Set(namespace.Labels)
+		}
+
+		matches, err := matchesRule(&rule.Spec, pod, namespaceLabels)
+		if err != nil {
+			return fmt.Errorf("invalid queue assignment rule %s: %w", rule.Name, err)
+		}
+		if !matches {
+			continue
+		}
+
+		if pod.Labels == nil {
+			pod.Labels = map[string]string{}
+		}
+		pod.Labels[constants.DefaultQueueLabel] = rule.Spec.Queue
+		logger.V(1).Info("assigned queue to pod", "namespace", pod.Namespace, "name", pod.Name,
+			"queue", rule.Spec.Queue, "rule", rule.Name)
+		return nil
+	}
+	return nil
+}
+
+// hasExplicitQueue checks if the pod's queue is already set by its queue or project label
+func hasExplicitQueue(pod *v1.Pod) bool {
+	return pod.Labels[constants.DefaultQueueLabel] != "" || pod.Labels[podgrouperconstants.ProjectLabelKey] != ""
+}
+
+func sortByPrecedence(rules []kaiv1alpha1.QueueAssignmentRule) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].Spec.Precedence != rules[j].Spec.Precedence {
+			return rules[i].Spec.Precedence > rules[j].Spec.Precedence
+		}
+		return rules[i].Name < rules[j].Name
+	})
+}
+
+func matchesRule(spec *kaiv1alpha1.QueueAssignmentRuleSpec, pod *v1.Pod, namespaceLabels labels.Set) (bool, error) {
+	if len(spec.ServiceAccounts) > 0 && !slices.Contains(spec.ServiceAccounts, serviceAccountName(pod)) {
+		return false, nil
+	}
+
+	matches, err := matchesSelector(spec.PodSelector, labels.Set(pod.Labels))
+	if err != nil || !matches {
+		return false, err
+	}
+	return matchesSelector(spec.NamespaceSelector, namespaceLabels)
+}
+
+func matchesSelector(labelSelector *metav1.LabelSelector, objectLabels labels.Set) (bool, error) {
+	if labelSelector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(objectLabels), nil
+}
+
+// serviceAccountName returns the service account the pod will run as, which is the namespace's default service
+// account when not set, since the service account admission plugin may run after the webhook
+func serviceAccountName(pod *v1.Pod) string {
+	if pod.Spec.ServiceAccountName == "" {
+		return "default"
+	}
+	return pod.Spec.ServiceAccountName
+}
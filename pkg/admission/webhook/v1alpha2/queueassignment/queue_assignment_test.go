@@ -0,0 +1,155 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package queueassignment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+func TestMutate(t *testing.T) {
+	namespace := &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "ns", Labels: map[string]string{"team": "research"}},
+	}
+	defaultRule := newRule("default", "default-queue", -100, kaiv1alpha1.QueueAssignmentRuleSpec{})
+	researchRule := newRule("research", "research-queue", 0, kaiv1alpha1.QueueAssignmentRuleSpec{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "research"}},
+	})
+	inferenceRule := newRule("inference", "inference-queue", 10, kaiv1alpha1.QueueAssignmentRuleSpec{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "research"}},
+		PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"workload": "inference"}},
+	})
+	serviceAccountRule := newRule("pipelines", "pipelines-queue", 10, kaiv1alpha1.QueueAssignmentRuleSpec{
+		ServiceAccounts: []string{"pipeline-runner"},
+	})
+	otherTeamRule := newRule("other-team", "other-queue", 100, kaiv1alpha1.QueueAssignmentRuleSpec{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "other"}},
+	})
+
+	tests := []struct {
+		name          string
+		pod           *v1.Pod
+		objects       []client.Object
+		expectedQueue string
+	}{
+		{
+			name:    "no rules",
+			pod:     newPod(nil, ""),
+			objects: []client.Object{namespace},
+		},
+		{
+			name:          "pod with a queue label",
+			pod:           newPod(map[string]string{constants.DefaultQueueLabel: "my-queue"}, ""),
+			objects:       []client.Object{namespace, defaultRule, researchRule},
+			expectedQueue: "my-queue",
+		},
+		{
+			name:    "pod with a project label",
+			pod:     newPod(map[string]string{"project": "my-project"}, ""),
+			objects: []client.Object{namespace, defaultRule},
+		},
+		{
+			name:          "default rule",
+			pod:           newPod(nil, ""),
+			objects:       []client.Object{namespace, defaultRule, otherTeamRule},
+			expectedQueue: "default-queue",
+		},
+		{
+			name:          "namespace selector takes precedence over the default rule",
+			pod:           newPod(nil, ""),
+			objects:       []client.Object{namespace, defaultRule, researchRule, otherTeamRule},
+			expectedQueue: "research-queue",
+		},
+		{
+			name:          "rule with higher precedence",
+			pod:           newPod(map[string]string{"workload": "inference"}, ""),
+			objects:       []client.Object{namespace, defaultRule, researchRule, inferenceRule},
+			expectedQueue: "inference-queue",
+		},
+		{
+			name:          "rule matching only part of its selectors",
+			pod:           newPod(map[string]string{"workload": "training"}, ""),
+			objects:       []client.Object{namespace, researchRule, inferenceRule},
+			expectedQueue: "research-queue",
+		},
+		{
+			name:          "rules with the same precedence are ordered by name",
+			pod:           newPod(map[string]string{"workload": "inference"}, "pipeline-runner"),
+			objects:       []client.Object{namespace, inferenceRule, serviceAccountRule},
+			expectedQueue: "inference-queue",
+		},
+		{
+			name:          "service account rule",
+			pod:           newPod(nil, "pipeline-runner"),
+			objects:       []client.Object{namespace, defaultRule, serviceAccountRule},
+			expectedQueue: "pipelines-queue",
+		},
+		{
+			name:    "no matching rule",
+			pod:     newPod(nil, "other-account"),
+			objects: []client.Object{namespace, serviceAccountRule, otherTeamRule},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(tt.objects...).Build()
+			plugin := New(kubeClient)
+
+			err := plugin.Mutate(tt.pod)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedQueue, tt.pod.Labels[constants.DefaultQueueLabel])
+		})
+	}
+}
+
+func TestMutateInvalidRule(t *testing.T) {
+	invalidRule := newRule("invalid", "queue", 0, kaiv1alpha1.QueueAssignmentRuleSpec{
+		PodSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "workload", Operator: "Unknown"},
+		}},
+	})
+	kubeClient := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(invalidRule).Build()
+
+	err := New(kubeClient).Mutate(newPod(nil, ""))
+	assert.Error(t, err)
+}
+
+func newRule(name, queue string, precedence int32, spec kaiv1alpha1.QueueAssignmentRuleSpec) *kaiv1alpha1.QueueAssignmentRule {
+	spec.Queue = queue
+	spec.Precedence = precedence
+	return &kaiv1alpha1.QueueAssignmentRule{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       spec,
+	}
+}
+
+func newPod(labels map[string]string, serviceAccount string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod",
+			Namespace: "ns",
+			Labels:    labels,
+		},
+		Spec: v1.PodSpec{ServiceAccountName: serviceAccount},
+	}
+}
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(kaiv1alpha1.AddToScheme(scheme))
+	return scheme
+}
@@ -0,0 +1,164 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcedefaults
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/common"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
+)
+
+// ResourceDefaults sets the resource defaults per GPU of the queue of a pod (or of its closest ancestor that sets
+// them) on the GPU containers of the pod, for the resources the containers don't request or limit.
+type ResourceDefaults struct {
+	kubeClient client.Client
+}
+
+func New(kubeClient client.Client) *ResourceDefaults {
+	return &ResourceDefaults{
+		kubeClient: kubeClient,
+	}
+}
+
+func (p *ResourceDefaults) Name() string {
+	return "resourcedefaults"
+}
+
+func (p *ResourceDefaults) Validate(pod *v1.Pod) error {
+	return nil
+}
+
+// +kubebuilder:rbac:groups=scheduling.run.ai,resources=queues,verbs=get;list;watch
+
+func (p *ResourceDefaults) Mutate(pod *v1.Pod) error {
+	if !resources.RequestsGPU(pod) {
+		return nil
+	}
+
+	defaults, err := p.getQueueResourceDefaults(context.Background(), pod.Labels[constants.DefaultQueueLabel])
+	if err != nil {
+		return err
+	}
+	if defaults == nil {
+		return nil
+	}
+
+	for index := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[index]
+		gpus := wholeGPUs(container)
+		if gpus > 0 {
+			applyResourceDefaults(container, defaults, gpus)
+		}
+	}
+
+	gpus, err := sharedGPUs(pod)
+	if err != nil || gpus == 0 {
+		// invalid GPU sharing annotations are rejected by the GPU sharing plugin
+		return nil
+	}
+	containerRef, err := common.GetFractionContainerRef(pod)
+	if err != nil {
+		return nil
+	}
+	applyResourceDefaults(containerRef.Container, defaults, gpus)
+	return nil
+}
+
+// getQueueResourceDefaults returns the resource defaults of the queue or of its closest ancestor that sets them
+func (p *ResourceDefaults) getQueueResourceDefaults(
+	ctx context.Context, queueName string,
+) (*v2.QueueResourceDefaults, error) {
+	visited := map[string]bool{}
+	for queueName != "" && !visited[queueName] {
+		visited[queueName] = true
+		queue := &v2.Queue{}
+		err := p.kubeClient.Get(ctx, types.NamespacedName{Name: queueName}, queue)
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get queue %s: %w", queueName, err)
+		}
+		if queue.Spec.ResourceDefaults != nil {
+			return queue.Spec.ResourceDefaults, nil
+		}
+		queueName = queue.Spec.ParentQueue
+	}
+	return nil, nil
+}
+
+func wholeGPUs(container *v1.Container) float64 {
+	gpus := resources.AcceleratorQuantity(container.Resources.Limits)
+	if gpus.IsZero() {
+		gpus = resources.AcceleratorQuantity(container.Resources.Requests)
+	}
+	return gpus.AsApproximateFloat64()
+}
+
+// sharedGPUs returns the number of GPUs of a pod that requests a GPU fraction or a range of GPUs through annotations.
+// Pods requesting GPU memory have no known number of GPUs, and get no defaults.
+func sharedGPUs(pod *v1.Pod) (float64, error) {
+	if resources.RequestsGPUCountRange(pod) {
+		minCount, _, err := resources.GetGPUCountRange(pod)
+		return float64(minCount), err
+	}
+	if _, found := pod.Annotations[constants.GpuFraction]; !found {
+		return 0, nil
+	}
+	fraction, err := resources.GetGPUFraction(pod)
+	if err != nil {
+		return 0, err
+	}
+	numDevices, err := resources.GetNumGPUFractionDevices(pod)
+	if err != nil {
+		return 0, err
+	}
+	return fraction * float64(numDevices), nil
+}
+
+// applyResourceDefaults sets the defaults for the given number of GPUs on the resources the container doesn't set.
+// Requests are capped by the limits of the container, and limits below its requests are not set.
+func applyResourceDefaults(container *v1.Container, defaults *v2.QueueResourceDefaults, gpus float64) {
+	for name, perGPU := range defaults.RequestsPerGPU {
+		if _, found := container.Resources.Requests[name]; found || resources.IsAcceleratorResource(name) {
+			continue
+		}
+		request := multiply(perGPU, gpus)
+		if limit, found := container.Resources.Limits[name]; found && request.Cmp(limit) > 0 {
+			request = limit.DeepCopy()
+		}
+		if container.Resources.Requests == nil {
+			container.Resources.Requests = v1.ResourceList{}
+		}
+		container.Resources.Requests[name] = request
+	}
+
+	for name, perGPU := range defaults.LimitsPerGPU {
+		if _, found := container.Resources.Limits[name]; found || resources.IsAcceleratorResource(name) {
+			continue
+		}
+		limit := multiply(perGPU, gpus)
+		if request, found := container.Resources.Requests[name]; found && request.Cmp(limit) > 0 {
+			continue
+		}
+		if container.Resources.Limits == nil {
+			container.Resources.Limits = v1.ResourceList{}
+		}
+		container.Resources.Limits[name] = limit
+	}
+}
+
+func multiply(quantity resource.Quantity, factor float64) resource.Quantity {
+	return *resource.NewMilliQuantity(int64(float64(quantity.MilliValue())*factor), quantity.Format)
+}
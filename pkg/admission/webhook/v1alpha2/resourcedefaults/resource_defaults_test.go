@@ -0,0 +1,209 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcedefaults
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+func TestMutate(t *testing.T) {
+	department := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "department"},
+		Spec: v2.QueueSpec{
+			ResourceDefaults: &v2.QueueResourceDefaults{
+				RequestsPerGPU: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("8"),
+					v1.ResourceMemory: resource.MustParse("64Gi"),
+				},
+				LimitsPerGPU: v1.ResourceList{
+					v1.ResourceMemory: resource.MustParse("64Gi"),
+				},
+			},
+		},
+	}
+	team := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "team"},
+		Spec:       v2.QueueSpec{ParentQueue: "department"},
+	}
+	plain := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "plain"},
+	}
+	objects := []client.Object{department, team, plain}
+
+	tests := []struct {
+		name             string
+		queue            string
+		annotations      map[string]string
+		requests         v1.ResourceList
+		limits           v1.ResourceList
+		expectedRequests v1.ResourceList
+		expectedLimits   v1.ResourceList
+	}{
+		{
+			name:     "whole GPUs",
+			queue:    "department",
+			requests: v1.ResourceList{constants.GpuResource: resource.MustParse("2")},
+			limits:   v1.ResourceList{constants.GpuResource: resource.MustParse("2")},
+			expectedRequests: v1.ResourceList{
+				constants.GpuResource: resource.MustParse("2"),
+				v1.ResourceCPU:        resource.MustParse("16"),
+				v1.ResourceMemory:     resource.MustParse("128Gi"),
+			},
+			expectedLimits: v1.ResourceList{
+				constants.GpuResource: resource.MustParse("2"),
+				v1.ResourceMemory:     resource.MustParse("128Gi"),
+			},
+		},
+		{
+			name:   "defaults of the parent queue",
+			queue:  "team",
+			limits: v1.ResourceList{constants.GpuResource: resource.MustParse("1")},
+			expectedRequests: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("8"),
+				v1.ResourceMemory: resource.MustParse("64Gi"),
+			},
+			expectedLimits: v1.ResourceList{
+				constants.GpuResource: resource.MustParse("1"),
+				v1.ResourceMemory:     resource.MustParse("64Gi"),
+			},
+		},
+		{
+			name:  "values set on the container are kept",
+			queue: "department",
+			requests: v1.ResourceList{
+				constants.GpuResource: resource.MustParse("1"),
+				v1.ResourceCPU:        resource.MustParse("2"),
+			},
+			limits: v1.ResourceList{
+				constants.GpuResource: resource.MustParse("1"),
+				v1.ResourceMemory:     resource.MustParse("16Gi"),
+			},
+			expectedRequests: v1.ResourceList{
+				constants.GpuResource: resource.MustParse("1"),
+				v1.ResourceCPU:        resource.MustParse("2"),
+				v1.ResourceMemory:     resource.MustParse("16Gi"),
+			},
+			expectedLimits: v1.ResourceList{
+				constants.GpuResource: resource.MustParse("1"),
+				v1.ResourceMemory:     resource.MustParse("16Gi"),
+			},
+		},
+		{
+			name:  "limits below the requests are not set",
+			queue: "department",
+			requests: v1.ResourceList{
+				constants.GpuResource: resource.MustParse("1"),
+				v1.ResourceMemory:     resource.MustParse("100Gi"),
+			},
+			limits: v1.ResourceList{constants.GpuResource: resource.MustParse("1")},
+			expectedRequests: v1.ResourceList{
+				constants.GpuResource: resource.MustParse("1"),
+				v1.ResourceCPU:        resource.MustParse("8"),
+				v1.ResourceMemory:     resource.MustParse("100Gi"),
+			},
+			expectedLimits: v1.ResourceList{constants.GpuResource: resource.MustParse("1")},
+		},
+		{
+			name:        "GPU fraction",
+			queue:       "department",
+			annotations: map[string]string{constants.GpuFraction: "0.5"},
+			expectedRequests: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("4"),
+				v1.ResourceMemory: resource.MustParse("32Gi"),
+			},
+			expectedLimits: v1.ResourceList{
+				v1.ResourceMemory: resource.MustParse("32Gi"),
+			},
+		},
+		{
+			name:        "GPU memory gets no defaults",
+			queue:       "department",
+			annotations: map[string]string{constants.GpuMemory: "2000"},
+		},
+		{
+			name:     "CPU only pod gets no defaults",
+			queue:    "department",
+			requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")},
+			expectedRequests: v1.ResourceList{
+				v1.ResourceCPU: resource.MustParse("1"),
+			},
+		},
+		{
+			name:   "queue without defaults",
+			queue:  "plain",
+			limits: v1.ResourceList{constants.GpuResource: resource.MustParse("1")},
+			expectedLimits: v1.ResourceList{
+				constants.GpuResource: resource.MustParse("1"),
+			},
+		},
+		{
+			name:   "missing queue",
+			queue:  "missing",
+			limits: v1.ResourceList{constants.GpuResource: resource.MustParse("1")},
+			expectedLimits: v1.ResourceList{
+				constants.GpuResource: resource.MustParse("1"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(objects...).Build()
+			plugin := New(kubeClient)
+
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "pod",
+					Namespace:   "ns",
+					Labels:      map[string]string{constants.DefaultQueueLabel: tt.queue},
+					Annotations: tt.annotations,
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name:      "main",
+							Resources: v1.ResourceRequirements{Requests: tt.requests, Limits: tt.limits},
+						},
+					},
+				},
+			}
+
+			assert.NoError(t, plugin.Mutate(pod))
+			resources := pod.Spec.Containers[0].Resources
+			assertResourceList(t, tt.expectedRequests, resources.Requests)
+			assertResourceList(t, tt.expectedLimits, resources.Limits)
+		})
+	}
+}
+
+func assertResourceList(t *testing.T, expected, actual v1.ResourceList) {
+	assert.Equal(t, len(expected), len(actual))
+	for name, quantity := range expected {
+		actualQuantity, found := actual[name]
+		if assert.True(t, found, "resource %s not found", name) {
+			assert.Zero(t, quantity.Cmp(actualQuantity), "resource %s: expected %s, got %s",
+				name, quantity.String(), actualQuantity.String())
+		}
+	}
+}
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(v2.AddToScheme(scheme))
+	return scheme
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package schedulingconstraints
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/podgroup"
+)
+
+// SchedulingConstraints injects the tolerations and node selector defined on the PodGroup and Queue of a pod into
+// the pod. Values set on the pod take precedence over those of the PodGroup, which take precedence over the Queue.
+// PodGroups created after their pods are applied by the scheduler and the binder instead.
+type SchedulingConstraints struct {
+	kubeClient client.Client
+}
+
+func New(kubeClient client.Client) *SchedulingConstraints {
+	return &SchedulingConstraints{
+		kubeClient: kubeClient,
+	}
+}
+
+func (p *SchedulingConstraints) Name() string {
+	return "schedulingconstraints"
+}
+
+func (p *SchedulingConstraints) Validate(pod *v1.Pod) error {
+	return nil
+}
+
+// +kubebuilder:rbac:groups=scheduling.run.ai,resources=podgroups,verbs=get;list;watch
+// +kubebuilder:rbac:groups=scheduling.run.ai,resources=queues,verbs=get;list;watch
+
+func (p *SchedulingConstraints) Mutate(pod *v1.Pod) error {
+	ctx := context.Background()
+
+	podGroup, err := p.getPodGroup(ctx, pod)
+	if err != nil {
+		return err
+	}
+
+	queueName := pod.Labels[constants.DefaultQueueLabel]
+	if podGroup != nil {
+		podgroup.ApplySchedulingConstraints(pod, podGroup.Spec.Tolerations, podGroup.Spec.NodeSelector)
+		if podGroup.Spec.Queue != "" {
+			queueName = podGroup.Spec.Queue
+		}
+	}
+
+	queue, err := p.getQueue(ctx, queueName)
+	if err != nil {
+		return err
+	}
+	if queue == nil {
+		return nil
+	}
+	podgroup.ApplySchedulingConstraints(pod, queue.Spec.Tolerations, queue.Spec.NodeSelector)
+
+	return p.applyParentQueuesTolerations(ctx, pod, queue)
+}
+
+// applyParentQueuesTolerations adds the tolerations of the queue's ancestors, so that a tainted node pool opened
+// to a parent queue is open to all of its child queues. Tolerations of closer queues take precedence.
+func (p *SchedulingConstraints) applyParentQueuesTolerations(ctx context.Context, pod *v1.Pod, queue *v2.Queue) error {
+	visited := map[string]bool{queue.Name: true}
+	parentName := queue.Spec.ParentQueue
+	for parentName != "" && !visited[parentName] {
+		visited[parentName] = true
+		parent, err := p.getQueue(ctx, parentName)
+		if err != nil || parent == nil {
+			return err
+		}
+		podgroup.ApplySchedulingConstraints(pod, parent.Spec.Tolerations, nil)
+		parentName = parent.Spec.ParentQueue
+	}
+	return nil
+}
+
+func (p *SchedulingConstraints) getPodGroup(ctx context.Context, pod *v1.Pod) (*v2alpha2.PodGroup, error) {
+	podGroupName := pod.Annotations[constants.PodGroupAnnotationForPod]
+	if podGroupName == "" {
+		return nil, nil
+	}
+
+	podGroup := &v2alpha2.PodGroup{}
+	err := p.kubeClient.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: podGroupName}, podGroup)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get podgroup %s/%s: %w", pod.Namespace, podGroupName, err)
+	}
+	return podGroup, nil
+}
+
+func (p *SchedulingConstraints) getQueue(ctx context.Context, queueName string) (*v2.Queue, error) {
+	if queueName == "" {
+		return nil, nil
+	}
+
+	queue := &v2.Queue{}
+	err := p.kubeClient.Get(ctx, types.NamespacedName{Name: queueName}, queue)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get queue %s: %w", queueName, err)
+	}
+	return queue, nil
+}
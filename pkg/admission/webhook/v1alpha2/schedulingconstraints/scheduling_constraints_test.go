@@ -0,0 +1,172 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package schedulingconstraints
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+var (
+	gpuToleration = v1.Toleration{
+		Key: "nvidia.com/gpu", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule,
+	}
+	teamToleration = v1.Toleration{
+		Key: "team", Operator: v1.TolerationOpEqual, Value: "a", Effect: v1.TaintEffectNoSchedule,
+	}
+	podTeamToleration = v1.Toleration{
+		Key: "team", Operator: v1.TolerationOpEqual, Value: "b", Effect: v1.TaintEffectNoSchedule,
+	}
+)
+
+func TestMutate(t *testing.T) {
+	queue := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "queue-a"},
+		Spec: v2.QueueSpec{
+			Tolerations:  []v1.Toleration{teamToleration},
+			NodeSelector: map[string]string{"pool": "queue-pool", "zone": "queue-zone"},
+		},
+	}
+	childQueue := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "queue-a-child"},
+		Spec: v2.QueueSpec{
+			ParentQueue:  "queue-a",
+			Tolerations:  []v1.Toleration{podTeamToleration},
+			NodeSelector: map[string]string{"zone": "child-zone"},
+		},
+	}
+	parentQueue := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "queue-a"},
+		Spec: v2.QueueSpec{
+			ParentQueue:  "queue-a-child",
+			Tolerations:  []v1.Toleration{teamToleration, gpuToleration},
+			NodeSelector: map[string]string{"pool": "queue-pool"},
+		},
+	}
+	podGroup := &v2alpha2.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "pg", Namespace: "ns"},
+		Spec: v2alpha2.PodGroupSpec{
+			Queue:        "queue-a",
+			Tolerations:  []v1.Toleration{gpuToleration},
+			NodeSelector: map[string]string{"pool": "pg-pool"},
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		pod                  *v1.Pod
+		objects              []client.Object
+		expectedTolerations  []v1.Toleration
+		expectedNodeSelector map[string]string
+	}{
+		{
+			name:    "pod without podgroup or queue",
+			pod:     newPod(nil, nil, v1.PodSpec{}),
+			objects: []client.Object{queue, podGroup},
+		},
+		{
+			name:                 "queue constraints by queue label",
+			pod:                  newPod(map[string]string{constants.DefaultQueueLabel: "queue-a"}, nil, v1.PodSpec{}),
+			objects:              []client.Object{queue},
+			expectedTolerations:  []v1.Toleration{teamToleration},
+			expectedNodeSelector: map[string]string{"pool": "queue-pool", "zone": "queue-zone"},
+		},
+		{
+			name: "podgroup takes precedence over queue",
+			pod: newPod(nil, map[string]string{constants.PodGroupAnnotationForPod: "pg"},
+				v1.PodSpec{}),
+			objects:              []client.Object{queue, podGroup},
+			expectedTolerations:  []v1.Toleration{gpuToleration, teamToleration},
+			expectedNodeSelector: map[string]string{"pool": "pg-pool", "zone": "queue-zone"},
+		},
+		{
+			name: "pod takes precedence over podgroup and queue",
+			pod: newPod(nil, map[string]string{constants.PodGroupAnnotationForPod: "pg"}, v1.PodSpec{
+				Tolerations:  []v1.Toleration{podTeamToleration},
+				NodeSelector: map[string]string{"zone": "pod-zone"},
+			}),
+			objects:              []client.Object{queue, podGroup},
+			expectedTolerations:  []v1.Toleration{podTeamToleration, gpuToleration},
+			expectedNodeSelector: map[string]string{"pool": "pg-pool", "zone": "pod-zone"},
+		},
+		{
+			name: "missing podgroup falls back to queue label",
+			pod: newPod(map[string]string{constants.DefaultQueueLabel: "queue-a"},
+				map[string]string{constants.PodGroupAnnotationForPod: "missing"}, v1.PodSpec{}),
+			objects:              []client.Object{queue},
+			expectedTolerations:  []v1.Toleration{teamToleration},
+			expectedNodeSelector: map[string]string{"pool": "queue-pool", "zone": "queue-zone"},
+		},
+		{
+			name:                 "child queue tolerations take precedence over parent queue",
+			pod:                  newPod(map[string]string{constants.DefaultQueueLabel: "queue-a-child"}, nil, v1.PodSpec{}),
+			objects:              []client.Object{childQueue, queue},
+			expectedTolerations:  []v1.Toleration{podTeamToleration},
+			expectedNodeSelector: map[string]string{"zone": "child-zone"},
+		},
+		{
+			name:                 "parent queue tolerations are inherited in a cyclic hierarchy",
+			pod:                  newPod(map[string]string{constants.DefaultQueueLabel: "queue-a-child"}, nil, v1.PodSpec{}),
+			objects:              []client.Object{childQueue, parentQueue},
+			expectedTolerations:  []v1.Toleration{podTeamToleration, gpuToleration},
+			expectedNodeSelector: map[string]string{"zone": "child-zone"},
+		},
+		{
+			name:                 "missing parent queue",
+			pod:                  newPod(map[string]string{constants.DefaultQueueLabel: "queue-a-child"}, nil, v1.PodSpec{}),
+			objects:              []client.Object{childQueue},
+			expectedTolerations:  []v1.Toleration{podTeamToleration},
+			expectedNodeSelector: map[string]string{"zone": "child-zone"},
+		},
+		{
+			name:    "missing queue",
+			pod:     newPod(map[string]string{constants.DefaultQueueLabel: "missing"}, nil, v1.PodSpec{}),
+			objects: []client.Object{queue},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(tt.objects...).Build()
+			plugin := New(kubeClient)
+
+			err := plugin.Mutate(tt.pod)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedTolerations, tt.pod.Spec.Tolerations)
+			assert.Equal(t, tt.expectedNodeSelector, tt.pod.Spec.NodeSelector)
+		})
+	}
+}
+
+func newPod(labels, annotations map[string]string, spec v1.PodSpec) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "pod",
+			Namespace:   "ns",
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: spec,
+	}
+}
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(v2.AddToScheme(scheme))
+	utilruntime.Must(v2alpha2.AddToScheme(scheme))
+	return scheme
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package workloadclass
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	podgrouperconstants "github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/constants"
+)
+
+// WorkloadClass rejects pods that set an invalid workload class, or a workload class that their queue (or its closest
+// ancestor that sets workload class settings) doesn't allow.
+type WorkloadClass struct {
+	kubeClient client.Client
+}
+
+func New(kubeClient client.Client) *WorkloadClass {
+	return &WorkloadClass{
+		kubeClient: kubeClient,
+	}
+}
+
+func (wc *WorkloadClass) Name() string {
+	return "workloadclass"
+}
+
+func (wc *WorkloadClass) Validate(pod *v1.Pod) error {
+	return nil
+}
+
+func (wc *WorkloadClass) Mutate(pod *v1.Pod) error {
+	return nil
+}
+
+// +kubebuilder:rbac:groups=scheduling.run.ai,resources=queues,verbs=get;list;watch
+
+func (wc *WorkloadClass) ValidateCreate(pod *v1.Pod) ([]string, error) {
+	workloadClass, err := v2alpha2.ParseWorkloadClass(pod.Labels[podgrouperconstants.WorkloadClassLabelKey])
+	if err != nil {
+		return nil, err
+	}
+	if workloadClass == "" {
+		return nil, nil
+	}
+
+	queueName := pod.Labels[constants.DefaultQueueLabel]
+	queueWorkloadClasses, err := wc.getQueueWorkloadClasses(context.Background(), queueName)
+	if err != nil {
+		return nil, err
+	}
+	if queueWorkloadClasses == nil || queueWorkloadClasses.IsAllowed(workloadClass) {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("queue %s does not allow workloads of the %s workload class", queueName, workloadClass)
+}
+
+// getQueueWorkloadClasses returns the workload class settings of the queue or of its closest ancestor that sets them
+func (wc *WorkloadClass) getQueueWorkloadClasses(
+	ctx context.Context, queueName string,
+) (*v2.QueueWorkloadClasses, error) {
+	visited := map[string]bool{}
+	for queueName != "" && !visited[queueName] {
+		visited[queueName] = true
+		queue := &v2.Queue{}
+		err := wc.kubeClient.Get(ctx, types.NamespacedName{Name: queueName}, queue)
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get queue %s: %w", queueName, err)
+		}
+		if queue.Spec.WorkloadClasses != nil {
+			return queue.Spec.WorkloadClasses, nil
+		}
+		queueName = queue.Spec.ParentQueue
+	}
+	return nil, nil
+}
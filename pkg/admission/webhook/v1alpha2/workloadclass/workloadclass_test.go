@@ -0,0 +1,90 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package workloadclass
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	podgrouperconstants "github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/constants"
+)
+
+func TestValidateCreate(t *testing.T) {
+	department := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "department"},
+		Spec: v2.QueueSpec{
+			WorkloadClasses: &v2.QueueWorkloadClasses{
+				Default: v2alpha2.BurstableGPU,
+				Allowed: []v2alpha2.WorkloadClass{v2alpha2.BestEffortGPU},
+			},
+		},
+	}
+	team := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "team"},
+		Spec:       v2.QueueSpec{ParentQueue: "department"},
+	}
+	open := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "open"},
+		Spec: v2.QueueSpec{
+			ParentQueue:     "department",
+			WorkloadClasses: &v2.QueueWorkloadClasses{Default: v2alpha2.BestEffortGPU},
+		},
+	}
+	objects := []client.Object{department, team, open}
+
+	tests := []struct {
+		name          string
+		queue         string
+		workloadClass string
+		expectError   bool
+	}{
+		{name: "pod without workload class", queue: "department"},
+		{name: "pod with the default workload class", queue: "department", workloadClass: "burstable-gpu"},
+		{name: "allowed workload class", queue: "department", workloadClass: "best-effort-gpu"},
+		{name: "workload class not allowed", queue: "department", workloadClass: "guaranteed", expectError: true},
+		{name: "workload class not allowed by parent queue", queue: "team", workloadClass: "guaranteed",
+			expectError: true},
+		{name: "all workload classes allowed", queue: "open", workloadClass: "guaranteed"},
+		{name: "missing queue", queue: "missing", workloadClass: "guaranteed"},
+		{name: "invalid workload class", queue: "open", workloadClass: "premium", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(objects...).Build()
+			plugin := New(kubeClient)
+
+			labels := map[string]string{constants.DefaultQueueLabel: tt.queue}
+			if tt.workloadClass != "" {
+				labels[podgrouperconstants.WorkloadClassLabelKey] = tt.workloadClass
+			}
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns", Labels: labels}}
+
+			_, err := plugin.ValidateCreate(pod)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(v2.AddToScheme(scheme))
+	return scheme
+}
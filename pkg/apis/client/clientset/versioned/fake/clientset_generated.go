@@ -23,6 +23,7 @@ SPDX-License-Identifier: Apache-2.0
 package fake
 
 import (
+	applyconfiguration "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/applyconfiguration"
 	clientset "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned"
 	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/typed/kai/v1alpha1"
 	fakekaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/typed/kai/v1alpha1/fake"
@@ -93,6 +94,42 @@ func (c *Clientset) Tracker() testing.ObjectTracker {
 	return c.tracker
 }
 
+// NewClientset returns a clientset that will respond with the provided objects.
+// It's backed by a very simple object tracker that processes creates, updates and deletions as-is,
+// without applying any validations and/or defaults. It shouldn't be considered a replacement
+// for a real clientset and is mostly useful in simple unit tests.
+func NewClientset(objects ...runtime.Object) *Clientset {
+	o := testing.NewFieldManagedObjectTracker(
+		scheme,
+		codecs.UniversalDecoder(),
+		applyconfiguration.NewTypeConverter(scheme),
+	)
+	for _, obj := range objects {
+		if err := o.Add(obj); err != nil {
+			panic(err)
+		}
+	}
+
+	cs := &Clientset{tracker: o}
+	cs.discovery = &fakediscovery.FakeDiscovery{Fake: &cs.Fake}
+	cs.AddReactor("*", "*", testing.ObjectReaction(o))
+	cs.AddWatchReactor("*", func(action testing.Action) (handled bool, ret watch.Interface, err error) {
+		var opts metav1.ListOptions
+		if watchActcion, ok := action.(testing.WatchActionImpl); ok {
+			opts = watchActcion.ListOptions
+		}
+		gvr := action.GetResource()
+		ns := action.GetNamespace()
+		watch, err := o.Watch(gvr, ns, opts)
+		if err != nil {
+			return false, nil, err
+		}
+		return true, watch, nil
+	})
+
+	return cs
+}
+
 var (
 	_ clientset.Interface = &Clientset{}
 	_ testing.FakeClient  = &Clientset{}
@@ -32,6 +32,10 @@ type FakeKaiV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeKaiV1alpha1) SchedulingFreezes() v1alpha1.SchedulingFreezeInterface {
+	return newFakeSchedulingFreezes(c)
+}
+
 func (c *FakeKaiV1alpha1) Topologies() v1alpha1.TopologyInterface {
 	return newFakeTopologies(c)
 }
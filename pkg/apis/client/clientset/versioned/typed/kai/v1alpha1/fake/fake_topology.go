@@ -23,20 +23,21 @@ SPDX-License-Identifier: Apache-2.0
 package fake
 
 import (
-	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/typed/kai/v1alpha1"
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/applyconfiguration/kai/v1alpha1"
+	typedkaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/typed/kai/v1alpha1"
 	v1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
 	gentype "k8s.io/client-go/gentype"
 )
 
 // fakeTopologies implements TopologyInterface
 type fakeTopologies struct {
-	*gentype.FakeClientWithList[*v1alpha1.Topology, *v1alpha1.TopologyList]
+	*gentype.FakeClientWithListAndApply[*v1alpha1.Topology, *v1alpha1.TopologyList, *kaiv1alpha1.TopologyApplyConfiguration]
 	Fake *FakeKaiV1alpha1
 }
 
-func newFakeTopologies(fake *FakeKaiV1alpha1) kaiv1alpha1.TopologyInterface {
+func newFakeTopologies(fake *FakeKaiV1alpha1) typedkaiv1alpha1.TopologyInterface {
 	return &fakeTopologies{
-		gentype.NewFakeClientWithList[*v1alpha1.Topology, *v1alpha1.TopologyList](
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.Topology, *v1alpha1.TopologyList, *kaiv1alpha1.TopologyApplyConfiguration](
 			fake.Fake,
 			"",
 			v1alpha1.SchemeGroupVersion.WithResource("topologies"),
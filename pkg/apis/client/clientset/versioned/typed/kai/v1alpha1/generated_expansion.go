@@ -22,4 +22,6 @@ SPDX-License-Identifier: Apache-2.0
 
 package v1alpha1
 
+type SchedulingFreezeExpansion interface{}
+
 type TopologyExpansion interface{}
@@ -32,6 +32,7 @@ import (
 
 type KaiV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	SchedulingFreezesGetter
 	TopologiesGetter
 }
 
@@ -40,6 +41,10 @@ type KaiV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *KaiV1alpha1Client) SchedulingFreezes() SchedulingFreezeInterface {
+	return newSchedulingFreezes(c)
+}
+
 func (c *KaiV1alpha1Client) Topologies() TopologyInterface {
 	return newTopologies(c)
 }
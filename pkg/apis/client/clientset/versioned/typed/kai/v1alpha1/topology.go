@@ -25,6 +25,7 @@ package v1alpha1
 import (
 	context "context"
 
+	applyconfigurationkaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/applyconfiguration/kai/v1alpha1"
 	scheme "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/scheme"
 	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -49,18 +50,19 @@ type TopologyInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*kaiv1alpha1.TopologyList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *kaiv1alpha1.Topology, err error)
+	Apply(ctx context.Context, topology *applyconfigurationkaiv1alpha1.TopologyApplyConfiguration, opts v1.ApplyOptions) (result *kaiv1alpha1.Topology, err error)
 	TopologyExpansion
 }
 
 // topologies implements TopologyInterface
 type topologies struct {
-	*gentype.ClientWithList[*kaiv1alpha1.Topology, *kaiv1alpha1.TopologyList]
+	*gentype.ClientWithListAndApply[*kaiv1alpha1.Topology, *kaiv1alpha1.TopologyList, *applyconfigurationkaiv1alpha1.TopologyApplyConfiguration]
 }
 
 // newTopologies returns a Topologies
 func newTopologies(c *KaiV1alpha1Client) *topologies {
 	return &topologies{
-		gentype.NewClientWithList[*kaiv1alpha1.Topology, *kaiv1alpha1.TopologyList](
+		gentype.NewClientWithListAndApply[*kaiv1alpha1.Topology, *kaiv1alpha1.TopologyList, *applyconfigurationkaiv1alpha1.TopologyApplyConfiguration](
 			"topologies",
 			c.RESTClient(),
 			scheme.ParameterCodec,
@@ -9,6 +9,7 @@ package v1alpha2
 import (
 	context "context"
 
+	applyconfigurationschedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/applyconfiguration/scheduling/v1alpha2"
 	scheme "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/scheme"
 	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -35,18 +36,21 @@ type BindRequestInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*schedulingv1alpha2.BindRequestList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *schedulingv1alpha2.BindRequest, err error)
+	Apply(ctx context.Context, bindRequest *applyconfigurationschedulingv1alpha2.BindRequestApplyConfiguration, opts v1.ApplyOptions) (result *schedulingv1alpha2.BindRequest, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, bindRequest *applyconfigurationschedulingv1alpha2.BindRequestApplyConfiguration, opts v1.ApplyOptions) (result *schedulingv1alpha2.BindRequest, err error)
 	BindRequestExpansion
 }
 
 // bindRequests implements BindRequestInterface
 type bindRequests struct {
-	*gentype.ClientWithList[*schedulingv1alpha2.BindRequest, *schedulingv1alpha2.BindRequestList]
+	*gentype.ClientWithListAndApply[*schedulingv1alpha2.BindRequest, *schedulingv1alpha2.BindRequestList, *applyconfigurationschedulingv1alpha2.BindRequestApplyConfiguration]
 }
 
 // newBindRequests returns a BindRequests
 func newBindRequests(c *SchedulingV1alpha2Client, namespace string) *bindRequests {
 	return &bindRequests{
-		gentype.NewClientWithList[*schedulingv1alpha2.BindRequest, *schedulingv1alpha2.BindRequestList](
+		gentype.NewClientWithListAndApply[*schedulingv1alpha2.BindRequest, *schedulingv1alpha2.BindRequestList, *applyconfigurationschedulingv1alpha2.BindRequestApplyConfiguration](
 			"bindrequests",
 			c.RESTClient(),
 			scheme.ParameterCodec,
@@ -7,20 +7,21 @@ SPDX-License-Identifier: Apache-2.0
 package fake
 
 import (
-	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/typed/scheduling/v1alpha2"
+	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/applyconfiguration/scheduling/v1alpha2"
+	typedschedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/typed/scheduling/v1alpha2"
 	v1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	gentype "k8s.io/client-go/gentype"
 )
 
 // fakeBindRequests implements BindRequestInterface
 type fakeBindRequests struct {
-	*gentype.FakeClientWithList[*v1alpha2.BindRequest, *v1alpha2.BindRequestList]
+	*gentype.FakeClientWithListAndApply[*v1alpha2.BindRequest, *v1alpha2.BindRequestList, *schedulingv1alpha2.BindRequestApplyConfiguration]
 	Fake *FakeSchedulingV1alpha2
 }
 
-func newFakeBindRequests(fake *FakeSchedulingV1alpha2, namespace string) schedulingv1alpha2.BindRequestInterface {
+func newFakeBindRequests(fake *FakeSchedulingV1alpha2, namespace string) typedschedulingv1alpha2.BindRequestInterface {
 	return &fakeBindRequests{
-		gentype.NewFakeClientWithList[*v1alpha2.BindRequest, *v1alpha2.BindRequestList](
+		gentype.NewFakeClientWithListAndApply[*v1alpha2.BindRequest, *v1alpha2.BindRequestList, *schedulingv1alpha2.BindRequestApplyConfiguration](
 			fake.Fake,
 			namespace,
 			v1alpha2.SchemeGroupVersion.WithResource("bindrequests"),
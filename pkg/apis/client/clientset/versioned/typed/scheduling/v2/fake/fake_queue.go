@@ -23,20 +23,21 @@ SPDX-License-Identifier: Apache-2.0
 package fake
 
 import (
-	schedulingv2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/typed/scheduling/v2"
+	schedulingv2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/applyconfiguration/scheduling/v2"
+	typedschedulingv2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/typed/scheduling/v2"
 	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 	gentype "k8s.io/client-go/gentype"
 )
 
 // fakeQueues implements QueueInterface
 type fakeQueues struct {
-	*gentype.FakeClientWithList[*v2.Queue, *v2.QueueList]
+	*gentype.FakeClientWithListAndApply[*v2.Queue, *v2.QueueList, *schedulingv2.QueueApplyConfiguration]
 	Fake *FakeSchedulingV2
 }
 
-func newFakeQueues(fake *FakeSchedulingV2, namespace string) schedulingv2.QueueInterface {
+func newFakeQueues(fake *FakeSchedulingV2, namespace string) typedschedulingv2.QueueInterface {
 	return &fakeQueues{
-		gentype.NewFakeClientWithList[*v2.Queue, *v2.QueueList](
+		gentype.NewFakeClientWithListAndApply[*v2.Queue, *v2.QueueList, *schedulingv2.QueueApplyConfiguration](
 			fake.Fake,
 			namespace,
 			v2.SchemeGroupVersion.WithResource("queues"),
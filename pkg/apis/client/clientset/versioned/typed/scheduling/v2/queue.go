@@ -25,6 +25,7 @@ package v2
 import (
 	context "context"
 
+	applyconfigurationschedulingv2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/applyconfiguration/scheduling/v2"
 	scheme "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/scheme"
 	schedulingv2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -51,18 +52,21 @@ type QueueInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*schedulingv2.QueueList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *schedulingv2.Queue, err error)
+	Apply(ctx context.Context, queue *applyconfigurationschedulingv2.QueueApplyConfiguration, opts v1.ApplyOptions) (result *schedulingv2.Queue, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, queue *applyconfigurationschedulingv2.QueueApplyConfiguration, opts v1.ApplyOptions) (result *schedulingv2.Queue, err error)
 	QueueExpansion
 }
 
 // queues implements QueueInterface
 type queues struct {
-	*gentype.ClientWithList[*schedulingv2.Queue, *schedulingv2.QueueList]
+	*gentype.ClientWithListAndApply[*schedulingv2.Queue, *schedulingv2.QueueList, *applyconfigurationschedulingv2.QueueApplyConfiguration]
 }
 
 // newQueues returns a Queues
 func newQueues(c *SchedulingV2Client, namespace string) *queues {
 	return &queues{
-		gentype.NewClientWithList[*schedulingv2.Queue, *schedulingv2.QueueList](
+		gentype.NewClientWithListAndApply[*schedulingv2.Queue, *schedulingv2.QueueList, *applyconfigurationschedulingv2.QueueApplyConfiguration](
 			"queues",
 			c.RESTClient(),
 			scheme.ParameterCodec,
@@ -23,20 +23,21 @@ SPDX-License-Identifier: Apache-2.0
 package fake
 
 import (
-	schedulingv2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/typed/scheduling/v2alpha2"
+	schedulingv2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/applyconfiguration/scheduling/v2alpha2"
+	typedschedulingv2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/typed/scheduling/v2alpha2"
 	v2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	gentype "k8s.io/client-go/gentype"
 )
 
 // fakePodGroups implements PodGroupInterface
 type fakePodGroups struct {
-	*gentype.FakeClientWithList[*v2alpha2.PodGroup, *v2alpha2.PodGroupList]
+	*gentype.FakeClientWithListAndApply[*v2alpha2.PodGroup, *v2alpha2.PodGroupList, *schedulingv2alpha2.PodGroupApplyConfiguration]
 	Fake *FakeSchedulingV2alpha2
 }
 
-func newFakePodGroups(fake *FakeSchedulingV2alpha2, namespace string) schedulingv2alpha2.PodGroupInterface {
+func newFakePodGroups(fake *FakeSchedulingV2alpha2, namespace string) typedschedulingv2alpha2.PodGroupInterface {
 	return &fakePodGroups{
-		gentype.NewFakeClientWithList[*v2alpha2.PodGroup, *v2alpha2.PodGroupList](
+		gentype.NewFakeClientWithListAndApply[*v2alpha2.PodGroup, *v2alpha2.PodGroupList, *schedulingv2alpha2.PodGroupApplyConfiguration](
 			fake.Fake,
 			namespace,
 			v2alpha2.SchemeGroupVersion.WithResource("podgroups"),
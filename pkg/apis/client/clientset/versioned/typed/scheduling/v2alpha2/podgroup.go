@@ -25,6 +25,7 @@ package v2alpha2
 import (
 	context "context"
 
+	applyconfigurationschedulingv2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/applyconfiguration/scheduling/v2alpha2"
 	scheme "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/scheme"
 	schedulingv2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -51,18 +52,21 @@ type PodGroupInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*schedulingv2alpha2.PodGroupList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *schedulingv2alpha2.PodGroup, err error)
+	Apply(ctx context.Context, podGroup *applyconfigurationschedulingv2alpha2.PodGroupApplyConfiguration, opts v1.ApplyOptions) (result *schedulingv2alpha2.PodGroup, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, podGroup *applyconfigurationschedulingv2alpha2.PodGroupApplyConfiguration, opts v1.ApplyOptions) (result *schedulingv2alpha2.PodGroup, err error)
 	PodGroupExpansion
 }
 
 // podGroups implements PodGroupInterface
 type podGroups struct {
-	*gentype.ClientWithList[*schedulingv2alpha2.PodGroup, *schedulingv2alpha2.PodGroupList]
+	*gentype.ClientWithListAndApply[*schedulingv2alpha2.PodGroup, *schedulingv2alpha2.PodGroupList, *applyconfigurationschedulingv2alpha2.PodGroupApplyConfiguration]
 }
 
 // newPodGroups returns a PodGroups
 func newPodGroups(c *SchedulingV2alpha2Client, namespace string) *podGroups {
 	return &podGroups{
-		gentype.NewClientWithList[*schedulingv2alpha2.PodGroup, *schedulingv2alpha2.PodGroupList](
+		gentype.NewClientWithListAndApply[*schedulingv2alpha2.PodGroup, *schedulingv2alpha2.PodGroupList, *applyconfigurationschedulingv2alpha2.PodGroupApplyConfiguration](
 			"podgroups",
 			c.RESTClient(),
 			scheme.ParameterCodec,
@@ -28,6 +28,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// SchedulingFreezes returns a SchedulingFreezeInformer.
+	SchedulingFreezes() SchedulingFreezeInformer
 	// Topologies returns a TopologyInformer.
 	Topologies() TopologyInformer
 }
@@ -43,6 +45,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// SchedulingFreezes returns a SchedulingFreezeInformer.
+func (v *version) SchedulingFreezes() SchedulingFreezeInformer {
+	return &schedulingFreezeInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Topologies returns a TopologyInformer.
 func (v *version) Topologies() TopologyInformer {
 	return &topologyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
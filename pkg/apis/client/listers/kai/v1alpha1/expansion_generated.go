@@ -22,6 +22,10 @@ SPDX-License-Identifier: Apache-2.0
 
 package v1alpha1
 
+// SchedulingFreezeListerExpansion allows custom methods to be added to
+// SchedulingFreezeLister.
+type SchedulingFreezeListerExpansion interface{}
+
 // TopologyListerExpansion allows custom methods to be added to
 // TopologyLister.
 type TopologyListerExpansion interface{}
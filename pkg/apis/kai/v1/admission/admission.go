@@ -15,6 +15,8 @@ const (
 	imageName                    = "admission"
 	defaultValidatingWebhookName = "validating-kai-admission"
 	defaultMutatingWebhookName   = "mutating-kai-admission"
+
+	defaultNodeCapacityValidation = "warn"
 )
 
 type Admission struct {
@@ -48,6 +50,12 @@ type Admission struct {
 	// set to empty string to disable
 	// +kubebuilder:validation:Optional
 	GPUPodRuntimeClassName *string `json:"gpuPodRuntimeClassName,omitempty"`
+
+	// NodeCapacityValidation specifies how pods requesting more resources than available in any single node of
+	// their node pool are handled: disabled, warn (admit the pod with a warning) or reject
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=disabled;warn;reject
+	NodeCapacityValidation *string `json:"nodeCapacityValidation,omitempty"`
 }
 
 func (b *Admission) SetDefaultsWhereNeeded(replicaCount *int32) {
@@ -68,6 +76,7 @@ func (b *Admission) SetDefaultsWhereNeeded(replicaCount *int32) {
 	b.MutatingWebhookConfigurationName = common.SetDefault(b.MutatingWebhookConfigurationName, ptr.To(defaultMutatingWebhookName))
 
 	b.GPUPodRuntimeClassName = common.SetDefault(b.GPUPodRuntimeClassName, ptr.To(constants.DefaultRuntimeClassName))
+	b.NodeCapacityValidation = common.SetDefault(b.NodeCapacityValidation, ptr.To(defaultNodeCapacityValidation))
 }
 
 // Webhook defines configuration for the admission webhook
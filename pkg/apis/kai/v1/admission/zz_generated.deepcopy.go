@@ -56,6 +56,11 @@ func (in *Admission) DeepCopyInto(out *Admission) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.NodeCapacityValidation != nil {
+		in, out := &in.NodeCapacityValidation, &out.NodeCapacityValidation
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Admission.
@@ -47,6 +47,33 @@ type Binder struct {
 	// leave empty if unsure to let the operator auto detect using ClusterPolicy (nvidia gpu-operator only)
 	// +kubebuilder:validation:Optional
 	CDIEnabled *bool `json:"cdiEnabled,omitempty"`
+
+	// BindTimeSecrets maps node pools to the secret that is filled on bind in the secret named by the
+	// kai.scheduler/bind-time-secret annotation of pods bound to their nodes
+	// +kubebuilder:validation:Optional
+	BindTimeSecrets map[string]BindTimeSecret `json:"bindTimeSecrets,omitempty"`
+}
+
+// BindTimeSecret configures the bind-time secret of the pods bound to the nodes of a node pool
+type BindTimeSecret struct {
+	// Secret is the <namespace>/<name> of the secret whose data is copied, such as an accelerator license token
+	// +kubebuilder:validation:Optional
+	Secret string `json:"secret,omitempty"`
+
+	// Namespaces are the namespaces whose pods may receive the bind-time secret. The binder may only read the source
+	// secret, and create secrets and service account tokens in these namespaces
+	// +kubebuilder:validation:MinItems=1
+	Namespaces []string `json:"namespaces"`
+
+	// ServiceAccountTokenAudience adds a token of the pod's service account for the audience, bound to the pod, to the
+	// bind-time secret
+	// +kubebuilder:validation:Optional
+	ServiceAccountTokenAudience string `json:"serviceAccountTokenAudience,omitempty"`
+
+	// ServiceAccountTokenExpirationSeconds is the lifetime of the service account token, one hour by default
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=600
+	ServiceAccountTokenExpirationSeconds *int64 `json:"serviceAccountTokenExpirationSeconds,omitempty"`
 }
 
 func (b *Binder) SetDefaultsWhereNeeded(replicaCount *int32) {
@@ -88,6 +115,11 @@ type ResourceReservation struct {
 	// +kubebuilder:validation:Optional
 	Image *common.Image `json:"image,omitempty"`
 
+	// ArchImages are the images used by the resource reservation pods on nodes of specific architectures, keyed by
+	// the node's kubernetes.io/arch label (e.g. arm64). Nodes of other architectures use Image
+	// +kubebuilder:validation:Optional
+	ArchImages map[string]*common.Image `json:"archImages,omitempty"`
+
 	// AllocationTimeout specifies the timeout for resource reservation pod allocation in seconds
 	// +kubebuilder:validation:Optional
 	AllocationTimeout *int `json:"allocationTimeout,omitempty"`
@@ -111,12 +143,28 @@ type ResourceReservation struct {
 	// If not set, Kubernetes defaults will be used, which allows for better backward compatibility.
 	// +kubebuilder:validation:Optional
 	PodResources *common.Resources `json:"podResources,omitempty"`
+
+	// GPUBindClaims enables claiming the whole GPUs of a pod group's pods with reservation pods until the pods are
+	// bound, protecting them from other schedulers during the bind window
+	// +kubebuilder:validation:Optional
+	GPUBindClaims *bool `json:"gpuBindClaims,omitempty"`
+
+	// GPUSharingReleaseFinalizer enables a finalizer on GPU sharing pods that keeps them until their GPU reservation is
+	// released after they terminate, so that their share of the GPU isn't seen as free while it is still held
+	// +kubebuilder:validation:Optional
+	GPUSharingReleaseFinalizer *bool `json:"gpuSharingReleaseFinalizer,omitempty"`
 }
 
 func (r *ResourceReservation) SetDefaultsWhereNeeded() {
 	r.Image = common.SetDefault(r.Image, &common.Image{})
 	r.Image.Name = common.SetDefault(r.Image.Name, ptr.To(defaultResourceReservationImageName))
 	r.Image.SetDefaultsWhereNeeded()
+	for arch, image := range r.ArchImages {
+		image = common.SetDefault(image, &common.Image{})
+		image.Name = common.SetDefault(image.Name, ptr.To(defaultResourceReservationImageName))
+		image.SetDefaultsWhereNeeded()
+		r.ArchImages[arch] = image
+	}
 
 	r.Namespace = common.SetDefault(r.Namespace, ptr.To(constants.DefaultResourceReservationName))
 	r.ServiceAccountName = common.SetDefault(r.ServiceAccountName, ptr.To(constants.DefaultResourceReservationName))
@@ -13,6 +13,31 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/common"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BindTimeSecret) DeepCopyInto(out *BindTimeSecret) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceAccountTokenExpirationSeconds != nil {
+		in, out := &in.ServiceAccountTokenExpirationSeconds, &out.ServiceAccountTokenExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BindTimeSecret.
+func (in *BindTimeSecret) DeepCopy() *BindTimeSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(BindTimeSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Binder) DeepCopyInto(out *Binder) {
 	*out = *in
@@ -56,6 +81,13 @@ func (in *Binder) DeepCopyInto(out *Binder) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.BindTimeSecrets != nil {
+		in, out := &in.BindTimeSecrets, &out.BindTimeSecrets
+		*out = make(map[string]BindTimeSecret, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Binder.
@@ -76,6 +108,22 @@ func (in *ResourceReservation) DeepCopyInto(out *ResourceReservation) {
 		*out = new(common.Image)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ArchImages != nil {
+		in, out := &in.ArchImages, &out.ArchImages
+		*out = make(map[string]*common.Image, len(*in))
+		for key, val := range *in {
+			var outVal *common.Image
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(common.Image)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.AllocationTimeout != nil {
 		in, out := &in.AllocationTimeout, &out.AllocationTimeout
 		*out = new(int)
@@ -106,6 +154,16 @@ func (in *ResourceReservation) DeepCopyInto(out *ResourceReservation) {
 		*out = new(common.Resources)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GPUBindClaims != nil {
+		in, out := &in.GPUBindClaims, &out.GPUBindClaims
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GPUSharingReleaseFinalizer != nil {
+		in, out := &in.GPUSharingReleaseFinalizer, &out.GPUSharingReleaseFinalizer
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReservation.
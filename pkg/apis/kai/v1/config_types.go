@@ -7,6 +7,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/admission"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/binder"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/common"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/event_aggregator"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/node_scale_adjuster"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/pod_group_controller"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/pod_grouper"
@@ -83,6 +84,10 @@ type ConfigSpec struct {
 	// Prometheus specifies configuration for Prometheus monitoring
 	// +kubebuilder:validation:Optional
 	Prometheus *prometheus.Prometheus `json:"prometheus,omitempty"`
+
+	// EventAggregator specifies configuration for the event-aggregator, which streams scheduling events
+	// +kubebuilder:validation:Optional
+	EventAggregator *event_aggregator.EventAggregator `json:"eventAggregator,omitempty"`
 }
 
 func (c *ConfigSpec) SetDefaultsWhereNeeded() {
@@ -115,6 +120,9 @@ func (c *ConfigSpec) SetDefaultsWhereNeeded() {
 
 	c.Prometheus = common.SetDefault(c.Prometheus, &prometheus.Prometheus{})
 	c.Prometheus.SetDefaultsWhereNeeded()
+
+	c.EventAggregator = common.SetDefault(c.EventAggregator, &event_aggregator.EventAggregator{})
+	c.EventAggregator.SetDefaultsWhereNeeded()
 }
 
 // ConfigStatus defines the observed state of Config
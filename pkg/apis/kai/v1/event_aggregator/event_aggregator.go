@@ -0,0 +1,65 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+// +kubebuilder:object:generate:=true
+package event_aggregator
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/common"
+)
+
+const (
+	imageName = "eventaggregator"
+)
+
+type EventAggregator struct {
+	Service *common.Service `json:"service,omitempty"`
+
+	// Args specifies the CLI arguments for the event-aggregator
+	// +kubebuilder:validation:Optional
+	Args *Args `json:"args,omitempty"`
+}
+
+// Args specifies the CLI arguments for the event-aggregator
+type Args struct {
+	// Port is the port the event stream is served on
+	// +kubebuilder:validation:Optional
+	Port *int `json:"port,omitempty"`
+
+	// BufferSize is the number of events buffered for each client before events are dropped for it
+	// +kubebuilder:validation:Optional
+	BufferSize *int `json:"bufferSize,omitempty"`
+}
+
+// SetDefaultsWhereNeeded sets default for unset fields
+func (args *Args) SetDefaultsWhereNeeded() {
+	args.Port = common.SetDefault(args.Port, ptr.To(8080))
+	args.BufferSize = common.SetDefault(args.BufferSize, ptr.To(100))
+}
+
+// SetDefaultsWhereNeeded sets default for unset fields. The event-aggregator is not deployed unless enabled.
+func (ea *EventAggregator) SetDefaultsWhereNeeded() {
+	ea.Service = common.SetDefault(ea.Service, &common.Service{})
+	ea.Service.Enabled = common.SetDefault(ea.Service.Enabled, ptr.To(false))
+	ea.Service.SetDefaultsWhereNeeded(imageName)
+
+	if _, found := ea.Service.Resources.Requests[v1.ResourceCPU]; !found {
+		ea.Service.Resources.Requests[v1.ResourceCPU] = resource.MustParse("20m")
+	}
+	if _, found := ea.Service.Resources.Requests[v1.ResourceMemory]; !found {
+		ea.Service.Resources.Requests[v1.ResourceMemory] = resource.MustParse("100Mi")
+	}
+	if _, found := ea.Service.Resources.Limits[v1.ResourceCPU]; !found {
+		ea.Service.Resources.Limits[v1.ResourceCPU] = resource.MustParse("500m")
+	}
+	if _, found := ea.Service.Resources.Limits[v1.ResourceMemory]; !found {
+		ea.Service.Resources.Limits[v1.ResourceMemory] = resource.MustParse("256Mi")
+	}
+
+	ea.Args = common.SetDefault(ea.Args, &Args{})
+	ea.Args.SetDefaultsWhereNeeded()
+}
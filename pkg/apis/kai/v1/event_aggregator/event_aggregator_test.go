@@ -0,0 +1,36 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package event_aggregator
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/common"
+)
+
+func TestEventAggregator(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "EventAggregator type suite")
+}
+
+var _ = Describe("EventAggregator", func() {
+	It("Set Defaults", func(ctx context.Context) {
+		aggregator := &EventAggregator{}
+		aggregator.SetDefaultsWhereNeeded()
+		Expect(*aggregator.Service.Enabled).To(Equal(false))
+		Expect(*aggregator.Service.Image.Name).To(Equal(imageName))
+		Expect(*aggregator.Args.Port).To(Equal(8080))
+	})
+
+	It("Keeps the service enabled", func(ctx context.Context) {
+		aggregator := &EventAggregator{Service: &common.Service{Enabled: ptr.To(true)}}
+		aggregator.SetDefaultsWhereNeeded()
+		Expect(*aggregator.Service.Enabled).To(Equal(true))
+	})
+})
@@ -35,6 +35,11 @@ type PodGroupController struct {
 	// Replicas specifies the number podgroup controller replicas
 	// +kubebuilder:validation:Optional
 	Replicas *int32 `json:"replicas,omitempty"`
+
+	// FallbackNodePool specifies the node pool that pending pod groups are moved to when their node pool has no
+	// ready nodes
+	// +kubebuilder:validation:Optional
+	FallbackNodePool *string `json:"fallbackNodePool,omitempty"`
 }
 
 func (pg *PodGroupController) SetDefaultsWhereNeeded(replicaCount *int32) {
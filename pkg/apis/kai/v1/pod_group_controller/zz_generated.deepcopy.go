@@ -41,6 +41,11 @@ func (in *PodGroupController) DeepCopyInto(out *PodGroupController) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.FallbackNodePool != nil {
+		in, out := &in.FallbackNodePool, &out.FallbackNodePool
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodGroupController.
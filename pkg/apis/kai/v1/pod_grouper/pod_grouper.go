@@ -41,6 +41,26 @@ type Args struct {
 	// +kubebuilder:validation:Optional
 	GangScheduleKnative *bool `json:"gangScheduleKnative,omitempty"`
 
+	// KueueWorkloads specifies whether pod groups of jobs admitted by Kueue follow the admission of their Kueue
+	// workloads: the queue named after the admitting ClusterQueue and the min members of the workload's pod sets
+	// +kubebuilder:validation:Optional
+	KueueWorkloads *bool `json:"kueueWorkloads,omitempty"`
+
+	// PriorityAssignmentRules specifies whether the priority class of pod groups is derived from the
+	// PriorityAssignmentRules of the cluster
+	// +kubebuilder:validation:Optional
+	PriorityAssignmentRules *bool `json:"priorityAssignmentRules,omitempty"`
+
+	// CoschedulingPodGroups specifies whether pods labeled with a scheduler-plugins coscheduling PodGroup are gang
+	// scheduled in a pod group of the same name, and the status of the coscheduling PodGroups is kept in sync
+	// +kubebuilder:validation:Optional
+	CoschedulingPodGroups *bool `json:"coschedulingPodGroups,omitempty"`
+
+	// SubGroupLabelKey specifies a pod label key whose values the subgroups of pod groups are derived from, when
+	// their workloads don't define subgroups
+	// +kubebuilder:validation:Optional
+	SubGroupLabelKey *string `json:"subGroupLabelKey,omitempty"`
+
 	// DefaultPrioritiesConfigMapName The name of the configmap that contains default priorities for pod groups
 	// +kubebuilder:validation:Optional
 	DefaultPrioritiesConfigMapName *string `json:"defaultPrioritiesConfigMapName,omitempty"`
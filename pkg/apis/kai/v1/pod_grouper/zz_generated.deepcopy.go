@@ -21,6 +21,26 @@ func (in *Args) DeepCopyInto(out *Args) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.KueueWorkloads != nil {
+		in, out := &in.KueueWorkloads, &out.KueueWorkloads
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PriorityAssignmentRules != nil {
+		in, out := &in.PriorityAssignmentRules, &out.PriorityAssignmentRules
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CoschedulingPodGroups != nil {
+		in, out := &in.CoschedulingPodGroups, &out.CoschedulingPodGroups
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SubGroupLabelKey != nil {
+		in, out := &in.SubGroupLabelKey, &out.SubGroupLabelKey
+		*out = new(string)
+		**out = **in
+	}
 	if in.DefaultPrioritiesConfigMapName != nil {
 		in, out := &in.DefaultPrioritiesConfigMapName, &out.DefaultPrioritiesConfigMapName
 		*out = new(string)
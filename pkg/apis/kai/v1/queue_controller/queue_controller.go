@@ -42,6 +42,21 @@ type QueueController struct {
 	// QueueLabelToDefaultMetricValue maps queue label keys to default metric values when the label is absent
 	// +kubebuilder:validation:Optional
 	QueueLabelToDefaultMetricValue *string `json:"queueLabelToDefaultMetricValue,omitempty"`
+
+	// EnableNamespaceQueues creates and syncs a leaf queue for every namespace annotated with kai.scheduler/auto-queue=true
+	// +kubebuilder:validation:Optional
+	EnableNamespaceQueues *bool `json:"enableNamespaceQueues,omitempty"`
+
+	// EnableNamespacedQueues syncs a cluster-scoped leaf queue for every NamespacedQueue, and validates NamespacedQueues
+	// against the bounds of their parent queue
+	// +kubebuilder:validation:Optional
+	EnableNamespacedQueues *bool `json:"enableNamespacedQueues,omitempty"`
+
+	// ReconciliationShards splits the reconciliation of queues between the replicas of the queue controller by queue
+	// hierarchy subtree. Every shard is reconciled by the replica holding its lease
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	ReconciliationShards *int32 `json:"reconciliationShards,omitempty"`
 }
 
 func (q *QueueController) SetDefaultsWhereNeeded(replicaCount *int32) {
@@ -81,6 +81,21 @@ func (in *QueueController) DeepCopyInto(out *QueueController) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.EnableNamespaceQueues != nil {
+		in, out := &in.EnableNamespaceQueues, &out.EnableNamespaceQueues
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableNamespacedQueues != nil {
+		in, out := &in.EnableNamespacedQueues, &out.EnableNamespacedQueues
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReconciliationShards != nil {
+		in, out := &in.ReconciliationShards, &out.ReconciliationShards
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueController.
@@ -22,6 +22,7 @@ import (
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/common"
 	usagedbapi "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache/usagedb/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
 )
 
 const (
@@ -56,6 +57,11 @@ type SchedulingShardSpec struct {
 	// +kubebuilder:validation:Optional
 	QueueDepthPerAction map[string]int `json:"queueDepthPerAction,omitempty"`
 
+	// ActionPeriods is the minimal interval between runs of an action, e.g. {"consolidation": "5m"}. Actions without
+	// a period run every scheduling cycle.
+	// +kubebuilder:validation:Optional
+	ActionPeriods map[string]metav1.Duration `json:"actionPeriods,omitempty"`
+
 	// MinRuntime specifies the minimum runtime of a jobs in the shard
 	// +kubebuilder:validation:Optional
 	MinRuntime *MinRuntime `json:"minRuntime,omitempty"`
@@ -67,6 +73,36 @@ type SchedulingShardSpec struct {
 	// UsageDBConfig defines configuration for the usage db client
 	// +kubebuilder:validation:Optional
 	UsageDBConfig *usagedbapi.UsageDBConfig `yaml:"usageDBConfig,omitempty" json:"usageDBConfig,omitempty"`
+
+	// GangSizeLanes splits the jobs tried by the allocate action into lanes by gang size, each with its own budget
+	// of allocation attempts per cycle
+	// +kubebuilder:validation:Optional
+	GangSizeLanes []conf.GangSizeLane `json:"gangSizeLanes,omitempty"`
+
+	// EvictionBudgets limits the pods and GPU hours that the preempt and reclaim actions may evict in a scheduling
+	// cycle, in total and per queue, e.g. {"reclaim": {"maxPods": 10}}
+	// +kubebuilder:validation:Optional
+	EvictionBudgets map[string]conf.EvictionBudget `json:"evictionBudgets,omitempty"`
+
+	// Scavenging lets preemptible jobs of some priority classes that exceed the limit of their queue run under a
+	// cluster-wide scavenger queue
+	// +kubebuilder:validation:Optional
+	Scavenging *conf.Scavenging `json:"scavenging,omitempty"`
+
+	// Shadow is a second configuration of actions and plugins that the scheduler evaluates on every scheduling cycle
+	// without acting on its decisions, and exports its differences from the decisions of the shard as metrics
+	// +kubebuilder:validation:Optional
+	Shadow *conf.ShadowConfiguration `json:"shadow,omitempty"`
+
+	// EventTriggers defines the cluster events that trigger a micro-cycle, which runs the allocate action for the
+	// queues affected by the events without waiting for the next scheduling cycle
+	// +kubebuilder:validation:Optional
+	EventTriggers *conf.EventTriggers `json:"eventTriggers,omitempty"`
+
+	// NodePoolSelector labels the nodes that match it into the node pool of the shard, with the node pool label and the
+	// partition label value of the shard, and removes the label from the nodes it labeled once they no longer match
+	// +kubebuilder:validation:Optional
+	NodePoolSelector *NodePoolSelector `json:"nodePoolSelector,omitempty"`
 }
 
 func (s *SchedulingShardSpec) SetDefaultsWhereNeeded() {
@@ -84,15 +120,64 @@ type MinRuntime struct {
 	ReclaimMinRuntime *string `json:"reclaimMinRuntime,omitempty"`
 }
 
+// NodePoolSelector selects nodes by the labels that GPU feature discovery, node feature discovery and cloud providers
+// set on them. A node matches when it matches every field that is set, and any of the values of a field.
+type NodePoolSelector struct {
+	// GPUProducts are values of the nvidia.com/gpu.product label, e.g. NVIDIA-H100-80GB-HBM3
+	// +kubebuilder:validation:Optional
+	GPUProducts []string `json:"gpuProducts,omitempty"`
+
+	// InstanceFamilies are families of the node.kubernetes.io/instance-type label, which is the instance type up to its
+	// first '.' or '-', e.g. p5 for p5.48xlarge or a3 for a3-highgpu-8g
+	// +kubebuilder:validation:Optional
+	InstanceFamilies []string `json:"instanceFamilies,omitempty"`
+
+	// MatchLabels are other labels that the nodes must have, e.g. the interconnect labels of node feature discovery
+	// +kubebuilder:validation:Optional
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+}
+
 // PlacementStrategy defines the scheduling strategy of NodePool
 type PlacementStrategy struct {
-	// GPU scheduling strategy (binpack/spread)
+	// GPU scheduling strategy (binpack/spread/auto). The auto strategy switches between binpack and spread according
+	// to the GPU fragmentation of the node pool and the pending whole GPU pods that don't fit in any node
 	// +kubebuilder:validation:Optional
 	GPU *string `json:"gpu,omitempty"`
 
 	// CPU scheduling strategy (binpack/spread)
 	// +kubebuilder:validation:Optional
 	CPU *string `json:"cpu,omitempty"`
+
+	// Auto configures the hysteresis of the auto GPU scheduling strategy
+	// +kubebuilder:validation:Optional
+	Auto *AutoPlacementStrategy `json:"auto,omitempty"`
+
+	// WorkloadClasses are the GPU scheduling strategies (binpack/spread) of the workloads of a workload class, by
+	// workload class. Workloads of other classes use the GPU scheduling strategy
+	// +kubebuilder:validation:Optional
+	WorkloadClasses map[string]string `json:"workloadClasses,omitempty"`
+}
+
+// AutoPlacementStrategy configures when the auto GPU scheduling strategy switches between binpack and spread. The GPU
+// fragmentation is the share of the non-allocated GPUs that are on nodes with allocated GPUs.
+type AutoPlacementStrategy struct {
+	// FragmentationHigh is the GPU fragmentation from which spread is switched to binpack. Default is 0.5.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1
+	FragmentationHigh *float64 `json:"fragmentationHigh,omitempty"`
+
+	// FragmentationLow is the GPU fragmentation below which binpack is switched back to spread. Default is 0.2.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1
+	FragmentationLow *float64 `json:"fragmentationLow,omitempty"`
+
+	// MinSessionsInStrategy is the number of scheduling cycles a strategy is kept before it can be switched again.
+	// Default is 10.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MinSessionsInStrategy *int `json:"minSessionsInStrategy,omitempty"`
 }
 
 func (p *PlacementStrategy) SetDefaultWhereNeeded() {
@@ -12,17 +12,49 @@ package v1
 import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/admission"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/binder"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/event_aggregator"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/node_scale_adjuster"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/pod_group_controller"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/pod_grouper"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/prometheus"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/queue_controller"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/scheduler"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoPlacementStrategy) DeepCopyInto(out *AutoPlacementStrategy) {
+	*out = *in
+	if in.FragmentationHigh != nil {
+		in, out := &in.FragmentationHigh, &out.FragmentationHigh
+		*out = new(float64)
+		**out = **in
+	}
+	if in.FragmentationLow != nil {
+		in, out := &in.FragmentationLow, &out.FragmentationLow
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinSessionsInStrategy != nil {
+		in, out := &in.MinSessionsInStrategy, &out.MinSessionsInStrategy
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoPlacementStrategy.
+func (in *AutoPlacementStrategy) DeepCopy() *AutoPlacementStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoPlacementStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Config) DeepCopyInto(out *Config) {
 	*out = *in
@@ -130,6 +162,11 @@ func (in *ConfigSpec) DeepCopyInto(out *ConfigSpec) {
 		*out = new(prometheus.Prometheus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EventAggregator != nil {
+		in, out := &in.EventAggregator, &out.EventAggregator
+		*out = new(event_aggregator.EventAggregator)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigSpec.
@@ -307,6 +344,38 @@ func (in *MinRuntime) DeepCopy() *MinRuntime {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolSelector) DeepCopyInto(out *NodePoolSelector) {
+	*out = *in
+	if in.GPUProducts != nil {
+		in, out := &in.GPUProducts, &out.GPUProducts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstanceFamilies != nil {
+		in, out := &in.InstanceFamilies, &out.InstanceFamilies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MatchLabels != nil {
+		in, out := &in.MatchLabels, &out.MatchLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolSelector.
+func (in *NodePoolSelector) DeepCopy() *NodePoolSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlacementStrategy) DeepCopyInto(out *PlacementStrategy) {
 	*out = *in
@@ -320,6 +389,18 @@ func (in *PlacementStrategy) DeepCopyInto(out *PlacementStrategy) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Auto != nil {
+		in, out := &in.Auto, &out.Auto
+		*out = new(AutoPlacementStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadClasses != nil {
+		in, out := &in.WorkloadClasses, &out.WorkloadClasses
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementStrategy.
@@ -413,6 +494,13 @@ func (in *SchedulingShardSpec) DeepCopyInto(out *SchedulingShardSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.ActionPeriods != nil {
+		in, out := &in.ActionPeriods, &out.ActionPeriods
+		*out = make(map[string]metav1.Duration, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.MinRuntime != nil {
 		in, out := &in.MinRuntime, &out.MinRuntime
 		*out = new(MinRuntime)
@@ -427,6 +515,35 @@ func (in *SchedulingShardSpec) DeepCopyInto(out *SchedulingShardSpec) {
 		in, out := &in.UsageDBConfig, &out.UsageDBConfig
 		*out = (*in).DeepCopy()
 	}
+	if in.GangSizeLanes != nil {
+		in, out := &in.GangSizeLanes, &out.GangSizeLanes
+		*out = make([]conf.GangSizeLane, len(*in))
+		copy(*out, *in)
+	}
+	if in.EvictionBudgets != nil {
+		in, out := &in.EvictionBudgets, &out.EvictionBudgets
+		*out = make(map[string]conf.EvictionBudget, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Scavenging != nil {
+		in, out := &in.Scavenging, &out.Scavenging
+		*out = (*in).DeepCopy()
+	}
+	if in.Shadow != nil {
+		in, out := &in.Shadow, &out.Shadow
+		*out = (*in).DeepCopy()
+	}
+	if in.EventTriggers != nil {
+		in, out := &in.EventTriggers, &out.EventTriggers
+		*out = (*in).DeepCopy()
+	}
+	if in.NodePoolSelector != nil {
+		in, out := &in.NodePoolSelector, &out.NodePoolSelector
+		*out = new(NodePoolSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingShardSpec.
@@ -0,0 +1,56 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Fraction",type=string,JSONPath=`.spec.fraction`
+// +kubebuilder:printcolumn:name="Memory",type=string,JSONPath=`.spec.memory`
+// +kubebuilder:printcolumn:name="Devices",type=integer,JSONPath=`.spec.devices`
+
+// GpuRequest describes a shared GPU request. Pods reference a GpuRequest of their namespace with the
+// kai.scheduler/gpu-request annotation, and the admission webhook applies it to the pod when the pod is created.
+type GpuRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	Spec GpuRequestSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// GpuRequestList contains a list of GpuRequest
+type GpuRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GpuRequest `json:"items"`
+}
+
+// GpuRequestSpec defines the share of a GPU device requested by a pod, either as a fraction of the device or as an
+// amount of GPU memory, and the number of devices the pod gets such a share of.
+// +kubebuilder:validation:XValidation:rule="has(self.fraction) != has(self.memory)",message="exactly one of fraction or memory must be set"
+type GpuRequestSpec struct {
+	// Fraction is the portion of each GPU device requested, between 0 and 1 exclusive, e.g. "0.5"
+	// +optional
+	// +kubebuilder:validation:Pattern=`^0?\.[0-9]*[1-9][0-9]*$`
+	Fraction *string `json:"fraction,omitempty"`
+
+	// Memory is the GPU memory requested on each GPU device, e.g. "4Gi". It is rounded up to whole MiB.
+	// +optional
+	Memory *resource.Quantity `json:"memory,omitempty"`
+
+	// Devices is the number of GPU devices the pod gets a share of. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Devices *int32 `json:"devices,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GpuRequest{}, &GpuRequestList{})
+}
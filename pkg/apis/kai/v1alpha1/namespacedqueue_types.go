@@ -0,0 +1,67 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Parent",type=string,JSONPath=`.spec.parentQueue`
+// +kubebuilder:printcolumn:name="Queue",type=string,JSONPath=`.status.queueName`
+
+// NamespacedQueue is a leaf queue that lives in a team's namespace, so that the team can manage the sub-quotas of its
+// cluster-scoped parent queue with namespace RBAC. The queue controller keeps a cluster-scoped leaf Queue in sync with
+// every NamespacedQueue, and its webhook keeps the NamespacedQueues within the bounds of their parent queue.
+type NamespacedQueue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	Spec   NamespacedQueueSpec   `json:"spec,omitempty"`
+	Status NamespacedQueueStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// NamespacedQueueList contains a list of NamespacedQueue
+type NamespacedQueueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NamespacedQueue `json:"items"`
+}
+
+// NamespacedQueueSpec defines the desired state of the leaf queue
+type NamespacedQueueSpec struct {
+	// ParentQueue is the name of the cluster-scoped parent queue
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ParentQueue string `json:"parentQueue"`
+
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Resources of the queue. The quotas of all the NamespacedQueues of a parent queue must not exceed the parent's
+	// quota, and their limits must not exceed the parent's limit.
+	// +kubebuilder:validation:Required
+	Resources *v2.QueueResources `json:"resources"`
+
+	// Priority of the queue, see the priority of Queue. When not set, default is 100.
+	// +optional
+	Priority *int `json:"priority,omitempty"`
+}
+
+// NamespacedQueueStatus defines the observed state of NamespacedQueue
+type NamespacedQueueStatus struct {
+	// QueueName is the name of the cluster-scoped queue of the NamespacedQueue, which pods are labeled with
+	// +optional
+	QueueName string `json:"queueName,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NamespacedQueue{}, &NamespacedQueueList{})
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="PriorityClass",type=string,JSONPath=`.spec.priorityClassName`
+// +kubebuilder:printcolumn:name="Precedence",type=integer,JSONPath=`.spec.precedence`
+// +kubebuilder:printcolumn:name="Override",type=boolean,JSONPath=`.spec.override`
+
+// PriorityAssignmentRule derives the priority of pod groups from the metadata of their workloads. The pod grouper
+// sets the priority class of a pod group to the priority class of the matching rule with the highest precedence.
+type PriorityAssignmentRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	Spec PriorityAssignmentRuleSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// PriorityAssignmentRuleList contains a list of PriorityAssignmentRule
+type PriorityAssignmentRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PriorityAssignmentRule `json:"items"`
+}
+
+// PriorityAssignmentRuleSpec defines the workloads a rule matches and the priority class assigned to their pod
+// groups. A workload matches the rule when it matches all of the rule's selectors. A rule without selectors matches
+// every workload.
+type PriorityAssignmentRuleSpec struct {
+	// PriorityClassName is the name of the priority class assigned to the pod groups of the matching workloads
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	PriorityClassName string `json:"priorityClassName"`
+
+	// Precedence orders the rules matching a workload. The rule with the highest precedence is applied, and rules
+	// with the same precedence are ordered by name. Defaults to 0.
+	// +optional
+	Precedence int32 `json:"precedence,omitempty"`
+
+	// Override applies the priority class even to workloads that set their own priority, by the priorityClassName
+	// label of the workload or its pods, or by the priorityClassName of the pods. When false, the rule only replaces
+	// the default priority of workloads that don't set one.
+	// +optional
+	Override bool `json:"override,omitempty"`
+
+	// NamespaceSelector selects workloads by the labels of their namespace
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// WorkloadSelector selects workloads by the labels of the top owner of their pods
+	// +optional
+	WorkloadSelector *metav1.LabelSelector `json:"workloadSelector,omitempty"`
+
+	// WorkloadKinds selects workloads by the group and kind of the top owner of their pods, e.g.
+	// {group: kubeflow.org, kind: PyTorchJob}. The group of core resources, such as bare pods, is empty.
+	// +optional
+	WorkloadKinds []metav1.GroupKind `json:"workloadKinds,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PriorityAssignmentRule{}, &PriorityAssignmentRuleList{})
+}
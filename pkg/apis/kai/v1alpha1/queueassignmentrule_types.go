@@ -0,0 +1,64 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Queue",type=string,JSONPath=`.spec.queue`
+// +kubebuilder:printcolumn:name="Precedence",type=integer,JSONPath=`.spec.precedence`
+
+// QueueAssignmentRule assigns a queue to pods that are submitted without a queue label. The admission webhook
+// labels a pod with the queue of the matching rule with the highest precedence.
+type QueueAssignmentRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	Spec QueueAssignmentRuleSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// QueueAssignmentRuleList contains a list of QueueAssignmentRule
+type QueueAssignmentRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QueueAssignmentRule `json:"items"`
+}
+
+// QueueAssignmentRuleSpec defines the pods a rule matches and the queue assigned to them. A pod matches the rule
+// when it matches all of the rule's selectors. A rule without selectors matches every pod, and can be used with
+// the lowest precedence as the default queue of the cluster.
+type QueueAssignmentRuleSpec struct {
+	// Queue is the name of the queue assigned to the matching pods
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Queue string `json:"queue"`
+
+	// Precedence orders the rules matching a pod. The rule with the highest precedence is applied, and rules with
+	// the same precedence are ordered by name. Defaults to 0.
+	// +optional
+	Precedence int32 `json:"precedence,omitempty"`
+
+	// NamespaceSelector selects pods by the labels of their namespace
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// PodSelector selects pods by their labels
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+
+	// ServiceAccounts selects pods running as one of the service accounts, by name
+	// +optional
+	// +listType=set
+	ServiceAccounts []string `json:"serviceAccounts,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QueueAssignmentRule{}, &QueueAssignmentRuleList{})
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Node Pool",type=string,JSONPath=`.spec.nodePool`
+// +kubebuilder:printcolumn:name="Preemption",type=boolean,JSONPath=`.spec.includePreemption`
+// +kubebuilder:printcolumn:name="Expires At",type=date,JSONPath=`.spec.expiresAt`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.spec.reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// SchedulingFreeze pauses the allocation of pods by the scheduler, cluster-wide or in a single node pool, until it
+// expires or is deleted. Expired freezes are kept, as a record of the freeze, until they are deleted.
+type SchedulingFreeze struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	Spec SchedulingFreezeSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// SchedulingFreezeList contains a list of SchedulingFreeze
+type SchedulingFreezeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SchedulingFreeze `json:"items"`
+}
+
+// SchedulingFreezeSpec defines where scheduling is paused, why and until when
+type SchedulingFreezeSpec struct {
+	// NodePool is the node pool label value of the scheduling shard that is frozen. All the shards are frozen when
+	// it is empty.
+	// +optional
+	NodePool string `json:"nodePool,omitempty"`
+
+	// IncludePreemption also pauses the actions that evict running pods: consolidation, reclaim and preempt
+	// +optional
+	IncludePreemption bool `json:"includePreemption,omitempty"`
+
+	// Reason describes why scheduling is frozen, e.g. a link to the incident
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Reason string `json:"reason"`
+
+	// ExpiresAt is the time the freeze stops taking effect. The freeze takes effect until it is deleted when it
+	// isn't set.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// IsActive returns whether the freeze takes effect at the given time
+func (sf *SchedulingFreeze) IsActive(now time.Time) bool {
+	return sf.Spec.ExpiresAt == nil || now.Before(sf.Spec.ExpiresAt.Time)
+}
+
+// AppliesToNodePool returns whether the freeze pauses the scheduling shard of the given node pool
+func (sf *SchedulingFreeze) AppliesToNodePool(nodePool string) bool {
+	return sf.Spec.NodePool == "" || sf.Spec.NodePool == nodePool
+}
+
+func init() {
+	SchemeBuilder.Register(&SchedulingFreeze{}, &SchedulingFreezeList{})
+}
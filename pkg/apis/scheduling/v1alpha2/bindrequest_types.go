@@ -57,6 +57,22 @@ const (
 )
 
 // BindRequestStatus defines the observed state of BindRequest
+// BindFailureReason is a machine readable reason for a failed binding attempt
+type BindFailureReason string
+
+const (
+	// BindFailureReasonDeviceUnavailable means the GPUs selected for the pod could not be reserved on the node
+	BindFailureReasonDeviceUnavailable BindFailureReason = "DeviceUnavailable"
+	// BindFailureReasonReservationPodTimeout means the GPU reservation pod was not allocated a device in time
+	BindFailureReasonReservationPodTimeout BindFailureReason = "ReservationPodTimeout"
+	// BindFailureReasonNodeGone means the selected node no longer exists
+	BindFailureReasonNodeGone BindFailureReason = "NodeGone"
+	// BindFailureReasonDRAClaimPending means a resource claim of the pod could not be found or reserved yet
+	BindFailureReasonDRAClaimPending BindFailureReason = "DRAClaimPending"
+	// BindFailureReasonUnknown is used for failures that don't match any other reason
+	BindFailureReasonUnknown BindFailureReason = "Unknown"
+)
+
 type BindRequestStatus struct {
 	// Phase is the current phase of the bindrequest. [Pending/Succeeded/Failed]
 	Phase string `json:"phase,omitempty"`
@@ -66,6 +82,10 @@ type BindRequestStatus struct {
 
 	// FailedAttempts is the number of failed attempts
 	FailedAttempts int32 `json:"failedAttempts,omitempty"`
+
+	// FailureReason is the machine readable reason of the last failed attempt
+	// [DeviceUnavailable/ReservationPodTimeout/NodeGone/DRAClaimPending/Unknown]
+	FailureReason BindFailureReason `json:"failureReason,omitempty"`
 }
 
 // +genclient
@@ -20,8 +20,13 @@ limitations under the License.
 package v2
 
 import (
+	"slices"
+	"time"
+
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -44,6 +49,398 @@ type QueueSpec struct {
 	// Minimum runtime of a job in queue before it can be reclaimed.
 	// +optional
 	ReclaimMinRuntime *metav1.Duration `json:"reclaimMinRuntime,omitempty"`
+
+	// MaxPodGroupMinRuntime allows PodGroups of the queue and of its child queues to lengthen their minimum runtime
+	// before preemption and reclaim with minRuntimeBeforePreemption, up to this value. When not set, the
+	// minRuntimeBeforePreemption of PodGroups is ignored.
+	// +optional
+	MaxPodGroupMinRuntime *metav1.Duration `json:"maxPodGroupMinRuntime,omitempty"`
+
+	// PriorityQuotaCaps limit the share of the queue's deserved quota that workloads of a given priority class can
+	// consume. Priority classes without a cap are not limited.
+	// +optional
+	// +listType=map
+	// +listMapKey=priorityClassName
+	PriorityQuotaCaps []PriorityQuotaCap `json:"priorityQuotaCaps,omitempty"`
+
+	// Tolerations are added by the admission webhook to every pod submitted to the queue or to any of its child
+	// queues. Tolerations set on the pod, its PodGroup or a closer queue for the same key and effect take precedence.
+	// +optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+
+	// NodeSelector is merged by the admission webhook into the node selector of every pod submitted to the queue.
+	// Keys set on the pod or its PodGroup take precedence.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// LoanPayback records the workloads of sibling queues that borrow the unused deserved quota of the queue as
+	// loans. When the queue needs its quota back, the borrowing workloads are reclaimed first, and while loans are
+	// outstanding, the queue gets a higher share of the over-quota resources.
+	// +optional
+	LoanPayback *LoanPayback `json:"loanPayback,omitempty"`
+
+	// EvictionMethod is how the scheduler evicts the pods of workloads in the queue when they are preempted or
+	// reclaimed. Child queues inherit the method of their parent queue. When not set, pods are deleted.
+	// +optional
+	EvictionMethod EvictionMethod `json:"evictionMethod,omitempty"`
+
+	// RejectExceedingLimits makes the admission webhook reject the creation of pods of the queue and of its child
+	// queues that request more resources than the limit of the queue or of its ancestors, which they can't get even
+	// if all other workloads are reclaimed, instead of leaving them pending.
+	// +optional
+	RejectExceedingLimits bool `json:"rejectExceedingLimits,omitempty"`
+
+	// Preemptibility sets the default preemptibility of workloads in the queue and in its child queues, and whether
+	// workloads may set a different one. Child queues inherit the setting of their closest ancestor that sets it.
+	// +optional
+	Preemptibility *QueuePreemptibility `json:"preemptibility,omitempty"`
+
+	// WorkloadClasses sets the default workload class of workloads in the queue and in its child queues, and the
+	// classes that workloads may set. Child queues inherit the setting of their closest ancestor that sets it.
+	// +optional
+	WorkloadClasses *QueueWorkloadClasses `json:"workloadClasses,omitempty"`
+
+	// GPUDeviceSelection is how the scheduler selects the devices of a node for the fractional GPU workloads of the
+	// queue and of its child queues. Child queues inherit the policy of their parent queue. When not set, the
+	// placement strategy of the scheduler is used.
+	// +optional
+	GPUDeviceSelection GPUDeviceSelectionPolicy `json:"gpuDeviceSelection,omitempty"`
+
+	// ResourceDefaults are resources per GPU that the admission webhook sets on the GPU containers of pods submitted
+	// to the queue or to any of its child queues, when the containers don't set them. Child queues inherit the
+	// defaults of their closest ancestor that sets them.
+	// +optional
+	ResourceDefaults *QueueResourceDefaults `json:"resourceDefaults,omitempty"`
+
+	// Burst lets the queue be allocated GPUs over its deserved quota for short periods without its workloads being
+	// reclaimed. The burst allowance is a token bucket that drains while the queue uses it, and refills while the
+	// queue is within its deserved quota.
+	// +optional
+	Burst *QueueBurst `json:"burst,omitempty"`
+
+	// Budget limits the GPU hours that the queue and its child queues can consume in a week or a month. Once the
+	// budget is exhausted, new allocations of the queue are blocked or demoted to the scavenger queue until the next
+	// period starts. Running workloads are not evicted.
+	// +optional
+	Budget *QueueBudget `json:"budget,omitempty"`
+
+	// Consolidation sets whether workloads in the queue and in its child queues may opt out of being moved by
+	// consolidation. Child queues inherit the setting of their closest ancestor that sets it.
+	// +optional
+	Consolidation *QueueConsolidation `json:"consolidation,omitempty"`
+
+	// QuotaRollout makes the queue controller apply changes of the GPU quota of the queue progressively, in steps,
+	// instead of at once. The projected reclaim of the next step is published in the status before it is applied.
+	// +optional
+	QuotaRollout *QueueQuotaRollout `json:"quotaRollout,omitempty"`
+
+	// MaxGPUsPerPod is the largest number of GPUs that a single pod of the queue or of its child queues may request.
+	// The admission webhook rejects larger pods, unless they are created with the override annotation by a user that
+	// is allowed to override the limit of the queue. The smallest limit of the queue and of its ancestors applies.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxGPUsPerPod *float64 `json:"maxGPUsPerPod,omitempty"`
+
+	// PreemptionLimit limits the pods that preemption and reclaim may evict for the workloads of the queue and of its
+	// child queues in a sliding window. The limits of the queue's ancestors also apply, each to the pods evicted for
+	// all its child queues.
+	// +optional
+	PreemptionLimit *QueuePreemptionLimit `json:"preemptionLimit,omitempty"`
+
+	// NodePools are other node pools in which the queue is entitled to its quota, in addition to the node pool of the
+	// queue's label. When the reclaim action looks for resources for a pending workload of the queue, it also
+	// considers the victims in these node pools, and moves the workload to the node pool with the cheapest reclaim.
+	// +optional
+	// +listType=set
+	NodePools []string `json:"nodePools,omitempty"`
+}
+
+// QueueBudgetPeriod is the period over which the consumption of a queue budget is accounted
+// +kubebuilder:validation:Enum=Weekly;Monthly
+type QueueBudgetPeriod string
+
+const (
+	// QueueBudgetPeriodWeekly periods start on Monday at midnight UTC
+	QueueBudgetPeriodWeekly QueueBudgetPeriod = "Weekly"
+	// QueueBudgetPeriodMonthly periods start on the first day of the month at midnight UTC
+	QueueBudgetPeriodMonthly QueueBudgetPeriod = "Monthly"
+)
+
+// QueueBudgetAction is what the scheduler does with new allocations of a queue whose budget is exhausted
+// +kubebuilder:validation:Enum=Block;Demote
+type QueueBudgetAction string
+
+const (
+	// QueueBudgetActionBlock doesn't allocate the workloads of the queue
+	QueueBudgetActionBlock QueueBudgetAction = "Block"
+	// QueueBudgetActionDemote allocates the preemptible workloads of the queue under the scavenger queue of the
+	// scheduler, and doesn't allocate the others
+	QueueBudgetActionDemote QueueBudgetAction = "Demote"
+)
+
+// QueueBudget is a limit on the GPU hours a queue consumes in a period
+type QueueBudget struct {
+	// GPUHours is the number of GPU hours the queue can consume in a period
+	// +kubebuilder:validation:Minimum=0
+	GPUHours float64 `json:"gpuHours"`
+
+	// Period is the period over which the consumption is accounted
+	Period QueueBudgetPeriod `json:"period"`
+
+	// ExhaustedAction is what the scheduler does with new allocations once the budget is exhausted. Defaults to Block.
+	// +optional
+	ExhaustedAction QueueBudgetAction `json:"exhaustedAction,omitempty"`
+}
+
+// GetPeriodStart returns the start of the budget period that includes the given time
+func (qb *QueueBudget) GetPeriodStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if qb.Period == QueueBudgetPeriodMonthly {
+		return day.AddDate(0, 0, 1-day.Day())
+	}
+	daysSinceMonday := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -daysSinceMonday)
+}
+
+// GetExhaustedAction returns what the scheduler does with new allocations once the budget is exhausted
+func (qb *QueueBudget) GetExhaustedAction() QueueBudgetAction {
+	if qb.ExhaustedAction == "" {
+		return QueueBudgetActionBlock
+	}
+	return qb.ExhaustedAction
+}
+
+// IsExhausted returns true if the consumption reported in the status reaches the budget in the period that includes
+// the given time. A status of an earlier period doesn't exhaust the budget.
+func (qb *QueueBudget) IsExhausted(status *QueueBudgetStatus, t time.Time) bool {
+	if status == nil || !status.PeriodStart.Time.Equal(qb.GetPeriodStart(t)) {
+		return false
+	}
+	return status.ConsumedGPUHours >= qb.GPUHours
+}
+
+// QueueBudgetStatus is the consumption of the budget of a queue in the current period
+type QueueBudgetStatus struct {
+	// PeriodStart is the start of the period the consumption is accounted for
+	PeriodStart metav1.Time `json:"periodStart"`
+
+	// ConsumedGPUHours is the number of GPU hours the queue and its child queues consumed in the period
+	ConsumedGPUHours float64 `json:"consumedGPUHours"`
+
+	// LastMeteringTime is the time up to which the consumption is accounted
+	LastMeteringTime metav1.Time `json:"lastMeteringTime"`
+}
+
+// QueueBurst is a token bucket allowance for exceeding the deserved GPU quota of a queue
+type QueueBurst struct {
+	// GPUs is the number of GPUs over the deserved quota that are protected from reclaim while the bucket isn't empty
+	// +kubebuilder:validation:Minimum=0
+	GPUs float64 `json:"gpus"`
+
+	// Duration is how long the queue can use all the burst GPUs before the bucket is empty. Using fewer GPUs drains
+	// the bucket proportionally slower.
+	Duration metav1.Duration `json:"duration"`
+
+	// RefillDuration is how long an empty bucket takes to refill while the queue is within its deserved quota.
+	// Defaults to the duration.
+	// +optional
+	RefillDuration *metav1.Duration `json:"refillDuration,omitempty"`
+}
+
+// Capacity returns the size of the bucket in GPU seconds
+func (qb *QueueBurst) Capacity() float64 {
+	return qb.GPUs * qb.Duration.Seconds()
+}
+
+// GetRefillDuration returns how long an empty bucket takes to refill
+func (qb *QueueBurst) GetRefillDuration() time.Duration {
+	if qb.RefillDuration == nil {
+		return qb.Duration.Duration
+	}
+	return qb.RefillDuration.Duration
+}
+
+// QueueResourceDefaults are the requests and limits of resources such as CPU and memory per GPU of a container
+type QueueResourceDefaults struct {
+	// RequestsPerGPU are multiplied by the number of GPUs of a container and set as the requests of the resources
+	// the container doesn't request. Requests are capped by the limits of the container.
+	// +optional
+	RequestsPerGPU v1.ResourceList `json:"requestsPerGPU,omitempty"`
+
+	// LimitsPerGPU are multiplied by the number of GPUs of a container and set as the limits of the resources the
+	// container doesn't limit. Limits below the requests of the container are not set.
+	// +optional
+	LimitsPerGPU v1.ResourceList `json:"limitsPerGPU,omitempty"`
+}
+
+// QueuePreemptionLimit is a limit on the pods evicted for the workloads of a queue in a sliding window
+type QueuePreemptionLimit struct {
+	// MaxVictims is the number of pods that can be evicted for the workloads of the queue in a window. 0 prevents the
+	// workloads of the queue from evicting pods.
+	// +kubebuilder:validation:Minimum=0
+	MaxVictims int32 `json:"maxVictims"`
+
+	// Window is the duration of the sliding window in which the evicted pods are counted
+	Window metav1.Duration `json:"window"`
+}
+
+// QueuePreemptibility configures the preemptibility of the workloads of a queue
+type QueuePreemptibility struct {
+	// Default is the preemptibility of workloads that don't set one. When not set, it is determined by the priority
+	// of the workloads.
+	// +optional
+	Default v2alpha2.Preemptibility `json:"default,omitempty"`
+
+	// AllowOverride allows workloads to set a preemptibility other than the default. When false, the admission
+	// webhook rejects pods that set another preemptibility, and the scheduler applies the default to all workloads of
+	// the queue. Requires a default. Defaults to true.
+	// +optional
+	AllowOverride *bool `json:"allowOverride,omitempty"`
+}
+
+// IsOverrideAllowed returns true if workloads may set a preemptibility other than the default
+func (qp *QueuePreemptibility) IsOverrideAllowed() bool {
+	return qp.Default == "" || qp.AllowOverride == nil || *qp.AllowOverride
+}
+
+// QueueWorkloadClasses configures the workload classes of the workloads of a queue
+type QueueWorkloadClasses struct {
+	// Default is the workload class of workloads that don't set one, or that set a class the queue doesn't allow.
+	// +optional
+	Default v2alpha2.WorkloadClass `json:"default,omitempty"`
+
+	// Allowed are the workload classes that workloads may set. The admission webhook rejects pods that set another
+	// class. The default class is always allowed. When empty, all classes are allowed.
+	// +optional
+	Allowed []v2alpha2.WorkloadClass `json:"allowed,omitempty"`
+}
+
+// IsAllowed returns true if workloads may set the given workload class
+func (qwc *QueueWorkloadClasses) IsAllowed(class v2alpha2.WorkloadClass) bool {
+	return len(qwc.Allowed) == 0 || class == qwc.Default || slices.Contains(qwc.Allowed, class)
+}
+
+// QueueConsolidation configures the consolidation of the workloads of a queue
+type QueueConsolidation struct {
+	// AllowOptOut allows workloads to opt out of consolidation with the kai.scheduler/do-not-consolidate annotation.
+	// When false, the admission webhook rejects pods that set the annotation, and the scheduler may move all
+	// preemptible workloads of the queue. Defaults to true.
+	// +optional
+	AllowOptOut *bool `json:"allowOptOut,omitempty"`
+}
+
+// IsOptOutAllowed returns true if workloads may opt out of consolidation
+func (qc *QueueConsolidation) IsOptOutAllowed() bool {
+	return qc.AllowOptOut == nil || *qc.AllowOptOut
+}
+
+// QueueQuotaRollout configures the progressive rollout of GPU quota changes of a queue
+type QueueQuotaRollout struct {
+	// StepPercentage is the percentage of the quota change that is applied in each step
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	StepPercentage int32 `json:"stepPercentage"`
+
+	// StepInterval is the time between two steps. The first step is applied one interval after the quota is changed.
+	StepInterval metav1.Duration `json:"stepInterval"`
+
+	// MaxProjectedReclaimGPUs is the number of GPUs that a single step may make reclaimable. A step whose projected
+	// reclaim exceeds it isn't applied and the rollout is aborted. When not set, steps are not limited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxProjectedReclaimGPUs *float64 `json:"maxProjectedReclaimGPUs,omitempty"`
+}
+
+// QuotaRolloutPhase is the phase of the rollout of a GPU quota change
+// +kubebuilder:validation:Enum=Progressing;Completed;Aborted
+type QuotaRolloutPhase string
+
+const (
+	// QuotaRolloutProgressing rollouts apply a step every step interval
+	QuotaRolloutProgressing QuotaRolloutPhase = "Progressing"
+	// QuotaRolloutCompleted rollouts applied the target quota
+	QuotaRolloutCompleted QuotaRolloutPhase = "Completed"
+	// QuotaRolloutAborted rollouts keep the applied quota until the quota of the queue is changed again
+	QuotaRolloutAborted QuotaRolloutPhase = "Aborted"
+)
+
+// QueueQuotaRolloutStatus is the progress of the rollout of the GPU quota of a queue
+type QueueQuotaRolloutStatus struct {
+	// Phase of the rollout
+	Phase QuotaRolloutPhase `json:"phase"`
+
+	// FromGPUQuota is the GPU quota that was applied when the rollout started
+	FromGPUQuota float64 `json:"fromGPUQuota"`
+
+	// TargetGPUQuota is the GPU quota set in the spec of the queue
+	TargetGPUQuota float64 `json:"targetGPUQuota"`
+
+	// AppliedGPUQuota is the GPU quota that the scheduler uses for the queue
+	AppliedGPUQuota float64 `json:"appliedGPUQuota"`
+
+	// LastStepTime is the time the last step was applied, or the rollout started
+	// +optional
+	LastStepTime metav1.Time `json:"lastStepTime,omitempty"`
+
+	// NextStepGPUQuota is the GPU quota that the next step applies
+	// +optional
+	NextStepGPUQuota *float64 `json:"nextStepGPUQuota,omitempty"`
+
+	// NextStepProjectedReclaimGPUs is the number of GPUs that the next step is projected to make reclaimable, from
+	// other queues when the quota is raised, or from the queue when it is lowered
+	// +optional
+	NextStepProjectedReclaimGPUs *float64 `json:"nextStepProjectedReclaimGPUs,omitempty"`
+
+	// Message explains why the rollout was aborted
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// EvictionMethod is how the scheduler evicts a pod
+// +kubebuilder:validation:Enum=Delete;EvictionAPI;Custom
+type EvictionMethod string
+
+const (
+	// EvictionMethodDelete deletes the pod directly, regardless of pod disruption budgets
+	EvictionMethodDelete EvictionMethod = "Delete"
+	// EvictionMethodEvictionAPI evicts the pod through the Eviction API, respecting pod disruption budgets
+	EvictionMethodEvictionAPI EvictionMethod = "EvictionAPI"
+	// EvictionMethodCustom marks the pod with an eviction request annotation, leaving its deletion to a
+	// workload-specific controller
+	EvictionMethodCustom EvictionMethod = "Custom"
+)
+
+// GPUDeviceSelectionPolicy is how the scheduler selects the devices of a node for a fractional GPU workload
+// +kubebuilder:validation:Enum=Pack;Spread
+type GPUDeviceSelectionPolicy string
+
+const (
+	// GPUDeviceSelectionPack places fractions on the most used devices that fit them, keeping more devices free
+	GPUDeviceSelectionPack GPUDeviceSelectionPolicy = "Pack"
+	// GPUDeviceSelectionSpread places fractions on the least used devices, preferring free devices over shared ones
+	GPUDeviceSelectionSpread GPUDeviceSelectionPolicy = "Spread"
+)
+
+// LoanPayback configures how a queue is paid back for lending its unused deserved quota to sibling queues
+type LoanPayback struct {
+	// OverQuotaWeightMultiplier multiplies the over-quota weight of the queue while workloads that borrowed its
+	// deserved quota are running. Defaults to 2.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	OverQuotaWeightMultiplier *float64 `json:"overQuotaWeightMultiplier,omitempty"`
+}
+
+// PriorityQuotaCap limits the quota consumed by workloads of a single priority class in a queue
+type PriorityQuotaCap struct {
+	// PriorityClassName of the workloads the cap applies to
+	PriorityClassName string `json:"priorityClassName"`
+
+	// MaxQuotaPercentage is the maximal percentage of the queue's deserved quota that workloads of the priority class
+	// can be allocated
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MaxQuotaPercentage int32 `json:"maxQuotaPercentage"`
 }
 
 // QueueStatus defines the observed state of Queue
@@ -70,6 +467,21 @@ type QueueStatus struct {
 	// Current requested GPU (in fractions), CPU (in millicpus) and Memory in megabytes
 	// by all running and pending jobs in queue and child queues
 	Requested v1.ResourceList `json:"requested,omitempty"`
+
+	// Resources that the queue could get right now by reclaiming resources that other queues use over their fair
+	// share, by node pool. Set by the scheduler of each node pool in every scheduling cycle.
+	// +optional
+	Reclaimable map[string]v1.ResourceList `json:"reclaimable,omitempty"`
+
+	// Budget is the consumption of the budget of the queue in the current period. Set by the queue controller when
+	// the queue has a budget.
+	// +optional
+	Budget *QueueBudgetStatus `json:"budget,omitempty"`
+
+	// QuotaRollout is the progress of the rollout of the GPU quota of the queue. Set by the queue controller when the
+	// queue has a quota rollout.
+	// +optional
+	QuotaRollout *QueueQuotaRolloutStatus `json:"quotaRollout,omitempty"`
 }
 
 // +genclient
@@ -35,6 +35,9 @@ func (_ *Queue) ValidateCreate(_ context.Context, obj runtime.Object) (admission
 	if queue.Spec.Resources == nil {
 		return []string{missingResourcesError}, fmt.Errorf(missingResourcesError)
 	}
+	if err := validatePreemptibility(queue.Spec.Preemptibility); err != nil {
+		return nil, err
+	}
 	return nil, nil
 }
 
@@ -49,6 +52,9 @@ func (_ *Queue) ValidateUpdate(_ context.Context, _ runtime.Object, newObj runti
 	if queue.Spec.Resources == nil {
 		return []string{missingResourcesError}, fmt.Errorf(missingResourcesError)
 	}
+	if err := validatePreemptibility(queue.Spec.Preemptibility); err != nil {
+		return nil, err
+	}
 	return nil, nil
 }
 
@@ -60,3 +66,13 @@ func (_ *Queue) ValidateDelete(_ context.Context, obj runtime.Object) (admission
 	queuelog.Info("validate delete", "name", queue.Name)
 	return nil, nil
 }
+
+func validatePreemptibility(preemptibility *QueuePreemptibility) error {
+	if preemptibility == nil {
+		return nil
+	}
+	if preemptibility.AllowOverride != nil && !*preemptibility.AllowOverride && preemptibility.Default == "" {
+		return fmt.Errorf("preemptibility.default must be set when preemptibility.allowOverride is false")
+	}
+	return nil
+}
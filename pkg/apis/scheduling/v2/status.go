@@ -18,6 +18,30 @@ const (
 	// OverQuota indicates whether the queue has more allocated resources then deserved (one resource being over quota
 	// is enough)
 	OverQuota QueueConditionType = "OverQuota"
+
+	// AtLimit indicates whether the allocated resources of the queue reached its limit while it has workloads (one
+	// resource reaching its limit is enough)
+	AtLimit QueueConditionType = "AtLimit"
+
+	// ReclaimVictim indicates whether workloads of the queue were evicted by the scheduler while the queue was over
+	// quota, which happens when other queues reclaim their deserved quota
+	ReclaimVictim QueueConditionType = "ReclaimVictim"
+
+	// Starved indicates whether the queue has pending requests within its deserved quota that were not allocated for
+	// longer than the starvation threshold of the queue controller
+	Starved QueueConditionType = "Starved"
+)
+
+// These are the reasons of the queue conditions set by the queue controller.
+const (
+	QueueReasonOverQuota    = "OverQuota"
+	QueueReasonWithinQuota  = "WithinQuota"
+	QueueReasonLimitReached = "LimitReached"
+	QueueReasonWithinLimit  = "WithinLimit"
+	QueueReasonReclaimed    = "Reclaimed"
+	QueueReasonNotReclaimed = "NotReclaimed"
+	QueueReasonStarved      = "Starved"
+	QueueReasonNotStarved   = "NotStarved"
 )
 
 type QueueCondition struct {
@@ -39,3 +63,39 @@ type QueueCondition struct {
 	// +optional
 	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
 }
+
+// FindQueueCondition returns the condition of the given type, or nil if it is not set.
+func FindQueueCondition(conditions []QueueCondition, conditionType QueueConditionType) *QueueCondition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetQueueCondition adds or updates the condition of the given type. The last transition time is kept if the status
+// of the condition did not change. Returns true if the status of the condition changed.
+func SetQueueCondition(conditions *[]QueueCondition, newCondition QueueCondition, now metav1.Time) bool {
+	existing := FindQueueCondition(*conditions, newCondition.Type)
+	if existing == nil {
+		newCondition.LastTransitionTime = now
+		*conditions = append(*conditions, newCondition)
+		return true
+	}
+
+	transitioned := existing.Status != newCondition.Status
+	if transitioned {
+		existing.LastTransitionTime = now
+	}
+	existing.Status = newCondition.Status
+	existing.Reason = newCondition.Reason
+	existing.Message = newCondition.Message
+	return transitioned
+}
+
+// IsQueueConditionTrue returns true if the condition of the given type is set with a true status.
+func IsQueueConditionTrue(conditions []QueueCondition, conditionType QueueConditionType) bool {
+	condition := FindQueueCondition(conditions, conditionType)
+	return condition != nil && condition.Status == v1.ConditionTrue
+}
@@ -10,11 +10,48 @@ SPDX-License-Identifier: Apache-2.0
 package v2
 
 import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoanPayback) DeepCopyInto(out *LoanPayback) {
+	*out = *in
+	if in.OverQuotaWeightMultiplier != nil {
+		in, out := &in.OverQuotaWeightMultiplier, &out.OverQuotaWeightMultiplier
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoanPayback.
+func (in *LoanPayback) DeepCopy() *LoanPayback {
+	if in == nil {
+		return nil
+	}
+	out := new(LoanPayback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PriorityQuotaCap) DeepCopyInto(out *PriorityQuotaCap) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PriorityQuotaCap.
+func (in *PriorityQuotaCap) DeepCopy() *PriorityQuotaCap {
+	if in == nil {
+		return nil
+	}
+	out := new(PriorityQuotaCap)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Queue) DeepCopyInto(out *Queue) {
 	*out = *in
@@ -42,6 +79,59 @@ func (in *Queue) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueBudget) DeepCopyInto(out *QueueBudget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueBudget.
+func (in *QueueBudget) DeepCopy() *QueueBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueBudgetStatus) DeepCopyInto(out *QueueBudgetStatus) {
+	*out = *in
+	in.PeriodStart.DeepCopyInto(&out.PeriodStart)
+	in.LastMeteringTime.DeepCopyInto(&out.LastMeteringTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueBudgetStatus.
+func (in *QueueBudgetStatus) DeepCopy() *QueueBudgetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueBudgetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueBurst) DeepCopyInto(out *QueueBurst) {
+	*out = *in
+	out.Duration = in.Duration
+	if in.RefillDuration != nil {
+		in, out := &in.RefillDuration, &out.RefillDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueBurst.
+func (in *QueueBurst) DeepCopy() *QueueBurst {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueBurst)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QueueCondition) DeepCopyInto(out *QueueCondition) {
 	*out = *in
@@ -59,6 +149,26 @@ func (in *QueueCondition) DeepCopy() *QueueCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueConsolidation) DeepCopyInto(out *QueueConsolidation) {
+	*out = *in
+	if in.AllowOptOut != nil {
+		in, out := &in.AllowOptOut, &out.AllowOptOut
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueConsolidation.
+func (in *QueueConsolidation) DeepCopy() *QueueConsolidation {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueConsolidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QueueList) DeepCopyInto(out *QueueList) {
 	*out = *in
@@ -91,6 +201,89 @@ func (in *QueueList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePreemptibility) DeepCopyInto(out *QueuePreemptibility) {
+	*out = *in
+	if in.AllowOverride != nil {
+		in, out := &in.AllowOverride, &out.AllowOverride
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePreemptibility.
+func (in *QueuePreemptibility) DeepCopy() *QueuePreemptibility {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePreemptibility)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePreemptionLimit) DeepCopyInto(out *QueuePreemptionLimit) {
+	*out = *in
+	out.Window = in.Window
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePreemptionLimit.
+func (in *QueuePreemptionLimit) DeepCopy() *QueuePreemptionLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePreemptionLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueQuotaRollout) DeepCopyInto(out *QueueQuotaRollout) {
+	*out = *in
+	out.StepInterval = in.StepInterval
+	if in.MaxProjectedReclaimGPUs != nil {
+		in, out := &in.MaxProjectedReclaimGPUs, &out.MaxProjectedReclaimGPUs
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueQuotaRollout.
+func (in *QueueQuotaRollout) DeepCopy() *QueueQuotaRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueQuotaRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueQuotaRolloutStatus) DeepCopyInto(out *QueueQuotaRolloutStatus) {
+	*out = *in
+	in.LastStepTime.DeepCopyInto(&out.LastStepTime)
+	if in.NextStepGPUQuota != nil {
+		in, out := &in.NextStepGPUQuota, &out.NextStepGPUQuota
+		*out = new(float64)
+		**out = **in
+	}
+	if in.NextStepProjectedReclaimGPUs != nil {
+		in, out := &in.NextStepProjectedReclaimGPUs, &out.NextStepProjectedReclaimGPUs
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueQuotaRolloutStatus.
+func (in *QueueQuotaRolloutStatus) DeepCopy() *QueueQuotaRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueQuotaRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QueueResource) DeepCopyInto(out *QueueResource) {
 	*out = *in
@@ -106,6 +299,35 @@ func (in *QueueResource) DeepCopy() *QueueResource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueResourceDefaults) DeepCopyInto(out *QueueResourceDefaults) {
+	*out = *in
+	if in.RequestsPerGPU != nil {
+		in, out := &in.RequestsPerGPU, &out.RequestsPerGPU
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.LimitsPerGPU != nil {
+		in, out := &in.LimitsPerGPU, &out.LimitsPerGPU
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueResourceDefaults.
+func (in *QueueResourceDefaults) DeepCopy() *QueueResourceDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueResourceDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QueueResources) DeepCopyInto(out *QueueResources) {
 	*out = *in
@@ -147,6 +369,85 @@ func (in *QueueSpec) DeepCopyInto(out *QueueSpec) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.MaxPodGroupMinRuntime != nil {
+		in, out := &in.MaxPodGroupMinRuntime, &out.MaxPodGroupMinRuntime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.PriorityQuotaCaps != nil {
+		in, out := &in.PriorityQuotaCaps, &out.PriorityQuotaCaps
+		*out = make([]PriorityQuotaCap, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LoanPayback != nil {
+		in, out := &in.LoanPayback, &out.LoanPayback
+		*out = new(LoanPayback)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preemptibility != nil {
+		in, out := &in.Preemptibility, &out.Preemptibility
+		*out = new(QueuePreemptibility)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadClasses != nil {
+		in, out := &in.WorkloadClasses, &out.WorkloadClasses
+		*out = new(QueueWorkloadClasses)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceDefaults != nil {
+		in, out := &in.ResourceDefaults, &out.ResourceDefaults
+		*out = new(QueueResourceDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(QueueBurst)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(QueueBudget)
+		**out = **in
+	}
+	if in.Consolidation != nil {
+		in, out := &in.Consolidation, &out.Consolidation
+		*out = new(QueueConsolidation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QuotaRollout != nil {
+		in, out := &in.QuotaRollout, &out.QuotaRollout
+		*out = new(QueueQuotaRollout)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxGPUsPerPod != nil {
+		in, out := &in.MaxGPUsPerPod, &out.MaxGPUsPerPod
+		*out = new(float64)
+		**out = **in
+	}
+	if in.PreemptionLimit != nil {
+		in, out := &in.PreemptionLimit, &out.PreemptionLimit
+		*out = new(QueuePreemptionLimit)
+		**out = **in
+	}
+	if in.NodePools != nil {
+		in, out := &in.NodePools, &out.NodePools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueSpec.
@@ -195,6 +496,34 @@ func (in *QueueStatus) DeepCopyInto(out *QueueStatus) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.Reclaimable != nil {
+		in, out := &in.Reclaimable, &out.Reclaimable
+		*out = make(map[string]corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			var outVal map[corev1.ResourceName]resource.Quantity
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make(corev1.ResourceList, len(*in))
+				for key, val := range *in {
+					(*out)[key] = val.DeepCopy()
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(QueueBudgetStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QuotaRollout != nil {
+		in, out := &in.QuotaRollout, &out.QuotaRollout
+		*out = new(QueueQuotaRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueStatus.
@@ -206,3 +535,23 @@ func (in *QueueStatus) DeepCopy() *QueueStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueWorkloadClasses) DeepCopyInto(out *QueueWorkloadClasses) {
+	*out = *in
+	if in.Allowed != nil {
+		in, out := &in.Allowed, &out.Allowed
+		*out = make([]v2alpha2.WorkloadClass, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueWorkloadClasses.
+func (in *QueueWorkloadClasses) DeepCopy() *QueueWorkloadClasses {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueWorkloadClasses)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package v2alpha2
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// These are the lifecycle conditions of a pod group, set in its status by the podgroup controller.
+// Clients should rely on these conditions instead of parsing events to follow the pod group lifecycle.
+const (
+	// PodGroupAdmitted means the pod group has enough pods to be considered by the scheduler and its queue exists.
+	PodGroupAdmitted PodGroupConditionType = "Admitted"
+	// PodGroupQuotaReserved means the resources of the pod group's minimum members are accounted to its queue.
+	PodGroupQuotaReserved PodGroupConditionType = "QuotaReserved"
+	// PodGroupScheduled means the scheduler has selected nodes for the pod group's minimum members.
+	PodGroupScheduled PodGroupConditionType = "Scheduled"
+	// PodGroupBindCompleted means the binder has bound the pod group's minimum members to their nodes.
+	PodGroupBindCompleted PodGroupConditionType = "BindCompleted"
+	// PodGroupPreempted means pods of the pod group were evicted by the scheduler and it was not scheduled since.
+	PodGroupPreempted PodGroupConditionType = "Preempted"
+	// PodGroupBackoffWaiting means the scheduler will not retry the pod group on its current node pool,
+	// as it is unschedulable there and has a scheduling backoff.
+	PodGroupBackoffWaiting PodGroupConditionType = "BackoffWaiting"
+	// PodGroupInfeasible means the pod group can never be scheduled as it is, since a pod requests more than any node
+	// of its node pool can allocate, or its minimum members request more than the limits of its queue.
+	PodGroupInfeasible PodGroupConditionType = "Infeasible"
+	// PodGroupNodePoolUnavailable means the node pool of the pod group has no ready nodes, so the pod group waits
+	// for nodes of its node pool to become ready.
+	PodGroupNodePoolUnavailable PodGroupConditionType = "NodePoolUnavailable"
+)
+
+// These are the reasons of the pod group lifecycle conditions.
+const (
+	// PodGroupReasonAdmitted is the reason of a true Admitted condition.
+	PodGroupReasonAdmitted = "Admitted"
+	// PodGroupReasonNotEnoughPods means the pod group has fewer pods than its minimum members.
+	PodGroupReasonNotEnoughPods = "NotEnoughPods"
+	// PodGroupReasonQueueDoesNotExist means the queue of the pod group does not exist.
+	PodGroupReasonQueueDoesNotExist = "QueueDoesNotExist"
+	// PodGroupReasonQuotaReserved is the reason of a true QuotaReserved condition.
+	PodGroupReasonQuotaReserved = "QuotaReserved"
+	// PodGroupReasonOverQuota means the pod group does not fit in the quota or limit of its queue.
+	PodGroupReasonOverQuota = "OverQuota"
+	// PodGroupReasonPending means the pod group is waiting for the scheduler.
+	PodGroupReasonPending = "Pending"
+	// PodGroupReasonScheduled is the reason of a true Scheduled condition.
+	PodGroupReasonScheduled = "Scheduled"
+	// PodGroupReasonBinding means the pod group is scheduled and its pods are being bound.
+	PodGroupReasonBinding = "Binding"
+	// PodGroupReasonBindingFailed means the binder failed to bind a pod of the pod group.
+	PodGroupReasonBindingFailed = "BindingFailed"
+	// PodGroupReasonBound is the reason of a true BindCompleted condition.
+	PodGroupReasonBound = "Bound"
+	// PodGroupReasonPreemptedByScheduler means pods of the pod group were evicted by the scheduler.
+	PodGroupReasonPreemptedByScheduler = "PreemptedByScheduler"
+	// PodGroupReasonNotPreempted is the reason of a false Preempted condition.
+	PodGroupReasonNotPreempted = "NotPreempted"
+	// PodGroupReasonSchedulingBackoff means the pod group waits for a change of node pool to be retried.
+	PodGroupReasonSchedulingBackoff = "SchedulingBackoff"
+	// PodGroupReasonNoBackoff is the reason of a false BackoffWaiting condition.
+	PodGroupReasonNoBackoff = "NoBackoff"
+	// PodGroupReasonPodExceedsNodes means a pod of the pod group requests more than any node of its node pool can
+	// allocate.
+	PodGroupReasonPodExceedsNodes = "PodExceedsNodes"
+	// PodGroupReasonExceedsQueueLimit means the minimum members of the pod group request more than the limit of its
+	// queue or of one of the queue's ancestors.
+	PodGroupReasonExceedsQueueLimit = "ExceedsQueueLimit"
+	// PodGroupReasonFeasible is the reason of a false Infeasible condition.
+	PodGroupReasonFeasible = "Feasible"
+	// PodGroupReasonNoReadyNodes means the node pool of the pod group has no ready nodes.
+	PodGroupReasonNoReadyNodes = "NoReadyNodes"
+	// PodGroupReasonNodePoolReady is the reason of a false NodePoolUnavailable condition.
+	PodGroupReasonNodePoolReady = "NodePoolReady"
+	// PodGroupReasonMovedToFallbackNodePool means the pod group was moved to the fallback node pool, since its node
+	// pool had no ready nodes.
+	PodGroupReasonMovedToFallbackNodePool = "MovedToFallbackNodePool"
+)
+
+// FindPodGroupCondition returns the condition of the given type, or nil if it is not set.
+func FindPodGroupCondition(conditions []PodGroupCondition, conditionType PodGroupConditionType) *PodGroupCondition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetPodGroupCondition adds or updates the condition of the given type. The last transition time is kept
+// if the status of the condition did not change. Returns true if the conditions were changed.
+func SetPodGroupCondition(conditions *[]PodGroupCondition, newCondition PodGroupCondition, now metav1.Time) bool {
+	existing := FindPodGroupCondition(*conditions, newCondition.Type)
+	if existing == nil {
+		newCondition.LastTransitionTime = now
+		*conditions = append(*conditions, newCondition)
+		return true
+	}
+
+	if existing.Status == newCondition.Status && existing.Reason == newCondition.Reason &&
+		existing.Message == newCondition.Message {
+		return false
+	}
+
+	if existing.Status != newCondition.Status {
+		existing.LastTransitionTime = now
+	}
+	existing.Status = newCondition.Status
+	existing.Reason = newCondition.Reason
+	existing.Message = newCondition.Message
+	return true
+}
+
+// IsPodGroupConditionTrue returns true if the condition of the given type is set with a true status.
+func IsPodGroupConditionTrue(conditions []PodGroupCondition, conditionType PodGroupConditionType) bool {
+	condition := FindPodGroupCondition(conditions, conditionType)
+	return condition != nil && condition.Status == v1.ConditionTrue
+}
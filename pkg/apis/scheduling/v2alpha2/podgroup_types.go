@@ -54,6 +54,12 @@ type PodGroupSpec struct {
 	// When unspecified, preemptibility is determined by the PriorityClass value - values below 100 are considered preemptible.
 	Preemptibility Preemptibility `json:"preemptibility,omitempty" protobuf:"bytes,4,opt,name=preemptibility"`
 
+	// WorkloadClass selects how the PodGroup is accounted, whether its resources may be reclaimed and how its pods
+	// are placed on nodes. Must be allowed by the queue of the PodGroup. When unspecified, the default class of the
+	// queue is used, if it sets one.
+	// +optional
+	WorkloadClass WorkloadClass `json:"workloadClass,omitempty"`
+
 	// The number of pods which will try to run at any instant.
 	Parallelism int32 `json:"parallelism,omitempty" protobuf:"bytes,4,opt,name=parallelism"`
 
@@ -74,6 +80,76 @@ type PodGroupSpec struct {
 
 	// SubGroups defines finer-grained subsets of pods within the PodGroup with individual scheduling constraints
 	SubGroups []SubGroup `json:"subGroups,omitempty"`
+
+	// UniqueNodes requires every member pod of the PodGroup to be scheduled on a different node.
+	// +optional
+	UniqueNodes bool `json:"uniqueNodes,omitempty"`
+
+	// PreferredNodeAffinityTerms defines soft node affinity preferences shared by all members of the PodGroup.
+	// The terms are merged with each pod's own preferred node affinity terms when scoring nodes.
+	// +optional
+	PreferredNodeAffinityTerms []v1.PreferredSchedulingTerm `json:"preferredNodeAffinityTerms,omitempty"`
+
+	// MinRuntimeBeforePreemption is the minimum time the PodGroup runs after it is started before it can be
+	// preempted or have its resources reclaimed. Overrides the preemptMinRuntime and reclaimMinRuntime of the queue.
+	// +optional
+	MinRuntimeBeforePreemption *metav1.Duration `json:"minRuntimeBeforePreemption,omitempty"`
+
+	// Tolerations are added by the admission webhook to every member pod that references the PodGroup when it
+	// is created. Tolerations already set on the pod for the same key and effect take precedence.
+	// +optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+
+	// NodeSelector is merged by the admission webhook into the node selector of every member pod that references
+	// the PodGroup when it is created. Keys already set on the pod take precedence.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Replaces is the name of a PodGroup in the same namespace and queue that this PodGroup is a new revision of,
+	// for rolling out a gang without dropping the old one below its minMember. The replaced PodGroup keeps running
+	// until this PodGroup is fully scheduled and running, and is then evicted by the scheduler. Until then, the
+	// resources of the replaced PodGroup are not counted against the quota and limit of the queue when this
+	// PodGroup is scheduled.
+	// +optional
+	Replaces string `json:"replaces,omitempty"`
+
+	// ConstraintRelaxation makes the scheduler drop the soft constraints of the PodGroup one by one, when it fails
+	// to schedule the PodGroup for a number of consecutive scheduling cycles. The dropped constraints are recorded
+	// in the relaxedConstraints of the PodGroup status.
+	// +optional
+	ConstraintRelaxation *ConstraintRelaxation `json:"constraintRelaxation,omitempty"`
+}
+
+// ConstraintRelaxation defines when and in which order the soft constraints of a PodGroup are relaxed
+type ConstraintRelaxation struct {
+	// FailedCycles is the number of consecutive scheduling cycles the PodGroup fails to be scheduled in before the
+	// next constraint is relaxed.
+	// +kubebuilder:validation:Minimum=1
+	FailedCycles int32 `json:"failedCycles"`
+
+	// Constraints are the soft constraints to relax, in the order they are relaxed. Constraints that the PodGroup
+	// doesn't set are skipped. Defaults to PreferredTopology, PreferredNodeAffinity and SubGroupSpread.
+	// +optional
+	Constraints []SoftConstraint `json:"constraints,omitempty"`
+}
+
+// SoftConstraint is a scheduling constraint of a PodGroup that may be relaxed
+// +kubebuilder:validation:Enum=PreferredTopology;PreferredNodeAffinity;SubGroupSpread
+type SoftConstraint string
+
+const (
+	// PreferredTopologyConstraint is the preferred topology level of the PodGroup and its SubGroups
+	PreferredTopologyConstraint SoftConstraint = "PreferredTopology"
+	// PreferredNodeAffinityConstraint is the preferred node affinity of the PodGroup and its pods
+	PreferredNodeAffinityConstraint SoftConstraint = "PreferredNodeAffinity"
+	// SubGroupSpreadConstraint is the subgroup spread topology level of the PodGroup and its SubGroups
+	SubGroupSpreadConstraint SoftConstraint = "SubGroupSpread"
+)
+
+// DefaultRelaxedConstraints returns the soft constraints that are relaxed when a ConstraintRelaxation doesn't set
+// them, in the order they are relaxed
+func DefaultRelaxedConstraints() []SoftConstraint {
+	return []SoftConstraint{PreferredTopologyConstraint, PreferredNodeAffinityConstraint, SubGroupSpreadConstraint}
 }
 
 // Preemptibility defines whether this PodGroup can be preempted
@@ -107,6 +183,51 @@ func ParsePreemptibility(value string) (Preemptibility, error) {
 	}
 }
 
+// WorkloadClass defines how the resources of a PodGroup are accounted and reclaimed
+//
+// Supported values are:
+// - `guaranteed` - PodGroup is accounted by its requests within the quota of its queue, and is never preempted
+// - `burstable-gpu` - PodGroup is accounted by its requests, may exceed the quota of its queue and be reclaimed
+// - `best-effort-gpu` - PodGroup is accounted to the scavenger queue instead of its own queue, and is reclaimed first
+//
+// +kubebuilder:validation:Enum=guaranteed;burstable-gpu;best-effort-gpu
+// +optional
+type WorkloadClass string
+
+const (
+	Guaranteed    WorkloadClass = "guaranteed"
+	BurstableGPU  WorkloadClass = "burstable-gpu"
+	BestEffortGPU WorkloadClass = "best-effort-gpu"
+)
+
+func ParseWorkloadClass(value string) (WorkloadClass, error) {
+	switch value {
+	case string(Guaranteed):
+		return Guaranteed, nil
+	case string(BurstableGPU):
+		return BurstableGPU, nil
+	case string(BestEffortGPU):
+		return BestEffortGPU, nil
+	case "":
+		// Empty value is valid and represents the default class of the queue
+		return "", nil
+	default:
+		return "", fmt.Errorf("invalid workload class value: %s", value)
+	}
+}
+
+// Preemptibility returns the preemptibility implied by the workload class, or an empty preemptibility if the class
+// doesn't imply one
+func (wc WorkloadClass) Preemptibility() Preemptibility {
+	switch wc {
+	case Guaranteed:
+		return NonPreemptible
+	case BurstableGPU, BestEffortGPU:
+		return Preemptible
+	}
+	return ""
+}
+
 type SubGroup struct {
 	// Name uniquely identifies the SubGroup within the PodGroup.
 	// +kubebuilder:validation:MinLength=1
@@ -123,8 +244,38 @@ type SubGroup struct {
 
 	// TopologyConstraint defines the topology constraints for this SubGroup
 	TopologyConstraint *TopologyConstraint `json:"topologyConstraint,omitempty"`
+
+	// PodSelector assigns the pods of the PodGroup that don't declare their SubGroup to this SubGroup when their
+	// labels match it, for workloads whose controllers can't label their pods with a SubGroup.
+	// Pods that match the selectors of several SubGroups are assigned to the first of them.
+	// Can only be set on SubGroups without child SubGroups.
+	// +kubebuilder:validation:Optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+
+	// UniqueNodes requires the member pods of this SubGroup, including the pods of its child SubGroups, to be
+	// scheduled on different nodes. Pods of other SubGroups may share a node with them.
+	// +kubebuilder:validation:Optional
+	UniqueNodes bool `json:"uniqueNodes,omitempty"`
+
+	// GPUInterconnect requires the member pods of this SubGroup, including the pods of its child SubGroups, to be
+	// scheduled within a single domain of the given GPU interconnect, e.g. for the shards of a tensor-parallel model.
+	// +kubebuilder:validation:Optional
+	GPUInterconnect GPUInterconnect `json:"gpuInterconnect,omitempty"`
 }
 
+// GPUInterconnect defines the GPU interconnect domain that the pods of a SubGroup must share
+//
+// Supported values are:
+//   - `NVLinkDomain` - pods are scheduled on nodes of a single NVLink domain, as labeled by GPU feature discovery, or
+//     on a single node if their nodes aren't labeled with an NVLink domain
+//
+// +kubebuilder:validation:Enum=NVLinkDomain
+type GPUInterconnect string
+
+const (
+	NVLinkDomain GPUInterconnect = "NVLinkDomain"
+)
+
 // PodGroupStatus defines the observed state of PodGroup
 type PodGroupStatus struct {
 	// Current phase of PodGroup.
@@ -157,6 +308,84 @@ type PodGroupStatus struct {
 	// The scheduling conditions of PodGroup.
 	// +optional
 	SchedulingConditions []SchedulingCondition `json:"schedulingConditions,omitempty" protobuf:"bytes,7,opt,name=schedulingConditions"`
+
+	// StartTimePrediction is the scheduler's estimate of when a pending pod group will start.
+	// +optional
+	StartTimePrediction *StartTimePrediction `json:"startTimePrediction,omitempty"`
+
+	// RelaxedConstraints are the soft constraints that the scheduler dropped after failing to schedule the PodGroup,
+	// in the order they were dropped.
+	// +optional
+	RelaxedConstraints []RelaxedConstraint `json:"relaxedConstraints,omitempty"`
+
+	// Preemptions records how often the scheduler preempted or reclaimed the PodGroup.
+	// +optional
+	Preemptions *PodGroupPreemptions `json:"preemptions,omitempty"`
+
+	// PlaceableReplicas is the scheduler's estimate of the number of pods of an elastic PodGroup that could be
+	// placed immediately: its allocated pods, and the copies of its pods that fit the idle resources of the nodes.
+	// Owning controllers can scale the PodGroup to this number instead of creating pods that stay pending.
+	// +optional
+	PlaceableReplicas *int32 `json:"placeableReplicas,omitempty"`
+}
+
+// PodGroupPreemptions records the preemptions and reclaims of a PodGroup
+type PodGroupPreemptions struct {
+	// Count is the number of scheduling cycles in which pods of the PodGroup were evicted by the preempt or reclaim
+	// actions
+	Count int32 `json:"count"`
+
+	// LastPreemptionTime is the time pods of the PodGroup were last evicted by the preempt or reclaim actions
+	// +optional
+	LastPreemptionTime *metav1.Time `json:"lastPreemptionTime,omitempty"`
+}
+
+// RelaxedConstraint is a soft constraint that the scheduler no longer applies to the PodGroup
+type RelaxedConstraint struct {
+	// Constraint is the relaxed constraint
+	Constraint SoftConstraint `json:"constraint"`
+
+	// RelaxationTime is the time the constraint was relaxed
+	RelaxationTime metav1.Time `json:"relaxationTime"`
+}
+
+// IsConstraintRelaxed returns true if the scheduler dropped the constraint of the PodGroup
+func (s *PodGroupStatus) IsConstraintRelaxed(constraint SoftConstraint) bool {
+	for _, relaxed := range s.RelaxedConstraints {
+		if relaxed.Constraint == constraint {
+			return true
+		}
+	}
+	return false
+}
+
+// StartTimePredictionReason explains how the start time of a pending pod group was predicted
+type StartTimePredictionReason string
+
+const (
+	// WithinQuota means the pod group fits in the unused deserved quota of its queue, reclaiming it if needed
+	WithinQuota StartTimePredictionReason = "WithinQuota"
+	// WaitingForRunningJobs means the pod group waits for running jobs of its queue to finish
+	WaitingForRunningJobs StartTimePredictionReason = "WaitingForRunningJobs"
+	// NoHistory means there are not enough finished jobs in the queue to estimate job durations
+	NoHistory StartTimePredictionReason = "NoHistory"
+)
+
+// StartTimePrediction is an estimate of when a pending pod group will start, based on its position in its queue,
+// the deserved quota of the queue and the durations of the queue's past jobs.
+type StartTimePrediction struct {
+	// ExpectedStartTime is the estimated start time of the pod group. Not set when it cannot be estimated.
+	// +optional
+	ExpectedStartTime *metav1.Time `json:"expectedStartTime,omitempty"`
+
+	// QueuePosition is the position of the pod group among the pending pod groups of its queue, starting at 1
+	QueuePosition int32 `json:"queuePosition"`
+
+	// Reason explains how the start time was predicted
+	Reason StartTimePredictionReason `json:"reason"`
+
+	// LastUpdateTime is the last time the prediction changed
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
 }
 
 // PodGroupPhase is the phase of a pod group at the current time.
@@ -177,7 +406,8 @@ type PodGroupResourcesStatus struct {
 	AllocatedNonPreemptible v1.ResourceList `json:"allocatedNonPreemptible,omitempty"`
 
 	// Current requested GPU (in fracions), CPU (in millicpus) and Memory in megabytes
-	// by all running and pending jobs in queue and child queues
+	// by all running and pending pods of this pod group. The request of a pod is its effective request, which
+	// accounts for its init containers, sidecars and overhead
 	// +optional
 	Requested v1.ResourceList `json:"requested,omitempty"`
 }
@@ -301,6 +531,29 @@ type UnschedulableExplanationDetails struct {
 	// QueueDetails contains information about the queue that the pod group is trying to schedule in. Used in NonPreemptibleOverQuota and OverLimit reasons.
 	// +optional
 	QueueDetails *QuotaDetails `json:"queueDetails,omitempty" protobuf:"bytes,1,opt,name=queueDetails"`
+
+	// NodeDetails contains the number of nodes that rejected a pod of the pod group, by the reason they rejected it.
+	// Used when no node was found for a pod of the pod group.
+	// +optional
+	NodeDetails *NodeFilterDetails `json:"nodeDetails,omitempty" protobuf:"bytes,2,opt,name=nodeDetails"`
+}
+
+type NodeFilterDetails struct {
+	// TotalNodes is the number of nodes that the pod was considered for.
+	TotalNodes int `json:"totalNodes" protobuf:"varint,1,opt,name=totalNodes"`
+
+	// FilterFailures are the reasons that nodes rejected the pod, with the number of nodes that rejected it for
+	// each reason. A node may reject the pod for several reasons.
+	// +optional
+	FilterFailures []NodeFilterFailure `json:"filterFailures,omitempty" protobuf:"bytes,2,rep,name=filterFailures"`
+}
+
+type NodeFilterFailure struct {
+	// Reason is the reason that the nodes rejected the pod.
+	Reason string `json:"reason" protobuf:"bytes,1,opt,name=reason"`
+
+	// Nodes is the number of nodes that rejected the pod for this reason.
+	Nodes int `json:"nodes" protobuf:"varint,2,opt,name=nodes"`
 }
 
 type QuotaDetails struct {
@@ -344,6 +597,14 @@ const (
 	// OverLimit means that the pod group is not schedulable because scheduling it would exceed the queue's limits.
 	OverLimit UnschedulableReason = "OverLimit"
 
+	// OverPriorityQuotaCap means that the pod group is not schedulable because scheduling it would exceed the share
+	// of the queue's quota allowed for its priority class.
+	OverPriorityQuotaCap UnschedulableReason = "OverPriorityQuotaCap"
+
+	// OverBudget means that the pod group is not schedulable because the GPU hours budget of the queue is exhausted
+	// for the current period.
+	OverBudget UnschedulableReason = "OverBudget"
+
 	// QueueDoesNotExist means the pod group references a queue that doesn't exist or has no parent queue.
 	QueueDoesNotExist UnschedulableReason = "QueueDoesNotExist"
 )
@@ -369,11 +630,26 @@ type TopologyConstraint struct {
 	// Jobs will be scheduled to maintain locality at this level when possible.
 	PreferredTopologyLevel string `json:"preferredTopologyLevel,omitempty"`
 
+	// PreferredTopologyWeight defines, as a percentage, how strongly the pods are packed within the preferred
+	// topology level domains when their nodes are scored. 100 (the default) applies the full topology packing score,
+	// while 0 makes the pods indifferent to the preferred topology level.
+	// SubGroups without a weight use the weight of their parent.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	PreferredTopologyWeight *int32 `json:"preferredTopologyWeight,omitempty"`
+
 	// RequiredTopologyLevel defines the maximal level in the topology hierarchy
 	// that all pods must be scheduled within.
 	// If set, all pods of the job must be scheduled within a single domain at this level.
 	RequiredTopologyLevel string `json:"requiredTopologyLevel,omitempty"`
 
+	// SubGroupSpreadTopologyLevel defines a level in the topology hierarchy across which
+	// the direct child subgroups are spread (e.g., "zone", "rack").
+	// If set, each child subgroup is scheduled in a different domain at this level,
+	// while the pods of every child subgroup follow the child's own topology constraint.
+	SubGroupSpreadTopologyLevel string `json:"subGroupSpreadTopologyLevel,omitempty"`
+
 	// Topology specifies the name of the topology CRD that defines the
 	// physical layout to use for this constraint. This allows for supporting
 	// multiple different topology configurations in the same cluster.
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -80,7 +81,8 @@ func validateSubGroups(subGroups []SubGroup) error {
 	if detectCycle(subGroupMap) {
 		return errors.New("cycle detected in subgroups")
 	}
-	return nil
+
+	return validatePodSelectors(subGroupMap)
 }
 
 func validateParent(subGroupMap map[string]*SubGroup) error {
@@ -95,6 +97,28 @@ func validateParent(subGroupMap map[string]*SubGroup) error {
 	return nil
 }
 
+func validatePodSelectors(subGroupMap map[string]*SubGroup) error {
+	parents := map[string]bool{}
+	for _, subGroup := range subGroupMap {
+		if subGroup.Parent != nil {
+			parents[*subGroup.Parent] = true
+		}
+	}
+
+	for _, subGroup := range subGroupMap {
+		if subGroup.PodSelector == nil {
+			continue
+		}
+		if parents[subGroup.Name] {
+			return fmt.Errorf("pod selector of %s is not allowed on a subgroup with child subgroups", subGroup.Name)
+		}
+		if _, err := metav1.LabelSelectorAsSelector(subGroup.PodSelector); err != nil {
+			return fmt.Errorf("invalid pod selector of %s: %w", subGroup.Name, err)
+		}
+	}
+	return nil
+}
+
 func detectCycle(subGroupMap map[string]*SubGroup) bool {
 	graph := map[string][]string{}
 	for _, subGroup := range subGroupMap {
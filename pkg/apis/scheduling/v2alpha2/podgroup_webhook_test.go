@@ -7,6 +7,7 @@ import (
 	"errors"
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
 
@@ -92,6 +93,35 @@ func TestValidateSubGroups(t *testing.T) {
 			},
 			wantErr: errors.New("cycle detected in subgroups"),
 		},
+		{
+			name: "Pod selectors on leaf subgroups",
+			subGroups: []SubGroup{
+				{Name: "A", MinMember: 1},
+				{Name: "B", Parent: ptr.To("A"), MinMember: 1,
+					PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"role": "worker"}}},
+				{Name: "C", MinMember: 1,
+					PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"role": "leader"}}},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Pod selector on a subgroup with child subgroups",
+			subGroups: []SubGroup{
+				{Name: "A", MinMember: 1,
+					PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"role": "worker"}}},
+				{Name: "B", Parent: ptr.To("A"), MinMember: 1},
+			},
+			wantErr: errors.New("pod selector of A is not allowed on a subgroup with child subgroups"),
+		},
+		{
+			name: "Invalid pod selector",
+			subGroups: []SubGroup{
+				{Name: "A", MinMember: 1, PodSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "role", Operator: "Matches"}},
+				}},
+			},
+			wantErr: errors.New("invalid pod selector of A: \"Matches\" is not a valid label selector operator"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -11,9 +11,65 @@ package v2alpha2
 
 import (
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConstraintRelaxation) DeepCopyInto(out *ConstraintRelaxation) {
+	*out = *in
+	if in.Constraints != nil {
+		in, out := &in.Constraints, &out.Constraints
+		*out = make([]SoftConstraint, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConstraintRelaxation.
+func (in *ConstraintRelaxation) DeepCopy() *ConstraintRelaxation {
+	if in == nil {
+		return nil
+	}
+	out := new(ConstraintRelaxation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeFilterDetails) DeepCopyInto(out *NodeFilterDetails) {
+	*out = *in
+	if in.FilterFailures != nil {
+		in, out := &in.FilterFailures, &out.FilterFailures
+		*out = make([]NodeFilterFailure, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeFilterDetails.
+func (in *NodeFilterDetails) DeepCopy() *NodeFilterDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeFilterDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeFilterFailure) DeepCopyInto(out *NodeFilterFailure) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeFilterFailure.
+func (in *NodeFilterFailure) DeepCopy() *NodeFilterFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeFilterFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodGroup) DeepCopyInto(out *PodGroup) {
 	*out = *in
@@ -89,6 +145,25 @@ func (in *PodGroupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodGroupPreemptions) DeepCopyInto(out *PodGroupPreemptions) {
+	*out = *in
+	if in.LastPreemptionTime != nil {
+		in, out := &in.LastPreemptionTime, &out.LastPreemptionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodGroupPreemptions.
+func (in *PodGroupPreemptions) DeepCopy() *PodGroupPreemptions {
+	if in == nil {
+		return nil
+	}
+	out := new(PodGroupPreemptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodGroupResourcesStatus) DeepCopyInto(out *PodGroupResourcesStatus) {
 	*out = *in
@@ -138,7 +213,7 @@ func (in *PodGroupSpec) DeepCopyInto(out *PodGroupSpec) {
 		*out = new(int32)
 		**out = **in
 	}
-	out.TopologyConstraint = in.TopologyConstraint
+	in.TopologyConstraint.DeepCopyInto(&out.TopologyConstraint)
 	if in.SubGroups != nil {
 		in, out := &in.SubGroups, &out.SubGroups
 		*out = make([]SubGroup, len(*in))
@@ -146,6 +221,37 @@ func (in *PodGroupSpec) DeepCopyInto(out *PodGroupSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PreferredNodeAffinityTerms != nil {
+		in, out := &in.PreferredNodeAffinityTerms, &out.PreferredNodeAffinityTerms
+		*out = make([]v1.PreferredSchedulingTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MinRuntimeBeforePreemption != nil {
+		in, out := &in.MinRuntimeBeforePreemption, &out.MinRuntimeBeforePreemption
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ConstraintRelaxation != nil {
+		in, out := &in.ConstraintRelaxation, &out.ConstraintRelaxation
+		*out = new(ConstraintRelaxation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodGroupSpec.
@@ -176,6 +282,28 @@ func (in *PodGroupStatus) DeepCopyInto(out *PodGroupStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.StartTimePrediction != nil {
+		in, out := &in.StartTimePrediction, &out.StartTimePrediction
+		*out = new(StartTimePrediction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RelaxedConstraints != nil {
+		in, out := &in.RelaxedConstraints, &out.RelaxedConstraints
+		*out = make([]RelaxedConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Preemptions != nil {
+		in, out := &in.Preemptions, &out.Preemptions
+		*out = new(PodGroupPreemptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PlaceableReplicas != nil {
+		in, out := &in.PlaceableReplicas, &out.PlaceableReplicas
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodGroupStatus.
@@ -252,6 +380,22 @@ func (in *QuotaDetails) DeepCopy() *QuotaDetails {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RelaxedConstraint) DeepCopyInto(out *RelaxedConstraint) {
+	*out = *in
+	in.RelaxationTime.DeepCopyInto(&out.RelaxationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RelaxedConstraint.
+func (in *RelaxedConstraint) DeepCopy() *RelaxedConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(RelaxedConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulingCondition) DeepCopyInto(out *SchedulingCondition) {
 	*out = *in
@@ -275,6 +419,26 @@ func (in *SchedulingCondition) DeepCopy() *SchedulingCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StartTimePrediction) DeepCopyInto(out *StartTimePrediction) {
+	*out = *in
+	if in.ExpectedStartTime != nil {
+		in, out := &in.ExpectedStartTime, &out.ExpectedStartTime
+		*out = (*in).DeepCopy()
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartTimePrediction.
+func (in *StartTimePrediction) DeepCopy() *StartTimePrediction {
+	if in == nil {
+		return nil
+	}
+	out := new(StartTimePrediction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubGroup) DeepCopyInto(out *SubGroup) {
 	*out = *in
@@ -286,7 +450,12 @@ func (in *SubGroup) DeepCopyInto(out *SubGroup) {
 	if in.TopologyConstraint != nil {
 		in, out := &in.TopologyConstraint, &out.TopologyConstraint
 		*out = new(TopologyConstraint)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -303,6 +472,11 @@ func (in *SubGroup) DeepCopy() *SubGroup {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopologyConstraint) DeepCopyInto(out *TopologyConstraint) {
 	*out = *in
+	if in.PreferredTopologyWeight != nil {
+		in, out := &in.PreferredTopologyWeight, &out.PreferredTopologyWeight
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyConstraint.
@@ -343,6 +517,11 @@ func (in *UnschedulableExplanationDetails) DeepCopyInto(out *UnschedulableExplan
 		*out = new(QuotaDetails)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NodeDetails != nil {
+		in, out := &in.NodeDetails, &out.NodeDetails
+		*out = new(NodeFilterDetails)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnschedulableExplanationDetails.
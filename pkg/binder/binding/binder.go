@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -16,11 +17,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/binding/resourcereservation"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/common"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/state"
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/podgroup"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 )
 
 var InvalidCrdWarning = errors.New("invalid binding request")
@@ -29,13 +33,17 @@ type Binder struct {
 	kubeClient                 client.Client
 	resourceReservationService resourcereservation.Interface
 	plugins                    *plugins.BinderPlugins
+	gpuBindClaims              bool
 }
 
-func NewBinder(kubeClient client.Client, rrs resourcereservation.Interface, plugins *plugins.BinderPlugins) *Binder {
+func NewBinder(
+	kubeClient client.Client, rrs resourcereservation.Interface, plugins *plugins.BinderPlugins, gpuBindClaims bool,
+) *Binder {
 	return &Binder{
 		kubeClient:                 kubeClient,
 		resourceReservationService: rrs,
 		plugins:                    plugins,
+		gpuBindClaims:              gpuBindClaims,
 	}
 }
 
@@ -46,6 +54,10 @@ func (b *Binder) Bind(ctx context.Context, pod *v1.Pod, node *v1.Node, bindReque
 		return fmt.Errorf("failed to sync reservation for pod <%s/%s> on node <%s>: %w", pod.Namespace, pod.Name, bindRequest.Spec.SelectedNode, err)
 	}
 
+	if b.gpuBindClaims {
+		b.claimPodGroupGPUs(ctx, pod, bindRequest)
+	}
+
 	var reservedGPUIds []string
 	if common.IsSharedGPUAllocation(bindRequest) {
 		reservedGPUIds, err = b.reserveGPUs(ctx, pod, bindRequest)
@@ -67,6 +79,17 @@ func (b *Binder) Bind(ctx context.Context, pod *v1.Pod, node *v1.Node, bindReque
 		return fmt.Errorf("failed to patch pod <%s/%s> with resource receive type annotation: %w", pod.Namespace, pod.Name, err)
 	}
 
+	err = b.patchPodGroupTolerations(ctx, pod)
+	if err != nil {
+		return fmt.Errorf("failed to patch pod <%s/%s> with the tolerations of its pod group: %w", pod.Namespace, pod.Name, err)
+	}
+
+	if b.gpuBindClaims && common.IsWholeGPUAllocation(bindRequest) {
+		if err = b.resourceReservationService.ReleaseGpuClaim(ctx, bindRequest); err != nil {
+			return fmt.Errorf("failed to release GPU claim of pod <%s/%s>: %w", pod.Namespace, pod.Name, err)
+		}
+	}
+
 	logger.Info("Binding pod", "namespace", pod.Namespace, "name", pod.Name, "hostname", node.Name)
 	binding := &v1.Binding{
 		ObjectMeta: metav1.ObjectMeta{Namespace: pod.Namespace, Name: pod.Name, UID: pod.UID},
@@ -127,12 +150,107 @@ func (b *Binder) reserveGPUs(ctx context.Context, pod *v1.Pod, bindRequest *v1al
 	return gpuIndexes, nil
 }
 
+// claimPodGroupGPUs claims the whole GPUs of the other bind requests of the pod group that are not bound yet, so that
+// other schedulers can't use them while the pod group is being bound. The GPUs of the bind request itself are used by
+// its pod right away, so they aren't claimed.
+func (b *Binder) claimPodGroupGPUs(ctx context.Context, pod *v1.Pod, bindRequest *v1alpha2.BindRequest) {
+	logger := log.FromContext(ctx)
+
+	podGroupName := pod.Annotations[constants.PodGroupAnnotationForPod]
+	if podGroupName == "" {
+		return
+	}
+	bindRequests, err := b.pendingPodGroupBindRequests(ctx, pod.Namespace, podGroupName, bindRequest)
+	if err != nil {
+		logger.Error(err, "Failed to list pending bind requests of pod group",
+			"namespace", pod.Namespace, "podGroup", podGroupName)
+	}
+	if len(bindRequests) == 0 {
+		return
+	}
+
+	if err = b.resourceReservationService.ClaimWholeGpus(ctx, bindRequests); err != nil {
+		logger.Error(err, "Failed to claim GPUs of pending bind requests", "pod", pod.Name,
+			"namespace", pod.Namespace)
+	}
+}
+
+// pendingPodGroupBindRequests returns the whole GPU bind requests of the pod group's pods that are not bound yet. Pods
+// and bind requests are looked up through cache indexes, so only the bind requests of the pod group are visited.
+func (b *Binder) pendingPodGroupBindRequests(
+	ctx context.Context, namespace, podGroupName string, bindRequest *v1alpha2.BindRequest,
+) ([]*v1alpha2.BindRequest, error) {
+	podGroupPods := &v1.PodList{}
+	err := b.kubeClient.List(ctx, podGroupPods, client.InNamespace(namespace),
+		client.MatchingFields{common.PodGroupIndexField: podGroupName})
+	if err != nil {
+		return nil, err
+	}
+
+	var bindRequests []*v1alpha2.BindRequest
+	for _, podGroupPod := range podGroupPods.Items {
+		if podGroupPod.Spec.NodeName != "" || podGroupPod.Name == bindRequest.Spec.PodName {
+			continue
+		}
+
+		podBindRequests := &v1alpha2.BindRequestList{}
+		err = b.kubeClient.List(ctx, podBindRequests, client.InNamespace(namespace),
+			client.MatchingFields{common.BindRequestPodNameIndexField: podGroupPod.Name})
+		if err != nil {
+			return bindRequests, err
+		}
+		for index := range podBindRequests.Items {
+			other := &podBindRequests.Items[index]
+			if other.UID == bindRequest.UID || other.DeletionTimestamp != nil ||
+				other.Status.Phase == v1alpha2.BindRequestPhaseSucceeded || !common.IsWholeGPUAllocation(other) {
+				continue
+			}
+			bindRequests = append(bindRequests, other)
+		}
+	}
+	return bindRequests, nil
+}
+
+// patchPodGroupTolerations adds the tolerations of the pod group to the pod, for pods that were created before their
+// pod group and so weren't mutated by the admission webhook. The scheduler already considered these tolerations, and
+// without them the pod would be evicted from nodes with NoExecute taints.
+func (b *Binder) patchPodGroupTolerations(ctx context.Context, pod *v1.Pod) error {
+	podGroupName := pod.Annotations[constants.PodGroupAnnotationForPod]
+	if podGroupName == "" {
+		return nil
+	}
+	podGroup := &v2alpha2.PodGroup{}
+	err := b.kubeClient.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: podGroupName}, podGroup)
+	if err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	tolerated := pod.DeepCopy()
+	if !podgroup.ApplySchedulingConstraints(tolerated, podGroup.Spec.Tolerations, nil) {
+		return nil
+	}
+	patchBytes, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"tolerations": tolerated.Spec.Tolerations,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return b.kubeClient.Patch(ctx, pod, client.RawPatch(types.MergePatchType, patchBytes))
+}
+
 func (b *Binder) patchResourceReceivedTypeAnnotation(ctx context.Context, pod *v1.Pod, bindRequest *v1alpha2.BindRequest) error {
+	annotations := map[string]string{
+		constants.ReceivedResourceType: bindRequest.Spec.ReceivedResourceType,
+	}
+	if resources.RequestsGPUCountRange(pod) && bindRequest.Spec.ReceivedGPU != nil {
+		annotations[constants.GpuCountGranted] = strconv.Itoa(bindRequest.Spec.ReceivedGPU.Count)
+	}
 	patchBytes, err := json.Marshal(map[string]interface{}{
 		"metadata": map[string]interface{}{
-			"annotations": map[string]string{
-				constants.ReceivedResourceType: bindRequest.Spec.ReceivedResourceType,
-			},
+			"annotations": annotations,
 		},
 	})
 	if err != nil {
@@ -12,10 +12,12 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 
 	rrmock "github.com/NVIDIA/KAI-scheduler/pkg/binder/binding/resourcereservation/mock"
@@ -74,7 +76,7 @@ func TestBind(t *testing.T) {
 	bindingGpuSharingPlugin := bindinggpusharing.New(kubeClient, false)
 	binderPlugins.RegisterPlugin(bindingGpuSharingPlugin)
 
-	binder := NewBinder(kubeClient, rrs, binderPlugins)
+	binder := NewBinder(kubeClient, rrs, binderPlugins, false)
 
 	err := binder.Bind(
 		context.TODO(),
@@ -94,57 +96,61 @@ func TestBind(t *testing.T) {
 }
 
 func TestBindApplyResourceReceivedType(t *testing.T) {
-	pod := &v1.Pod{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: "my-ns",
-			Name:      "my-pod",
-			Annotations: map[string]string{
-				gpuSharingConfigMapAnnotation: "my-config",
+	pod := newGpuSharingPod()
+	kubeObjects := []runtime.Object{
+		pod,
+		&v1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "my-node",
 			},
 		},
-		Spec: v1.PodSpec{
-			Containers: []v1.Container{
-				{
-					Env: []v1.EnvVar{
-						{
-							Name: constants.NvidiaVisibleDevices,
-							ValueFrom: &v1.EnvVarSource{
-								ConfigMapKeyRef: &v1.ConfigMapKeySelector{
-									Key: constants.NvidiaVisibleDevices,
-									LocalObjectReference: v1.LocalObjectReference{
-										Name: "my-config-0",
-									},
-								},
-							},
-						},
-						{
-							Name: common.GPUPortion,
-							ValueFrom: &v1.EnvVarSource{
-								ConfigMapKeyRef: &v1.ConfigMapKeySelector{
-									Key: common.GPUPortion,
-									LocalObjectReference: v1.LocalObjectReference{
-										Name: "my-config-0",
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-			Volumes: []v1.Volume{
-				{
-					Name: "my-configmap-vol",
-					VolumeSource: v1.VolumeSource{
-						ConfigMap: &v1.ConfigMapVolumeSource{
-							LocalObjectReference: v1.LocalObjectReference{
-								Name: "my-config-0",
-							},
-						},
-					},
-				},
+	}
+
+	bindRequest := &v1alpha2.BindRequest{
+		Spec: v1alpha2.BindRequestSpec{
+			SelectedNode:         "my-node",
+			ReceivedResourceType: common.ReceivedTypeFraction,
+			SelectedGPUGroups:    []string{"group1"},
+			ReceivedGPU: &v1alpha2.ReceivedGPU{
+				Count:   1,
+				Portion: "1",
 			},
 		},
 	}
+
+	controller := gomock.NewController(t)
+	rrs := rrmock.NewMockInterface(controller)
+	rrs.EXPECT().SyncForNode(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+	rrs.EXPECT().ReserveGpuDevice(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).
+		Return("1", nil)
+
+	kubeClient := fake.NewClientBuilder().WithRuntimeObjects(kubeObjects...).WithInterceptorFuncs(test_utils.EmptyBind).Build()
+
+	binderPlugins := plugins.New()
+	bindingGpuSharingPlugin := bindinggpusharing.New(kubeClient, false)
+	binderPlugins.RegisterPlugin(bindingGpuSharingPlugin)
+
+	binder := NewBinder(kubeClient, rrs, binderPlugins, false)
+
+	err := binder.Bind(context.TODO(), pod, &v1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name: "my-node",
+	}}, bindRequest)
+
+	assert.Nil(t, err)
+
+	newPod := &v1.Pod{}
+	err = kubeClient.Get(context.TODO(), client.ObjectKey{
+		Namespace: "my-ns",
+		Name:      "my-pod",
+	}, newPod)
+	assert.Nil(t, err)
+	assert.Equal(t, common.ReceivedTypeFraction, newPod.Annotations[constants.ReceivedResourceType])
+}
+
+func TestBindApplyGrantedGpuCount(t *testing.T) {
+	pod := newGpuSharingPod()
+	pod.Annotations[constants.GpuCountMin] = "1"
+	pod.Annotations[constants.GpuCountMax] = "4"
 	kubeObjects := []runtime.Object{
 		pod,
 		&v1.Node{
@@ -158,9 +164,9 @@ func TestBindApplyResourceReceivedType(t *testing.T) {
 		Spec: v1alpha2.BindRequestSpec{
 			SelectedNode:         "my-node",
 			ReceivedResourceType: common.ReceivedTypeFraction,
-			SelectedGPUGroups:    []string{"group1"},
+			SelectedGPUGroups:    []string{"group1", "group2"},
 			ReceivedGPU: &v1alpha2.ReceivedGPU{
-				Count:   1,
+				Count:   2,
 				Portion: "1",
 			},
 		},
@@ -169,7 +175,7 @@ func TestBindApplyResourceReceivedType(t *testing.T) {
 	controller := gomock.NewController(t)
 	rrs := rrmock.NewMockInterface(controller)
 	rrs.EXPECT().SyncForNode(gomock.Any(), gomock.Any()).Times(1).Return(nil)
-	rrs.EXPECT().ReserveGpuDevice(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).
+	rrs.EXPECT().ReserveGpuDevice(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(2).
 		Return("1", nil)
 
 	kubeClient := fake.NewClientBuilder().WithRuntimeObjects(kubeObjects...).WithInterceptorFuncs(test_utils.EmptyBind).Build()
@@ -178,7 +184,7 @@ func TestBindApplyResourceReceivedType(t *testing.T) {
 	bindingGpuSharingPlugin := bindinggpusharing.New(kubeClient, false)
 	binderPlugins.RegisterPlugin(bindingGpuSharingPlugin)
 
-	binder := NewBinder(kubeClient, rrs, binderPlugins)
+	binder := NewBinder(kubeClient, rrs, binderPlugins, false)
 
 	err := binder.Bind(context.TODO(), pod, &v1.Node{ObjectMeta: metav1.ObjectMeta{
 		Name: "my-node",
@@ -192,7 +198,7 @@ func TestBindApplyResourceReceivedType(t *testing.T) {
 		Name:      "my-pod",
 	}, newPod)
 	assert.Nil(t, err)
-	assert.Equal(t, common.ReceivedTypeFraction, newPod.Annotations[constants.ReceivedResourceType])
+	assert.Equal(t, "2", newPod.Annotations[constants.GpuCountGranted])
 }
 
 func TestBindFail(t *testing.T) {
@@ -225,7 +231,7 @@ func TestBindFail(t *testing.T) {
 	bindingGpuSharingPlugin := bindinggpusharing.New(kubeClient, false)
 	binderPlugins.RegisterPlugin(bindingGpuSharingPlugin)
 
-	binder := NewBinder(kubeClient, rrs, binderPlugins)
+	binder := NewBinder(kubeClient, rrs, binderPlugins, false)
 
 	err := binder.Bind(context.TODO(), pod, &v1.Node{ObjectMeta: metav1.ObjectMeta{
 		Name: "my-node",
@@ -238,3 +244,170 @@ func TestBindFail(t *testing.T) {
 
 	assert.NotNil(t, err)
 }
+
+func TestBindClaimsPodGroupGPUs(t *testing.T) {
+	newPod := func(name, podGroup, nodeName string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "my-ns",
+				Name:        name,
+				Annotations: map[string]string{constants.PodGroupAnnotationForPod: podGroup},
+			},
+			Spec: v1.PodSpec{NodeName: nodeName},
+		}
+	}
+	newBindRequest := func(name, resourceType string, gpus int, phase string) *v1alpha2.BindRequest {
+		return &v1alpha2.BindRequest{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: name, UID: types.UID(name)},
+			Spec: v1alpha2.BindRequestSpec{
+				PodName:              name,
+				SelectedNode:         "my-node",
+				ReceivedResourceType: resourceType,
+				ReceivedGPU:          &v1alpha2.ReceivedGPU{Count: gpus, Portion: "1"},
+			},
+			Status: v1alpha2.BindRequestStatus{Phase: phase},
+		}
+	}
+
+	pod := newPod("pod-0", "pg", "")
+	bindRequest := newBindRequest("pod-0", common.ReceivedTypeRegular, 2, "")
+	pendingSibling := newBindRequest("pod-1", common.ReceivedTypeRegular, 2, "")
+	kubeObjects := []runtime.Object{
+		pod,
+		bindRequest,
+		newPod("pod-1", "pg", ""),
+		pendingSibling,
+		newPod("pod-2", "pg", "my-node"),
+		newBindRequest("pod-2", common.ReceivedTypeRegular, 2, v1alpha2.BindRequestPhaseSucceeded),
+		newPod("pod-3", "pg", ""),
+		newBindRequest("pod-3", common.ReceivedTypeFraction, 1, ""),
+		newPod("other-pod", "other-pg", ""),
+		newBindRequest("other-pod", common.ReceivedTypeRegular, 2, ""),
+	}
+
+	testScheme := runtime.NewScheme()
+	assert.Nil(t, v1.AddToScheme(testScheme))
+	assert.Nil(t, v1alpha2.AddToScheme(testScheme))
+	assert.Nil(t, v2alpha2.AddToScheme(testScheme))
+	kubeClient := fake.NewClientBuilder().WithScheme(testScheme).WithRuntimeObjects(kubeObjects...).
+		WithIndex(&v1.Pod{}, common.PodGroupIndexField, common.PodGroupIndexer).
+		WithIndex(&v1alpha2.BindRequest{}, common.BindRequestPodNameIndexField, common.BindRequestPodNameIndexer).
+		WithInterceptorFuncs(test_utils.EmptyBind).Build()
+
+	controller := gomock.NewController(t)
+	rrs := rrmock.NewMockInterface(controller)
+	rrs.EXPECT().SyncForNode(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+	claimCall := rrs.EXPECT().ClaimWholeGpus(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, bindRequests []*v1alpha2.BindRequest) error {
+			var names []string
+			for _, claimed := range bindRequests {
+				names = append(names, claimed.Name)
+			}
+			assert.ElementsMatch(t, []string{"pod-1"}, names, "only the sibling bind requests are claimed")
+			return nil
+		})
+	rrs.EXPECT().ReleaseGpuClaim(gomock.Any(), bindRequest).Times(1).After(claimCall).Return(nil)
+
+	binder := NewBinder(kubeClient, rrs, plugins.New(), true)
+	err := binder.Bind(context.TODO(), pod, &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "my-node"}}, bindRequest)
+	assert.Nil(t, err)
+}
+
+func TestBindAddsPodGroupTolerations(t *testing.T) {
+	podToleration := v1.Toleration{Key: "team", Operator: v1.TolerationOpEqual, Value: "b",
+		Effect: v1.TaintEffectNoExecute}
+	podGroupTolerations := []v1.Toleration{
+		{Key: "team", Operator: v1.TolerationOpEqual, Value: "a", Effect: v1.TaintEffectNoExecute},
+		{Key: "dedicated", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoExecute},
+	}
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "my-ns",
+			Name:        "my-pod",
+			Annotations: map[string]string{constants.PodGroupAnnotationForPod: "pg"},
+		},
+		Spec: v1.PodSpec{Tolerations: []v1.Toleration{podToleration}},
+	}
+	podGroup := &v2alpha2.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "pg"},
+		Spec:       v2alpha2.PodGroupSpec{Tolerations: podGroupTolerations},
+	}
+
+	testScheme := runtime.NewScheme()
+	assert.Nil(t, v1.AddToScheme(testScheme))
+	assert.Nil(t, v2alpha2.AddToScheme(testScheme))
+	kubeClient := fake.NewClientBuilder().WithScheme(testScheme).WithRuntimeObjects(pod, podGroup).
+		WithInterceptorFuncs(test_utils.EmptyBind).Build()
+
+	controller := gomock.NewController(t)
+	rrs := rrmock.NewMockInterface(controller)
+	rrs.EXPECT().SyncForNode(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+
+	binder := NewBinder(kubeClient, rrs, plugins.New(), false)
+	err := binder.Bind(context.TODO(), pod, &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "my-node"}},
+		&v1alpha2.BindRequest{
+			Spec: v1alpha2.BindRequestSpec{
+				SelectedNode:         "my-node",
+				ReceivedResourceType: common.ReceivedTypeRegular,
+			},
+		})
+	assert.Nil(t, err)
+
+	boundPod := &v1.Pod{}
+	assert.Nil(t, kubeClient.Get(context.TODO(), client.ObjectKeyFromObject(pod), boundPod))
+	assert.Equal(t, []v1.Toleration{podToleration, podGroupTolerations[1]}, boundPod.Spec.Tolerations)
+}
+
+func newGpuSharingPod() *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-ns",
+			Name:      "my-pod",
+			Annotations: map[string]string{
+				gpuSharingConfigMapAnnotation: "my-config",
+			},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Env: []v1.EnvVar{
+						{
+							Name: constants.NvidiaVisibleDevices,
+							ValueFrom: &v1.EnvVarSource{
+								ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+									Key: constants.NvidiaVisibleDevices,
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "my-config-0",
+									},
+								},
+							},
+						},
+						{
+							Name: common.GPUPortion,
+							ValueFrom: &v1.EnvVarSource{
+								ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+									Key: common.GPUPortion,
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: "my-config-0",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Volumes: []v1.Volume{
+				{
+					Name: "my-configmap-vol",
+					VolumeSource: v1.VolumeSource{
+						ConfigMap: &v1.ConfigMapVolumeSource{
+							LocalObjectReference: v1.LocalObjectReference{
+								Name: "my-config-0",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
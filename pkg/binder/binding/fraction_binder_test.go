@@ -179,7 +179,7 @@ var _ = Describe("FractionBinder", func() {
 				bindingGpuSharingPlugin := bindinggpusharing.New(fakeClient, false)
 				binderPlugins.RegisterPlugin(bindingGpuSharingPlugin)
 
-				testedBinder := NewBinder(fakeClient, rrs, binderPlugins)
+				testedBinder := NewBinder(fakeClient, rrs, binderPlugins, false)
 
 				pod := testData.kubeObjects[0].(*v1.Pod)
 
@@ -241,7 +241,7 @@ var _ = Describe("FractionBinder", func() {
 		bindingGpuSharingPlugin := bindinggpusharing.New(fakeClient, false)
 		binderPlugins.RegisterPlugin(bindingGpuSharingPlugin)
 
-		testedBinder := NewBinder(fakeClient, rrs, binderPlugins)
+		testedBinder := NewBinder(fakeClient, rrs, binderPlugins, false)
 
 		pod := happyFlowObjects[0].(*v1.Pod)
 
@@ -0,0 +1,215 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcereservation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/common"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+const (
+	gpuClaimPodPrefix           = gpuReservationPodPrefix + "-claim"
+	gpuClaimBindRequestLabel    = "kai.scheduler/gpu-claim-bind-request"
+	gpuClaimBindRequestKey      = "kai.scheduler/gpu-claim-bind-request-key"
+	gpuClaimTTL                 = 5 * time.Minute
+	gpuClaimReleasePollInterval = 200 * time.Millisecond
+	gpuClaimReleaseGracePeriod  = 1
+)
+
+// ClaimWholeGpus creates a claim pod on the selected node of every bind request, holding its whole GPUs until the
+// bind request's pod is bound. This keeps other schedulers from using GPUs that were already allocated to pods
+// that are still waiting to be bound.
+func (rsc *service) ClaimWholeGpus(ctx context.Context, bindRequests []*v1alpha2.BindRequest) error {
+	if err := rsc.SyncGpuClaims(ctx); err != nil {
+		return err
+	}
+
+	claims, err := rsc.listGpuClaims(ctx)
+	if err != nil {
+		return err
+	}
+	claimed := map[string]bool{}
+	for _, claim := range claims {
+		claimed[claim.Labels[gpuClaimBindRequestLabel]] = true
+	}
+
+	for _, bindRequest := range bindRequests {
+		if !common.IsWholeGPUAllocation(bindRequest) || claimed[string(bindRequest.UID)] {
+			continue
+		}
+		if err = rsc.claimWholeGpus(ctx, bindRequest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rsc *service) claimWholeGpus(ctx context.Context, bindRequest *v1alpha2.BindRequest) error {
+	logger := log.FromContext(ctx)
+	key := string(bindRequest.UID)
+	rsc.gpuClaimMutex.LockMutexForGroup(key)
+	defer rsc.gpuClaimMutex.ReleaseMutex(key)
+
+	if rsc.isGpuClaimReleased(bindRequest.UID) {
+		return nil
+	}
+
+	pod := rsc.newReservationPod(bindRequest.Spec.SelectedNode, gpuClaimPodName(bindRequest), map[string]string{
+		constants.AppLabelName:   rsc.appLabelValue,
+		gpuClaimBindRequestLabel: key,
+	}, rsc.reservationPodResources(
+		bindRequest.Spec.SelectedNode, int64(bindRequest.Spec.ReceivedGPU.Count)))
+	pod.Annotations[gpuClaimBindRequestKey] = fmt.Sprintf("%s/%s", bindRequest.Namespace, bindRequest.Name)
+
+	err := rsc.kubeClient.Create(ctx, pod)
+	if apierrors.IsAlreadyExists(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create GPU claim pod for bind request <%s/%s>: %w",
+			bindRequest.Namespace, bindRequest.Name, err)
+	}
+	logger.Info("Created GPU claim pod", "name", pod.Name, "node", bindRequest.Spec.SelectedNode,
+		"bindRequest", pod.Annotations[gpuClaimBindRequestKey])
+	return nil
+}
+
+// ReleaseGpuClaim deletes the claim pod of the bind request and waits for it to be removed, so the claimed GPUs
+// are free when the bind request's pod is bound.
+func (rsc *service) ReleaseGpuClaim(ctx context.Context, bindRequest *v1alpha2.BindRequest) error {
+	logger := log.FromContext(ctx)
+	key := string(bindRequest.UID)
+	rsc.gpuClaimMutex.LockMutexForGroup(key)
+	rsc.markGpuClaimReleased(bindRequest.UID)
+	rsc.gpuClaimMutex.ReleaseMutex(key)
+
+	claim := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gpuClaimPodName(bindRequest),
+			Namespace: rsc.namespace,
+		},
+	}
+
+	// Deletion requests are served by the API server, so they also tell whether the claim pod still exists
+	err := wait.PollUntilContextTimeout(ctx, gpuClaimReleasePollInterval, rsc.allocationTimeout, true,
+		func(ctx context.Context) (bool, error) {
+			err := rsc.kubeClient.Delete(ctx, claim, client.GracePeriodSeconds(gpuClaimReleaseGracePeriod))
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		})
+	if err == nil {
+		return nil
+	}
+
+	logger.Error(err, "Failed waiting for GPU claim pod removal, force deleting it", "name", claim.Name)
+	if deleteErr := rsc.kubeClient.Delete(ctx, claim, client.GracePeriodSeconds(0)); client.IgnoreNotFound(deleteErr) != nil {
+		return fmt.Errorf("failed to delete GPU claim pod %s: %w", claim.Name, deleteErr)
+	}
+	return nil
+}
+
+// SyncGpuClaims deletes claim pods whose bind request was completed or deleted, or that outlived the claim TTL
+func (rsc *service) SyncGpuClaims(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+	rsc.pruneReleasedGpuClaims()
+
+	claims, err := rsc.listGpuClaims(ctx)
+	if err != nil || len(claims) == 0 {
+		return err
+	}
+
+	for _, claim := range claims {
+		expired := !claim.CreationTimestamp.IsZero() && time.Since(claim.CreationTimestamp.Time) > gpuClaimTTL
+		if !expired {
+			pending, err := rsc.isGpuClaimBindRequestPending(ctx, claim)
+			if err != nil {
+				return err
+			}
+			if pending {
+				continue
+			}
+		}
+		logger.Info("Deleting stale GPU claim pod", "name", claim.Name, "expired", expired)
+		if err = rsc.deleteReservationPod(ctx, claim); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isGpuClaimBindRequestPending checks whether the bind request that the claim pod holds GPUs for still waits to be bound
+func (rsc *service) isGpuClaimBindRequestPending(ctx context.Context, claim *v1.Pod) (bool, error) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(claim.Annotations[gpuClaimBindRequestKey])
+	if err != nil || name == "" {
+		return false, nil
+	}
+
+	bindRequest := &v1alpha2.BindRequest{}
+	err = rsc.kubeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, bindRequest)
+	if err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	return string(bindRequest.UID) == claim.Labels[gpuClaimBindRequestLabel] &&
+		bindRequest.Status.Phase != v1alpha2.BindRequestPhaseSucceeded && bindRequest.DeletionTimestamp == nil, nil
+}
+
+func (rsc *service) listGpuClaims(ctx context.Context) ([]*v1.Pod, error) {
+	pods := &v1.PodList{}
+	err := rsc.kubeClient.List(ctx, pods,
+		client.InNamespace(rsc.namespace),
+		client.HasLabels{gpuClaimBindRequestLabel},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims []*v1.Pod
+	for index := range pods.Items {
+		claims = append(claims, &pods.Items[index])
+	}
+	return claims, nil
+}
+
+func (rsc *service) isGpuClaimReleased(uid types.UID) bool {
+	rsc.releasedGpuClaimsMutex.Lock()
+	defer rsc.releasedGpuClaimsMutex.Unlock()
+	_, found := rsc.releasedGpuClaims[uid]
+	return found
+}
+
+func (rsc *service) markGpuClaimReleased(uid types.UID) {
+	rsc.releasedGpuClaimsMutex.Lock()
+	defer rsc.releasedGpuClaimsMutex.Unlock()
+	rsc.releasedGpuClaims[uid] = time.Now()
+}
+
+func (rsc *service) pruneReleasedGpuClaims() {
+	rsc.releasedGpuClaimsMutex.Lock()
+	defer rsc.releasedGpuClaimsMutex.Unlock()
+	for uid, releaseTime := range rsc.releasedGpuClaims {
+		if time.Since(releaseTime) > gpuClaimTTL {
+			delete(rsc.releasedGpuClaims, uid)
+		}
+	}
+}
+
+func gpuClaimPodName(bindRequest *v1alpha2.BindRequest) string {
+	return fmt.Sprintf("%s-%s", gpuClaimPodPrefix, bindRequest.UID)
+}
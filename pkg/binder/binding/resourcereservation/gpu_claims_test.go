@@ -0,0 +1,112 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcereservation
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/common"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+var _ = Describe("GPU claims", func() {
+	const nodeName = "node-1"
+	var (
+		kubeClient client.WithWatch
+		rsc        *service
+	)
+
+	newBindRequest := func(name, resourceType string, gpus int, phase string) *v1alpha2.BindRequest {
+		return &v1alpha2.BindRequest{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name, UID: types.UID(name + "-uid")},
+			Spec: v1alpha2.BindRequestSpec{
+				PodName:              name,
+				SelectedNode:         nodeName,
+				ReceivedResourceType: resourceType,
+				ReceivedGPU:          &v1alpha2.ReceivedGPU{Count: gpus, Portion: "1"},
+			},
+			Status: v1alpha2.BindRequestStatus{Phase: phase},
+		}
+	}
+
+	listClaims := func() []v1.Pod {
+		pods := &v1.PodList{}
+		Expect(kubeClient.List(context.TODO(), pods, client.InNamespace(resourceReservationNameSpace))).To(Succeed())
+		return pods.Items
+	}
+
+	setup := func(objects ...runtime.Object) {
+		testScheme := runtime.NewScheme()
+		Expect(v1.AddToScheme(testScheme)).To(Succeed())
+		Expect(v1alpha2.AddToScheme(testScheme)).To(Succeed())
+		kubeClient = fake.NewClientBuilder().WithScheme(testScheme).WithRuntimeObjects(objects...).Build()
+		rsc = initializeTestService(kubeClient)
+	}
+
+	It("claims the whole GPUs of bind requests", func() {
+		wholeGpu := newBindRequest("whole", common.ReceivedTypeRegular, 2, "")
+		fraction := newBindRequest("fraction", common.ReceivedTypeFraction, 1, "")
+		setup(wholeGpu, fraction)
+
+		Expect(rsc.ClaimWholeGpus(context.TODO(), []*v1alpha2.BindRequest{wholeGpu, fraction})).To(Succeed())
+		Expect(rsc.ClaimWholeGpus(context.TODO(), []*v1alpha2.BindRequest{wholeGpu})).To(Succeed())
+
+		claims := listClaims()
+		Expect(claims).To(HaveLen(1))
+		Expect(claims[0].Spec.NodeName).To(Equal(nodeName))
+		Expect(claims[0].Labels[constants.AppLabelName]).To(Equal(resourceReservationAppLabelValue))
+		Expect(claims[0].Labels[gpuClaimBindRequestLabel]).To(Equal("whole-uid"))
+		Expect(claims[0].Labels).NotTo(HaveKey(constants.GPUGroup))
+		gpus := claims[0].Spec.Containers[0].Resources.Requests[constants.GpuResource]
+		Expect(gpus.Value()).To(Equal(int64(2)))
+	})
+
+	It("does not claim GPUs again after the claim was released", func() {
+		bindRequest := newBindRequest("whole", common.ReceivedTypeRegular, 1, "")
+		setup(bindRequest)
+
+		Expect(rsc.ClaimWholeGpus(context.TODO(), []*v1alpha2.BindRequest{bindRequest})).To(Succeed())
+		Expect(listClaims()).To(HaveLen(1))
+
+		Expect(rsc.ReleaseGpuClaim(context.TODO(), bindRequest)).To(Succeed())
+		Expect(listClaims()).To(BeEmpty())
+
+		Expect(rsc.ClaimWholeGpus(context.TODO(), []*v1alpha2.BindRequest{bindRequest})).To(Succeed())
+		Expect(listClaims()).To(BeEmpty())
+	})
+
+	It("releases a bind request without a claim", func() {
+		setup()
+		Expect(rsc.ReleaseGpuClaim(context.TODO(), newBindRequest("whole", common.ReceivedTypeRegular, 1, ""))).
+			To(Succeed())
+	})
+
+	It("deletes claims of bound or deleted bind requests", func() {
+		pending := newBindRequest("pending", common.ReceivedTypeRegular, 1, "")
+		bound := newBindRequest("bound", common.ReceivedTypeRegular, 1, v1alpha2.BindRequestPhaseSucceeded)
+		deleted := newBindRequest("deleted", common.ReceivedTypeRegular, 1, "")
+		setup(pending, bound)
+
+		for _, bindRequest := range []*v1alpha2.BindRequest{pending, bound, deleted} {
+			Expect(rsc.claimWholeGpus(context.TODO(), bindRequest)).To(Succeed())
+		}
+		Expect(listClaims()).To(HaveLen(3))
+
+		Expect(rsc.SyncGpuClaims(context.TODO())).To(Succeed())
+		claims := listClaims()
+		Expect(claims).To(HaveLen(1))
+		Expect(claims[0].Labels[gpuClaimBindRequestLabel]).To(Equal("pending-uid"))
+	})
+})
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	v1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	gomock "go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
 )
@@ -41,6 +42,34 @@ func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
 	return m.recorder
 }
 
+// ClaimWholeGpus mocks base method.
+func (m *MockInterface) ClaimWholeGpus(ctx context.Context, bindRequests []*v1alpha2.BindRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimWholeGpus", ctx, bindRequests)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimWholeGpus indicates an expected call of ClaimWholeGpus.
+func (mr *MockInterfaceMockRecorder) ClaimWholeGpus(ctx, bindRequests any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimWholeGpus", reflect.TypeOf((*MockInterface)(nil).ClaimWholeGpus), ctx, bindRequests)
+}
+
+// ReleaseGpuClaim mocks base method.
+func (m *MockInterface) ReleaseGpuClaim(ctx context.Context, bindRequest *v1alpha2.BindRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseGpuClaim", ctx, bindRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseGpuClaim indicates an expected call of ReleaseGpuClaim.
+func (mr *MockInterfaceMockRecorder) ReleaseGpuClaim(ctx, bindRequest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseGpuClaim", reflect.TypeOf((*MockInterface)(nil).ReleaseGpuClaim), ctx, bindRequest)
+}
+
 // RemovePodGpuGroupsConnection mocks base method.
 func (m *MockInterface) RemovePodGpuGroupsConnection(ctx context.Context, pod *v1.Pod) error {
 	m.ctrl.T.Helper()
@@ -111,3 +140,17 @@ func (mr *MockInterfaceMockRecorder) SyncForNode(ctx, nodeName any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncForNode", reflect.TypeOf((*MockInterface)(nil).SyncForNode), ctx, nodeName)
 }
+
+// SyncGpuClaims mocks base method.
+func (m *MockInterface) SyncGpuClaims(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncGpuClaims", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncGpuClaims indicates an expected call of SyncGpuClaims.
+func (mr *MockInterfaceMockRecorder) SyncGpuClaims(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncGpuClaims", reflect.TypeOf((*MockInterface)(nil).SyncGpuClaims), ctx)
+}
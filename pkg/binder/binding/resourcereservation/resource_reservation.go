@@ -7,8 +7,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"maps"
 	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/exp/slices"
@@ -20,10 +20,13 @@ import (
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/apimachinery/pkg/watch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	karpenterv1 "sigs.k8s.io/karpenter/pkg/apis/v1"
 
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/binding/resourcereservation/group_mutex"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/common"
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 )
@@ -34,6 +37,9 @@ type Interface interface {
 	SyncForGpuGroup(ctx context.Context, gpuGroup string) error
 	ReserveGpuDevice(ctx context.Context, pod *v1.Pod, nodeName string, gpuGroup string) (string, error)
 	RemovePodGpuGroupsConnection(ctx context.Context, pod *v1.Pod) error
+	ClaimWholeGpus(ctx context.Context, bindRequests []*v1alpha2.BindRequest) error
+	ReleaseGpuClaim(ctx context.Context, bindRequest *v1alpha2.BindRequest) error
+	SyncGpuClaims(ctx context.Context) error
 }
 
 const (
@@ -46,23 +52,29 @@ const (
 )
 
 type service struct {
-	fakeGPuNodes        bool
-	kubeClient          client.WithWatch
-	reservationPodImage string
-	allocationTimeout   time.Duration
-	gpuGroupMutex       *group_mutex.GroupMutex
-	namespace           string
-	serviceAccountName  string
-	appLabelValue       string
-	scalingPodNamespace string
-	runtimeClassName    string
-	podResources        *v1.ResourceRequirements
+	fakeGPuNodes           bool
+	kubeClient             client.WithWatch
+	reservationPodImage    string
+	archReservationImages  map[string]string
+	allocationTimeout      time.Duration
+	gpuGroupMutex          *group_mutex.GroupMutex
+	gpuClaimMutex          *group_mutex.GroupMutex
+	releasedGpuClaims      map[types.UID]time.Time
+	releasedGpuClaimsMutex sync.Mutex
+	namespace              string
+	serviceAccountName     string
+	appLabelValue          string
+	scalingPodNamespace    string
+	runtimeClassName       string
+	podResources           *v1.ResourceRequirements
+	releaseFinalizer       bool
 }
 
 func NewService(
 	fakeGPuNodes bool,
 	kubeClient client.WithWatch,
 	reservationPodImage string,
+	archReservationImages map[string]string,
 	allocationTimeout time.Duration,
 	namespace string,
 	serviceAccountName string,
@@ -70,19 +82,24 @@ func NewService(
 	scalingPodNamespace string,
 	runtimeClassName string,
 	podResources *v1.ResourceRequirements,
+	releaseFinalizer bool,
 ) *service {
 	return &service{
-		fakeGPuNodes:        fakeGPuNodes,
-		kubeClient:          kubeClient,
-		reservationPodImage: reservationPodImage,
-		allocationTimeout:   allocationTimeout,
-		gpuGroupMutex:       group_mutex.NewGroupMutex(),
-		namespace:           namespace,
-		serviceAccountName:  serviceAccountName,
-		appLabelValue:       appLabelValue,
-		scalingPodNamespace: scalingPodNamespace,
-		runtimeClassName:    runtimeClassName,
-		podResources:        podResources,
+		fakeGPuNodes:          fakeGPuNodes,
+		kubeClient:            kubeClient,
+		reservationPodImage:   reservationPodImage,
+		archReservationImages: archReservationImages,
+		allocationTimeout:     allocationTimeout,
+		gpuGroupMutex:         group_mutex.NewGroupMutex(),
+		gpuClaimMutex:         group_mutex.NewGroupMutex(),
+		releasedGpuClaims:     map[types.UID]time.Time{},
+		namespace:             namespace,
+		serviceAccountName:    serviceAccountName,
+		appLabelValue:         appLabelValue,
+		scalingPodNamespace:   scalingPodNamespace,
+		runtimeClassName:      runtimeClassName,
+		podResources:          podResources,
+		releaseFinalizer:      releaseFinalizer,
 	}
 }
 
@@ -95,7 +112,10 @@ func (rsc *service) Sync(ctx context.Context) error {
 		return err
 	}
 
-	return rsc.SyncForPodsList(ctx, podsList)
+	if err = rsc.SyncForPodsList(ctx, podsList); err != nil {
+		return err
+	}
+	return rsc.SyncGpuClaims(ctx)
 }
 
 func (rsc *service) SyncForNode(ctx context.Context, nodeName string) error {
@@ -255,6 +275,10 @@ func (rsc *service) updatePodGPUGroup(
 	} else {
 		pod.Labels[constants.GPUGroup] = gpuGroup
 	}
+	if rsc.releaseFinalizer {
+		// Keeps the pod, and its share of the GPU, visible until its reservation is synced after it terminates
+		controllerutil.AddFinalizer(pod, constants.GpuSharingReleaseFinalizer)
+	}
 
 	err = rsc.kubeClient.Patch(ctx, pod, client.MergeFrom(originalPod))
 	if err != nil {
@@ -274,6 +298,13 @@ func (rsc *service) RemovePodGpuGroupsConnection(ctx context.Context, pod *v1.Po
 			})
 		}
 	}
+	if index := slices.Index(pod.Finalizers, constants.GpuSharingReleaseFinalizer); index >= 0 {
+		path := fmt.Sprintf("/metadata/finalizers/%d", index)
+		patch = append(patch,
+			map[string]string{"op": "test", "path": path, "value": constants.GpuSharingReleaseFinalizer},
+			map[string]string{"op": "remove", "path": path},
+		)
+	}
 
 	patchBytes, err := json.Marshal(patch)
 	if err != nil {
@@ -322,7 +353,8 @@ func (rsc *service) findGPUIndexByGroup(gpuGroup string) (
 
 	gpuIndex, found := pods.Items[0].Annotations[gpuIndexAnnotationName]
 	if !found {
-		return "", fmt.Errorf("failed to find annotation on reservation pod %s", pods.Items[0].Name)
+		return "", common.NewBindError(v1alpha2.BindFailureReasonDeviceUnavailable,
+			fmt.Errorf("failed to find annotation on reservation pod %s", pods.Items[0].Name))
 	}
 
 	return gpuIndex, nil
@@ -342,8 +374,8 @@ func (rsc *service) createGPUReservationPodAndGetIndex(ctx context.Context, node
 		if deleteErr != nil {
 			logger.Error(deleteErr, "failed to delete reservation pod", "name", pod.Name)
 		}
-		return unknownGpuIndicator, fmt.Errorf(
-			"failed waiting for GPU reservation pod to allocate: %v/%v", rsc.namespace, pod.Name)
+		return unknownGpuIndicator, common.NewBindError(v1alpha2.BindFailureReasonReservationPodTimeout, fmt.Errorf(
+			"failed waiting for GPU reservation pod to allocate: %v/%v", rsc.namespace, pod.Name))
 	}
 
 	return gpuIndex, err
@@ -386,43 +418,62 @@ func (rsc *service) deleteReservationPod(ctx context.Context, pod *v1.Pod) error
 func (rsc *service) createGPUReservationPod(ctx context.Context, nodeName, gpuGroup string) (*v1.Pod, error) {
 	logger := log.FromContext(ctx)
 	if rsc.isScalingUp(ctx) {
-		return nil, fmt.Errorf("cluster is scaling up, could not create reservation pod")
+		return nil, common.NewBindError(v1alpha2.BindFailureReasonDeviceUnavailable,
+			fmt.Errorf("cluster is scaling up, could not create reservation pod"))
 	}
 
 	podName := fmt.Sprintf("%s-%s-%s", gpuReservationPodPrefix, nodeName, rand.String(reservationPodRandomCharacters))
+	pod, err := rsc.createResourceReservationPod(
+		nodeName, gpuGroup, podName, rsc.reservationPodResources(nodeName, numberOfGPUsToReserve))
+	if err != nil {
+		logger.Error(err, "Failed to create GPU reservation pod on node",
+			"nodeName", nodeName, "namespace", rsc.namespace, "name", podName)
+		return nil, err
+	}
+
+	logger.Info(
+		"Successfully created GPU resource reservation pod",
+		"nodeName", nodeName, "namespace", rsc.namespace, "name", podName)
+	return pod, nil
+}
 
-	// Build resource requirements starting with GPU resources
+func (rsc *service) reservationPodResources(nodeName string, gpus int64) v1.ResourceRequirements {
+	// Build resource requirements starting with the accelerator resources of the node
+	acceleratorResource := rsc.nodeAcceleratorResourceName(nodeName)
 	resources := v1.ResourceRequirements{
 		Limits: v1.ResourceList{
-			constants.GpuResource: *resource.NewQuantity(numberOfGPUsToReserve, resource.DecimalSI),
+			acceleratorResource: *resource.NewQuantity(gpus, resource.DecimalSI),
 		},
 		Requests: v1.ResourceList{
-			constants.GpuResource: *resource.NewQuantity(numberOfGPUsToReserve, resource.DecimalSI),
+			acceleratorResource: *resource.NewQuantity(gpus, resource.DecimalSI),
 		},
 	}
 
 	if rsc.podResources != nil {
-		if rsc.podResources.Limits != nil {
-			delete(rsc.podResources.Limits, constants.GpuResource)
-			maps.Copy(resources.Limits, rsc.podResources.Limits)
-		}
-		if rsc.podResources.Requests != nil {
-			delete(rsc.podResources.Requests, constants.GpuResource)
-			maps.Copy(resources.Requests, rsc.podResources.Requests)
-		}
+		copyNonAcceleratorResources(resources.Limits, rsc.podResources.Limits)
+		copyNonAcceleratorResources(resources.Requests, rsc.podResources.Requests)
 	}
+	return resources
+}
 
-	pod, err := rsc.createResourceReservationPod(nodeName, gpuGroup, podName, resources)
-	if err != nil {
-		logger.Error(err, "Failed to create GPU reservation pod on node",
-			"nodeName", nodeName, "namespace", rsc.namespace, "name", podName)
-		return nil, err
+// nodeAcceleratorResourceName returns the accelerator resource that the reservation pods on the node request
+func (rsc *service) nodeAcceleratorResourceName(nodeName string) v1.ResourceName {
+	node := &v1.Node{}
+	if err := rsc.kubeClient.Get(context.Background(), types.NamespacedName{Name: nodeName}, node); err != nil {
+		log.Log.Error(err, "Failed to get node accelerator resource, reserving NVIDIA GPUs",
+			"nodeName", nodeName)
+		return constants.GpuResource
 	}
+	return resources.NodeAcceleratorResourceName(node)
+}
 
-	logger.Info(
-		"Successfully created GPU resource reservation pod",
-		"nodeName", nodeName, "namespace", rsc.namespace, "name", podName)
-	return pod, nil
+func copyNonAcceleratorResources(dst, src v1.ResourceList) {
+	for resourceName, quantity := range src {
+		if resources.IsAcceleratorResource(resourceName) {
+			continue
+		}
+		dst[resourceName] = quantity
+	}
 }
 
 func (rsc *service) waitForGPUReservationPodAllocation(
@@ -480,20 +531,29 @@ func (rsc *service) waitForGPUReservationPodAllocation(
 func (rsc *service) createResourceReservationPod(
 	nodeName, gpuGroup, podName string, resources v1.ResourceRequirements,
 ) (*v1.Pod, error) {
+	podSpec := rsc.newReservationPod(nodeName, podName, map[string]string{
+		constants.AppLabelName: rsc.appLabelValue,
+		constants.GPUGroup:     gpuGroup,
+	}, resources)
+	return podSpec, rsc.kubeClient.Create(context.Background(), podSpec)
+}
+
+func (rsc *service) newReservationPod(
+	nodeName, podName string, labels map[string]string, resources v1.ResourceRequirements,
+) *v1.Pod {
+	image, nodeSelector := rsc.reservationPodImageForNode(nodeName)
 	podSpec := &v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      podName,
 			Namespace: rsc.namespace,
-			Labels: map[string]string{
-				constants.AppLabelName: rsc.appLabelValue,
-				constants.GPUGroup:     gpuGroup,
-			},
+			Labels:    labels,
 			Annotations: map[string]string{
 				karpenterv1.DoNotDisruptAnnotationKey: "true",
 			},
 		},
 		Spec: v1.PodSpec{
-			NodeName: nodeName,
+			NodeName:     nodeName,
+			NodeSelector: nodeSelector,
 			RuntimeClassName: func() *string {
 				if len(rsc.runtimeClassName) == 0 {
 					return nil
@@ -504,7 +564,7 @@ func (rsc *service) createResourceReservationPod(
 			Containers: []v1.Container{
 				{
 					Name:            resourceReservation,
-					Image:           rsc.reservationPodImage,
+					Image:           image,
 					ImagePullPolicy: v1.PullIfNotPresent,
 					Resources:       resources,
 					Env: []v1.EnvVar{
@@ -524,6 +584,10 @@ func (rsc *service) createResourceReservationPod(
 								},
 							},
 						},
+						{
+							Name:  constants.ReservedResourceNameEnv,
+							Value: string(reservedAcceleratorResource(resources)),
+						},
 					},
 				},
 			},
@@ -543,7 +607,44 @@ func (rsc *service) createResourceReservationPod(
 		podSpec.Spec.Containers[0].Args = []string{"sleep", "infinity"}
 	}
 
-	return podSpec, rsc.kubeClient.Create(context.Background(), podSpec)
+	return podSpec
+}
+
+// reservedAcceleratorResource returns the accelerator resource that the reservation pod requests, so the pod
+// discovers the reserved device of the right vendor
+func reservedAcceleratorResource(requirements v1.ResourceRequirements) v1.ResourceName {
+	for resourceName := range requirements.Limits {
+		if resources.IsAcceleratorResource(resourceName) {
+			return resourceName
+		}
+	}
+	return constants.GpuResource
+}
+
+// reservationPodImageForNode returns the reservation pod image matching the architecture of the node, and a node
+// selector pinning the pod to that architecture. The default image is used for architectures without an image of
+// their own, or when the node architecture is unknown.
+func (rsc *service) reservationPodImageForNode(nodeName string) (string, map[string]string) {
+	if len(rsc.archReservationImages) == 0 {
+		return rsc.reservationPodImage, nil
+	}
+
+	node := &v1.Node{}
+	if err := rsc.kubeClient.Get(context.Background(), types.NamespacedName{Name: nodeName}, node); err != nil {
+		log.Log.Error(err, "Failed to get node architecture, using the default reservation pod image",
+			"nodeName", nodeName)
+		return rsc.reservationPodImage, nil
+	}
+	arch, found := node.Labels[v1.LabelArchStable]
+	if !found {
+		return rsc.reservationPodImage, nil
+	}
+
+	nodeSelector := map[string]string{v1.LabelArchStable: arch}
+	if image, found := rsc.archReservationImages[arch]; found {
+		return image, nodeSelector
+	}
+	return rsc.reservationPodImage, nodeSelector
 }
 
 func (rsc *service) isScalingUp(ctx context.Context) bool {
@@ -45,9 +45,9 @@ func nodeNameIndexer(rawObj runtimeClient.Object) []string {
 func initializeTestService(
 	client runtimeClient.WithWatch,
 ) *service {
-	service := NewService(false, client, "", 40*time.Millisecond,
+	service := NewService(false, client, "", nil, 40*time.Millisecond,
 		resourceReservationNameSpace, resourceReservationServiceAccount, resourceReservationAppLabelValue, scalingPodsNamespace, constants.DefaultRuntimeClassName,
-		nil) // nil podResources to use defaults
+		nil, false) // nil podResources to use defaults
 
 	return service
 }
@@ -1091,6 +1091,27 @@ var _ = Describe("ResourceReservationService", func() {
 				}
 			})
 		}
+
+		It("removes the gpu sharing release finalizer", func() {
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "job-1-0-0",
+					Namespace:  "my-ns",
+					Labels:     map[string]string{constants.GPUGroup: gpuGroup},
+					Finalizers: []string{"other/finalizer", constants.GpuSharingReleaseFinalizer},
+				},
+			}
+			fakeClient := fake.NewClientBuilder().WithRuntimeObjects(pod).Build()
+			rsc := initializeTestService(fakeClient)
+
+			Expect(rsc.RemovePodGpuGroupsConnection(context.TODO(), pod)).To(Succeed())
+
+			updatedPod := &v1.Pod{}
+			Expect(fakeClient.Get(context.Background(), runtimeClient.ObjectKeyFromObject(pod), updatedPod)).
+				To(Succeed())
+			Expect(updatedPod.Labels).To(BeEmpty())
+			Expect(updatedPod.Finalizers).To(Equal([]string{"other/finalizer"}))
+		})
 	})
 
 	Context("createGPUReservationPod with resource configuration", func() {
@@ -1246,6 +1267,68 @@ var _ = Describe("ResourceReservationService", func() {
 			Expect(gpuLimit.Value()).To(Equal(int64(1)), "GPU limit should be 1, not overridden by podResources")
 		})
 	})
+
+	Context("createGPUReservationPod on mixed architecture clusters", func() {
+		newNode := func(name, arch string) *v1.Node {
+			node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{}}}
+			if arch != "" {
+				node.Labels[v1.LabelArchStable] = arch
+			}
+			return node
+		}
+
+		for testName, testData := range map[string]struct {
+			archImages           map[string]string
+			node                 *v1.Node
+			expectedImage        string
+			expectedNodeSelector map[string]string
+		}{
+			"no architecture images": {
+				node:          newNode("test-node", "arm64"),
+				expectedImage: "test-image:latest",
+			},
+			"node with an architecture image": {
+				archImages:           map[string]string{"arm64": "test-image-arm64:latest"},
+				node:                 newNode("test-node", "arm64"),
+				expectedImage:        "test-image-arm64:latest",
+				expectedNodeSelector: map[string]string{v1.LabelArchStable: "arm64"},
+			},
+			"node without an architecture image": {
+				archImages:           map[string]string{"arm64": "test-image-arm64:latest"},
+				node:                 newNode("test-node", "amd64"),
+				expectedImage:        "test-image:latest",
+				expectedNodeSelector: map[string]string{v1.LabelArchStable: "amd64"},
+			},
+			"node without an architecture label": {
+				archImages:    map[string]string{"arm64": "test-image-arm64:latest"},
+				node:          newNode("test-node", ""),
+				expectedImage: "test-image:latest",
+			},
+			"unknown node": {
+				archImages:    map[string]string{"arm64": "test-image-arm64:latest"},
+				node:          newNode("other-node", "arm64"),
+				expectedImage: "test-image:latest",
+			},
+		} {
+			testData := testData
+			It(testName, func() {
+				rsc := &service{
+					namespace:             "kai-resource-reservation",
+					appLabelValue:         "kai-reservation",
+					serviceAccountName:    "kai-sa",
+					reservationPodImage:   "test-image:latest",
+					archReservationImages: testData.archImages,
+					kubeClient:            fake.NewClientBuilder().WithRuntimeObjects(testData.node).Build(),
+					scalingPodNamespace:   scalingPodsNamespace,
+				}
+
+				pod, err := rsc.createGPUReservationPod(context.TODO(), "test-node", "test-gpu-group")
+				Expect(err).To(BeNil())
+				Expect(pod.Spec.Containers[0].Image).To(Equal(testData.expectedImage))
+				Expect(pod.Spec.NodeSelector).To(Equal(testData.expectedNodeSelector))
+			})
+		}
+	})
 })
 
 type FakeWatchPod struct {
@@ -0,0 +1,41 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"errors"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+)
+
+// BindError is a binding failure with a machine readable reason, so that automation can tell transient failures
+// from permanent ones.
+type BindError struct {
+	Reason v1alpha2.BindFailureReason
+	err    error
+}
+
+func NewBindError(reason v1alpha2.BindFailureReason, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &BindError{Reason: reason, err: err}
+}
+
+func (e *BindError) Error() string {
+	return e.err.Error()
+}
+
+func (e *BindError) Unwrap() error {
+	return e.err
+}
+
+// GetBindFailureReason returns the reason of the first BindError in the chain of err, or Unknown if there is none
+func GetBindFailureReason(err error) v1alpha2.BindFailureReason {
+	var bindError *BindError
+	if errors.As(err, &bindError) {
+		return bindError.Reason
+	}
+	return v1alpha2.BindFailureReasonUnknown
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+)
+
+func TestNewBindErrorNil(t *testing.T) {
+	assert.Nil(t, NewBindError(v1alpha2.BindFailureReasonNodeGone, nil))
+}
+
+func TestGetBindFailureReason(t *testing.T) {
+	baseErr := errors.New("node not found")
+	tests := []struct {
+		name     string
+		err      error
+		expected v1alpha2.BindFailureReason
+	}{
+		{
+			name:     "plain error",
+			err:      baseErr,
+			expected: v1alpha2.BindFailureReasonUnknown,
+		},
+		{
+			name:     "bind error",
+			err:      NewBindError(v1alpha2.BindFailureReasonNodeGone, baseErr),
+			expected: v1alpha2.BindFailureReasonNodeGone,
+		},
+		{
+			name: "wrapped bind error",
+			err: fmt.Errorf("failed to bind: %w",
+				NewBindError(v1alpha2.BindFailureReasonDRAClaimPending, baseErr)),
+			expected: v1alpha2.BindFailureReasonDRAClaimPending,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, GetBindFailureReason(tt.err))
+			assert.ErrorIs(t, tt.err, baseErr)
+		})
+	}
+}
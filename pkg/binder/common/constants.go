@@ -5,6 +5,7 @@ package common
 
 const (
 	GPUPortion           = "GPU_PORTION"
+	MpsThreadPercentage  = "CUDA_MPS_ACTIVE_THREAD_PERCENTAGE"
 	ReceivedTypeFraction = "Fraction"
 	ReceivedTypeRegular  = "Regular"
 )
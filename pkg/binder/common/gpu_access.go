@@ -122,6 +122,29 @@ func SetGPUPortion(
 	return nil
 }
 
+// SetMpsThreadPercentage limits the share of the GPU's streaming multiprocessors that the container's CUDA clients can
+// use when connected to an MPS server, by their CUDA_MPS_ACTIVE_THREAD_PERCENTAGE env var
+func SetMpsThreadPercentage(
+	ctx context.Context, kubeClient client.Client, pod *v1.Pod, containerRef *gpusharingconfigmap.PodContainerRef,
+	threadPercentage string,
+) error {
+	updateFunc := func(data map[string]string) error {
+		data[MpsThreadPercentage] = threadPercentage
+		return nil
+	}
+	directEnvVarsMapName, err := gpusharingconfigmap.ExtractDirectEnvVarsConfigMapName(pod, containerRef)
+	if err != nil {
+		return err
+	}
+
+	err = UpdateConfigMapEnvironmentVariable(ctx, kubeClient, pod, directEnvVarsMapName, updateFunc)
+	if err != nil {
+		return fmt.Errorf("failed to update %s value in gpu sharing configmap for pod <%s/%s>: %v",
+			MpsThreadPercentage, pod.Namespace, pod.Name, err)
+	}
+	return nil
+}
+
 func UpdateConfigMapEnvironmentVariable(
 	ctx context.Context, kubeclient client.Client, task *v1.Pod,
 	configMapName string, changesFunc func(map[string]string) error,
@@ -0,0 +1,35 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+const (
+	// PodGroupIndexField indexes pods by the name of their pod group
+	PodGroupIndexField = "metadata.annotations.pod-group-name"
+	// BindRequestPodNameIndexField indexes bind requests by the name of the pod they bind
+	BindRequestPodNameIndexField = "spec.podName"
+)
+
+func PodGroupIndexer(obj client.Object) []string {
+	podGroupName := obj.(*v1.Pod).Annotations[constants.PodGroupAnnotationForPod]
+	if podGroupName == "" {
+		return nil
+	}
+	return []string{podGroupName}
+}
+
+func BindRequestPodNameIndexer(obj client.Object) []string {
+	podName := obj.(*v1alpha2.BindRequest).Spec.PodName
+	if podName == "" {
+		return nil
+	}
+	return []string{podName}
+}
@@ -10,3 +10,8 @@ import (
 func IsSharedGPUAllocation(bindRequest *v1alpha2.BindRequest) bool {
 	return bindRequest.Spec.ReceivedResourceType == ReceivedTypeFraction
 }
+
+func IsWholeGPUAllocation(bindRequest *v1alpha2.BindRequest) bool {
+	return !IsSharedGPUAllocation(bindRequest) &&
+		bindRequest.Spec.ReceivedGPU != nil && bindRequest.Spec.ReceivedGPU.Count > 0
+}
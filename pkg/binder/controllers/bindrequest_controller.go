@@ -11,6 +11,9 @@ import (
 	"runtime/debug"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	v1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -30,12 +33,16 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/binding"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/binding/resourcereservation"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/common"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/tracing"
 
 	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	schedulingv2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 )
 
 const (
-	podBoundCondition = "PodBound"
+	podBoundCondition  = "PodBound"
+	bindingErrorReason = "BindingError"
 )
 
 // BindRequestReconciler reconciles a BindRequest object
@@ -79,6 +86,7 @@ func NewBindRequestReconciler(
 // +kubebuilder:rbac:groups=scheduling.run.ai,resources=bindrequests,verbs=get;list;watch;patch;update;delete
 // +kubebuilder:rbac:groups=scheduling.run.ai,resources=bindrequests/finalizers,verbs=patch;update
 // +kubebuilder:rbac:groups=scheduling.run.ai,resources=bindrequests/status,verbs=get;list;watch;patch;update
+// +kubebuilder:rbac:groups=scheduling.run.ai,resources=podgroups,verbs=get;list;watch
 // +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses;csinodes;csidrivers;csistoragecapacities,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -109,6 +117,23 @@ func (r *BindRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return result, nil
 	}
 
+	// Once started, a binding is completed or rolled back even if the binder is shutting down, so that pods
+	// of a gang are not left half bound. The manager's graceful shutdown timeout bounds the wait for it.
+	ctx = context.WithoutCancel(ctx)
+
+	ctx, span := tracing.Tracer().Start(tracing.ExtractFromAnnotations(ctx, bindRequest.Annotations), "binder.Bind",
+		trace.WithAttributes(
+			attribute.String("pod", bindRequest.Namespace+"/"+bindRequest.Spec.PodName),
+			attribute.String("node", bindRequest.Spec.SelectedNode),
+		))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	defer func() {
 		var finalError error
 		if r := recover(); r != nil {
@@ -140,6 +165,12 @@ func (r *BindRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			"node", pod.Spec.NodeName)
 		return result, nil
 	}
+	if isForReplacedPod(bindRequest, pod) {
+		logger.Info("BindRequest was created for a previous pod with the same name, deleting it",
+			"name", pod.Name, "namespace", pod.Namespace, "uid", pod.UID)
+		pod = nil
+		return result, client.IgnoreNotFound(r.Client.Delete(ctx, bindRequest))
+	}
 
 	node := &v1.Node{
 		ObjectMeta: metav1.ObjectMeta{
@@ -147,6 +178,9 @@ func (r *BindRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		},
 	}
 	if err = r.Client.Get(ctx, client.ObjectKeyFromObject(node), node); err != nil {
+		if kerrors.IsNotFound(err) {
+			err = common.NewBindError(schedulingv1alpha2.BindFailureReasonNodeGone, err)
+		}
 		return result, err
 	}
 
@@ -172,6 +206,17 @@ func (r *BindRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	return result, err
 }
 
+// isForReplacedPod returns true if the bind request is owned by a previous pod with the same name, which its
+// controller replaced. Pods whose containers restart in place keep their UID, and their bind request.
+func isForReplacedPod(bindRequest *schedulingv1alpha2.BindRequest, pod *v1.Pod) bool {
+	for _, owner := range bindRequest.OwnerReferences {
+		if owner.Kind == "Pod" && owner.Name == pod.Name && owner.UID != "" && pod.UID != "" {
+			return owner.UID != pod.UID
+		}
+	}
+	return false
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *BindRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
@@ -221,6 +266,12 @@ func (r *BindRequestReconciler) deleteHandler(ctx context.Context, event event.T
 			}
 		}
 	}
+
+	if common.IsWholeGPUAllocation(bindRequest) {
+		if err := r.resourceReservation.SyncGpuClaims(ctx); err != nil {
+			logger.Error(err, "Failed to sync GPU claims", "bindRequest", bindRequest.Name)
+		}
+	}
 }
 
 func (r *BindRequestReconciler) UpdateStatus(
@@ -237,8 +288,10 @@ func (r *BindRequestReconciler) UpdateStatus(
 		}
 		bindRequest.Status.Phase = schedulingv1alpha2.BindRequestPhaseFailed
 		bindRequest.Status.Reason = err.Error()
+		bindRequest.Status.FailureReason = common.GetBindFailureReason(err)
 	} else {
 		bindRequest.Status.Phase = schedulingv1alpha2.BindRequestPhaseSucceeded
+		bindRequest.Status.FailureReason = ""
 	}
 
 	if originalBindRequest.Status.Phase == bindRequest.Status.Phase {
@@ -279,17 +332,23 @@ func (r *BindRequestReconciler) updatePodCondition(
 			"Failed to bind pod %s/%s to node %s: %s", pod.Namespace, pod.Name,
 			bindRequest.Spec.SelectedNode, err.Error(),
 		)
+		reason = bindingErrorReason
+		if failureReason := common.GetBindFailureReason(err); failureReason != schedulingv1alpha2.BindFailureReasonUnknown {
+			reason = string(failureReason)
+		}
 		condition = &v1.PodCondition{
 			Type:    podBoundCondition,
 			Status:  v1.ConditionFalse,
-			Reason:  "BindingError",
+			Reason:  reason,
 			Message: message,
 		}
 		eventType = v1.EventTypeWarning
-		reason = "BindingError"
 	}
 
 	r.eventRecorder.Eventf(pod, eventType, reason, message)
+	if eventType == v1.EventTypeWarning {
+		r.recordPodGroupEvent(ctx, pod, reason, message)
+	}
 
 	if podutil.UpdatePodCondition(&pod.Status, condition) {
 		statusPatchBaseObject := v1.PodStatus{}
@@ -309,3 +368,21 @@ func (r *BindRequestReconciler) updatePodCondition(
 		}
 	}
 }
+
+// recordPodGroupEvent publishes a binding failure on the pod group of the pod, so that the failure reasons of a
+// gang can be seen in one place.
+func (r *BindRequestReconciler) recordPodGroupEvent(ctx context.Context, pod *v1.Pod, reason, message string) {
+	podGroupName, found := pod.Annotations[constants.PodGroupAnnotationForPod]
+	if !found || podGroupName == "" {
+		return
+	}
+
+	podGroup := &schedulingv2alpha2.PodGroup{}
+	key := client.ObjectKey{Namespace: pod.Namespace, Name: podGroupName}
+	if err := r.Client.Get(ctx, key, podGroup); err != nil {
+		log.FromContext(ctx).V(1).Info("Failed to get pod group for binding failure event",
+			"podGroup", podGroupName, "namespace", pod.Namespace, "error", err.Error())
+		return
+	}
+	r.eventRecorder.Eventf(podGroup, v1.EventTypeWarning, reason, message)
+}
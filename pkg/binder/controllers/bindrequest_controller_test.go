@@ -15,6 +15,7 @@ import (
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
@@ -25,11 +26,13 @@ import (
 
 	kubeaischedulerscheme "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/scheme"
 	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	schedulingv2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/binding"
 	mock_binder "github.com/NVIDIA/KAI-scheduler/pkg/binder/binding/mock"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/binding/resourcereservation"
+	bindercommon "github.com/NVIDIA/KAI-scheduler/pkg/binder/common"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins"
 	mockplugins "github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/mock"
 )
@@ -88,10 +91,10 @@ var _ = Describe("BindRequest Controller", func() {
 		fakePlugin = mockplugins.NewMockPlugin(gomock.NewController(GinkgoT()))
 		binderPlugins.RegisterPlugin(fakePlugin)
 
-		rrs := resourcereservation.NewService(false, fakeClient, "", 40*time.Second,
+		rrs := resourcereservation.NewService(false, fakeClient, "", nil, 40*time.Second,
 			resourceReservationNameSpace, resourceReservationServiceAccount, resourceReservationAppLabelValue, scalingPodsNamespace, constants.DefaultRuntimeClassName,
-			nil) // nil podResources to use defaults
-		binder := binding.NewBinder(fakeClient, rrs, binderPlugins)
+			nil, false) // nil podResources to use defaults
+		binder := binding.NewBinder(fakeClient, rrs, binderPlugins, false)
 		reconciler = NewBindRequestReconciler(fakeClient, testScheme, fakeEventRecorder, params,
 			binder, rrs)
 	})
@@ -241,6 +244,61 @@ var _ = Describe("BindRequest Controller", func() {
 			),
 		)
 
+		It("sets the NodeGone failure reason when the selected node is missing", func() {
+			Expect(fakeClient.Create(context.TODO(), pod.DeepCopy())).Should(Succeed())
+			bindRequest := baseRequest.DeepCopy()
+			bindRequest.Spec.PodName = pod.Name
+			bindRequest.Spec.SelectedNode = node.Name
+			Expect(fakeClient.Create(context.TODO(), bindRequest)).Should(Succeed())
+
+			_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{
+				NamespacedName: client.ObjectKeyFromObject(bindRequest),
+			})
+			Expect(err).Should(HaveOccurred())
+
+			updatedBindRequest := &schedulingv1alpha2.BindRequest{}
+			Expect(fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(bindRequest), updatedBindRequest)).
+				Should(Succeed())
+			Expect(updatedBindRequest.Status.Phase).To(Equal(schedulingv1alpha2.BindRequestPhaseFailed))
+			Expect(updatedBindRequest.Status.FailureReason).To(Equal(schedulingv1alpha2.BindFailureReasonNodeGone))
+
+			updatedPod := &v1.Pod{}
+			Expect(fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(pod), updatedPod)).Should(Succeed())
+			Expect(updatedPod.Status.Conditions).To(HaveLen(1))
+			Expect(updatedPod.Status.Conditions[0].Reason).To(Equal(string(schedulingv1alpha2.BindFailureReasonNodeGone)))
+		})
+
+		It("deletes the BindRequest of a replaced pod without binding the new pod", func() {
+			newPod := pod.DeepCopy()
+			newPod.UID = "new-pod-uid"
+			Expect(fakeClient.Create(context.TODO(), newPod)).Should(Succeed())
+			Expect(fakeClient.Create(context.TODO(), node.DeepCopy())).Should(Succeed())
+			bindRequest := baseRequest.DeepCopy()
+			bindRequest.Spec.PodName = pod.Name
+			bindRequest.Spec.SelectedNode = node.Name
+			bindRequest.OwnerReferences = []metav1.OwnerReference{{
+				APIVersion: "v1", Kind: "Pod", Name: pod.Name, UID: "old-pod-uid",
+			}}
+			Expect(fakeClient.Create(context.TODO(), bindRequest)).Should(Succeed())
+
+			mockBinder := mock_binder.NewMockInterface(gomock.NewController(GinkgoT()))
+			reconciler.binder = mockBinder
+
+			_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{
+				NamespacedName: client.ObjectKeyFromObject(bindRequest),
+			})
+			Expect(err).Should(BeNil())
+
+			err = fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(bindRequest),
+				&schedulingv1alpha2.BindRequest{})
+			Expect(kerrors.IsNotFound(err)).To(BeTrue())
+
+			updatedPod := &v1.Pod{}
+			Expect(fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(pod), updatedPod)).Should(Succeed())
+			Expect(updatedPod.Spec.NodeName).To(BeEmpty())
+			Expect(updatedPod.Status.Conditions).To(BeEmpty())
+		})
+
 		Context("multiple pods", func() {
 			It("handles multiple pods concurrently", func() {
 				Expect(fakeClient.Create(context.TODO(), node)).Should(Succeed())
@@ -452,6 +510,31 @@ var _ = Describe("BindRequest Controller", func() {
 					Expect(event).To(ContainSubstring(expectedFailMsg))
 				}
 			})
+
+			It("publishes the failure reason on the pod and its pod group", func() {
+				podGroup := &schedulingv2alpha2.PodGroup{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: pod.Namespace,
+						Name:      "pg-1",
+					},
+				}
+				pod.Annotations = map[string]string{constants.PodGroupAnnotationForPod: podGroup.Name}
+				Expect(fakeClient.Create(context.TODO(), podGroup)).Should(Succeed())
+				Expect(fakeClient.Create(context.TODO(), bindRequest)).Should(Succeed())
+				Expect(fakeClient.Create(context.TODO(), pod)).Should(Succeed())
+
+				bindErr := bindercommon.NewBindError(schedulingv1alpha2.BindFailureReasonReservationPodTimeout,
+					errors.New("error"))
+				reconciler.updatePodCondition(context.TODO(), bindRequest, pod, ctrl.Result{}, bindErr)
+
+				close(fakeEventRecorder.Events)
+
+				Expect(fakeEventRecorder.Events).To(HaveLen(2))
+				for event := range fakeEventRecorder.Events {
+					Expect(event).To(ContainSubstring("Warning ReservationPodTimeout"))
+					Expect(event).To(ContainSubstring(expectedFailMsg))
+				}
+			})
 		})
 	})
 })
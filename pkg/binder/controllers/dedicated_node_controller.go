@@ -0,0 +1,77 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+// DedicatedNodeReconciler removes the dedicated node taints whose lease expired. The scheduler renews the leases of
+// the nodes it dedicates, so an expired lease is left behind by a scheduler that stopped, or whose dedicatednodes
+// plugin was removed, and would otherwise keep the pods of every other workload off the node.
+type DedicatedNodeReconciler struct {
+	Client client.Client
+	Clock  clock.PassiveClock
+}
+
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch;update
+
+func (r *DedicatedNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	node := &corev1.Node{}
+	if err := r.Client.Get(ctx, req.NamespacedName, node); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !hasDedicatedNodeTaint(node) {
+		return ctrl.Result{}, nil
+	}
+	if untilExpiry := dedicatedNodeLeaseExpiry(node).Sub(r.Clock.Now()); untilExpiry > 0 {
+		return ctrl.Result{RequeueAfter: untilExpiry}, nil
+	}
+
+	log.FromContext(ctx).Info("Removing the dedicated node taint with an expired lease",
+		"node", node.Name, "leaseExpiry", node.Annotations[constants.DedicatedNodeLeaseExpiry])
+	node.Spec.Taints = slices.DeleteFunc(node.Spec.Taints, isDedicatedNodeTaint)
+	delete(node.Annotations, constants.DedicatedNodeLeaseExpiry)
+	return ctrl.Result{}, r.Client.Update(ctx, node)
+}
+
+func (r *DedicatedNodeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("dedicated-node").
+		For(&corev1.Node{}).
+		WithEventFilter(predicate.NewPredicateFuncs(func(object client.Object) bool {
+			node, ok := object.(*corev1.Node)
+			return ok && hasDedicatedNodeTaint(node)
+		})).
+		Complete(r)
+}
+
+func hasDedicatedNodeTaint(node *corev1.Node) bool {
+	return slices.ContainsFunc(node.Spec.Taints, isDedicatedNodeTaint)
+}
+
+func isDedicatedNodeTaint(taint corev1.Taint) bool {
+	return taint.Key == constants.DedicatedNodeTaintKey
+}
+
+// dedicatedNodeLeaseExpiry returns the expiry of the lease of the dedicated node taint, or the zero time if the node
+// has no valid lease
+func dedicatedNodeLeaseExpiry(node *corev1.Node) time.Time {
+	expiry, err := time.Parse(time.RFC3339, node.Annotations[constants.DedicatedNodeLeaseExpiry])
+	if err != nil {
+		return time.Time{}
+	}
+	return expiry
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+var _ = Describe("Dedicated Node Controller", func() {
+	var (
+		now        = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		otherTaint = v1.Taint{Key: "other", Effect: v1.TaintEffectNoSchedule}
+		fakeClient client.WithWatch
+		reconciler *DedicatedNodeReconciler
+		node       *v1.Node
+	)
+	BeforeEach(func() {
+		testScheme := runtime.NewScheme()
+		Expect(v1.AddToScheme(testScheme)).Should(Succeed())
+		fakeClient = fake.NewClientBuilder().WithScheme(testScheme).Build()
+		reconciler = &DedicatedNodeReconciler{
+			Client: fakeClient,
+			Clock:  clocktesting.NewFakePassiveClock(now),
+		}
+		node = &v1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node"},
+			Spec: v1.NodeSpec{Taints: []v1.Taint{
+				otherTaint,
+				{Key: constants.DedicatedNodeTaintKey, Value: "podgroup-uid", Effect: v1.TaintEffectNoSchedule},
+			}},
+		}
+	})
+
+	reconcile := func() (ctrl.Result, *v1.Node) {
+		result, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(node)})
+		Expect(err).NotTo(HaveOccurred())
+		updatedNode := &v1.Node{}
+		Expect(fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(node), updatedNode)).Should(Succeed())
+		return result, updatedNode
+	}
+
+	It("keeps the taint until its lease expires", func() {
+		node.Annotations = map[string]string{
+			constants.DedicatedNodeLeaseExpiry: now.Add(time.Minute).Format(time.RFC3339),
+		}
+		Expect(fakeClient.Create(context.TODO(), node)).Should(Succeed())
+
+		result, updatedNode := reconcile()
+
+		Expect(result.RequeueAfter).To(Equal(time.Minute))
+		Expect(updatedNode.Spec.Taints).To(Equal(node.Spec.Taints))
+	})
+
+	It("removes the taint and the lease once the lease expired", func() {
+		node.Annotations = map[string]string{
+			constants.DedicatedNodeLeaseExpiry: now.Add(-time.Minute).Format(time.RFC3339),
+		}
+		Expect(fakeClient.Create(context.TODO(), node)).Should(Succeed())
+
+		result, updatedNode := reconcile()
+
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(updatedNode.Spec.Taints).To(Equal([]v1.Taint{otherTaint}))
+		Expect(updatedNode.Annotations).NotTo(HaveKey(constants.DedicatedNodeLeaseExpiry))
+	})
+
+	It("removes a taint without a valid lease", func() {
+		Expect(fakeClient.Create(context.TODO(), node)).Should(Succeed())
+
+		_, updatedNode := reconcile()
+
+		Expect(updatedNode.Spec.Taints).To(Equal([]v1.Taint{otherTaint}))
+	})
+})
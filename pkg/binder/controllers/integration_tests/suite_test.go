@@ -116,10 +116,10 @@ var _ = BeforeSuite(func() {
 	clientWithWatch, err := client.NewWithWatch(cfg, client.Options{})
 	Expect(err).NotTo(HaveOccurred())
 
-	rrs := resourcereservation.NewService(false, clientWithWatch, "", 40*time.Second,
+	rrs := resourcereservation.NewService(false, clientWithWatch, "", nil, 40*time.Second,
 		resourceReservationNameSpace, resourceReservationServiceAccount, resourceReservationAppLabelValue, scalingPodsNamespace, constants.DefaultRuntimeClassName,
-		nil) // nil podResources to use defaults
-	podBinder := binding.NewBinder(k8sManager.GetClient(), rrs, binderPlugins)
+		nil, false) // nil podResources to use defaults
+	podBinder := binding.NewBinder(k8sManager.GetClient(), rrs, binderPlugins, false)
 
 	err = controllers.NewBindRequestReconciler(
 		k8sManager.GetClient(), k8sManager.GetScheme(), k8sManager.GetEventRecorderFor("binder"), params,
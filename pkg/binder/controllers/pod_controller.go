@@ -5,21 +5,25 @@ package controllers
 
 import (
 	"context"
+	"fmt"
 	"slices"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/binding/resourcereservation"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 )
 
@@ -47,7 +51,53 @@ type ReconcilerParams struct {
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.14.4/pkg/reconcile
 func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return ctrl.Result{}, nil
+	pod := &corev1.Pod{}
+	if err := r.Client.Get(ctx, req.NamespacedName, pod); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !controllerutil.ContainsFinalizer(pod, constants.GpuSharingReleaseFinalizer) {
+		return ctrl.Result{}, nil
+	}
+	released, err := r.isGpuSharingReleased(ctx, pod)
+	if err != nil || !released {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, r.releaseGpuSharing(ctx, pod)
+}
+
+// isGpuSharingReleased returns whether the pod no longer uses its share of the GPU. The containers of a bound pod may
+// use it until they terminate, even if the pod is deleted, unless the pod is force deleted or its node is gone.
+func (r *PodReconciler) isGpuSharingReleased(ctx context.Context, pod *corev1.Pod) (bool, error) {
+	if isTerminated(pod) || isForceDeleted(pod) {
+		return true, nil
+	}
+	if pod.Spec.NodeName == "" {
+		return pod.DeletionTimestamp != nil, nil
+	}
+	err := r.Client.Get(ctx, client.ObjectKey{Name: pod.Spec.NodeName}, &corev1.Node{})
+	if kerrors.IsNotFound(err) {
+		return true, nil
+	}
+	return false, client.IgnoreNotFound(err)
+}
+
+// releaseGpuSharing syncs the reservations of the GPU groups of a terminated pod before removing its finalizer, so
+// that its share of the GPU isn't seen as free while its reservation pod still holds it
+func (r *PodReconciler) releaseGpuSharing(ctx context.Context, pod *corev1.Pod) error {
+	logger := log.FromContext(ctx)
+	for _, gpuGroup := range resources.GetGpuGroups(pod) {
+		if err := r.ResourceReservation.SyncForGpuGroup(ctx, gpuGroup); err != nil {
+			return fmt.Errorf("failed to sync reservation of gpu group %s for pod <%s/%s>: %w",
+				gpuGroup, pod.Namespace, pod.Name, err)
+		}
+	}
+
+	logger.Info("Released GPU sharing reservation of pod", "name", pod.Name, "namespace", pod.Namespace)
+	originalPod := pod.DeepCopy()
+	controllerutil.RemoveFinalizer(pod, constants.GpuSharingReleaseFinalizer)
+	return client.IgnoreNotFound(r.Client.Patch(ctx, pod,
+		client.MergeFromWithOptions(originalPod, client.MergeFromWithOptimisticLock{})))
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -65,6 +115,7 @@ func (r *PodReconciler) SetupWithManager(
 			),
 			SkipNameValidation: &[]bool{true}[0],
 		}).
+		Watches(&corev1.Node{}, handler.Funcs{DeleteFunc: r.enqueueNodePods}).
 		Owns(&corev1.ConfigMap{}).
 		Complete(r)
 }
@@ -107,6 +158,25 @@ func (r *PodReconciler) eventHandlers() handler.Funcs {
 	}
 }
 
+// enqueueNodePods enqueues the pods of a deleted node that hold the GPU sharing finalizer, since their containers no
+// longer run and their phase may never be updated
+func (r *PodReconciler) enqueueNodePods(
+	ctx context.Context, deleteEvent event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request],
+) {
+	logger := log.FromContext(ctx)
+	pods := &corev1.PodList{}
+	if err := r.Client.List(ctx, pods,
+		client.MatchingFields{"spec.nodeName": deleteEvent.Object.GetName()}); err != nil {
+		logger.Error(err, "failed to list pods of deleted node", "node", deleteEvent.Object.GetName())
+		return
+	}
+	for _, pod := range pods.Items {
+		if r.isRelevantPod(&pod) && controllerutil.ContainsFinalizer(&pod, constants.GpuSharingReleaseFinalizer) {
+			q.Add(reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&pod)})
+		}
+	}
+}
+
 func (r *PodReconciler) syncReservationIfNeeded(ctx context.Context, object client.Object) {
 	logger := log.FromContext(ctx)
 	pod, isPod := object.(*corev1.Pod)
@@ -135,6 +205,17 @@ func (r *PodReconciler) isRelevantPod(object client.Object) bool {
 	return false
 }
 
+func isTerminated(pod *corev1.Pod) bool {
+	return pod.Status.Phase == corev1.PodFailed || pod.Status.Phase == corev1.PodSucceeded
+}
+
+// isForceDeleted returns whether the pod was deleted without a grace period, so the API server no longer waits for the
+// kubelet to report that its containers terminated
+func isForceDeleted(pod *corev1.Pod) bool {
+	return pod.DeletionTimestamp != nil &&
+		pod.DeletionGracePeriodSeconds != nil && *pod.DeletionGracePeriodSeconds == 0
+}
+
 func isCompletionEvent(oldObject client.Object, newObject client.Object) bool {
 	oldPod, isPod := oldObject.(*corev1.Pod)
 	if !isPod {
@@ -0,0 +1,135 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	rrmock "github.com/NVIDIA/KAI-scheduler/pkg/binder/binding/resourcereservation/mock"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+var _ = Describe("Pod Controller", func() {
+	const gpuGroup = "gpu-group"
+
+	var (
+		fakeClient client.WithWatch
+		mockRrs    *rrmock.MockInterface
+		reconciler *PodReconciler
+		pod        *v1.Pod
+		node       *v1.Node
+	)
+	BeforeEach(func() {
+		testScheme := runtime.NewScheme()
+		Expect(v1.AddToScheme(testScheme)).Should(Succeed())
+		fakeClient = fake.NewClientBuilder().WithScheme(testScheme).Build()
+		mockRrs = rrmock.NewMockInterface(gomock.NewController(GinkgoT()))
+		reconciler = &PodReconciler{
+			Client:              fakeClient,
+			Scheme:              testScheme,
+			ResourceReservation: mockRrs,
+		}
+		node = &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+		Expect(fakeClient.Create(context.TODO(), node)).Should(Succeed())
+		pod = &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "pod",
+				Namespace:  "default",
+				Labels:     map[string]string{constants.GPUGroup: gpuGroup},
+				Finalizers: []string{constants.GpuSharingReleaseFinalizer},
+			},
+			Spec:   v1.PodSpec{NodeName: node.Name},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		}
+	})
+
+	reconcile := func() error {
+		_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(pod)})
+		return err
+	}
+
+	It("keeps the finalizer of a running pod", func() {
+		Expect(fakeClient.Create(context.TODO(), pod)).Should(Succeed())
+
+		Expect(reconcile()).Should(Succeed())
+
+		updatedPod := &v1.Pod{}
+		Expect(fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(pod), updatedPod)).Should(Succeed())
+		Expect(updatedPod.Finalizers).To(ContainElement(constants.GpuSharingReleaseFinalizer))
+	})
+
+	It("releases the reservation of a terminated pod before removing its finalizer", func() {
+		pod.Status.Phase = v1.PodSucceeded
+		Expect(fakeClient.Create(context.TODO(), pod)).Should(Succeed())
+		mockRrs.EXPECT().SyncForGpuGroup(gomock.Any(), gpuGroup).Return(nil).Times(1)
+
+		Expect(reconcile()).Should(Succeed())
+
+		updatedPod := &v1.Pod{}
+		Expect(fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(pod), updatedPod)).Should(Succeed())
+		Expect(updatedPod.Finalizers).NotTo(ContainElement(constants.GpuSharingReleaseFinalizer))
+	})
+
+	It("keeps the finalizer of a deleted pod until its containers terminate", func() {
+		Expect(fakeClient.Create(context.TODO(), pod)).Should(Succeed())
+		Expect(fakeClient.Delete(context.TODO(), pod)).Should(Succeed())
+
+		Expect(reconcile()).Should(Succeed())
+
+		updatedPod := &v1.Pod{}
+		Expect(fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(pod), updatedPod)).Should(Succeed())
+		Expect(updatedPod.DeletionTimestamp).NotTo(BeNil())
+		Expect(updatedPod.Finalizers).To(ContainElement(constants.GpuSharingReleaseFinalizer))
+	})
+
+	It("completes the deletion of a terminated pod once its reservation is released", func() {
+		pod.Status.Phase = v1.PodFailed
+		Expect(fakeClient.Create(context.TODO(), pod)).Should(Succeed())
+		Expect(fakeClient.Delete(context.TODO(), pod)).Should(Succeed())
+		mockRrs.EXPECT().SyncForGpuGroup(gomock.Any(), gpuGroup).Return(nil).Times(1)
+
+		Expect(reconcile()).Should(Succeed())
+
+		err := fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(pod), &v1.Pod{})
+		Expect(kerrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("releases the reservation of a force deleted pod", func() {
+		// The fake client doesn't record the grace period of deletions
+		pod.DeletionTimestamp = ptr.To(metav1.Now())
+		pod.DeletionGracePeriodSeconds = ptr.To(int64(0))
+		fakeClient = fake.NewClientBuilder().WithScheme(fakeClient.Scheme()).WithObjects(node, pod).Build()
+		reconciler.Client = fakeClient
+		mockRrs.EXPECT().SyncForGpuGroup(gomock.Any(), gpuGroup).Return(nil).Times(1)
+
+		Expect(reconcile()).Should(Succeed())
+
+		err := fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(pod), &v1.Pod{})
+		Expect(kerrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("releases the reservation of a pod whose node is gone", func() {
+		Expect(fakeClient.Create(context.TODO(), pod)).Should(Succeed())
+		Expect(fakeClient.Delete(context.TODO(), node)).Should(Succeed())
+		mockRrs.EXPECT().SyncForGpuGroup(gomock.Any(), gpuGroup).Return(nil).Times(1)
+
+		Expect(reconcile()).Should(Succeed())
+
+		updatedPod := &v1.Pod{}
+		Expect(fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(pod), updatedPod)).Should(Succeed())
+		Expect(updatedPod.Finalizers).NotTo(ContainElement(constants.GpuSharingReleaseFinalizer))
+	})
+})
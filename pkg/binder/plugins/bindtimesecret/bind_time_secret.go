@@ -0,0 +1,264 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package bindtimesecret
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/state"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+// ServiceAccountTokenKey is the key of the token of the pod's service account in its bind-time secret
+const ServiceAccountTokenKey = "service-account-token"
+
+const defaultTokenExpirationSeconds = int64(3600)
+
+// NodePoolSecret configures the bind-time secret of the pods bound to the nodes of a node pool
+type NodePoolSecret struct {
+	// Secret is the <namespace>/<name> of the secret whose data is copied, such as an accelerator license token
+	Secret string `json:"secret,omitempty"`
+	// Namespaces are the namespaces whose pods may receive the bind-time secret of the node pool
+	Namespaces []string `json:"namespaces"`
+	// ServiceAccountTokenAudience requests a token of the pod's service account for the audience, bound to the pod
+	ServiceAccountTokenAudience string `json:"serviceAccountTokenAudience,omitempty"`
+	// ServiceAccountTokenExpirationSeconds is the lifetime of the token, one hour by default
+	ServiceAccountTokenExpirationSeconds *int64 `json:"serviceAccountTokenExpirationSeconds,omitempty"`
+}
+
+type nodePoolSecret struct {
+	source          *types.NamespacedName
+	namespaces      map[string]bool
+	tokenAudience   string
+	tokenExpiration int64
+}
+
+// BindTimeSecret fills the secret named by the kai.scheduler/bind-time-secret annotation of a pod with the bind-time
+// secret of the node pool the pod is bound to: the data of a source secret, such as the license token or device access
+// keys of its accelerators, and a short-lived token of the pod's service account. Only pods of the namespaces allowed
+// for the node pool get the secret. The pod mounts the secret, so its containers don't start before the secret is
+// created on bind.
+type BindTimeSecret struct {
+	kubeClient       kubernetes.Interface
+	nodePoolLabelKey string
+	nodePoolSecrets  map[string]*nodePoolSecret
+}
+
+// New returns the plugin with the bind-time secrets of the node pools, by node pool name
+func New(
+	kubeClient kubernetes.Interface, nodePoolLabelKey string, nodePoolSecrets map[string]NodePoolSecret,
+) (*BindTimeSecret, error) {
+	secrets := make(map[string]*nodePoolSecret, len(nodePoolSecrets))
+	for nodePool, config := range nodePoolSecrets {
+		secret := &nodePoolSecret{
+			namespaces:      map[string]bool{},
+			tokenAudience:   config.ServiceAccountTokenAudience,
+			tokenExpiration: ptr.Deref(config.ServiceAccountTokenExpirationSeconds, defaultTokenExpirationSeconds),
+		}
+		if config.Secret != "" {
+			namespace, name, found := strings.Cut(config.Secret, "/")
+			if !found || namespace == "" || name == "" {
+				return nil, fmt.Errorf("invalid bind-time secret %q of node pool %s, expected <namespace>/<name>",
+					config.Secret, nodePool)
+			}
+			secret.source = &types.NamespacedName{Namespace: namespace, Name: name}
+		}
+		if secret.source == nil && secret.tokenAudience == "" {
+			return nil, fmt.Errorf("bind-time secret of node pool %s has neither a source secret nor a "+
+				"service account token audience", nodePool)
+		}
+		if len(config.Namespaces) == 0 {
+			return nil, fmt.Errorf("bind-time secret of node pool %s doesn't allow any namespace", nodePool)
+		}
+		for _, namespace := range config.Namespaces {
+			secret.namespaces[namespace] = true
+		}
+		secrets[nodePool] = secret
+	}
+	return &BindTimeSecret{
+		kubeClient:       kubeClient,
+		nodePoolLabelKey: nodePoolLabelKey,
+		nodePoolSecrets:  secrets,
+	}, nil
+}
+
+func (p *BindTimeSecret) Name() string {
+	return "bindtimesecret"
+}
+
+func (p *BindTimeSecret) PreBind(
+	ctx context.Context, pod *v1.Pod, node *v1.Node, _ *v1alpha2.BindRequest, _ *state.BindingState,
+) error {
+	secretName := pod.Annotations[constants.BindTimeSecret]
+	if secretName == "" {
+		return nil
+	}
+
+	nodePool := node.Labels[p.nodePoolLabelKey]
+	if nodePool == "" {
+		nodePool = constants.DefaultNodePoolName
+	}
+	config, found := p.nodePoolSecrets[nodePool]
+	if !found {
+		return fmt.Errorf("no bind-time secret is configured for node pool %s of node %s", nodePool, node.Name)
+	}
+	if !config.namespaces[pod.Namespace] {
+		return fmt.Errorf("pods of namespace %s may not get the bind-time secret of node pool %s",
+			pod.Namespace, nodePool)
+	}
+	data, secretType, err := p.secretData(ctx, pod, nodePool, config)
+	if err != nil {
+		return err
+	}
+
+	desiredSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: pod.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "v1",
+					Kind:       "Pod",
+					Name:       pod.Name,
+					UID:        pod.UID,
+				},
+			},
+		},
+		Type: secretType,
+		Data: data,
+	}
+	_, err = p.kubeClient.CoreV1().Secrets(pod.Namespace).Create(ctx, desiredSecret, metav1.CreateOptions{})
+	if err == nil {
+		return nil
+	}
+	if !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create bind-time secret %s/%s for pod %s: %w",
+			pod.Namespace, secretName, pod.Name, err)
+	}
+
+	// The secret of a previous bind attempt of the pod is refreshed, any other secret is left untouched
+	existingSecret, err := p.kubeClient.CoreV1().Secrets(pod.Namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get bind-time secret %s/%s for pod %s: %w",
+			pod.Namespace, secretName, pod.Name, err)
+	}
+	if !isOwnedByPod(existingSecret, pod) {
+		return fmt.Errorf("secret %s/%s already exists and isn't owned by pod %s",
+			pod.Namespace, secretName, pod.Name)
+	}
+	existingSecret.Data = desiredSecret.Data
+	_, err = p.kubeClient.CoreV1().Secrets(pod.Namespace).Update(ctx, existingSecret, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update bind-time secret %s/%s for pod %s: %w",
+			pod.Namespace, secretName, pod.Name, err)
+	}
+	return nil
+}
+
+// secretData returns the data and the type of the bind-time secret of the pod
+func (p *BindTimeSecret) secretData(
+	ctx context.Context, pod *v1.Pod, nodePool string, config *nodePoolSecret,
+) (map[string][]byte, v1.SecretType, error) {
+	data := map[string][]byte{}
+	secretType := v1.SecretTypeOpaque
+	if config.source != nil {
+		sourceSecret, err := p.kubeClient.CoreV1().Secrets(config.source.Namespace).Get(
+			ctx, config.source.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to get bind-time secret %s of node pool %s: %w",
+				config.source, nodePool, err)
+		}
+		data = maps.Clone(sourceSecret.Data)
+		if data == nil {
+			data = map[string][]byte{}
+		}
+		secretType = sourceSecret.Type
+	}
+	if config.tokenAudience == "" {
+		return data, secretType, nil
+	}
+
+	serviceAccount := pod.Spec.ServiceAccountName
+	if serviceAccount == "" {
+		serviceAccount = "default"
+	}
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         []string{config.tokenAudience},
+			ExpirationSeconds: ptr.To(config.tokenExpiration),
+			BoundObjectRef: &authenticationv1.BoundObjectReference{
+				APIVersion: "v1",
+				Kind:       "Pod",
+				Name:       pod.Name,
+				UID:        pod.UID,
+			},
+		},
+	}
+	token, err := p.kubeClient.CoreV1().ServiceAccounts(pod.Namespace).CreateToken(
+		ctx, serviceAccount, tokenRequest, metav1.CreateOptions{})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to request a token of service account %s/%s for pod %s: %w",
+			pod.Namespace, serviceAccount, pod.Name, err)
+	}
+	data[ServiceAccountTokenKey] = []byte(token.Status.Token)
+	return data, secretType, nil
+}
+
+func (p *BindTimeSecret) PostBind(
+	context.Context, *v1.Pod, *v1.Node, *v1alpha2.BindRequest, *state.BindingState,
+) {
+}
+
+func (p *BindTimeSecret) Rollback(
+	ctx context.Context, pod *v1.Pod, _ *v1.Node, _ *v1alpha2.BindRequest, _ *state.BindingState,
+) error {
+	secretName := pod.Annotations[constants.BindTimeSecret]
+	if secretName == "" {
+		return nil
+	}
+
+	secret, err := p.kubeClient.CoreV1().Secrets(pod.Namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get bind-time secret %s/%s during rollback: %w", pod.Namespace, secretName, err)
+	}
+	if !isOwnedByPod(secret, pod) {
+		return nil
+	}
+
+	err = p.kubeClient.CoreV1().Secrets(pod.Namespace).Delete(ctx, secretName, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &secret.UID},
+	})
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete bind-time secret %s/%s during rollback: %w",
+			pod.Namespace, secretName, err)
+	}
+	log.FromContext(ctx).V(1).Info("deleted bind-time secret",
+		"namespace", pod.Namespace, "name", pod.Name, "secret", secretName)
+	return nil
+}
+
+func isOwnedByPod(secret *v1.Secret, pod *v1.Pod) bool {
+	for _, owner := range secret.OwnerReferences {
+		if owner.Kind == "Pod" && owner.UID == pod.UID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,243 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package bindtimesecret
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+const (
+	sourceNamespace = "licenses"
+	podNamespace    = "team-a"
+	targetName      = "accelerator-license"
+)
+
+func newSourceSecret(name, token string) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: sourceNamespace},
+		Type:       v1.SecretTypeOpaque,
+		Data:       map[string][]byte{"token": []byte(token)},
+	}
+}
+
+func newPod(annotated bool) *v1.Pod {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Namespace: podNamespace, UID: "pod-uid"},
+	}
+	if annotated {
+		pod.Annotations = map[string]string{constants.BindTimeSecret: targetName}
+	}
+	return pod
+}
+
+func newNode(nodePool string) *v1.Node {
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-0"}}
+	if nodePool != "" {
+		node.Labels = map[string]string{constants.DefaultNodePoolLabelKey: nodePool}
+	}
+	return node
+}
+
+func newPlugin(t *testing.T, objects ...*v1.Secret) (*BindTimeSecret, *fake.Clientset) {
+	kubeClient := fake.NewSimpleClientset()
+	for _, object := range objects {
+		_, err := kubeClient.CoreV1().Secrets(object.Namespace).Create(context.TODO(), object, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+	plugin, err := New(kubeClient, constants.DefaultNodePoolLabelKey, map[string]NodePoolSecret{
+		"default":   {Secret: sourceNamespace + "/default-license", Namespaces: []string{podNamespace}},
+		"inference": {Secret: sourceNamespace + "/inference-license", Namespaces: []string{podNamespace}},
+		"restricted": {
+			Secret: sourceNamespace + "/restricted-license", Namespaces: []string{"team-b"},
+		},
+	})
+	require.NoError(t, err)
+	return plugin, kubeClient
+}
+
+func getTarget(t *testing.T, kubeClient *fake.Clientset) (*v1.Secret, error) {
+	t.Helper()
+	return kubeClient.CoreV1().Secrets(podNamespace).Get(context.TODO(), targetName, metav1.GetOptions{})
+}
+
+func TestNew_InvalidSource(t *testing.T) {
+	for _, source := range []string{"license", "/license", "licenses/"} {
+		_, err := New(fake.NewSimpleClientset(), constants.DefaultNodePoolLabelKey,
+			map[string]NodePoolSecret{"default": {Secret: source, Namespaces: []string{podNamespace}}})
+		assert.Error(t, err, source)
+	}
+}
+
+func TestNew_InvalidConfig(t *testing.T) {
+	tests := map[string]NodePoolSecret{
+		"no namespaces":            {Secret: sourceNamespace + "/license"},
+		"no source and no token":   {Namespaces: []string{podNamespace}},
+		"token without namespaces": {ServiceAccountTokenAudience: "vendor"},
+	}
+	for name, config := range tests {
+		_, err := New(fake.NewSimpleClientset(), constants.DefaultNodePoolLabelKey,
+			map[string]NodePoolSecret{"default": config})
+		assert.Error(t, err, name)
+	}
+}
+
+func TestPreBind_NamespaceNotAllowed(t *testing.T) {
+	plugin, kubeClient := newPlugin(t, newSourceSecret("restricted-license", "restricted-token"))
+
+	assert.Error(t, plugin.PreBind(context.TODO(), newPod(true), newNode("restricted"), nil, nil))
+
+	_, err := getTarget(t, kubeClient)
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestPreBind_ServiceAccountToken(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(newSourceSecret("default-license", "license-token"))
+	var tokenRequest *authenticationv1.TokenRequest
+	var serviceAccount string
+	kubeClient.PrependReactor("create", "serviceaccounts",
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			createAction := action.(k8stesting.CreateActionImpl)
+			if createAction.GetSubresource() != "token" {
+				return false, nil, nil
+			}
+			serviceAccount = createAction.Name
+			tokenRequest = createAction.GetObject().(*authenticationv1.TokenRequest).DeepCopy()
+			tokenRequest.Status.Token = "service-account-token"
+			return true, tokenRequest, nil
+		})
+	plugin, err := New(kubeClient, constants.DefaultNodePoolLabelKey, map[string]NodePoolSecret{
+		"default": {
+			Secret:                      sourceNamespace + "/default-license",
+			Namespaces:                  []string{podNamespace},
+			ServiceAccountTokenAudience: "vendor",
+		},
+	})
+	require.NoError(t, err)
+	pod := newPod(true)
+	pod.Spec.ServiceAccountName = "trainer"
+
+	require.NoError(t, plugin.PreBind(context.TODO(), pod, newNode(""), nil, nil))
+
+	require.NotNil(t, tokenRequest)
+	assert.Equal(t, "trainer", serviceAccount)
+	assert.Equal(t, []string{"vendor"}, tokenRequest.Spec.Audiences)
+	assert.Equal(t, defaultTokenExpirationSeconds, *tokenRequest.Spec.ExpirationSeconds)
+	assert.Equal(t, pod.UID, tokenRequest.Spec.BoundObjectRef.UID)
+	secret, err := getTarget(t, kubeClient)
+	require.NoError(t, err)
+	assert.Equal(t, "license-token", string(secret.Data["token"]))
+	assert.Equal(t, "service-account-token", string(secret.Data[ServiceAccountTokenKey]))
+}
+
+func TestPreBind_CopiesSecretOfNodePool(t *testing.T) {
+	tests := []struct {
+		name      string
+		nodePool  string
+		wantToken string
+	}{
+		{name: "labeled node", nodePool: "inference", wantToken: "inference-token"},
+		{name: "unlabeled node uses the default node pool", nodePool: "", wantToken: "default-token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin, kubeClient := newPlugin(t,
+				newSourceSecret("default-license", "default-token"),
+				newSourceSecret("inference-license", "inference-token"))
+			pod := newPod(true)
+
+			require.NoError(t, plugin.PreBind(context.TODO(), pod, newNode(tt.nodePool), nil, nil))
+
+			secret, err := getTarget(t, kubeClient)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantToken, string(secret.Data["token"]))
+			assert.Equal(t, v1.SecretTypeOpaque, secret.Type)
+			require.Len(t, secret.OwnerReferences, 1)
+			assert.Equal(t, pod.UID, secret.OwnerReferences[0].UID)
+		})
+	}
+}
+
+func TestPreBind_PodWithoutAnnotation(t *testing.T) {
+	plugin, kubeClient := newPlugin(t)
+
+	require.NoError(t, plugin.PreBind(context.TODO(), newPod(false), newNode("unknown"), nil, nil))
+
+	_, err := getTarget(t, kubeClient)
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestPreBind_NodePoolWithoutSecret(t *testing.T) {
+	plugin, _ := newPlugin(t, newSourceSecret("default-license", "default-token"))
+
+	assert.Error(t, plugin.PreBind(context.TODO(), newPod(true), newNode("training"), nil, nil))
+}
+
+func TestPreBind_RefreshesSecretOfPreviousAttempt(t *testing.T) {
+	plugin, kubeClient := newPlugin(t, newSourceSecret("default-license", "new-token"))
+	pod := newPod(true)
+	previous := newSourceSecret(targetName, "old-token")
+	previous.Namespace = podNamespace
+	previous.OwnerReferences = []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: pod.Name, UID: pod.UID}}
+	_, err := kubeClient.CoreV1().Secrets(podNamespace).Create(context.TODO(), previous, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, plugin.PreBind(context.TODO(), pod, newNode(""), nil, nil))
+
+	secret, err := getTarget(t, kubeClient)
+	require.NoError(t, err)
+	assert.Equal(t, "new-token", string(secret.Data["token"]))
+}
+
+func TestPreBind_DoesNotOverwriteSecretOfOthers(t *testing.T) {
+	plugin, kubeClient := newPlugin(t, newSourceSecret("default-license", "new-token"))
+	existing := newSourceSecret(targetName, "user-token")
+	existing.Namespace = podNamespace
+	_, err := kubeClient.CoreV1().Secrets(podNamespace).Create(context.TODO(), existing, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	assert.Error(t, plugin.PreBind(context.TODO(), newPod(true), newNode(""), nil, nil))
+
+	secret, err := getTarget(t, kubeClient)
+	require.NoError(t, err)
+	assert.Equal(t, "user-token", string(secret.Data["token"]))
+}
+
+func TestRollback(t *testing.T) {
+	plugin, kubeClient := newPlugin(t, newSourceSecret("default-license", "default-token"))
+	pod := newPod(true)
+	require.NoError(t, plugin.PreBind(context.TODO(), pod, newNode(""), nil, nil))
+
+	require.NoError(t, plugin.Rollback(context.TODO(), pod, nil, nil, nil))
+	_, err := getTarget(t, kubeClient)
+	assert.True(t, errors.IsNotFound(err))
+
+	// Rolling back again is a no-op
+	require.NoError(t, plugin.Rollback(context.TODO(), pod, nil, nil, nil))
+}
+
+func TestRollback_KeepsSecretOfOthers(t *testing.T) {
+	plugin, kubeClient := newPlugin(t)
+	existing := newSourceSecret(targetName, "user-token")
+	existing.Namespace = podNamespace
+	_, err := kubeClient.CoreV1().Secrets(podNamespace).Create(context.TODO(), existing, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, plugin.Rollback(context.TODO(), newPod(true), nil, nil, nil))
+
+	_, err = getTarget(t, kubeClient)
+	assert.NoError(t, err)
+}
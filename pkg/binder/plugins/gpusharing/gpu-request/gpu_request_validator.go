@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 )
 
 func ValidateGpuRequests(pod *v1.Pod) error {
@@ -19,6 +20,7 @@ func ValidateGpuRequests(pod *v1.Pod) error {
 	gpuFractionsCountFromAnnotation, hasGpuFractionsCount := pod.Annotations[constants.GpuFractionsNumDevices]
 
 	mpsFromAnnotation, hasMpsAnnotation := pod.Annotations[constants.MpsAnnotation]
+	computeFractionFromAnnotation, hasComputeFraction := pod.Annotations[constants.GpuComputeFraction]
 
 	wholeGPULimit := getFirstGPULimit(pod)
 	hasWholeGPULimit := wholeGPULimit != nil
@@ -29,6 +31,10 @@ func ValidateGpuRequests(pod *v1.Pod) error {
 		return fmt.Errorf("MPS is only supported with GPU fraction request")
 	}
 
+	if !isFractional && hasComputeFraction {
+		return fmt.Errorf("GPU compute fraction is only supported with GPU fraction request")
+	}
+
 	if hasGpuFractionAnnotation && hasWholeGPULimit {
 		return fmt.Errorf("cannot have both GPU fraction request and whole GPU resource request/limit")
 	}
@@ -43,6 +49,15 @@ func ValidateGpuRequests(pod *v1.Pod) error {
 		)
 	}
 
+	if resources.RequestsGPUCountRange(pod) {
+		if isFractional || hasWholeGPULimit || hasGpuFractionsCount {
+			return fmt.Errorf("cannot request a range of GPUs together with any other GPU request")
+		}
+		if _, _, err := resources.GetGPUCountRange(pod); err != nil {
+			return err
+		}
+	}
+
 	err := validateMemoryAnnotation(hasGpuMemoryAnnotation, gpuMemoryFromAnnotation)
 	if err != nil {
 		return err
@@ -53,6 +68,11 @@ func ValidateGpuRequests(pod *v1.Pod) error {
 		return err
 	}
 
+	err = validateComputeFractionAnnotation(hasComputeFraction, computeFractionFromAnnotation)
+	if err != nil {
+		return err
+	}
+
 	err = validateMultiFractionRequest(hasGpuFractionsCount, gpuFractionsCountFromAnnotation)
 	if err != nil {
 		return err
@@ -84,6 +104,18 @@ func validateGpuFractionAnnotation(hasGpuFractionAnnotation bool, gpuFractionFro
 	return nil
 }
 
+func validateComputeFractionAnnotation(hasComputeFraction bool, computeFractionFromAnnotation string) error {
+	if !hasComputeFraction {
+		return nil
+	}
+	computeFraction, err := strconv.ParseFloat(computeFractionFromAnnotation, 64)
+	if err != nil || computeFraction <= 0 || computeFraction > 1 {
+		return fmt.Errorf("%s annotation value must be a positive number not greater than 1.0",
+			constants.GpuComputeFraction)
+	}
+	return nil
+}
+
 func validateMultiFractionRequest(hasGpuFractionsCount bool, gpuFractionsCountFromAnnotation string) error {
 	if !hasGpuFractionsCount {
 		return nil
@@ -95,12 +127,14 @@ func validateMultiFractionRequest(hasGpuFractionsCount bool, gpuFractionsCountFr
 	return nil
 }
 
-// getFirstGPULimit gets the first GPU limit from the pod.Containers or pod.InitContainers.
+// getFirstGPULimit gets the first limit of an accelerator resource from the pod.Containers or pod.InitContainers.
 func getFirstGPULimit(pod *v1.Pod) *resource.Quantity {
 	containers := append(pod.Spec.Containers, pod.Spec.InitContainers...)
 	for _, container := range containers {
-		if limit, ok := container.Resources.Limits[constants.GpuResource]; ok {
-			return &limit
+		for _, resourceName := range resources.AcceleratorResourceNames() {
+			if limit, ok := container.Resources.Limits[resourceName]; ok {
+				return &limit
+			}
 		}
 	}
 	return nil
@@ -368,6 +368,85 @@ func TestValidateGpuRequests(t *testing.T) {
 			},
 			error: fmt.Errorf("fraction count annotation value must be a positive integer greater than 0"),
 		},
+		{
+			name: "allow GPU count range",
+			pod: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						constants.GpuCountMin: "4",
+						constants.GpuCountMax: "8",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Resources: v1.ResourceRequirements{},
+						},
+					},
+				},
+			},
+			error: nil,
+		},
+		{
+			name: "forbid GPU count range without a minimum",
+			pod: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						constants.GpuCountMax: "8",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Resources: v1.ResourceRequirements{},
+						},
+					},
+				},
+			},
+			error: fmt.Errorf("kai.scheduler/gpu-count-min annotation not found"),
+		},
+		{
+			name: "forbid GPU count range with a maximum smaller than the minimum",
+			pod: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						constants.GpuCountMin: "4",
+						constants.GpuCountMax: "2",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Resources: v1.ResourceRequirements{},
+						},
+					},
+				},
+			},
+			error: fmt.Errorf(
+				"kai.scheduler/gpu-count-max annotation value must be an integer not smaller than kai.scheduler/gpu-count-min"),
+		},
+		{
+			name: "forbid GPU count range with whole gpu limit",
+			pod: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						constants.GpuCountMin: "4",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Resources: v1.ResourceRequirements{
+								Limits: v1.ResourceList{
+									constants.GpuResource: resource.MustParse("4"),
+								},
+							},
+						},
+					},
+				},
+			},
+			error: fmt.Errorf("cannot request a range of GPUs together with any other GPU request"),
+		},
 		{
 			name: "allow GPU fraction count with memory",
 			pod: &v1.Pod{
@@ -406,6 +485,62 @@ func TestValidateGpuRequests(t *testing.T) {
 			},
 			error: nil,
 		},
+		{
+			name: "allow GPU compute fraction with fractions",
+			pod: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						constants.GpuFraction:        "0.5",
+						constants.GpuComputeFraction: "0.25",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Resources: v1.ResourceRequirements{},
+						},
+					},
+				},
+			},
+			error: nil,
+		},
+		{
+			name: "forbid GPU compute fraction without fractions or memory",
+			pod: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						constants.GpuComputeFraction: "0.25",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Resources: v1.ResourceRequirements{},
+						},
+					},
+				},
+			},
+			error: fmt.Errorf("GPU compute fraction is only supported with GPU fraction request"),
+		},
+		{
+			name: "forbid GPU compute fraction which is greater than 1.0",
+			pod: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						constants.GpuMemory:          "1000",
+						constants.GpuComputeFraction: "1.5",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Resources: v1.ResourceRequirements{},
+						},
+					},
+				},
+			},
+			error: fmt.Errorf("kai.scheduler/gpu-compute-fraction annotation value must be a positive number not greater than 1.0"),
+		},
 	}
 
 	for _, tt := range tests {
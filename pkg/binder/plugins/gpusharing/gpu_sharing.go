@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 
 	"golang.org/x/exp/slices"
@@ -19,6 +21,7 @@ import (
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/common"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/state"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 )
 
 const (
@@ -42,7 +45,7 @@ func (p *GPUSharing) Name() string {
 }
 
 func (p *GPUSharing) PreBind(
-	ctx context.Context, pod *v1.Pod, _ *v1.Node, bindRequest *v1alpha2.BindRequest, state *state.BindingState,
+	ctx context.Context, pod *v1.Pod, node *v1.Node, bindRequest *v1alpha2.BindRequest, state *state.BindingState,
 ) error {
 	if !common.IsSharedGPUAllocation(bindRequest) {
 		return nil
@@ -70,6 +73,11 @@ func (p *GPUSharing) PreBind(
 		return fmt.Errorf("failed to create env configmap: %w", err)
 	}
 
+	err = p.setComputeFraction(ctx, pod, node, containerRef)
+	if err != nil {
+		return err
+	}
+
 	nVisibleDevicesStr := strings.Join(reservedGPUIds, ",")
 	err = common.SetNvidiaVisibleDevices(ctx, p.kubeClient, pod, containerRef, nVisibleDevicesStr)
 	if err != nil {
@@ -99,6 +107,29 @@ func (p *GPUSharing) createDirectEnvMapIfMissing(ctx context.Context, pod *v1.Po
 	return gpusharingconfigmap.UpsertJobConfigMap(ctx, p.kubeClient, pod, directEnvVarsMapName, directEnvVars)
 }
 
+// setComputeFraction enforces the compute fraction requested by the pod with MPS, on nodes that run an MPS server.
+// On other nodes the pod can use all the compute of its GPUs, as without a compute fraction.
+func (p *GPUSharing) setComputeFraction(
+	ctx context.Context, pod *v1.Pod, node *v1.Node, containerRef *gpusharingconfigmap.PodContainerRef,
+) error {
+	computeFractionStr, found := pod.Annotations[constants.GpuComputeFraction]
+	if !found {
+		return nil
+	}
+	if node.Labels[constants.MpsCapableLabel] != "true" {
+		log.FromContext(ctx).V(1).Info("node doesn't support MPS, the compute fraction of the pod is not enforced",
+			"namespace", pod.Namespace, "name", pod.Name, "node", node.Name)
+		return nil
+	}
+
+	computeFraction, err := strconv.ParseFloat(computeFractionStr, 64)
+	if err != nil || computeFraction <= 0 || computeFraction > 1 {
+		return fmt.Errorf("invalid %s annotation value %q", constants.GpuComputeFraction, computeFractionStr)
+	}
+	threadPercentage := max(1, int(math.Round(computeFraction*100)))
+	return common.SetMpsThreadPercentage(ctx, p.kubeClient, pod, containerRef, strconv.Itoa(threadPercentage))
+}
+
 func (p *GPUSharing) PostBind(
 	context.Context, *v1.Pod, *v1.Node, *v1alpha2.BindRequest, *state.BindingState,
 ) {
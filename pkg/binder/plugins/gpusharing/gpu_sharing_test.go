@@ -18,6 +18,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/common"
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/common/gpusharingconfigmap"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/state"
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 )
 
@@ -514,3 +515,83 @@ func TestGPUSharingRollbackDeleteConfigMap(t *testing.T) {
 		})
 	}
 }
+
+func TestGPUSharingPreBindComputeFraction(t *testing.T) {
+	tests := []struct {
+		name                     string
+		computeFraction          string
+		nodeLabels               map[string]string
+		expectedThreadPercentage string
+	}{
+		{
+			name:       "no compute fraction",
+			nodeLabels: map[string]string{constants.MpsCapableLabel: "true"},
+		},
+		{
+			name:                     "compute fraction on an MPS node",
+			computeFraction:          "0.3",
+			nodeLabels:               map[string]string{constants.MpsCapableLabel: "true"},
+			expectedThreadPercentage: "30",
+		},
+		{
+			name:                     "tiny compute fraction gets at least one percent",
+			computeFraction:          "0.001",
+			nodeLabels:               map[string]string{constants.MpsCapableLabel: "true"},
+			expectedThreadPercentage: "1",
+		},
+		{
+			name:            "compute fraction on a node without MPS",
+			computeFraction: "0.3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-pod",
+					Namespace: "test-ns",
+					UID:       "test-pod-uid",
+					Annotations: map[string]string{
+						constants.GpuFraction:                   "0.5",
+						constants.GpuSharingConfigMapAnnotation: "test-pod-abc1234-shared-gpu",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{Name: "container-0"}},
+				},
+			}
+			if tt.computeFraction != "" {
+				pod.Annotations[constants.GpuComputeFraction] = tt.computeFraction
+			}
+			node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-0", Labels: tt.nodeLabels}}
+			bindRequest := &v1alpha2.BindRequest{
+				Spec: v1alpha2.BindRequestSpec{
+					ReceivedResourceType: common.ReceivedTypeFraction,
+					ReceivedGPU:          &v1alpha2.ReceivedGPU{Count: 1, Portion: "0.5"},
+				},
+			}
+
+			scheme := runtime.NewScheme()
+			_ = v1.AddToScheme(scheme)
+			kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pod).Build()
+
+			plugin := New(kubeClient, false)
+			err := plugin.PreBind(context.Background(), pod, node, bindRequest,
+				&state.BindingState{ReservedGPUIds: []string{"0"}})
+			assert.NoError(t, err)
+
+			containerRef, err := common.GetFractionContainerRef(pod)
+			assert.NoError(t, err)
+			directEnvVarsMapName, err := gpusharingconfigmap.ExtractDirectEnvVarsConfigMapName(pod, containerRef)
+			assert.NoError(t, err)
+			directEnvVars := &v1.ConfigMap{}
+			err = kubeClient.Get(context.Background(),
+				types.NamespacedName{Namespace: pod.Namespace, Name: directEnvVarsMapName}, directEnvVars)
+			assert.NoError(t, err)
+			threadPercentage, found := directEnvVars.Data[common.MpsThreadPercentage]
+			assert.Equal(t, tt.expectedThreadPercentage != "", found)
+			assert.Equal(t, tt.expectedThreadPercentage, threadPercentage)
+		})
+	}
+}
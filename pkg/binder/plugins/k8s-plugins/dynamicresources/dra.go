@@ -20,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	bindercommon "github.com/NVIDIA/KAI-scheduler/pkg/binder/common"
 	plugins "github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/k8s-plugins/common"
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 )
@@ -99,8 +100,9 @@ func (drp *dynamicResourcesPlugin) bindResourceClaim(ctx context.Context, desire
 
 	claimName, err := getClaimName(pod, desiredStatus.Name)
 	if err != nil {
-		return status.Error(2, fmt.Sprintf("failed to get claim %s name for pod %s/%s: %v",
-			desiredStatus.Name, pod.Namespace, pod.Name, err))
+		return bindercommon.NewBindError(v1alpha2.BindFailureReasonDRAClaimPending,
+			status.Error(2, fmt.Sprintf("failed to get claim %s name for pod %s/%s: %v",
+				desiredStatus.Name, pod.Namespace, pod.Name, err)))
 	}
 
 	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
@@ -121,8 +123,9 @@ func (drp *dynamicResourcesPlugin) bindResourceClaim(ctx context.Context, desire
 	})
 
 	if err != nil {
-		return status.Error(2, fmt.Sprintf("failed to update claim %s for pod %s/%s: %v",
-			claimName, pod.Namespace, pod.Name, err))
+		return bindercommon.NewBindError(v1alpha2.BindFailureReasonDRAClaimPending,
+			status.Error(2, fmt.Sprintf("failed to update claim %s for pod %s/%s: %v",
+				claimName, pod.Namespace, pod.Name, err)))
 	}
 	return nil
 }
@@ -189,7 +189,7 @@ func (p *K8sPlugins) bindPluginWrapper(
 	err = plugin.Bind(ctx, pod, request, state)
 	if err != nil {
 		plugin.UnAllocate(ctx, pod, node.Name, state)
-		return fmt.Errorf("K8sPlugin %s failed Bind for pod: %s/%s and node %s. error: %s",
+		return fmt.Errorf("K8sPlugin %s failed Bind for pod: %s/%s and node %s. error: %w",
 			plugin.Name(), pod.Namespace, pod.Name, node.Name, err), state
 	}
 	return nil, state
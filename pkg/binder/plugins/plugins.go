@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -16,30 +17,49 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/state"
 )
 
+type registeredPlugin struct {
+	Plugin
+	preBindTimeout time.Duration
+}
+
 type BinderPlugins struct {
-	plugins []Plugin
+	plugins []registeredPlugin
 }
 
 func New() *BinderPlugins {
 	return &BinderPlugins{
-		plugins: []Plugin{},
+		plugins: []registeredPlugin{},
 	}
 }
 
 func (bp *BinderPlugins) RegisterPlugin(plugin Plugin) {
-	bp.plugins = append(bp.plugins, plugin)
+	bp.RegisterPluginWithTimeout(plugin, 0)
 }
 
+// RegisterPluginWithTimeout registers a plugin whose PreBind fails if it doesn't complete within preBindTimeout.
+// A timeout of 0 doesn't limit the PreBind of the plugin.
+func (bp *BinderPlugins) RegisterPluginWithTimeout(plugin Plugin, preBindTimeout time.Duration) {
+	bp.plugins = append(bp.plugins, registeredPlugin{Plugin: plugin, preBindTimeout: preBindTimeout})
+}
+
+// PreBind runs the PreBind of the plugins in their registration order. If a plugin fails, the plugins that already
+// ran, including the failed one, are rolled back in reverse order before the error is returned.
 func (bp *BinderPlugins) PreBind(ctx context.Context, pod *v1.Pod, host *v1.Node, bindRequest *v1alpha2.BindRequest,
 	state *state.BindingState) error {
-	for _, p := range bp.plugins {
-		err := p.PreBind(ctx, pod, host, bindRequest, state)
-		if err != nil {
-			logger := log.FromContext(context.Background())
-			logger.Error(err, "PreBind plugin failed for pod",
-				"plugin", p.Name(), "namespace", pod.Namespace, "name", pod.Name)
-			return fmt.Errorf("plugin %s failed in PreBind: %v", p.Name(), err)
+	logger := log.FromContext(ctx)
+	for index, p := range bp.plugins {
+		err := p.preBind(ctx, pod, host, bindRequest, state)
+		if err == nil {
+			continue
+		}
+		logger.Error(err, "PreBind plugin failed for pod",
+			"plugin", p.Name(), "namespace", pod.Namespace, "name", pod.Name)
+		err = fmt.Errorf("plugin %s failed in PreBind: %w", p.Name(), err)
+
+		if rollbackErr := rollback(ctx, bp.plugins[:index+1], pod, host, bindRequest, state); rollbackErr != nil {
+			return errors.Join(err, rollbackErr)
 		}
+		return err
 	}
 	return nil
 }
@@ -51,11 +71,19 @@ func (bp *BinderPlugins) PostBind(ctx context.Context, pod *v1.Pod, host *v1.Nod
 	}
 }
 
+// Rollback rolls back all the plugins in reverse registration order. Plugin rollbacks must be idempotent, as a plugin
+// may be rolled back again after its PreBind failure was already rolled back, and must handle a nil state.
 func (bp *BinderPlugins) Rollback(ctx context.Context, pod *v1.Pod, host *v1.Node, bindRequest *v1alpha2.BindRequest,
 	state *state.BindingState) error {
+	return rollback(ctx, bp.plugins, pod, host, bindRequest, state)
+}
+
+func rollback(ctx context.Context, plugins []registeredPlugin, pod *v1.Pod, host *v1.Node,
+	bindRequest *v1alpha2.BindRequest, state *state.BindingState) error {
 	logger := log.FromContext(ctx)
 	var rollbackErrs []error
-	for _, p := range bp.plugins {
+	for index := len(plugins) - 1; index >= 0; index-- {
+		p := plugins[index]
 		if err := p.Rollback(ctx, pod, host, bindRequest, state); err != nil {
 			logger.Error(err, "Rollback plugin failed for pod",
 				"plugin", p.Name(), "namespace", pod.Namespace, "name", pod.Name)
@@ -64,3 +92,22 @@ func (bp *BinderPlugins) Rollback(ctx context.Context, pod *v1.Pod, host *v1.Nod
 	}
 	return errors.Join(rollbackErrs...)
 }
+
+func (p *registeredPlugin) preBind(ctx context.Context, pod *v1.Pod, host *v1.Node,
+	bindRequest *v1alpha2.BindRequest, state *state.BindingState) error {
+	if p.preBindTimeout <= 0 {
+		return p.PreBind(ctx, pod, host, bindRequest, state)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, p.preBindTimeout)
+	defer cancel()
+	err := p.PreBind(timeoutCtx, pod, host, bindRequest, state)
+	if ctx.Err() == nil && errors.Is(timeoutCtx.Err(), context.DeadlineExceeded) {
+		// A plugin that returned after its deadline may have only partially prepared the pod
+		if err == nil {
+			err = timeoutCtx.Err()
+		}
+		return fmt.Errorf("timed out after %v: %w", p.preBindTimeout, err)
+	}
+	return err
+}
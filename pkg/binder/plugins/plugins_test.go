@@ -0,0 +1,89 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package plugins_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins"
+	mock_plugins "github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/mock"
+	"github.com/NVIDIA/KAI-scheduler/pkg/binder/plugins/state"
+)
+
+func newMockPlugin(controller *gomock.Controller, name string) *mock_plugins.MockPlugin {
+	plugin := mock_plugins.NewMockPlugin(controller)
+	plugin.EXPECT().Name().Return(name).AnyTimes()
+	return plugin
+}
+
+func TestPreBindRollsBackPluginsThatRan(t *testing.T) {
+	controller := gomock.NewController(t)
+	first := newMockPlugin(controller, "first")
+	failing := newMockPlugin(controller, "failing")
+	notRun := newMockPlugin(controller, "not-run")
+
+	binderPlugins := plugins.New()
+	binderPlugins.RegisterPlugin(first)
+	binderPlugins.RegisterPlugin(failing)
+	binderPlugins.RegisterPlugin(notRun)
+
+	bindingState := &state.BindingState{}
+	gomock.InOrder(
+		first.EXPECT().PreBind(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), bindingState).Return(nil),
+		failing.EXPECT().PreBind(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), bindingState).
+			Return(errors.New("device not ready")),
+		failing.EXPECT().Rollback(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), bindingState).Return(nil),
+		first.EXPECT().Rollback(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), bindingState).Return(nil),
+	)
+
+	err := binderPlugins.PreBind(context.Background(), &v1.Pod{}, &v1.Node{}, &v1alpha2.BindRequest{},
+		bindingState)
+	assert.ErrorContains(t, err, "plugin failing failed in PreBind: device not ready")
+}
+
+func TestPreBindTimeout(t *testing.T) {
+	controller := gomock.NewController(t)
+	slow := newMockPlugin(controller, "slow")
+
+	binderPlugins := plugins.New()
+	binderPlugins.RegisterPluginWithTimeout(slow, 10*time.Millisecond)
+
+	slow.EXPECT().PreBind(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ *v1.Pod, _ *v1.Node, _ *v1alpha2.BindRequest, _ *state.BindingState) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+	slow.EXPECT().Rollback(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	err := binderPlugins.PreBind(context.Background(), &v1.Pod{}, &v1.Node{}, &v1alpha2.BindRequest{}, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "timed out after 10ms")
+}
+
+func TestRollbackInReverseOrder(t *testing.T) {
+	controller := gomock.NewController(t)
+	first := newMockPlugin(controller, "first")
+	second := newMockPlugin(controller, "second")
+
+	binderPlugins := plugins.New()
+	binderPlugins.RegisterPlugin(first)
+	binderPlugins.RegisterPlugin(second)
+
+	gomock.InOrder(
+		second.EXPECT().Rollback(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(errors.New("rollback failed")),
+		first.EXPECT().Rollback(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
+	)
+
+	err := binderPlugins.Rollback(context.Background(), &v1.Pod{}, &v1.Node{}, &v1alpha2.BindRequest{}, nil)
+	assert.ErrorContains(t, err, "plugin second failed in Rollback: rollback failed")
+}
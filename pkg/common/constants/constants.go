@@ -6,10 +6,16 @@ package constants
 const (
 	AppLabelName              = "app"
 	GpuResource               = "nvidia.com/gpu"
+	AmdGpuResource            = "amd.com/gpu"
+	IntelGpuResource          = "intel.com/gpu"
+	HabanaGaudiResource       = "habana.ai/gaudi"
 	NvidiaGpuMemory           = "nvidia.com/gpu.memory"
+	AmdGpuMemoryLabelPrefix   = "beta.amd.com/gpu.vram"
+	IntelGpuMemory            = "gpu.intel.com/memory.max"
 	UnlimitedResourceQuantity = float64(-1)
 
 	DefaultQueuePriority                  = 100
+	DefaultLoanPaybackWeightMultiplier    = 2.0
 	DefaultPodGroupPriority               = 50 // Default when no global default priority exists
 	DefaultNodePoolName                   = "default"
 	DefaultMetricsNamespace               = "kai"
@@ -32,16 +38,48 @@ const (
 
 	// Annotations
 	PodGroupAnnotationForPod      = "pod-group-name"
+	MinMemberAnnotationForPod     = "min-member"
 	GpuFraction                   = "gpu-fraction"
 	GpuFractionContainerName      = "gpu-fraction-container-name"
 	GpuMemory                     = "gpu-memory"
 	ReceivedResourceType          = "received-resource-type"
 	GpuFractionsNumDevices        = "gpu-fraction-num-devices"
 	MpsAnnotation                 = "mps"
+	GpuComputeFraction            = "kai.scheduler/gpu-compute-fraction"
 	StalePodgroupTimeStamp        = "kai.scheduler/stale-podgroup-timestamp"
 	LastStartTimeStamp            = "kai.scheduler/last-start-timestamp"
+	LoanLenders                   = "kai.scheduler/loan-lenders"
+	EvictionMethod                = "kai.scheduler/eviction-method"
+	EvictionRequested             = "kai.scheduler/eviction-requested"
+	GPUDeviceSelection            = "kai.scheduler/gpu-device-selection"
 	GpuSharingConfigMapAnnotation = "runai/shared-gpu-configmap"
 	NvidiaVisibleDevices          = "NVIDIA_VISIBLE_DEVICES"
+	HabanaVisibleDevices          = "HABANA_VISIBLE_DEVICES"
+	ReservedResourceNameEnv       = "RESERVED_RESOURCE_NAME"
+	MinGpuMemory                  = "kai.scheduler/min-gpu-memory"
+	MinGpuComputeCapability       = "kai.scheduler/min-compute-capability"
+	GpuCountMin                   = "kai.scheduler/gpu-count-min"
+	GpuCountMax                   = "kai.scheduler/gpu-count-max"
+	GpuCountGranted               = "kai.scheduler/gpu-count-granted"
+	GpuRequestAnnotation          = "kai.scheduler/gpu-request"
+	DedicatedNodes                = "kai.scheduler/dedicated-nodes"
+	PlacementPreview              = "kai.scheduler/placement-preview"
+	RecurringJob                  = "kai.scheduler/recurring-job"
+	DoNotConsolidate              = "kai.scheduler/do-not-consolidate"
+	BindTimeSecret                = "kai.scheduler/bind-time-secret"
+	MaxGPUsPerPodOverride         = "kai.scheduler/max-gpus-per-pod-override"
+	Datasets                      = "kai.scheduler/datasets"
+	QuotaReservationTimeout       = "kai.scheduler/quota-reservation-timeout"
+	FallbackFromNodePool          = "kai.scheduler/fallback-from-node-pool"
+	CrossPoolReclaimCostPrefix    = "cross-pool-reclaim-cost.kai.scheduler/"
+
+	// Node Annotations
+	OtherSchedulersReservedPercentage = "kai.scheduler/other-schedulers-reserved-percentage"
+	DedicatedNodeLeaseExpiry          = "kai.scheduler/dedicated-lease-expiry"
+	NodePoolAutoLabeled               = "kai.scheduler/node-pool-auto-labeled"
+
+	// Node Taints
+	DedicatedNodeTaintKey = "kai.scheduler/dedicated"
 
 	// UsageDB Prometheus Selector
 	DefaultAccountingLabelKey   = "kai.scheduler/accounting"
@@ -52,7 +90,16 @@ const (
 	MultiGpuGroupLabelPrefix = GPUGroup + "/"
 	MigStrategyLabel         = "nvidia.com/mig.strategy"
 	GpuCountLabel            = "nvidia.com/gpu.count"
+	GpuComputeMajorLabel     = "nvidia.com/gpu.compute.major"
+	GpuComputeMinorLabel     = "nvidia.com/gpu.compute.minor"
+	GpuProductLabel          = "nvidia.com/gpu.product"
+	MpsCapableLabel          = "nvidia.com/mps.capable"
 	SubGroupLabelKey         = "kai.scheduler/subgroup-name"
+	ScavengerQueueLabelKey   = "kai.scheduler/scavenger-queue"
+	CrossPoolReclaimLabelKey = "kai.scheduler/cross-pool-reclaim"
+
+	// Pod Finalizers
+	GpuSharingReleaseFinalizer = "kai.scheduler/gpu-sharing-release"
 )
 
 // QueueValidatedVersions returns the list of queue versions that we validate with a webhook. This will be used by the
@@ -4,6 +4,7 @@
 package podgroup
 
 import (
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 )
 
@@ -24,3 +25,17 @@ func CalculatePreemptibility(preemptibility v2alpha2.Preemptibility, priority in
 	}
 	return v2alpha2.NonPreemptible
 }
+
+// ApplyQueuePreemptibility applies the preemptibility settings of a queue to the preemptibility set by a podgroup.
+// The queue's default replaces an unset preemptibility, or any preemptibility when the queue doesn't allow overriding.
+func ApplyQueuePreemptibility(
+	preemptibility v2alpha2.Preemptibility, queuePreemptibility *v2.QueuePreemptibility,
+) v2alpha2.Preemptibility {
+	if queuePreemptibility == nil || queuePreemptibility.Default == "" {
+		return preemptibility
+	}
+	if preemptibility == "" || !queuePreemptibility.IsOverrideAllowed() {
+		return queuePreemptibility.Default
+	}
+	return preemptibility
+}
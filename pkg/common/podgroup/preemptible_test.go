@@ -6,6 +6,9 @@ package podgroup_test
 import (
 	"testing"
 
+	"k8s.io/utils/ptr"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	pg "github.com/NVIDIA/KAI-scheduler/pkg/common/podgroup"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
@@ -97,3 +100,50 @@ func TestCalculatePreemptibility(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyQueuePreemptibility(t *testing.T) {
+	tests := []struct {
+		name                string
+		preemptibility      v2alpha2.Preemptibility
+		queuePreemptibility *v2.QueuePreemptibility
+		expectedResult      v2alpha2.Preemptibility
+	}{
+		{
+			name:           "queue without preemptibility settings",
+			preemptibility: v2alpha2.NonPreemptible,
+			expectedResult: v2alpha2.NonPreemptible,
+		},
+		{
+			name:                "queue without default",
+			preemptibility:      "",
+			queuePreemptibility: &v2.QueuePreemptibility{AllowOverride: ptr.To(false)},
+			expectedResult:      "",
+		},
+		{
+			name:                "unset preemptibility gets the queue default",
+			preemptibility:      "",
+			queuePreemptibility: &v2.QueuePreemptibility{Default: v2alpha2.Preemptible},
+			expectedResult:      v2alpha2.Preemptible,
+		},
+		{
+			name:                "override allowed by default",
+			preemptibility:      v2alpha2.NonPreemptible,
+			queuePreemptibility: &v2.QueuePreemptibility{Default: v2alpha2.Preemptible},
+			expectedResult:      v2alpha2.NonPreemptible,
+		},
+		{
+			name:           "override not allowed",
+			preemptibility: v2alpha2.NonPreemptible,
+			queuePreemptibility: &v2.QueuePreemptibility{
+				Default: v2alpha2.Preemptible, AllowOverride: ptr.To(false),
+			},
+			expectedResult: v2alpha2.Preemptible,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedResult, pg.ApplyQueuePreemptibility(tt.preemptibility, tt.queuePreemptibility))
+		})
+	}
+}
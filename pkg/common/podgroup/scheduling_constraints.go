@@ -0,0 +1,42 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package podgroup
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// ApplySchedulingConstraints adds the tolerations and node selector to the pod. Tolerations already set on the pod for
+// the same key and effect, and node selector keys already set on the pod, take precedence.
+// Returns whether the pod was changed.
+func ApplySchedulingConstraints(pod *v1.Pod, tolerations []v1.Toleration, nodeSelector map[string]string) bool {
+	changed := false
+	for _, toleration := range tolerations {
+		if !hasToleration(pod.Spec.Tolerations, toleration) {
+			pod.Spec.Tolerations = append(pod.Spec.Tolerations, toleration)
+			changed = true
+		}
+	}
+
+	for key, value := range nodeSelector {
+		if _, found := pod.Spec.NodeSelector[key]; found {
+			continue
+		}
+		if pod.Spec.NodeSelector == nil {
+			pod.Spec.NodeSelector = map[string]string{}
+		}
+		pod.Spec.NodeSelector[key] = value
+		changed = true
+	}
+	return changed
+}
+
+func hasToleration(podTolerations []v1.Toleration, toleration v1.Toleration) bool {
+	for _, podToleration := range podTolerations {
+		if podToleration.Key == toleration.Key && podToleration.Effect == toleration.Effect {
+			return true
+		}
+	}
+	return false
+}
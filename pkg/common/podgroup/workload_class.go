@@ -0,0 +1,23 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package podgroup
+
+import (
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+)
+
+// ApplyQueueWorkloadClass applies the workload class settings of a queue to the workload class set by a podgroup.
+// The queue's default replaces an unset workload class, or a workload class that the queue doesn't allow.
+func ApplyQueueWorkloadClass(
+	workloadClass v2alpha2.WorkloadClass, queueWorkloadClasses *v2.QueueWorkloadClasses,
+) v2alpha2.WorkloadClass {
+	if queueWorkloadClasses == nil {
+		return workloadClass
+	}
+	if workloadClass == "" || !queueWorkloadClasses.IsAllowed(workloadClass) {
+		return queueWorkloadClasses.Default
+	}
+	return workloadClass
+}
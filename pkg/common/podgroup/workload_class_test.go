@@ -0,0 +1,62 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package podgroup_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	pg "github.com/NVIDIA/KAI-scheduler/pkg/common/podgroup"
+)
+
+func TestApplyQueueWorkloadClass(t *testing.T) {
+	restricted := &v2.QueueWorkloadClasses{
+		Default: v2alpha2.BurstableGPU,
+		Allowed: []v2alpha2.WorkloadClass{v2alpha2.BestEffortGPU},
+	}
+	tests := []struct {
+		name                 string
+		workloadClass        v2alpha2.WorkloadClass
+		queueWorkloadClasses *v2.QueueWorkloadClasses
+		expectedResult       v2alpha2.WorkloadClass
+	}{
+		{
+			name:           "queue without settings",
+			workloadClass:  v2alpha2.Guaranteed,
+			expectedResult: v2alpha2.Guaranteed,
+		},
+		{
+			name:                 "unset workload class uses the queue default",
+			queueWorkloadClasses: restricted,
+			expectedResult:       v2alpha2.BurstableGPU,
+		},
+		{
+			name:                 "allowed workload class",
+			workloadClass:        v2alpha2.BestEffortGPU,
+			queueWorkloadClasses: restricted,
+			expectedResult:       v2alpha2.BestEffortGPU,
+		},
+		{
+			name:                 "workload class not allowed uses the queue default",
+			workloadClass:        v2alpha2.Guaranteed,
+			queueWorkloadClasses: restricted,
+			expectedResult:       v2alpha2.BurstableGPU,
+		},
+		{
+			name:                 "queue allowing all workload classes",
+			workloadClass:        v2alpha2.Guaranteed,
+			queueWorkloadClasses: &v2.QueueWorkloadClasses{Default: v2alpha2.BestEffortGPU},
+			expectedResult:       v2alpha2.Guaranteed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedResult, pg.ApplyQueueWorkloadClass(tt.workloadClass, tt.queueWorkloadClasses))
+		})
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+const (
+	amdGpuMemoryLabelValuePattern = "(\\d+.*)"
+	amdGpuMemoryValueFactor       = 1000000
+	bytesInMib                    = 1024 * 1024
+)
+
+var (
+	ErrDeviceInfoNotFound = errors.New("device info labels not found")
+
+	amdGpuMemoryLabelValueRegex = regexp.MustCompile(amdGpuMemoryLabelValuePattern)
+)
+
+// DeviceMemoryFn returns the memory of a single accelerator device of the node in MiB, as published in the node
+// labels by the vendor's device discovery. It returns ErrDeviceInfoNotFound if the node doesn't have the labels.
+type DeviceMemoryFn func(node *v1.Node) (float64, error)
+
+// AcceleratorVendor describes an accelerator resource that is accounted, shared and reserved like GPUs
+type AcceleratorVendor struct {
+	ResourceName v1.ResourceName
+	// DeviceMemory discovers the memory of the vendor's devices. Nil when the vendor doesn't publish it.
+	DeviceMemory DeviceMemoryFn
+}
+
+type acceleratorRegistry struct {
+	vendors map[v1.ResourceName]AcceleratorVendor
+	// resourceNames are the accelerator resources that are accounted as GPUs, in order of precedence
+	resourceNames []v1.ResourceName
+}
+
+// accelerators is replaced as a whole on every change, since it is read in the hot paths of the scheduler
+var accelerators atomic.Pointer[acceleratorRegistry]
+
+func init() {
+	accelerators.Store(&acceleratorRegistry{
+		vendors: map[v1.ResourceName]AcceleratorVendor{
+			constants.GpuResource:         {ResourceName: constants.GpuResource, DeviceMemory: nvidiaDeviceMemory},
+			constants.AmdGpuResource:      {ResourceName: constants.AmdGpuResource, DeviceMemory: amdDeviceMemory},
+			constants.IntelGpuResource:    {ResourceName: constants.IntelGpuResource, DeviceMemory: intelDeviceMemory},
+			constants.HabanaGaudiResource: {ResourceName: constants.HabanaGaudiResource},
+		},
+	})
+	SetAcceleratorResourceNames(DefaultAcceleratorResourceNames())
+}
+
+// DefaultAcceleratorResourceNames returns the accelerator resources that are accounted as GPUs by default
+func DefaultAcceleratorResourceNames() []string {
+	return []string{
+		constants.GpuResource, constants.AmdGpuResource, constants.IntelGpuResource, constants.HabanaGaudiResource,
+	}
+}
+
+// SetAcceleratorResourceNames sets the accelerator resources that are accounted as GPUs. Resources without a
+// registered vendor are accounted as GPUs, without device discovery.
+func SetAcceleratorResourceNames(resourceNames []string) {
+	current := accelerators.Load()
+	updated := &acceleratorRegistry{vendors: current.vendors}
+	for _, resourceName := range resourceNames {
+		updated.resourceNames = append(updated.resourceNames, v1.ResourceName(resourceName))
+	}
+	accelerators.Store(updated)
+}
+
+// RegisterAcceleratorVendor adds or replaces the device discovery hooks of an accelerator resource. The resource
+// is accounted as a GPU only if it is one of the accelerator resource names.
+func RegisterAcceleratorVendor(vendor AcceleratorVendor) {
+	current := accelerators.Load()
+	updated := &acceleratorRegistry{
+		vendors:       make(map[v1.ResourceName]AcceleratorVendor, len(current.vendors)+1),
+		resourceNames: current.resourceNames,
+	}
+	for resourceName, registered := range current.vendors {
+		updated.vendors[resourceName] = registered
+	}
+	updated.vendors[vendor.ResourceName] = vendor
+	accelerators.Store(updated)
+}
+
+// AcceleratorResourceNames returns the accelerator resources that are accounted as GPUs
+func AcceleratorResourceNames() []v1.ResourceName {
+	return accelerators.Load().resourceNames
+}
+
+// IsAcceleratorResource returns true if the resource is accounted as GPUs
+func IsAcceleratorResource(resourceName v1.ResourceName) bool {
+	for _, acceleratorResource := range accelerators.Load().resourceNames {
+		if resourceName == acceleratorResource {
+			return true
+		}
+	}
+	return false
+}
+
+// AcceleratorQuantity returns the sum of the accelerator resources in the resource list
+func AcceleratorQuantity(resourceList v1.ResourceList) resource.Quantity {
+	var total resource.Quantity
+	for resourceName, quantity := range resourceList {
+		if IsAcceleratorResource(resourceName) {
+			total.Add(quantity)
+		}
+	}
+	return total
+}
+
+// NodeAcceleratorResourceName returns the first accelerator resource that the node has allocatable, or the
+// NVIDIA GPU resource if the node has none.
+func NodeAcceleratorResourceName(node *v1.Node) v1.ResourceName {
+	if node == nil {
+		return constants.GpuResource
+	}
+	for _, resourceName := range accelerators.Load().resourceNames {
+		if quantity, found := node.Status.Allocatable[resourceName]; found && !quantity.IsZero() {
+			return resourceName
+		}
+	}
+	return constants.GpuResource
+}
+
+// NodeDeviceMemory returns the memory of a single accelerator device of the node in MiB, as discovered by the
+// vendors' hooks. It returns ErrDeviceInfoNotFound if no vendor discovered it, and an error if more than one did,
+// since nodes with devices of several vendors aren't supported for GPU memory requests.
+func NodeDeviceMemory(node *v1.Node) (float64, error) {
+	registry := accelerators.Load()
+	var memory float64
+	var discoveredBy v1.ResourceName
+	for _, resourceName := range registry.resourceNames {
+		vendor, found := registry.vendors[resourceName]
+		if !found || vendor.DeviceMemory == nil {
+			continue
+		}
+		vendorMemory, err := vendor.DeviceMemory(node)
+		if errors.Is(err, ErrDeviceInfoNotFound) {
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to discover the device memory of %s: %w", resourceName, err)
+		}
+		if discoveredBy != "" {
+			return 0, fmt.Errorf("the node %s has device memory labels of both %s and %s. "+
+				"Such nodes aren't supported for GPU memory requests", node.Name, discoveredBy, resourceName)
+		}
+		memory, discoveredBy = vendorMemory, resourceName
+	}
+	if discoveredBy == "" {
+		return 0, ErrDeviceInfoNotFound
+	}
+	return memory, nil
+}
+
+func nvidiaDeviceMemory(node *v1.Node) (float64, error) {
+	memoryStr, found := node.Labels[constants.NvidiaGpuMemory]
+	if !found {
+		return 0, ErrDeviceInfoNotFound
+	}
+	memory, err := strconv.Atoi(memoryStr)
+	if err != nil {
+		return 0, err
+	}
+	return float64(memory), nil
+}
+
+// amdDeviceMemory parses the memory from the key of the AMD node labeller label, e.g. beta.amd.com/gpu.vram.16G
+func amdDeviceMemory(node *v1.Node) (float64, error) {
+	for label := range node.Labels {
+		if !strings.HasPrefix(label, constants.AmdGpuMemoryLabelPrefix) {
+			continue
+		}
+		match := amdGpuMemoryLabelValueRegex.FindString(label)
+		if match == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(match)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse amd memory resource size. Label: %s, regex match: %s, error: %w",
+				label, match, err)
+		}
+		value, success := quantity.AsInt64()
+		if !success {
+			return 0, fmt.Errorf("could not extract memory amount from amd gpu memory label %s", label)
+		}
+		return float64(value) / amdGpuMemoryValueFactor, nil
+	}
+	return 0, ErrDeviceInfoNotFound
+}
+
+// intelDeviceMemory returns the memory published by the Intel GPU node feature rules, in bytes
+func intelDeviceMemory(node *v1.Node) (float64, error) {
+	memoryStr, found := node.Labels[constants.IntelGpuMemory]
+	if !found {
+		return 0, ErrDeviceInfoNotFound
+	}
+	memory, err := strconv.ParseInt(memoryStr, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return float64(memory / bytesInMib), nil
+}
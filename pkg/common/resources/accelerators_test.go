@@ -0,0 +1,190 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"errors"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+func Test_amdDeviceMemory(t *testing.T) {
+	tests := []struct {
+		name    string
+		node    *v1.Node
+		want    float64
+		wantErr bool
+	}{
+		{
+			"Node with memory label",
+			&v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"beta.amd.com/gpu.vram.16G": "1"},
+				},
+			},
+			16000,
+			false,
+		},
+		{
+			"Node without memory label",
+			&v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"A": "5000"},
+				},
+			},
+			0,
+			true,
+		},
+		{
+			"Node with invalid memory label",
+			&v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"beta.amd.com/gpu.vram.16b8": "1"},
+				},
+			},
+			0,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := amdDeviceMemory(tt.node)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("amdDeviceMemory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("amdDeviceMemory() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_nvidiaDeviceMemory(t *testing.T) {
+	tests := []struct {
+		name    string
+		node    *v1.Node
+		want    float64
+		wantErr bool
+	}{
+		{
+			"Node with memory label",
+			&v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{constants.NvidiaGpuMemory: "5000"},
+				},
+			},
+			5000,
+			false,
+		},
+		{
+			"Node without memory label",
+			&v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"A": "5000"},
+				},
+			},
+			0,
+			true,
+		},
+		{
+			"Node with memory label - invalid value",
+			&v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{constants.NvidiaGpuMemory: "abd"},
+				},
+			},
+			0,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := nvidiaDeviceMemory(tt.node)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("nvidiaDeviceMemory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("nvidiaDeviceMemory() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNodeDeviceMemory(t *testing.T) {
+	tests := []struct {
+		name     string
+		labels   map[string]string
+		want     float64
+		notFound bool
+		wantErr  bool
+	}{
+		{name: "nvidia", labels: map[string]string{constants.NvidiaGpuMemory: "4000"}, want: 4000},
+		{name: "amd", labels: map[string]string{"beta.amd.com/gpu.vram.16G": "1"}, want: 16000},
+		{name: "intel", labels: map[string]string{constants.IntelGpuMemory: "17179869184"}, want: 16384},
+		{name: "no labels", labels: map[string]string{}, notFound: true, wantErr: true},
+		{
+			name:    "several vendors",
+			labels:  map[string]string{constants.NvidiaGpuMemory: "4000", "beta.amd.com/gpu.vram.32G": "1"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node", Labels: tt.labels}}
+			got, err := NodeDeviceMemory(node)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NodeDeviceMemory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if errors.Is(err, ErrDeviceInfoNotFound) != tt.notFound {
+				t.Errorf("NodeDeviceMemory() error = %v, notFound %v", err, tt.notFound)
+			}
+			if got != tt.want {
+				t.Errorf("NodeDeviceMemory() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAcceleratorResourceNames(t *testing.T) {
+	defer SetAcceleratorResourceNames(DefaultAcceleratorResourceNames())
+
+	for _, resourceName := range DefaultAcceleratorResourceNames() {
+		if !IsAcceleratorResource(v1.ResourceName(resourceName)) {
+			t.Errorf("IsAcceleratorResource(%s) = false, want true", resourceName)
+		}
+	}
+	if IsAcceleratorResource(v1.ResourceCPU) {
+		t.Errorf("IsAcceleratorResource(%s) = true, want false", v1.ResourceCPU)
+	}
+
+	SetAcceleratorResourceNames([]string{constants.GpuResource, "example.com/npu"})
+	if IsAcceleratorResource(constants.AmdGpuResource) || !IsAcceleratorResource("example.com/npu") {
+		t.Errorf("AcceleratorResourceNames() = %v, want the configured names", AcceleratorResourceNames())
+	}
+
+	total := AcceleratorQuantity(v1.ResourceList{
+		constants.GpuResource: resource.MustParse("2"),
+		"example.com/npu":     resource.MustParse("1"),
+		v1.ResourceCPU:        resource.MustParse("4"),
+	})
+	if total.Value() != 3 {
+		t.Errorf("AcceleratorQuantity() = %v, want 3", total.String())
+	}
+
+	node := &v1.Node{Status: v1.NodeStatus{Allocatable: v1.ResourceList{"example.com/npu": resource.MustParse("8")}}}
+	if got := NodeAcceleratorResourceName(node); got != "example.com/npu" {
+		t.Errorf("NodeAcceleratorResourceName() = %v, want example.com/npu", got)
+	}
+	if got := NodeAcceleratorResourceName(&v1.Node{}); got != constants.GpuResource {
+		t.Errorf("NodeAcceleratorResourceName() = %v, want %v", got, constants.GpuResource)
+	}
+}
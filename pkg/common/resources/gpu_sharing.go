@@ -24,20 +24,55 @@ func RequestsGPUFraction(pod *v1.Pod) bool {
 	return foundFraction || foundGPUMemory
 }
 
+// RequestsGPUCountRange checks if the pod requests a range of whole GPUs, of which the scheduler grants as many as
+// it can
+func RequestsGPUCountRange(pod *v1.Pod) bool {
+	_, foundMin := pod.Annotations[constants.GpuCountMin]
+	_, foundMax := pod.Annotations[constants.GpuCountMax]
+	return foundMin || foundMax
+}
+
+// GetGPUCountRange returns the minimal and the preferred number of GPUs of a pod requesting a range of GPUs. The
+// preferred number defaults to the minimal one.
+func GetGPUCountRange(pod *v1.Pod) (int64, int64, error) {
+	minStr, found := pod.Annotations[constants.GpuCountMin]
+	if !found {
+		return 0, 0, fmt.Errorf("%s annotation not found", constants.GpuCountMin)
+	}
+	minCount, err := strconv.ParseInt(minStr, 10, 64)
+	if err != nil || minCount <= 0 {
+		return 0, 0, fmt.Errorf("%s annotation value must be a positive integer", constants.GpuCountMin)
+	}
+
+	maxStr, found := pod.Annotations[constants.GpuCountMax]
+	if !found {
+		return minCount, minCount, nil
+	}
+	maxCount, err := strconv.ParseInt(maxStr, 10, 64)
+	if err != nil || maxCount < minCount {
+		return 0, 0, fmt.Errorf("%s annotation value must be an integer not smaller than %s",
+			constants.GpuCountMax, constants.GpuCountMin)
+	}
+	return minCount, maxCount, nil
+}
+
+// RequestsWholeGPU checks if the pod requests any of the accelerator resources that are accounted as GPUs
 func RequestsWholeGPU(pod *v1.Pod) bool {
 	for _, container := range pod.Spec.Containers {
-		if _, ok := container.Resources.Requests[constants.GpuResource]; ok {
-			return true
-		}
-		if _, ok := container.Resources.Limits[constants.GpuResource]; ok {
-			return true
+		for _, resourceName := range AcceleratorResourceNames() {
+			if _, ok := container.Resources.Requests[resourceName]; ok {
+				return true
+			}
+			if _, ok := container.Resources.Limits[resourceName]; ok {
+				return true
+			}
 		}
 	}
 	return false
 }
 
 func RequestsGPU(pod *v1.Pod) bool {
-	return RequestsGPUFraction(pod) || RequestsWholeGPU(pod)
+	return RequestsGPUFraction(pod) || RequestsWholeGPU(pod) || RequestsGPUCountRange(pod)
 }
 
 func GetGPUFraction(pod *v1.Pod) (float64, error) {
@@ -104,6 +139,9 @@ func GetMultiFractionGpuGroupLabel(gpuGroup string) (string, string) {
 }
 
 func IsMultiFraction(pod *v1.Pod) (bool, error) {
+	if RequestsGPUCountRange(pod) {
+		return true, nil
+	}
 	numDevices, err := GetNumGPUFractionDevices(pod)
 	if err != nil {
 		if errors.Is(err, fractionDevicesAnnotationNotFound) {
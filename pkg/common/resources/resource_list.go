@@ -10,6 +10,7 @@ import (
 
 	"golang.org/x/exp/maps"
 	v1 "k8s.io/api/core/v1"
+	resourcehelper "k8s.io/component-helpers/resource"
 )
 
 func SumResources(left, right v1.ResourceList) v1.ResourceList {
@@ -30,6 +31,15 @@ func SumResources(left, right v1.ResourceList) v1.ResourceList {
 	return total
 }
 
+// PodRequests returns the effective resource request of the pod, the same way the kubelet and kube-scheduler compute
+// it. Restartable init containers (sidecars) keep running with the regular containers, so their requests are added to
+// the sum of the regular containers. Other init containers run one at a time, each next to the sidecars started
+// before it, so they only count when one of them with these sidecars requests more than the running pod. The pod
+// overhead is added on top, and pod level requests replace the requests of the containers.
+func PodRequests(pod *v1.Pod) v1.ResourceList {
+	return resourcehelper.PodRequests(pod, resourcehelper.PodResourcesOptions{})
+}
+
 func EqualResourceLists(got, want v1.ResourceList) error {
 	gotKeys := maps.Keys(got)
 	sortResourceNames(gotKeys)
@@ -0,0 +1,94 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName = "github.com/NVIDIA/KAI-scheduler"
+
+	// AnnotationPrefix is prepended to W3C trace context keys when propagating spans through object annotations
+	AnnotationPrefix = "kai.scheduler/trace-"
+)
+
+var propagator = propagation.TraceContext{}
+
+// Setup configures a global OTLP/gRPC trace exporter for the component. An empty endpoint keeps tracing disabled.
+// The returned function flushes and stops the exporter.
+func Setup(ctx context.Context, serviceName, otlpEndpoint string) (func(context.Context) error, error) {
+	if otlpEndpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx,
+		otlptracegrpc.WithEndpoint(otlpEndpoint),
+		otlptracegrpc.WithInsecure(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagator)
+
+	return tracerProvider.Shutdown, nil
+}
+
+// Tracer returns the tracer used by all KAI components
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// InjectToAnnotations stores the span context of ctx in the annotations, allocating the map if needed.
+// Nothing is stored when ctx does not hold a recording span.
+func InjectToAnnotations(ctx context.Context, annotations map[string]string) map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return annotations
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	propagator.Inject(ctx, annotationsCarrier(annotations))
+	return annotations
+}
+
+// ExtractFromAnnotations returns a context holding the remote span context stored in the annotations, if any
+func ExtractFromAnnotations(ctx context.Context, annotations map[string]string) context.Context {
+	return propagator.Extract(ctx, annotationsCarrier(annotations))
+}
+
+type annotationsCarrier map[string]string
+
+func (c annotationsCarrier) Get(key string) string {
+	return c[AnnotationPrefix+key]
+}
+
+func (c annotationsCarrier) Set(key, value string) {
+	c[AnnotationPrefix+key] = value
+}
+
+func (c annotationsCarrier) Keys() []string {
+	var keys []string
+	for key := range c {
+		if strings.HasPrefix(key, AnnotationPrefix) {
+			keys = append(keys, strings.TrimPrefix(key, AnnotationPrefix))
+		}
+	}
+	return keys
+}
@@ -0,0 +1,41 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestAnnotationsRoundTrip(t *testing.T) {
+	tracerProvider := sdktrace.NewTracerProvider()
+	ctx, span := tracerProvider.Tracer("test").Start(context.Background(), "group-pods")
+	defer span.End()
+
+	annotations := InjectToAnnotations(ctx, nil)
+	assert.Contains(t, annotations, AnnotationPrefix+"traceparent")
+
+	extracted := trace.SpanContextFromContext(ExtractFromAnnotations(context.Background(), annotations))
+	assert.True(t, extracted.IsRemote())
+	assert.Equal(t, span.SpanContext().TraceID(), extracted.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), extracted.SpanID())
+}
+
+func TestInjectWithoutSpan(t *testing.T) {
+	annotations := InjectToAnnotations(context.Background(), map[string]string{"a": "b"})
+	assert.Equal(t, map[string]string{"a": "b"}, annotations)
+
+	extracted := trace.SpanContextFromContext(ExtractFromAnnotations(context.Background(), annotations))
+	assert.False(t, extracted.IsValid())
+}
+
+func TestSetupDisabled(t *testing.T) {
+	shutdown, err := Setup(context.Background(), "test", "")
+	assert.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+}
@@ -0,0 +1,152 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package eventaggregator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	bearerPrefix   = "Bearer "
+	eventsResource = "events"
+	watchVerb      = "watch"
+
+	decisionCacheSize = 10000
+	decisionCacheTTL  = 10 * time.Second
+)
+
+// Authorizer authenticates the clients of the event stream with their Kubernetes bearer token, and allows them to
+// stream the events of the namespaces whose events they may watch. The decisions are cached for a short time by the
+// hash of the token and the namespace, so that clients that reconnect don't review their token again.
+type Authorizer struct {
+	kubeClient client.Client
+	decisions  *cache.LRUExpireCache
+}
+
+// decision is the cached answer to a token that asked to stream the events of a namespace
+type decision struct {
+	status int
+	err    error
+}
+
+func NewAuthorizer(kubeClient client.Client) *Authorizer {
+	return &Authorizer{
+		kubeClient: kubeClient,
+		decisions:  cache.NewLRUExpireCache(decisionCacheSize),
+	}
+}
+
+// +kubebuilder:rbac:groups="authentication.k8s.io",resources=tokenreviews,verbs=create
+// +kubebuilder:rbac:groups="authorization.k8s.io",resources=subjectaccessreviews,verbs=create
+
+// Authorize returns the HTTP status to answer a request that isn't allowed to stream the events of the namespaces, or
+// http.StatusOK. Without namespaces, the user must be allowed to watch the events of all namespaces.
+func (a *Authorizer) Authorize(ctx context.Context, r *http.Request, namespaces []string) (int, error) {
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), bearerPrefix)
+	if !found || token == "" {
+		return http.StatusUnauthorized, fmt.Errorf("missing bearer token")
+	}
+
+	tokenHash := sha256.Sum256([]byte(token))
+	tokenKey := hex.EncodeToString(tokenHash[:])
+
+	if len(namespaces) == 0 {
+		namespaces = []string{""}
+	}
+	var user *authenticationv1.UserInfo
+	for _, namespace := range namespaces {
+		key := tokenKey + "/" + namespace
+		cached, found := a.decisions.Get(key)
+		if !found {
+			var err error
+			if user == nil {
+				if user, err = a.authenticate(ctx, token); err != nil {
+					return http.StatusInternalServerError, err
+				}
+			}
+			d := a.decide(ctx, user, namespace)
+			if d.status != http.StatusInternalServerError {
+				a.decisions.Add(key, d, decisionCacheTTL)
+			}
+			cached = d
+		}
+		if d := cached.(decision); d.status != http.StatusOK {
+			return d.status, d.err
+		}
+	}
+	return http.StatusOK, nil
+}
+
+// decide returns the decision for the user of a token to stream the events of the namespace. A nil user is the user of
+// a token that isn't authenticated. Errors of the access review aren't cached.
+func (a *Authorizer) decide(ctx context.Context, user *authenticationv1.UserInfo, namespace string) decision {
+	if user == nil {
+		return decision{status: http.StatusUnauthorized, err: fmt.Errorf("invalid bearer token")}
+	}
+	allowed, err := a.canWatchEvents(ctx, user, namespace)
+	if err != nil {
+		return decision{status: http.StatusInternalServerError, err: err}
+	}
+	if allowed {
+		return decision{status: http.StatusOK}
+	}
+	if namespace == "" {
+		return decision{status: http.StatusForbidden,
+			err: fmt.Errorf("user %s may not watch the events of all namespaces", user.Username)}
+	}
+	return decision{status: http.StatusForbidden,
+		err: fmt.Errorf("user %s may not watch the events of namespace %s", user.Username, namespace)}
+}
+
+// authenticate returns the user of the token, or nil if the token isn't authenticated
+func (a *Authorizer) authenticate(ctx context.Context, token string) (*authenticationv1.UserInfo, error) {
+	review := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}
+	if err := a.kubeClient.Create(ctx, review); err != nil {
+		return nil, fmt.Errorf("failed to review token: %w", err)
+	}
+	if !review.Status.Authenticated {
+		return nil, nil
+	}
+	return &review.Status.User, nil
+}
+
+// canWatchEvents checks with a SubjectAccessReview whether the user may watch the events of the namespace
+func (a *Authorizer) canWatchEvents(ctx context.Context, user *authenticationv1.UserInfo, namespace string) (
+	bool, error) {
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for key, value := range user.Extra {
+		extra[key] = authorizationv1.ExtraValue(value)
+	}
+	review := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			Groups: user.Groups,
+			UID:    user.UID,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Resource:  eventsResource,
+				Verb:      watchVerb,
+			},
+		},
+	}
+	if err := a.kubeClient.Create(ctx, review); err != nil {
+		return false, fmt.Errorf("failed to review access of user %s to the events of namespace %q: %w",
+			user.Username, namespace, err)
+	}
+	return review.Status.Allowed, nil
+}
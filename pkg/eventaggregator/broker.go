@@ -0,0 +1,78 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package eventaggregator
+
+import (
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Broker fans out scheduling events to the subscribers whose filter matches them. A subscriber that doesn't keep up
+// with the events misses the events that don't fit in its buffer, so that it never blocks the other subscribers.
+type Broker struct {
+	mutex       sync.RWMutex
+	bufferSize  int
+	nextID      int
+	subscribers map[int]*subscriber
+}
+
+type subscriber struct {
+	filter  Filter
+	events  chan *SchedulingEvent
+	dropped int
+}
+
+func NewBroker(bufferSize int) *Broker {
+	return &Broker{
+		bufferSize:  bufferSize,
+		subscribers: map[int]*subscriber{},
+	}
+}
+
+// Subscribe returns the channel of the events matching the filter, and a function that ends the subscription and
+// closes the channel
+func (b *Broker) Subscribe(filter Filter) (<-chan *SchedulingEvent, func()) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	id := b.nextID
+	b.nextID++
+	sub := &subscriber{filter: filter, events: make(chan *SchedulingEvent, b.bufferSize)}
+	b.subscribers[id] = sub
+
+	return sub.events, func() {
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+		if _, found := b.subscribers[id]; !found {
+			return
+		}
+		delete(b.subscribers, id)
+		close(sub.events)
+	}
+}
+
+func (b *Broker) Publish(event *SchedulingEvent) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for _, sub := range b.subscribers {
+		if !sub.filter.matches(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			sub.dropped++
+			log.Log.V(1).Info("Subscriber is too slow, dropping event",
+				"reason", event.Reason, "namespace", event.Namespace, "name", event.Name, "dropped", sub.dropped)
+		}
+	}
+}
+
+func (b *Broker) subscriberCount() int {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return len(b.subscribers)
+}
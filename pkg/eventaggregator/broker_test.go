@@ -0,0 +1,67 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package eventaggregator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterMatches(t *testing.T) {
+	event := &SchedulingEvent{Namespace: "team-a", Queue: "queue-a"}
+
+	tests := []struct {
+		name     string
+		filter   Filter
+		expected bool
+	}{
+		{name: "empty filter", filter: Filter{}, expected: true},
+		{name: "matching namespace", filter: Filter{Namespaces: []string{"team-b", "team-a"}}, expected: true},
+		{name: "other namespace", filter: Filter{Namespaces: []string{"team-b"}}, expected: false},
+		{name: "matching queue", filter: Filter{Queues: []string{"queue-a"}}, expected: true},
+		{name: "other queue", filter: Filter{Queues: []string{"queue-b"}}, expected: false},
+		{name: "matching namespace and other queue",
+			filter: Filter{Namespaces: []string{"team-a"}, Queues: []string{"queue-b"}}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.filter.matches(event))
+		})
+	}
+}
+
+func TestBrokerPublish(t *testing.T) {
+	broker := NewBroker(1)
+	all, cancelAll := broker.Subscribe(Filter{})
+	defer cancelAll()
+	queueB, cancelQueueB := broker.Subscribe(Filter{Queues: []string{"queue-b"}})
+	defer cancelQueueB()
+
+	first := &SchedulingEvent{Name: "first", Queue: "queue-a"}
+	second := &SchedulingEvent{Name: "second", Queue: "queue-b"}
+	broker.Publish(first)
+	broker.Publish(second)
+
+	// The buffer of the first subscriber holds a single event, so the second event is dropped
+	assert.Equal(t, first, <-all)
+	assert.Empty(t, all)
+	assert.Equal(t, second, <-queueB)
+	assert.Empty(t, queueB)
+}
+
+func TestBrokerUnsubscribe(t *testing.T) {
+	broker := NewBroker(1)
+	events, cancel := broker.Subscribe(Filter{})
+	assert.Equal(t, 1, broker.subscriberCount())
+
+	cancel()
+	cancel()
+	assert.Equal(t, 0, broker.subscriberCount())
+	_, open := <-events
+	assert.False(t, open)
+
+	broker.Publish(&SchedulingEvent{Name: "after-cancel"})
+}
@@ -0,0 +1,46 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package eventaggregator
+
+import (
+	"time"
+)
+
+// SchedulingEvent is a Kubernetes event of a pod or a pod group scheduled by KAI, with the queue and the pod group
+// it belongs to, so that consumers can filter the events without looking up the objects.
+type SchedulingEvent struct {
+	Type      string    `json:"type"`
+	Reason    string    `json:"reason"`
+	Message   string    `json:"message"`
+	Kind      string    `json:"kind"`
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	PodGroup  string    `json:"podGroup,omitempty"`
+	Queue     string    `json:"queue,omitempty"`
+	Source    string    `json:"source,omitempty"`
+	Count     int32     `json:"count"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Filter selects the events a subscriber receives. An empty list matches all the events.
+type Filter struct {
+	Namespaces []string
+	Queues     []string
+}
+
+func (f *Filter) matches(event *SchedulingEvent) bool {
+	return matchesAny(f.Namespaces, event.Namespace) && matchesAny(f.Queues, event.Queue)
+}
+
+func matchesAny(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package eventaggregator
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	EventsPath        = "/events"
+	sseEventName      = "scheduling"
+	heartbeatInterval = 15 * time.Second
+	shutdownTimeout   = 5 * time.Second
+
+	certFileName = "tls.crt"
+	keyFileName  = "tls.key"
+)
+
+// Server streams the scheduling events to HTTP clients as server-sent events. Clients select the events with the
+// namespace and queue query parameters, which can be repeated. Clients may only stream the events of the namespaces
+// they may watch events in. The events are served over TLS with the certificate in the cert directory, which is
+// reloaded when it changes, since clients send their bearer tokens.
+type Server struct {
+	address           string
+	certDir           string
+	broker            *Broker
+	authorizer        *Authorizer
+	heartbeatInterval time.Duration
+}
+
+func NewServer(address, certDir string, broker *Broker, authorizer *Authorizer) *Server {
+	return &Server{
+		address:           address,
+		certDir:           certDir,
+		broker:            broker,
+		authorizer:        authorizer,
+		heartbeatInterval: heartbeatInterval,
+	}
+}
+
+func (s *Server) Start(ctx context.Context) error {
+	certWatcher, err := certwatcher.New(
+		filepath.Join(s.certDir, certFileName), filepath.Join(s.certDir, keyFileName))
+	if err != nil {
+		return fmt.Errorf("failed to load the serving certificate from %s: %w", s.certDir, err)
+	}
+	go func() {
+		if err := certWatcher.Start(ctx); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to watch the serving certificate")
+		}
+	}()
+
+	mux := http.NewServeMux()
+	mux.Handle(EventsPath, s)
+	httpServer := &http.Server{
+		Addr:              s.address,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		TLSConfig: &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: certWatcher.GetCertificate,
+		},
+		// Shutdown doesn't wait for the streams to end, so they are ended by the context of the server
+		BaseContext: func(net.Listener) context.Context { return ctx },
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to shut down the event stream server")
+		}
+	}()
+
+	log.FromContext(ctx).Info("Serving scheduling events", "address", s.address, "path", EventsPath)
+	if err := httpServer.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// NeedLeaderElection returns false, so that every replica serves the events
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	query := r.URL.Query()
+	if status, err := s.authorizer.Authorize(r.Context(), r, query["namespace"]); status != http.StatusOK {
+		log.FromContext(r.Context()).V(1).Info("Refused event stream client", "status", status, "error", err.Error())
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+
+	events, cancel := s.broker.Subscribe(Filter{
+		Namespaces: query["namespace"],
+		Queues:     query["queue"],
+	})
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(s.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if err := writeEvent(w, event); err != nil {
+				log.FromContext(r.Context()).V(1).Info("Failed to write event to client", "error", err.Error())
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+func writeEvent(w http.ResponseWriter, event *SchedulingEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", sseEventName, data)
+	return err
+}
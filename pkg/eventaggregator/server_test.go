@@ -0,0 +1,244 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package eventaggregator
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	generate "github.com/NVIDIA/KAI-scheduler/pkg/operator/cert-utils"
+)
+
+const (
+	validToken       = "valid-token"
+	allowedNamespace = "team-a"
+)
+
+func TestServeEvents(t *testing.T) {
+	broker := NewBroker(10)
+	server := NewServer(":0", "", broker, newTestAuthorizer())
+	httpServer := httptest.NewTLSServer(server)
+	defer httpServer.Close()
+
+	response, err := getEvents(httpServer.Client(), httpServer.URL+EventsPath+"?namespace=team-a&queue=queue-a", validToken)
+	require.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+
+	require.Eventually(t, func() bool { return broker.subscriberCount() == 1 }, time.Second, 10*time.Millisecond)
+	broker.Publish(&SchedulingEvent{Name: "other-queue", Namespace: "team-a", Queue: "queue-b"})
+	broker.Publish(&SchedulingEvent{Name: "pod-a", Namespace: "team-a", Queue: "queue-a", Reason: "Scheduled"})
+
+	reader := bufio.NewReader(response.Body)
+	eventLine, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "event: scheduling\n", eventLine)
+	dataLine, err := reader.ReadString('\n')
+	require.NoError(t, err)
+
+	event := &SchedulingEvent{}
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(dataLine, "data: ")), event))
+	assert.Equal(t, "pod-a", event.Name)
+	assert.Equal(t, "Scheduled", event.Reason)
+
+	response.Body.Close()
+	assert.Eventually(t, func() bool { return broker.subscriberCount() == 0 }, time.Second, 10*time.Millisecond)
+}
+
+func TestServeEventsMethodNotAllowed(t *testing.T) {
+	server := NewServer(":0", "", NewBroker(10), newTestAuthorizer())
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, EventsPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestServeEventsAuthorization(t *testing.T) {
+	tests := []struct {
+		name           string
+		token          string
+		query          string
+		expectedStatus int
+	}{
+		{
+			name:           "missing token",
+			query:          "?namespace=team-a",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid token",
+			token:          "invalid-token",
+			query:          "?namespace=team-a",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "namespace the user may not watch",
+			token:          validToken,
+			query:          "?namespace=team-a&namespace=team-b",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "all namespaces",
+			token:          validToken,
+			query:          "?queue=queue-a",
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broker := NewBroker(10)
+			server := NewServer(":0", "", broker, newTestAuthorizer())
+			request := httptest.NewRequest(http.MethodGet, EventsPath+tt.query, nil)
+			if tt.token != "" {
+				request.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, request)
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			assert.Equal(t, 0, broker.subscriberCount())
+		})
+	}
+}
+
+func TestStartEndsStreamsOnShutdown(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	broker := NewBroker(10)
+	certDir, httpClient := writeTestCert(t)
+	server := NewServer(address, certDir, broker, newTestAuthorizer())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stopped := make(chan error)
+	go func() { stopped <- server.Start(ctx) }()
+
+	var response *http.Response
+	require.Eventually(t, func() bool {
+		response, err = getEvents(httpClient, "https://"+address+EventsPath+"?namespace=team-a", validToken)
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+	defer response.Body.Close()
+	require.Eventually(t, func() bool { return broker.subscriberCount() == 1 }, time.Second, 10*time.Millisecond)
+
+	cancel()
+	select {
+	case err := <-stopped:
+		assert.NoError(t, err)
+	case <-time.After(shutdownTimeout / 2):
+		t.Fatal("server did not stop before the shutdown timeout")
+	}
+	streamEnded := make(chan error)
+	go func() {
+		_, err := io.ReadAll(response.Body)
+		streamEnded <- err
+	}()
+	select {
+	case err := <-streamEnded:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("stream did not end on shutdown")
+	}
+	assert.Eventually(t, func() bool { return broker.subscriberCount() == 0 }, time.Second, 10*time.Millisecond)
+}
+
+func TestAuthorizeCachesDecisions(t *testing.T) {
+	reviews := 0
+	authorizer := newTestAuthorizerWithReviewCount(&reviews)
+	request := httptest.NewRequest(http.MethodGet, EventsPath, nil)
+	request.Header.Set("Authorization", "Bearer "+validToken)
+
+	for i := 0; i < 3; i++ {
+		status, _ := authorizer.Authorize(context.Background(), request, []string{allowedNamespace})
+		assert.Equal(t, http.StatusOK, status)
+		status, _ = authorizer.Authorize(context.Background(), request, []string{"team-b"})
+		assert.Equal(t, http.StatusForbidden, status)
+	}
+	// A token review and an access review for each namespace
+	assert.Equal(t, 4, reviews)
+
+	status, _ := authorizer.Authorize(context.Background(), request, []string{allowedNamespace, "team-c"})
+	assert.Equal(t, http.StatusForbidden, status)
+	assert.Equal(t, 6, reviews)
+}
+
+func getEvents(httpClient *http.Client, url, token string) (*http.Response, error) {
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	return httpClient.Do(request)
+}
+
+// writeTestCert writes a self signed serving certificate for localhost to a temporary cert directory, and returns a
+// client that trusts it
+func writeTestCert(t *testing.T) (string, *http.Client) {
+	cert, key, err := generate.GenerateSelfSignedCert("localhost", []string{"localhost"})
+	require.NoError(t, err)
+	certDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(certDir, certFileName), cert, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(certDir, keyFileName), key, 0600))
+
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(cert))
+	return certDir, &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{RootCAs: roots, ServerName: "localhost"},
+	}}
+}
+
+// newTestAuthorizer returns an authorizer that authenticates only the valid token, and allows its user to watch the
+// events of the allowed namespace only
+func newTestAuthorizer() *Authorizer {
+	return newTestAuthorizerWithReviewCount(new(int))
+}
+
+func newTestAuthorizerWithReviewCount(reviews *int) *Authorizer {
+	testScheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(testScheme))
+	kubeClient := fake.NewClientBuilder().WithScheme(testScheme).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				switch review := obj.(type) {
+				case *authenticationv1.TokenReview:
+					*reviews++
+					review.Status.Authenticated = review.Spec.Token == validToken
+					review.Status.User = authenticationv1.UserInfo{Username: "user"}
+				case *authorizationv1.SubjectAccessReview:
+					*reviews++
+					attributes := review.Spec.ResourceAttributes
+					review.Status.Allowed = review.Spec.User == "user" && attributes.Verb == watchVerb &&
+						attributes.Resource == eventsResource && attributes.Namespace == allowedNamespace
+				default:
+					return c.Create(ctx, obj, opts...)
+				}
+				return nil
+			},
+		}).Build()
+	return NewAuthorizer(kubeClient)
+}
@@ -0,0 +1,177 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package eventaggregator
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	schedulingv2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+const (
+	podKind      = "Pod"
+	podGroupKind = "PodGroup"
+)
+
+// EventWatcher publishes the events of pods scheduled by KAI and of pod groups to the broker. Events that existed
+// before the watcher started are not published.
+type EventWatcher struct {
+	cache  cache.Cache
+	reader client.Reader
+	broker *Broker
+}
+
+func NewEventWatcher(cache cache.Cache, reader client.Reader, broker *Broker) *EventWatcher {
+	return &EventWatcher{
+		cache:  cache,
+		reader: reader,
+		broker: broker,
+	}
+}
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="scheduling.run.ai",resources=podgroups,verbs=get;list;watch
+
+func (w *EventWatcher) Start(ctx context.Context) error {
+	informer, err := w.cache.GetInformer(ctx, &v1.Event{})
+	if err != nil {
+		return err
+	}
+	_, err = informer.AddEventHandler(toolscache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			if isInInitialList {
+				return
+			}
+			if event, ok := obj.(*v1.Event); ok {
+				w.publish(ctx, event)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldEvent, oldOk := oldObj.(*v1.Event)
+			newEvent, newOk := newObj.(*v1.Event)
+			if !oldOk || !newOk || !isRecurrence(oldEvent, newEvent) {
+				return
+			}
+			w.publish(ctx, newEvent)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	<-ctx.Done()
+	return nil
+}
+
+// NeedLeaderElection returns false, so that every replica serves the events
+func (w *EventWatcher) NeedLeaderElection() bool {
+	return false
+}
+
+// isRecurrence returns whether an event was updated because it occurred again, rather than for housekeeping
+func isRecurrence(oldEvent, newEvent *v1.Event) bool {
+	if newEvent.Count != oldEvent.Count {
+		return true
+	}
+	return newEvent.Series != nil && (oldEvent.Series == nil || newEvent.Series.Count != oldEvent.Series.Count)
+}
+
+func (w *EventWatcher) publish(ctx context.Context, event *v1.Event) {
+	schedulingEvent, ok := w.toSchedulingEvent(ctx, event)
+	if !ok {
+		return
+	}
+	w.broker.Publish(schedulingEvent)
+}
+
+// toSchedulingEvent returns the scheduling event of a pod or pod group event, and false for events of other objects
+// and of pods that are not scheduled by KAI
+func (w *EventWatcher) toSchedulingEvent(ctx context.Context, event *v1.Event) (*SchedulingEvent, bool) {
+	involvedObject := event.InvolvedObject
+	schedulingEvent := &SchedulingEvent{
+		Type:      event.Type,
+		Reason:    event.Reason,
+		Message:   event.Message,
+		Kind:      involvedObject.Kind,
+		Namespace: involvedObject.Namespace,
+		Name:      involvedObject.Name,
+		Source:    eventSource(event),
+		Count:     eventCount(event),
+		Timestamp: eventTime(event),
+	}
+
+	switch {
+	case involvedObject.Kind == podGroupKind &&
+		strings.HasPrefix(involvedObject.APIVersion, schedulingv2alpha2.GroupVersion.Group+"/"):
+		schedulingEvent.PodGroup = involvedObject.Name
+		schedulingEvent.Queue = w.podGroupQueue(ctx, involvedObject.Namespace, involvedObject.Name)
+		return schedulingEvent, true
+	case involvedObject.Kind == podKind && involvedObject.APIVersion == "v1":
+		pod := &v1.Pod{}
+		err := w.reader.Get(ctx, client.ObjectKey{Namespace: involvedObject.Namespace, Name: involvedObject.Name}, pod)
+		if err != nil {
+			// The cache only holds pods scheduled by KAI
+			return nil, false
+		}
+		schedulingEvent.PodGroup = pod.Annotations[constants.PodGroupAnnotationForPod]
+		if schedulingEvent.PodGroup != "" {
+			schedulingEvent.Queue = w.podGroupQueue(ctx, pod.Namespace, schedulingEvent.PodGroup)
+		}
+		if schedulingEvent.Queue == "" {
+			schedulingEvent.Queue = pod.Labels[constants.DefaultQueueLabel]
+		}
+		return schedulingEvent, true
+	}
+	return nil, false
+}
+
+func (w *EventWatcher) podGroupQueue(ctx context.Context, namespace, name string) string {
+	podGroup := &schedulingv2alpha2.PodGroup{}
+	if err := w.reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, podGroup); err != nil {
+		log.FromContext(ctx).V(2).Info("Failed to get the pod group of an event",
+			"namespace", namespace, "name", name, "error", err.Error())
+		return ""
+	}
+	return podGroup.Spec.Queue
+}
+
+func eventSource(event *v1.Event) string {
+	if event.Source.Component != "" {
+		return event.Source.Component
+	}
+	return event.ReportingController
+}
+
+func eventCount(event *v1.Event) int32 {
+	if event.Series != nil {
+		return event.Series.Count
+	}
+	if event.Count > 0 {
+		return event.Count
+	}
+	return 1
+}
+
+func eventTime(event *v1.Event) time.Time {
+	if event.Series != nil && !event.Series.LastObservedTime.IsZero() {
+		return event.Series.LastObservedTime.Time
+	}
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	return event.CreationTimestamp.Time
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package eventaggregator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	schedulingv2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+func TestToSchedulingEvent(t *testing.T) {
+	lastTimestamp := metav1.NewTime(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	podGroup := &schedulingv2alpha2.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "pg", Namespace: "ns"},
+		Spec:       schedulingv2alpha2.PodGroupSpec{Queue: "queue-a"},
+	}
+	podInPodGroup := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name: "pod", Namespace: "ns",
+		Annotations: map[string]string{constants.PodGroupAnnotationForPod: "pg"},
+	}}
+	podWithQueueLabel := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name: "labeled", Namespace: "ns",
+		Labels: map[string]string{constants.DefaultQueueLabel: "queue-b"},
+	}}
+
+	tests := []struct {
+		name           string
+		involvedObject v1.ObjectReference
+		expected       *SchedulingEvent
+	}{
+		{
+			name:           "pod group event",
+			involvedObject: v1.ObjectReference{Kind: "PodGroup", APIVersion: "scheduling.run.ai/v2alpha2", Namespace: "ns", Name: "pg"},
+			expected: &SchedulingEvent{Kind: "PodGroup", Namespace: "ns", Name: "pg", PodGroup: "pg",
+				Queue: "queue-a"},
+		},
+		{
+			name:           "pod event with the queue of its pod group",
+			involvedObject: v1.ObjectReference{Kind: "Pod", APIVersion: "v1", Namespace: "ns", Name: "pod"},
+			expected: &SchedulingEvent{Kind: "Pod", Namespace: "ns", Name: "pod", PodGroup: "pg",
+				Queue: "queue-a"},
+		},
+		{
+			name:           "pod event with the queue of its label",
+			involvedObject: v1.ObjectReference{Kind: "Pod", APIVersion: "v1", Namespace: "ns", Name: "labeled"},
+			expected:       &SchedulingEvent{Kind: "Pod", Namespace: "ns", Name: "labeled", Queue: "queue-b"},
+		},
+		{
+			name:           "pod that is not scheduled by kai",
+			involvedObject: v1.ObjectReference{Kind: "Pod", APIVersion: "v1", Namespace: "ns", Name: "missing"},
+		},
+		{
+			name:           "other object",
+			involvedObject: v1.ObjectReference{Kind: "Node", APIVersion: "v1", Name: "node"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := fake.NewClientBuilder().WithScheme(newScheme()).
+				WithObjects(podGroup, podInPodGroup, podWithQueueLabel).Build()
+			watcher := NewEventWatcher(nil, kubeClient, NewBroker(1))
+			event := &v1.Event{
+				InvolvedObject: tt.involvedObject,
+				Type:           v1.EventTypeNormal,
+				Reason:         "Scheduled",
+				Message:        "scheduled",
+				Source:         v1.EventSource{Component: "kai-scheduler"},
+				Count:          2,
+				LastTimestamp:  lastTimestamp,
+			}
+
+			schedulingEvent, ok := watcher.toSchedulingEvent(context.Background(), event)
+			if tt.expected == nil {
+				assert.False(t, ok)
+				return
+			}
+			assert.True(t, ok)
+			tt.expected.Type = v1.EventTypeNormal
+			tt.expected.Reason = "Scheduled"
+			tt.expected.Message = "scheduled"
+			tt.expected.Source = "kai-scheduler"
+			tt.expected.Count = 2
+			tt.expected.Timestamp = lastTimestamp.Time
+			assert.Equal(t, tt.expected, schedulingEvent)
+		})
+	}
+}
+
+func TestIsRecurrence(t *testing.T) {
+	event := &v1.Event{Count: 1}
+	recurred := &v1.Event{Count: 2}
+	relabeled := &v1.Event{Count: 1, ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"a": "b"}}}
+	series := &v1.Event{Series: &v1.EventSeries{Count: 3}}
+
+	assert.True(t, isRecurrence(event, recurred))
+	assert.False(t, isRecurrence(event, relabeled))
+	assert.True(t, isRecurrence(&v1.Event{}, series))
+	assert.False(t, isRecurrence(series, series))
+}
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(schedulingv2alpha2.AddToScheme(scheme))
+	return scheme
+}
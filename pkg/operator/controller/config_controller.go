@@ -38,6 +38,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/operator/operands/binder"
 	"github.com/NVIDIA/KAI-scheduler/pkg/operator/operands/common"
 	"github.com/NVIDIA/KAI-scheduler/pkg/operator/operands/deployable"
+	"github.com/NVIDIA/KAI-scheduler/pkg/operator/operands/event_aggregator"
 	"github.com/NVIDIA/KAI-scheduler/pkg/operator/operands/known_types"
 	"github.com/NVIDIA/KAI-scheduler/pkg/operator/operands/node_scale_adjuster"
 	"github.com/NVIDIA/KAI-scheduler/pkg/operator/operands/pod_group_controller"
@@ -56,6 +57,7 @@ var ConfigReconcilerOperands = []operands.Operand{
 	&admission.Admission{},
 	&prometheus.Prometheus{},
 	&scheduler.SchedulerForConfig{},
+	&event_aggregator.EventAggregator{},
 }
 
 // ConfigReconciler reconciles a Config object
@@ -75,6 +77,8 @@ func (r *ConfigReconciler) SetOperands(ops []operands.Operand) {
 // +kubebuilder:rbac:groups=kai.scheduler,resources=configs/finalizers,verbs=update
 // +kubebuilder:rbac:groups=apps,resources=deployments;daemonsets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=serviceaccounts/token,verbs=create
+// +kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=roles;rolebindings,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=services;secrets;serviceaccounts;configmaps;persistentvolumeclaims;pods;endpoints,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="admissionregistration.k8s.io",resources=mutatingwebhookconfigurations;validatingwebhookconfigurations,resourceNames=kai-podgroup-validation-v2alpha2;kai-queue-validation-v2;mutating-kai-admission;validating-kai-admission,verbs=delete;update;patch
 // +kubebuilder:rbac:groups="admissionregistration.k8s.io",resources=mutatingwebhookconfigurations;validatingwebhookconfigurations,verbs=get;list;watch;create
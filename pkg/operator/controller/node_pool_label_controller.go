@@ -0,0 +1,149 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kaiv1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/operator/operands/known_types"
+)
+
+const nodePoolLabelControllerName = "node-pool-label"
+
+// NodePoolLabelReconciler labels nodes into the node pools of the SchedulingShards whose node pool selector they
+// match, and keeps the labels up to date as nodes join the cluster and their discovery labels change
+type NodePoolLabelReconciler struct {
+	client.Client
+}
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch;patch
+
+func (r *NodePoolLabelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	node := &v1.Node{}
+	if err := r.Get(ctx, req.NamespacedName, node); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	kaiConfig := &kaiv1.Config{}
+	if err := r.Get(ctx, client.ObjectKey{Name: known_types.SingletonInstanceName}, kaiConfig); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	kaiConfig.Spec.SetDefaultsWhereNeeded()
+	nodePoolLabelKey := *kaiConfig.Spec.Global.NodePoolLabelKey
+
+	shards := &kaiv1.SchedulingShardList{}
+	if err := r.List(ctx, shards); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	original := node.DeepCopy()
+	nodePool, found := selectNodePool(node, shards.Items)
+	if found {
+		metav1.SetMetaDataLabel(&node.ObjectMeta, nodePoolLabelKey, nodePool)
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, constants.NodePoolAutoLabeled, "true")
+	} else if _, autoLabeled := node.Annotations[constants.NodePoolAutoLabeled]; autoLabeled {
+		delete(node.Labels, nodePoolLabelKey)
+		delete(node.Annotations, constants.NodePoolAutoLabeled)
+	}
+	if maps.Equal(original.Labels, node.Labels) && maps.Equal(original.Annotations, node.Annotations) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Updating the node pool label of node", "node", node.Name,
+		"previousNodePool", original.Labels[nodePoolLabelKey], "nodePool", nodePool)
+	return ctrl.Result{}, r.Patch(ctx, node, client.MergeFrom(original))
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NodePoolLabelReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(nodePoolLabelControllerName).
+		For(&v1.Node{}, builder.WithPredicates(
+			predicate.Or(predicate.LabelChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		Watches(&kaiv1.SchedulingShard{}, handler.EnqueueRequestsFromMapFunc(r.requestAllNodes),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&kaiv1.Config{}, handler.EnqueueRequestsFromMapFunc(r.requestAllNodes),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
+
+// requestAllNodes returns all the nodes, so that a change in the node pool selectors of the SchedulingShards or in
+// the node pool label key relabels every node
+func (r *NodePoolLabelReconciler) requestAllNodes(ctx context.Context, _ client.Object) []reconcile.Request {
+	nodes := &v1.NodeList{}
+	if err := r.Client.List(ctx, nodes); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list nodes")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(nodes.Items))
+	for _, node := range nodes.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&node)})
+	}
+	return requests
+}
+
+// selectNodePool returns the partition label value of the first SchedulingShard, by name, whose node pool selector
+// matches the node
+func selectNodePool(node *v1.Node, shards []kaiv1.SchedulingShard) (string, bool) {
+	slices.SortFunc(shards, func(a, b kaiv1.SchedulingShard) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	for _, shard := range shards {
+		if shard.DeletionTimestamp != nil || shard.Spec.NodePoolSelector == nil ||
+			shard.Spec.PartitionLabelValue == "" {
+			continue
+		}
+		if nodePoolSelectorMatches(shard.Spec.NodePoolSelector, node.Labels) {
+			return shard.Spec.PartitionLabelValue, true
+		}
+	}
+	return "", false
+}
+
+// nodePoolSelectorMatches returns whether the node labels match every field that is set in the selector. An empty
+// selector matches no node.
+func nodePoolSelectorMatches(selector *kaiv1.NodePoolSelector, labels map[string]string) bool {
+	if len(selector.GPUProducts) == 0 && len(selector.InstanceFamilies) == 0 && len(selector.MatchLabels) == 0 {
+		return false
+	}
+	if len(selector.GPUProducts) > 0 && !slices.Contains(selector.GPUProducts, labels[constants.GpuProductLabel]) {
+		return false
+	}
+	if len(selector.InstanceFamilies) > 0 &&
+		!slices.Contains(selector.InstanceFamilies, getInstanceFamily(labels[v1.LabelInstanceTypeStable])) {
+		return false
+	}
+	for key, value := range selector.MatchLabels {
+		if labelValue, found := labels[key]; !found || labelValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// getInstanceFamily returns the instance type up to its first '.' or '-', e.g. p5 for p5.48xlarge
+func getInstanceFamily(instanceType string) string {
+	if i := strings.IndexAny(instanceType, ".-"); i >= 0 {
+		return instanceType[:i]
+	}
+	return instanceType
+}
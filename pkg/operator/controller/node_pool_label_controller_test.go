@@ -0,0 +1,120 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kaiv1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/operator/operands/known_types"
+)
+
+var _ = Describe("NodePoolLabelReconciler", Ordered, func() {
+	BeforeAll(func() {
+		Expect(kaiv1.AddToScheme(scheme.Scheme)).To(Succeed())
+	})
+
+	newNode := func(labels, annotations map[string]string) *v1.Node {
+		return &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: labels, Annotations: annotations}}
+	}
+	newShard := func(name, partitionLabelValue string, selector *kaiv1.NodePoolSelector) *kaiv1.SchedulingShard {
+		return &kaiv1.SchedulingShard{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: kaiv1.SchedulingShardSpec{
+				PartitionLabelValue: partitionLabelValue,
+				NodePoolSelector:    selector,
+			},
+		}
+	}
+	h100Shard := newShard("h100", "h100-pool", &kaiv1.NodePoolSelector{
+		GPUProducts:      []string{"NVIDIA-H100-80GB-HBM3"},
+		InstanceFamilies: []string{"p5", "a3"},
+		MatchLabels:      map[string]string{"feature.node.kubernetes.io/rdma.available": "true"},
+	})
+	h100Labels := map[string]string{
+		constants.GpuProductLabel:                   "NVIDIA-H100-80GB-HBM3",
+		v1.LabelInstanceTypeStable:                  "p5.48xlarge",
+		"feature.node.kubernetes.io/rdma.available": "true",
+	}
+
+	DescribeTable(
+		"Reconcile",
+		func(node *v1.Node, shards []*kaiv1.SchedulingShard, expectedNodePool string, expectedAutoLabeled bool) {
+			objects := []client.Object{
+				node, &kaiv1.Config{ObjectMeta: metav1.ObjectMeta{Name: known_types.SingletonInstanceName}},
+			}
+			for _, shard := range shards {
+				objects = append(objects, shard)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build()
+
+			reconciler := &NodePoolLabelReconciler{Client: fakeClient}
+			_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+				NamespacedName: client.ObjectKeyFromObject(node),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			updatedNode := &v1.Node{}
+			Expect(fakeClient.Get(context.Background(), client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+			Expect(updatedNode.Labels[constants.DefaultNodePoolLabelKey]).To(Equal(expectedNodePool))
+			_, autoLabeled := updatedNode.Annotations[constants.NodePoolAutoLabeled]
+			Expect(autoLabeled).To(Equal(expectedAutoLabeled))
+		},
+		Entry("matching node is labeled",
+			newNode(h100Labels, nil), []*kaiv1.SchedulingShard{h100Shard}, "h100-pool", true),
+		Entry("misrouted node is relabeled",
+			newNode(withLabel(h100Labels, constants.DefaultNodePoolLabelKey, "a100-pool"), nil),
+			[]*kaiv1.SchedulingShard{h100Shard}, "h100-pool", true),
+		Entry("node of another instance family is not labeled",
+			newNode(withLabel(h100Labels, v1.LabelInstanceTypeStable, "p4d.24xlarge"), nil),
+			[]*kaiv1.SchedulingShard{h100Shard}, "", false),
+		Entry("node without the interconnect label is not labeled",
+			newNode(withLabel(h100Labels, "feature.node.kubernetes.io/rdma.available", "false"), nil),
+			[]*kaiv1.SchedulingShard{h100Shard}, "", false),
+		Entry("auto labeled node that no longer matches is unlabeled",
+			newNode(map[string]string{constants.DefaultNodePoolLabelKey: "h100-pool"},
+				map[string]string{constants.NodePoolAutoLabeled: "true"}),
+			[]*kaiv1.SchedulingShard{h100Shard}, "", false),
+		Entry("manually labeled node that doesn't match is kept",
+			newNode(map[string]string{constants.DefaultNodePoolLabelKey: "cpu-pool"}, nil),
+			[]*kaiv1.SchedulingShard{h100Shard}, "cpu-pool", false),
+		Entry("first shard by name wins",
+			newNode(h100Labels, nil),
+			[]*kaiv1.SchedulingShard{
+				newShard("gpu", "gpu-pool", &kaiv1.NodePoolSelector{
+					MatchLabels: map[string]string{constants.GpuProductLabel: "NVIDIA-H100-80GB-HBM3"}}),
+				h100Shard,
+			}, "gpu-pool", true),
+		Entry("shard with an empty selector selects no node",
+			newNode(h100Labels, nil),
+			[]*kaiv1.SchedulingShard{newShard("all", "all-pool", &kaiv1.NodePoolSelector{})}, "", false),
+	)
+
+	It("should get instance families", func() {
+		Expect(getInstanceFamily("p5.48xlarge")).To(Equal("p5"))
+		Expect(getInstanceFamily("a3-highgpu-8g")).To(Equal("a3"))
+		Expect(getInstanceFamily("Standard_ND96asr_v4")).To(Equal("Standard_ND96asr_v4"))
+	})
+})
+
+func withLabel(labels map[string]string, key, value string) map[string]string {
+	result := map[string]string{key: value}
+	for labelKey, labelValue := range labels {
+		if labelKey != key {
+			result[labelKey] = labelValue
+		}
+	}
+	return result
+}
@@ -349,6 +349,8 @@ func buildArgsList(kaiConfig *kaiv1.Config, config *kaiv1admission.Admission) []
 		fmt.Sprintf(":%d", *config.Webhook.ProbePort),
 		"--metrics-bind-address",
 		fmt.Sprintf(":%d", *config.Webhook.MetricsPort),
+		"--nodepool-label-key",
+		*kaiConfig.Spec.Global.NodePoolLabelKey,
 	}
 
 	if config.GPUSharing != nil && *config.GPUSharing {
@@ -363,6 +365,10 @@ func buildArgsList(kaiConfig *kaiv1.Config, config *kaiv1admission.Admission) []
 		args = append(args, "--gpu-pod-runtime-class-name", *config.GPUPodRuntimeClassName)
 	}
 
+	if config.NodeCapacityValidation != nil {
+		args = append(args, "--node-capacity-validation", *config.NodeCapacityValidation)
+	}
+
 	common.AddK8sClientConfigToArgs(config.Service.K8sClientConfig, args)
 
 	return args
@@ -197,6 +197,31 @@ func TestDeploymentForKAIConfig(t *testing.T) {
 				"--gpu-pod-runtime-class-name", "",
 			},
 		},
+		{
+			name: "configuration with node capacity validation",
+			config: &kaiv1.Config{
+				Spec: kaiv1.ConfigSpec{
+					Namespace: constants.DefaultKAINamespace,
+					Global: &kaiv1.GlobalConfig{
+						SchedulerName:    ptr.To(constants.DefaultSchedulerName),
+						NodePoolLabelKey: ptr.To("custom-node-pool"),
+					},
+					Admission: &admission.Admission{
+						Replicas: ptr.To(int32(1)),
+						Webhook: &admission.Webhook{
+							TargetPort:  ptr.To(9443),
+							ProbePort:   ptr.To(8081),
+							MetricsPort: ptr.To(8080),
+						},
+						NodeCapacityValidation: ptr.To("reject"),
+					},
+				},
+			},
+			expectedArgs: []string{
+				"--nodepool-label-key", "custom-node-pool",
+				"--node-capacity-validation", "reject",
+			},
+		},
 	}
 
 	for _, tt := range tests {
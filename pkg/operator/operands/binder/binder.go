@@ -39,6 +39,7 @@ func (b *Binder) DesiredState(
 		b.serviceAccountForKAIConfig,
 		b.serviceForKAIConfig,
 		resourceReservationServiceAccount,
+		b.bindTimeSecretsRBACForKAIConfig,
 	} {
 		newResources, err := resourceFunc(ctx, runtimeClient, kaiConfig)
 		if err != nil {
@@ -16,10 +16,12 @@ import (
 	. "github.com/onsi/gomega"
 
 	kaiv1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1"
+	kaiv1binder "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/binder"
 	"github.com/NVIDIA/KAI-scheduler/pkg/operator/operands/common/test_utils"
 
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -227,6 +229,73 @@ var _ = Describe("Binder", func() {
 				Expect(newReservationSA.ImagePullSecrets).To(ContainElement(v1.LocalObjectReference{Name: "test-secret"}))
 			})
 		})
+
+		Context("Bind-Time Secrets", func() {
+			BeforeEach(func() {
+				kaiConfig.Spec.Binder.BindTimeSecrets = map[string]kaiv1binder.BindTimeSecret{
+					"pool-a": {Secret: "licenses/pool-a", Namespaces: []string{"team-a", "licenses"}},
+					"pool-b": {
+						Secret:                      "licenses/pool-b",
+						Namespaces:                  []string{"team-b"},
+						ServiceAccountTokenAudience: "accelerator",
+					},
+				}
+			})
+
+			It("passes the bind-time secrets to the binder", func(ctx context.Context) {
+				objects, err := b.DesiredState(ctx, fakeKubeClient, kaiConfig)
+				Expect(err).To(BeNil())
+
+				deployment := *test_utils.FindTypeInObjects[*appsv1.Deployment](objects)
+				args := deployment.Spec.Template.Spec.Containers[0].Args
+				Expect(args).To(ContainElement("--bind-time-secrets"))
+				Expect(args).To(ContainElement(ContainSubstring(`"serviceAccountTokenAudience":"accelerator"`)))
+			})
+
+			It("grants the binder access to secrets only in the source and allowed namespaces", func(ctx context.Context) {
+				objects, err := b.DesiredState(ctx, fakeKubeClient, kaiConfig)
+				Expect(err).To(BeNil())
+
+				roles := map[string]*rbacv1.Role{}
+				roleBindings := map[string]*rbacv1.RoleBinding{}
+				for _, obj := range objects {
+					switch typed := obj.(type) {
+					case *rbacv1.Role:
+						roles[typed.Namespace] = typed
+					case *rbacv1.RoleBinding:
+						roleBindings[typed.Namespace] = typed
+					}
+				}
+				Expect(roles).To(HaveLen(3))
+				Expect(roleBindings).To(HaveLen(3))
+
+				Expect(roles["licenses"].Rules).To(ConsistOf(
+					rbacv1.PolicyRule{
+						APIGroups:     []string{""},
+						Resources:     []string{"secrets"},
+						ResourceNames: []string{"pool-a", "pool-b"},
+						Verbs:         []string{"get"},
+					},
+					rbacv1.PolicyRule{
+						APIGroups: []string{""},
+						Resources: []string{"secrets"},
+						Verbs:     []string{"get", "create", "update", "delete"},
+					},
+				))
+				Expect(roles["team-a"].Rules).To(HaveLen(1))
+				Expect(roles["team-b"].Rules).To(ContainElement(rbacv1.PolicyRule{
+					APIGroups: []string{""},
+					Resources: []string{"serviceaccounts/token"},
+					Verbs:     []string{"create"},
+				}))
+				Expect(roleBindings["team-b"].Subjects).To(ConsistOf(rbacv1.Subject{
+					Kind:      rbacv1.ServiceAccountKind,
+					Name:      defaultResourceName,
+					Namespace: kaiConfig.Spec.Namespace,
+				}))
+				Expect(roleBindings["team-b"].RoleRef.Name).To(Equal(roles["team-b"].Name))
+			})
+		})
 	})
 })
 
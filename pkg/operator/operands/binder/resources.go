@@ -7,11 +7,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	"golang.org/x/mod/semver"
 
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,6 +27,7 @@ import (
 
 	kaiv1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1"
 	kaiv1binder "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/binder"
+	kaiv1common "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/common"
 	kaiConfigUtils "github.com/NVIDIA/KAI-scheduler/pkg/operator/config"
 	"github.com/NVIDIA/KAI-scheduler/pkg/operator/operands/common"
 )
@@ -230,11 +235,31 @@ func buildArgsList(kaiConfig *kaiv1.Config, config *kaiv1binder.Binder, fakeGPU
 			*config.MaxConcurrentReconciles))
 	}
 
+	if len(config.ResourceReservation.ArchImages) > 0 {
+		args = append(args, "--resource-reservation-arch-images="+archImagesArg(config.ResourceReservation.ArchImages))
+	}
+
 	if config.ResourceReservation.AllocationTimeout != nil {
 		args = append(args, fmt.Sprintf("--resource-reservation-allocation-timeout=%d",
 			*config.ResourceReservation.AllocationTimeout))
 	}
 
+	if config.ResourceReservation.GPUBindClaims != nil && *config.ResourceReservation.GPUBindClaims {
+		args = append(args, "--gpu-bind-claims")
+	}
+
+	if config.ResourceReservation.GPUSharingReleaseFinalizer != nil &&
+		*config.ResourceReservation.GPUSharingReleaseFinalizer {
+		args = append(args, "--gpu-sharing-release-finalizer")
+	}
+
+	if len(config.BindTimeSecrets) > 0 {
+		// Marshalling a map of plain structs can't fail
+		bindTimeSecretsJSON, _ := json.Marshal(config.BindTimeSecrets)
+		args = append(args, "--nodepool-label-key", *kaiConfig.Spec.Global.NodePoolLabelKey,
+			"--bind-time-secrets", string(bindTimeSecretsJSON))
+	}
+
 	if config.VolumeBindingTimeoutSeconds != nil {
 		args = append(args, fmt.Sprintf("--volume-binding-timeout-seconds=%d",
 			*config.VolumeBindingTimeoutSeconds))
@@ -277,3 +302,88 @@ func buildArgsList(kaiConfig *kaiv1.Config, config *kaiv1binder.Binder, fakeGPU
 
 	return args
 }
+
+// bindTimeSecretsRBACForKAIConfig returns the Roles and RoleBindings that let the binder read the source secrets of
+// the bind-time secrets, and create secrets and service account tokens in the namespaces they are allowed for, so
+// that the binder has no cluster wide access to secrets
+func (b *Binder) bindTimeSecretsRBACForKAIConfig(
+	_ context.Context, _ client.Reader, kaiConfig *kaiv1.Config,
+) ([]client.Object, error) {
+	rulesByNamespace := map[string][]rbacv1.PolicyRule{}
+	sourceNames := map[string][]string{}
+	tokenNamespaces := map[string]bool{}
+	targetNamespaces := map[string]bool{}
+	for _, nodePool := range slices.Sorted(maps.Keys(kaiConfig.Spec.Binder.BindTimeSecrets)) {
+		bindTimeSecret := kaiConfig.Spec.Binder.BindTimeSecrets[nodePool]
+		if namespace, name, found := strings.Cut(bindTimeSecret.Secret, "/"); found {
+			if !slices.Contains(sourceNames[namespace], name) {
+				sourceNames[namespace] = append(sourceNames[namespace], name)
+			}
+		}
+		for _, namespace := range bindTimeSecret.Namespaces {
+			targetNamespaces[namespace] = true
+			if bindTimeSecret.ServiceAccountTokenAudience != "" {
+				tokenNamespaces[namespace] = true
+			}
+		}
+	}
+	for namespace, names := range sourceNames {
+		slices.Sort(names)
+		rulesByNamespace[namespace] = append(rulesByNamespace[namespace], rbacv1.PolicyRule{
+			APIGroups:     []string{""},
+			Resources:     []string{"secrets"},
+			ResourceNames: names,
+			Verbs:         []string{"get"},
+		})
+	}
+	for namespace := range targetNamespaces {
+		rulesByNamespace[namespace] = append(rulesByNamespace[namespace], rbacv1.PolicyRule{
+			APIGroups: []string{""},
+			Resources: []string{"secrets"},
+			Verbs:     []string{"get", "create", "update", "delete"},
+		})
+		if tokenNamespaces[namespace] {
+			rulesByNamespace[namespace] = append(rulesByNamespace[namespace], rbacv1.PolicyRule{
+				APIGroups: []string{""},
+				Resources: []string{"serviceaccounts/token"},
+				Verbs:     []string{"create"},
+			})
+		}
+	}
+
+	name := fmt.Sprintf("kai-%s-bind-time-secrets", b.BaseResourceName)
+	var objects []client.Object
+	for _, namespace := range slices.Sorted(maps.Keys(rulesByNamespace)) {
+		objects = append(objects,
+			&rbacv1.Role{
+				TypeMeta:   metav1.TypeMeta{Kind: "Role", APIVersion: rbacv1.SchemeGroupVersion.String()},
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Rules:      rulesByNamespace[namespace],
+			},
+			&rbacv1.RoleBinding{
+				TypeMeta:   metav1.TypeMeta{Kind: "RoleBinding", APIVersion: rbacv1.SchemeGroupVersion.String()},
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Subjects: []rbacv1.Subject{
+					{
+						Kind:      rbacv1.ServiceAccountKind,
+						Name:      b.BaseResourceName,
+						Namespace: kaiConfig.Spec.Namespace,
+					},
+				},
+				RoleRef: rbacv1.RoleRef{
+					APIGroup: rbacv1.GroupName,
+					Kind:     "Role",
+					Name:     name,
+				},
+			})
+	}
+	return objects, nil
+}
+
+func archImagesArg(archImages map[string]*kaiv1common.Image) string {
+	var pairs []string
+	for _, arch := range slices.Sorted(maps.Keys(archImages)) {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", arch, archImages[arch].Url()))
+	}
+	return strings.Join(pairs, ",")
+}
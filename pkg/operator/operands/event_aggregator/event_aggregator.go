@@ -0,0 +1,68 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package event_aggregator
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kaiv1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/operator/operands/common"
+)
+
+type EventAggregator struct {
+	namespace        string
+	lastDesiredState []client.Object
+}
+
+type resourceForKAIConfig func(ctx context.Context, runtimeClient client.Reader, kaiConfig *kaiv1.Config) (client.Object, error)
+
+func (ea *EventAggregator) DesiredState(
+	ctx context.Context, runtimeClient client.Reader, kaiConfig *kaiv1.Config,
+) ([]client.Object, error) {
+	ea.namespace = kaiConfig.Spec.Namespace
+
+	if !*kaiConfig.Spec.EventAggregator.Service.Enabled {
+		ea.lastDesiredState = []client.Object{}
+		return nil, nil
+	}
+
+	var objects []client.Object
+	for _, resourceFunc := range []resourceForKAIConfig{
+		secretForKAIConfig,
+		deploymentForKAIConfig,
+		serviceAccountForKAIConfig,
+		serviceForKAIConfig,
+	} {
+		obj, err := resourceFunc(ctx, runtimeClient, kaiConfig)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, obj)
+	}
+
+	ea.lastDesiredState = objects
+	return objects, nil
+}
+
+func (ea *EventAggregator) IsDeployed(ctx context.Context, readerClient client.Reader) (bool, error) {
+	return common.AllObjectsExists(ctx, readerClient, ea.lastDesiredState)
+}
+
+func (ea *EventAggregator) IsAvailable(ctx context.Context, readerClient client.Reader) (bool, error) {
+	return common.AllControllersAvailable(ctx, readerClient, ea.lastDesiredState)
+}
+
+func (ea *EventAggregator) Name() string {
+	return "EventAggregator"
+}
+
+func (ea *EventAggregator) Monitor(ctx context.Context, runtimeReader client.Reader, kaiConfig *kaiv1.Config) error {
+	return nil
+}
+
+func (ea *EventAggregator) HasMissingDependencies(context.Context, client.Reader, *kaiv1.Config) (string, error) {
+	return "", nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package event_aggregator
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kaiv1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/operator/operands/common/test_utils"
+)
+
+func TestEventAggregator(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "EventAggregator operand Suite")
+}
+
+var _ = Describe("EventAggregator", func() {
+	Describe("DesiredState", func() {
+		var (
+			fakeKubeClient client.Client
+			ea             *EventAggregator
+			kaiConfig      *kaiv1.Config
+		)
+		BeforeEach(func(ctx context.Context) {
+			fakeKubeClient = fake.NewFakeClient()
+			ea = &EventAggregator{}
+			kaiConfig = kaiConfigForEventAggregator()
+		})
+
+		Context("Not Enabled", func() {
+			It("should return no objects by default", func(ctx context.Context) {
+				kaiConfig = &kaiv1.Config{}
+				kaiConfig.Spec.SetDefaultsWhereNeeded()
+				objects, err := ea.DesiredState(ctx, fakeKubeClient, kaiConfig)
+				Expect(err).To(BeNil())
+				Expect(len(objects)).To(BeZero())
+			})
+		})
+
+		Context("Enabled", func() {
+			It("should return a Deployment serving the event stream", func(ctx context.Context) {
+				kaiConfig.Spec.EventAggregator.Args.Port = ptr.To(9090)
+				objects, err := ea.DesiredState(ctx, fakeKubeClient, kaiConfig)
+				Expect(err).To(BeNil())
+
+				deploymentT := test_utils.FindTypeInObjects[*appsv1.Deployment](objects)
+				Expect(deploymentT).NotTo(BeNil())
+				deployment := *deploymentT
+				Expect(deployment.Name).To(Equal(deploymentName))
+				container := deployment.Spec.Template.Spec.Containers[0]
+				Expect(container.Args).To(ContainElements("--listen-address", ":9090"))
+				Expect(container.Ports[0].ContainerPort).To(Equal(int32(9090)))
+			})
+
+			It("should serve the event stream with the certificate of the TLS secret", func(ctx context.Context) {
+				objects, err := ea.DesiredState(ctx, fakeKubeClient, kaiConfig)
+				Expect(err).To(BeNil())
+
+				secretT := test_utils.FindTypeInObjects[*v1.Secret](objects)
+				Expect(secretT).NotTo(BeNil())
+				secret := *secretT
+				Expect(secret.Data).To(HaveKey(certKey))
+				Expect(secret.Data).To(HaveKey(keyKey))
+
+				deployment := *test_utils.FindTypeInObjects[*appsv1.Deployment](objects)
+				Expect(deployment.Spec.Template.Spec.Volumes[0].Secret.SecretName).To(Equal(secret.Name))
+				container := deployment.Spec.Template.Spec.Containers[0]
+				Expect(container.VolumeMounts[0].MountPath).To(Equal(certDir))
+				Expect(container.Args).To(ContainElements("--cert-dir", certDir))
+			})
+
+			It("should keep the certificate of an existing TLS secret", func(ctx context.Context) {
+				existing := &v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: kaiConfig.Spec.Namespace},
+					Data:       map[string][]byte{certKey: []byte("cert"), keyKey: []byte("key")},
+				}
+				fakeKubeClient = fake.NewFakeClient(existing)
+				objects, err := ea.DesiredState(ctx, fakeKubeClient, kaiConfig)
+				Expect(err).To(BeNil())
+
+				secret := *test_utils.FindTypeInObjects[*v1.Secret](objects)
+				Expect(secret.Data[certKey]).To(Equal([]byte("cert")))
+			})
+
+			It("should return a Service and a ServiceAccount", func(ctx context.Context) {
+				objects, err := ea.DesiredState(ctx, fakeKubeClient, kaiConfig)
+				Expect(err).To(BeNil())
+
+				serviceT := test_utils.FindTypeInObjects[*v1.Service](objects)
+				Expect(serviceT).NotTo(BeNil())
+				service := *serviceT
+				Expect(service.Spec.Ports[0].Port).To(Equal(int32(8080)))
+				Expect(service.Spec.Selector).To(HaveKeyWithValue("app", deploymentName))
+
+				Expect(test_utils.FindTypeInObjects[*v1.ServiceAccount](objects)).NotTo(BeNil())
+			})
+		})
+	})
+})
+
+func kaiConfigForEventAggregator() *kaiv1.Config {
+	kaiConfig := &kaiv1.Config{}
+	kaiConfig.Spec.SetDefaultsWhereNeeded()
+	kaiConfig.Spec.EventAggregator.Service.Enabled = ptr.To(true)
+
+	return kaiConfig
+}
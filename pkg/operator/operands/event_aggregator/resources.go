@@ -0,0 +1,170 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package event_aggregator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kaiv1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1/event_aggregator"
+	generate "github.com/NVIDIA/KAI-scheduler/pkg/operator/cert-utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/operator/operands/common"
+)
+
+const (
+	deploymentName = "event-aggregator"
+	portName       = "https"
+
+	secretName = "event-aggregator-tls-secret"
+	certKey    = "tls.crt"
+	keyKey     = "tls.key"
+	certVolume = "cert"
+	certDir    = "/tmp/event-aggregator/serving-certs"
+)
+
+func deploymentForKAIConfig(
+	ctx context.Context, runtimeClient client.Reader, kaiConfig *kaiv1.Config,
+) (client.Object, error) {
+	config := kaiConfig.Spec.EventAggregator
+	deployment, err := common.DeploymentForKAIConfig(ctx, runtimeClient, kaiConfig, config.Service, deploymentName)
+	if err != nil {
+		return nil, err
+	}
+
+	container := &deployment.Spec.Template.Spec.Containers[0]
+	container.Args = argsForKAIConfig(config, *kaiConfig.Spec.Global.SchedulerName)
+	container.Ports = []v1.ContainerPort{
+		{
+			Name:          portName,
+			ContainerPort: int32(*config.Args.Port),
+			Protocol:      v1.ProtocolTCP,
+		},
+	}
+	container.VolumeMounts = []v1.VolumeMount{
+		{
+			Name:      certVolume,
+			ReadOnly:  true,
+			MountPath: certDir,
+		},
+	}
+	deployment.Spec.Template.Spec.Volumes = []v1.Volume{
+		{
+			Name: certVolume,
+			VolumeSource: v1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{
+					SecretName:  secretName,
+					DefaultMode: ptr.To(int32(420)),
+				},
+			},
+		},
+	}
+
+	return deployment, nil
+}
+
+// secretForKAIConfig returns the secret of the serving certificate of the event stream. A self signed certificate for
+// the service is generated unless the secret already holds a certificate.
+func secretForKAIConfig(
+	ctx context.Context, k8sReader client.Reader, kaiConfig *kaiv1.Config,
+) (client.Object, error) {
+	obj, err := common.ObjectForKAIConfig(ctx, k8sReader, &v1.Secret{}, secretName, kaiConfig.Spec.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := obj.(*v1.Secret)
+	secret.TypeMeta = metav1.TypeMeta{
+		Kind:       "Secret",
+		APIVersion: "v1",
+	}
+	if _, found := secret.Data[certKey]; !found {
+		serviceName := fmt.Sprintf("%s.%s.svc", deploymentName, kaiConfig.Spec.Namespace)
+		cert, key, err := generate.GenerateSelfSignedCert(serviceName, []string{
+			deploymentName, fmt.Sprintf("%s.%s", deploymentName, kaiConfig.Spec.Namespace), serviceName,
+		})
+		if err != nil {
+			return nil, err
+		}
+		secret.Data = map[string][]byte{
+			certKey: cert,
+			keyKey:  key,
+		}
+	}
+	return secret, nil
+}
+
+func serviceAccountForKAIConfig(
+	ctx context.Context, k8sReader client.Reader, kaiConfig *kaiv1.Config,
+) (client.Object, error) {
+	sa, err := common.ObjectForKAIConfig(ctx, k8sReader, &v1.ServiceAccount{}, deploymentName,
+		kaiConfig.Spec.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	sa.(*v1.ServiceAccount).TypeMeta = metav1.TypeMeta{
+		Kind:       "ServiceAccount",
+		APIVersion: "v1",
+	}
+	return sa, err
+}
+
+func serviceForKAIConfig(
+	ctx context.Context, k8sReader client.Reader, kaiConfig *kaiv1.Config,
+) (client.Object, error) {
+	obj, err := common.ObjectForKAIConfig(ctx, k8sReader, &v1.Service{}, deploymentName,
+		kaiConfig.Spec.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	service := obj.(*v1.Service)
+	service.TypeMeta = metav1.TypeMeta{
+		Kind:       "Service",
+		APIVersion: "v1",
+	}
+	service.Spec.Ports = []v1.ServicePort{
+		{
+			Name:       portName,
+			Port:       int32(*kaiConfig.Spec.EventAggregator.Args.Port),
+			Protocol:   v1.ProtocolTCP,
+			TargetPort: intstr.FromString(portName),
+		},
+	}
+	service.Spec.Selector = map[string]string{
+		"app": deploymentName,
+	}
+
+	return service, nil
+}
+
+func argsForKAIConfig(config *event_aggregator.EventAggregator, schedulerName string) []string {
+	args := []string{
+		"--scheduler-name", schedulerName,
+		"--listen-address", fmt.Sprintf(":%d", *config.Args.Port),
+		"--cert-dir", certDir,
+	}
+
+	if config.Args.BufferSize != nil {
+		args = append(args, "--buffer-size", strconv.Itoa(*config.Args.BufferSize))
+	}
+
+	if clientConfig := config.Service.K8sClientConfig; clientConfig != nil {
+		if clientConfig.QPS != nil {
+			args = append(args, "--qps", strconv.Itoa(*clientConfig.QPS))
+		}
+		if clientConfig.Burst != nil {
+			args = append(args, "--burst", strconv.Itoa(*clientConfig.Burst))
+		}
+	}
+
+	return args
+}
@@ -43,6 +43,8 @@ func init() {
 	registerConfigmaps()
 	registerServices()
 	registerSecrets()
+	registerRoles()
+	registerRoleBindings()
 	registerMutatingWebhookConfigurations()
 	registerValidatingWebhookConfigurations()
 	registerCustomResourceDefinitions()
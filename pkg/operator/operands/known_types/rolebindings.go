@@ -0,0 +1,56 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package known_types
+
+import (
+	"context"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+func rolebindingIndexer(object client.Object) []string {
+	rolebinding := object.(*rbacv1.RoleBinding)
+	owner := metav1.GetControllerOf(rolebinding)
+	if !checkOwnerType(owner) {
+		return nil
+	}
+	return []string{getOwnerKey(owner)}
+}
+
+func registerRoleBindings() {
+	SetupKAIConfigOwned(&Collectable{
+		Collect: getCurrentRoleBindingsState,
+		InitWithManager: func(ctx context.Context, mgr manager.Manager) error {
+			return mgr.GetFieldIndexer().IndexField(ctx, &rbacv1.RoleBinding{}, CollectableOwnerKey, rolebindingIndexer)
+		},
+		InitWithBuilder: func(builder *builder.Builder) *builder.Builder {
+			return builder.Owns(&rbacv1.RoleBinding{})
+		},
+		InitWithFakeClientBuilder: func(fakeClientBuilder *fake.ClientBuilder) {
+			fakeClientBuilder.WithIndex(&rbacv1.RoleBinding{}, CollectableOwnerKey, rolebindingIndexer)
+		},
+	})
+}
+
+func getCurrentRoleBindingsState(ctx context.Context, runtimeClient client.Client, reconciler client.Object) (map[string]client.Object, error) {
+	result := map[string]client.Object{}
+	rolebindings := &rbacv1.RoleBindingList{}
+	reconcilerKey := getReconcilerKey(reconciler)
+
+	err := runtimeClient.List(ctx, rolebindings, client.MatchingFields{CollectableOwnerKey: reconcilerKey})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rolebinding := range rolebindings.Items {
+		result[GetKey(rolebinding.GroupVersionKind(), rolebinding.Namespace, rolebinding.Name)] = &rolebinding
+	}
+
+	return result, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package known_types
+
+import (
+	"context"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+func roleIndexer(object client.Object) []string {
+	role := object.(*rbacv1.Role)
+	owner := metav1.GetControllerOf(role)
+	if !checkOwnerType(owner) {
+		return nil
+	}
+	return []string{getOwnerKey(owner)}
+}
+
+func registerRoles() {
+	SetupKAIConfigOwned(&Collectable{
+		Collect: getCurrentRolesState,
+		InitWithManager: func(ctx context.Context, mgr manager.Manager) error {
+			return mgr.GetFieldIndexer().IndexField(ctx, &rbacv1.Role{}, CollectableOwnerKey, roleIndexer)
+		},
+		InitWithBuilder: func(builder *builder.Builder) *builder.Builder {
+			return builder.Owns(&rbacv1.Role{})
+		},
+		InitWithFakeClientBuilder: func(fakeClientBuilder *fake.ClientBuilder) {
+			fakeClientBuilder.WithIndex(&rbacv1.Role{}, CollectableOwnerKey, roleIndexer)
+		},
+	})
+}
+
+func getCurrentRolesState(ctx context.Context, runtimeClient client.Client, reconciler client.Object) (map[string]client.Object, error) {
+	result := map[string]client.Object{}
+	roles := &rbacv1.RoleList{}
+	reconcilerKey := getReconcilerKey(reconciler)
+
+	err := runtimeClient.List(ctx, roles, client.MatchingFields{CollectableOwnerKey: reconcilerKey})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range roles.Items {
+		result[GetKey(role.GroupVersionKind(), role.Namespace, role.Name)] = &role
+	}
+
+	return result, nil
+}
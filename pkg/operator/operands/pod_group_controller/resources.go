@@ -47,7 +47,7 @@ func (p *PodGroupController) deploymentForKAIConfig(
 	}
 
 	deployment.Spec.Replicas = config.Replicas
-	deployment.Spec.Template.Spec.Containers[0].Args = buildArgsList(config, *kaiConfig.Spec.Global.SchedulerName)
+	deployment.Spec.Template.Spec.Containers[0].Args = buildArgsList(kaiConfig, config)
 	deployment.Spec.Template.Spec.Containers[0].VolumeMounts = []v1.VolumeMount{
 		{
 			Name:      "cert",
@@ -219,9 +219,10 @@ func (p *PodGroupController) webhookClientConfig(namespace, path string, cabundl
 	}
 }
 
-func buildArgsList(config *pod_group_controller.PodGroupController, schedulerName string) []string {
+func buildArgsList(kaiConfig *kaiv1.Config, config *pod_group_controller.PodGroupController) []string {
 	args := []string{
-		"--scheduler-name", schedulerName,
+		"--scheduler-name", *kaiConfig.Spec.Global.SchedulerName,
+		"--nodepool-label-key", *kaiConfig.Spec.Global.NodePoolLabelKey,
 	}
 
 	common.AddK8sClientConfigToArgs(config.Service.K8sClientConfig, args)
@@ -234,5 +235,9 @@ func buildArgsList(config *pod_group_controller.PodGroupController, schedulerNam
 		args = append(args, "--leader-elect")
 	}
 
+	if config.FallbackNodePool != nil && *config.FallbackNodePool != "" {
+		args = append(args, "--fallback-node-pool", *config.FallbackNodePool)
+	}
+
 	return args
 }
@@ -62,6 +62,18 @@ func buildArgsList(kaiConfig *kaiv1.Config) []string {
 	if config.Args.GangScheduleKnative != nil {
 		args = append(args, "--knative-gang-schedule="+strconv.FormatBool(*config.Args.GangScheduleKnative))
 	}
+	if config.Args.KueueWorkloads != nil {
+		args = append(args, "--kueue-workloads="+strconv.FormatBool(*config.Args.KueueWorkloads))
+	}
+	if config.Args.PriorityAssignmentRules != nil {
+		args = append(args, "--priority-assignment-rules="+strconv.FormatBool(*config.Args.PriorityAssignmentRules))
+	}
+	if config.Args.CoschedulingPodGroups != nil {
+		args = append(args, "--coscheduling-pod-groups="+strconv.FormatBool(*config.Args.CoschedulingPodGroups))
+	}
+	if config.Args.SubGroupLabelKey != nil && *config.Args.SubGroupLabelKey != "" {
+		args = append(args, "--subgroup-label-key", *config.Args.SubGroupLabelKey)
+	}
 
 	k8sClientConfig := config.K8sClientConfig
 	if k8sClientConfig.QPS != nil {
@@ -49,6 +49,31 @@ func TestBuildArgsList(t *testing.T) {
 				"--nodepool-label-key", constants.DefaultNodePoolLabelKey,
 			},
 		},
+		{
+			name: "with subgroup label key",
+			config: &kaiv1.Config{
+				Spec: kaiv1.ConfigSpec{
+					Global: &kaiv1.GlobalConfig{
+						SchedulerName:    ptr.To(constants.DefaultSchedulerName),
+						QueueLabelKey:    ptr.To(constants.DefaultQueueLabel),
+						NodePoolLabelKey: ptr.To(constants.DefaultNodePoolLabelKey),
+					},
+					PodGrouper: &pod_grouper.PodGrouper{
+						Replicas: ptr.To(int32(1)),
+						Args: &pod_grouper.Args{
+							SubGroupLabelKey: ptr.To("training.kubeflow.org/replica-type"),
+						},
+						K8sClientConfig: &common.K8sClientConfig{},
+					},
+				},
+			},
+			expected: []string{
+				"--scheduler-name", constants.DefaultSchedulerName,
+				"--queue-label-key", constants.DefaultQueueLabel,
+				"--nodepool-label-key", constants.DefaultNodePoolLabelKey,
+				"--subgroup-label-key", "training.kubeflow.org/replica-type",
+			},
+		},
 		{
 			name: "with namespace label selector",
 			config: &kaiv1.Config{
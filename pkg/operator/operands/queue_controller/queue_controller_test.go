@@ -142,6 +142,25 @@ var _ = Describe("QueueController", func() {
 				Expect(validatingWebhookConfigurations).To(BeNil())
 			})
 
+			It("should return a validating webhook for namespaced queues when they are enabled", func(ctx context.Context) {
+				namespacedQueuesKAIConfig := kaiConfig.DeepCopy()
+				namespacedQueuesKAIConfig.Spec.QueueController.EnableNamespacedQueues = ptr.To(true)
+
+				objects, err := qc.DesiredState(ctx, fakeKubeClient, namespacedQueuesKAIConfig)
+				Expect(err).To(BeNil())
+
+				validatingWebhookConfigurations := test_utils.FindTypesInObjects[*v1.ValidatingWebhookConfiguration](objects)
+				Expect(len(validatingWebhookConfigurations)).To(Equal(len(constants.QueueValidatedVersions()) + 1))
+
+				namespacedQueueWebhook := validatingWebhookConfigurations[len(validatingWebhookConfigurations)-1].Webhooks[0]
+				Expect(namespacedQueueWebhook.Rules[0].Resources).To(ConsistOf("namespacedqueues"))
+				Expect(*namespacedQueueWebhook.ClientConfig.Service.Path).To(
+					Equal("/validate-kai-scheduler-v1alpha1-namespacedqueue"))
+
+				deployment := *test_utils.FindTypeInObjects[*appsv1.Deployment](objects)
+				Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--enable-namespaced-queues"))
+			})
+
 			It("the validating webhooks should keep labels from existing validating webhooks", func(ctx context.Context) {
 				objects, err := qc.DesiredState(ctx, fakeKubeClient, kaiConfig)
 				Expect(err).To(BeNil())
@@ -6,6 +6,7 @@ package queue_controller
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	kaiv1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1"
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
@@ -30,6 +31,9 @@ const (
 	appName             = defaultResourceName
 	queueWebhookName    = "queue-validation.kai.scheduler"
 
+	namespacedQueueWebhookName              = "namespacedqueue-validation.kai.scheduler"
+	namespacedQueueWebhookConfigurationName = "namespacedqueue"
+
 	secretName = "queue-webhook-tls-secret"
 	certKey    = "tls.crt"
 	keyKey     = "tls.key"
@@ -224,9 +228,64 @@ func (q *QueueController) validatingWCForKAIConfig(
 		validatingWebhookConfigurations = append(validatingWebhookConfigurations, validatingWebhookConfiguration)
 	}
 
+	if ptr.Deref(kaiConfig.Spec.QueueController.EnableNamespacedQueues, false) {
+		validatingWebhookConfiguration, err := q.namespacedQueueValidatingWC(ctx, runtimeClient, kaiConfig, crt)
+		if err != nil {
+			return nil, err
+		}
+		validatingWebhookConfigurations = append(validatingWebhookConfigurations, validatingWebhookConfiguration)
+	}
+
 	return validatingWebhookConfigurations, nil
 }
 
+func (q *QueueController) namespacedQueueValidatingWC(
+	ctx context.Context, runtimeClient client.Reader, kaiConfig *kaiv1.Config, crt []byte,
+) (*admissionv1.ValidatingWebhookConfiguration, error) {
+	validatingWebhookConfiguration := &admissionv1.ValidatingWebhookConfiguration{}
+
+	webhookName := fmt.Sprintf("%s%s",
+		*kaiConfig.Spec.QueueController.Webhooks.WebhookConfigurationNamePrefix, namespacedQueueWebhookConfigurationName)
+	err := runtimeClient.Get(ctx, types.NamespacedName{Name: webhookName}, validatingWebhookConfiguration)
+	if client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	validatingWebhookConfiguration.Name = webhookName
+
+	if validatingWebhookConfiguration.Labels == nil {
+		validatingWebhookConfiguration.Labels = map[string]string{}
+	}
+	validatingWebhookConfiguration.Labels["app"] = q.BaseResourceName
+	validatingWebhookConfiguration.Webhooks = []admissionv1.ValidatingWebhook{
+		{
+			Name:                    namespacedQueueWebhookName,
+			AdmissionReviewVersions: []string{"v1"},
+			SideEffects:             sideEffectsNone(),
+			FailurePolicy:           failurePolicyTypeFail(),
+			ClientConfig: q.webhookClientConfig(kaiConfig.Spec.Namespace,
+				"/validate-kai-scheduler-v1alpha1-namespacedqueue", crt,
+				int(*kaiConfig.Spec.QueueController.ControllerService.Webhook.Port)),
+			Rules: []admissionv1.RuleWithOperations{
+				{
+					Operations: []admissionv1.OperationType{
+						admissionv1.Create,
+						admissionv1.Update,
+					},
+					Rule: admissionv1.Rule{
+						APIGroups:   []string{"kai.scheduler"},
+						APIVersions: []string{"v1alpha1"},
+						Resources: []string{
+							"namespacedqueues",
+						},
+						Scope: ptr.To(admissionv1.NamespacedScope),
+					},
+				},
+			},
+		},
+	}
+	return validatingWebhookConfiguration, nil
+}
+
 func sideEffectsNone() *admissionv1.SideEffectClass {
 	se := admissionv1.SideEffectClassNone
 	return &se
@@ -275,6 +334,19 @@ func buildArgsList(kaiConfig *kaiv1.Config) []string {
 		args = append(args, "--queue-label-to-default-metric-value", *config.QueueLabelToDefaultMetricValue)
 	}
 
+	if config.EnableNamespaceQueues != nil && *config.EnableNamespaceQueues {
+		args = append(args, "--enable-namespace-queues")
+	}
+
+	if config.EnableNamespacedQueues != nil && *config.EnableNamespacedQueues {
+		args = append(args, "--enable-namespaced-queues")
+	}
+
+	if config.ReconciliationShards != nil && *config.ReconciliationShards > 1 {
+		args = append(args, "--reconciliation-shards", strconv.Itoa(int(*config.ReconciliationShards)),
+			"--shard-lease-namespace", kaiConfig.Spec.Namespace)
+	}
+
 	common.AddK8sClientConfigToArgs(config.Service.K8sClientConfig, args)
 
 	return args
@@ -28,7 +28,8 @@ import (
 )
 
 const (
-	invalidJobDepthMapError = "the scheduler's actions are %s. %s isn't one of them, making the queueDepthPerAction invalid"
+	invalidJobDepthMapError   = "the scheduler's actions are %s. %s isn't one of them, making the queueDepthPerAction invalid"
+	invalidActionPeriodsError = "the scheduler's actions are %s. %s isn't one of them, making the actionPeriods invalid"
 )
 
 func (s *SchedulerForShard) deploymentForShard(
@@ -153,6 +154,7 @@ func (s *SchedulerForShard) configMapForShard(
 				{Name: "nodeavailability"},
 				{Name: "resourcetype"},
 				{Name: "podaffinity"},
+				{Name: "preferrednodeaffinity"},
 				{Name: "elastic"},
 				{Name: "kubeflow"},
 				{Name: "ray"},
@@ -167,16 +169,22 @@ func (s *SchedulerForShard) configMapForShard(
 		},
 	}
 
+	// The auto strategy only switches the placement of pods between nodes, and shared GPUs are always packed
+	gpuOrderStrategy := placementArguments[gpuResource]
+	if gpuOrderStrategy == autoStrategy {
+		gpuOrderStrategy = binpackStrategy
+	}
+
 	innerConfig.Tiers[0].Plugins = append(
 		innerConfig.Tiers[0].Plugins,
-		conf.PluginOption{Name: fmt.Sprintf("gpu%s", strings.Replace(placementArguments[gpuResource], "bin", "", 1))},
+		conf.PluginOption{Name: fmt.Sprintf("gpu%s", strings.Replace(gpuOrderStrategy, "bin", "", 1))},
 		conf.PluginOption{
 			Name:      "nodeplacement",
 			Arguments: placementArguments,
 		},
 	)
 
-	if placementArguments[gpuResource] == binpackStrategy {
+	if gpuOrderStrategy == binpackStrategy {
 		innerConfig.Tiers[0].Plugins = append(
 			innerConfig.Tiers[0].Plugins,
 			conf.PluginOption{Name: "gpusharingorder"},
@@ -193,6 +201,19 @@ func (s *SchedulerForShard) configMapForShard(
 		innerConfig.QueueDepthPerAction = shard.Spec.QueueDepthPerAction
 	}
 
+	if len(shard.Spec.ActionPeriods) > 0 {
+		if err = validateActionPeriods(shard, innerConfig, actions); err != nil {
+			return nil, err
+		}
+		innerConfig.ActionPeriods = shard.Spec.ActionPeriods
+	}
+
+	innerConfig.GangSizeLanes = shard.Spec.GangSizeLanes
+	innerConfig.EvictionBudgets = shard.Spec.EvictionBudgets
+	innerConfig.Scavenging = shard.Spec.Scavenging
+	innerConfig.Shadow = shard.Spec.Shadow
+	innerConfig.EventTriggers = shard.Spec.EventTriggers
+
 	usageDBConfig, err := getUsageDBConfig(shard, kaiConfig)
 	if err != nil {
 		return nil, err
@@ -219,6 +240,15 @@ func validateJobDepthMap(shard *kaiv1.SchedulingShard, innerConfig conf.Schedule
 	return nil
 }
 
+func validateActionPeriods(shard *kaiv1.SchedulingShard, innerConfig conf.SchedulerConfiguration, actions []string) error {
+	for actionToConfigure := range shard.Spec.ActionPeriods {
+		if !slices.Contains(actions, actionToConfigure) {
+			return fmt.Errorf(invalidActionPeriodsError, innerConfig.Actions, actionToConfigure)
+		}
+	}
+	return nil
+}
+
 func getUsageDBConfig(shard *kaiv1.SchedulingShard, kaiConfig *kaiv1.Config) (*usagedbapi.UsageDBConfig, error) {
 	// Check for nil inputs
 	if shard == nil {
@@ -340,9 +370,27 @@ func buildArgsList(
 }
 
 func calculatePlacementArguments(placementStrategy *kaiv1.PlacementStrategy) map[string]string {
-	return map[string]string{
+	arguments := map[string]string{
 		gpuResource: *placementStrategy.GPU, cpuResource: *placementStrategy.CPU,
 	}
+	for workloadClass, strategy := range placementStrategy.WorkloadClasses {
+		arguments[workloadClass] = strategy
+	}
+	if *placementStrategy.GPU != autoStrategy || placementStrategy.Auto == nil {
+		return arguments
+	}
+
+	auto := placementStrategy.Auto
+	if auto.FragmentationHigh != nil {
+		arguments["gpuAutoFragmentationHigh"] = strconv.FormatFloat(*auto.FragmentationHigh, 'f', -1, 64)
+	}
+	if auto.FragmentationLow != nil {
+		arguments["gpuAutoFragmentationLow"] = strconv.FormatFloat(*auto.FragmentationLow, 'f', -1, 64)
+	}
+	if auto.MinSessionsInStrategy != nil {
+		arguments["gpuAutoMinSessionsInStrategy"] = strconv.Itoa(*auto.MinSessionsInStrategy)
+	}
+	return arguments
 }
 
 func addMinRuntimePluginIfNeeded(plugins *[]conf.PluginOption, minRuntime *kaiv1.MinRuntime) {
@@ -204,6 +204,56 @@ func TestValidateJobDepthMap(t *testing.T) {
 	}
 }
 
+func TestValidateActionPeriods(t *testing.T) {
+	tests := []struct {
+		name        string
+		shard       *kaiv1.SchedulingShard
+		actions     []string
+		expectError bool
+	}{
+		{
+			name: "valid periods of consolidation and reclaim",
+			shard: &kaiv1.SchedulingShard{
+				Spec: kaiv1.SchedulingShardSpec{
+					ActionPeriods: map[string]metav1.Duration{
+						"consolidation": {Duration: 5 * time.Minute},
+						"reclaim":       {Duration: 30 * time.Second},
+					},
+				},
+			},
+			actions:     []string{"allocate", "consolidation", "reclaim", "preempt"},
+			expectError: false,
+		},
+		{
+			name: "invalid period of unknown action",
+			shard: &kaiv1.SchedulingShard{
+				Spec: kaiv1.SchedulingShardSpec{
+					ActionPeriods: map[string]metav1.Duration{
+						"invalid": {Duration: time.Minute},
+					},
+				},
+			},
+			actions:     []string{"allocate", "preempt", "reclaim"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			innerConfig := conf.SchedulerConfiguration{
+				Actions: strings.Join(tt.actions, ", "),
+			}
+
+			err := validateActionPeriods(tt.shard, innerConfig, tt.actions)
+			if tt.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestBuildArgsList(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -347,6 +397,7 @@ tiers:
   - name: nodeavailability
   - name: resourcetype
   - name: podaffinity
+  - name: preferrednodeaffinity
   - name: elastic
   - name: kubeflow
   - name: ray
@@ -399,6 +450,7 @@ tiers:
   - name: nodeavailability
   - name: resourcetype
   - name: podaffinity
+  - name: preferrednodeaffinity
   - name: elastic
   - name: kubeflow
   - name: ray
@@ -416,6 +468,106 @@ tiers:
       gpu: spread`,
 			},
 		},
+		{
+			name: "auto gpu strategy",
+			config: &kaiv1.Config{
+				Spec: kaiv1.ConfigSpec{
+					Scheduler: &kaiv1scheduler.Scheduler{
+						Replicas: ptr.To(int32(1)),
+					},
+				},
+			},
+			shard: &kaiv1.SchedulingShard{
+				Spec: kaiv1.SchedulingShardSpec{
+					PlacementStrategy: &kaiv1.PlacementStrategy{
+						GPU: ptr.To(autoStrategy),
+						CPU: ptr.To(binpackStrategy),
+						Auto: &kaiv1.AutoPlacementStrategy{
+							FragmentationHigh:     ptr.To(0.6),
+							MinSessionsInStrategy: ptr.To(5),
+						},
+					},
+				},
+			},
+			expected: map[string]string{
+				"config.yaml": `actions: allocate,consolidation,reclaim,preempt,stalegangeviction
+tiers:
+- plugins:
+  - name: predicates
+  - name: proportion
+  - name: priority
+  - name: nodeavailability
+  - name: resourcetype
+  - name: podaffinity
+  - name: preferrednodeaffinity
+  - name: elastic
+  - name: kubeflow
+  - name: ray
+  - name: subgrouporder
+  - name: taskorder
+  - name: nominatednode
+  - name: dynamicresources
+  - name: minruntime
+  - name: topology
+  - name: snapshot
+  - name: gpupack
+  - name: nodeplacement
+    arguments:
+      cpu: binpack
+      gpu: auto
+      gpuAutoFragmentationHigh: "0.6"
+      gpuAutoMinSessionsInStrategy: "5"
+  - name: gpusharingorder`,
+			},
+		},
+		{
+			name: "workload class gpu strategies",
+			config: &kaiv1.Config{
+				Spec: kaiv1.ConfigSpec{
+					Scheduler: &kaiv1scheduler.Scheduler{
+						Replicas: ptr.To(int32(1)),
+					},
+				},
+			},
+			shard: &kaiv1.SchedulingShard{
+				Spec: kaiv1.SchedulingShardSpec{
+					PlacementStrategy: &kaiv1.PlacementStrategy{
+						GPU:             ptr.To(binpackStrategy),
+						CPU:             ptr.To(binpackStrategy),
+						WorkloadClasses: map[string]string{"best-effort-gpu": spreadStrategy},
+					},
+				},
+			},
+			expected: map[string]string{
+				"config.yaml": `actions: allocate,consolidation,reclaim,preempt,stalegangeviction
+tiers:
+- plugins:
+  - name: predicates
+  - name: proportion
+  - name: priority
+  - name: nodeavailability
+  - name: resourcetype
+  - name: podaffinity
+  - name: preferrednodeaffinity
+  - name: elastic
+  - name: kubeflow
+  - name: ray
+  - name: subgrouporder
+  - name: taskorder
+  - name: nominatednode
+  - name: dynamicresources
+  - name: minruntime
+  - name: topology
+  - name: snapshot
+  - name: gpupack
+  - name: nodeplacement
+    arguments:
+      best-effort-gpu: spread
+      cpu: binpack
+      gpu: binpack
+  - name: gpusharingorder`,
+			},
+		},
 		{
 			name: "invalid queue depth configuration",
 			config: &kaiv1.Config{
@@ -462,6 +614,7 @@ tiers:
   - name: nodeavailability
   - name: resourcetype
   - name: podaffinity
+  - name: preferrednodeaffinity
   - name: elastic
   - name: kubeflow
   - name: ray
@@ -692,6 +845,7 @@ tiers:
   - name: nodeavailability
   - name: resourcetype
   - name: podaffinity
+  - name: preferrednodeaffinity
   - name: elastic
   - name: kubeflow
   - name: ray
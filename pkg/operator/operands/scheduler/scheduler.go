@@ -17,6 +17,7 @@ const (
 	configMountPath     = "/etc/config/config.yaml"
 	binpackStrategy     = "binpack"
 	spreadStrategy      = "spread"
+	autoStrategy        = "auto"
 	gpuResource         = "gpu"
 	cpuResource         = "cpu"
 	defaultResourceName = "scheduler"
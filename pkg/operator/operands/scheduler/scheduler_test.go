@@ -146,6 +146,7 @@ tiers:
         - name: nodeavailability
         - name: resourcetype
         - name: podaffinity
+        - name: preferrednodeaffinity
         - name: elastic
         - name: kubeflow
         - name: ray
@@ -185,6 +186,7 @@ tiers:
         - name: nodeavailability
         - name: resourcetype
         - name: podaffinity
+        - name: preferrednodeaffinity
         - name: elastic
         - name: kubeflow
         - name: ray
@@ -0,0 +1,222 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+// Package conditions calculates the lifecycle conditions of a pod group from the state of its pods, the scheduling
+// conditions set by the scheduler and the bind requests handled by the binder.
+package conditions
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+)
+
+const singleSchedulingBackoff = 1
+
+var quotaReasons = []v2alpha2.UnschedulableReason{
+	v2alpha2.OverLimit, v2alpha2.NonPreemptibleOverQuota, v2alpha2.OverPriorityQuotaCap, v2alpha2.OverBudget,
+}
+
+type podGroupState struct {
+	podGroup       *v2alpha2.PodGroup
+	lastCondition  *v2alpha2.SchedulingCondition
+	minMember      int32
+	alivePods      int32
+	scheduledPods  int32
+	boundPods      int32
+	failedBindings []string
+	preemptedPods  []string
+}
+
+// Calculate returns the pod group conditions updated with the current state of the pod group. The given
+// conditions are not modified.
+func Calculate(
+	podGroup *v2alpha2.PodGroup, pods []v1.Pod, bindRequests []v1alpha2.BindRequest, now metav1.Time,
+) []v2alpha2.PodGroupCondition {
+	state := newPodGroupState(podGroup, pods, bindRequests)
+
+	conditions := slices.Clone(podGroup.Status.Conditions)
+	for _, condition := range []v2alpha2.PodGroupCondition{
+		state.admitted(),
+		state.quotaReserved(),
+		state.scheduled(),
+		state.bindCompleted(),
+		state.preempted(),
+		state.backoffWaiting(),
+	} {
+		v2alpha2.SetPodGroupCondition(&conditions, condition, now)
+	}
+	return conditions
+}
+
+func newPodGroupState(
+	podGroup *v2alpha2.PodGroup, pods []v1.Pod, bindRequests []v1alpha2.BindRequest,
+) *podGroupState {
+	state := &podGroupState{
+		podGroup:      podGroup,
+		lastCondition: lastSchedulingCondition(podGroup),
+		minMember:     max(podGroup.Spec.MinMember, 1),
+	}
+
+	bindRequestsByPod := map[string]*v1alpha2.BindRequest{}
+	for i := range bindRequests {
+		bindRequestsByPod[bindRequests[i].Spec.PodName] = &bindRequests[i]
+	}
+
+	for _, pod := range pods {
+		if isPreemptedByScheduler(&pod) {
+			state.preemptedPods = append(state.preemptedPods, pod.Name)
+		}
+		if pod.DeletionTimestamp != nil || pod.Status.Phase == v1.PodFailed || pod.Status.Phase == v1.PodSucceeded {
+			continue
+		}
+		state.alivePods++
+
+		if pod.Spec.NodeName != "" {
+			state.scheduledPods++
+			state.boundPods++
+			continue
+		}
+		bindRequest, found := bindRequestsByPod[pod.Name]
+		if !found {
+			continue
+		}
+		if bindRequest.Status.Phase == v1alpha2.BindRequestPhaseFailed {
+			state.failedBindings = append(state.failedBindings, pod.Name)
+			continue
+		}
+		state.scheduledPods++
+	}
+	return state
+}
+
+func (s *podGroupState) admitted() v2alpha2.PodGroupCondition {
+	if s.alivePods < s.minMember {
+		return condition(v2alpha2.PodGroupAdmitted, v1.ConditionFalse, v2alpha2.PodGroupReasonNotEnoughPods,
+			fmt.Sprintf("%d of the %d minimum pods exist", s.alivePods, s.minMember))
+	}
+	if explanation := s.findUnschedulableReason(v2alpha2.QueueDoesNotExist); explanation != nil {
+		return condition(v2alpha2.PodGroupAdmitted, v1.ConditionFalse, v2alpha2.PodGroupReasonQueueDoesNotExist,
+			explanation.Message)
+	}
+	return condition(v2alpha2.PodGroupAdmitted, v1.ConditionTrue, v2alpha2.PodGroupReasonAdmitted, "")
+}
+
+func (s *podGroupState) quotaReserved() v2alpha2.PodGroupCondition {
+	if s.isScheduled() {
+		return condition(v2alpha2.PodGroupQuotaReserved, v1.ConditionTrue, v2alpha2.PodGroupReasonQuotaReserved, "")
+	}
+	if explanation := s.findUnschedulableReason(quotaReasons...); explanation != nil {
+		return condition(v2alpha2.PodGroupQuotaReserved, v1.ConditionFalse, v2alpha2.PodGroupReasonOverQuota,
+			explanation.Message)
+	}
+	return condition(v2alpha2.PodGroupQuotaReserved, v1.ConditionFalse, v2alpha2.PodGroupReasonPending, "")
+}
+
+func (s *podGroupState) scheduled() v2alpha2.PodGroupCondition {
+	if s.isScheduled() {
+		return condition(v2alpha2.PodGroupScheduled, v1.ConditionTrue, v2alpha2.PodGroupReasonScheduled, "")
+	}
+	if s.isUnschedulable() {
+		return condition(v2alpha2.PodGroupScheduled, v1.ConditionFalse, v2alpha2.PodGroupReasonUnschedulable,
+			s.lastCondition.Message)
+	}
+	return condition(v2alpha2.PodGroupScheduled, v1.ConditionFalse, v2alpha2.PodGroupReasonPending, "")
+}
+
+func (s *podGroupState) bindCompleted() v2alpha2.PodGroupCondition {
+	if s.boundPods >= s.minMember {
+		return condition(v2alpha2.PodGroupBindCompleted, v1.ConditionTrue, v2alpha2.PodGroupReasonBound, "")
+	}
+	if len(s.failedBindings) > 0 {
+		return condition(v2alpha2.PodGroupBindCompleted, v1.ConditionFalse, v2alpha2.PodGroupReasonBindingFailed,
+			fmt.Sprintf("failed to bind pods %v", s.failedBindings))
+	}
+	if s.isScheduled() {
+		return condition(v2alpha2.PodGroupBindCompleted, v1.ConditionFalse, v2alpha2.PodGroupReasonBinding,
+			fmt.Sprintf("%d of the %d minimum pods are bound", s.boundPods, s.minMember))
+	}
+	return condition(v2alpha2.PodGroupBindCompleted, v1.ConditionFalse, v2alpha2.PodGroupReasonPending, "")
+}
+
+func (s *podGroupState) preempted() v2alpha2.PodGroupCondition {
+	if len(s.preemptedPods) > 0 {
+		return condition(v2alpha2.PodGroupPreempted, v1.ConditionTrue, v2alpha2.PodGroupReasonPreemptedByScheduler,
+			fmt.Sprintf("pods %v were preempted by the scheduler", s.preemptedPods))
+	}
+	// The preempted pods are deleted, so the condition is kept until the pod group is scheduled again
+	if !s.isScheduled() && v2alpha2.IsPodGroupConditionTrue(s.podGroup.Status.Conditions, v2alpha2.PodGroupPreempted) {
+		return *v2alpha2.FindPodGroupCondition(s.podGroup.Status.Conditions, v2alpha2.PodGroupPreempted)
+	}
+	return condition(v2alpha2.PodGroupPreempted, v1.ConditionFalse, v2alpha2.PodGroupReasonNotPreempted, "")
+}
+
+func (s *podGroupState) backoffWaiting() v2alpha2.PodGroupCondition {
+	schedulingBackoff := s.podGroup.Spec.SchedulingBackoff
+	if schedulingBackoff != nil && *schedulingBackoff == singleSchedulingBackoff && s.isUnschedulable() {
+		return condition(v2alpha2.PodGroupBackoffWaiting, v1.ConditionTrue, v2alpha2.PodGroupReasonSchedulingBackoff,
+			fmt.Sprintf("unschedulable on node pool %q, waiting for a node pool change", s.lastCondition.NodePool))
+	}
+	return condition(v2alpha2.PodGroupBackoffWaiting, v1.ConditionFalse, v2alpha2.PodGroupReasonNoBackoff, "")
+}
+
+func (s *podGroupState) isScheduled() bool {
+	return s.scheduledPods >= s.minMember
+}
+
+func (s *podGroupState) isUnschedulable() bool {
+	return !s.isScheduled() && s.lastCondition != nil && s.lastCondition.Status == v1.ConditionTrue &&
+		s.lastCondition.Type == v2alpha2.UnschedulableOnNodePool
+}
+
+func (s *podGroupState) findUnschedulableReason(
+	reasons ...v2alpha2.UnschedulableReason,
+) *v2alpha2.UnschedulableExplanation {
+	if !s.isUnschedulable() {
+		return nil
+	}
+	for i, explanation := range s.lastCondition.Reasons {
+		if slices.Contains(reasons, explanation.Reason) {
+			return &s.lastCondition.Reasons[i]
+		}
+	}
+	return nil
+}
+
+func isPreemptedByScheduler(pod *v1.Pod) bool {
+	for _, podCondition := range pod.Status.Conditions {
+		if podCondition.Type == v1.DisruptionTarget && podCondition.Status == v1.ConditionTrue &&
+			podCondition.Reason == v1.PodReasonPreemptionByScheduler {
+			return true
+		}
+	}
+	return false
+}
+
+func lastSchedulingCondition(podGroup *v2alpha2.PodGroup) *v2alpha2.SchedulingCondition {
+	var lastCondition *v2alpha2.SchedulingCondition
+	lastConditionID := -1
+	for i, schedulingCondition := range podGroup.Status.SchedulingConditions {
+		conditionID, err := strconv.Atoi(schedulingCondition.TransitionID)
+		if err != nil {
+			conditionID = -1
+		}
+		if lastCondition == nil || conditionID > lastConditionID {
+			lastCondition = &podGroup.Status.SchedulingConditions[i]
+			lastConditionID = conditionID
+		}
+	}
+	return lastCondition
+}
+
+func condition(
+	conditionType v2alpha2.PodGroupConditionType, status v1.ConditionStatus, reason, message string,
+) v2alpha2.PodGroupCondition {
+	return v2alpha2.PodGroupCondition{Type: conditionType, Status: status, Reason: reason, Message: message}
+}
@@ -0,0 +1,258 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package conditions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+)
+
+type expectedCondition struct {
+	status v1.ConditionStatus
+	reason string
+}
+
+func TestCalculate(t *testing.T) {
+	unschedulable := func(nodePool string, reasons ...v2alpha2.UnschedulableReason) v2alpha2.SchedulingCondition {
+		condition := v2alpha2.SchedulingCondition{
+			Type:         v2alpha2.UnschedulableOnNodePool,
+			NodePool:     nodePool,
+			Reason:       v2alpha2.PodGroupReasonUnschedulable,
+			Message:      "not enough resources",
+			TransitionID: "1",
+			Status:       v1.ConditionTrue,
+		}
+		for _, reason := range reasons {
+			condition.Reasons = append(condition.Reasons,
+				v2alpha2.UnschedulableExplanation{Reason: reason, Message: string(reason)})
+		}
+		return condition
+	}
+
+	tests := []struct {
+		name                 string
+		minMember            int32
+		schedulingBackoff    *int32
+		existingConditions   []v2alpha2.PodGroupCondition
+		schedulingConditions []v2alpha2.SchedulingCondition
+		pods                 []v1.Pod
+		bindRequests         []v1alpha2.BindRequest
+		expected             map[v2alpha2.PodGroupConditionType]expectedCondition
+	}{
+		{
+			name:      "missing pods",
+			minMember: 2,
+			pods:      []v1.Pod{pendingPod("pod-0")},
+			expected: map[v2alpha2.PodGroupConditionType]expectedCondition{
+				v2alpha2.PodGroupAdmitted:      {v1.ConditionFalse, v2alpha2.PodGroupReasonNotEnoughPods},
+				v2alpha2.PodGroupQuotaReserved: {v1.ConditionFalse, v2alpha2.PodGroupReasonPending},
+				v2alpha2.PodGroupScheduled:     {v1.ConditionFalse, v2alpha2.PodGroupReasonPending},
+				v2alpha2.PodGroupBindCompleted: {v1.ConditionFalse, v2alpha2.PodGroupReasonPending},
+				v2alpha2.PodGroupPreempted:     {v1.ConditionFalse, v2alpha2.PodGroupReasonNotPreempted},
+			},
+		},
+		{
+			name:                 "missing queue",
+			minMember:            1,
+			pods:                 []v1.Pod{pendingPod("pod-0")},
+			schedulingConditions: []v2alpha2.SchedulingCondition{unschedulable("default", v2alpha2.QueueDoesNotExist)},
+			expected: map[v2alpha2.PodGroupConditionType]expectedCondition{
+				v2alpha2.PodGroupAdmitted:       {v1.ConditionFalse, v2alpha2.PodGroupReasonQueueDoesNotExist},
+				v2alpha2.PodGroupScheduled:      {v1.ConditionFalse, v2alpha2.PodGroupReasonUnschedulable},
+				v2alpha2.PodGroupBackoffWaiting: {v1.ConditionFalse, v2alpha2.PodGroupReasonNoBackoff},
+			},
+		},
+		{
+			name:                 "over quota",
+			minMember:            1,
+			pods:                 []v1.Pod{pendingPod("pod-0")},
+			schedulingConditions: []v2alpha2.SchedulingCondition{unschedulable("default", v2alpha2.OverLimit)},
+			expected: map[v2alpha2.PodGroupConditionType]expectedCondition{
+				v2alpha2.PodGroupAdmitted:      {v1.ConditionTrue, v2alpha2.PodGroupReasonAdmitted},
+				v2alpha2.PodGroupQuotaReserved: {v1.ConditionFalse, v2alpha2.PodGroupReasonOverQuota},
+				v2alpha2.PodGroupScheduled:     {v1.ConditionFalse, v2alpha2.PodGroupReasonUnschedulable},
+			},
+		},
+		{
+			name:                 "unschedulable with scheduling backoff",
+			minMember:            1,
+			schedulingBackoff:    ptr.To(int32(1)),
+			pods:                 []v1.Pod{pendingPod("pod-0")},
+			schedulingConditions: []v2alpha2.SchedulingCondition{unschedulable("pool-a")},
+			expected: map[v2alpha2.PodGroupConditionType]expectedCondition{
+				v2alpha2.PodGroupQuotaReserved:  {v1.ConditionFalse, v2alpha2.PodGroupReasonPending},
+				v2alpha2.PodGroupScheduled:      {v1.ConditionFalse, v2alpha2.PodGroupReasonUnschedulable},
+				v2alpha2.PodGroupBackoffWaiting: {v1.ConditionTrue, v2alpha2.PodGroupReasonSchedulingBackoff},
+			},
+		},
+		{
+			name:                 "scheduled and binding",
+			minMember:            2,
+			pods:                 []v1.Pod{pendingPod("pod-0"), boundPod("pod-1")},
+			schedulingConditions: []v2alpha2.SchedulingCondition{unschedulable("default")},
+			bindRequests:         []v1alpha2.BindRequest{bindRequest("pod-0", v1alpha2.BindRequestPhasePending)},
+			expected: map[v2alpha2.PodGroupConditionType]expectedCondition{
+				v2alpha2.PodGroupQuotaReserved: {v1.ConditionTrue, v2alpha2.PodGroupReasonQuotaReserved},
+				v2alpha2.PodGroupScheduled:     {v1.ConditionTrue, v2alpha2.PodGroupReasonScheduled},
+				v2alpha2.PodGroupBindCompleted: {v1.ConditionFalse, v2alpha2.PodGroupReasonBinding},
+			},
+		},
+		{
+			name:         "binding failed",
+			minMember:    1,
+			pods:         []v1.Pod{pendingPod("pod-0")},
+			bindRequests: []v1alpha2.BindRequest{bindRequest("pod-0", v1alpha2.BindRequestPhaseFailed)},
+			expected: map[v2alpha2.PodGroupConditionType]expectedCondition{
+				v2alpha2.PodGroupScheduled:     {v1.ConditionFalse, v2alpha2.PodGroupReasonPending},
+				v2alpha2.PodGroupBindCompleted: {v1.ConditionFalse, v2alpha2.PodGroupReasonBindingFailed},
+			},
+		},
+		{
+			name:      "bound",
+			minMember: 2,
+			pods:      []v1.Pod{boundPod("pod-0"), boundPod("pod-1")},
+			expected: map[v2alpha2.PodGroupConditionType]expectedCondition{
+				v2alpha2.PodGroupAdmitted:       {v1.ConditionTrue, v2alpha2.PodGroupReasonAdmitted},
+				v2alpha2.PodGroupQuotaReserved:  {v1.ConditionTrue, v2alpha2.PodGroupReasonQuotaReserved},
+				v2alpha2.PodGroupScheduled:      {v1.ConditionTrue, v2alpha2.PodGroupReasonScheduled},
+				v2alpha2.PodGroupBindCompleted:  {v1.ConditionTrue, v2alpha2.PodGroupReasonBound},
+				v2alpha2.PodGroupPreempted:      {v1.ConditionFalse, v2alpha2.PodGroupReasonNotPreempted},
+				v2alpha2.PodGroupBackoffWaiting: {v1.ConditionFalse, v2alpha2.PodGroupReasonNoBackoff},
+			},
+		},
+		{
+			name:      "preempted pod",
+			minMember: 2,
+			pods:      []v1.Pod{preemptedPod("pod-0"), boundPod("pod-1")},
+			expected: map[v2alpha2.PodGroupConditionType]expectedCondition{
+				v2alpha2.PodGroupScheduled:     {v1.ConditionFalse, v2alpha2.PodGroupReasonPending},
+				v2alpha2.PodGroupBindCompleted: {v1.ConditionFalse, v2alpha2.PodGroupReasonPending},
+				v2alpha2.PodGroupPreempted:     {v1.ConditionTrue, v2alpha2.PodGroupReasonPreemptedByScheduler},
+			},
+		},
+		{
+			name:      "preempted until scheduled again",
+			minMember: 2,
+			pods:      []v1.Pod{pendingPod("pod-0"), boundPod("pod-1")},
+			existingConditions: []v2alpha2.PodGroupCondition{{
+				Type:   v2alpha2.PodGroupPreempted,
+				Status: v1.ConditionTrue,
+				Reason: v2alpha2.PodGroupReasonPreemptedByScheduler,
+			}},
+			expected: map[v2alpha2.PodGroupConditionType]expectedCondition{
+				v2alpha2.PodGroupPreempted: {v1.ConditionTrue, v2alpha2.PodGroupReasonPreemptedByScheduler},
+			},
+		},
+		{
+			name:      "scheduled again after preemption",
+			minMember: 2,
+			pods:      []v1.Pod{boundPod("pod-0"), boundPod("pod-1")},
+			existingConditions: []v2alpha2.PodGroupCondition{{
+				Type:   v2alpha2.PodGroupPreempted,
+				Status: v1.ConditionTrue,
+				Reason: v2alpha2.PodGroupReasonPreemptedByScheduler,
+			}},
+			expected: map[v2alpha2.PodGroupConditionType]expectedCondition{
+				v2alpha2.PodGroupPreempted: {v1.ConditionFalse, v2alpha2.PodGroupReasonNotPreempted},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			podGroup := &v2alpha2.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "pg", Namespace: "ns"},
+				Spec:       v2alpha2.PodGroupSpec{MinMember: tt.minMember, SchedulingBackoff: tt.schedulingBackoff},
+				Status: v2alpha2.PodGroupStatus{
+					Conditions:           tt.existingConditions,
+					SchedulingConditions: tt.schedulingConditions,
+				},
+			}
+			conditions := Calculate(podGroup, tt.pods, tt.bindRequests, metav1.Now())
+
+			assert.Len(t, conditions, 6)
+			for conditionType, expected := range tt.expected {
+				condition := v2alpha2.FindPodGroupCondition(conditions, conditionType)
+				if !assert.NotNil(t, condition, "condition %s", conditionType) {
+					continue
+				}
+				assert.Equal(t, expected.status, condition.Status, "condition %s", conditionType)
+				assert.Equal(t, expected.reason, condition.Reason, "condition %s", conditionType)
+			}
+		})
+	}
+}
+
+func TestCalculateKeepsTransitionTime(t *testing.T) {
+	transitionTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	podGroup := &v2alpha2.PodGroup{
+		Spec: v2alpha2.PodGroupSpec{MinMember: 1},
+		Status: v2alpha2.PodGroupStatus{
+			Conditions: []v2alpha2.PodGroupCondition{
+				{
+					Type:               v2alpha2.PodGroupScheduled,
+					Status:             v1.ConditionTrue,
+					Reason:             v2alpha2.PodGroupReasonScheduled,
+					LastTransitionTime: transitionTime,
+				},
+				{
+					Type:               v2alpha2.PodGroupBindCompleted,
+					Status:             v1.ConditionFalse,
+					Reason:             v2alpha2.PodGroupReasonBinding,
+					LastTransitionTime: transitionTime,
+				},
+			},
+		},
+	}
+	now := metav1.Now()
+	conditions := Calculate(podGroup, []v1.Pod{boundPod("pod-0")}, nil, now)
+
+	scheduled := v2alpha2.FindPodGroupCondition(conditions, v2alpha2.PodGroupScheduled)
+	assert.Equal(t, transitionTime, scheduled.LastTransitionTime)
+	bound := v2alpha2.FindPodGroupCondition(conditions, v2alpha2.PodGroupBindCompleted)
+	assert.Equal(t, now, bound.LastTransitionTime)
+	assert.Equal(t, v2alpha2.PodGroupReasonBinding, podGroup.Status.Conditions[1].Reason,
+		"the pod group conditions should not be modified")
+}
+
+func pendingPod(name string) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+		Status:     v1.PodStatus{Phase: v1.PodPending},
+	}
+}
+
+func boundPod(name string) v1.Pod {
+	pod := pendingPod(name)
+	pod.Spec.NodeName = "node-0"
+	pod.Status.Phase = v1.PodRunning
+	return pod
+}
+
+func preemptedPod(name string) v1.Pod {
+	pod := boundPod(name)
+	pod.DeletionTimestamp = ptr.To(metav1.Now())
+	pod.Status.Conditions = []v1.PodCondition{{
+		Type:   v1.DisruptionTarget,
+		Status: v1.ConditionTrue,
+		Reason: v1.PodReasonPreemptionByScheduler,
+	}}
+	return pod
+}
+
+func bindRequest(podName string, phase string) v1alpha2.BindRequest {
+	return v1alpha2.BindRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: "ns"},
+		Spec:       v1alpha2.BindRequestSpec{PodName: podName},
+		Status:     v1alpha2.BindRequestStatus{Phase: phase},
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+// Package feasibility statically checks whether a pod group can ever be scheduled, by comparing the requests of its
+// pods to the nodes of its node pool and to the limits of its queue hierarchy. The check does not account for the
+// resources used by other workloads, so it only flags pod groups that the scheduler would never place.
+package feasibility
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	commonresources "github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgroupcontroller/controllers/resources"
+)
+
+const (
+	defaultNodePoolName = "default"
+	megabytes           = 1000 * 1000
+)
+
+type Checker struct {
+	client.Client
+	NodePoolLabelKey string
+	// FallbackNodePool is the node pool that pending pod groups are moved to when their node pool has no ready
+	// nodes. Pod groups are not moved if it is empty.
+	FallbackNodePool string
+}
+
+// Check returns the Infeasible condition of the pod group
+func (c *Checker) Check(ctx context.Context, podGroup *v2alpha2.PodGroup, pods []v1.Pod) (
+	v2alpha2.PodGroupCondition, error) {
+	podsRequests, err := getPodsRequests(pods)
+	if err != nil {
+		return v2alpha2.PodGroupCondition{}, err
+	}
+
+	message, err := c.checkNodes(ctx, podGroup, podsRequests)
+	if err != nil {
+		return v2alpha2.PodGroupCondition{}, err
+	}
+	if message != "" {
+		return condition(v1.ConditionTrue, v2alpha2.PodGroupReasonPodExceedsNodes, message), nil
+	}
+
+	message, err = c.checkQueueLimits(ctx, podGroup, podsRequests)
+	if err != nil {
+		return v2alpha2.PodGroupCondition{}, err
+	}
+	if message != "" {
+		return condition(v1.ConditionTrue, v2alpha2.PodGroupReasonExceedsQueueLimit, message), nil
+	}
+
+	return condition(v1.ConditionFalse, v2alpha2.PodGroupReasonFeasible, ""), nil
+}
+
+type podRequests struct {
+	name      string
+	scheduled bool
+	requests  v1.ResourceList
+}
+
+func getPodsRequests(pods []v1.Pod) ([]podRequests, error) {
+	var podsRequests []podRequests
+	for i := range pods {
+		pod := &pods[i]
+		if pod.DeletionTimestamp != nil || pod.Status.Phase == v1.PodFailed || pod.Status.Phase == v1.PodSucceeded {
+			continue
+		}
+		gpuSharingRequests, err := resources.ExtractGPUSharingRequestedResources(pod)
+		if err != nil {
+			return nil, err
+		}
+		requests := commonresources.PodRequests(pod)
+		if gpuFraction, found := gpuSharingRequests[constants.GpuResource]; found {
+			requests[constants.GpuResource] = gpuFraction
+		}
+		podsRequests = append(podsRequests, podRequests{
+			name:      pod.Name,
+			scheduled: pod.Spec.NodeName != "",
+			requests:  requests,
+		})
+	}
+	return podsRequests, nil
+}
+
+// checkNodes returns why a pod of the pod group can't be allocated by any node of its node pool, or an empty message
+// if all the pods fit. Node pools without nodes are not checked, as they might be scaled up.
+func (c *Checker) checkNodes(ctx context.Context, podGroup *v2alpha2.PodGroup, podsRequests []podRequests) (
+	string, error) {
+	nodePool, selector, err := c.nodePoolSelector(podGroup)
+	if err != nil {
+		return "", err
+	}
+	nodes := &v1.NodeList{}
+	if err = c.List(ctx, nodes, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return "", fmt.Errorf("failed to list the nodes of node pool %s: %v", nodePool, err)
+	}
+	if len(nodes.Items) == 0 {
+		return "", nil
+	}
+
+	advertised := map[v1.ResourceName]bool{}
+	for _, node := range nodes.Items {
+		for resourceName := range node.Status.Allocatable {
+			advertised[resourceName] = true
+		}
+	}
+
+	for _, pod := range podsRequests {
+		if pod.scheduled {
+			continue
+		}
+		checked := checkedRequests(pod.requests, advertised)
+		if !slices.ContainsFunc(nodes.Items, func(node v1.Node) bool { return fits(checked, &node) }) {
+			return fmt.Sprintf("pod %s requests more than any node of node pool %s can allocate: %s",
+				pod.name, nodePool, formatRequests(checked)), nil
+		}
+	}
+	return "", nil
+}
+
+func (c *Checker) nodePoolSelector(podGroup *v2alpha2.PodGroup) (string, labels.Selector, error) {
+	if c.NodePoolLabelKey == "" {
+		return defaultNodePoolName, labels.Everything(), nil
+	}
+	nodePool, found := podGroup.Labels[c.NodePoolLabelKey]
+	operator, values := selection.Equals, []string{nodePool}
+	if !found || nodePool == "" {
+		nodePool, operator, values = defaultNodePoolName, selection.DoesNotExist, nil
+	}
+	requirement, err := labels.NewRequirement(c.NodePoolLabelKey, operator, values)
+	if err != nil {
+		return "", nil, err
+	}
+	return nodePool, labels.NewSelector().Add(*requirement), nil
+}
+
+// checkedRequests returns the positive requests of the pod that are compared to the nodes. Accelerators, CPU and
+// memory are always compared, other resources only if some node advertises them, since requests such as GPU memory
+// are not node resources.
+func checkedRequests(requests v1.ResourceList, advertised map[v1.ResourceName]bool) v1.ResourceList {
+	checked := v1.ResourceList{}
+	for resourceName, quantity := range requests {
+		if quantity.Sign() <= 0 {
+			continue
+		}
+		if resourceName == v1.ResourceCPU || resourceName == v1.ResourceMemory ||
+			commonresources.IsAcceleratorResource(resourceName) || advertised[resourceName] {
+			checked[resourceName] = quantity
+		}
+	}
+	return checked
+}
+
+func fits(requests v1.ResourceList, node *v1.Node) bool {
+	for resourceName, quantity := range requests {
+		allocatable, found := node.Status.Allocatable[resourceName]
+		if !found || allocatable.Cmp(quantity) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// queueResource is a resource limited by queues, in the units of the queue limits
+type queueResource struct {
+	name    string
+	request func(requests v1.ResourceList) float64
+	limit   func(queueResources *v2.QueueResources) float64
+}
+
+var queueResources = []queueResource{
+	{
+		name: "gpu",
+		request: func(requests v1.ResourceList) float64 {
+			quantity := commonresources.AcceleratorQuantity(requests)
+			return quantity.AsApproximateFloat64()
+		},
+		limit: func(queueResources *v2.QueueResources) float64 { return queueResources.GPU.Limit },
+	},
+	{
+		name:    "cpu",
+		request: func(requests v1.ResourceList) float64 { return float64(requests.Cpu().MilliValue()) },
+		limit:   func(queueResources *v2.QueueResources) float64 { return queueResources.CPU.Limit },
+	},
+	{
+		name:    "memory",
+		request: func(requests v1.ResourceList) float64 { return requests.Memory().AsApproximateFloat64() / megabytes },
+		limit:   func(queueResources *v2.QueueResources) float64 { return queueResources.Memory.Limit },
+	},
+}
+
+// checkQueueLimits returns why the minimum members of the pod group exceed the limits of its queue or of one of the
+// queue's ancestors, or an empty message if they don't. Only limits with positive values are checked.
+func (c *Checker) checkQueueLimits(ctx context.Context, podGroup *v2alpha2.PodGroup, podsRequests []podRequests) (
+	string, error) {
+	minMembers := int(max(podGroup.Spec.MinMember, 1))
+	minRequests := make([]float64, len(queueResources))
+	for i, queueResource := range queueResources {
+		minRequests[i] = minMembersRequest(podsRequests, minMembers, queueResource.request)
+	}
+
+	visited := map[string]bool{}
+	for queueName := podGroup.Spec.Queue; queueName != "" && !visited[queueName]; {
+		visited[queueName] = true
+		queue := &v2.Queue{}
+		if err := c.Get(ctx, types.NamespacedName{Name: queueName}, queue); err != nil {
+			// A missing queue is reported by the Admitted condition
+			return "", client.IgnoreNotFound(err)
+		}
+		if queue.Spec.Resources != nil {
+			for i, queueResource := range queueResources {
+				limit := queueResource.limit(queue.Spec.Resources)
+				if limit > 0 && minRequests[i] > limit {
+					return fmt.Sprintf("the %d minimum pods request %v %s, above the limit %v of queue %s",
+						minMembers, minRequests[i], queueResource.name, limit, queue.Name), nil
+				}
+			}
+		}
+		queueName = queue.Spec.ParentQueue
+	}
+	return "", nil
+}
+
+// minMembersRequest returns the smallest total request of a resource by the minimum members of the pod group
+func minMembersRequest(podsRequests []podRequests, minMembers int, quantity func(v1.ResourceList) float64) float64 {
+	quantities := make([]float64, 0, len(podsRequests))
+	for _, pod := range podsRequests {
+		quantities = append(quantities, quantity(pod.requests))
+	}
+	sort.Float64s(quantities)
+
+	var total float64
+	for _, q := range quantities[:min(minMembers, len(quantities))] {
+		total += q
+	}
+	return total
+}
+
+func formatRequests(requests v1.ResourceList) string {
+	var formatted []string
+	for resourceName, quantity := range requests {
+		formatted = append(formatted, fmt.Sprintf("%s %s", resourceName, quantity.String()))
+	}
+	sort.Strings(formatted)
+	return strings.Join(formatted, ", ")
+}
+
+func condition(status v1.ConditionStatus, reason, message string) v2alpha2.PodGroupCondition {
+	return v2alpha2.PodGroupCondition{Type: v2alpha2.PodGroupInfeasible, Status: status, Reason: reason,
+		Message: message}
+}
@@ -0,0 +1,249 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package feasibility
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+const nodePoolLabelKey = "kai.scheduler/node-pool"
+
+func newNode(name, nodePool string, cpu, memory, gpus string) *v1.Node {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{}},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse(cpu),
+				v1.ResourceMemory: resource.MustParse(memory),
+			},
+		},
+	}
+	if gpus != "" {
+		node.Status.Allocatable[constants.GpuResource] = resource.MustParse(gpus)
+	}
+	if nodePool != "" {
+		node.Labels[nodePoolLabelKey] = nodePool
+	}
+	return node
+}
+
+func newPod(name string, requests v1.ResourceList, annotations map[string]string) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", Annotations: annotations},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "c", Resources: v1.ResourceRequirements{Requests: requests}}},
+		},
+	}
+}
+
+func newQueue(name, parent string, gpuLimit, cpuLimit float64) *v2.Queue {
+	return &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v2.QueueSpec{
+			ParentQueue: parent,
+			Resources: &v2.QueueResources{
+				GPU:    v2.QueueResource{Limit: gpuLimit},
+				CPU:    v2.QueueResource{Limit: cpuLimit},
+				Memory: v2.QueueResource{Limit: -1},
+			},
+		},
+	}
+}
+
+func newPodGroup(queue, nodePool string, minMember int32) *v2alpha2.PodGroup {
+	podGroup := &v2alpha2.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "pg", Namespace: "ns", Labels: map[string]string{}},
+		Spec:       v2alpha2.PodGroupSpec{Queue: queue, MinMember: minMember},
+	}
+	if nodePool != "" {
+		podGroup.Labels[nodePoolLabelKey] = nodePool
+	}
+	return podGroup
+}
+
+func requests(cpu, memory, gpus string) v1.ResourceList {
+	list := v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse(cpu),
+		v1.ResourceMemory: resource.MustParse(memory),
+	}
+	if gpus != "" {
+		list[constants.GpuResource] = resource.MustParse(gpus)
+	}
+	return list
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name           string
+		objects        []client.Object
+		podGroup       *v2alpha2.PodGroup
+		pods           []v1.Pod
+		expectedStatus v1.ConditionStatus
+		expectedReason string
+	}{
+		{
+			name:           "pods fit a node",
+			objects:        []client.Object{newNode("n1", "", "8", "32Gi", "8")},
+			podGroup:       newPodGroup("", "", 1),
+			pods:           []v1.Pod{newPod("p1", requests("4", "16Gi", "8"), nil)},
+			expectedStatus: v1.ConditionFalse,
+			expectedReason: v2alpha2.PodGroupReasonFeasible,
+		},
+		{
+			name: "pod requests more gpus than any node",
+			objects: []client.Object{
+				newNode("n1", "", "8", "32Gi", "8"),
+				newNode("n2", "", "8", "32Gi", "4"),
+			},
+			podGroup:       newPodGroup("", "", 1),
+			pods:           []v1.Pod{newPod("p1", requests("1", "1Gi", "16"), nil)},
+			expectedStatus: v1.ConditionTrue,
+			expectedReason: v2alpha2.PodGroupReasonPodExceedsNodes,
+		},
+		{
+			name:           "pod requests gpus from nodes without gpus",
+			objects:        []client.Object{newNode("n1", "", "8", "32Gi", "")},
+			podGroup:       newPodGroup("", "", 1),
+			pods:           []v1.Pod{newPod("p1", requests("1", "1Gi", "1"), nil)},
+			expectedStatus: v1.ConditionTrue,
+			expectedReason: v2alpha2.PodGroupReasonPodExceedsNodes,
+		},
+		{
+			name: "pod fits only a node of another node pool",
+			objects: []client.Object{
+				newNode("n1", "pool-a", "8", "32Gi", "8"),
+				newNode("n2", "pool-b", "8", "32Gi", "2"),
+			},
+			podGroup:       newPodGroup("", "pool-b", 1),
+			pods:           []v1.Pod{newPod("p1", requests("1", "1Gi", "4"), nil)},
+			expectedStatus: v1.ConditionTrue,
+			expectedReason: v2alpha2.PodGroupReasonPodExceedsNodes,
+		},
+		{
+			name: "default node pool includes only unlabeled nodes",
+			objects: []client.Object{
+				newNode("n1", "pool-a", "8", "32Gi", "8"),
+				newNode("n2", "", "8", "32Gi", "2"),
+			},
+			podGroup:       newPodGroup("", "", 1),
+			pods:           []v1.Pod{newPod("p1", requests("1", "1Gi", "4"), nil)},
+			expectedStatus: v1.ConditionTrue,
+			expectedReason: v2alpha2.PodGroupReasonPodExceedsNodes,
+		},
+		{
+			name:           "node pool without nodes is not checked",
+			objects:        []client.Object{newNode("n1", "pool-a", "8", "32Gi", "1")},
+			podGroup:       newPodGroup("", "pool-b", 1),
+			pods:           []v1.Pod{newPod("p1", requests("1", "1Gi", "4"), nil)},
+			expectedStatus: v1.ConditionFalse,
+			expectedReason: v2alpha2.PodGroupReasonFeasible,
+		},
+		{
+			name:           "scheduled pods are not checked against nodes",
+			objects:        []client.Object{newNode("n1", "", "8", "32Gi", "1")},
+			podGroup:       newPodGroup("", "", 1),
+			pods:           []v1.Pod{scheduled(newPod("p1", requests("1", "1Gi", "4"), nil))},
+			expectedStatus: v1.ConditionFalse,
+			expectedReason: v2alpha2.PodGroupReasonFeasible,
+		},
+		{
+			name:     "gpu fraction fits a gpu node",
+			objects:  []client.Object{newNode("n1", "", "8", "32Gi", "1")},
+			podGroup: newPodGroup("", "", 1),
+			pods: []v1.Pod{newPod("p1", requests("1", "1Gi", ""),
+				map[string]string{constants.GpuFraction: "0.5"})},
+			expectedStatus: v1.ConditionFalse,
+			expectedReason: v2alpha2.PodGroupReasonFeasible,
+		},
+		{
+			name: "minimum members exceed the queue gpu limit",
+			objects: []client.Object{
+				newNode("n1", "", "8", "32Gi", "8"),
+				newQueue("team", "", 4, -1),
+			},
+			podGroup: newPodGroup("team", "", 2),
+			pods: []v1.Pod{
+				newPod("p1", requests("1", "1Gi", "4"), nil),
+				newPod("p2", requests("1", "1Gi", "4"), nil),
+			},
+			expectedStatus: v1.ConditionTrue,
+			expectedReason: v2alpha2.PodGroupReasonExceedsQueueLimit,
+		},
+		{
+			name: "minimum members exceed the parent queue cpu limit",
+			objects: []client.Object{
+				newNode("n1", "", "8", "32Gi", "8"),
+				newQueue("dept", "", -1, 1000),
+				newQueue("team", "dept", -1, -1),
+			},
+			podGroup: newPodGroup("team", "", 1),
+			pods: []v1.Pod{
+				newPod("p1", requests("2", "1Gi", ""), nil),
+			},
+			expectedStatus: v1.ConditionTrue,
+			expectedReason: v2alpha2.PodGroupReasonExceedsQueueLimit,
+		},
+		{
+			name: "smallest minimum members fit the queue limit",
+			objects: []client.Object{
+				newNode("n1", "", "8", "32Gi", "8"),
+				newQueue("team", "", 4, -1),
+			},
+			podGroup: newPodGroup("team", "", 1),
+			pods: []v1.Pod{
+				newPod("p1", requests("1", "1Gi", "8"), nil),
+				newPod("p2", requests("1", "1Gi", "2"), nil),
+			},
+			expectedStatus: v1.ConditionFalse,
+			expectedReason: v2alpha2.PodGroupReasonFeasible,
+		},
+		{
+			name:           "missing queue is ignored",
+			objects:        []client.Object{newNode("n1", "", "8", "32Gi", "8")},
+			podGroup:       newPodGroup("missing", "", 1),
+			pods:           []v1.Pod{newPod("p1", requests("1", "1Gi", "8"), nil)},
+			expectedStatus: v1.ConditionFalse,
+			expectedReason: v2alpha2.PodGroupReasonFeasible,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			require.NoError(t, v1.AddToScheme(scheme))
+			require.NoError(t, v2.AddToScheme(scheme))
+			checker := &Checker{
+				Client:           fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...).Build(),
+				NodePoolLabelKey: nodePoolLabelKey,
+			}
+
+			condition, err := checker.Check(context.Background(), tt.podGroup, tt.pods)
+			require.NoError(t, err)
+			assert.Equal(t, v2alpha2.PodGroupInfeasible, condition.Type)
+			assert.Equal(t, tt.expectedStatus, condition.Status)
+			assert.Equal(t, tt.expectedReason, condition.Reason)
+			if tt.expectedStatus == v1.ConditionTrue {
+				assert.NotEmpty(t, condition.Message)
+			}
+		})
+	}
+}
+
+func scheduled(pod v1.Pod) v1.Pod {
+	pod.Spec.NodeName = "n1"
+	return pod
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package feasibility
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+// CheckNodePool returns the NodePoolUnavailable condition of the pod group
+func (c *Checker) CheckNodePool(ctx context.Context, podGroup *v2alpha2.PodGroup) (v2alpha2.PodGroupCondition, error) {
+	nodePool, selector, err := c.nodePoolSelector(podGroup)
+	if err != nil {
+		return v2alpha2.PodGroupCondition{}, err
+	}
+	ready, err := c.hasReadyNodes(ctx, nodePool, selector)
+	if err != nil {
+		return v2alpha2.PodGroupCondition{}, err
+	}
+	if !ready {
+		return nodePoolCondition(v1.ConditionTrue, v2alpha2.PodGroupReasonNoReadyNodes,
+			fmt.Sprintf("node pool %s has no ready nodes", nodePool)), nil
+	}
+	if previousNodePool, found := podGroup.Annotations[constants.FallbackFromNodePool]; found {
+		return nodePoolCondition(v1.ConditionFalse, v2alpha2.PodGroupReasonMovedToFallbackNodePool,
+			fmt.Sprintf("moved from node pool %s, which had no ready nodes, to node pool %s",
+				previousNodePool, nodePool)), nil
+	}
+	return nodePoolCondition(v1.ConditionFalse, v2alpha2.PodGroupReasonNodePoolReady, ""), nil
+}
+
+// GetFallbackNodePool returns the node pool of the pod group and the node pool that it should be moved to, which is
+// empty if it should stay in its node pool. A pod group is moved only if its node pool has no ready nodes, none of
+// its pods was scheduled, and the fallback node pool has ready nodes.
+func (c *Checker) GetFallbackNodePool(ctx context.Context, podGroup *v2alpha2.PodGroup, pods []v1.Pod) (
+	string, string, error) {
+	nodePool, selector, err := c.nodePoolSelector(podGroup)
+	if err != nil {
+		return "", "", err
+	}
+	if c.FallbackNodePool == "" || c.NodePoolLabelKey == "" ||
+		podGroup.Labels[c.NodePoolLabelKey] == c.FallbackNodePool ||
+		slices.ContainsFunc(pods, func(pod v1.Pod) bool { return pod.Spec.NodeName != "" }) {
+		return nodePool, "", nil
+	}
+
+	ready, err := c.hasReadyNodes(ctx, nodePool, selector)
+	if err != nil || ready {
+		return nodePool, "", err
+	}
+	requirement, err := labels.NewRequirement(c.NodePoolLabelKey, selection.Equals, []string{c.FallbackNodePool})
+	if err != nil {
+		return nodePool, "", err
+	}
+	ready, err = c.hasReadyNodes(ctx, c.FallbackNodePool, labels.NewSelector().Add(*requirement))
+	if err != nil || !ready {
+		return nodePool, "", err
+	}
+	return nodePool, c.FallbackNodePool, nil
+}
+
+func (c *Checker) hasReadyNodes(ctx context.Context, nodePool string, selector labels.Selector) (bool, error) {
+	nodes := &v1.NodeList{}
+	if err := c.List(ctx, nodes, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return false, fmt.Errorf("failed to list the nodes of node pool %s: %v", nodePool, err)
+	}
+	return slices.ContainsFunc(nodes.Items, isNodeReady), nil
+}
+
+func isNodeReady(node v1.Node) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+	return slices.ContainsFunc(node.Status.Conditions, func(condition v1.NodeCondition) bool {
+		return condition.Type == v1.NodeReady && condition.Status == v1.ConditionTrue
+	})
+}
+
+func nodePoolCondition(status v1.ConditionStatus, reason, message string) v2alpha2.PodGroupCondition {
+	return v2alpha2.PodGroupCondition{Type: v2alpha2.PodGroupNodePoolUnavailable, Status: status, Reason: reason,
+		Message: message}
+}
@@ -0,0 +1,169 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package feasibility
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+func readyNode(name, nodePool string) *v1.Node {
+	node := newNode(name, nodePool, "8", "32Gi", "8")
+	node.Status.Conditions = []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}}
+	return node
+}
+
+func newNodePoolChecker(t *testing.T, fallbackNodePool string, objects ...client.Object) *Checker {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1.AddToScheme(scheme))
+	return &Checker{
+		Client:           fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+		NodePoolLabelKey: nodePoolLabelKey,
+		FallbackNodePool: fallbackNodePool,
+	}
+}
+
+func TestCheckNodePool(t *testing.T) {
+	notReady := readyNode("n2", "pool-a")
+	notReady.Status.Conditions[0].Status = v1.ConditionFalse
+	cordoned := readyNode("n3", "pool-a")
+	cordoned.Spec.Unschedulable = true
+	movedPodGroup := newPodGroup("", "pool-b", 1)
+	movedPodGroup.Annotations = map[string]string{constants.FallbackFromNodePool: "pool-a"}
+
+	tests := []struct {
+		name           string
+		objects        []client.Object
+		podGroup       *v2alpha2.PodGroup
+		expectedStatus v1.ConditionStatus
+		expectedReason string
+	}{
+		{
+			name:           "node pool with a ready node",
+			objects:        []client.Object{readyNode("n1", "pool-a"), notReady},
+			podGroup:       newPodGroup("", "pool-a", 1),
+			expectedStatus: v1.ConditionFalse,
+			expectedReason: v2alpha2.PodGroupReasonNodePoolReady,
+		},
+		{
+			name:           "node pool with only not ready and cordoned nodes",
+			objects:        []client.Object{readyNode("n1", "pool-b"), notReady, cordoned},
+			podGroup:       newPodGroup("", "pool-a", 1),
+			expectedStatus: v1.ConditionTrue,
+			expectedReason: v2alpha2.PodGroupReasonNoReadyNodes,
+		},
+		{
+			name:           "node pool without nodes",
+			objects:        []client.Object{readyNode("n1", "pool-b")},
+			podGroup:       newPodGroup("", "", 1),
+			expectedStatus: v1.ConditionTrue,
+			expectedReason: v2alpha2.PodGroupReasonNoReadyNodes,
+		},
+		{
+			name:           "pod group moved to the fallback node pool",
+			objects:        []client.Object{readyNode("n1", "pool-b")},
+			podGroup:       movedPodGroup,
+			expectedStatus: v1.ConditionFalse,
+			expectedReason: v2alpha2.PodGroupReasonMovedToFallbackNodePool,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := newNodePoolChecker(t, "", tt.objects...)
+
+			condition, err := checker.CheckNodePool(context.Background(), tt.podGroup)
+			require.NoError(t, err)
+			assert.Equal(t, v2alpha2.PodGroupNodePoolUnavailable, condition.Type)
+			assert.Equal(t, tt.expectedStatus, condition.Status)
+			assert.Equal(t, tt.expectedReason, condition.Reason)
+			if tt.expectedStatus == v1.ConditionTrue {
+				assert.NotEmpty(t, condition.Message)
+			}
+		})
+	}
+}
+
+func TestGetFallbackNodePool(t *testing.T) {
+	tests := []struct {
+		name             string
+		fallbackNodePool string
+		objects          []client.Object
+		podGroup         *v2alpha2.PodGroup
+		pods             []v1.Pod
+		expectedNodePool string
+		expectedFallback string
+	}{
+		{
+			name:             "node pool without ready nodes falls back",
+			fallbackNodePool: "pool-b",
+			objects:          []client.Object{readyNode("n1", "pool-b")},
+			podGroup:         newPodGroup("", "pool-a", 1),
+			pods:             []v1.Pod{newPod("p1", requests("1", "1Gi", "1"), nil)},
+			expectedNodePool: "pool-a",
+			expectedFallback: "pool-b",
+		},
+		{
+			name:             "default node pool without ready nodes falls back",
+			fallbackNodePool: "pool-b",
+			objects:          []client.Object{readyNode("n1", "pool-b")},
+			podGroup:         newPodGroup("", "", 1),
+			expectedNodePool: defaultNodePoolName,
+			expectedFallback: "pool-b",
+		},
+		{
+			name:             "node pool with ready nodes",
+			fallbackNodePool: "pool-b",
+			objects:          []client.Object{readyNode("n1", "pool-a"), readyNode("n2", "pool-b")},
+			podGroup:         newPodGroup("", "pool-a", 1),
+			expectedNodePool: "pool-a",
+		},
+		{
+			name:             "fallback disabled",
+			objects:          []client.Object{readyNode("n1", "pool-b")},
+			podGroup:         newPodGroup("", "pool-a", 1),
+			expectedNodePool: "pool-a",
+		},
+		{
+			name:             "fallback node pool without ready nodes",
+			fallbackNodePool: "pool-b",
+			objects:          []client.Object{readyNode("n1", "pool-c")},
+			podGroup:         newPodGroup("", "pool-a", 1),
+			expectedNodePool: "pool-a",
+		},
+		{
+			name:             "pod group in the fallback node pool",
+			fallbackNodePool: "pool-b",
+			podGroup:         newPodGroup("", "pool-b", 1),
+			expectedNodePool: "pool-b",
+		},
+		{
+			name:             "pod group with scheduled pods",
+			fallbackNodePool: "pool-b",
+			objects:          []client.Object{readyNode("n1", "pool-b")},
+			podGroup:         newPodGroup("", "pool-a", 1),
+			pods:             []v1.Pod{scheduled(newPod("p1", requests("1", "1Gi", "1"), nil))},
+			expectedNodePool: "pool-a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := newNodePoolChecker(t, tt.fallbackNodePool, tt.objects...)
+
+			nodePool, fallback, err := checker.GetFallbackNodePool(context.Background(), tt.podGroup, tt.pods)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedNodePool, nodePool)
+			assert.Equal(t, tt.expectedFallback, fallback)
+		})
+	}
+}
@@ -67,10 +67,7 @@ func isPodScheduled(pod *v1.Pod) bool {
 
 func calculatedAllocatedResources(ctx context.Context, pod *v1.Pod, kubeClient client.Client) (
 	v1.ResourceList, error) {
-	allocatedResources := v1.ResourceList{}
-	for _, container := range pod.Spec.Containers {
-		allocatedResources = resources.SumResources(allocatedResources, container.Resources.Requests)
-	}
+	allocatedResources := commonresources.PodRequests(pod)
 
 	gpuSharingReceivedResources, err := resources.ExtractGPUSharingReceivedResources(ctx, pod, kubeClient)
 	if err != nil {
@@ -92,10 +89,7 @@ func calculatedAllocatedResources(ctx context.Context, pod *v1.Pod, kubeClient c
 }
 
 func calculateRequestedResources(ctx context.Context, pod *v1.Pod, kubeClient client.Client) (v1.ResourceList, error) {
-	requestedResources := v1.ResourceList{}
-	for _, container := range pod.Spec.Containers {
-		requestedResources = resources.SumResources(requestedResources, container.Resources.Requests)
-	}
+	requestedResources := commonresources.PodRequests(pod)
 	gpuSharingRequestedResources, err := resources.ExtractGPUSharingRequestedResources(pod)
 	if err != nil {
 		return nil, err
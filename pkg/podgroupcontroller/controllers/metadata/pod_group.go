@@ -6,6 +6,7 @@ package metadata
 import (
 	v1 "k8s.io/api/core/v1"
 
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgroupcontroller/controllers/resources"
 )
 
@@ -19,6 +20,9 @@ type PodGroupMetadata struct {
 	// Current requested GPU (in fracions), CPU (in millicpus) and Memory in megabytes any extra resources in ints
 	// for all resources used or requested by pods of this pod group
 	Requested v1.ResourceList `json:"requested,omitempty"`
+
+	// Lifecycle conditions of the pod group
+	Conditions []v2alpha2.PodGroupCondition `json:"conditions,omitempty"`
 }
 
 func NewPodGroupMetadata() *PodGroupMetadata {
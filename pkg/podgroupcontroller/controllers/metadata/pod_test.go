@@ -4,9 +4,15 @@
 package metadata
 
 import (
+	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 )
 
 func TestIsPodAllocated(t *testing.T) {
@@ -182,3 +188,35 @@ func TestIsActivePod(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPodMetadata(t *testing.T) {
+	requests := func(cpu, memory string) v1.ResourceRequirements {
+		return v1.ResourceRequirements{Requests: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse(cpu),
+			v1.ResourceMemory: resource.MustParse(memory),
+		}}
+	}
+	pod := &v1.Pod{
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{
+				{Name: "setup", Resources: requests("4", "1Gi")},
+				{Name: "sidecar", RestartPolicy: ptr.To(v1.ContainerRestartPolicyAlways), Resources: requests("1", "1Gi")},
+			},
+			Containers: []v1.Container{
+				{Name: "main", Resources: requests("2", "2Gi")},
+			},
+			Overhead: v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m")},
+		},
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}
+
+	podMetadata, err := GetPodMetadata(context.Background(), pod, nil)
+	assert.NoError(t, err)
+
+	expected := v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("4500m"),
+		v1.ResourceMemory: resource.MustParse("3Gi"),
+	}
+	assert.NoError(t, resources.EqualResourceLists(podMetadata.RequestedResources, expected))
+	assert.NoError(t, resources.EqualResourceLists(podMetadata.AllocatedResources, expected))
+}
@@ -45,6 +45,9 @@ func getStatusWithMetadata(
 	if !metaData.Preemptible {
 		updatedStatus.ResourcesStatus.AllocatedNonPreemptible = metaData.Allocated
 	}
+	if metaData.Conditions != nil {
+		updatedStatus.Conditions = metaData.Conditions
+	}
 
 	return updatedStatus
 }
@@ -20,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgroupcontroller/controllers/cluster_relations"
 )
@@ -27,10 +28,19 @@ import (
 const (
 	rateLimiterBaseDelay = time.Second
 	rateLimiterMaxDelay  = time.Minute
+
+	// infeasibleRequeueInterval is how often infeasible pod groups are checked again, as node and queue changes don't
+	// trigger their reconciliation
+	infeasibleRequeueInterval = 5 * time.Minute
+	// nodePoolUnavailableRequeueInterval is how often pod groups whose node pool has no ready nodes are checked
+	// again, as node changes don't trigger their reconciliation
+	nodePoolUnavailableRequeueInterval = time.Minute
 )
 
 type Configs struct {
 	MaxConcurrentReconciles int
+	NodePoolLabelKey        string
+	FallbackNodePool        string
 }
 
 // PodGroupReconciler reconciles a Pod object
@@ -40,13 +50,15 @@ type PodGroupReconciler struct {
 	config Configs
 }
 
-// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;patch
 // +kubebuilder:rbac:groups="",resources=pods/status,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
 // +kubebuilder:rbac:groups="scheduling.k8s.io",resources=priorityclasses,verbs=get;list;watch
-// +kubebuilder:rbac:groups="scheduling.run.ai",resources=podgroups,verbs=get;list;watch
+// +kubebuilder:rbac:groups="scheduling.run.ai",resources=podgroups,verbs=get;list;watch;patch
 // +kubebuilder:rbac:groups="scheduling.run.ai",resources=podgroups/status,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="resource.k8s.io",resources=resourceclaims,verbs=get;list;watch
+// +kubebuilder:rbac:groups="scheduling.run.ai",resources=bindrequests,verbs=get;list;watch
+// +kubebuilder:rbac:groups="scheduling.run.ai",resources=queues,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -85,6 +97,7 @@ func (r *PodGroupReconciler) SetupWithManager(mgr ctrl.Manager, configs Configs,
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v2alpha2.PodGroup{}).
 		Watches(&v1.Pod{}, handler.EnqueueRequestsFromMapFunc(mapPodEventToPodGroup)).
+		Watches(&v1alpha2.BindRequest{}, handler.EnqueueRequestsFromMapFunc(r.mapBindRequestEventToPodGroup)).
 		WithOptions(
 			controller.Options{
 				MaxConcurrentReconciles: r.config.MaxConcurrentReconciles,
@@ -122,3 +135,23 @@ func mapPodEventToPodGroup(ctx context.Context, p client.Object) []reconcile.Req
 		},
 	}
 }
+
+func (r *PodGroupReconciler) mapBindRequestEventToPodGroup(ctx context.Context, obj client.Object) []reconcile.Request {
+	logger := log.FromContext(ctx)
+
+	bindRequest, ok := obj.(*v1alpha2.BindRequest)
+	if !ok {
+		return []reconcile.Request{}
+	}
+
+	logger.V(4).Info("Mapping bind request to pod group")
+	pod := &v1.Pod{}
+	err := r.Client.Get(ctx, types.NamespacedName{Namespace: bindRequest.Namespace, Name: bindRequest.Spec.PodName}, pod)
+	if err != nil {
+		logger.V(4).Info(fmt.Sprintf("cann't get pod of bind request %s/%s: %v",
+			bindRequest.Namespace, bindRequest.Name, err))
+		return []reconcile.Request{}
+	}
+
+	return mapPodEventToPodGroup(ctx, pod)
+}
@@ -300,107 +300,3 @@ func Test_getNodeSingleGpuMemory(t *testing.T) {
 		})
 	}
 }
-
-func Test_getNodeSingleAmdGpuMemory(t *testing.T) {
-	tests := []struct {
-		name    string
-		node    *v1.Node
-		want    float64
-		wantErr bool
-	}{
-		{
-			"Node with memory label",
-			&v1.Node{
-				ObjectMeta: metav1.ObjectMeta{
-					Labels: map[string]string{"beta.amd.com/gpu.vram.16G": "1"},
-				},
-			},
-			16000,
-			false,
-		},
-		{
-			"Node without memory label",
-			&v1.Node{
-				ObjectMeta: metav1.ObjectMeta{
-					Labels: map[string]string{"A": "5000"},
-				},
-			},
-			0,
-			true,
-		},
-		{
-			"Node with invalid memory label",
-			&v1.Node{
-				ObjectMeta: metav1.ObjectMeta{
-					Labels: map[string]string{"beta.amd.com/gpu.vram.16b8": "1"},
-				},
-			},
-			0,
-			true,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := getNodeSingleAmdGpuMemory(tt.node)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("getNodeSingleAmdGpuMemory() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if got != tt.want {
-				t.Errorf("getNodeSingleAmdGpuMemory() got = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
-func Test_getNodeSingleNvidiaGpuMemory(t *testing.T) {
-	tests := []struct {
-		name    string
-		node    *v1.Node
-		want    float64
-		wantErr bool
-	}{
-		{
-			"Node with memory label",
-			&v1.Node{
-				ObjectMeta: metav1.ObjectMeta{
-					Labels: map[string]string{constants.NvidiaGpuMemory: "5000"},
-				},
-			},
-			5000,
-			false,
-		},
-		{
-			"Node without memory label",
-			&v1.Node{
-				ObjectMeta: metav1.ObjectMeta{
-					Labels: map[string]string{"A": "5000"},
-				},
-			},
-			0,
-			true,
-		},
-		{
-			"Node with memory label - invalid value",
-			&v1.Node{
-				ObjectMeta: metav1.ObjectMeta{
-					Labels: map[string]string{constants.NvidiaGpuMemory: "abd"},
-				},
-			},
-			0,
-			true,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := getNodeSingleNvidiaGpuMemory(tt.node)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("getNodeSingleNvidiaGpuMemory() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if got != tt.want {
-				t.Errorf("getNodeSingleNvidiaGpuMemory() got = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
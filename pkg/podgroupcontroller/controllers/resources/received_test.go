@@ -70,6 +70,24 @@ func Test_extractReceivedResources(t *testing.T) {
 			v1.ResourceList{constants.GpuResource: resource.MustParse("0.4")},
 			false,
 		},
+		{
+			"receivedTypeFraction with granted gpu count",
+			args{
+				&v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							receivedResourceTypeAnnotationName: receivedTypeFraction,
+							constants.GpuCountMin:              "4",
+							constants.GpuCountMax:              "8",
+							constants.GpuCountGranted:          "6",
+						},
+					},
+				},
+				&v1.Node{},
+			},
+			v1.ResourceList{constants.GpuResource: resource.MustParse("6")},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
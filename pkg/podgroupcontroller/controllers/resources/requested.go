@@ -19,6 +19,18 @@ const (
 func ExtractGPUSharingRequestedResources(pod *v1.Pod) (v1.ResourceList, error) {
 	resources := v1.ResourceList{}
 
+	gpuCountMinStr, hasAnnotation := pod.Annotations[constants.GpuCountMin]
+	if hasAnnotation {
+		quantity, err := resource.ParseQuantity(gpuCountMinStr)
+		if err != nil {
+			return v1.ResourceList{},
+				fmt.Errorf("failed to parse gpu count min annotation value <%s>, error: %s",
+					gpuCountMinStr, err.Error())
+		}
+		resources[v1.ResourceName(constants.GpuResource)] = quantity
+		return resources, nil
+	}
+
 	fractionsCount := int64(1)
 	gpuFractionsCountStr, hasAnnotation := pod.Annotations[constants.GpuFractionsNumDevices]
 	if hasAnnotation {
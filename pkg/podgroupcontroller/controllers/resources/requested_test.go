@@ -41,6 +41,18 @@ func Test_extractRequestedResources(t *testing.T) {
 			},
 			v1.ResourceList{constants.GpuResource: resource.MustParse("1")},
 		},
+		{
+			"Pod with gpu count range",
+			&v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						constants.GpuCountMin: "4",
+						constants.GpuCountMax: "8",
+					},
+				},
+			},
+			v1.ResourceList{constants.GpuResource: resource.MustParse("4")},
+		},
 		{
 			"Pod with gpu memory",
 			&v1.Pod{
@@ -10,12 +10,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgroupcontroller/controllers/cluster_relations"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgroupcontroller/controllers/conditions"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgroupcontroller/controllers/feasibility"
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgroupcontroller/controllers/metadata"
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgroupcontroller/controllers/patcher"
 	utilities "github.com/NVIDIA/KAI-scheduler/pkg/podgroupcontroller/utilities/pod-group"
@@ -31,6 +36,14 @@ func (r *PodGroupReconciler) handlePodGroupStatus(ctx context.Context, podGroup
 			podGroup.Namespace, podGroup.Name, err)
 	}
 
+	if err = r.assignPodsToSubGroups(ctx, podGroup, &relatedPods); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err = r.moveToFallbackNodePool(ctx, podGroup, relatedPods.Items); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	podGroupMetadata, err := r.calculatePodGroupMetadata(ctx, podGroup, relatedPods)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -41,9 +54,41 @@ func (r *PodGroupReconciler) handlePodGroupStatus(ctx context.Context, podGroup
 		logger.Error(err, fmt.Sprintf("Failed to update podgroup %s/%s with metadata",
 			podGroup.Namespace, podGroup.Name))
 	}
+	if v2alpha2.IsPodGroupConditionTrue(podGroupMetadata.Conditions, v2alpha2.PodGroupNodePoolUnavailable) {
+		return ctrl.Result{RequeueAfter: nodePoolUnavailableRequeueInterval}, err
+	}
+	if v2alpha2.IsPodGroupConditionTrue(podGroupMetadata.Conditions, v2alpha2.PodGroupInfeasible) {
+		return ctrl.Result{RequeueAfter: infeasibleRequeueInterval}, err
+	}
 	return ctrl.Result{}, err
 }
 
+// moveToFallbackNodePool labels the pod group with the fallback node pool if its node pool has no ready nodes, and
+// records the node pool it was moved from in its annotations
+func (r *PodGroupReconciler) moveToFallbackNodePool(ctx context.Context, podGroup *v2alpha2.PodGroup,
+	pods []v1.Pod) error {
+	nodePool, fallbackNodePool, err := r.feasibilityChecker().GetFallbackNodePool(ctx, podGroup, pods)
+	if err != nil || fallbackNodePool == "" {
+		return err
+	}
+
+	original := podGroup.DeepCopy()
+	metav1.SetMetaDataLabel(&podGroup.ObjectMeta, r.config.NodePoolLabelKey, fallbackNodePool)
+	metav1.SetMetaDataAnnotation(&podGroup.ObjectMeta, constants.FallbackFromNodePool, nodePool)
+	if err = r.Client.Patch(ctx, podGroup, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to move pod-group %s/%s to the fallback node pool %s: %w",
+			podGroup.Namespace, podGroup.Name, fallbackNodePool, err)
+	}
+	log.FromContext(ctx).Info(fmt.Sprintf("Moved pod-group %s/%s from node pool %s, which has no ready nodes, "+
+		"to node pool %s", podGroup.Namespace, podGroup.Name, nodePool, fallbackNodePool))
+	return nil
+}
+
+func (r *PodGroupReconciler) feasibilityChecker() *feasibility.Checker {
+	return &feasibility.Checker{Client: r.Client, NodePoolLabelKey: r.config.NodePoolLabelKey,
+		FallbackNodePool: r.config.FallbackNodePool}
+}
+
 func (r *PodGroupReconciler) updateStatusIfNecessary(
 	ctx context.Context, podGroup *v2alpha2.PodGroup, podGroupMetadata *metadata.PodGroupMetadata,
 ) error {
@@ -78,6 +123,33 @@ func (r *PodGroupReconciler) calculatePodGroupMetadata(
 			return nil, err
 		}
 	}
+
+	bindRequests := v1alpha2.BindRequestList{}
+	if err = r.Client.List(ctx, &bindRequests, client.InNamespace(podGroup.Namespace)); err != nil {
+		logger.Error(err, fmt.Sprintf("Failed to list bind requests for pod-group %s/%s",
+			podGroup.Namespace, podGroup.Name))
+		return nil, err
+	}
+	now := metav1.Now()
+	podGroupMetadata.Conditions = conditions.Calculate(podGroup, relatedPods.Items, bindRequests.Items, now)
+
+	feasibilityChecker := r.feasibilityChecker()
+	infeasibleCondition, err := feasibilityChecker.Check(ctx, podGroup, relatedPods.Items)
+	if err != nil {
+		logger.Error(err, fmt.Sprintf("Failed to check the feasibility of pod-group %s/%s",
+			podGroup.Namespace, podGroup.Name))
+		return nil, err
+	}
+	v2alpha2.SetPodGroupCondition(&podGroupMetadata.Conditions, infeasibleCondition, now)
+
+	nodePoolCondition, err := feasibilityChecker.CheckNodePool(ctx, podGroup)
+	if err != nil {
+		logger.Error(err, fmt.Sprintf("Failed to check the node pool of pod-group %s/%s",
+			podGroup.Namespace, podGroup.Name))
+		return nil, err
+	}
+	v2alpha2.SetPodGroupCondition(&podGroupMetadata.Conditions, nodePoolCondition, now)
+
 	logger.V(3).Info(fmt.Sprintf("Pod-group calculated metadata %v", podGroupMetadata))
 	return podGroupMetadata, nil
 }
@@ -17,7 +17,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgroupcontroller/controllers/cluster_relations"
 )
@@ -768,6 +770,17 @@ func Test_handlePodGroupStatus(t *testing.T) {
 				return
 			}
 
+			// The lifecycle conditions are covered by the conditions package tests
+			for _, conditionType := range []v2alpha2.PodGroupConditionType{
+				v2alpha2.PodGroupAdmitted, v2alpha2.PodGroupQuotaReserved, v2alpha2.PodGroupScheduled,
+				v2alpha2.PodGroupBindCompleted, v2alpha2.PodGroupPreempted, v2alpha2.PodGroupBackoffWaiting,
+			} {
+				if v2alpha2.FindPodGroupCondition(updatedPodGroup.Status.Conditions, conditionType) == nil {
+					t.Errorf("handlePodGroupStatus() condition %s was not set", conditionType)
+				}
+			}
+			updatedPodGroup.Status.Conditions = nil
+
 			if !reflect.DeepEqual(updatedPodGroup.Status, tt.expectedChangedStatus) {
 				t.Errorf("handlePodGroupStatus() got = %v, want %v",
 					updatedPodGroup.Status, tt.expectedChangedStatus)
@@ -776,6 +789,67 @@ func Test_handlePodGroupStatus(t *testing.T) {
 	}
 }
 
+func Test_handlePodGroupStatus_fallbackNodePool(t *testing.T) {
+	const nodePoolLabelKey = "kai.scheduler/node-pool"
+	podGroup := &v2alpha2.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "n1",
+			Name:      "pg1",
+			Labels:    map[string]string{nodePoolLabelKey: "pool-a"},
+		},
+	}
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "n1",
+			Name:        "pod1",
+			Annotations: map[string]string{"pod-group-name": "pg1"},
+		},
+		Status: v1.PodStatus{Phase: v1.PodPending},
+	}
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: map[string]string{nodePoolLabelKey: "pool-b"}},
+		Status: v1.NodeStatus{
+			Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}},
+		},
+	}
+	kubeClient := fake.NewClientBuilder().WithScheme(createScheme(t)).WithStatusSubresource(&v2alpha2.PodGroup{}).
+		WithIndex(&v1.Pod{}, cluster_relations.PodGroupToPodsIndexer, cluster_relations.PodGroupNameIndexerFunc).
+		WithObjects(podGroup, pod, node).Build()
+	podReconciler := &PodGroupReconciler{
+		Client: kubeClient,
+		config: Configs{NodePoolLabelKey: nodePoolLabelKey, FallbackNodePool: "pool-b"},
+	}
+
+	key := types.NamespacedName{Namespace: "n1", Name: "pg1"}
+	originalPodGroup := v2alpha2.PodGroup{}
+	if err := kubeClient.Get(context.TODO(), key, &originalPodGroup); err != nil {
+		t.Fatal(err)
+	}
+	result, err := podReconciler.handlePodGroupStatus(context.TODO(), &originalPodGroup)
+	if err != nil {
+		t.Fatalf("handlePodGroupStatus() error = %v", err)
+	}
+	if result.RequeueAfter != 0 {
+		t.Errorf("handlePodGroupStatus() requeued after %v", result.RequeueAfter)
+	}
+
+	updatedPodGroup := v2alpha2.PodGroup{}
+	if err = kubeClient.Get(context.TODO(), key, &updatedPodGroup); err != nil {
+		t.Fatal(err)
+	}
+	if nodePool := updatedPodGroup.Labels[nodePoolLabelKey]; nodePool != "pool-b" {
+		t.Errorf("handlePodGroupStatus() moved the pod-group to node pool %s, want pool-b", nodePool)
+	}
+	if previous := updatedPodGroup.Annotations[constants.FallbackFromNodePool]; previous != "pool-a" {
+		t.Errorf("handlePodGroupStatus() recorded the previous node pool %s, want pool-a", previous)
+	}
+	condition := v2alpha2.FindPodGroupCondition(updatedPodGroup.Status.Conditions, v2alpha2.PodGroupNodePoolUnavailable)
+	if condition == nil || condition.Status != v1.ConditionFalse ||
+		condition.Reason != v2alpha2.PodGroupReasonMovedToFallbackNodePool {
+		t.Errorf("handlePodGroupStatus() set the %s condition %v", v2alpha2.PodGroupNodePoolUnavailable, condition)
+	}
+}
+
 func createScheme(t *testing.T) *runtime.Scheme {
 	scheme := runtime.NewScheme()
 	err := v1.AddToScheme(scheme)
@@ -790,5 +864,9 @@ func createScheme(t *testing.T) *runtime.Scheme {
 	if err != nil {
 		t.Fatal(err)
 	}
+	err = v1alpha2.AddToScheme(scheme)
+	if err != nil {
+		t.Fatal(err)
+	}
 	return scheme
 }
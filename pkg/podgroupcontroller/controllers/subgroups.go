@@ -0,0 +1,86 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+type subGroupSelector struct {
+	name     string
+	selector labels.Selector
+}
+
+// assignPodsToSubGroups labels the pods of the podgroup that don't declare their subgroup with the first subgroup
+// whose pod selector matches them. The scheduler ignores the pods of a podgroup with subgroups until they are
+// assigned to one of its subgroups.
+func (r *PodGroupReconciler) assignPodsToSubGroups(
+	ctx context.Context, podGroup *v2alpha2.PodGroup, relatedPods *v1.PodList,
+) error {
+	selectors, err := subGroupSelectors(podGroup)
+	if err != nil || len(selectors) == 0 {
+		return err
+	}
+
+	logger := log.FromContext(ctx)
+	for i := range relatedPods.Items {
+		pod := &relatedPods.Items[i]
+		if _, found := pod.Labels[constants.SubGroupLabelKey]; found {
+			continue
+		}
+
+		subGroupName, found := matchSubGroup(selectors, pod)
+		if !found {
+			continue
+		}
+
+		originalPod := pod.DeepCopy()
+		if pod.Labels == nil {
+			pod.Labels = map[string]string{}
+		}
+		pod.Labels[constants.SubGroupLabelKey] = subGroupName
+		if err = r.Client.Patch(ctx, pod, client.MergeFrom(originalPod)); err != nil {
+			return fmt.Errorf("failed to assign pod %s/%s to subgroup %s of podgroup %s/%s: %w",
+				pod.Namespace, pod.Name, subGroupName, podGroup.Namespace, podGroup.Name, err)
+		}
+		logger.V(2).Info(fmt.Sprintf("Assigned pod %s/%s to subgroup %s of podgroup %s/%s",
+			pod.Namespace, pod.Name, subGroupName, podGroup.Namespace, podGroup.Name))
+	}
+	return nil
+}
+
+func subGroupSelectors(podGroup *v2alpha2.PodGroup) ([]subGroupSelector, error) {
+	var selectors []subGroupSelector
+	for _, subGroup := range podGroup.Spec.SubGroups {
+		if subGroup.PodSelector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(subGroup.PodSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pod selector of subgroup %s of podgroup %s/%s: %w",
+				subGroup.Name, podGroup.Namespace, podGroup.Name, err)
+		}
+		selectors = append(selectors, subGroupSelector{name: subGroup.Name, selector: selector})
+	}
+	return selectors, nil
+}
+
+func matchSubGroup(selectors []subGroupSelector, pod *v1.Pod) (string, bool) {
+	for _, subGroupSelector := range selectors {
+		if subGroupSelector.selector.Matches(labels.Set(pod.Labels)) {
+			return subGroupSelector.name, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+func Test_assignPodsToSubGroups(t *testing.T) {
+	podGroup := &v2alpha2.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "n1", Name: "pg1"},
+		Spec: v2alpha2.PodGroupSpec{
+			SubGroups: []v2alpha2.SubGroup{
+				{Name: "leaders", MinMember: 1,
+					PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"role": "leader"}}},
+				{Name: "workers", MinMember: 2, PodSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "role", Operator: metav1.LabelSelectorOpIn, Values: []string{"worker", "leader"}},
+					},
+				}},
+				{Name: "others", MinMember: 1},
+			},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		podLabels        map[string]string
+		expectedSubGroup string
+	}{
+		{
+			name:             "matching a single subgroup",
+			podLabels:        map[string]string{"role": "worker"},
+			expectedSubGroup: "workers",
+		},
+		{
+			name:             "matching several subgroups is assigned to the first",
+			podLabels:        map[string]string{"role": "leader"},
+			expectedSubGroup: "leaders",
+		},
+		{
+			name:             "declared subgroup is kept",
+			podLabels:        map[string]string{"role": "worker", constants.SubGroupLabelKey: "others"},
+			expectedSubGroup: "others",
+		},
+		{
+			name:      "not matching any subgroup",
+			podLabels: map[string]string{"role": "evaluator"},
+		},
+		{
+			name: "without labels",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "n1",
+					Name:        "pod1",
+					Labels:      tt.podLabels,
+					Annotations: map[string]string{"pod-group-name": "pg1"},
+				},
+			}
+			kubeClient := fake.NewClientBuilder().WithScheme(createScheme(t)).WithObjects(pod, podGroup).Build()
+			podReconciler := &PodGroupReconciler{Client: kubeClient}
+
+			relatedPods := v1.PodList{Items: []v1.Pod{*pod}}
+			if err := podReconciler.assignPodsToSubGroups(context.TODO(), podGroup, &relatedPods); err != nil {
+				t.Fatalf("assignPodsToSubGroups() error = %v", err)
+			}
+
+			updatedPod := &v1.Pod{}
+			if err := kubeClient.Get(context.TODO(), types.NamespacedName{Namespace: "n1", Name: "pod1"},
+				updatedPod); err != nil {
+				t.Fatalf("failed to get pod: %v", err)
+			}
+			if subGroup := updatedPod.Labels[constants.SubGroupLabelKey]; subGroup != tt.expectedSubGroup {
+				t.Errorf("assignPodsToSubGroups() assigned subgroup %q, want %q", subGroup, tt.expectedSubGroup)
+			}
+			if subGroup := relatedPods.Items[0].Labels[constants.SubGroupLabelKey]; subGroup != tt.expectedSubGroup {
+				t.Errorf("assignPodsToSubGroups() related pod subgroup %q, want %q", subGroup, tt.expectedSubGroup)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	schedulingv2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+// isBarePodGangMember returns whether the pod has no owner and asks to be gang scheduled with the other bare pods
+// that have the same pod group name.
+func isBarePodGangMember(pod *v1.Pod) bool {
+	_, foundMinMember := pod.Annotations[constants.MinMemberAnnotationForPod]
+	return isOrphanPodWithPodGroup(pod) && foundMinMember
+}
+
+// reconcileBarePodGang creates or updates the pod group shared by the bare pods of a gang. Every pod of the gang is
+// an owner of the pod group, so it is garbage collected once all of them are deleted. It is deleted earlier, once
+// all the pods of the gang have finished.
+func (r *PodReconciler) reconcileBarePodGang(ctx context.Context, pod *v1.Pod) error {
+	podGroupName := pod.Annotations[constants.PodGroupAnnotationForPod]
+	if isPodFinished(pod) {
+		return r.deleteBarePodGangIfFinished(ctx, pod.Namespace, podGroupName)
+	}
+
+	minMember, err := strconv.ParseInt(pod.Annotations[constants.MinMemberAnnotationForPod], 10, 32)
+	if err != nil || minMember < 1 {
+		return fmt.Errorf("invalid %s annotation <%s> on pod %s/%s, expected a positive integer",
+			constants.MinMemberAnnotationForPod, pod.Annotations[constants.MinMemberAnnotationForPod],
+			pod.Namespace, pod.Name)
+	}
+
+	topOwner, allOwners, err := r.podGrouper.GetPodOwners(ctx, pod)
+	if err != nil {
+		return err
+	}
+	metadata, err := r.podGrouper.GetPGMetadata(ctx, pod, topOwner, allOwners)
+	if err != nil {
+		return err
+	}
+
+	metadata.Name = podGroupName
+	metadata.MinAvailable = int32(minMember)
+	metadata.Owner = metav1.OwnerReference{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Name:       pod.Name,
+		UID:        pod.UID,
+		Controller: ptr.To(false),
+	}
+	metadata.SharedByOwners = true
+	if len(r.configs.NodePoolLabelKey) > 0 {
+		addNodePoolLabel(metadata, pod, r.configs.NodePoolLabelKey)
+	}
+
+	return r.PodGroupHandler.ApplyToCluster(ctx, *metadata)
+}
+
+func (r *PodReconciler) deleteBarePodGangIfFinished(ctx context.Context, namespace, podGroupName string) error {
+	pods := &v1.PodList{}
+	if err := r.Client.List(ctx, pods, client.InNamespace(namespace)); err != nil {
+		return err
+	}
+	for _, pod := range pods.Items {
+		if isBarePodGangMember(&pod) && pod.Annotations[constants.PodGroupAnnotationForPod] == podGroupName &&
+			!isPodFinished(&pod) {
+			return nil
+		}
+	}
+
+	podGroup := &schedulingv2alpha2.PodGroup{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: podGroupName}, podGroup); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !isOwnedByPodsOnly(podGroup) {
+		return nil
+	}
+
+	log.FromContext(ctx).V(1).Info("All the pods of the gang have finished, deleting their pod group",
+		"podGroup", fmt.Sprintf("%s/%s", namespace, podGroupName))
+	return client.IgnoreNotFound(r.Client.Delete(ctx, podGroup))
+}
+
+// isOwnedByPodsOnly returns whether the pod group was created for a gang of bare pods, so that pod groups created
+// by users for bare pods are never deleted.
+func isOwnedByPodsOnly(podGroup *schedulingv2alpha2.PodGroup) bool {
+	if len(podGroup.OwnerReferences) == 0 {
+		return false
+	}
+	for _, owner := range podGroup.OwnerReferences {
+		if owner.APIVersion != "v1" || owner.Kind != "Pod" {
+			return false
+		}
+	}
+	return true
+}
+
+func isPodFinished(pod *v1.Pod) bool {
+	return pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed
+}
@@ -0,0 +1,227 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// coschedulingPodGroupLabel is the label with which pods join a PodGroup of the scheduler-plugins coscheduling
+	// plugin
+	coschedulingPodGroupLabel = "scheduling.x-k8s.io/pod-group"
+	// legacyCoschedulingPodGroupLabel is the label used by releases of the coscheduling plugin that served the
+	// scheduling.sigs.k8s.io API group
+	legacyCoschedulingPodGroupLabel = "pod-group.scheduling.sigs.k8s.io"
+
+	coschedulingControllerName = "coscheduling-pod-group"
+
+	coschedulingPhasePending    = "Pending"
+	coschedulingPhaseScheduling = "Scheduling"
+	coschedulingPhaseScheduled  = "Scheduled"
+	coschedulingPhaseRunning    = "Running"
+	coschedulingPhaseFinished   = "Finished"
+	coschedulingPhaseFailed     = "Failed"
+)
+
+var coschedulingPodGroupGVK = schema.GroupVersionKind{
+	Group:   "scheduling.x-k8s.io",
+	Version: "v1alpha1",
+	Kind:    "PodGroup",
+}
+
+// coschedulingPodGroupStatus is the part of the status of a coscheduling PodGroup that is kept in sync with its pods
+type coschedulingPodGroupStatus struct {
+	Phase     string
+	Scheduled int64
+	Running   int64
+	Succeeded int64
+	Failed    int64
+}
+
+func newCoschedulingPodGroup() *unstructured.Unstructured {
+	podGroup := &unstructured.Unstructured{}
+	podGroup.SetGroupVersionKind(coschedulingPodGroupGVK)
+	return podGroup
+}
+
+// getCoschedulingPodGroupName returns the name of the coscheduling PodGroup that the pod is a member of
+func getCoschedulingPodGroupName(pod *v1.Pod) (string, bool) {
+	for _, label := range []string{coschedulingPodGroupLabel, legacyCoschedulingPodGroupLabel} {
+		if name := pod.Labels[label]; name != "" {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// +kubebuilder:rbac:groups="scheduling.x-k8s.io",resources=podgroups,verbs=get;list;watch
+
+// reconcileCoschedulingPodGroupMember creates or updates the pod group of a pod that is a member of a coscheduling
+// PodGroup. The pod group has the name and min members of the coscheduling PodGroup, which owns it, so pods keep
+// being gang scheduled together regardless of their owners.
+func (r *PodReconciler) reconcileCoschedulingPodGroupMember(ctx context.Context, pod *v1.Pod, podGroupName string) error {
+	coschedulingPodGroup := newCoschedulingPodGroup()
+	err := r.Client.Get(ctx, client.ObjectKey{Namespace: pod.Namespace, Name: podGroupName}, coschedulingPodGroup)
+	if err != nil {
+		return fmt.Errorf("failed to get coscheduling PodGroup %s/%s of pod %s: %w",
+			pod.Namespace, podGroupName, pod.Name, err)
+	}
+	minMember, _, err := unstructured.NestedInt64(coschedulingPodGroup.Object, "spec", "minMember")
+	if err != nil {
+		return fmt.Errorf("invalid minMember in coscheduling PodGroup %s/%s: %w", pod.Namespace, podGroupName, err)
+	}
+
+	topOwner, allOwners, err := r.podGrouper.GetPodOwners(ctx, pod)
+	if err != nil {
+		return err
+	}
+	metadata, err := r.podGrouper.GetPGMetadata(ctx, pod, topOwner, allOwners)
+	if err != nil {
+		return err
+	}
+
+	metadata.Name = podGroupName
+	metadata.MinAvailable = max(int32(minMember), 1)
+	metadata.SubGroups = nil
+	metadata.Owner = metav1.OwnerReference{
+		APIVersion: coschedulingPodGroup.GetAPIVersion(),
+		Kind:       coschedulingPodGroup.GetKind(),
+		Name:       coschedulingPodGroup.GetName(),
+		UID:        coschedulingPodGroup.GetUID(),
+	}
+	if queue, found := coschedulingPodGroup.GetLabels()[r.configs.SchedulingQueueLabelKey]; found {
+		metadata.Queue = queue
+	}
+	if len(r.configs.NodePoolLabelKey) > 0 {
+		addNodePoolLabel(metadata, pod, r.configs.NodePoolLabelKey)
+	}
+
+	if err = r.PodGroupHandler.ApplyToCluster(ctx, *metadata); err != nil {
+		return err
+	}
+	return r.assignPodToGroupAndSubGroup(ctx, pod, metadata)
+}
+
+// CoschedulingPodGroupReconciler keeps the status of scheduler-plugins coscheduling PodGroups in sync with their
+// pods, for tools and operators that wait on the coscheduling PodGroups of workloads scheduled by KAI.
+type CoschedulingPodGroupReconciler struct {
+	client.Client
+}
+
+// +kubebuilder:rbac:groups="scheduling.x-k8s.io",resources=podgroups/status,verbs=get;update;patch
+
+func (r *CoschedulingPodGroupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	podGroup := newCoschedulingPodGroup()
+	if err := r.Client.Get(ctx, req.NamespacedName, podGroup); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	minMember, _, err := unstructured.NestedInt64(podGroup.Object, "spec", "minMember")
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	pods := &v1.PodList{}
+	if err = r.Client.List(ctx, pods, client.InNamespace(req.Namespace)); err != nil {
+		return ctrl.Result{}, err
+	}
+	var members []*v1.Pod
+	for i, pod := range pods.Items {
+		if name, found := getCoschedulingPodGroupName(&pod); found && name == req.Name {
+			members = append(members, &pods.Items[i])
+		}
+	}
+
+	status := getCoschedulingPodGroupStatus(minMember, members)
+	original := podGroup.DeepCopy()
+	for field, value := range map[string]interface{}{
+		"phase":     status.Phase,
+		"scheduled": status.Scheduled,
+		"running":   status.Running,
+		"succeeded": status.Succeeded,
+		"failed":    status.Failed,
+	} {
+		if err = unstructured.SetNestedField(podGroup.Object, value, "status", field); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if equality.Semantic.DeepEqual(original.Object["status"], podGroup.Object["status"]) {
+		return ctrl.Result{}, nil
+	}
+
+	log.FromContext(ctx).V(1).Info("Updating coscheduling PodGroup status",
+		"podGroup", req.NamespacedName, "phase", status.Phase)
+	return ctrl.Result{}, r.Client.Status().Patch(ctx, podGroup, client.MergeFrom(original))
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CoschedulingPodGroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(coschedulingControllerName).
+		For(newCoschedulingPodGroup()).
+		Watches(&v1.Pod{}, handler.EnqueueRequestsFromMapFunc(podToCoschedulingPodGroup)).
+		Complete(r)
+}
+
+func podToCoschedulingPodGroup(_ context.Context, obj client.Object) []reconcile.Request {
+	pod, ok := obj.(*v1.Pod)
+	if !ok {
+		return nil
+	}
+	name, found := getCoschedulingPodGroupName(pod)
+	if !found {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: pod.Namespace, Name: name}}}
+}
+
+// getCoschedulingPodGroupStatus counts the pods of a coscheduling PodGroup by their state, and sets its phase the way
+// the coscheduling plugin does: the PodGroup is pending until it has min member pods, scheduling until min member
+// pods are bound to nodes, and running, finished or failed by the pods that run or completed.
+func getCoschedulingPodGroupStatus(minMember int64, pods []*v1.Pod) coschedulingPodGroupStatus {
+	minMember = max(minMember, 1)
+	status := coschedulingPodGroupStatus{}
+	for _, pod := range pods {
+		if pod.Spec.NodeName != "" {
+			status.Scheduled++
+		}
+		switch pod.Status.Phase {
+		case v1.PodRunning:
+			status.Running++
+		case v1.PodSucceeded:
+			status.Succeeded++
+		case v1.PodFailed:
+			status.Failed++
+		}
+	}
+
+	switch {
+	case int64(len(pods)) < minMember:
+		status.Phase = coschedulingPhasePending
+	case status.Succeeded >= minMember:
+		status.Phase = coschedulingPhaseFinished
+	case status.Failed > 0 && status.Failed+status.Running+status.Succeeded >= minMember:
+		status.Phase = coschedulingPhaseFailed
+	case status.Running+status.Succeeded >= minMember:
+		status.Phase = coschedulingPhaseRunning
+	case status.Scheduled >= minMember:
+		status.Phase = coschedulingPhaseScheduled
+	default:
+		status.Phase = coschedulingPhaseScheduling
+	}
+	return status
+}
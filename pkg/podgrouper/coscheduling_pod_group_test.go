@@ -0,0 +1,219 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	schedulingv2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgroup"
+)
+
+func newCoschedulingTestScheme(t *testing.T) *runtime.Scheme {
+	testScheme := runtime.NewScheme()
+	assert.NoError(t, v1.AddToScheme(testScheme))
+	assert.NoError(t, schedulingv2alpha2.AddToScheme(testScheme))
+	testScheme.AddKnownTypeWithName(coschedulingPodGroupGVK, &unstructured.Unstructured{})
+	listGVK := coschedulingPodGroupGVK
+	listGVK.Kind += "List"
+	testScheme.AddKnownTypeWithName(listGVK, &unstructured.UnstructuredList{})
+	return testScheme
+}
+
+func newTestCoschedulingPodGroup(name string, minMember int64, labels map[string]string) *unstructured.Unstructured {
+	podGroup := newCoschedulingPodGroup()
+	podGroup.SetNamespace("test-ns")
+	podGroup.SetName(name)
+	podGroup.SetUID(types.UID(name + "-uid"))
+	podGroup.SetLabels(labels)
+	_ = unstructured.SetNestedField(podGroup.Object, minMember, "spec", "minMember")
+	return podGroup
+}
+
+func newCoschedulingMember(name, label, podGroupName string, phase v1.PodPhase, nodeName string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test-ns",
+			UID:       types.UID(name + "-uid"),
+			Labels:    map[string]string{label: podGroupName},
+		},
+		Spec:   v1.PodSpec{SchedulerName: "kai-scheduler", NodeName: nodeName},
+		Status: v1.PodStatus{Phase: phase},
+	}
+}
+
+func TestReconcileCoschedulingPodGroupMember(t *testing.T) {
+	testScheme := newCoschedulingTestScheme(t)
+	coschedulingPodGroup := newTestCoschedulingPodGroup("training", 3,
+		map[string]string{constants.DefaultQueueLabel: "team-b"})
+	pods := []*v1.Pod{
+		newCoschedulingMember("worker-0", coschedulingPodGroupLabel, "training", v1.PodPending, ""),
+		newCoschedulingMember("worker-1", legacyCoschedulingPodGroupLabel, "training", v1.PodPending, ""),
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(testScheme).
+		WithObjects(coschedulingPodGroup, pods[0], pods[1]).Build()
+
+	reconciler := PodReconciler{
+		Client:          fakeClient,
+		Scheme:          testScheme,
+		podGrouper:      &barePodGrouper{},
+		PodGroupHandler: podgroup.NewHandler(fakeClient, nodePoolKey, constants.DefaultQueueLabel),
+		configs: Configs{
+			SchedulerName:           "kai-scheduler",
+			SchedulingQueueLabelKey: constants.DefaultQueueLabel,
+			CoschedulingPodGroups:   true,
+		},
+		eventRecorder: record.NewFakeRecorder(10),
+	}
+	for _, pod := range pods {
+		_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(pod)})
+		assert.NoError(t, err)
+	}
+
+	podGroup := &schedulingv2alpha2.PodGroup{}
+	assert.NoError(t, fakeClient.Get(context.TODO(),
+		types.NamespacedName{Namespace: "test-ns", Name: "training"}, podGroup))
+	assert.Equal(t, int32(3), podGroup.Spec.MinMember)
+	assert.Equal(t, "team-b", podGroup.Spec.Queue)
+	assert.Len(t, podGroup.OwnerReferences, 1)
+	assert.Equal(t, "PodGroup", podGroup.OwnerReferences[0].Kind)
+	assert.Equal(t, coschedulingPodGroup.GetUID(), podGroup.OwnerReferences[0].UID)
+
+	for _, pod := range pods {
+		updatedPod := &v1.Pod{}
+		assert.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(pod), updatedPod))
+		assert.Equal(t, "training", updatedPod.Annotations[constants.PodGroupAnnotationForPod])
+	}
+}
+
+func TestReconcileCoschedulingPodGroupMemberDisabled(t *testing.T) {
+	testScheme := newCoschedulingTestScheme(t)
+	pod := newCoschedulingMember("worker-0", coschedulingPodGroupLabel, "training", v1.PodPending, "")
+	fakeClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(pod).Build()
+
+	reconciler := PodReconciler{
+		Client:          fakeClient,
+		Scheme:          testScheme,
+		podGrouper:      &barePodGrouper{},
+		PodGroupHandler: podgroup.NewHandler(fakeClient, nodePoolKey, constants.DefaultQueueLabel),
+		configs:         Configs{SchedulerName: "kai-scheduler"},
+		eventRecorder:   record.NewFakeRecorder(10),
+	}
+	_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(pod)})
+	assert.NoError(t, err)
+
+	podGroup := &schedulingv2alpha2.PodGroup{}
+	assert.NoError(t, fakeClient.Get(context.TODO(),
+		types.NamespacedName{Namespace: "test-ns", Name: "pg-worker-0"}, podGroup),
+		"pods should be grouped by their owners when coscheduling PodGroups are disabled")
+}
+
+func TestCoschedulingPodGroupReconciler(t *testing.T) {
+	testScheme := newCoschedulingTestScheme(t)
+	coschedulingPodGroup := newTestCoschedulingPodGroup("training", 2, nil)
+	fakeClient := fake.NewClientBuilder().WithScheme(testScheme).
+		WithObjects(
+			coschedulingPodGroup,
+			newCoschedulingMember("worker-0", coschedulingPodGroupLabel, "training", v1.PodRunning, "node-0"),
+			newCoschedulingMember("worker-1", coschedulingPodGroupLabel, "training", v1.PodRunning, "node-1"),
+			newCoschedulingMember("other-0", coschedulingPodGroupLabel, "other", v1.PodRunning, "node-1"),
+		).
+		WithStatusSubresource(coschedulingPodGroup).
+		Build()
+
+	reconciler := CoschedulingPodGroupReconciler{Client: fakeClient}
+	_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{
+		NamespacedName: client.ObjectKeyFromObject(coschedulingPodGroup),
+	})
+	assert.NoError(t, err)
+
+	updated := newCoschedulingPodGroup()
+	assert.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(coschedulingPodGroup), updated))
+	phase, _, _ := unstructured.NestedString(updated.Object, "status", "phase")
+	assert.Equal(t, coschedulingPhaseRunning, phase)
+	running, _, _ := unstructured.NestedInt64(updated.Object, "status", "running")
+	assert.Equal(t, int64(2), running)
+	scheduled, _, _ := unstructured.NestedInt64(updated.Object, "status", "scheduled")
+	assert.Equal(t, int64(2), scheduled)
+}
+
+func TestGetCoschedulingPodGroupStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		minMember int64
+		phases    []v1.PodPhase
+		nodeNames []string
+		expected  coschedulingPodGroupStatus
+	}{
+		{
+			name:      "less pods than min member",
+			minMember: 3,
+			phases:    []v1.PodPhase{v1.PodPending, v1.PodPending},
+			nodeNames: []string{"", ""},
+			expected:  coschedulingPodGroupStatus{Phase: coschedulingPhasePending},
+		},
+		{
+			name:      "waiting for the scheduler",
+			minMember: 2,
+			phases:    []v1.PodPhase{v1.PodPending, v1.PodPending},
+			nodeNames: []string{"node-0", ""},
+			expected:  coschedulingPodGroupStatus{Phase: coschedulingPhaseScheduling, Scheduled: 1},
+		},
+		{
+			name:      "scheduled",
+			minMember: 2,
+			phases:    []v1.PodPhase{v1.PodPending, v1.PodRunning},
+			nodeNames: []string{"node-0", "node-1"},
+			expected:  coschedulingPodGroupStatus{Phase: coschedulingPhaseScheduled, Scheduled: 2, Running: 1},
+		},
+		{
+			name:      "running",
+			minMember: 2,
+			phases:    []v1.PodPhase{v1.PodSucceeded, v1.PodRunning},
+			nodeNames: []string{"node-0", "node-1"},
+			expected: coschedulingPodGroupStatus{
+				Phase: coschedulingPhaseRunning, Scheduled: 2, Running: 1, Succeeded: 1},
+		},
+		{
+			name:      "finished",
+			minMember: 2,
+			phases:    []v1.PodPhase{v1.PodSucceeded, v1.PodSucceeded},
+			nodeNames: []string{"node-0", "node-1"},
+			expected:  coschedulingPodGroupStatus{Phase: coschedulingPhaseFinished, Scheduled: 2, Succeeded: 2},
+		},
+		{
+			name:      "failed",
+			minMember: 2,
+			phases:    []v1.PodPhase{v1.PodFailed, v1.PodRunning},
+			nodeNames: []string{"node-0", "node-1"},
+			expected: coschedulingPodGroupStatus{
+				Phase: coschedulingPhaseFailed, Scheduled: 2, Running: 1, Failed: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pods []*v1.Pod
+			for i, phase := range tt.phases {
+				pods = append(pods, newCoschedulingMember("worker", coschedulingPodGroupLabel, "training", phase,
+					tt.nodeNames[i]))
+			}
+			assert.Equal(t, tt.expected, getCoschedulingPodGroupStatus(tt.minMember, pods))
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgroup"
+)
+
+// deriveSubGroups sets a subgroup for every value of the subgroup label key on the pods of a pod group whose workload
+// doesn't define subgroups, and returns the other pods of the pod group, which must be assigned to the subgroups too.
+// The subgroups are derived once the pod group has as many pods as its min available, so that a partial set of
+// subgroups doesn't loosen the gang of the pod group while its pods are being created.
+func (r *PodReconciler) deriveSubGroups(
+	ctx context.Context, pod *v1.Pod, metadata *podgroup.Metadata,
+) ([]*v1.Pod, error) {
+	labelKey := r.configs.SubGroupLabelKey
+	if len(metadata.SubGroups) > 0 || pod.Labels[labelKey] == "" {
+		return nil, nil
+	}
+
+	podList := &v1.PodList{}
+	if err := r.Client.List(ctx, podList, client.InNamespace(pod.Namespace)); err != nil {
+		return nil, err
+	}
+	var otherPods []*v1.Pod
+	for i := range podList.Items {
+		member := &podList.Items[i]
+		if member.Name == pod.Name || isPodFinished(member) ||
+			member.Annotations[constants.PodGroupAnnotationForPod] != metadata.Name {
+			continue
+		}
+		otherPods = append(otherPods, member)
+	}
+
+	pods := append([]*v1.Pod{pod}, otherPods...)
+	if int32(len(pods)) < metadata.MinAvailable {
+		return nil, nil
+	}
+	metadata.SubGroups = subGroupsFromLabel(pods, labelKey, metadata.MinAvailable)
+	if metadata.SubGroups == nil {
+		return nil, nil
+	}
+	return otherPods, nil
+}
+
+// subGroupsFromLabel returns a subgroup for every value of the label on the pods, with the share of the min available
+// of the pod group in proportion to its pods, rounded up. Returns nil if any of the pods doesn't have the label, as
+// every pod of a pod group with subgroups must belong to one of them.
+func subGroupsFromLabel(pods []*v1.Pod, labelKey string, minAvailable int32) []*podgroup.SubGroupMetadata {
+	podsByValue := map[string][]*types.NamespacedName{}
+	for _, pod := range pods {
+		value := pod.Labels[labelKey]
+		if value == "" {
+			return nil
+		}
+		podsByValue[value] = append(podsByValue[value], &types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name})
+	}
+
+	totalPods := int32(len(pods))
+	var subGroups []*podgroup.SubGroupMetadata
+	for _, value := range slices.Sorted(maps.Keys(podsByValue)) {
+		podsReferences := podsByValue[value]
+		subGroupPods := int32(len(podsReferences))
+		subGroupMinAvailable := subGroupPods
+		if minAvailable < totalPods {
+			subGroupMinAvailable = max((subGroupPods*minAvailable+totalPods-1)/totalPods, 1)
+		}
+		subGroups = append(subGroups, &podgroup.SubGroupMetadata{
+			Name:           value,
+			MinAvailable:   subGroupMinAvailable,
+			PodsReferences: podsReferences,
+		})
+	}
+	return subGroups
+}
@@ -0,0 +1,170 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	schedulingv2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgroup"
+)
+
+const replicaTypeLabel = "training.kubeflow.org/replica-type"
+
+func newReplicaPod(name, replicaType string) *v1.Pod {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test-ns",
+			UID:       types.UID(name + "-uid"),
+			Labels:    map[string]string{},
+		},
+		Spec: v1.PodSpec{SchedulerName: "kai-scheduler"},
+	}
+	if replicaType != "" {
+		pod.Labels[replicaTypeLabel] = replicaType
+	}
+	return pod
+}
+
+func TestSubGroupsFromLabel(t *testing.T) {
+	tests := []struct {
+		name         string
+		pods         []*v1.Pod
+		minAvailable int32
+		expected     map[string]int32
+	}{
+		{
+			name: "a subgroup with all its pods for every value when the whole gang is required",
+			pods: []*v1.Pod{
+				newReplicaPod("master-0", "master"),
+				newReplicaPod("worker-0", "worker"),
+				newReplicaPod("worker-1", "worker"),
+			},
+			minAvailable: 3,
+			expected:     map[string]int32{"master": 1, "worker": 2},
+		},
+		{
+			name: "the min available is split in proportion to the pods of every value",
+			pods: []*v1.Pod{
+				newReplicaPod("master-0", "master"),
+				newReplicaPod("worker-0", "worker"),
+				newReplicaPod("worker-1", "worker"),
+				newReplicaPod("worker-2", "worker"),
+				newReplicaPod("worker-3", "worker"),
+				newReplicaPod("worker-4", "worker"),
+			},
+			minAvailable: 3,
+			expected:     map[string]int32{"master": 1, "worker": 3},
+		},
+		{
+			name: "no subgroups when a pod doesn't have the label",
+			pods: []*v1.Pod{
+				newReplicaPod("master-0", "master"),
+				newReplicaPod("sidecar", ""),
+			},
+			minAvailable: 2,
+			expected:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subGroups := subGroupsFromLabel(tt.pods, replicaTypeLabel, tt.minAvailable)
+			if tt.expected == nil {
+				assert.Nil(t, subGroups)
+				return
+			}
+			minAvailable := map[string]int32{}
+			pods := 0
+			for _, subGroup := range subGroups {
+				minAvailable[subGroup.Name] = subGroup.MinAvailable
+				pods += len(subGroup.PodsReferences)
+			}
+			assert.Equal(t, tt.expected, minAvailable)
+			assert.Equal(t, len(tt.pods), pods)
+		})
+	}
+}
+
+// workloadPodGrouper groups all the pods in a single pod group of the workload that doesn't define subgroups
+type workloadPodGrouper struct {
+	minAvailable int32
+}
+
+func (g *workloadPodGrouper) GetPGMetadata(_ context.Context, pod *v1.Pod, _ *unstructured.Unstructured,
+	_ []*metav1.PartialObjectMetadata) (*podgroup.Metadata, error) {
+	return &podgroup.Metadata{
+		Namespace:    pod.Namespace,
+		Name:         "pg-training",
+		Queue:        "team-a",
+		MinAvailable: g.minAvailable,
+	}, nil
+}
+
+func (*workloadPodGrouper) GetPodOwners(context.Context, *v1.Pod) (*unstructured.Unstructured,
+	[]*metav1.PartialObjectMetadata, error) {
+	return &unstructured.Unstructured{}, nil, nil
+}
+
+func TestReconcileDerivesSubGroupsFromLabel(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	assert.NoError(t, v1.AddToScheme(testScheme))
+	assert.NoError(t, schedulingv2alpha2.AddToScheme(testScheme))
+
+	pods := []*v1.Pod{
+		newReplicaPod("master-0", "master"),
+		newReplicaPod("worker-0", "worker"),
+		newReplicaPod("worker-1", "worker"),
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(pods[0], pods[1], pods[2]).Build()
+	reconciler := PodReconciler{
+		Client:          fakeClient,
+		Scheme:          testScheme,
+		podGrouper:      &workloadPodGrouper{minAvailable: 3},
+		PodGroupHandler: podgroup.NewHandler(fakeClient, nodePoolKey, constants.DefaultQueueLabel),
+		configs: Configs{
+			SchedulerName:    "kai-scheduler",
+			SubGroupLabelKey: replicaTypeLabel,
+		},
+		eventRecorder: record.NewFakeRecorder(10),
+	}
+	reconcile := func(pod *v1.Pod) {
+		_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(pod)})
+		assert.NoError(t, err)
+	}
+	podGroup := &schedulingv2alpha2.PodGroup{}
+	podGroupKey := types.NamespacedName{Namespace: "test-ns", Name: "pg-training"}
+
+	reconcile(pods[0])
+	reconcile(pods[1])
+	assert.NoError(t, fakeClient.Get(context.TODO(), podGroupKey, podGroup))
+	assert.Empty(t, podGroup.Spec.SubGroups, "the subgroups are derived once the pod group has its min available pods")
+
+	reconcile(pods[2])
+	assert.NoError(t, fakeClient.Get(context.TODO(), podGroupKey, podGroup))
+	assert.Equal(t, []schedulingv2alpha2.SubGroup{
+		{Name: "master", MinMember: 1},
+		{Name: "worker", MinMember: 2},
+	}, podGroup.Spec.SubGroups)
+	for _, pod := range pods {
+		updatedPod := &v1.Pod{}
+		assert.NoError(t, fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(pod), updatedPod))
+		assert.Equal(t, "pg-training", updatedPod.Annotations[constants.PodGroupAnnotationForPod])
+		assert.Equal(t, pod.Labels[replicaTypeLabel], updatedPod.Labels[constants.SubGroupLabelKey])
+	}
+}
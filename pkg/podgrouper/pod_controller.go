@@ -49,8 +49,14 @@ type Configs struct {
 	MaxConcurrentReconciles  int
 	SearchForLegacyPodGroups bool
 	KnativeGangSchedule      bool
+	KueueWorkloads           bool
+	PriorityAssignmentRules  bool
+	CoschedulingPodGroups    bool
 	SchedulerName            string
 	SchedulingQueueLabelKey  string
+	// SubGroupLabelKey is the pod label key whose values the subgroups of pod groups are derived from, when their
+	// workloads don't define subgroups. Subgroups aren't derived when it is empty.
+	SubGroupLabelKey string
 
 	PodLabelSelector       map[string]string
 	NamespaceLabelSelector map[string]string
@@ -63,7 +69,7 @@ type Configs struct {
 // +kubebuilder:rbac:groups="",resources=pods,verbs=create;update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch;update;get;list;watch
 // +kubebuilder:rbac:groups="scheduling.k8s.io",resources=priorityclasses,verbs=get;list;watch
-// +kubebuilder:rbac:groups="scheduling.run.ai",resources=podgroups,verbs=create;update;patch;get;list;watch
+// +kubebuilder:rbac:groups="scheduling.run.ai",resources=podgroups,verbs=create;update;patch;get;list;watch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -96,6 +102,22 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 		}
 	}()
 
+	if podGroupName, found := getCoschedulingPodGroupName(&pod); found && r.configs.CoschedulingPodGroups {
+		err = r.reconcileCoschedulingPodGroupMember(ctx, &pod, podGroupName)
+		if err != nil {
+			logger.V(1).Error(err, "Failed to apply pod group of coscheduling PodGroup member", req.Namespace, req.Name)
+		}
+		return ctrl.Result{}, err
+	}
+
+	if isBarePodGangMember(&pod) {
+		err = r.reconcileBarePodGang(ctx, &pod)
+		if err != nil {
+			logger.V(1).Error(err, "Failed to apply pod group of bare pod gang", req.Namespace, req.Name)
+		}
+		return ctrl.Result{}, err
+	}
+
 	if isOrphanPodWithPodGroup(&pod) {
 		return ctrl.Result{}, nil
 	}
@@ -120,6 +142,15 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 		addNodePoolLabel(metadata, &pod, r.configs.NodePoolLabelKey)
 	}
 
+	var otherSubGroupPods []*v1.Pod
+	if len(r.configs.SubGroupLabelKey) > 0 {
+		otherSubGroupPods, err = r.deriveSubGroups(ctx, &pod, metadata)
+		if err != nil {
+			logger.V(1).Error(err, "Failed to derive the subgroups of the pod group", metadata.Namespace, metadata.Name)
+			return ctrl.Result{}, err
+		}
+	}
+
 	err = r.PodGroupHandler.ApplyToCluster(ctx, *metadata)
 	if err != nil {
 		logger.V(1).Error(err, "Failed to apply metadata for pod group", metadata.Namespace, metadata.Name)
@@ -132,6 +163,14 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 		return ctrl.Result{}, err
 	}
 
+	for _, otherPod := range otherSubGroupPods {
+		err = r.assignPodToGroupAndSubGroup(ctx, otherPod, metadata)
+		if err != nil {
+			logger.V(1).Error(err, "Failed to assign pod to subgroup", "pod", otherPod.Name)
+			return ctrl.Result{}, err
+		}
+	}
+
 	return ctrl.Result{}, nil
 }
 
@@ -142,7 +181,14 @@ func (r *PodReconciler) SetupWithManager(mgr ctrl.Manager, configs Configs, plug
 		return err
 	}
 
-	r.podGrouper = podgrouper.NewPodgrouper(mgr.GetClient(), clientWithoutCache, pluginsHub)
+	podGrouper := podgrouper.NewPodgrouper(mgr.GetClient(), clientWithoutCache, pluginsHub)
+	if configs.KueueWorkloads {
+		podGrouper.EnableKueueWorkloads()
+	}
+	if configs.PriorityAssignmentRules {
+		podGrouper.EnablePriorityAssignmentRules()
+	}
+	r.podGrouper = podGrouper
 	r.PodGroupHandler = podgroup.NewHandler(mgr.GetClient(), configs.NodePoolLabelKey, configs.SchedulingQueueLabelKey)
 	r.configs = configs
 	r.eventRecorder = mgr.GetEventRecorderFor(controllerName)
@@ -12,8 +12,10 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
@@ -22,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	schedulingv2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgroup"
 )
@@ -508,3 +511,85 @@ func TestAssignPodToGroupAndSubGroup(t *testing.T) {
 		})
 	}
 }
+
+type barePodGrouper struct{}
+
+func (*barePodGrouper) GetPGMetadata(ctx context.Context, pod *v1.Pod, topOwner *unstructured.Unstructured, allOwners []*metav1.PartialObjectMetadata) (*podgroup.Metadata, error) {
+	return &podgroup.Metadata{
+		Namespace:    pod.Namespace,
+		Name:         "pg-" + pod.Name,
+		Queue:        "team-a",
+		MinAvailable: 1,
+	}, nil
+}
+
+func (*barePodGrouper) GetPodOwners(ctx context.Context, pod *v1.Pod) (*unstructured.Unstructured, []*metav1.PartialObjectMetadata, error) {
+	return &unstructured.Unstructured{}, nil, nil
+}
+
+func TestReconcileBarePodGang(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	assert.NoError(t, v1.AddToScheme(testScheme))
+	assert.NoError(t, schedulingv2alpha2.AddToScheme(testScheme))
+
+	newGangPod := func(name string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-ns",
+				UID:       types.UID(name + "-uid"),
+				Annotations: map[string]string{
+					constants.PodGroupAnnotationForPod:  "notebook-gang",
+					constants.MinMemberAnnotationForPod: "2",
+				},
+			},
+			Spec: v1.PodSpec{SchedulerName: "kai-scheduler"},
+		}
+	}
+	pods := []*v1.Pod{newGangPod("worker-0"), newGangPod("worker-1")}
+	fakeClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(pods[0], pods[1]).Build()
+
+	reconciler := PodReconciler{
+		Client:          fakeClient,
+		Scheme:          testScheme,
+		podGrouper:      &barePodGrouper{},
+		PodGroupHandler: podgroup.NewHandler(fakeClient, nodePoolKey, constants.DefaultQueueLabel),
+		configs: Configs{
+			SchedulerName: "kai-scheduler",
+		},
+		eventRecorder: record.NewFakeRecorder(10),
+	}
+	reconcileAll := func() {
+		for _, pod := range pods {
+			_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{
+				NamespacedName: client.ObjectKeyFromObject(pod),
+			})
+			assert.NoError(t, err)
+		}
+	}
+
+	reconcileAll()
+
+	podGroup := &schedulingv2alpha2.PodGroup{}
+	podGroupKey := types.NamespacedName{Namespace: "test-ns", Name: "notebook-gang"}
+	assert.NoError(t, fakeClient.Get(context.TODO(), podGroupKey, podGroup))
+	assert.Equal(t, int32(2), podGroup.Spec.MinMember)
+	assert.Equal(t, "team-a", podGroup.Spec.Queue)
+	assert.Len(t, podGroup.OwnerReferences, 2)
+	for i, owner := range podGroup.OwnerReferences {
+		assert.Equal(t, "Pod", owner.Kind)
+		assert.Equal(t, pods[i].UID, owner.UID)
+	}
+
+	pods[0].Status.Phase = v1.PodSucceeded
+	assert.NoError(t, fakeClient.Status().Update(context.TODO(), pods[0]))
+	reconcileAll()
+	assert.NoError(t, fakeClient.Get(context.TODO(), podGroupKey, podGroup),
+		"the pod group should be kept while a pod of the gang is running")
+
+	pods[1].Status.Phase = v1.PodFailed
+	assert.NoError(t, fakeClient.Status().Update(context.TODO(), pods[1]))
+	reconcileAll()
+	err := fakeClient.Get(context.TODO(), podGroupKey, podGroup)
+	assert.True(t, errors.IsNotFound(err), "expected the pod group to be deleted, got %v", err)
+}
@@ -5,13 +5,18 @@ package podgroup
 
 import (
 	"context"
+	"slices"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	schedulingv2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/tracing"
 )
 
 type Handler struct {
@@ -39,13 +44,15 @@ func (h *Handler) ApplyToCluster(ctx context.Context, pgMetadata Metadata) error
 	err := h.client.Get(ctx, key, oldPodGroup)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			err = h.client.Create(ctx, newPodGroup)
-			return err
+			return h.createPodGroup(ctx, newPodGroup)
 		}
 		return err
 	}
 
 	newPodGroup = h.ignoreFields(oldPodGroup, newPodGroup)
+	if pgMetadata.SharedByOwners {
+		newPodGroup.OwnerReferences = mergeOwnerReferences(oldPodGroup.OwnerReferences, pgMetadata.Owner)
+	}
 
 	// If we got here then oldPodGroup exists - update if necessary
 	if podGroupsEqual(oldPodGroup, newPodGroup) {
@@ -59,6 +66,22 @@ func (h *Handler) ApplyToCluster(ctx context.Context, pgMetadata Metadata) error
 	return err
 }
 
+// createPodGroup starts the workload trace, stored in the PodGroup annotations so that the scheduler and the binder
+// can continue it.
+func (h *Handler) createPodGroup(ctx context.Context, podGroup *schedulingv2alpha2.PodGroup) error {
+	ctx, span := tracing.Tracer().Start(ctx, "podgrouper.CreatePodGroup", trace.WithNewRoot(),
+		trace.WithAttributes(attribute.String("podgroup", podGroup.Namespace+"/"+podGroup.Name)))
+	defer span.End()
+
+	podGroup.Annotations = tracing.InjectToAnnotations(ctx, podGroup.Annotations)
+	err := h.client.Create(ctx, podGroup)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
 func (h *Handler) ignoreFields(oldPodGroup, newPodGroup *schedulingv2alpha2.PodGroup) *schedulingv2alpha2.PodGroup {
 	// to avoid overriding the fields that the pod-group-assigner is responsible for
 	newPodGroupCopy := newPodGroup.DeepCopy()
@@ -67,6 +90,19 @@ func (h *Handler) ignoreFields(oldPodGroup, newPodGroup *schedulingv2alpha2.PodG
 	newPodGroupCopy.Spec.SchedulingBackoff = oldPodGroup.Spec.SchedulingBackoff
 	newPodGroupCopy.Spec.Queue = oldPodGroup.Spec.Queue
 
+	// to avoid overriding the fields that users set directly on the pod group
+	newPodGroupCopy.Spec.PreferredNodeAffinityTerms = oldPodGroup.Spec.PreferredNodeAffinityTerms
+	newPodGroupCopy.Spec.MinRuntimeBeforePreemption = oldPodGroup.Spec.MinRuntimeBeforePreemption
+	newPodGroupCopy.Spec.Tolerations = oldPodGroup.Spec.Tolerations
+	newPodGroupCopy.Spec.NodeSelector = oldPodGroup.Spec.NodeSelector
+	newPodGroupCopy.Spec.Replaces = oldPodGroup.Spec.Replaces
+	newPodGroupCopy.Spec.ConstraintRelaxation = oldPodGroup.Spec.ConstraintRelaxation
+	newPodGroupCopy.Spec.TopologyConstraint.SubGroupSpreadTopologyLevel =
+		oldPodGroup.Spec.TopologyConstraint.SubGroupSpreadTopologyLevel
+	newPodGroupCopy.Spec.TopologyConstraint.PreferredTopologyWeight =
+		oldPodGroup.Spec.TopologyConstraint.PreferredTopologyWeight
+	newPodGroupCopy.Spec.SubGroups = ignoreSubGroupsFields(oldPodGroup.Spec.SubGroups, newPodGroupCopy.Spec.SubGroups)
+
 	if newPodGroupCopy.Labels == nil {
 		newPodGroupCopy.Labels = map[string]string{}
 	}
@@ -85,6 +121,44 @@ func (h *Handler) ignoreFields(oldPodGroup, newPodGroup *schedulingv2alpha2.PodG
 	return newPodGroupCopy
 }
 
+// ignoreSubGroupsFields keeps the fields that users set directly on the subgroups that the workload defines. Subgroups
+// that the workload no longer defines are dropped.
+func ignoreSubGroupsFields(oldSubGroups, newSubGroups []schedulingv2alpha2.SubGroup) []schedulingv2alpha2.SubGroup {
+	oldSubGroupsByName := map[string]schedulingv2alpha2.SubGroup{}
+	for _, subGroup := range oldSubGroups {
+		oldSubGroupsByName[subGroup.Name] = subGroup
+	}
+	for i := range newSubGroups {
+		oldSubGroup, found := oldSubGroupsByName[newSubGroups[i].Name]
+		if !found {
+			continue
+		}
+		newSubGroups[i].PodSelector = oldSubGroup.PodSelector
+		if oldSubGroup.TopologyConstraint == nil ||
+			(oldSubGroup.TopologyConstraint.SubGroupSpreadTopologyLevel == "" &&
+				oldSubGroup.TopologyConstraint.PreferredTopologyWeight == nil) {
+			continue
+		}
+		if newSubGroups[i].TopologyConstraint == nil {
+			newSubGroups[i].TopologyConstraint = &schedulingv2alpha2.TopologyConstraint{}
+		}
+		newSubGroups[i].TopologyConstraint.SubGroupSpreadTopologyLevel =
+			oldSubGroup.TopologyConstraint.SubGroupSpreadTopologyLevel
+		newSubGroups[i].TopologyConstraint.PreferredTopologyWeight =
+			oldSubGroup.TopologyConstraint.PreferredTopologyWeight
+	}
+	return newSubGroups
+}
+
+func mergeOwnerReferences(owners []metav1.OwnerReference, owner metav1.OwnerReference) []metav1.OwnerReference {
+	for _, existingOwner := range owners {
+		if existingOwner.UID == owner.UID {
+			return owners
+		}
+	}
+	return append(slices.Clone(owners), owner)
+}
+
 func (h *Handler) createPodGroupForMetadata(podGroupMetadata Metadata) *schedulingv2alpha2.PodGroup {
 	pg := &schedulingv2alpha2.PodGroup{
 		ObjectMeta: metav1.ObjectMeta{
@@ -102,6 +176,7 @@ func (h *Handler) createPodGroupForMetadata(podGroupMetadata Metadata) *scheduli
 			PriorityClassName: podGroupMetadata.PriorityClassName,
 			SubGroups:         []schedulingv2alpha2.SubGroup{},
 			Preemptibility:    podGroupMetadata.Preemptibility,
+			WorkloadClass:     podGroupMetadata.WorkloadClass,
 		},
 	}
 
@@ -5,8 +5,10 @@ package podgroup
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
@@ -683,3 +685,129 @@ func Test_createPodGroupForMetadata(t *testing.T) {
 		})
 	}
 }
+
+func Test_ignoreFields(t *testing.T) {
+	userSubGroupSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"role": "worker"}}
+	oldPodGroup := &schedulingv2alpha2.PodGroup{
+		Spec: schedulingv2alpha2.PodGroupSpec{
+			MinMember: 2,
+			Queue:     "user-queue",
+			PreferredNodeAffinityTerms: []v1.PreferredSchedulingTerm{
+				{
+					Weight: 10,
+					Preference: v1.NodeSelectorTerm{
+						MatchExpressions: []v1.NodeSelectorRequirement{
+							{Key: "zone", Operator: v1.NodeSelectorOpIn, Values: []string{"a"}},
+						},
+					},
+				},
+			},
+			MinRuntimeBeforePreemption: &metav1.Duration{Duration: time.Hour},
+			Tolerations: []v1.Toleration{
+				{Key: "dedicated", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule},
+			},
+			NodeSelector: map[string]string{"pool": "a100"},
+			Replaces:     "pg-revision-1",
+			ConstraintRelaxation: &schedulingv2alpha2.ConstraintRelaxation{
+				FailedCycles: 3,
+			},
+			TopologyConstraint: schedulingv2alpha2.TopologyConstraint{
+				Topology:                    "old-topology",
+				SubGroupSpreadTopologyLevel: "zone",
+			},
+			SubGroups: []schedulingv2alpha2.SubGroup{
+				{
+					Name:        "workers",
+					MinMember:   1,
+					PodSelector: userSubGroupSelector,
+					TopologyConstraint: &schedulingv2alpha2.TopologyConstraint{
+						SubGroupSpreadTopologyLevel: "rack",
+						PreferredTopologyWeight:     ptr.To(int32(0)),
+					},
+				},
+				{Name: "removed", MinMember: 1},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		newSpec  schedulingv2alpha2.PodGroupSpec
+		expected schedulingv2alpha2.PodGroupSpec
+	}{
+		{
+			name: "keeps user set fields",
+			newSpec: schedulingv2alpha2.PodGroupSpec{
+				MinMember: 3,
+				Queue:     "default-queue",
+				TopologyConstraint: schedulingv2alpha2.TopologyConstraint{
+					Topology: "new-topology",
+				},
+				SubGroups: []schedulingv2alpha2.SubGroup{
+					{Name: "workers", MinMember: 3},
+					{Name: "leaders", MinMember: 1},
+				},
+			},
+			expected: schedulingv2alpha2.PodGroupSpec{
+				MinMember:                  3,
+				Queue:                      "user-queue",
+				PreferredNodeAffinityTerms: oldPodGroup.Spec.PreferredNodeAffinityTerms,
+				MinRuntimeBeforePreemption: oldPodGroup.Spec.MinRuntimeBeforePreemption,
+				Tolerations:                oldPodGroup.Spec.Tolerations,
+				NodeSelector:               oldPodGroup.Spec.NodeSelector,
+				Replaces:                   oldPodGroup.Spec.Replaces,
+				ConstraintRelaxation:       oldPodGroup.Spec.ConstraintRelaxation,
+				TopologyConstraint: schedulingv2alpha2.TopologyConstraint{
+					Topology:                    "new-topology",
+					SubGroupSpreadTopologyLevel: "zone",
+				},
+				SubGroups: []schedulingv2alpha2.SubGroup{
+					{
+						Name:        "workers",
+						MinMember:   3,
+						PodSelector: userSubGroupSelector,
+						TopologyConstraint: &schedulingv2alpha2.TopologyConstraint{
+							SubGroupSpreadTopologyLevel: "rack",
+							PreferredTopologyWeight:     ptr.To(int32(0)),
+						},
+					},
+					{Name: "leaders", MinMember: 1},
+				},
+			},
+		},
+		{
+			name: "drops the subgroups that the workload no longer defines",
+			newSpec: schedulingv2alpha2.PodGroupSpec{
+				MinMember: 2,
+				Queue:     "default-queue",
+				SubGroups: []schedulingv2alpha2.SubGroup{},
+			},
+			expected: schedulingv2alpha2.PodGroupSpec{
+				MinMember:                  2,
+				Queue:                      "user-queue",
+				PreferredNodeAffinityTerms: oldPodGroup.Spec.PreferredNodeAffinityTerms,
+				MinRuntimeBeforePreemption: oldPodGroup.Spec.MinRuntimeBeforePreemption,
+				Tolerations:                oldPodGroup.Spec.Tolerations,
+				NodeSelector:               oldPodGroup.Spec.NodeSelector,
+				Replaces:                   oldPodGroup.Spec.Replaces,
+				ConstraintRelaxation:       oldPodGroup.Spec.ConstraintRelaxation,
+				TopologyConstraint: schedulingv2alpha2.TopologyConstraint{
+					SubGroupSpreadTopologyLevel: "zone",
+				},
+				SubGroups: []schedulingv2alpha2.SubGroup{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := &Handler{}
+			newPodGroup := &schedulingv2alpha2.PodGroup{Spec: tt.newSpec}
+			result := handler.ignoreFields(oldPodGroup, newPodGroup)
+
+			if diff := cmp.Diff(tt.expected, result.Spec); diff != "" {
+				t.Errorf("ignoreFields() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
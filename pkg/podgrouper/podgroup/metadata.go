@@ -28,6 +28,7 @@ type Metadata struct {
 	Labels            map[string]string
 	PriorityClassName string
 	Preemptibility    v2alpha2.Preemptibility
+	WorkloadClass     v2alpha2.WorkloadClass
 	Queue             string
 	Namespace         string
 	Name              string
@@ -35,6 +36,10 @@ type Metadata struct {
 	Owner             metav1.OwnerReference
 	SubGroups         []*SubGroupMetadata
 
+	// SharedByOwners marks a pod group that is owned by several objects, each adding itself as an owner when the
+	// pod group is applied, instead of replacing the owner of the pod group.
+	SharedByOwners bool
+
 	PreferredTopologyLevel string
 	RequiredTopologyLevel  string
 	Topology               string
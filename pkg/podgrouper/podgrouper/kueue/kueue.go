@@ -0,0 +1,97 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package kueue
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgroup"
+)
+
+const (
+	// QueueNameLabel is the label with which workloads are submitted to a Kueue LocalQueue
+	QueueNameLabel = "kueue.x-k8s.io/queue-name"
+	// JobUIDLabel is the label with which Kueue links a Workload to the job it was created for
+	JobUIDLabel = "kueue.x-k8s.io/job-uid"
+)
+
+var workloadListGVK = schema.GroupVersionKind{
+	Group:   "kueue.x-k8s.io",
+	Version: "v1beta1",
+	Kind:    "WorkloadList",
+}
+
+// WorkloadSource maps workloads admitted by Kueue to pod groups, so that Kueue does the admission and quota
+// management of a job while KAI places its pods, schedules them as a gang and preempts them.
+type WorkloadSource struct {
+	client client.Client
+}
+
+func NewWorkloadSource(client client.Client) *WorkloadSource {
+	return &WorkloadSource{client: client}
+}
+
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch
+
+// ApplyAdmission updates the pod group metadata of a job submitted to Kueue with the admission of its Workload: the
+// pod group is put in the KAI queue named after the ClusterQueue that admitted the Workload, and its min members
+// are the pods required by the pod sets of the Workload. Jobs that are not submitted to Kueue, or whose Workload
+// was not admitted yet, are left unchanged.
+func (s *WorkloadSource) ApplyAdmission(
+	ctx context.Context, topOwner *unstructured.Unstructured, metadata *podgroup.Metadata,
+) error {
+	if _, found := topOwner.GetLabels()[QueueNameLabel]; !found {
+		return nil
+	}
+
+	workloads := &unstructured.UnstructuredList{}
+	workloads.SetGroupVersionKind(workloadListGVK)
+	err := s.client.List(ctx, workloads, client.InNamespace(topOwner.GetNamespace()),
+		client.MatchingLabels{JobUIDLabel: string(topOwner.GetUID())})
+	if err != nil {
+		return fmt.Errorf("failed to list Kueue workloads of %s %s/%s: %w",
+			topOwner.GetKind(), topOwner.GetNamespace(), topOwner.GetName(), err)
+	}
+
+	for _, workload := range workloads.Items {
+		clusterQueue, found, err := unstructured.NestedString(workload.Object, "status", "admission", "clusterQueue")
+		if err != nil || !found || clusterQueue == "" {
+			continue
+		}
+		metadata.Queue = clusterQueue
+		if minMembers := workloadMinMembers(&workload); minMembers > 0 {
+			metadata.MinAvailable = minMembers
+		}
+		return nil
+	}
+	return nil
+}
+
+// workloadMinMembers returns the number of pods the pod sets of the workload require to run, which is the minCount
+// of pod sets that allow partial admission, and the count of the others.
+func workloadMinMembers(workload *unstructured.Unstructured) int32 {
+	podSets, found, err := unstructured.NestedSlice(workload.Object, "spec", "podSets")
+	if err != nil || !found {
+		return 0
+	}
+
+	var minMembers int64
+	for _, rawPodSet := range podSets {
+		podSet, ok := rawPodSet.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		count, found, err := unstructured.NestedInt64(podSet, "minCount")
+		if err != nil || !found {
+			count, _, _ = unstructured.NestedInt64(podSet, "count")
+		}
+		minMembers += count
+	}
+	return int32(minMembers)
+}
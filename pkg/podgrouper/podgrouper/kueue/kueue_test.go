@@ -0,0 +1,121 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package kueue
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgroup"
+)
+
+const (
+	namespace    = "team-a"
+	jobUID       = "job-uid-1"
+	localQueue   = "local-queue"
+	clusterQueue = "cluster-queue"
+	defaultQueue = "default-queue"
+)
+
+func TestApplyAdmission(t *testing.T) {
+	tests := []struct {
+		name             string
+		jobLabels        map[string]string
+		workloads        []*unstructured.Unstructured
+		expectedQueue    string
+		expectedMinAvail int32
+	}{
+		{
+			name:             "job not submitted to kueue",
+			jobLabels:        map[string]string{},
+			workloads:        []*unstructured.Unstructured{getWorkload("wl-1", clusterQueue, 2, 0)},
+			expectedQueue:    defaultQueue,
+			expectedMinAvail: 1,
+		},
+		{
+			name:             "workload not admitted",
+			jobLabels:        map[string]string{QueueNameLabel: localQueue},
+			workloads:        []*unstructured.Unstructured{getWorkload("wl-1", "", 2, 0)},
+			expectedQueue:    defaultQueue,
+			expectedMinAvail: 1,
+		},
+		{
+			name:             "no workload for the job",
+			jobLabels:        map[string]string{QueueNameLabel: localQueue},
+			workloads:        []*unstructured.Unstructured{},
+			expectedQueue:    defaultQueue,
+			expectedMinAvail: 1,
+		},
+		{
+			name:             "admitted workload",
+			jobLabels:        map[string]string{QueueNameLabel: localQueue},
+			workloads:        []*unstructured.Unstructured{getWorkload("wl-1", clusterQueue, 4, 0)},
+			expectedQueue:    clusterQueue,
+			expectedMinAvail: 4,
+		},
+		{
+			name:             "admitted workload with partial admission",
+			jobLabels:        map[string]string{QueueNameLabel: localQueue},
+			workloads:        []*unstructured.Unstructured{getWorkload("wl-1", clusterQueue, 4, 2)},
+			expectedQueue:    clusterQueue,
+			expectedMinAvail: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var objects []client.Object
+			for _, workload := range test.workloads {
+				objects = append(objects, workload)
+			}
+			kubeClient := fake.NewClientBuilder().WithObjects(objects...).Build()
+
+			job := &unstructured.Unstructured{}
+			job.SetAPIVersion("batch/v1")
+			job.SetKind("Job")
+			job.SetName("job-1")
+			job.SetNamespace(namespace)
+			job.SetUID(types.UID(jobUID))
+			job.SetLabels(test.jobLabels)
+
+			metadata := &podgroup.Metadata{Queue: defaultQueue, MinAvailable: 1}
+			err := NewWorkloadSource(kubeClient).ApplyAdmission(context.Background(), job, metadata)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedQueue, metadata.Queue)
+			assert.Equal(t, test.expectedMinAvail, metadata.MinAvailable)
+		})
+	}
+}
+
+func getWorkload(name, admittingClusterQueue string, count, minCount int64) *unstructured.Unstructured {
+	podSet := map[string]interface{}{
+		"name":  "main",
+		"count": count,
+	}
+	if minCount > 0 {
+		podSet["minCount"] = minCount
+	}
+
+	workload := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"queueName": localQueue,
+			"podSets":   []interface{}{podSet},
+		},
+	}}
+	workload.SetAPIVersion("kueue.x-k8s.io/v1beta1")
+	workload.SetKind("Workload")
+	workload.SetName(name)
+	workload.SetNamespace(namespace)
+	workload.SetLabels(map[string]string{JobUIDLabel: jobUID})
+	if admittingClusterQueue != "" {
+		_ = unstructured.SetNestedField(workload.Object, admittingClusterQueue, "status", "admission", "clusterQueue")
+	}
+	return workload
+}
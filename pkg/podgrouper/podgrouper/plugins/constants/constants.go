@@ -8,6 +8,7 @@ const (
 	ProjectLabelKey        = "project"
 	PriorityLabelKey       = "priorityClassName"
 	PreemptibilityLabelKey = "kai.scheduler/preemptibility"
+	WorkloadClassLabelKey  = "kai.scheduler/workload-class"
 	UserLabelKey           = "user"
 
 	BuildPriorityClass     = "build"
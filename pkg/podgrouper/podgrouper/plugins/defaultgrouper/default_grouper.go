@@ -80,6 +80,7 @@ func (dg *DefaultGrouper) GetPodGroupMetadata(topOwner *unstructured.Unstructure
 		Queue:             dg.CalcPodGroupQueue(topOwner, pod),
 		PriorityClassName: priorityClassName,
 		Preemptibility:    preemptibility,
+		WorkloadClass:     dg.calcPodGroupWorkloadClass(allOwners, pod),
 		MinAvailable:      1,
 	}
 
@@ -102,6 +103,18 @@ func (dg *DefaultGrouper) CalcPodGroupAnnotations(topOwner *unstructured.Unstruc
 	if value, exists := pod.GetAnnotations()[constants.UserLabelKey]; exists {
 		pgAnnotations[constants.UserLabelKey] = value
 	}
+	// Workloads opt out of consolidation from the pod template too, where the admission webhook validates it
+	if value, exists := pod.GetAnnotations()[commonconsts.DoNotConsolidate]; exists {
+		pgAnnotations[commonconsts.DoNotConsolidate] = value
+	}
+	// The datasets of a workload can be set on the pod template too, for workloads that don't own their PodGroup
+	if value, exists := pod.GetAnnotations()[commonconsts.Datasets]; exists {
+		pgAnnotations[commonconsts.Datasets] = value
+	}
+	// Controllers that create the pods of a gang gradually may not own its PodGroup either
+	if value, exists := pod.GetAnnotations()[commonconsts.QuotaReservationTimeout]; exists {
+		pgAnnotations[commonconsts.QuotaReservationTimeout] = value
+	}
 
 	topOwnerMetadata := topowner.GetTopOwnerMetadata(topOwner)
 	marshalledMetadata, err := topOwnerMetadata.MarshalYAML()
@@ -223,6 +236,28 @@ func (dg *DefaultGrouper) calcPriorityClassWithDefaults(allOwners []*metav1.Part
 	return defaultPriorityClassForJob, defaultConfigs
 }
 
+// calcPodGroupWorkloadClass returns the workload class set by the label of the closest owner or of the pod
+func (dg *DefaultGrouper) calcPodGroupWorkloadClass(
+	allOwners []*metav1.PartialObjectMetadata, pod *v1.Pod) v2alpha2.WorkloadClass {
+	for _, owner := range allOwners {
+		if workloadClassStr, found := owner.GetLabels()[constants.WorkloadClassLabelKey]; found {
+			if workloadClass, err := v2alpha2.ParseWorkloadClass(workloadClassStr); err == nil {
+				return workloadClass
+			} else {
+				logger.Error(err, "Invalid workload class label found on owner", "owner", owner.GetName())
+			}
+		}
+	}
+	if workloadClassStr, found := pod.GetLabels()[constants.WorkloadClassLabelKey]; found {
+		if workloadClass, err := v2alpha2.ParseWorkloadClass(workloadClassStr); err == nil {
+			return workloadClass
+		} else {
+			logger.Error(err, "Invalid workload class label found on pod", "pod", pod.GetName())
+		}
+	}
+	return ""
+}
+
 func (dg *DefaultGrouper) calcPodGroupPreemptibilityWithDefaults(
 	allOwners []*metav1.PartialObjectMetadata,
 	pod *v1.Pod,
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconsts "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/constants"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
@@ -875,6 +876,87 @@ func TestGetPodGroupMetadata_OwnerUserOverridePodUser(t *testing.T) {
 	assert.Equal(t, "ownerUser", podGroupMetadata.Labels["user"])
 }
 
+func TestGetPodGroupMetadata_DoNotConsolidateFromPod(t *testing.T) {
+	owner := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"kind":       "test_kind",
+			"apiVersion": "test_version",
+			"metadata": map[string]interface{}{
+				"name":      "test_name",
+				"namespace": "test_namespace",
+				"uid":       "1",
+			},
+		},
+	}
+	pod := &v1.Pod{
+		ObjectMeta: v12.ObjectMeta{
+			Annotations: map[string]string{
+				commonconsts.DoNotConsolidate: "true",
+			},
+		},
+	}
+
+	defaultGrouper := NewDefaultGrouper(queueLabelKey, nodePoolLabelKey, fake.NewFakeClient())
+	podGroupMetadata, err := defaultGrouper.GetPodGroupMetadata(owner, pod, convertOwnerToPartial(owner))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "true", podGroupMetadata.Annotations[commonconsts.DoNotConsolidate])
+}
+
+func TestGetPodGroupMetadata_DatasetsFromPod(t *testing.T) {
+	owner := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"kind":       "test_kind",
+			"apiVersion": "test_version",
+			"metadata": map[string]interface{}{
+				"name":      "test_name",
+				"namespace": "test_namespace",
+				"uid":       "1",
+			},
+		},
+	}
+	pod := &v1.Pod{
+		ObjectMeta: v12.ObjectMeta{
+			Annotations: map[string]string{
+				commonconsts.Datasets: "imagenet,coco",
+			},
+		},
+	}
+
+	defaultGrouper := NewDefaultGrouper(queueLabelKey, nodePoolLabelKey, fake.NewFakeClient())
+	podGroupMetadata, err := defaultGrouper.GetPodGroupMetadata(owner, pod, convertOwnerToPartial(owner))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "imagenet,coco", podGroupMetadata.Annotations[commonconsts.Datasets])
+}
+
+func TestGetPodGroupMetadata_QuotaReservationTimeoutFromPod(t *testing.T) {
+	owner := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"kind":       "test_kind",
+			"apiVersion": "test_version",
+			"metadata": map[string]interface{}{
+				"name":      "test_name",
+				"namespace": "test_namespace",
+				"uid":       "1",
+			},
+		},
+	}
+	pod := &v1.Pod{
+		ObjectMeta: v12.ObjectMeta{
+			Annotations: map[string]string{
+				commonconsts.QuotaReservationTimeout: "30s",
+			},
+		},
+	}
+
+	defaultGrouper := NewDefaultGrouper(queueLabelKey, nodePoolLabelKey, fake.NewFakeClient())
+	podGroupMetadata, err := defaultGrouper.GetPodGroupMetadata(owner, pod, convertOwnerToPartial(owner))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "30s", podGroupMetadata.Annotations[commonconsts.QuotaReservationTimeout])
+}
+
 func TestGetPodGroupMetadataWithTopology(t *testing.T) {
 	owner := &unstructured.Unstructured{
 		Object: map[string]interface{}{
@@ -1162,3 +1244,51 @@ func TestCalcPodGroupPreemptibility(t *testing.T) {
 		})
 	}
 }
+
+func TestCalcPodGroupWorkloadClass(t *testing.T) {
+	tests := []struct {
+		name           string
+		ownerLabels    map[string]string
+		podLabels      map[string]string
+		expectedResult v2alpha2.WorkloadClass
+	}{
+		{
+			name:           "from owner",
+			ownerLabels:    map[string]string{constants.WorkloadClassLabelKey: "best-effort-gpu"},
+			expectedResult: v2alpha2.BestEffortGPU,
+		},
+		{
+			name:           "from pod",
+			podLabels:      map[string]string{constants.WorkloadClassLabelKey: "guaranteed"},
+			expectedResult: v2alpha2.Guaranteed,
+		},
+		{
+			name:           "owner overrides pod",
+			ownerLabels:    map[string]string{constants.WorkloadClassLabelKey: "burstable-gpu"},
+			podLabels:      map[string]string{constants.WorkloadClassLabelKey: "guaranteed"},
+			expectedResult: v2alpha2.BurstableGPU,
+		},
+		{
+			name:           "owner invalid pod valid",
+			ownerLabels:    map[string]string{constants.WorkloadClassLabelKey: "invalid-value"},
+			podLabels:      map[string]string{constants.WorkloadClassLabelKey: "guaranteed"},
+			expectedResult: v2alpha2.Guaranteed,
+		},
+		{
+			name:           "no labels",
+			expectedResult: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner := &v12.PartialObjectMetadata{ObjectMeta: v12.ObjectMeta{Name: "owner", Labels: tt.ownerLabels}}
+			pod := &v1.Pod{ObjectMeta: v12.ObjectMeta{Name: "pod", Labels: tt.podLabels}}
+
+			defaultGrouper := NewDefaultGrouper(queueLabelKey, nodePoolLabelKey, fake.NewFakeClient())
+			workloadClass := defaultGrouper.calcPodGroupWorkloadClass([]*v12.PartialObjectMetadata{owner}, pod)
+
+			assert.Equal(t, tt.expectedResult, workloadClass)
+		})
+	}
+}
@@ -19,6 +19,8 @@ import (
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgroup"
 	pluginshub "github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/hub"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/kueue"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/priorityassignment"
 )
 
 type Interface interface {
@@ -37,6 +39,12 @@ type podGrouper struct {
 	// https://github.com/kubernetes/client-go/issues/1310#issuecomment-1921598658
 	// https://github.com/kubernetes-sigs/controller-runtime/issues/1222#issuecomment-713037979
 	clientWithoutCache client.Client
+
+	// kueueWorkloads applies the admission of Kueue workloads to their pod groups, when enabled
+	kueueWorkloads *kueue.WorkloadSource
+
+	// priorityRules derives the priority class of pod groups from the PriorityAssignmentRules, when enabled
+	priorityRules *priorityassignment.RuleSource
 }
 
 type GetPodGroupMetadataFunc func(topOwner *unstructured.Unstructured, pod *v1.Pod, otherOwners ...*metav1.PartialObjectMetadata) (*podgroup.Metadata, error)
@@ -51,6 +59,16 @@ func NewPodgrouper(client client.Client, clientWithoutCache client.Client, plugi
 	return podGrouper
 }
 
+// EnableKueueWorkloads makes the pod groups of jobs submitted to Kueue follow the admission of their Kueue workloads
+func (pg *podGrouper) EnableKueueWorkloads() {
+	pg.kueueWorkloads = kueue.NewWorkloadSource(pg.client)
+}
+
+// EnablePriorityAssignmentRules makes the priority class of pod groups follow the PriorityAssignmentRules
+func (pg *podGrouper) EnablePriorityAssignmentRules() {
+	pg.priorityRules = priorityassignment.NewRuleSource(pg.client)
+}
+
 func (pg *podGrouper) GetPodOwners(ctx context.Context, pod *v1.Pod) (
 	*unstructured.Unstructured, []*metav1.PartialObjectMetadata, error,
 ) {
@@ -81,7 +99,19 @@ func (pg *podGrouper) GetPGMetadata(ctx context.Context, pod *v1.Pod, topOwner *
 	plugin := pg.pluginsHub.GetPodGrouperPlugin(ownerKind)
 	logger.V(1).Info(fmt.Sprintf("Using %v plugin for pod.", plugin.Name()),
 		"pod", fmt.Sprintf("%s/%s", pod.Namespace, pod.Name), "topOwner", topOwner)
-	return plugin.GetPodGroupMetadata(topOwner, pod, allOwners...)
+	metadata, err := plugin.GetPodGroupMetadata(topOwner, pod, allOwners...)
+	if err != nil || metadata == nil {
+		return metadata, err
+	}
+	if pg.priorityRules != nil {
+		if err = pg.priorityRules.ApplyRules(ctx, pod, topOwner, allOwners, metadata); err != nil {
+			return nil, err
+		}
+	}
+	if pg.kueueWorkloads == nil {
+		return metadata, nil
+	}
+	return metadata, pg.kueueWorkloads.ApplyAdmission(ctx, topOwner, metadata)
 }
 
 func (pg *podGrouper) getResourceOwners(ctx context.Context, pod *v1.Pod) (
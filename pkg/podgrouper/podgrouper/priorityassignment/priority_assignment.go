@@ -0,0 +1,158 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package priorityassignment
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgroup"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/constants"
+)
+
+// RuleSource derives the priority class of pod groups from the PriorityAssignmentRules of the cluster, so that
+// the priority of workloads follows a central policy instead of the priority their users ask for.
+type RuleSource struct {
+	client client.Client
+}
+
+func NewRuleSource(client client.Client) *RuleSource {
+	return &RuleSource{client: client}
+}
+
+// +kubebuilder:rbac:groups=kai.scheduler,resources=priorityassignmentrules,verbs=get;list;watch
+
+// ApplyRules sets the priority class of the pod group to the priority class of the matching rule with the highest
+// precedence. Rules without override are skipped for workloads that set their own priority. Rules whose priority
+// class doesn't exist are skipped, and the pod group is left unchanged when no rule applies.
+func (s *RuleSource) ApplyRules(
+	ctx context.Context, pod *v1.Pod, topOwner *unstructured.Unstructured,
+	allOwners []*metav1.PartialObjectMetadata, metadata *podgroup.Metadata,
+) error {
+	logger := log.FromContext(ctx)
+
+	rules := &kaiv1alpha1.PriorityAssignmentRuleList{}
+	if err := s.client.List(ctx, rules); err != nil {
+		return fmt.Errorf("failed to list priority assignment rules: %w", err)
+	}
+	if len(rules.Items) == 0 {
+		return nil
+	}
+	sortByPrecedence(rules.Items)
+
+	explicitPriority := hasExplicitPriority(pod, topOwner, allOwners)
+	var namespaceLabels labels.Set
+	for _, rule := range rules.Items {
+		if explicitPriority && !rule.Spec.Override {
+			continue
+		}
+		if rule.Spec.NamespaceSelector != nil && namespaceLabels == nil {
+			namespace := &v1.Namespace{}
+			if err := s.client.Get(ctx, types.NamespacedName{Name: pod.Namespace}, namespace); err != nil {
+				return fmt.Errorf("failed to get namespace %s: %w", pod.Namespace, err)
+			}
+			namespaceLabels = labels.Set(namespace.Labels)
+		}
+
+		matches, err := matchesRule(&rule.Spec, topOwner, namespaceLabels)
+		if err != nil {
+			return fmt.Errorf("invalid priority assignment rule %s: %w", rule.Name, err)
+		}
+		if !matches {
+			continue
+		}
+
+		exists, err := s.priorityClassExists(ctx, rule.Spec.PriorityClassName)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			logger.V(1).Info("skipping priority assignment rule, its priority class doesn't exist",
+				"rule", rule.Name, "priorityClassName", rule.Spec.PriorityClassName)
+			continue
+		}
+
+		logger.V(1).Info("assigned priority class to pod group", "namespace", metadata.Namespace,
+			"name", metadata.Name, "priorityClassName", rule.Spec.PriorityClassName, "rule", rule.Name)
+		metadata.PriorityClassName = rule.Spec.PriorityClassName
+		return nil
+	}
+	return nil
+}
+
+func (s *RuleSource) priorityClassExists(ctx context.Context, priorityClassName string) (bool, error) {
+	priorityClass := &schedulingv1.PriorityClass{}
+	err := s.client.Get(ctx, client.ObjectKey{Name: priorityClassName}, priorityClass)
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get priority class %s: %w", priorityClassName, err)
+	}
+	return true, nil
+}
+
+// hasExplicitPriority checks if the user set the priority of the workload, by the priority label of the workload,
+// one of the pod's owners or the pod, or by the priority class of the pod
+func hasExplicitPriority(
+	pod *v1.Pod, topOwner *unstructured.Unstructured, allOwners []*metav1.PartialObjectMetadata,
+) bool {
+	if pod.Spec.PriorityClassName != "" || pod.Labels[constants.PriorityLabelKey] != "" ||
+		topOwner.GetLabels()[constants.PriorityLabelKey] != "" {
+		return true
+	}
+	return slices.ContainsFunc(allOwners, func(owner *metav1.PartialObjectMetadata) bool {
+		return owner.GetLabels()[constants.PriorityLabelKey] != ""
+	})
+}
+
+func sortByPrecedence(rules []kaiv1alpha1.PriorityAssignmentRule) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].Spec.Precedence != rules[j].Spec.Precedence {
+			return rules[i].Spec.Precedence > rules[j].Spec.Precedence
+		}
+		return rules[i].Name < rules[j].Name
+	})
+}
+
+func matchesRule(
+	spec *kaiv1alpha1.PriorityAssignmentRuleSpec, topOwner *unstructured.Unstructured, namespaceLabels labels.Set,
+) (bool, error) {
+	if len(spec.WorkloadKinds) > 0 {
+		groupKind := topOwner.GroupVersionKind().GroupKind()
+		if !slices.Contains(spec.WorkloadKinds, metav1.GroupKind{Group: groupKind.Group, Kind: groupKind.Kind}) {
+			return false, nil
+		}
+	}
+
+	matches, err := matchesSelector(spec.WorkloadSelector, labels.Set(topOwner.GetLabels()))
+	if err != nil || !matches {
+		return false, err
+	}
+	return matchesSelector(spec.NamespaceSelector, namespaceLabels)
+}
+
+func matchesSelector(labelSelector *metav1.LabelSelector, objectLabels labels.Set) (bool, error) {
+	if labelSelector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(objectLabels), nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package priorityassignment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgroup"
+	"github.com/NVIDIA/KAI-scheduler/pkg/podgrouper/podgrouper/plugins/constants"
+)
+
+const (
+	namespace       = "team-a"
+	defaultPriority = "train"
+)
+
+func TestApplyRules(t *testing.T) {
+	tests := []struct {
+		name             string
+		rules            []*kaiv1alpha1.PriorityAssignmentRule
+		jobLabels        map[string]string
+		podPriorityClass string
+		expectedPriority string
+	}{
+		{
+			name:             "no rules",
+			expectedPriority: defaultPriority,
+		},
+		{
+			name: "rule matching the workload kind",
+			rules: []*kaiv1alpha1.PriorityAssignmentRule{
+				getRule("batch-jobs", "build", 0, false, func(spec *kaiv1alpha1.PriorityAssignmentRuleSpec) {
+					spec.WorkloadKinds = []metav1.GroupKind{{Group: "batch", Kind: "Job"}}
+				}),
+			},
+			expectedPriority: "build",
+		},
+		{
+			name: "rule of another workload kind",
+			rules: []*kaiv1alpha1.PriorityAssignmentRule{
+				getRule("deployments", "inference", 0, false, func(spec *kaiv1alpha1.PriorityAssignmentRuleSpec) {
+					spec.WorkloadKinds = []metav1.GroupKind{{Group: "apps", Kind: "Deployment"}}
+				}),
+			},
+			expectedPriority: defaultPriority,
+		},
+		{
+			name: "rule matching the workload labels",
+			rules: []*kaiv1alpha1.PriorityAssignmentRule{
+				getRule("interactive", "build", 0, false, func(spec *kaiv1alpha1.PriorityAssignmentRuleSpec) {
+					spec.WorkloadSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"type": "interactive"}}
+				}),
+			},
+			jobLabels:        map[string]string{"type": "interactive"},
+			expectedPriority: "build",
+		},
+		{
+			name: "rule matching the namespace labels",
+			rules: []*kaiv1alpha1.PriorityAssignmentRule{
+				getRule("research", "inference", 0, false, func(spec *kaiv1alpha1.PriorityAssignmentRuleSpec) {
+					spec.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "research"}}
+				}),
+			},
+			expectedPriority: "inference",
+		},
+		{
+			name: "rule of other namespaces",
+			rules: []*kaiv1alpha1.PriorityAssignmentRule{
+				getRule("production", "inference", 0, false, func(spec *kaiv1alpha1.PriorityAssignmentRuleSpec) {
+					spec.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "production"}}
+				}),
+			},
+			expectedPriority: defaultPriority,
+		},
+		{
+			name: "rule with the highest precedence wins",
+			rules: []*kaiv1alpha1.PriorityAssignmentRule{
+				getRule("low", "build", 1, false, nil),
+				getRule("high", "inference", 10, false, nil),
+			},
+			expectedPriority: "inference",
+		},
+		{
+			name: "rules with the same precedence are ordered by name",
+			rules: []*kaiv1alpha1.PriorityAssignmentRule{
+				getRule("b-rule", "inference", 0, false, nil),
+				getRule("a-rule", "build", 0, false, nil),
+			},
+			expectedPriority: "build",
+		},
+		{
+			name: "rule with a missing priority class is skipped",
+			rules: []*kaiv1alpha1.PriorityAssignmentRule{
+				getRule("missing", "missing-priority", 10, false, nil),
+				getRule("fallback", "build", 0, false, nil),
+			},
+			expectedPriority: "build",
+		},
+		{
+			name: "priority label of the workload is respected",
+			rules: []*kaiv1alpha1.PriorityAssignmentRule{
+				getRule("all", "build", 0, false, nil),
+			},
+			jobLabels:        map[string]string{constants.PriorityLabelKey: defaultPriority},
+			expectedPriority: defaultPriority,
+		},
+		{
+			name: "priority class of the pod is respected",
+			rules: []*kaiv1alpha1.PriorityAssignmentRule{
+				getRule("all", "build", 0, false, nil),
+			},
+			podPriorityClass: defaultPriority,
+			expectedPriority: defaultPriority,
+		},
+		{
+			name: "override rule replaces the priority of the workload",
+			rules: []*kaiv1alpha1.PriorityAssignmentRule{
+				getRule("all", "build", 0, true, nil),
+			},
+			jobLabels:        map[string]string{constants.PriorityLabelKey: defaultPriority},
+			expectedPriority: "build",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objects := []client.Object{
+				&v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+					Name: namespace, Labels: map[string]string{"team": "research"},
+				}},
+				&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "build"}, Value: 100},
+				&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "inference"}, Value: 125},
+			}
+			for _, rule := range test.rules {
+				objects = append(objects, rule)
+			}
+			kubeClient := fake.NewClientBuilder().WithScheme(getScheme(t)).WithObjects(objects...).Build()
+
+			job := &unstructured.Unstructured{}
+			job.SetAPIVersion("batch/v1")
+			job.SetKind("Job")
+			job.SetName("job-1")
+			job.SetNamespace(namespace)
+			job.SetLabels(test.jobLabels)
+
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "job-1-pod", Namespace: namespace},
+				Spec:       v1.PodSpec{PriorityClassName: test.podPriorityClass},
+			}
+
+			metadata := &podgroup.Metadata{Namespace: namespace, Name: "pg-job-1", PriorityClassName: defaultPriority}
+			err := NewRuleSource(kubeClient).ApplyRules(context.Background(), pod, job, nil, metadata)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedPriority, metadata.PriorityClassName)
+		})
+	}
+}
+
+func getRule(
+	name, priorityClassName string, precedence int32, override bool,
+	mutate func(spec *kaiv1alpha1.PriorityAssignmentRuleSpec),
+) *kaiv1alpha1.PriorityAssignmentRule {
+	rule := &kaiv1alpha1.PriorityAssignmentRule{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: kaiv1alpha1.PriorityAssignmentRuleSpec{
+			PriorityClassName: priorityClassName,
+			Precedence:        precedence,
+			Override:          override,
+		},
+	}
+	if mutate != nil {
+		mutate(&rule.Spec)
+	}
+	return rule
+}
+
+func getScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, v1.AddToScheme(scheme))
+	assert.NoError(t, schedulingv1.AddToScheme(scheme))
+	assert.NoError(t, kaiv1alpha1.AddToScheme(scheme))
+	return scheme
+}
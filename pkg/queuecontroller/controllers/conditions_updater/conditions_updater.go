@@ -0,0 +1,250 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package conditions_updater
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
+)
+
+const megabytes = 1000 * 1000
+
+// These are the reasons of the events emitted when a queue condition becomes true.
+const (
+	LimitReachedEvent = "QueueLimitReached"
+	BorrowingEvent    = "QueueBorrowing"
+	ReclaimedEvent    = "QueueReclaimed"
+	StarvedEvent      = "QueueStarved"
+)
+
+// ConditionsUpdater sets the quota conditions of queues, and emits an event for every condition that becomes true,
+// so that alerts can be built on the standard event pipelines. It must run after the resources of the queue status
+// are updated.
+type ConditionsUpdater struct {
+	client.Client
+	Recorder      record.EventRecorder
+	QueueLabelKey string
+	// StarvationThreshold is how long a queue must have unallocated requests within its quota to be starved
+	StarvationThreshold time.Duration
+
+	mutex            sync.Mutex
+	underservedSince map[string]time.Time
+}
+
+// UpdateQueue updates the conditions of the queue status. Returns the time after which the queue should be
+// reconciled again for a pending starvation to be detected, or zero if none is pending.
+func (cu *ConditionsUpdater) UpdateQueue(ctx context.Context, queue *v2.Queue) (time.Duration, error) {
+	preemptedPodGroups, err := cu.listPreemptedPodGroups(ctx, queue)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update queue conditions: %v", err)
+	}
+
+	now := time.Now()
+	usage := getResourceUsage(queue)
+	starved, requeueAfter := cu.isStarved(queue.Name, usage, now)
+
+	wasOverQuota := v2.IsQueueConditionTrue(queue.Status.Conditions, v2.OverQuota)
+	wasReclaimed := v2.IsQueueConditionTrue(queue.Status.Conditions, v2.ReclaimVictim)
+	for _, condition := range []v2.QueueCondition{
+		atLimitCondition(usage),
+		overQuotaCondition(usage),
+		reclaimVictimCondition(preemptedPodGroups, wasOverQuota || wasReclaimed),
+		starvedCondition(usage, starved, cu.StarvationThreshold),
+	} {
+		transitioned := v2.SetQueueCondition(&queue.Status.Conditions, condition, metav1.NewTime(now))
+		if transitioned && condition.Status == v1.ConditionTrue {
+			cu.recordEvent(queue, condition)
+		}
+	}
+	return requeueAfter, nil
+}
+
+// ForgetQueue removes the state kept for a deleted queue
+func (cu *ConditionsUpdater) ForgetQueue(queueName string) {
+	cu.mutex.Lock()
+	defer cu.mutex.Unlock()
+	delete(cu.underservedSince, queueName)
+}
+
+func (cu *ConditionsUpdater) listPreemptedPodGroups(ctx context.Context, queue *v2.Queue) ([]string, error) {
+	queuePodGroups := v2alpha2.PodGroupList{}
+	err := cu.Client.List(ctx, &queuePodGroups, client.MatchingLabels{cu.QueueLabelKey: queue.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	var preempted []string
+	for _, podGroup := range queuePodGroups.Items {
+		if v2alpha2.IsPodGroupConditionTrue(podGroup.Status.Conditions, v2alpha2.PodGroupPreempted) {
+			preempted = append(preempted, fmt.Sprintf("%s/%s", podGroup.Namespace, podGroup.Name))
+		}
+	}
+	return preempted, nil
+}
+
+// isStarved returns true if the queue is underserved for longer than the starvation threshold, and otherwise the
+// time left until it is, if it is underserved
+func (cu *ConditionsUpdater) isStarved(queueName string, usage []resourceUsage, now time.Time) (bool, time.Duration) {
+	cu.mutex.Lock()
+	defer cu.mutex.Unlock()
+	if cu.underservedSince == nil {
+		cu.underservedSince = map[string]time.Time{}
+	}
+
+	if len(underservedResources(usage)) == 0 {
+		delete(cu.underservedSince, queueName)
+		return false, 0
+	}
+	since, found := cu.underservedSince[queueName]
+	if !found {
+		since = now
+		cu.underservedSince[queueName] = since
+	}
+	if remaining := cu.StarvationThreshold - now.Sub(since); remaining > 0 {
+		return false, remaining
+	}
+	return true, 0
+}
+
+func (cu *ConditionsUpdater) recordEvent(queue *v2.Queue, condition v2.QueueCondition) {
+	if cu.Recorder == nil {
+		return
+	}
+	switch condition.Type {
+	case v2.AtLimit:
+		cu.Recorder.Event(queue, v1.EventTypeWarning, LimitReachedEvent, condition.Message)
+	case v2.OverQuota:
+		cu.Recorder.Event(queue, v1.EventTypeNormal, BorrowingEvent, condition.Message)
+	case v2.ReclaimVictim:
+		cu.Recorder.Event(queue, v1.EventTypeWarning, ReclaimedEvent, condition.Message)
+	case v2.Starved:
+		cu.Recorder.Event(queue, v1.EventTypeWarning, StarvedEvent, condition.Message)
+	}
+}
+
+type resourceUsage struct {
+	name      string
+	allocated float64
+	requested float64
+	quota     float64
+	limit     float64
+}
+
+// getResourceUsage returns the allocated and requested resources of the queue in the units of its quota
+func getResourceUsage(queue *v2.Queue) []resourceUsage {
+	var queueResources v2.QueueResources
+	if queue.Spec.Resources != nil {
+		queueResources = *queue.Spec.Resources
+	}
+
+	allocatedGPUs := resources.AcceleratorQuantity(queue.Status.Allocated)
+	requestedGPUs := resources.AcceleratorQuantity(queue.Status.Requested)
+	allocatedCPU := queue.Status.Allocated[v1.ResourceCPU]
+	requestedCPU := queue.Status.Requested[v1.ResourceCPU]
+	allocatedMemory := queue.Status.Allocated[v1.ResourceMemory]
+	requestedMemory := queue.Status.Requested[v1.ResourceMemory]
+	return []resourceUsage{
+		{
+			name:      "gpu",
+			allocated: allocatedGPUs.AsApproximateFloat64(),
+			requested: requestedGPUs.AsApproximateFloat64(),
+			quota:     queueResources.GPU.Quota,
+			limit:     queueResources.GPU.Limit,
+		},
+		{
+			name:      "cpu",
+			allocated: float64(allocatedCPU.MilliValue()),
+			requested: float64(requestedCPU.MilliValue()),
+			quota:     queueResources.CPU.Quota,
+			limit:     queueResources.CPU.Limit,
+		},
+		{
+			name:      "memory",
+			allocated: allocatedMemory.AsApproximateFloat64() / megabytes,
+			requested: requestedMemory.AsApproximateFloat64() / megabytes,
+			quota:     queueResources.Memory.Quota,
+			limit:     queueResources.Memory.Limit,
+		},
+	}
+}
+
+func atLimitCondition(usage []resourceUsage) v2.QueueCondition {
+	var atLimit []string
+	for _, resource := range usage {
+		if resource.limit != constants.UnlimitedResourceQuantity && resource.requested > 0 &&
+			resource.allocated >= resource.limit {
+			atLimit = append(atLimit, resource.name)
+		}
+	}
+	if len(atLimit) == 0 {
+		return condition(v2.AtLimit, v1.ConditionFalse, v2.QueueReasonWithinLimit, "")
+	}
+	return condition(v2.AtLimit, v1.ConditionTrue, v2.QueueReasonLimitReached,
+		fmt.Sprintf("queue reached its %s limit", strings.Join(atLimit, ", ")))
+}
+
+func overQuotaCondition(usage []resourceUsage) v2.QueueCondition {
+	var overQuota []string
+	for _, resource := range usage {
+		if resource.quota != constants.UnlimitedResourceQuantity && resource.allocated > resource.quota {
+			overQuota = append(overQuota, resource.name)
+		}
+	}
+	if len(overQuota) == 0 {
+		return condition(v2.OverQuota, v1.ConditionFalse, v2.QueueReasonWithinQuota, "")
+	}
+	return condition(v2.OverQuota, v1.ConditionTrue, v2.QueueReasonOverQuota,
+		fmt.Sprintf("queue is borrowing %s over its deserved quota", strings.Join(overQuota, ", ")))
+}
+
+// reclaimVictimCondition is true while the pod groups that were preempted when the queue was over quota are not
+// scheduled again. The preempted pod groups free the over quota resources, so the condition is kept after the queue
+// is no longer over quota.
+func reclaimVictimCondition(preemptedPodGroups []string, overQuota bool) v2.QueueCondition {
+	if len(preemptedPodGroups) == 0 || !overQuota {
+		return condition(v2.ReclaimVictim, v1.ConditionFalse, v2.QueueReasonNotReclaimed, "")
+	}
+	return condition(v2.ReclaimVictim, v1.ConditionTrue, v2.QueueReasonReclaimed,
+		fmt.Sprintf("pod groups %v of the queue were evicted while it was over quota", preemptedPodGroups))
+}
+
+func starvedCondition(usage []resourceUsage, starved bool, threshold time.Duration) v2.QueueCondition {
+	if !starved {
+		return condition(v2.Starved, v1.ConditionFalse, v2.QueueReasonNotStarved, "")
+	}
+	return condition(v2.Starved, v1.ConditionTrue, v2.QueueReasonStarved,
+		fmt.Sprintf("queue has %s requests within its deserved quota that were not allocated for over %v",
+			strings.Join(underservedResources(usage), ", "), threshold))
+}
+
+// underservedResources returns the resources that the queue requests more of than allocated while its allocation is
+// below its deserved quota
+func underservedResources(usage []resourceUsage) []string {
+	var underserved []string
+	for _, resource := range usage {
+		if resource.quota > 0 && resource.allocated < resource.quota && resource.requested > resource.allocated {
+			underserved = append(underserved, resource.name)
+		}
+	}
+	return underserved
+}
+
+func condition(
+	conditionType v2.QueueConditionType, status v1.ConditionStatus, reason, message string,
+) v2.QueueCondition {
+	return v2.QueueCondition{Type: conditionType, Status: status, Reason: reason, Message: message}
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package conditions_updater
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+)
+
+const queueLabelName = "kai/queue"
+
+func TestUpdateQueue(t *testing.T) {
+	queue := newQueue(v2.QueueResources{
+		GPU:    v2.QueueResource{Quota: 2, Limit: 4},
+		CPU:    v2.QueueResource{Quota: -1, Limit: -1},
+		Memory: v2.QueueResource{Quota: -1, Limit: -1},
+	}, v1.ResourceList{"nvidia.com/gpu": resource.MustParse("4")}, v1.ResourceList{
+		"nvidia.com/gpu": resource.MustParse("6"),
+	})
+	recorder := record.NewFakeRecorder(10)
+	updater := newUpdater(t, recorder, preemptedPodGroup("preempted"))
+
+	requeueAfter, err := updater.UpdateQueue(context.Background(), queue)
+	assert.NoError(t, err)
+	assert.Zero(t, requeueAfter)
+	assertCondition(t, queue, v2.AtLimit, v1.ConditionTrue)
+	assertCondition(t, queue, v2.OverQuota, v1.ConditionTrue)
+	assertCondition(t, queue, v2.ReclaimVictim, v1.ConditionFalse)
+	assertCondition(t, queue, v2.Starved, v1.ConditionFalse)
+	assert.Equal(t, []string{
+		"Warning QueueLimitReached queue reached its gpu limit",
+		"Normal QueueBorrowing queue is borrowing gpu over its deserved quota",
+	}, drainEvents(recorder))
+
+	// The queue was over quota when its pod group was preempted
+	_, err = updater.UpdateQueue(context.Background(), queue)
+	assert.NoError(t, err)
+	assertCondition(t, queue, v2.ReclaimVictim, v1.ConditionTrue)
+	assert.Equal(t, []string{
+		"Warning QueueReclaimed pod groups [ns/preempted] of the queue were evicted while it was over quota",
+	}, drainEvents(recorder), "events are only emitted when conditions become true")
+
+	queue.Status.Allocated = v1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")}
+	queue.Status.Requested = queue.Status.Allocated
+	_, err = updater.UpdateQueue(context.Background(), queue)
+	assert.NoError(t, err)
+	assertCondition(t, queue, v2.AtLimit, v1.ConditionFalse)
+	assertCondition(t, queue, v2.OverQuota, v1.ConditionFalse)
+	assertCondition(t, queue, v2.ReclaimVictim, v1.ConditionTrue)
+	assert.Empty(t, drainEvents(recorder))
+}
+
+func TestUpdateQueue_Starvation(t *testing.T) {
+	queue := newQueue(v2.QueueResources{
+		GPU:    v2.QueueResource{Quota: 4, Limit: -1},
+		CPU:    v2.QueueResource{Quota: -1, Limit: -1},
+		Memory: v2.QueueResource{Quota: -1, Limit: -1},
+	}, v1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")}, v1.ResourceList{
+		"nvidia.com/gpu": resource.MustParse("3"),
+	})
+	recorder := record.NewFakeRecorder(10)
+	updater := newUpdater(t, recorder)
+	updater.StarvationThreshold = time.Hour
+
+	requeueAfter, err := updater.UpdateQueue(context.Background(), queue)
+	assert.NoError(t, err)
+	assert.Greater(t, requeueAfter, 59*time.Minute)
+	assertCondition(t, queue, v2.Starved, v1.ConditionFalse)
+
+	updater.underservedSince[queue.Name] = time.Now().Add(-2 * time.Hour)
+	requeueAfter, err = updater.UpdateQueue(context.Background(), queue)
+	assert.NoError(t, err)
+	assert.Zero(t, requeueAfter)
+	assertCondition(t, queue, v2.Starved, v1.ConditionTrue)
+	assert.Equal(t, []string{
+		"Warning QueueStarved queue has gpu requests within its deserved quota that were not allocated for over 1h0m0s",
+	}, drainEvents(recorder))
+
+	queue.Status.Allocated = queue.Status.Requested
+	_, err = updater.UpdateQueue(context.Background(), queue)
+	assert.NoError(t, err)
+	assertCondition(t, queue, v2.Starved, v1.ConditionFalse)
+	assert.NotContains(t, updater.underservedSince, queue.Name)
+}
+
+func newQueue(resources v2.QueueResources, allocated, requested v1.ResourceList) *v2.Queue {
+	return &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "queue-name"},
+		Spec:       v2.QueueSpec{Resources: &resources},
+		Status:     v2.QueueStatus{Allocated: allocated, Requested: requested},
+	}
+}
+
+func preemptedPodGroup(name string) *v2alpha2.PodGroup {
+	return &v2alpha2.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "ns",
+			Labels:    map[string]string{queueLabelName: "queue-name"},
+		},
+		Status: v2alpha2.PodGroupStatus{
+			Conditions: []v2alpha2.PodGroupCondition{
+				{Type: v2alpha2.PodGroupPreempted, Status: v1.ConditionTrue},
+			},
+		},
+	}
+}
+
+func newUpdater(t *testing.T, recorder record.EventRecorder, objects ...client.Object) *ConditionsUpdater {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, v2alpha2.AddToScheme(scheme))
+	assert.NoError(t, v2.AddToScheme(scheme))
+	return &ConditionsUpdater{
+		Client:        fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+		Recorder:      recorder,
+		QueueLabelKey: queueLabelName,
+	}
+}
+
+func assertCondition(t *testing.T, queue *v2.Queue, conditionType v2.QueueConditionType, status v1.ConditionStatus) {
+	condition := v2.FindQueueCondition(queue.Status.Conditions, conditionType)
+	if assert.NotNil(t, condition, "condition %s", conditionType) {
+		assert.Equal(t, status, condition.Status, "condition %s", conditionType)
+	}
+}
+
+func drainEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+	for {
+		select {
+		case event := <-recorder.Events:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
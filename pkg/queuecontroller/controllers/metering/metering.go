@@ -0,0 +1,78 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metering accounts the GPU hours consumed by queues against their budgets.
+package metering
+
+import (
+	"math"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
+)
+
+// DefaultInterval is the longest time between two meterings of a queue with a budget
+const DefaultInterval = 5 * time.Minute
+
+// BudgetMeter accounts the GPU hours consumed by queues with a budget in the budget status of the queues. The
+// consumption since the last metering is the GPUs allocated to the queue multiplied by the time that passed, so it
+// must run before the resources of the queue status are updated.
+type BudgetMeter struct {
+	// Interval is the longest time between two meterings of a queue with a budget. Defaults to DefaultInterval.
+	Interval time.Duration
+}
+
+// UpdateQueue updates the budget status of the queue up to the given time. Returns the time after which the queue
+// should be metered again, or zero if the queue has no budget.
+func (bm *BudgetMeter) UpdateQueue(queue *v2.Queue, now time.Time) time.Duration {
+	budget := queue.Spec.Budget
+	if budget == nil {
+		queue.Status.Budget = nil
+		return 0
+	}
+
+	periodStart := budget.GetPeriodStart(now)
+	status := queue.Status.Budget
+	if status == nil {
+		// Consumption before the budget was set is not known
+		status = &v2.QueueBudgetStatus{
+			PeriodStart:      metav1.NewTime(periodStart),
+			LastMeteringTime: metav1.NewTime(now),
+		}
+	}
+	meteredSince := status.LastMeteringTime.Time
+	if !status.PeriodStart.Time.Equal(periodStart) {
+		status.PeriodStart = metav1.NewTime(periodStart)
+		status.ConsumedGPUHours = 0
+		if meteredSince.Before(periodStart) {
+			meteredSince = periodStart
+		}
+	}
+
+	allocatedGPUs := resources.AcceleratorQuantity(queue.Status.Allocated)
+	gpus := allocatedGPUs.AsApproximateFloat64()
+	if elapsed := now.Sub(meteredSince); elapsed > 0 {
+		status.ConsumedGPUHours += gpus * elapsed.Hours()
+		status.LastMeteringTime = metav1.NewTime(now)
+	}
+	queue.Status.Budget = status
+
+	return bm.nextMetering(budget, status, gpus)
+}
+
+// nextMetering returns the time until the budget is exhausted at the current allocation, if it is sooner than the
+// metering interval
+func (bm *BudgetMeter) nextMetering(budget *v2.QueueBudget, status *v2.QueueBudgetStatus, gpus float64) time.Duration {
+	interval := bm.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	remainingGPUHours := budget.GPUHours - status.ConsumedGPUHours
+	if gpus <= 0 || remainingGPUHours <= 0 || remainingGPUHours/gpus >= interval.Hours() {
+		return interval
+	}
+	return time.Duration(math.Ceil(remainingGPUHours / gpus * float64(time.Hour)))
+}
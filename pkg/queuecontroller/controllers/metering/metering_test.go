@@ -0,0 +1,151 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package metering
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+// wednesday is in the week starting on monday
+var (
+	monday    = time.Date(2025, time.June, 2, 0, 0, 0, 0, time.UTC)
+	wednesday = time.Date(2025, time.June, 4, 12, 0, 0, 0, time.UTC)
+)
+
+func newQueue(budget *v2.QueueBudget, status *v2.QueueBudgetStatus, allocatedGPUs string) *v2.Queue {
+	queue := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+		Spec:       v2.QueueSpec{Budget: budget},
+		Status:     v2.QueueStatus{Budget: status},
+	}
+	if allocatedGPUs != "" {
+		queue.Status.Allocated = v1.ResourceList{constants.GpuResource: resource.MustParse(allocatedGPUs)}
+	}
+	return queue
+}
+
+func TestUpdateQueue(t *testing.T) {
+	weekly := &v2.QueueBudget{GPUHours: 100, Period: v2.QueueBudgetPeriodWeekly}
+	tests := []struct {
+		name                 string
+		queue                *v2.Queue
+		now                  time.Time
+		expectedStatus       *v2.QueueBudgetStatus
+		expectedRequeueAfter time.Duration
+	}{
+		{
+			name:                 "queue without a budget",
+			queue:                newQueue(nil, &v2.QueueBudgetStatus{ConsumedGPUHours: 10}, "4"),
+			now:                  wednesday,
+			expectedStatus:       nil,
+			expectedRequeueAfter: 0,
+		},
+		{
+			name:  "first metering starts the period without consumption",
+			queue: newQueue(weekly, nil, "4"),
+			now:   wednesday,
+			expectedStatus: &v2.QueueBudgetStatus{
+				PeriodStart:      metav1.NewTime(monday),
+				LastMeteringTime: metav1.NewTime(wednesday),
+			},
+			expectedRequeueAfter: DefaultInterval,
+		},
+		{
+			name:  "monthly period starts on the first day of the month",
+			queue: newQueue(&v2.QueueBudget{GPUHours: 100, Period: v2.QueueBudgetPeriodMonthly}, nil, ""),
+			now:   wednesday,
+			expectedStatus: &v2.QueueBudgetStatus{
+				PeriodStart:      metav1.NewTime(time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)),
+				LastMeteringTime: metav1.NewTime(wednesday),
+			},
+			expectedRequeueAfter: DefaultInterval,
+		},
+		{
+			name: "allocated gpus are accounted since the last metering",
+			queue: newQueue(weekly, &v2.QueueBudgetStatus{
+				PeriodStart:      metav1.NewTime(monday),
+				ConsumedGPUHours: 10,
+				LastMeteringTime: metav1.NewTime(wednesday.Add(-time.Hour)),
+			}, "4"),
+			now: wednesday,
+			expectedStatus: &v2.QueueBudgetStatus{
+				PeriodStart:      metav1.NewTime(monday),
+				ConsumedGPUHours: 14,
+				LastMeteringTime: metav1.NewTime(wednesday),
+			},
+			expectedRequeueAfter: DefaultInterval,
+		},
+		{
+			name: "new period accounts only the time since it started",
+			queue: newQueue(weekly, &v2.QueueBudgetStatus{
+				PeriodStart:      metav1.NewTime(monday.AddDate(0, 0, -7)),
+				ConsumedGPUHours: 90,
+				LastMeteringTime: metav1.NewTime(monday.Add(-time.Hour)),
+			}, "2"),
+			now: monday.Add(2 * time.Hour),
+			expectedStatus: &v2.QueueBudgetStatus{
+				PeriodStart:      metav1.NewTime(monday),
+				ConsumedGPUHours: 4,
+				LastMeteringTime: metav1.NewTime(monday.Add(2 * time.Hour)),
+			},
+			expectedRequeueAfter: DefaultInterval,
+		},
+		{
+			name: "requeue when the budget is about to be exhausted",
+			queue: newQueue(weekly, &v2.QueueBudgetStatus{
+				PeriodStart:      metav1.NewTime(monday),
+				ConsumedGPUHours: 99,
+				LastMeteringTime: metav1.NewTime(wednesday),
+			}, "60"),
+			now: wednesday,
+			expectedStatus: &v2.QueueBudgetStatus{
+				PeriodStart:      metav1.NewTime(monday),
+				ConsumedGPUHours: 99,
+				LastMeteringTime: metav1.NewTime(wednesday),
+			},
+			expectedRequeueAfter: time.Minute,
+		},
+		{
+			name: "exhausted budget keeps being metered",
+			queue: newQueue(weekly, &v2.QueueBudgetStatus{
+				PeriodStart:      metav1.NewTime(monday),
+				ConsumedGPUHours: 100,
+				LastMeteringTime: metav1.NewTime(wednesday.Add(-30 * time.Minute)),
+			}, "2"),
+			now: wednesday,
+			expectedStatus: &v2.QueueBudgetStatus{
+				PeriodStart:      metav1.NewTime(monday),
+				ConsumedGPUHours: 101,
+				LastMeteringTime: metav1.NewTime(wednesday),
+			},
+			expectedRequeueAfter: DefaultInterval,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meter := &BudgetMeter{}
+			requeueAfter := meter.UpdateQueue(tt.queue, tt.now)
+			assert.Equal(t, tt.expectedRequeueAfter, requeueAfter)
+			if tt.expectedStatus == nil {
+				assert.Nil(t, tt.queue.Status.Budget)
+				return
+			}
+			status := tt.queue.Status.Budget
+			assert.True(t, tt.expectedStatus.PeriodStart.Equal(&status.PeriodStart),
+				"period start %v", status.PeriodStart)
+			assert.True(t, tt.expectedStatus.LastMeteringTime.Equal(&status.LastMeteringTime),
+				"last metering time %v", status.LastMeteringTime)
+			assert.InDelta(t, tt.expectedStatus.ConsumedGPUHours, status.ConsumedGPUHours, 1e-9)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/queuecontroller/controllers/namespace_queues"
+)
+
+// NamespaceQueueReconciler keeps a leaf queue in sync with the annotations of every opted-in namespace
+type NamespaceQueueReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	syncer namespace_queues.Syncer
+}
+
+//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+//+kubebuilder:rbac:groups=scheduling.run.ai,resources=queues,verbs=create;delete
+
+func (r *NamespaceQueueReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("Reconcile for namespace queue", "namespace", req.Name)
+
+	return ctrl.Result{}, r.syncer.SyncNamespace(ctx, req.Name)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NamespaceQueueReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
+	r.syncer = namespace_queues.Syncer{Client: r.Client}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("namespacequeue").
+		For(&v1.Namespace{}).
+		Watches(&v2.Queue{},
+			handler.EnqueueRequestsFromMapFunc(enqueueManagedQueueNamespace)).
+		WithOptions(
+			controller.Options{
+				SkipNameValidation: &skipNameValidation,
+			}).
+		Complete(r)
+}
+
+func enqueueManagedQueueNamespace(_ context.Context, q client.Object) []reconcile.Request {
+	namespaceName, found := q.GetLabels()[namespace_queues.ManagedByNamespaceLabel]
+	if !found {
+		return []reconcile.Request{}
+	}
+
+	return []reconcile.Request{
+		{
+			NamespacedName: types.NamespacedName{Name: namespaceName},
+		},
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package namespace_queues
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+)
+
+const (
+	annotationPrefix = "kai.scheduler/"
+
+	// AutoQueueAnnotation opts a namespace in to automatic queue creation
+	AutoQueueAnnotation = annotationPrefix + "auto-queue"
+	// ParentQueueAnnotation sets the parent of the namespace's queue
+	ParentQueueAnnotation = annotationPrefix + "queue-parent"
+
+	// ManagedByNamespaceLabel marks queues that are created and owned by a namespace
+	ManagedByNamespaceLabel = annotationPrefix + "managed-by-namespace"
+
+	defaultQuota           = 0
+	defaultLimit           = -1
+	defaultOverQuotaWeight = 1
+)
+
+// Syncer keeps a leaf queue per opted-in namespace, configured from the namespace annotations:
+// kai.scheduler/queue-parent and kai.scheduler/queue-<gpu|cpu|memory>-<quota|limit|over-quota-weight>.
+type Syncer struct {
+	client.Client
+}
+
+// SyncNamespace creates, updates or deletes the queue of the namespace according to its current state
+func (s *Syncer) SyncNamespace(ctx context.Context, namespaceName string) error {
+	logger := log.FromContext(ctx)
+
+	namespace := &v1.Namespace{}
+	err := s.Get(ctx, client.ObjectKey{Name: namespaceName}, namespace)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	namespaceExists := err == nil
+
+	queue := &v2.Queue{}
+	err = s.Get(ctx, client.ObjectKey{Name: namespaceName}, queue)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	queueExists := err == nil
+
+	if queueExists && queue.Labels[ManagedByNamespaceLabel] != namespaceName {
+		logger.V(1).Info("Queue is not managed by namespace, skipping", "queue", queue.Name)
+		return nil
+	}
+
+	if !namespaceExists || !isAutoQueueNamespace(namespace) {
+		if !queueExists {
+			return nil
+		}
+		logger.Info("Deleting namespace queue", "queue", queue.Name)
+		return client.IgnoreNotFound(s.Delete(ctx, queue))
+	}
+
+	desiredSpec, err := queueSpecFromAnnotations(namespace.Annotations)
+	if err != nil {
+		return fmt.Errorf("invalid queue annotations on namespace %s: %v", namespaceName, err)
+	}
+
+	if !queueExists {
+		queue = &v2.Queue{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            namespaceName,
+				Labels:          map[string]string{ManagedByNamespaceLabel: namespaceName},
+				OwnerReferences: []metav1.OwnerReference{namespaceOwnerReference(namespace)},
+			},
+			Spec: desiredSpec,
+		}
+		logger.Info("Creating namespace queue", "queue", queue.Name)
+		return s.Create(ctx, queue)
+	}
+
+	if reflect.DeepEqual(queue.Spec.ParentQueue, desiredSpec.ParentQueue) &&
+		reflect.DeepEqual(queue.Spec.Resources, desiredSpec.Resources) {
+		return nil
+	}
+	originalQueue := queue.DeepCopy()
+	queue.Spec.ParentQueue = desiredSpec.ParentQueue
+	queue.Spec.Resources = desiredSpec.Resources
+	logger.Info("Updating namespace queue", "queue", queue.Name)
+	return s.Patch(ctx, queue, client.MergeFrom(originalQueue))
+}
+
+func isAutoQueueNamespace(namespace *v1.Namespace) bool {
+	if namespace.DeletionTimestamp != nil {
+		return false
+	}
+	enabled, err := strconv.ParseBool(namespace.Annotations[AutoQueueAnnotation])
+	return err == nil && enabled
+}
+
+func queueSpecFromAnnotations(annotations map[string]string) (v2.QueueSpec, error) {
+	spec := v2.QueueSpec{
+		ParentQueue: annotations[ParentQueueAnnotation],
+		Resources:   &v2.QueueResources{},
+	}
+
+	resources := map[string]*v2.QueueResource{
+		"gpu":    &spec.Resources.GPU,
+		"cpu":    &spec.Resources.CPU,
+		"memory": &spec.Resources.Memory,
+	}
+	for resourceName, queueResource := range resources {
+		var err error
+		if queueResource.Quota, err = annotationValue(annotations, resourceName, "quota", defaultQuota); err != nil {
+			return spec, err
+		}
+		if queueResource.Limit, err = annotationValue(annotations, resourceName, "limit", defaultLimit); err != nil {
+			return spec, err
+		}
+		if queueResource.OverQuotaWeight, err = annotationValue(
+			annotations, resourceName, "over-quota-weight", defaultOverQuotaWeight); err != nil {
+			return spec, err
+		}
+	}
+
+	return spec, nil
+}
+
+func annotationValue(annotations map[string]string, resourceName, field string, defaultValue float64) (float64, error) {
+	key := fmt.Sprintf("%squeue-%s-%s", annotationPrefix, resourceName, field)
+	value, found := annotations[key]
+	if !found {
+		return defaultValue, nil
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse annotation %s=%s: %v", key, value, err)
+	}
+	return parsed, nil
+}
+
+func namespaceOwnerReference(namespace *v1.Namespace) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: "v1",
+		Kind:       "Namespace",
+		Name:       namespace.Name,
+		UID:        namespace.UID,
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package namespace_queues
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+)
+
+func newTestSyncer(t *testing.T, objects ...client.Object) *Syncer {
+	scheme := runtime.NewScheme()
+	assert.Nil(t, clientgoscheme.AddToScheme(scheme))
+	assert.Nil(t, v2.AddToScheme(scheme))
+
+	return &Syncer{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+	}
+}
+
+func newNamespace(name string, annotations map[string]string) *v1.Namespace {
+	return &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			UID:         "ns-uid",
+			Annotations: annotations,
+		},
+	}
+}
+
+func TestSyncNamespaceCreatesQueue(t *testing.T) {
+	syncer := newTestSyncer(t, newNamespace("team-a", map[string]string{
+		AutoQueueAnnotation:                         "true",
+		ParentQueueAnnotation:                       "research",
+		"kai.scheduler/queue-gpu-quota":             "4",
+		"kai.scheduler/queue-gpu-limit":             "8",
+		"kai.scheduler/queue-cpu-over-quota-weight": "2",
+	}))
+
+	assert.Nil(t, syncer.SyncNamespace(context.Background(), "team-a"))
+
+	queue := &v2.Queue{}
+	assert.Nil(t, syncer.Get(context.Background(), client.ObjectKey{Name: "team-a"}, queue))
+	assert.Equal(t, "team-a", queue.Labels[ManagedByNamespaceLabel])
+	assert.Equal(t, "research", queue.Spec.ParentQueue)
+	assert.Equal(t, v2.QueueResource{Quota: 4, Limit: 8, OverQuotaWeight: 1}, queue.Spec.Resources.GPU)
+	assert.Equal(t, v2.QueueResource{Quota: 0, Limit: -1, OverQuotaWeight: 2}, queue.Spec.Resources.CPU)
+	assert.Equal(t, v2.QueueResource{Quota: 0, Limit: -1, OverQuotaWeight: 1}, queue.Spec.Resources.Memory)
+	assert.Len(t, queue.OwnerReferences, 1)
+	assert.Equal(t, "Namespace", queue.OwnerReferences[0].Kind)
+}
+
+func TestSyncNamespaceUpdatesQueue(t *testing.T) {
+	namespace := newNamespace("team-a", map[string]string{
+		AutoQueueAnnotation:             "true",
+		"kai.scheduler/queue-gpu-quota": "2",
+	})
+	syncer := newTestSyncer(t, namespace)
+	assert.Nil(t, syncer.SyncNamespace(context.Background(), "team-a"))
+
+	namespace.Annotations["kai.scheduler/queue-gpu-quota"] = "6"
+	assert.Nil(t, syncer.Update(context.Background(), namespace))
+	assert.Nil(t, syncer.SyncNamespace(context.Background(), "team-a"))
+
+	queue := &v2.Queue{}
+	assert.Nil(t, syncer.Get(context.Background(), client.ObjectKey{Name: "team-a"}, queue))
+	assert.Equal(t, float64(6), queue.Spec.Resources.GPU.Quota)
+}
+
+func TestSyncNamespaceDeletesQueue(t *testing.T) {
+	queue := &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "team-a",
+			Labels: map[string]string{ManagedByNamespaceLabel: "team-a"},
+		},
+	}
+
+	tests := map[string][]client.Object{
+		"namespace deleted":       {queue.DeepCopy()},
+		"annotation removed":      {queue.DeepCopy(), newNamespace("team-a", nil)},
+		"annotation set to false": {queue.DeepCopy(), newNamespace("team-a", map[string]string{AutoQueueAnnotation: "false"})},
+	}
+	for name, objects := range tests {
+		t.Run(name, func(t *testing.T) {
+			syncer := newTestSyncer(t, objects...)
+			assert.Nil(t, syncer.SyncNamespace(context.Background(), "team-a"))
+
+			err := syncer.Get(context.Background(), client.ObjectKey{Name: "team-a"}, &v2.Queue{})
+			assert.True(t, errors.IsNotFound(err))
+		})
+	}
+}
+
+func TestSyncNamespaceSkipsUnmanagedQueue(t *testing.T) {
+	syncer := newTestSyncer(t,
+		newNamespace("team-a", map[string]string{
+			AutoQueueAnnotation:             "true",
+			"kai.scheduler/queue-gpu-quota": "2",
+		}),
+		&v2.Queue{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+	)
+	assert.Nil(t, syncer.SyncNamespace(context.Background(), "team-a"))
+
+	queue := &v2.Queue{}
+	assert.Nil(t, syncer.Get(context.Background(), client.ObjectKey{Name: "team-a"}, queue))
+	assert.Nil(t, queue.Spec.Resources)
+}
+
+func TestSyncNamespaceInvalidAnnotation(t *testing.T) {
+	syncer := newTestSyncer(t, newNamespace("team-a", map[string]string{
+		AutoQueueAnnotation:             "true",
+		"kai.scheduler/queue-gpu-quota": "lots",
+	}))
+	assert.NotNil(t, syncer.SyncNamespace(context.Background(), "team-a"))
+}
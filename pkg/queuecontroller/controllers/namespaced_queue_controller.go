@@ -0,0 +1,68 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/queuecontroller/controllers/namespaced_queues"
+)
+
+// NamespacedQueueReconciler keeps a cluster-scoped leaf queue in sync with every NamespacedQueue
+type NamespacedQueueReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	syncer namespaced_queues.Syncer
+}
+
+//+kubebuilder:rbac:groups=kai.scheduler,resources=namespacedqueues,verbs=get;list;watch
+//+kubebuilder:rbac:groups=kai.scheduler,resources=namespacedqueues/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=scheduling.run.ai,resources=queues,verbs=create;patch;delete
+
+func (r *NamespacedQueueReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("Reconcile for namespaced queue", "namespacedQueue", req.NamespacedName)
+
+	return ctrl.Result{}, r.syncer.SyncNamespacedQueue(ctx, req.NamespacedName)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NamespacedQueueReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
+	r.syncer = namespaced_queues.Syncer{Client: r.Client}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("namespacedqueue").
+		For(&kaiv1alpha1.NamespacedQueue{}).
+		Watches(&v2.Queue{},
+			handler.EnqueueRequestsFromMapFunc(enqueueManagingNamespacedQueue)).
+		WithOptions(
+			controller.Options{
+				SkipNameValidation: &skipNameValidation,
+			}).
+		Complete(r)
+}
+
+func enqueueManagingNamespacedQueue(_ context.Context, q client.Object) []reconcile.Request {
+	namespacedQueue, found := namespaced_queues.ManagingNamespacedQueue(q)
+	if !found {
+		return []reconcile.Request{}
+	}
+
+	return []reconcile.Request{
+		{
+			NamespacedName: namespacedQueue,
+		},
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package namespaced_queues
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+)
+
+// ManagedByNamespacedQueueAnnotation marks queues that are created and owned by a NamespacedQueue, with the
+// <namespace>/<name> of the NamespacedQueue as value
+const ManagedByNamespacedQueueAnnotation = "kai.scheduler/managed-by-namespaced-queue"
+
+// QueueName returns the name of the cluster-scoped queue of a NamespacedQueue. Namespace names can't contain dots,
+// so the names of queues of different NamespacedQueues never collide.
+func QueueName(namespacedQueue types.NamespacedName) string {
+	return fmt.Sprintf("%s.%s", namespacedQueue.Namespace, namespacedQueue.Name)
+}
+
+// ManagingNamespacedQueue returns the NamespacedQueue that owns the queue, if any
+func ManagingNamespacedQueue(queue client.Object) (types.NamespacedName, bool) {
+	value, found := queue.GetAnnotations()[ManagedByNamespacedQueueAnnotation]
+	if !found {
+		return types.NamespacedName{}, false
+	}
+	namespace, name, found := strings.Cut(value, "/")
+	if !found {
+		return types.NamespacedName{}, false
+	}
+	return types.NamespacedName{Namespace: namespace, Name: name}, true
+}
+
+// Syncer keeps a cluster-scoped leaf queue per NamespacedQueue. Cluster-scoped objects can't be owned by namespaced
+// objects, so the queue is deleted by the syncer when the NamespacedQueue is deleted.
+type Syncer struct {
+	client.Client
+}
+
+// SyncNamespacedQueue creates, updates or deletes the queue of the NamespacedQueue according to its current state
+func (s *Syncer) SyncNamespacedQueue(ctx context.Context, key types.NamespacedName) error {
+	logger := log.FromContext(ctx)
+
+	namespacedQueue := &kaiv1alpha1.NamespacedQueue{}
+	err := s.Get(ctx, key, namespacedQueue)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	namespacedQueueExists := err == nil && namespacedQueue.DeletionTimestamp == nil
+
+	queue := &v2.Queue{}
+	err = s.Get(ctx, client.ObjectKey{Name: QueueName(key)}, queue)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	queueExists := err == nil
+
+	if queueExists {
+		if managingQueue, found := ManagingNamespacedQueue(queue); !found || managingQueue != key {
+			logger.V(1).Info("Queue is not managed by the namespaced queue, skipping", "queue", queue.Name)
+			return nil
+		}
+	}
+
+	if !namespacedQueueExists {
+		if !queueExists {
+			return nil
+		}
+		logger.Info("Deleting namespaced queue's queue", "queue", queue.Name)
+		return client.IgnoreNotFound(s.Delete(ctx, queue))
+	}
+
+	desiredSpec := queueSpec(namespacedQueue)
+	if !queueExists {
+		queue = &v2.Queue{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        QueueName(key),
+				Annotations: map[string]string{ManagedByNamespacedQueueAnnotation: key.String()},
+			},
+			Spec: desiredSpec,
+		}
+		logger.Info("Creating namespaced queue's queue", "queue", queue.Name)
+		if err = s.Create(ctx, queue); err != nil {
+			return err
+		}
+	} else if !reflect.DeepEqual(queue.Spec, desiredSpec) {
+		originalQueue := queue.DeepCopy()
+		queue.Spec = desiredSpec
+		logger.Info("Updating namespaced queue's queue", "queue", queue.Name)
+		if err = s.Patch(ctx, queue, client.MergeFrom(originalQueue)); err != nil {
+			return err
+		}
+	}
+
+	return s.updateStatus(ctx, namespacedQueue, queue.Name)
+}
+
+func (s *Syncer) updateStatus(ctx context.Context, namespacedQueue *kaiv1alpha1.NamespacedQueue, queueName string) error {
+	if namespacedQueue.Status.QueueName == queueName {
+		return nil
+	}
+	originalNamespacedQueue := namespacedQueue.DeepCopy()
+	namespacedQueue.Status.QueueName = queueName
+	return s.Status().Patch(ctx, namespacedQueue, client.MergeFrom(originalNamespacedQueue))
+}
+
+func queueSpec(namespacedQueue *kaiv1alpha1.NamespacedQueue) v2.QueueSpec {
+	return v2.QueueSpec{
+		DisplayName: namespacedQueue.Spec.DisplayName,
+		ParentQueue: namespacedQueue.Spec.ParentQueue,
+		Resources:   namespacedQueue.Spec.Resources.DeepCopy(),
+		Priority:    namespacedQueue.Spec.Priority,
+	}
+}
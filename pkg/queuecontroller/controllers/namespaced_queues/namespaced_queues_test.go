@@ -0,0 +1,189 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package namespaced_queues
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+)
+
+var teamAResearch = types.NamespacedName{Namespace: "team-a", Name: "research"}
+
+func newTestClient(t *testing.T, objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	assert.Nil(t, clientgoscheme.AddToScheme(scheme))
+	assert.Nil(t, v2.AddToScheme(scheme))
+	assert.Nil(t, kaiv1alpha1.AddToScheme(scheme))
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).
+		WithStatusSubresource(&kaiv1alpha1.NamespacedQueue{}).Build()
+}
+
+func newNamespacedQueue(namespace, name, parent string, gpuQuota, gpuLimit float64) *kaiv1alpha1.NamespacedQueue {
+	return &kaiv1alpha1.NamespacedQueue{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: kaiv1alpha1.NamespacedQueueSpec{
+			ParentQueue: parent,
+			Resources: &v2.QueueResources{
+				GPU:    v2.QueueResource{Quota: gpuQuota, Limit: gpuLimit, OverQuotaWeight: 1},
+				CPU:    v2.QueueResource{Quota: 0, Limit: -1, OverQuotaWeight: 1},
+				Memory: v2.QueueResource{Quota: 0, Limit: -1, OverQuotaWeight: 1},
+			},
+		},
+	}
+}
+
+func newQueue(name string, gpuQuota, gpuLimit float64) *v2.Queue {
+	return &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v2.QueueSpec{
+			Resources: &v2.QueueResources{
+				GPU:    v2.QueueResource{Quota: gpuQuota, Limit: gpuLimit, OverQuotaWeight: 1},
+				CPU:    v2.QueueResource{Quota: -1, Limit: -1, OverQuotaWeight: 1},
+				Memory: v2.QueueResource{Quota: -1, Limit: -1, OverQuotaWeight: 1},
+			},
+		},
+	}
+}
+
+func TestSyncNamespacedQueueCreatesQueue(t *testing.T) {
+	syncer := &Syncer{Client: newTestClient(t, newNamespacedQueue("team-a", "research", "org", 4, 8))}
+
+	assert.Nil(t, syncer.SyncNamespacedQueue(context.Background(), teamAResearch))
+
+	queue := &v2.Queue{}
+	assert.Nil(t, syncer.Get(context.Background(), client.ObjectKey{Name: "team-a.research"}, queue))
+	assert.Equal(t, "team-a/research", queue.Annotations[ManagedByNamespacedQueueAnnotation])
+	assert.Equal(t, "org", queue.Spec.ParentQueue)
+	assert.Equal(t, v2.QueueResource{Quota: 4, Limit: 8, OverQuotaWeight: 1}, queue.Spec.Resources.GPU)
+
+	namespacedQueue := &kaiv1alpha1.NamespacedQueue{}
+	assert.Nil(t, syncer.Get(context.Background(), teamAResearch, namespacedQueue))
+	assert.Equal(t, "team-a.research", namespacedQueue.Status.QueueName)
+}
+
+func TestSyncNamespacedQueueUpdatesQueue(t *testing.T) {
+	namespacedQueue := newNamespacedQueue("team-a", "research", "org", 4, 8)
+	syncer := &Syncer{Client: newTestClient(t, namespacedQueue)}
+	assert.Nil(t, syncer.SyncNamespacedQueue(context.Background(), teamAResearch))
+
+	assert.Nil(t, syncer.Get(context.Background(), teamAResearch, namespacedQueue))
+	namespacedQueue.Spec.Resources.GPU.Quota = 6
+	assert.Nil(t, syncer.Update(context.Background(), namespacedQueue))
+	assert.Nil(t, syncer.SyncNamespacedQueue(context.Background(), teamAResearch))
+
+	queue := &v2.Queue{}
+	assert.Nil(t, syncer.Get(context.Background(), client.ObjectKey{Name: "team-a.research"}, queue))
+	assert.Equal(t, float64(6), queue.Spec.Resources.GPU.Quota)
+}
+
+func TestSyncNamespacedQueueDeletesQueue(t *testing.T) {
+	namespacedQueue := newNamespacedQueue("team-a", "research", "org", 4, 8)
+	syncer := &Syncer{Client: newTestClient(t, namespacedQueue)}
+	assert.Nil(t, syncer.SyncNamespacedQueue(context.Background(), teamAResearch))
+
+	assert.Nil(t, syncer.Delete(context.Background(), namespacedQueue))
+	assert.Nil(t, syncer.SyncNamespacedQueue(context.Background(), teamAResearch))
+
+	err := syncer.Get(context.Background(), client.ObjectKey{Name: "team-a.research"}, &v2.Queue{})
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestSyncNamespacedQueueSkipsUnmanagedQueue(t *testing.T) {
+	syncer := &Syncer{Client: newTestClient(t,
+		newNamespacedQueue("team-a", "research", "org", 4, 8),
+		newQueue("team-a.research", 1, 1))}
+
+	assert.Nil(t, syncer.SyncNamespacedQueue(context.Background(), teamAResearch))
+
+	queue := &v2.Queue{}
+	assert.Nil(t, syncer.Get(context.Background(), client.ObjectKey{Name: "team-a.research"}, queue))
+	assert.Equal(t, float64(1), queue.Spec.Resources.GPU.Quota)
+	assert.Empty(t, queue.Spec.ParentQueue)
+}
+
+func TestValidate(t *testing.T) {
+	managedQueue := newQueue("team-b.dev", 2, -1)
+	managedQueue.Annotations = map[string]string{ManagedByNamespacedQueueAnnotation: "team-b/dev"}
+
+	objects := []client.Object{
+		newQueue("org", 10, 16),
+		newQueue("unlimited", -1, -1),
+		managedQueue,
+		newNamespacedQueue("team-b", "dev", "org", 6, -1),
+	}
+
+	tests := []struct {
+		name            string
+		namespacedQueue *kaiv1alpha1.NamespacedQueue
+		expectedError   string
+	}{
+		{
+			name:            "within the parent's bounds",
+			namespacedQueue: newNamespacedQueue("team-a", "research", "org", 4, 16),
+		},
+		{
+			name:            "update of an existing namespaced queue",
+			namespacedQueue: newNamespacedQueue("team-b", "dev", "org", 10, -1),
+		},
+		{
+			name:            "parent without quota",
+			namespacedQueue: newNamespacedQueue("team-a", "research", "unlimited", -1, 100),
+		},
+		{
+			name:            "missing parent",
+			namespacedQueue: newNamespacedQueue("team-a", "research", "missing", 4, 8),
+			expectedError:   "parent queue missing does not exist",
+		},
+		{
+			name:            "parent is the queue of a namespaced queue",
+			namespacedQueue: newNamespacedQueue("team-a", "research", "team-b.dev", 1, 1),
+			expectedError: "parent queue team-b.dev is the queue of a namespaced queue, and can't have child " +
+				"queues",
+		},
+		{
+			name:            "quotas exceed the parent's quota",
+			namespacedQueue: newNamespacedQueue("team-a", "research", "org", 5, 8),
+			expectedError: "namespaced queue team-a/research exceeds the bounds of parent queue org: gpu quota 5 " +
+				"with 6 of other namespaced queues (parent quota 10)",
+		},
+		{
+			name:            "unlimited quota with a limited parent quota",
+			namespacedQueue: newNamespacedQueue("team-a", "research", "org", -1, 8),
+			expectedError: "namespaced queue team-a/research exceeds the bounds of parent queue org: unlimited gpu " +
+				"quota (parent quota 10)",
+		},
+		{
+			name:            "limit exceeds the parent's limit",
+			namespacedQueue: newNamespacedQueue("team-a", "research", "org", 2, 20),
+			expectedError: "namespaced queue team-a/research exceeds the bounds of parent queue org: gpu limit 20 " +
+				"(parent limit 16)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := &Validator{Reader: newTestClient(t, objects...)}
+
+			_, err := validator.ValidateCreate(context.Background(), tt.namespacedQueue)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
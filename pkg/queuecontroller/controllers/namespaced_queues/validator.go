@@ -0,0 +1,128 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package namespaced_queues
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+)
+
+var validatorlog = logf.Log.WithName("namespaced-queue-validator")
+
+const unlimited = -1
+
+// Validator keeps NamespacedQueues within the bounds of their parent queue: the parent must be a cluster-scoped
+// queue, the quotas of all the NamespacedQueues of the parent must not exceed the parent's quota, and the limit of
+// every NamespacedQueue must not exceed the parent's limit.
+type Validator struct {
+	client.Reader
+}
+
+// +kubebuilder:rbac:groups=kai.scheduler,resources=namespacedqueues,verbs=get;list;watch
+
+func (v *Validator) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&kaiv1alpha1.NamespacedQueue{}).
+		WithValidator(v).
+		Complete()
+}
+
+func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	namespacedQueue, ok := obj.(*kaiv1alpha1.NamespacedQueue)
+	if !ok {
+		return nil, fmt.Errorf("expected a NamespacedQueue but got a %T", obj)
+	}
+	validatorlog.Info("validate create", "namespace", namespacedQueue.Namespace, "name", namespacedQueue.Name)
+	return nil, v.validate(ctx, namespacedQueue)
+}
+
+func (v *Validator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	namespacedQueue, ok := newObj.(*kaiv1alpha1.NamespacedQueue)
+	if !ok {
+		return nil, fmt.Errorf("expected a NamespacedQueue but got a %T", newObj)
+	}
+	validatorlog.Info("validate update", "namespace", namespacedQueue.Namespace, "name", namespacedQueue.Name)
+	return nil, v.validate(ctx, namespacedQueue)
+}
+
+func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *Validator) validate(ctx context.Context, namespacedQueue *kaiv1alpha1.NamespacedQueue) error {
+	if namespacedQueue.Spec.Resources == nil {
+		return fmt.Errorf("resources must be specified")
+	}
+
+	parent := &v2.Queue{}
+	if err := v.Get(ctx, client.ObjectKey{Name: namespacedQueue.Spec.ParentQueue}, parent); err != nil {
+		if errors.IsNotFound(err) {
+			return fmt.Errorf("parent queue %s does not exist", namespacedQueue.Spec.ParentQueue)
+		}
+		return fmt.Errorf("failed to get parent queue %s: %w", namespacedQueue.Spec.ParentQueue, err)
+	}
+	if _, found := ManagingNamespacedQueue(parent); found {
+		return fmt.Errorf("parent queue %s is the queue of a namespaced queue, and can't have child queues",
+			parent.Name)
+	}
+	if parent.Spec.Resources == nil {
+		return nil
+	}
+
+	siblings := &kaiv1alpha1.NamespacedQueueList{}
+	if err := v.List(ctx, siblings); err != nil {
+		return fmt.Errorf("failed to list namespaced queues: %w", err)
+	}
+	siblingsQuota := v2.QueueResources{}
+	for _, sibling := range siblings.Items {
+		if sibling.Spec.ParentQueue != parent.Name || sibling.Spec.Resources == nil ||
+			(sibling.Namespace == namespacedQueue.Namespace && sibling.Name == namespacedQueue.Name) {
+			continue
+		}
+		siblingsQuota.GPU.Quota += max(sibling.Spec.Resources.GPU.Quota, 0)
+		siblingsQuota.CPU.Quota += max(sibling.Spec.Resources.CPU.Quota, 0)
+		siblingsQuota.Memory.Quota += max(sibling.Spec.Resources.Memory.Quota, 0)
+	}
+
+	resources := namespacedQueue.Spec.Resources
+	violations := boundViolations("gpu", resources.GPU, parent.Spec.Resources.GPU, siblingsQuota.GPU.Quota)
+	violations = append(violations,
+		boundViolations("cpu", resources.CPU, parent.Spec.Resources.CPU, siblingsQuota.CPU.Quota)...)
+	violations = append(violations,
+		boundViolations("memory", resources.Memory, parent.Spec.Resources.Memory, siblingsQuota.Memory.Quota)...)
+	if len(violations) > 0 {
+		return fmt.Errorf("namespaced queue %s/%s exceeds the bounds of parent queue %s: %s",
+			namespacedQueue.Namespace, namespacedQueue.Name, parent.Name, strings.Join(violations, ", "))
+	}
+	return nil
+}
+
+func boundViolations(resourceName string, queue, parent v2.QueueResource, siblingsQuota float64) []string {
+	var violations []string
+	if parent.Quota != unlimited {
+		if queue.Quota == unlimited {
+			violations = append(violations, fmt.Sprintf("unlimited %s quota (parent quota %v)",
+				resourceName, parent.Quota))
+		} else if queue.Quota+siblingsQuota > parent.Quota {
+			violations = append(violations, fmt.Sprintf(
+				"%s quota %v with %v of other namespaced queues (parent quota %v)", resourceName, queue.Quota, siblingsQuota, parent.Quota))
+		}
+	}
+	if parent.Limit != unlimited && queue.Limit > parent.Limit {
+		violations = append(violations, fmt.Sprintf("%s limit %v (parent limit %v)",
+			resourceName, queue.Limit, parent.Limit))
+	}
+	return violations
+}
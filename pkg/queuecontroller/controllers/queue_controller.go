@@ -6,31 +6,48 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/queuecontroller/common"
 	"github.com/NVIDIA/KAI-scheduler/pkg/queuecontroller/controllers/childqueues_updater"
+	"github.com/NVIDIA/KAI-scheduler/pkg/queuecontroller/controllers/conditions_updater"
+	"github.com/NVIDIA/KAI-scheduler/pkg/queuecontroller/controllers/metering"
+	"github.com/NVIDIA/KAI-scheduler/pkg/queuecontroller/controllers/quotarollout"
 	"github.com/NVIDIA/KAI-scheduler/pkg/queuecontroller/controllers/resource_updater"
 	"github.com/NVIDIA/KAI-scheduler/pkg/queuecontroller/metrics"
+	"github.com/NVIDIA/KAI-scheduler/pkg/queuecontroller/sharding"
 )
 
 // QueueReconciler reconciles a Queue object
 type QueueReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+	// StarvationThreshold is how long a queue must have unallocated requests within its quota to be starved
+	StarvationThreshold time.Duration
+	// BudgetMeteringInterval is the longest time between two meterings of a queue with a budget
+	BudgetMeteringInterval time.Duration
+	// Shards limits the reconciled queues to the shards led by this replica, all the queues are reconciled when nil
+	Shards *sharding.Shards
 
 	resourceUpdater    resource_updater.ResourceUpdater
 	childQueuesUpdater childqueues_updater.ChildQueuesUpdater
+	conditionsUpdater  *conditions_updater.ConditionsUpdater
+	budgetMeter        metering.BudgetMeter
+	quotaRollout       quotarollout.Rollout
 }
 
 //+kubebuilder:rbac:groups=scheduling.run.ai,resources=queues,verbs=get;list;watch;update;patch
@@ -38,6 +55,7 @@ type QueueReconciler struct {
 //+kubebuilder:rbac:groups=scheduling.run.ai,resources=queues/finalizers,verbs=update
 
 //+kubebuilder:rbac:groups=scheduling.run.ai,resources=podgroups,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch;update
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -60,11 +78,29 @@ func (r *QueueReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		if ignoreNotFoundErr == nil {
 			// If the queue is not found, reset its metrics
 			metrics.ResetQueueMetrics(req.Name)
+			r.conditionsUpdater.ForgetQueue(req.Name)
 		}
 		return ctrl.Result{}, ignoreNotFoundErr
 	}
+
+	if r.Shards != nil {
+		owned, err := r.Shards.OwnsQueue(ctx, queue)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to get the shard of queue %s: %v", queue.Name, err)
+		}
+		if !owned {
+			// Another replica reconciles the queue, and publishes its metrics
+			metrics.ResetQueueMetrics(queue.Name)
+			r.conditionsUpdater.ForgetQueue(queue.Name)
+			return ctrl.Result{}, nil
+		}
+	}
+
 	originalQueue := queue.DeepCopy()
 
+	// The consumption since the last metering is accounted by the allocation before it is updated
+	meteringRequeueAfter := r.budgetMeter.UpdateQueue(queue, time.Now())
+
 	err = r.resourceUpdater.UpdateQueue(ctx, queue)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to update queue resources: %v", err)
@@ -75,6 +111,14 @@ func (r *QueueReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		return ctrl.Result{}, fmt.Errorf("failed to update child queues: %v", err)
 	}
 
+	// The projected reclaim of the next quota step is computed from the updated resources
+	rolloutRequeueAfter := r.quotaRollout.UpdateQueue(queue, time.Now())
+
+	requeueAfter, err := r.conditionsUpdater.UpdateQueue(ctx, queue)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	err = r.Client.Status().Patch(ctx, queue, client.MergeFrom(originalQueue))
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to patch status for queue %s, error: %v", queue.Name, err)
@@ -82,7 +126,13 @@ func (r *QueueReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 
 	metrics.SetQueueMetrics(queue)
 
-	return ctrl.Result{}, err
+	if meteringRequeueAfter > 0 && (requeueAfter == 0 || meteringRequeueAfter < requeueAfter) {
+		requeueAfter = meteringRequeueAfter
+	}
+	if rolloutRequeueAfter > 0 && (requeueAfter == 0 || rolloutRequeueAfter < requeueAfter) {
+		requeueAfter = rolloutRequeueAfter
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, err
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -100,17 +150,37 @@ func (r *QueueReconciler) SetupWithManager(mgr ctrl.Manager, queueLabelKey strin
 	r.childQueuesUpdater = childqueues_updater.ChildQueuesUpdater{
 		Client: r.Client,
 	}
+	recorder := mgr.GetEventRecorderFor("queue-controller")
+	r.conditionsUpdater = &conditions_updater.ConditionsUpdater{
+		Client:              r.Client,
+		Recorder:            recorder,
+		QueueLabelKey:       queueLabelKey,
+		StarvationThreshold: r.StarvationThreshold,
+	}
+	r.budgetMeter = metering.BudgetMeter{
+		Interval: r.BudgetMeteringInterval,
+	}
+	r.quotaRollout = quotarollout.Rollout{
+		Recorder: recorder,
+	}
 
-	return ctrl.NewControllerManagedBy(mgr).
+	controllerOptions := controller.Options{
+		SkipNameValidation: &skipNameValidation,
+	}
+	builder := ctrl.NewControllerManagedBy(mgr).
 		For(&v2.Queue{}).
 		Watches(&v2.Queue{},
 			handler.EnqueueRequestsFromMapFunc(enqueueQueue)).
 		Watches(&v2alpha2.PodGroup{},
-			handler.EnqueueRequestsFromMapFunc(enqueuePodGroup)).
-		WithOptions(
-			controller.Options{
-				SkipNameValidation: &skipNameValidation,
-			}).
+			handler.EnqueueRequestsFromMapFunc(enqueuePodGroup))
+	if r.Shards != nil {
+		// Every replica runs the controller, and reconciles the queues of the shards it leads
+		controllerOptions.NeedLeaderElection = ptr.To(false)
+		builder = builder.WatchesRawSource(source.Channel(r.Shards.Events(), &handler.EnqueueRequestForObject{}))
+	}
+
+	return builder.
+		WithOptions(controllerOptions).
 		Complete(r)
 }
 
@@ -150,9 +220,15 @@ func enqueuePodGroup(_ context.Context, pg client.Object) []reconcile.Request {
 		return []reconcile.Request{}
 	}
 
-	return []reconcile.Request{
+	requests := []reconcile.Request{
 		{
 			NamespacedName: types.NamespacedName{Name: podGroup.Spec.Queue},
 		},
 	}
+	if scavengerQueue := podGroup.Labels[constants.ScavengerQueueLabelKey]; scavengerQueue != "" {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: scavengerQueue},
+		})
+	}
+	return requests
 }
@@ -0,0 +1,158 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+// Package quotarollout applies the GPU quota changes of queues progressively, in steps.
+package quotarollout
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
+)
+
+const (
+	// AbortedEvent is recorded on a queue when the rollout of its GPU quota is aborted
+	AbortedEvent = "QuotaRolloutAborted"
+
+	// quotaTolerance is the distance from the target quota under which a step applies the target quota
+	quotaTolerance = 1e-6
+)
+
+// Rollout applies the GPU quota changes of queues with a quota rollout in the quota rollout status of the queues,
+// which the scheduler uses instead of the quota in the spec. The projected reclaim of a step is computed from the
+// resources of the queue status, so it must run after they are updated.
+type Rollout struct {
+	// Recorder records an event on the queue when its rollout is aborted
+	Recorder record.EventRecorder
+}
+
+// UpdateQueue updates the quota rollout status of the queue at the given time. Returns the time after which the next
+// step should be applied, or zero if the queue has no rollout in progress.
+func (r *Rollout) UpdateQueue(queue *v2.Queue, now time.Time) time.Duration {
+	rollout := queue.Spec.QuotaRollout
+	if rollout == nil {
+		queue.Status.QuotaRollout = nil
+		return 0
+	}
+
+	target := getGPUQuota(queue)
+	status := queue.Status.QuotaRollout
+	if status == nil {
+		// The quota when the rollout is set is applied at once
+		queue.Status.QuotaRollout = &v2.QueueQuotaRolloutStatus{
+			Phase:           v2.QuotaRolloutCompleted,
+			FromGPUQuota:    target,
+			TargetGPUQuota:  target,
+			AppliedGPUQuota: target,
+			LastStepTime:    metav1.NewTime(now),
+		}
+		return 0
+	}
+
+	if status.TargetGPUQuota != target {
+		status.Phase = v2.QuotaRolloutProgressing
+		status.FromGPUQuota = status.AppliedGPUQuota
+		status.TargetGPUQuota = target
+		status.LastStepTime = metav1.NewTime(now)
+		status.Message = ""
+		if isUnlimited(status.FromGPUQuota) || isUnlimited(target) {
+			// A change from or to an unlimited quota can't be split into steps
+			status.AppliedGPUQuota = target
+			status.Phase = v2.QuotaRolloutCompleted
+		}
+	}
+	if status.Phase != v2.QuotaRolloutProgressing {
+		clearNextStep(status)
+		return 0
+	}
+
+	nextQuota := getNextStepGPUQuota(rollout, status)
+	projectedReclaim := getProjectedReclaimGPUs(&queue.Status, status.AppliedGPUQuota, nextQuota)
+	nextStepTime := status.LastStepTime.Add(rollout.StepInterval.Duration)
+	if now.Before(nextStepTime) {
+		setNextStep(status, nextQuota, projectedReclaim)
+		return nextStepTime.Sub(now)
+	}
+
+	if rollout.MaxProjectedReclaimGPUs != nil && projectedReclaim > *rollout.MaxProjectedReclaimGPUs {
+		status.Phase = v2.QuotaRolloutAborted
+		status.Message = fmt.Sprintf(
+			"the step from %v to %v GPUs is projected to make %v GPUs reclaimable, more than the maximum of %v",
+			status.AppliedGPUQuota, nextQuota, projectedReclaim, *rollout.MaxProjectedReclaimGPUs)
+		clearNextStep(status)
+		if r.Recorder != nil {
+			r.Recorder.Event(queue, v1.EventTypeWarning, AbortedEvent, status.Message)
+		}
+		return 0
+	}
+
+	status.AppliedGPUQuota = nextQuota
+	status.LastStepTime = metav1.NewTime(now)
+	if nextQuota == target {
+		status.Phase = v2.QuotaRolloutCompleted
+		clearNextStep(status)
+		return 0
+	}
+
+	nextQuota = getNextStepGPUQuota(rollout, status)
+	setNextStep(status, nextQuota, getProjectedReclaimGPUs(&queue.Status, status.AppliedGPUQuota, nextQuota))
+	return rollout.StepInterval.Duration
+}
+
+// getNextStepGPUQuota returns the applied quota moved towards the target by the step percentage of the change
+func getNextStepGPUQuota(rollout *v2.QueueQuotaRollout, status *v2.QueueQuotaRolloutStatus) float64 {
+	change := status.TargetGPUQuota - status.FromGPUQuota
+	next := status.AppliedGPUQuota + change*float64(rollout.StepPercentage)/100
+	if math.Abs(status.TargetGPUQuota-next) < quotaTolerance || (change > 0) == (next > status.TargetGPUQuota) {
+		return status.TargetGPUQuota
+	}
+	return next
+}
+
+// getProjectedReclaimGPUs returns the GPUs that changing the quota of the queue makes reclaimable. Raising the quota
+// lets the requests of the queue that exceed its allocation reclaim GPUs of other queues, up to the new quota.
+// Lowering the quota makes the preemptible allocation of the queue between the new and the old quota reclaimable.
+func getProjectedReclaimGPUs(queueStatus *v2.QueueStatus, fromQuota, toQuota float64) float64 {
+	allocated := gpus(queueStatus.Allocated)
+	if toQuota > fromQuota {
+		requested := gpus(queueStatus.Requested)
+		return math.Max(0, math.Min(requested, toQuota)-math.Max(allocated, fromQuota))
+	}
+	allocatedNonPreemptible := gpus(queueStatus.AllocatedNonPreemptible)
+	return math.Max(0, math.Min(allocated, fromQuota)-math.Max(toQuota, allocatedNonPreemptible))
+}
+
+func getGPUQuota(queue *v2.Queue) float64 {
+	if queue.Spec.Resources == nil {
+		return 0
+	}
+	return queue.Spec.Resources.GPU.Quota
+}
+
+func isUnlimited(quota float64) bool {
+	return quota == commonconstants.UnlimitedResourceQuantity
+}
+
+func gpus(resourceList v1.ResourceList) float64 {
+	quantity := resources.AcceleratorQuantity(resourceList)
+	return quantity.AsApproximateFloat64()
+}
+
+func setNextStep(status *v2.QueueQuotaRolloutStatus, nextQuota, projectedReclaim float64) {
+	status.NextStepGPUQuota = ptr.To(nextQuota)
+	status.NextStepProjectedReclaimGPUs = ptr.To(projectedReclaim)
+}
+
+func clearNextStep(status *v2.QueueQuotaRolloutStatus) {
+	status.NextStepGPUQuota = nil
+	status.NextStepProjectedReclaimGPUs = nil
+}
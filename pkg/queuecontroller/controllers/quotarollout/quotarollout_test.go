@@ -0,0 +1,268 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package quotarollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+var now = time.Date(2025, time.June, 4, 12, 0, 0, 0, time.UTC)
+
+func newQueue(
+	rollout *v2.QueueQuotaRollout, status *v2.QueueQuotaRolloutStatus, gpuQuota float64,
+	allocatedGPUs, allocatedNonPreemptibleGPUs, requestedGPUs string,
+) *v2.Queue {
+	return &v2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+		Spec: v2.QueueSpec{
+			Resources:    &v2.QueueResources{GPU: v2.QueueResource{Quota: gpuQuota}},
+			QuotaRollout: rollout,
+		},
+		Status: v2.QueueStatus{
+			QuotaRollout:            status,
+			Allocated:               v1.ResourceList{constants.GpuResource: resource.MustParse(allocatedGPUs)},
+			AllocatedNonPreemptible: v1.ResourceList{constants.GpuResource: resource.MustParse(allocatedNonPreemptibleGPUs)},
+			Requested:               v1.ResourceList{constants.GpuResource: resource.MustParse(requestedGPUs)},
+		},
+	}
+}
+
+func TestUpdateQueue(t *testing.T) {
+	hourly := &v2.QueueQuotaRollout{StepPercentage: 25, StepInterval: metav1.Duration{Duration: time.Hour}}
+	budgeted := &v2.QueueQuotaRollout{
+		StepPercentage:          50,
+		StepInterval:            metav1.Duration{Duration: time.Hour},
+		MaxProjectedReclaimGPUs: ptr.To(2.0),
+	}
+	tests := []struct {
+		name                 string
+		queue                *v2.Queue
+		expectedStatus       *v2.QueueQuotaRolloutStatus
+		expectedRequeueAfter time.Duration
+		expectedEvents       int
+	}{
+		{
+			name: "queue without a rollout",
+			queue: newQueue(nil, &v2.QueueQuotaRolloutStatus{AppliedGPUQuota: 4},
+				8, "0", "0", "0"),
+			expectedStatus:       nil,
+			expectedRequeueAfter: 0,
+		},
+		{
+			name:  "quota when the rollout is set is applied at once",
+			queue: newQueue(hourly, nil, 8, "0", "0", "0"),
+			expectedStatus: &v2.QueueQuotaRolloutStatus{
+				Phase:           v2.QuotaRolloutCompleted,
+				FromGPUQuota:    8,
+				TargetGPUQuota:  8,
+				AppliedGPUQuota: 8,
+				LastStepTime:    metav1.NewTime(now),
+			},
+			expectedRequeueAfter: 0,
+		},
+		{
+			name: "quota change starts a rollout and publishes the first step",
+			queue: newQueue(hourly, &v2.QueueQuotaRolloutStatus{
+				Phase: v2.QuotaRolloutCompleted, FromGPUQuota: 4, TargetGPUQuota: 4, AppliedGPUQuota: 4,
+			}, 12, "4", "0", "10"),
+			expectedStatus: &v2.QueueQuotaRolloutStatus{
+				Phase:                        v2.QuotaRolloutProgressing,
+				FromGPUQuota:                 4,
+				TargetGPUQuota:               12,
+				AppliedGPUQuota:              4,
+				LastStepTime:                 metav1.NewTime(now),
+				NextStepGPUQuota:             ptr.To(6.0),
+				NextStepProjectedReclaimGPUs: ptr.To(2.0),
+			},
+			expectedRequeueAfter: time.Hour,
+		},
+		{
+			name: "step is applied after the step interval",
+			queue: newQueue(hourly, &v2.QueueQuotaRolloutStatus{
+				Phase: v2.QuotaRolloutProgressing, FromGPUQuota: 4, TargetGPUQuota: 12, AppliedGPUQuota: 4,
+				LastStepTime: metav1.NewTime(now.Add(-time.Hour)),
+			}, 12, "4", "0", "10"),
+			expectedStatus: &v2.QueueQuotaRolloutStatus{
+				Phase:                        v2.QuotaRolloutProgressing,
+				FromGPUQuota:                 4,
+				TargetGPUQuota:               12,
+				AppliedGPUQuota:              6,
+				LastStepTime:                 metav1.NewTime(now),
+				NextStepGPUQuota:             ptr.To(8.0),
+				NextStepProjectedReclaimGPUs: ptr.To(2.0),
+			},
+			expectedRequeueAfter: time.Hour,
+		},
+		{
+			name: "step is not applied before the step interval",
+			queue: newQueue(hourly, &v2.QueueQuotaRolloutStatus{
+				Phase: v2.QuotaRolloutProgressing, FromGPUQuota: 4, TargetGPUQuota: 12, AppliedGPUQuota: 6,
+				LastStepTime: metav1.NewTime(now.Add(-15 * time.Minute)),
+			}, 12, "4", "0", "4"),
+			expectedStatus: &v2.QueueQuotaRolloutStatus{
+				Phase:                        v2.QuotaRolloutProgressing,
+				FromGPUQuota:                 4,
+				TargetGPUQuota:               12,
+				AppliedGPUQuota:              6,
+				LastStepTime:                 metav1.NewTime(now.Add(-15 * time.Minute)),
+				NextStepGPUQuota:             ptr.To(8.0),
+				NextStepProjectedReclaimGPUs: ptr.To(0.0),
+			},
+			expectedRequeueAfter: 45 * time.Minute,
+		},
+		{
+			name: "last step completes the rollout",
+			queue: newQueue(hourly, &v2.QueueQuotaRolloutStatus{
+				Phase: v2.QuotaRolloutProgressing, FromGPUQuota: 4, TargetGPUQuota: 12, AppliedGPUQuota: 10,
+				LastStepTime: metav1.NewTime(now.Add(-time.Hour)),
+			}, 12, "4", "0", "4"),
+			expectedStatus: &v2.QueueQuotaRolloutStatus{
+				Phase:           v2.QuotaRolloutCompleted,
+				FromGPUQuota:    4,
+				TargetGPUQuota:  12,
+				AppliedGPUQuota: 12,
+				LastStepTime:    metav1.NewTime(now),
+			},
+			expectedRequeueAfter: 0,
+		},
+		{
+			name: "lowered quota projects the reclaim of the preemptible allocation",
+			queue: newQueue(budgeted, &v2.QueueQuotaRolloutStatus{
+				Phase: v2.QuotaRolloutCompleted, FromGPUQuota: 8, TargetGPUQuota: 8, AppliedGPUQuota: 8,
+			}, 0, "8", "1", "8"),
+			expectedStatus: &v2.QueueQuotaRolloutStatus{
+				Phase:                        v2.QuotaRolloutProgressing,
+				FromGPUQuota:                 8,
+				TargetGPUQuota:               0,
+				AppliedGPUQuota:              8,
+				LastStepTime:                 metav1.NewTime(now),
+				NextStepGPUQuota:             ptr.To(4.0),
+				NextStepProjectedReclaimGPUs: ptr.To(4.0),
+			},
+			expectedRequeueAfter: time.Hour,
+		},
+		{
+			name: "step over the reclaim budget aborts the rollout",
+			queue: newQueue(budgeted, &v2.QueueQuotaRolloutStatus{
+				Phase: v2.QuotaRolloutProgressing, FromGPUQuota: 8, TargetGPUQuota: 0, AppliedGPUQuota: 8,
+				LastStepTime: metav1.NewTime(now.Add(-time.Hour)),
+			}, 0, "8", "1", "8"),
+			expectedStatus: &v2.QueueQuotaRolloutStatus{
+				Phase:           v2.QuotaRolloutAborted,
+				FromGPUQuota:    8,
+				TargetGPUQuota:  0,
+				AppliedGPUQuota: 8,
+				LastStepTime:    metav1.NewTime(now.Add(-time.Hour)),
+				Message: "the step from 8 to 4 GPUs is projected to make 4 GPUs reclaimable, " +
+					"more than the maximum of 2",
+			},
+			expectedRequeueAfter: 0,
+			expectedEvents:       1,
+		},
+		{
+			name: "step within the reclaim budget is applied",
+			queue: newQueue(budgeted, &v2.QueueQuotaRolloutStatus{
+				Phase: v2.QuotaRolloutProgressing, FromGPUQuota: 8, TargetGPUQuota: 0, AppliedGPUQuota: 8,
+				LastStepTime: metav1.NewTime(now.Add(-time.Hour)),
+			}, 0, "5", "3", "5"),
+			expectedStatus: &v2.QueueQuotaRolloutStatus{
+				Phase:                        v2.QuotaRolloutProgressing,
+				FromGPUQuota:                 8,
+				TargetGPUQuota:               0,
+				AppliedGPUQuota:              4,
+				LastStepTime:                 metav1.NewTime(now),
+				NextStepGPUQuota:             ptr.To(0.0),
+				NextStepProjectedReclaimGPUs: ptr.To(1.0),
+			},
+			expectedRequeueAfter: time.Hour,
+		},
+		{
+			name: "aborted rollout keeps the applied quota",
+			queue: newQueue(budgeted, &v2.QueueQuotaRolloutStatus{
+				Phase: v2.QuotaRolloutAborted, FromGPUQuota: 8, TargetGPUQuota: 0, AppliedGPUQuota: 8,
+				Message: "aborted",
+			}, 0, "8", "1", "8"),
+			expectedStatus: &v2.QueueQuotaRolloutStatus{
+				Phase:           v2.QuotaRolloutAborted,
+				FromGPUQuota:    8,
+				TargetGPUQuota:  0,
+				AppliedGPUQuota: 8,
+				Message:         "aborted",
+			},
+			expectedRequeueAfter: 0,
+		},
+		{
+			name: "quota change after an aborted rollout starts a new rollout",
+			queue: newQueue(budgeted, &v2.QueueQuotaRolloutStatus{
+				Phase: v2.QuotaRolloutAborted, FromGPUQuota: 8, TargetGPUQuota: 0, AppliedGPUQuota: 8,
+				Message: "aborted",
+			}, 6, "8", "1", "8"),
+			expectedStatus: &v2.QueueQuotaRolloutStatus{
+				Phase:                        v2.QuotaRolloutProgressing,
+				FromGPUQuota:                 8,
+				TargetGPUQuota:               6,
+				AppliedGPUQuota:              8,
+				LastStepTime:                 metav1.NewTime(now),
+				NextStepGPUQuota:             ptr.To(7.0),
+				NextStepProjectedReclaimGPUs: ptr.To(1.0),
+			},
+			expectedRequeueAfter: time.Hour,
+		},
+		{
+			name: "change to an unlimited quota is applied at once",
+			queue: newQueue(hourly, &v2.QueueQuotaRolloutStatus{
+				Phase: v2.QuotaRolloutCompleted, FromGPUQuota: 4, TargetGPUQuota: 4, AppliedGPUQuota: 4,
+			}, constants.UnlimitedResourceQuantity, "4", "0", "10"),
+			expectedStatus: &v2.QueueQuotaRolloutStatus{
+				Phase:           v2.QuotaRolloutCompleted,
+				FromGPUQuota:    4,
+				TargetGPUQuota:  constants.UnlimitedResourceQuantity,
+				AppliedGPUQuota: constants.UnlimitedResourceQuantity,
+				LastStepTime:    metav1.NewTime(now),
+			},
+			expectedRequeueAfter: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			rollout := &Rollout{Recorder: recorder}
+			requeueAfter := rollout.UpdateQueue(tt.queue, now)
+			assert.Equal(t, tt.expectedStatus, tt.queue.Status.QuotaRollout)
+			assert.Equal(t, tt.expectedRequeueAfter, requeueAfter)
+			assert.Len(t, recorder.Events, tt.expectedEvents)
+		})
+	}
+}
+
+func TestUpdateQueue_FractionalSteps(t *testing.T) {
+	rollout := &v2.QueueQuotaRollout{StepPercentage: 30, StepInterval: metav1.Duration{Duration: time.Hour}}
+	queue := newQueue(rollout, &v2.QueueQuotaRolloutStatus{
+		Phase: v2.QuotaRolloutCompleted, FromGPUQuota: 0, TargetGPUQuota: 0, AppliedGPUQuota: 0,
+	}, 1, "0", "0", "0")
+
+	r := &Rollout{}
+	stepTime := now
+	var appliedQuotas []float64
+	for step := 0; step < 5; step++ {
+		r.UpdateQueue(queue, stepTime)
+		appliedQuotas = append(appliedQuotas, queue.Status.QuotaRollout.AppliedGPUQuota)
+		stepTime = stepTime.Add(time.Hour)
+	}
+	assert.InDeltaSlice(t, []float64{0, 0.3, 0.6, 0.9, 1}, appliedQuotas, 1e-9)
+	assert.Equal(t, v2.QuotaRolloutCompleted, queue.Status.QuotaRollout.Phase)
+	assert.Equal(t, 1.0, queue.Status.QuotaRollout.AppliedGPUQuota)
+}
@@ -12,6 +12,7 @@ import (
 
 	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 	"github.com/NVIDIA/KAI-scheduler/pkg/queuecontroller/common"
 )
@@ -70,7 +71,23 @@ func (ru *ResourceUpdater) sumPodGroupsResources(ctx context.Context, queue *v2.
 		return err
 	}
 
-	for _, pg := range queuePodGroups.Items {
+	// Pod groups scavenged by another queue are accounted to the scavenger queue
+	scavengedPodGroups := v2alpha2.PodGroupList{}
+	err = ru.Client.List(ctx, &scavengedPodGroups, client.MatchingLabels{
+		constants.ScavengerQueueLabelKey: queue.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, pg := range append(queuePodGroups.Items, scavengedPodGroups.Items...) {
+		scavengerQueue, found := pg.Labels[constants.ScavengerQueueLabelKey]
+		if found && scavengerQueue != queue.Name {
+			continue
+		}
+		if found && pg.Labels[ru.QueueLabelKey] == queue.Name {
+			continue
+		}
 		queue.Status.Allocated = resources.SumResources(pg.Status.ResourcesStatus.Allocated, queue.Status.Allocated)
 		queue.Status.AllocatedNonPreemptible = resources.SumResources(pg.Status.ResourcesStatus.AllocatedNonPreemptible,
 			queue.Status.AllocatedNonPreemptible)
@@ -17,6 +17,7 @@ import (
 
 	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 )
 
 const (
@@ -267,3 +268,59 @@ func TestUpdateQueue_QueuesOnly(t *testing.T) {
 	assert.True(t, expectedMemory.Equal(queue.Status.AllocatedNonPreemptible["memory"]))
 	assert.True(t, expectedMemory.Equal(queue.Status.Requested["memory"]))
 }
+
+func TestUpdateQueue_ScavengedPodGroups(t *testing.T) {
+	newPodGroup := func(name, queueName string, labels map[string]string) *v2alpha2.PodGroup {
+		podGroup := &v2alpha2.PodGroup{
+			ObjectMeta: v12.ObjectMeta{
+				Name:      name,
+				Namespace: "proj-1",
+				Labels: map[string]string{
+					queueLabelName: queueName,
+				},
+			},
+			Status: v2alpha2.PodGroupStatus{
+				ResourcesStatus: v2alpha2.PodGroupResourcesStatus{
+					Allocated: v1.ResourceList{
+						"nvidia.com/gpu": resource.MustParse("1"),
+					},
+					Requested: v1.ResourceList{
+						"nvidia.com/gpu": resource.MustParse("1"),
+					},
+				},
+			},
+		}
+		for key, value := range labels {
+			podGroup.Labels[key] = value
+		}
+		return podGroup
+	}
+
+	objects := []client.Object{
+		newPodGroup("own", "queue-name", nil),
+		newPodGroup("scavenged-from", "queue-name", map[string]string{
+			constants.ScavengerQueueLabelKey: "scavenger",
+		}),
+		newPodGroup("scavenged-by", "other-queue", map[string]string{
+			constants.ScavengerQueueLabelKey: "queue-name",
+		}),
+	}
+
+	scheme := runtime.NewScheme()
+	assert.Nil(t, v2alpha2.AddToScheme(scheme))
+	assert.Nil(t, v2.AddToScheme(scheme))
+
+	updater := ResourceUpdater{
+		Client:        fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+		QueueLabelKey: queueLabelName,
+	}
+
+	for queueName, expectedGPUs := range map[string]string{"queue-name": "2", "scavenger": "1", "other-queue": "0"} {
+		queue := v2.Queue{ObjectMeta: v12.ObjectMeta{Name: queueName}}
+		assert.Nil(t, updater.sumPodGroupsResources(context.Background(), &queue))
+
+		expectedGPU := resource.MustParse(expectedGPUs)
+		assert.True(t, expectedGPU.Equal(queue.Status.Allocated["nvidia.com/gpu"]), queueName)
+		assert.True(t, expectedGPU.Equal(queue.Status.Requested["nvidia.com/gpu"]), queueName)
+	}
+}
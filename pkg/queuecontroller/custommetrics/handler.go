@@ -0,0 +1,195 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package custommetrics
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	custommetricsv1beta2 "k8s.io/metrics/pkg/apis/custom_metrics/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/queuecontroller/metrics"
+)
+
+const (
+	apiPrefix         = "/apis/" + custommetricsv1beta2.GroupName + "/v1beta2"
+	namespacesSegment = "namespaces"
+)
+
+var queuesResource = "queues." + v2.GroupVersion.Group
+
+var metricNames = []string{
+	metrics.QueueDeservedGPUsMetricName,
+	metrics.QueueQuotaCPUMetricName,
+	metrics.QueueQuotaMemoryMetricName,
+	metrics.QueueAllocatedGPUsMetricName,
+	metrics.QueueAllocatedCPUMetricName,
+	metrics.QueueAllocatedMemoryMetricName,
+}
+
+type handler struct {
+	kubeClient client.Reader
+}
+
+// NewHandler returns a handler of the custom.metrics.k8s.io/v1beta2 API, serving the usage and quota metrics of
+// queues as metrics of the cluster scoped queues.scheduling.run.ai resource.
+func NewHandler(kubeClient client.Reader) http.Handler {
+	return &handler{kubeClient: kubeClient}
+}
+
+func (h *handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		writeError(writer, apierrors.NewMethodNotSupported(custommetricsv1beta2.Resource("metrics"), request.Method))
+		return
+	}
+
+	path, found := strings.CutPrefix(request.URL.Path, apiPrefix)
+	if !found {
+		writeError(writer, apierrors.NewNotFound(custommetricsv1beta2.Resource("metrics"), request.URL.Path))
+		return
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	// Queues are cluster scoped, the namespace of namespaced requests (such as those of HPAs) is ignored
+	if len(segments) == 5 && segments[0] == namespacesSegment {
+		segments = segments[2:]
+	}
+
+	switch {
+	case len(segments) == 1 && segments[0] == "":
+		writeObject(writer, apiResourceList())
+	case len(segments) == 3 && segments[0] == queuesResource:
+		h.serveQueueMetric(writer, request, segments[1], segments[2])
+	default:
+		writeError(writer, apierrors.NewNotFound(custommetricsv1beta2.Resource("metrics"), path))
+	}
+}
+
+func (h *handler) serveQueueMetric(writer http.ResponseWriter, request *http.Request, queueName, metricName string) {
+	if !isQueueMetric(metricName) {
+		writeError(writer, apierrors.NewNotFound(custommetricsv1beta2.Resource("metrics"), metricName))
+		return
+	}
+
+	queues, err := h.getQueues(request, queueName)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+
+	now := metav1.NewTime(time.Now())
+	metricValues := &custommetricsv1beta2.MetricValueList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "MetricValueList",
+			APIVersion: custommetricsv1beta2.SchemeGroupVersion.String(),
+		},
+		Items: []custommetricsv1beta2.MetricValue{},
+	}
+	for _, queue := range queues {
+		value := metrics.GetQueueMetricValues(queue)[metricName]
+		metricValues.Items = append(metricValues.Items, custommetricsv1beta2.MetricValue{
+			DescribedObject: v1.ObjectReference{
+				Kind:       "Queue",
+				APIVersion: v2.GroupVersion.String(),
+				Name:       queue.Name,
+				UID:        queue.UID,
+			},
+			Metric:    custommetricsv1beta2.MetricIdentifier{Name: metricName},
+			Timestamp: now,
+			Value:     *resource.NewMilliQuantity(int64(math.Round(value*1000)), resource.DecimalSI),
+		})
+	}
+	writeObject(writer, metricValues)
+}
+
+func (h *handler) getQueues(request *http.Request, queueName string) ([]*v2.Queue, error) {
+	ctx := request.Context()
+	if queueName != custommetricsv1beta2.AllObjects {
+		queue := &v2.Queue{}
+		if err := h.kubeClient.Get(ctx, types.NamespacedName{Name: queueName}, queue); err != nil {
+			return nil, err
+		}
+		return []*v2.Queue{queue}, nil
+	}
+
+	selector, err := labels.Parse(request.URL.Query().Get("labelSelector"))
+	if err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid label selector: %v", err))
+	}
+	queueList := &v2.QueueList{}
+	if err = h.kubeClient.List(ctx, queueList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+
+	queues := make([]*v2.Queue, 0, len(queueList.Items))
+	for index := range queueList.Items {
+		queues = append(queues, &queueList.Items[index])
+	}
+	sort.Slice(queues, func(i, j int) bool {
+		return queues[i].Name < queues[j].Name
+	})
+	return queues, nil
+}
+
+func apiResourceList() *metav1.APIResourceList {
+	resources := &metav1.APIResourceList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "APIResourceList",
+			APIVersion: "v1",
+		},
+		GroupVersion: custommetricsv1beta2.SchemeGroupVersion.String(),
+	}
+	for _, metricName := range metricNames {
+		resources.APIResources = append(resources.APIResources, metav1.APIResource{
+			Name:       queuesResource + "/" + metricName,
+			Namespaced: false,
+			Kind:       "MetricValueList",
+			Verbs:      metav1.Verbs{"get"},
+		})
+	}
+	return resources
+}
+
+func isQueueMetric(metricName string) bool {
+	for _, name := range metricNames {
+		if name == metricName {
+			return true
+		}
+	}
+	return false
+}
+
+func writeObject(writer http.ResponseWriter, object any) {
+	writer.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(writer).Encode(object); err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func writeError(writer http.ResponseWriter, err error) {
+	var status apierrors.APIStatus
+	if !errors.As(err, &status) {
+		status = apierrors.NewInternalError(err)
+	}
+	statusObject := status.Status()
+	statusObject.TypeMeta = metav1.TypeMeta{Kind: "Status", APIVersion: "v1"}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(int(statusObject.Code))
+	_ = json.NewEncoder(writer).Encode(statusObject)
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package custommetrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	custommetricsv1beta2 "k8s.io/metrics/pkg/apis/custom_metrics/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+)
+
+func TestCustomMetrics(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "queuecontroller custom metrics tests")
+}
+
+var _ = Describe("Custom metrics API", func() {
+	var handler http.Handler
+
+	newQueue := func(name, team string, gpuQuota float64, allocatedGpus string) *v2.Queue {
+		return &v2.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"team": team}},
+			Spec: v2.QueueSpec{
+				Resources: &v2.QueueResources{
+					GPU:    v2.QueueResource{Quota: gpuQuota},
+					CPU:    v2.QueueResource{Quota: -1},
+					Memory: v2.QueueResource{Quota: -1},
+				},
+			},
+			Status: v2.QueueStatus{
+				Allocated: v1.ResourceList{"nvidia.com/gpu": resource.MustParse(allocatedGpus)},
+			},
+		}
+	}
+
+	get := func(path string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		return recorder
+	}
+
+	getMetricValues := func(path string) []custommetricsv1beta2.MetricValue {
+		recorder := get(path)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		metricValues := &custommetricsv1beta2.MetricValueList{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), metricValues)).To(Succeed())
+		return metricValues.Items
+	}
+
+	BeforeEach(func() {
+		testScheme := runtime.NewScheme()
+		Expect(v2.AddToScheme(testScheme)).To(Succeed())
+		kubeClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(
+			newQueue("queue-a", "a", 4, "2.5"),
+			newQueue("queue-b", "b", 8, "1"),
+		).Build()
+		handler = NewHandler(kubeClient)
+	})
+
+	It("lists the queue metrics", func() {
+		recorder := get("/apis/custom.metrics.k8s.io/v1beta2")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		resources := &metav1.APIResourceList{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), resources)).To(Succeed())
+		Expect(resources.GroupVersion).To(Equal("custom.metrics.k8s.io/v1beta2"))
+		Expect(resources.APIResources).To(HaveLen(len(metricNames)))
+		Expect(resources.APIResources[0].Name).To(Equal("queues.scheduling.run.ai/queue_deserved_gpus"))
+		Expect(resources.APIResources[0].Namespaced).To(BeFalse())
+	})
+
+	It("returns the metric of a queue", func() {
+		values := getMetricValues("/apis/custom.metrics.k8s.io/v1beta2/queues.scheduling.run.ai/queue-a/queue_allocated_gpus")
+		Expect(values).To(HaveLen(1))
+		Expect(values[0].DescribedObject.Name).To(Equal("queue-a"))
+		Expect(values[0].DescribedObject.Kind).To(Equal("Queue"))
+		Expect(values[0].Metric.Name).To(Equal("queue_allocated_gpus"))
+		Expect(values[0].Value.AsApproximateFloat64()).To(Equal(2.5))
+	})
+
+	It("ignores the namespace of namespaced requests", func() {
+		values := getMetricValues(
+			"/apis/custom.metrics.k8s.io/v1beta2/namespaces/default/queues.scheduling.run.ai/queue-b/queue_deserved_gpus")
+		Expect(values).To(HaveLen(1))
+		Expect(values[0].Value.AsApproximateFloat64()).To(Equal(float64(8)))
+	})
+
+	It("returns the metric of all queues matching a label selector", func() {
+		values := getMetricValues("/apis/custom.metrics.k8s.io/v1beta2/queues.scheduling.run.ai/*/queue_deserved_gpus")
+		Expect(values).To(HaveLen(2))
+		Expect(values[0].DescribedObject.Name).To(Equal("queue-a"))
+		Expect(values[1].DescribedObject.Name).To(Equal("queue-b"))
+
+		values = getMetricValues(
+			"/apis/custom.metrics.k8s.io/v1beta2/queues.scheduling.run.ai/*/queue_deserved_gpus?labelSelector=team%3Db")
+		Expect(values).To(HaveLen(1))
+		Expect(values[0].DescribedObject.Name).To(Equal("queue-b"))
+	})
+
+	It("returns not found for unknown queues and metrics", func() {
+		Expect(get("/apis/custom.metrics.k8s.io/v1beta2/queues.scheduling.run.ai/missing/queue_allocated_gpus").Code).
+			To(Equal(http.StatusNotFound))
+		Expect(get("/apis/custom.metrics.k8s.io/v1beta2/queues.scheduling.run.ai/queue-a/unknown").Code).
+			To(Equal(http.StatusNotFound))
+		Expect(get("/apis/custom.metrics.k8s.io/v1beta2/pods/pod-a/queue_allocated_gpus").Code).
+			To(Equal(http.StatusNotFound))
+	})
+
+	It("rejects invalid label selectors", func() {
+		Expect(get("/apis/custom.metrics.k8s.io/v1beta2/queues.scheduling.run.ai/*/queue_deserved_gpus?labelSelector=%3D%3D").Code).
+			To(Equal(http.StatusBadRequest))
+	})
+})
@@ -0,0 +1,111 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package custommetrics
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	certFileName    = "tls.crt"
+	keyFileName     = "tls.key"
+	shutdownTimeout = 10 * time.Second
+)
+
+// Server serves the custom metrics API of queues over TLS, to be registered with an APIService in the Kubernetes
+// API aggregation layer. The API server authorizes requests before proxying them, so when a client CA is given,
+// only clients with a certificate signed by it (the aggregator) are accepted.
+type Server struct {
+	address      string
+	certDir      string
+	clientCAFile string
+	handler      http.Handler
+}
+
+func NewServer(kubeClient client.Reader, address, certDir, clientCAFile string) *Server {
+	return &Server{
+		address:      address,
+		certDir:      certDir,
+		clientCAFile: clientCAFile,
+		handler:      NewHandler(kubeClient),
+	}
+}
+
+// NeedLeaderElection returns false, as every replica can serve the API
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *Server) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("custom-metrics-api")
+
+	certWatcher, err := certwatcher.New(filepath.Join(s.certDir, certFileName), filepath.Join(s.certDir, keyFileName))
+	if err != nil {
+		return fmt.Errorf("failed to load custom metrics API serving certificate: %w", err)
+	}
+	go func() {
+		if err := certWatcher.Start(ctx); err != nil {
+			logger.Error(err, "Certificate watcher failed")
+		}
+	}()
+
+	tlsConfig := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: certWatcher.GetCertificate,
+	}
+	if s.clientCAFile != "" {
+		clientCAs, err := loadCertPool(s.clientCAFile)
+		if err != nil {
+			return err
+		}
+		tlsConfig.ClientCAs = clientCAs
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	server := &http.Server{
+		Addr:              s.address,
+		Handler:           s.handler,
+		TLSConfig:         tlsConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logger.Error(err, "Failed to shut down custom metrics API server")
+		}
+	}()
+
+	logger.Info("Serving custom metrics API", "address", s.address)
+	if err = server.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	caData, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA file %s: %w", caFile, err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caData) {
+		return nil, fmt.Errorf("no certificates found in client CA file %s", caFile)
+	}
+	return pool, nil
+}
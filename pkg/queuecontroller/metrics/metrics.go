@@ -27,6 +27,16 @@ const (
 	gpuResourceNameSuffix = "/gpu"
 )
 
+const (
+	queueInfoMetricName            = "queue_info"
+	QueueDeservedGPUsMetricName    = "queue_deserved_gpus"
+	QueueQuotaCPUMetricName        = "queue_quota_cpu_cores"
+	QueueQuotaMemoryMetricName     = "queue_quota_memory_bytes"
+	QueueAllocatedGPUsMetricName   = "queue_allocated_gpus"
+	QueueAllocatedCPUMetricName    = "queue_allocated_cpu_cores"
+	QueueAllocatedMemoryMetricName = "queue_allocated_memory_bytes"
+)
+
 var (
 	initiated = false
 
@@ -82,7 +92,7 @@ func InitMetrics(namespace string, queueLabelToMetricLabelMap, queueLabelToDefau
 	queueInfo = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: namespace,
-			Name:      "queue_info",
+			Name:      queueInfoMetricName,
 			Help:      "Queues info",
 		}, queueMetricsLabels,
 	)
@@ -90,7 +100,7 @@ func InitMetrics(namespace string, queueLabelToMetricLabelMap, queueLabelToDefau
 	queueDeservedGPUs = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: namespace,
-			Name:      "queue_deserved_gpus",
+			Name:      QueueDeservedGPUsMetricName,
 			Help:      "Queue deserved GPUs",
 		}, queueMetricsLabels,
 	)
@@ -98,7 +108,7 @@ func InitMetrics(namespace string, queueLabelToMetricLabelMap, queueLabelToDefau
 	queueQuotaCPU = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: namespace,
-			Name:      "queue_quota_cpu_cores",
+			Name:      QueueQuotaCPUMetricName,
 			Help:      "Queue quota CPU",
 		}, queueMetricsLabels,
 	)
@@ -106,7 +116,7 @@ func InitMetrics(namespace string, queueLabelToMetricLabelMap, queueLabelToDefau
 	queueQuotaMemory = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: namespace,
-			Name:      "queue_quota_memory_bytes",
+			Name:      QueueQuotaMemoryMetricName,
 			Help:      "Queue quota memory",
 		}, queueMetricsLabels,
 	)
@@ -114,7 +124,7 @@ func InitMetrics(namespace string, queueLabelToMetricLabelMap, queueLabelToDefau
 	queueAllocatedGpus = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: namespace,
-			Name:      "queue_allocated_gpus",
+			Name:      QueueAllocatedGPUsMetricName,
 			Help:      "Queue allocated GPUs",
 		}, queueMetricsLabels,
 	)
@@ -122,7 +132,7 @@ func InitMetrics(namespace string, queueLabelToMetricLabelMap, queueLabelToDefau
 	queueAllocatedCpus = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: namespace,
-			Name:      "queue_allocated_cpu_cores",
+			Name:      QueueAllocatedCPUMetricName,
 			Help:      "Queue allocated CPUs",
 		}, queueMetricsLabels,
 	)
@@ -130,7 +140,7 @@ func InitMetrics(namespace string, queueLabelToMetricLabelMap, queueLabelToDefau
 	queueAllocatedMemory = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: namespace,
-			Name:      "queue_allocated_memory_bytes",
+			Name:      QueueAllocatedMemoryMetricName,
 			Help:      "Queue allocated memory",
 		}, queueMetricsLabels,
 	)
@@ -167,6 +177,18 @@ func SetQueueMetrics(queue *v2.Queue) {
 	queueAllocatedMemory.WithLabelValues(queueQuotaMetricValues...).Set(allocatedMemory)
 }
 
+// GetQueueMetricValues returns the values of the usage and quota metrics of the queue by metric name
+func GetQueueMetricValues(queue *v2.Queue) map[string]float64 {
+	return map[string]float64{
+		QueueDeservedGPUsMetricName:    getGpuQuota(queue.Spec.Resources),
+		QueueQuotaCPUMetricName:        getCpuQuotaCores(queue.Spec.Resources),
+		QueueQuotaMemoryMetricName:     getMemoryQuotaBytes(queue.Spec.Resources),
+		QueueAllocatedGPUsMetricName:   getAllocatedGpus(queue.Status),
+		QueueAllocatedCPUMetricName:    getAllocatedCpuCores(queue.Status),
+		QueueAllocatedMemoryMetricName: getAllocatedMemoryBytes(queue.Status),
+	}
+}
+
 func ResetQueueMetrics(queueName string) {
 	queueLabelIdentifier := prometheus.Labels{queueNameLabel: queueName}
 	queueInfo.DeletePartialMatch(queueLabelIdentifier)
@@ -0,0 +1,262 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	coordinationv1api "k8s.io/api/coordination/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coordinationv1 "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	leaseNamePrefix       = "queue-controller-shard"
+	memberLeaseNamePrefix = "queue-controller-replica"
+
+	leaseDuration = 15 * time.Second
+	renewDeadline = 10 * time.Second
+	retryPeriod   = 2 * time.Second
+
+	// balancePeriod is how often a replica releases the shards it leads above its fair share
+	balancePeriod = leaseDuration
+)
+
+// Elector runs a leader election for every shard, leading each shard by a single replica through a lease. Every
+// replica also renews a member lease, so the replicas that don't lead any shard are counted when the shards are
+// balanced between the replicas.
+type Elector struct {
+	shards          *Shards
+	leaseNamespace  string
+	identity        string
+	memberLeaseName string
+	leasesClient    coordinationv1.CoordinationV1Interface
+
+	mutex sync.Mutex
+	// leading holds the cancel functions of the elections of the shards led by this replica
+	leading map[int]context.CancelFunc
+	// released holds the shards that this replica released to balance the shards between the replicas
+	released map[int]bool
+}
+
+func NewElector(shards *Shards, leaseNamespace string, leasesClient coordinationv1.CoordinationV1Interface) (*Elector, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hostname: %v", err)
+	}
+	id := uuid.NewUUID()
+	return &Elector{
+		shards:          shards,
+		leaseNamespace:  leaseNamespace,
+		identity:        fmt.Sprintf("%s_%s", hostname, id),
+		memberLeaseName: fmt.Sprintf("%s-%s", memberLeaseNamePrefix, id),
+		leasesClient:    leasesClient,
+		leading:         map[int]context.CancelFunc{},
+		released:        map[int]bool{},
+	}, nil
+}
+
+// NeedLeaderElection returns false, as the elector runs on every replica to lead its own shards.
+func (e *Elector) NeedLeaderElection() bool {
+	return false
+}
+
+// Start joins the election of every shard. A replica delays joining the election of the next shard by the number of
+// shards it already leads, so the shards are spread between the replicas that start together. Replicas that start
+// later, or that are left after other replicas stop, get their share of the shards as the replicas that lead more
+// than their share release them.
+func (e *Elector) Start(ctx context.Context) error {
+	go wait.UntilWithContext(ctx, e.renewMemberLease, retryPeriod)
+	go wait.UntilWithContext(ctx, e.balance, balancePeriod)
+	defer e.deleteMemberLease()
+
+	for shard := 0; shard < e.shards.Count(); shard++ {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(time.Duration(e.shards.ownedCount()) * retryPeriod):
+		}
+		go e.runShardElection(ctx, shard)
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func (e *Elector) runShardElection(ctx context.Context, shard int) {
+	logger := log.FromContext(ctx).WithValues("shard", shard)
+
+	// The election returns when the lease is lost or released, join it again until the replica stops
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		elector, err := leaderelection.NewLeaderElector(e.electionConfig(ctx, shard, logger))
+		if err != nil {
+			logger.Error(err, "Failed to create the leader elector of the shard")
+			return
+		}
+		electionCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		e.mutex.Lock()
+		e.leading[shard] = cancel
+		e.mutex.Unlock()
+
+		elector.Run(electionCtx)
+
+		e.mutex.Lock()
+		delete(e.leading, shard)
+		released := e.released[shard]
+		delete(e.released, shard)
+		e.mutex.Unlock()
+
+		// Give the other replicas a lease duration to acquire a released shard before joining its election again
+		if released {
+			select {
+			case <-ctx.Done():
+			case <-time.After(leaseDuration):
+			}
+		}
+	}, retryPeriod)
+}
+
+// balance releases the shards that this replica leads above its share of the shards between the live replicas
+func (e *Elector) balance(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	replicas, err := e.countLiveReplicas(ctx)
+	if err != nil {
+		logger.Error(err, "Failed to count the replicas leading queue shards")
+		return
+	}
+	share := (e.shards.Count() + replicas - 1) / replicas
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	owned := e.shards.ownedShards()
+	if len(owned) <= share {
+		return
+	}
+	for _, shard := range owned[share:] {
+		cancel, found := e.leading[shard]
+		if !found {
+			continue
+		}
+		logger.Info("Releasing queue shard to balance the shards between the replicas",
+			"shard", shard, "replicas", replicas, "share", share)
+		e.released[shard] = true
+		cancel()
+	}
+}
+
+// countLiveReplicas counts the distinct holders of the shard and member leases that didn't expire, including this
+// replica
+func (e *Elector) countLiveReplicas(ctx context.Context) (int, error) {
+	leases, err := e.leasesClient.Leases(e.leaseNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list leases: %v", err)
+	}
+	holders := map[string]bool{e.identity: true}
+	now := time.Now()
+	for _, lease := range leases.Items {
+		if !strings.HasPrefix(lease.Name, leaseNamePrefix) && !strings.HasPrefix(lease.Name, memberLeaseNamePrefix) {
+			continue
+		}
+		if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity == "" || isLeaseExpired(&lease, now) {
+			continue
+		}
+		holders[*lease.Spec.HolderIdentity] = true
+	}
+	return len(holders), nil
+}
+
+// renewMemberLease creates or renews the member lease of this replica
+func (e *Elector) renewMemberLease(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	leases := e.leasesClient.Leases(e.leaseNamespace)
+	now := metav1.NewMicroTime(time.Now())
+
+	lease, err := leases.Get(ctx, e.memberLeaseName, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		lease = &coordinationv1api.Lease{
+			ObjectMeta: metav1.ObjectMeta{Name: e.memberLeaseName, Namespace: e.leaseNamespace},
+			Spec: coordinationv1api.LeaseSpec{
+				HolderIdentity:       &e.identity,
+				LeaseDurationSeconds: ptr.To(int32(leaseDuration.Seconds())),
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}
+		if _, err = leases.Create(ctx, lease, metav1.CreateOptions{}); err != nil {
+			logger.Error(err, "Failed to create the member lease of the replica")
+		}
+		return
+	}
+	if err != nil {
+		logger.Error(err, "Failed to get the member lease of the replica")
+		return
+	}
+	lease.Spec.RenewTime = &now
+	if _, err = leases.Update(ctx, lease, metav1.UpdateOptions{}); err != nil {
+		logger.Error(err, "Failed to renew the member lease of the replica")
+	}
+}
+
+// deleteMemberLease deletes the member lease of this replica once it stops, so the other replicas stop counting it
+// without waiting for the lease to expire
+func (e *Elector) deleteMemberLease() {
+	ctx, cancel := context.WithTimeout(context.Background(), renewDeadline)
+	defer cancel()
+	err := e.leasesClient.Leases(e.leaseNamespace).Delete(ctx, e.memberLeaseName, metav1.DeleteOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		log.FromContext(ctx).Error(err, "Failed to delete the member lease of the replica")
+	}
+}
+
+func isLeaseExpired(lease *coordinationv1api.Lease, now time.Time) bool {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	expiry := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+	return expiry.Before(now)
+}
+
+func (e *Elector) electionConfig(ctx context.Context, shard int, logger logr.Logger) leaderelection.LeaderElectionConfig {
+	return leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Namespace: e.leaseNamespace,
+				Name:      fmt.Sprintf("%s-%d", leaseNamePrefix, shard),
+			},
+			Client:     e.leasesClient,
+			LockConfig: resourcelock.ResourceLockConfig{Identity: e.identity},
+		},
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   renewDeadline,
+		RetryPeriod:     retryPeriod,
+		ReleaseOnCancel: true,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				logger.Info("Started leading queue shard")
+				if err := e.shards.acquire(ctx, shard); err != nil {
+					logger.Error(err, "Failed to resync the queues of the shard")
+				}
+			},
+			OnStoppedLeading: func() {
+				logger.Info("Stopped leading queue shard")
+				if err := e.shards.release(ctx, shard); err != nil {
+					logger.Error(err, "Failed to resync the queues of the shard")
+				}
+			},
+		},
+	}
+}
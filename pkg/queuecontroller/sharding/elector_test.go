@@ -0,0 +1,131 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package sharding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+const testLeaseNamespace = "kai-scheduler"
+
+func newLease(name, holder string, renewTime time.Time) *coordinationv1.Lease {
+	return &coordinationv1.Lease{
+		ObjectMeta: v1.ObjectMeta{Name: name, Namespace: testLeaseNamespace},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.To(holder),
+			LeaseDurationSeconds: ptr.To(int32(leaseDuration.Seconds())),
+			RenewTime:            ptr.To(v1.NewMicroTime(renewTime)),
+		},
+	}
+}
+
+func newTestElector(t *testing.T, shards *Shards, leases ...*coordinationv1.Lease) *Elector {
+	kubeClient := kubefake.NewClientset()
+	for _, lease := range leases {
+		_, err := kubeClient.CoordinationV1().Leases(testLeaseNamespace).Create(
+			context.Background(), lease, v1.CreateOptions{})
+		require.NoError(t, err)
+	}
+	elector, err := NewElector(shards, testLeaseNamespace, kubeClient.CoordinationV1())
+	require.NoError(t, err)
+	return elector
+}
+
+func TestBalanceReleasesShardsAboveShare(t *testing.T) {
+	tests := []struct {
+		name             string
+		leases           []*coordinationv1.Lease
+		expectedReleased []int
+	}{
+		{
+			name:             "single replica keeps every shard",
+			expectedReleased: []int{},
+		},
+		{
+			name: "replica without shards gets a share",
+			leases: []*coordinationv1.Lease{
+				newLease(memberLeaseNamePrefix+"-other", "other", time.Now()),
+			},
+			expectedReleased: []int{3},
+		},
+		{
+			name: "replicas are counted from the holders of shard leases",
+			leases: []*coordinationv1.Lease{
+				newLease(leaseNamePrefix+"-4", "other", time.Now()),
+				newLease(leaseNamePrefix+"-5", "third", time.Now()),
+			},
+			expectedReleased: []int{2, 3},
+		},
+		{
+			name: "expired leases and other leases aren't counted",
+			leases: []*coordinationv1.Lease{
+				newLease(memberLeaseNamePrefix+"-other", "other", time.Now().Add(-2*leaseDuration)),
+				newLease("kai-scheduler-leader", "scheduler", time.Now()),
+			},
+			expectedReleased: []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shards := newTestShards(t, 6)
+			elector := newTestElector(t, shards, tt.leases...)
+
+			cancelled := []int{}
+			for shard := 0; shard < 4; shard++ {
+				shards.owned[shard] = true
+				elector.leading[shard] = func() { cancelled = append(cancelled, shard) }
+			}
+
+			elector.balance(context.Background())
+
+			assert.ElementsMatch(t, tt.expectedReleased, cancelled)
+			for _, shard := range tt.expectedReleased {
+				assert.True(t, elector.released[shard])
+			}
+		})
+	}
+}
+
+func TestRenewMemberLease(t *testing.T) {
+	elector := newTestElector(t, newTestShards(t, 2))
+	leases := elector.leasesClient.Leases(testLeaseNamespace)
+
+	elector.renewMemberLease(context.Background())
+	lease, err := leases.Get(context.Background(), elector.memberLeaseName, v1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, elector.identity, *lease.Spec.HolderIdentity)
+	assert.False(t, isLeaseExpired(lease, time.Now()))
+
+	lease.Spec.RenewTime = ptr.To(v1.NewMicroTime(time.Now().Add(-2 * leaseDuration)))
+	_, err = leases.Update(context.Background(), lease, v1.UpdateOptions{})
+	require.NoError(t, err)
+	elector.renewMemberLease(context.Background())
+	lease, err = leases.Get(context.Background(), elector.memberLeaseName, v1.GetOptions{})
+	require.NoError(t, err)
+	assert.False(t, isLeaseExpired(lease, time.Now()))
+
+	elector.deleteMemberLease()
+	_, err = leases.Get(context.Background(), elector.memberLeaseName, v1.GetOptions{})
+	assert.Error(t, err)
+}
+
+func TestReleaseSkipsResyncOnceStopped(t *testing.T) {
+	shards := newTestShards(t, 2, newQueue("root", ""))
+	shards.owned[ShardForRootQueue("root", 2)] = true
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The events channel has no reader, so a resync would block
+	require.NoError(t, shards.release(ctx, ShardForRootQueue("root", 2)))
+	assert.False(t, shards.OwnsShard(ShardForRootQueue("root", 2)))
+}
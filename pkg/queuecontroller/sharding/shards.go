@@ -0,0 +1,164 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"slices"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+)
+
+// Shards tracks which reconciliation shards of the queues are led by this replica. Queues are sharded by the root
+// queue of their hierarchy, so a queue is always reconciled together with its parents and children.
+type Shards struct {
+	client.Reader
+	count int
+
+	mutex sync.RWMutex
+	owned map[int]bool
+
+	events chan event.GenericEvent
+}
+
+func NewShards(reader client.Reader, count int) *Shards {
+	return &Shards{
+		Reader: reader,
+		count:  count,
+		owned:  map[int]bool{},
+		events: make(chan event.GenericEvent),
+	}
+}
+
+// Events returns the channel of the queues that should be reconciled after this replica acquired or released their
+// shard
+func (s *Shards) Events() <-chan event.GenericEvent {
+	return s.events
+}
+
+func (s *Shards) Count() int {
+	return s.count
+}
+
+func (s *Shards) OwnsShard(shard int) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.owned[shard]
+}
+
+func (s *Shards) ownedCount() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return len(s.owned)
+}
+
+// ownedShards returns the shards led by this replica in ascending order
+func (s *Shards) ownedShards() []int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return slices.Sorted(maps.Keys(s.owned))
+}
+
+// OwnsQueue returns whether the shard of the queue's hierarchy is led by this replica
+func (s *Shards) OwnsQueue(ctx context.Context, queue *v2.Queue) (bool, error) {
+	root, err := findRootQueue(queue.Name, queue.Spec.ParentQueue, func(name string) (string, error) {
+		parent := &v2.Queue{}
+		if err := s.Get(ctx, types.NamespacedName{Name: name}, parent); err != nil {
+			return "", client.IgnoreNotFound(err)
+		}
+		return parent.Spec.ParentQueue, nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return s.OwnsShard(ShardForRootQueue(root, s.count)), nil
+}
+
+func (s *Shards) acquire(ctx context.Context, shard int) error {
+	s.mutex.Lock()
+	s.owned[shard] = true
+	s.mutex.Unlock()
+
+	return s.resyncShard(ctx, shard)
+}
+
+// release stops leading the shard, and resyncs its queues so their metrics are reset until the replica that acquires
+// the shard publishes them. The queues aren't resynced once the replica stops.
+func (s *Shards) release(ctx context.Context, shard int) error {
+	s.mutex.Lock()
+	delete(s.owned, shard)
+	s.mutex.Unlock()
+
+	if ctx.Err() != nil {
+		return nil
+	}
+	return s.resyncShard(ctx, shard)
+}
+
+func (s *Shards) resyncShard(ctx context.Context, shard int) error {
+	queues := &v2.QueueList{}
+	if err := s.List(ctx, queues); err != nil {
+		return fmt.Errorf("failed to list queues of shard %d: %v", shard, err)
+	}
+	parents := map[string]string{}
+	for _, queue := range queues.Items {
+		parents[queue.Name] = queue.Spec.ParentQueue
+	}
+	for i := range queues.Items {
+		queue := &queues.Items[i]
+		root, err := findRootQueue(queue.Name, queue.Spec.ParentQueue, func(name string) (string, error) {
+			return parents[name], nil
+		})
+		if err != nil {
+			return err
+		}
+		if ShardForRootQueue(root, s.count) != shard {
+			continue
+		}
+		select {
+		case s.events <- event.GenericEvent{Object: queue}:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+	return nil
+}
+
+// ShardForRootQueue returns the shard of the queues in the hierarchy of the root queue
+func ShardForRootQueue(root string, count int) int {
+	if count <= 1 {
+		return 0
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(root))
+	return int(hash.Sum32() % uint32(count))
+}
+
+// findRootQueue walks up the parents of the queue until a queue without a parent is found. A parent queue that
+// doesn't exist is treated as the root of the hierarchy
+func findRootQueue(queueName, parent string, getParent func(name string) (string, error)) (string, error) {
+	root := queueName
+	visited := map[string]bool{queueName: true}
+	for parent != "" {
+		if visited[parent] {
+			return "", fmt.Errorf("queue %s has a cyclic parent hierarchy", queueName)
+		}
+		visited[parent] = true
+
+		grandParent, err := getParent(parent)
+		if err != nil {
+			return "", fmt.Errorf("failed to get parent queue %s of queue %s: %v", parent, root, err)
+		}
+		root, parent = parent, grandParent
+	}
+	return root, nil
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+)
+
+func newQueue(name, parent string) *v2.Queue {
+	return &v2.Queue{
+		ObjectMeta: v1.ObjectMeta{Name: name},
+		Spec:       v2.QueueSpec{ParentQueue: parent},
+	}
+}
+
+func newTestShards(t *testing.T, count int, queues ...client.Object) *Shards {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v2.AddToScheme(scheme))
+	return NewShards(fake.NewClientBuilder().WithScheme(scheme).WithObjects(queues...).Build(), count)
+}
+
+func TestShardForRootQueue(t *testing.T) {
+	assert.Equal(t, 0, ShardForRootQueue("root", 1))
+	assert.Equal(t, ShardForRootQueue("root", 4), ShardForRootQueue("root", 4))
+
+	shards := map[int]bool{}
+	for i := 0; i < 100; i++ {
+		shard := ShardForRootQueue(fmt.Sprintf("root-%d", i), 4)
+		assert.True(t, shard >= 0 && shard < 4)
+		shards[shard] = true
+	}
+	assert.Len(t, shards, 4)
+}
+
+func TestFindRootQueue(t *testing.T) {
+	parents := map[string]string{
+		"root":      "",
+		"dept":      "root",
+		"team":      "dept",
+		"orphan":    "missing",
+		"cycle-a":   "cycle-b",
+		"cycle-b":   "cycle-a",
+		"self-loop": "self-loop",
+	}
+	getParent := func(name string) (string, error) {
+		return parents[name], nil
+	}
+
+	tests := []struct {
+		name         string
+		queue        string
+		expectedRoot string
+		expectErr    bool
+	}{
+		{name: "root queue", queue: "root", expectedRoot: "root"},
+		{name: "leaf queue", queue: "team", expectedRoot: "root"},
+		{name: "missing parent", queue: "orphan", expectedRoot: "missing"},
+		{name: "cyclic hierarchy", queue: "cycle-a", expectErr: true},
+		{name: "queue is its own parent", queue: "self-loop", expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, err := findRootQueue(tt.queue, parents[tt.queue], getParent)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRoot, root)
+		})
+	}
+}
+
+func TestOwnsQueue(t *testing.T) {
+	shards := newTestShards(t, 8, newQueue("root", ""), newQueue("dept", "root"), newQueue("team", "dept"))
+	rootShard := ShardForRootQueue("root", 8)
+
+	owned, err := shards.OwnsQueue(context.Background(), newQueue("team", "dept"))
+	require.NoError(t, err)
+	assert.False(t, owned)
+
+	require.NoError(t, shards.acquire(context.Background(), (rootShard+1)%8))
+	owned, err = shards.OwnsQueue(context.Background(), newQueue("team", "dept"))
+	require.NoError(t, err)
+	assert.False(t, owned)
+
+	go func() {
+		for range shards.Events() {
+		}
+	}()
+	require.NoError(t, shards.acquire(context.Background(), rootShard))
+	for _, queue := range []*v2.Queue{newQueue("root", ""), newQueue("dept", "root"), newQueue("team", "dept")} {
+		owned, err = shards.OwnsQueue(context.Background(), queue)
+		require.NoError(t, err)
+		assert.True(t, owned, "queue %s", queue.Name)
+	}
+
+	require.NoError(t, shards.release(context.Background(), rootShard))
+	owned, err = shards.OwnsQueue(context.Background(), newQueue("team", "dept"))
+	require.NoError(t, err)
+	assert.False(t, owned)
+}
+
+func TestAcquireResyncsShardQueues(t *testing.T) {
+	var queues []client.Object
+	for i := 0; i < 10; i++ {
+		root := fmt.Sprintf("root-%d", i)
+		queues = append(queues, newQueue(root, ""), newQueue(root+"-child", root))
+	}
+	shards := newTestShards(t, 3, queues...)
+
+	var received []string
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for e := range shards.Events() {
+			received = append(received, e.Object.GetName())
+		}
+	}()
+	require.NoError(t, shards.acquire(context.Background(), 1))
+	close(shards.events)
+	<-done
+
+	var expected []string
+	for i := 0; i < 10; i++ {
+		root := fmt.Sprintf("root-%d", i)
+		if ShardForRootQueue(root, 3) == 1 {
+			expected = append(expected, root, root+"-child")
+		}
+	}
+	assert.ElementsMatch(t, expected, received)
+	assert.True(t, shards.OwnsShard(1))
+	assert.False(t, shards.OwnsShard(0))
+}
+
+func TestReleaseResyncsShardQueues(t *testing.T) {
+	shards := newTestShards(t, 8, newQueue("root", ""), newQueue("dept", "root"), newQueue("other", ""))
+	rootShard := ShardForRootQueue("root", 8)
+
+	shards.owned[rootShard] = true
+
+	var received []string
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for e := range shards.Events() {
+			received = append(received, e.Object.GetName())
+		}
+	}()
+	require.NoError(t, shards.release(context.Background(), rootShard))
+	close(shards.events)
+	<-done
+
+	expected := []string{"root", "dept"}
+	if ShardForRootQueue("other", 8) == rootShard {
+		expected = append(expected, "other")
+	}
+	assert.ElementsMatch(t, expected, received)
+	assert.False(t, shards.OwnsShard(rootShard))
+}
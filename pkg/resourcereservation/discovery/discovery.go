@@ -6,15 +6,41 @@ package discovery
 import (
 	"context"
 	"fmt"
+	"os"
+	"runtime"
 
 	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	v1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 )
 
+// nvmlLibraryPaths are the locations of the NVML library injected by the container toolkit, per architecture.
+// They are used when the library is not found in the default library search path.
+var nvmlLibraryPaths = map[string][]string{
+	"amd64": {"/usr/lib/x86_64-linux-gnu/libnvidia-ml.so.1", "/usr/lib64/libnvidia-ml.so.1"},
+	"arm64": {"/usr/lib/aarch64-linux-gnu/libnvidia-ml.so.1", "/usr/lib64/libnvidia-ml.so.1"},
+}
+
+// GetGPUDevice returns the id of the single accelerator device of the pod, discovered by the vendor of the
+// accelerator resource that the pod reserves
 func GetGPUDevice(ctx context.Context) (string, error) {
+	resourceName := os.Getenv(constants.ReservedResourceNameEnv)
+	if resourceName == "" {
+		resourceName = constants.GpuResource
+	}
+	discoverDevice, found := deviceDiscoveries[v1.ResourceName(resourceName)]
+	if !found {
+		return "", fmt.Errorf("no device discovery for the accelerator resource %s", resourceName)
+	}
+	return discoverDevice(ctx)
+}
+
+func nvidiaDevice(ctx context.Context) (string, error) {
 	logger := log.FromContext(ctx)
 
-	ret := nvml.Init()
+	ret := initNVML(ctx)
 	if ret != nvml.SUCCESS {
 		return "", fmt.Errorf("unable to initialize NVML: %v", nvml.ErrorString(ret))
 	}
@@ -47,3 +73,23 @@ func GetGPUDevice(ctx context.Context) (string, error) {
 
 	return uuid, nil
 }
+
+func initNVML(ctx context.Context) nvml.Return {
+	logger := log.FromContext(ctx)
+
+	ret := nvml.Init()
+	if ret != nvml.ERROR_LIBRARY_NOT_FOUND {
+		return ret
+	}
+	for _, libraryPath := range nvmlLibraryPaths[runtime.GOARCH] {
+		if err := nvml.SetLibraryOptions(nvml.WithLibraryPath(libraryPath)); err != nil {
+			logger.Info("Unable to set NVML library path", "path", libraryPath, "error", err)
+			continue
+		}
+		logger.Info("Retrying NVML initialization", "path", libraryPath, "arch", runtime.GOARCH)
+		if ret = nvml.Init(); ret != nvml.ERROR_LIBRARY_NOT_FOUND {
+			return ret
+		}
+	}
+	return ret
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+// renderNodesPattern matches the render nodes that the AMD and Intel device plugins mount into the container
+const renderNodesPattern = "/dev/dri/renderD*"
+
+// DeviceDiscoveryFn returns the id of the single device of an accelerator vendor that is mounted into the pod
+type DeviceDiscoveryFn func(ctx context.Context) (string, error)
+
+var deviceDiscoveries = map[v1.ResourceName]DeviceDiscoveryFn{
+	constants.GpuResource:         nvidiaDevice,
+	constants.AmdGpuResource:      renderNodeDevice,
+	constants.IntelGpuResource:    renderNodeDevice,
+	constants.HabanaGaudiResource: habanaDevice,
+}
+
+// RegisterDeviceDiscovery adds or replaces the device discovery of an accelerator resource. It is not safe to call
+// concurrently with GetGPUDevice.
+func RegisterDeviceDiscovery(resourceName v1.ResourceName, discoverDevice DeviceDiscoveryFn) {
+	deviceDiscoveries[resourceName] = discoverDevice
+}
+
+// renderNodeDevice returns the name of the render node of the device, e.g. renderD128
+func renderNodeDevice(ctx context.Context) (string, error) {
+	renderNodes, err := filepath.Glob(renderNodesPattern)
+	if err != nil {
+		return "", fmt.Errorf("unable to list render nodes: %w", err)
+	}
+
+	log.FromContext(ctx).Info(fmt.Sprintf("Found %d render nodes", len(renderNodes)))
+	if len(renderNodes) != 1 {
+		return "", fmt.Errorf("found %d devices, 1 was expected", len(renderNodes))
+	}
+	return filepath.Base(renderNodes[0]), nil
+}
+
+// habanaDevice returns the module id of the device, which the Habana device plugin sets in the environment
+func habanaDevice(ctx context.Context) (string, error) {
+	visibleDevices := os.Getenv(constants.HabanaVisibleDevices)
+	if visibleDevices == "" {
+		return "", fmt.Errorf("%s is not set", constants.HabanaVisibleDevices)
+	}
+
+	devices := strings.Split(visibleDevices, ",")
+	log.FromContext(ctx).Info(fmt.Sprintf("Found %d Habana devices", len(devices)))
+	if len(devices) != 1 {
+		return "", fmt.Errorf("found %d devices, 1 was expected", len(devices))
+	}
+	return strings.TrimSpace(devices[0]), nil
+}
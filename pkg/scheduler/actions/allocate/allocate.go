@@ -20,12 +20,19 @@ limitations under the License.
 package allocate
 
 import (
-	"time"
+	"slices"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/maps"
 
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/tracing"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/common"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
@@ -48,23 +55,43 @@ func (alloc *allocateAction) Execute(ssn *framework.Session) {
 	defer log.InfraLogger.V(2).Infof("Leaving Allocate ...")
 
 	jobsOrderByQueues := utils.NewJobsOrderByQueues(ssn, utils.JobsOrderInitOptions{
-		FilterNonPending:  true,
-		FilterUnready:     true,
-		MaxJobsQueueDepth: ssn.GetJobsDepth(framework.Allocate),
+		FilterNonPending:       true,
+		FilterUnready:          true,
+		FilterOutOfScopeQueues: true,
+		MaxJobsQueueDepth:      ssn.GetJobsDepth(framework.Allocate),
 	})
 	jobsOrderByQueues.InitializeWithJobs(ssn.ClusterInfo.PodGroupInfos)
 
 	log.InfraLogger.V(2).Infof("There are <%d> PodGroupInfos and <%d> Queues in total for scheduling",
 		jobsOrderByQueues.Len(), ssn.CountLeafQueues())
+	gangSizeLanes := utils.NewGangSizeLanes(ssn.Config.GangSizeLanes)
 	for !jobsOrderByQueues.IsEmpty() {
 		job := jobsOrderByQueues.PopNextJob()
+		if lane, ok := gangSizeLanes.TryAttempt(job); !ok {
+			log.InfraLogger.V(3).Infof("Gang size lane <%s> used its attempts for this cycle, skipping job: <%v/%v>",
+				lane, job.Namespace, job.Name)
+			continue
+		}
 		stmt := ssn.Statement()
 		alreadyAllocated := job.GetNumAllocatedTasks() > 0
-		if ok, pipelined := attemptToAllocateJob(ssn, stmt, job); ok {
+		_, span := tracing.Tracer().Start(ssn.Context(), "allocate.Job",
+			trace.WithAttributes(attribute.String("job", job.NamespacedName)))
+		var ok, pipelined bool
+		if scavengerQueue := getScavengerQueue(ssn, job); scavengerQueue != "" {
+			ok, pipelined = attemptToScavenge(ssn, stmt, job, scavengerQueue)
+		} else {
+			ok, pipelined = attemptToAllocateJob(ssn, stmt, job)
+		}
+		span.SetAttributes(attribute.Bool("allocated", ok), attribute.Bool("pipelined", pipelined))
+		if ok {
 			metrics.IncPodgroupScheduledByAction()
 			err := stmt.Commit()
-			if err == nil && !pipelined && !alreadyAllocated {
-				setLastStartTimestamp(job)
+			span.End()
+			if err == nil && !pipelined {
+				if !alreadyAllocated {
+					setLastStartTimestamp(ssn, job)
+				}
+				ssn.PostJobAllocation(job)
 			}
 			if err == nil && podgroup_info.HasTasksToAllocate(job, true) {
 				jobsOrderByQueues.PushJob(job)
@@ -72,6 +99,7 @@ func (alloc *allocateAction) Execute(ssn *framework.Session) {
 			}
 		} else {
 			stmt.Discard()
+			span.End()
 		}
 	}
 }
@@ -90,9 +118,9 @@ func attemptToAllocateJob(ssn *framework.Session, stmt *framework.Statement, job
 		return false, false
 	}
 	pipelined = false
-	if job.ShouldPipelineJob() {
+	if job.ShouldPipelineJob() || ssn.ShouldDeferJobBind(job) {
 		log.InfraLogger.V(3).Infof(
-			"Some tasks were pipelined, setting all job to be pipelined for job: <%v/%v>",
+			"Some tasks were pipelined or the bind was deferred, setting all job to be pipelined for job: <%v/%v>",
 			job.Namespace, job.Name)
 		err := stmt.ConvertAllAllocatedToPipelined(job.UID)
 		if err != nil {
@@ -110,7 +138,66 @@ func attemptToAllocateJob(ssn *framework.Session, stmt *framework.Statement, job
 	return true, pipelined
 }
 
-func setLastStartTimestamp(job *podgroup_info.PodGroupInfo) {
-	timeNow := time.Now()
+// getScavengerQueue returns the scavenger queue that the job may overflow to, if the job is preemptible and either is
+// of the best-effort-gpu workload class, is of one of the scavenging priority classes and exceeds the limit of its own
+// queue, or belongs to a queue that exhausted its budget and demotes its workloads
+func getScavengerQueue(ssn *framework.Session, job *podgroup_info.PodGroupInfo) common_info.QueueID {
+	scavenging := ssn.Config.Scavenging
+	if scavenging == nil || job.ScavengedFrom != "" || job.Queue == common_info.QueueID(scavenging.Queue) ||
+		!job.IsPreemptibleJob() || job.GetNumAllocatedTasks() > 0 {
+		return ""
+	}
+
+	// Best-effort jobs are always accounted to the scavenger queue instead of their own queue
+	if job.WorkloadClass != enginev2alpha2.BestEffortGPU {
+		tasksToAllocate := podgroup_info.GetTasksToAllocate(job, ssn.PodSetOrderFn, ssn.TaskOrderFn, true)
+		result := ssn.IsJobOverQueueCapacityFn(job, tasksToAllocate)
+		if result.IsSchedulable || !mayScavenge(ssn, job, result) {
+			return ""
+		}
+	}
+
+	scavengerQueue, found := ssn.ClusterInfo.Queues[common_info.QueueID(scavenging.Queue)]
+	if !found || !scavengerQueue.IsLeafQueue() {
+		log.InfraLogger.V(2).Warnf("Scavenger queue <%s> does not exist or is not a leaf queue", scavenging.Queue)
+		return ""
+	}
+	return scavengerQueue.UID
+}
+
+// mayScavenge returns true if the reason the job can't be allocated in its own queue lets it overflow to the scavenger
+// queue
+func mayScavenge(ssn *framework.Session, job *podgroup_info.PodGroupInfo, result *api.SchedulableResult) bool {
+	switch result.Reason {
+	case enginev2alpha2.OverLimit:
+		return slices.Contains(ssn.Config.Scavenging.PriorityClasses, job.GetPriorityClassName())
+	case enginev2alpha2.OverBudget:
+		if result.Details == nil || result.Details.QueueDetails == nil {
+			return false
+		}
+		queue, found := ssn.ClusterInfo.Queues[common_info.QueueID(result.Details.QueueDetails.Name)]
+		return found && queue.Budget != nil && queue.Budget.GetExhaustedAction() == enginev2.QueueBudgetActionDemote
+	}
+	return false
+}
+
+// attemptToScavenge attempts to allocate a job that can't be allocated under its queue under the scavenger queue. The job
+// stays in the scavenger queue while it is allocated, and is moved back to its queue if it can't be allocated.
+func attemptToScavenge(ssn *framework.Session, stmt *framework.Statement, job *podgroup_info.PodGroupInfo,
+	scavengerQueue common_info.QueueID) (allocated, pipelined bool) {
+	log.InfraLogger.V(3).Infof("Job <%v/%v> can't be allocated under queue <%v>, attempting to allocate it under "+
+		"scavenger queue <%v>", job.Namespace, job.Name, job.Queue, scavengerQueue)
+	job.Scavenge(scavengerQueue)
+	allocated, pipelined = attemptToAllocateJob(ssn, stmt, job)
+	if !allocated {
+		// The allocations are discarded before the job leaves the queue they were accounted to
+		stmt.Discard()
+		job.Scavenge("")
+	}
+	return allocated, pipelined
+}
+
+func setLastStartTimestamp(ssn *framework.Session, job *podgroup_info.PodGroupInfo) {
+	timeNow := ssn.Clock().Now()
 	job.LastStartTimestamp = &timeNow
 }
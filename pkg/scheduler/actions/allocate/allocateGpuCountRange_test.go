@@ -0,0 +1,241 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package allocate_test
+
+import (
+	"testing"
+
+	. "go.uber.org/mock/gomock"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/integration_tests/integration_tests_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestHandleGpuCountRangeAllocation(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	runTests(t, getGpuCountRangeTestsMetadata(), controller)
+}
+
+func getGpuCountRangeTestsMetadata() []integration_tests_utils.TestTopologyMetadata {
+	return []integration_tests_utils.TestTopologyMetadata{
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name: "Allocate the max number of GPUs in the range",
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:      "pending_job0",
+						QueueName: "queue0",
+						Priority:  constants.PriorityTrainNumber,
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								State:         pod_status.Pending,
+								GPUCountRange: &pod_info.GpuCountRange{Min: 4, Max: 8},
+							},
+						},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {
+						GPUs: 8,
+					},
+				},
+				Queues: []test_utils.TestQueueBasic{
+					{
+						Name:         "queue0",
+						DeservedGPUs: 8,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheBinds: 1,
+					},
+				},
+				JobExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"pending_job0": {
+						NodeName:     "node0",
+						GPUsRequired: 8,
+						Status:       pod_status.Binding,
+					},
+				},
+			},
+		},
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name: "Allocate the available GPUs when less than the max are available",
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:      "pending_job0",
+						QueueName: "queue0",
+						Priority:  constants.PriorityTrainNumber,
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								State:         pod_status.Pending,
+								GPUCountRange: &pod_info.GpuCountRange{Min: 4, Max: 8},
+							},
+						},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {
+						GPUs: 6,
+					},
+				},
+				Queues: []test_utils.TestQueueBasic{
+					{
+						Name:         "queue0",
+						DeservedGPUs: 8,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheBinds: 1,
+					},
+				},
+				JobExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"pending_job0": {
+						NodeName:     "node0",
+						GPUsRequired: 6,
+						Status:       pod_status.Binding,
+					},
+				},
+			},
+		},
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name: "Do not allocate when less than the min GPUs are available",
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:      "pending_job0",
+						QueueName: "queue0",
+						Priority:  constants.PriorityTrainNumber,
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								State:         pod_status.Pending,
+								GPUCountRange: &pod_info.GpuCountRange{Min: 4, Max: 8},
+							},
+						},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {
+						GPUs: 2,
+					},
+				},
+				Queues: []test_utils.TestQueueBasic{
+					{
+						Name:         "queue0",
+						DeservedGPUs: 8,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheBinds: 0,
+					},
+				},
+				JobExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"pending_job0": {
+						GPUsRequired: 8,
+						Status:       pod_status.Pending,
+					},
+				},
+			},
+		},
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name: "Allocate all the tasks of a gang job within their ranges",
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:      "pending_job0",
+						QueueName: "queue0",
+						Priority:  constants.PriorityTrainNumber,
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								State:         pod_status.Pending,
+								GPUCountRange: &pod_info.GpuCountRange{Min: 2, Max: 4},
+							},
+							{
+								State:         pod_status.Pending,
+								GPUCountRange: &pod_info.GpuCountRange{Min: 2, Max: 4},
+							},
+						},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {
+						GPUs: 6,
+					},
+				},
+				Queues: []test_utils.TestQueueBasic{
+					{
+						Name:         "queue0",
+						DeservedGPUs: 8,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheBinds: 2,
+					},
+				},
+				JobExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"pending_job0": {
+						NodeName:     "node0",
+						GPUsRequired: 6,
+						Status:       pod_status.Binding,
+					},
+				},
+			},
+		},
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name: "Allocate the GPUs allowed by the queue limit",
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:      "pending_job0",
+						QueueName: "queue0",
+						Priority:  constants.PriorityTrainNumber,
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								State:         pod_status.Pending,
+								GPUCountRange: &pod_info.GpuCountRange{Min: 4, Max: 8},
+							},
+						},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {
+						GPUs: 8,
+					},
+				},
+				Queues: []test_utils.TestQueueBasic{
+					{
+						Name:           "queue0",
+						DeservedGPUs:   4,
+						MaxAllowedGPUs: 5,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheBinds: 1,
+					},
+				},
+				JobExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"pending_job0": {
+						NodeName:     "node0",
+						GPUsRequired: 5,
+						Status:       pod_status.Binding,
+					},
+				},
+			},
+		},
+	}
+}
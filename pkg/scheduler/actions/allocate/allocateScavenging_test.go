@@ -0,0 +1,188 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package allocate_test
+
+import (
+	"testing"
+	"time"
+
+	. "go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/allocate"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestAllocateScavenging(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	for testNumber, testData := range []struct {
+		name                  string
+		priorityClassName     string
+		priority              int32
+		budgetAction          enginev2.QueueBudgetAction
+		workloadClass         enginev2alpha2.WorkloadClass
+		expectedQueue         common_info.QueueID
+		expectedScavengedFrom common_info.QueueID
+		expectedStatus        pod_status.PodStatus
+		expectedBinds         int
+	}{
+		{
+			name:                  "job over the queue limit overflows to the scavenger queue",
+			priorityClassName:     "train",
+			priority:              constants.PriorityTrainNumber,
+			expectedQueue:         "scavenger",
+			expectedScavengedFrom: "queue0",
+			expectedStatus:        pod_status.Binding,
+			expectedBinds:         2,
+		},
+		{
+			name:              "job of a priority class that doesn't scavenge stays pending",
+			priorityClassName: "other",
+			priority:          constants.PriorityTrainNumber,
+			expectedQueue:     "queue0",
+			expectedStatus:    pod_status.Pending,
+		},
+		{
+			name:              "non preemptible job stays pending",
+			priorityClassName: "train",
+			priority:          constants.PriorityBuildNumber,
+			expectedQueue:     "queue0",
+			expectedStatus:    pod_status.Pending,
+		},
+		{
+			name:                  "job of a queue that exhausted its budget is demoted to the scavenger queue",
+			priorityClassName:     "other",
+			priority:              constants.PriorityTrainNumber,
+			budgetAction:          enginev2.QueueBudgetActionDemote,
+			expectedQueue:         "scavenger",
+			expectedScavengedFrom: "queue0",
+			expectedStatus:        pod_status.Binding,
+			expectedBinds:         2,
+		},
+		{
+			name:              "job of a queue that exhausted its blocking budget stays pending",
+			priorityClassName: "train",
+			priority:          constants.PriorityTrainNumber,
+			budgetAction:      enginev2.QueueBudgetActionBlock,
+			expectedQueue:     "queue0",
+			expectedStatus:    pod_status.Pending,
+		},
+		{
+			name:              "non preemptible job of a queue that exhausted its budget stays pending",
+			priorityClassName: "train",
+			priority:          constants.PriorityBuildNumber,
+			budgetAction:      enginev2.QueueBudgetActionDemote,
+			expectedQueue:     "queue0",
+			expectedStatus:    pod_status.Pending,
+		},
+		{
+			name:                  "best-effort job is allocated under the scavenger queue",
+			priorityClassName:     "other",
+			priority:              constants.PriorityTrainNumber,
+			workloadClass:         enginev2alpha2.BestEffortGPU,
+			expectedQueue:         "scavenger",
+			expectedScavengedFrom: "queue0",
+			expectedStatus:        pod_status.Binding,
+			expectedBinds:         2,
+		},
+	} {
+		t.Logf("Running test %d: %s", testNumber, testData.name)
+
+		topology := test_utils.TestTopologyBasic{
+			Name: testData.name,
+			Jobs: []*jobs_fake.TestJobBasic{
+				{
+					Name:                "pending_job0",
+					RequiredGPUsPerTask: 1,
+					QueueName:           "queue0",
+					Priority:            testData.priority,
+					Tasks: []*tasks_fake.TestTaskBasic{
+						{State: pod_status.Pending},
+						{State: pod_status.Pending},
+					},
+				},
+			},
+			Nodes: map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 2},
+			},
+			Queues: []test_utils.TestQueueBasic{
+				{
+					Name:           "queue0",
+					ParentQueue:    "department-a",
+					DeservedGPUs:   1,
+					MaxAllowedGPUs: 1,
+				},
+				{
+					Name:        "scavenger",
+					ParentQueue: "department-a",
+				},
+			},
+			Departments: []test_utils.TestDepartmentBasic{
+				{
+					Name:         "department-a",
+					DeservedGPUs: 2,
+				},
+			},
+			Mocks: &test_utils.TestMock{
+				CacheRequirements: &test_utils.CacheMocking{
+					NumberOfCacheBinds: testData.expectedBinds,
+				},
+			},
+		}
+
+		if testData.budgetAction != "" {
+			now := time.Now()
+			budget := &enginev2.QueueBudget{
+				GPUHours:        10,
+				Period:          enginev2.QueueBudgetPeriodWeekly,
+				ExhaustedAction: testData.budgetAction,
+			}
+			topology.Clock = clocktesting.NewFakeClock(now)
+			topology.Queues[0].Budget = budget
+			topology.Queues[0].BudgetStatus = &enginev2.QueueBudgetStatus{
+				PeriodStart:      metav1.NewTime(budget.GetPeriodStart(now)),
+				ConsumedGPUHours: 10,
+				LastMeteringTime: metav1.NewTime(now),
+			}
+		}
+
+		ssn := test_utils.BuildSession(topology, controller)
+		ssn.Config.Scavenging = &conf.Scavenging{
+			Queue:           "scavenger",
+			PriorityClasses: []string{"train"},
+		}
+		job := ssn.ClusterInfo.PodGroupInfos["pending_job0"]
+		job.PodGroup.Spec.PriorityClassName = testData.priorityClassName
+		job.WorkloadClass = testData.workloadClass
+
+		allocate.New().Execute(ssn)
+
+		if job.Queue != testData.expectedQueue {
+			t.Errorf("test %d: expected queue %s, got %s", testNumber, testData.expectedQueue, job.Queue)
+		}
+		if job.ScavengedFrom != testData.expectedScavengedFrom {
+			t.Errorf("test %d: expected job scavenged from %s, got %s",
+				testNumber, testData.expectedScavengedFrom, job.ScavengedFrom)
+		}
+		for _, task := range job.GetAllPodsMap() {
+			if task.Status != testData.expectedStatus {
+				t.Errorf("test %d: expected task %s status %s, got %s",
+					testNumber, task.Name, testData.expectedStatus, task.Status)
+			}
+		}
+	}
+}
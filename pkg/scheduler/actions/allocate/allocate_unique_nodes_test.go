@@ -0,0 +1,104 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package allocate_test
+
+import (
+	"testing"
+
+	. "go.uber.org/mock/gomock"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/allocate"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info/subgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestUniqueNodesAllocation(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	tests := []struct {
+		name          string
+		nodes         map[string]nodes_fake.TestNodeBasic
+		expectedBinds int
+	}{
+		{
+			name: "pods of a unique nodes job are bound to different nodes",
+			nodes: map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 3},
+				"node1": {GPUs: 3},
+				"node2": {GPUs: 3},
+			},
+			expectedBinds: 3,
+		},
+		{
+			name: "unique nodes job stays pending when there are fewer nodes than pods",
+			nodes: map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 3},
+				"node1": {GPUs: 3},
+			},
+		},
+	}
+	for testNumber, tt := range tests {
+		t.Logf("Running test %d: %s", testNumber, tt.name)
+
+		root := subgroup_info.NewSubGroupSet(subgroup_info.RootSubGroupSetName, nil)
+		root.SetUniqueNodes(true)
+		root.AddPodSet(subgroup_info.NewPodSet("replicas", 3, nil))
+		topology := test_utils.TestTopologyBasic{
+			Name: tt.name,
+			Jobs: []*jobs_fake.TestJobBasic{
+				{
+					Name:                "pending_job0",
+					RequiredGPUsPerTask: 1,
+					QueueName:           "queue0",
+					Priority:            constants.PriorityTrainNumber,
+					RootSubGroupSet:     root,
+					Tasks: []*tasks_fake.TestTaskBasic{
+						{State: pod_status.Pending, SubGroupName: "replicas"},
+						{State: pod_status.Pending, SubGroupName: "replicas"},
+						{State: pod_status.Pending, SubGroupName: "replicas"},
+					},
+				},
+			},
+			Nodes: tt.nodes,
+			Queues: []test_utils.TestQueueBasic{
+				{
+					Name:         "queue0",
+					DeservedGPUs: 3,
+				},
+			},
+			Mocks: &test_utils.TestMock{
+				CacheRequirements: &test_utils.CacheMocking{
+					NumberOfCacheBinds: tt.expectedBinds,
+				},
+			},
+		}
+
+		ssn := test_utils.BuildSession(topology, controller)
+		allocate.New().Execute(ssn)
+
+		usedNodes := map[string]bool{}
+		binds := 0
+		for _, task := range ssn.ClusterInfo.PodGroupInfos[common_info.PodGroupID("pending_job0")].GetAllPodsMap() {
+			if task.Status != pod_status.Binding {
+				continue
+			}
+			binds++
+			if usedNodes[task.NodeName] {
+				t.Errorf("Test %d: %s, more than one pod was bound to node %s", testNumber, tt.name, task.NodeName)
+			}
+			usedNodes[task.NodeName] = true
+		}
+		if binds != tt.expectedBinds {
+			t.Errorf("Test %d: %s, expected %d bound pods, got %d", testNumber, tt.name, tt.expectedBinds, binds)
+		}
+	}
+}
@@ -125,6 +125,30 @@ func allocateTask(ssn *framework.Session, stmt *framework.Statement, nodes []*no
 		log.InfraLogger.Errorf("Failed to find job <%s> in session <%s>", task.Job, ssn.ID)
 		return false
 	}
+	if task.GpuCountRange != nil {
+		return allocateGpuCountRangeTask(ssn, stmt, nodes, job, task, isPipelineOnly)
+	}
+	return allocateTaskRequest(ssn, stmt, nodes, job, task, isPipelineOnly)
+}
+
+// allocateGpuCountRangeTask allocates a task requesting a range of GPUs with the largest number of GPUs in the range
+// that can be allocated
+func allocateGpuCountRangeTask(ssn *framework.Session, stmt *framework.Statement, nodes []*node_info.NodeInfo,
+	job *podgroup_info.PodGroupInfo, task *pod_info.PodInfo, isPipelineOnly bool) bool {
+	for count := task.GpuCountRange.Max; count >= task.GpuCountRange.Min; count-- {
+		task.SetGpuCount(count)
+		if allocateTaskRequest(ssn, stmt, nodes, job, task, isPipelineOnly) {
+			log.InfraLogger.V(6).Infof("Allocated %d GPUs to task <%v/%v> requesting %d-%d GPUs",
+				count, task.Namespace, task.Name, task.GpuCountRange.Min, task.GpuCountRange.Max)
+			return true
+		}
+	}
+	task.SetGpuCount(task.GpuCountRange.Max)
+	return false
+}
+
+func allocateTaskRequest(ssn *framework.Session, stmt *framework.Statement, nodes []*node_info.NodeInfo,
+	job *podgroup_info.PodGroupInfo, task *pod_info.PodInfo, isPipelineOnly bool) (success bool) {
 	err := ssn.PrePredicateFn(task, job)
 	if err != nil {
 		log.InfraLogger.V(6).Infof("pre-predicates failed on task %s/%s. Error: %v",
@@ -170,9 +194,30 @@ func allocateTaskToNode(ssn *framework.Session, stmt *framework.Statement, task
 	if taskAllocatable := node.IsTaskAllocatable(task); !isPipelineOnly && taskAllocatable {
 		return bindTaskToNode(ssn, stmt, task, node)
 	}
+	if !isPipelineOnly && !isReleasedWithinHorizon(ssn, task, node) {
+		return false
+	}
 	return pipelineTaskToNode(ssn, stmt, task, node, !isPipelineOnly)
 }
 
+// isReleasedWithinHorizon returns whether the resources of the terminating pods that the task would be pipelined on are
+// expected to be released within the releasing horizon of the session. A task pipelined on the resources of pods with
+// long grace periods would hold them, and the quota of its queue, until the pods end.
+func isReleasedWithinHorizon(ssn *framework.Session, task *pod_info.PodInfo, node *node_info.NodeInfo) bool {
+	horizon := ssn.ReleasingHorizon()
+	if horizon <= 0 {
+		return true
+	}
+	now := ssn.Clock().Now()
+	if node.IsTaskAllocatableOnIdleOrReleasedBy(task, now, now.Add(horizon)) {
+		return true
+	}
+	log.InfraLogger.V(6).Infof("Not pipelining task <%v/%v> to node <%v>, its terminating pods are expected to "+
+		"release <%v> after the releasing horizon of %v", task.Namespace, task.Name, node.Name,
+		node.ReleasedAfter(now, now.Add(horizon)), horizon)
+	return false
+}
+
 func bindTaskToNode(ssn *framework.Session, stmt *framework.Statement, task *pod_info.PodInfo, node *node_info.NodeInfo) bool {
 	log.InfraLogger.V(6).Infof("Binding Task <%v/%v> to node <%v>, requires: %v GPUs",
 		task.Namespace, task.Name, node.Name, task.ResReq)
@@ -210,27 +255,26 @@ func handleFailedTaskAllocation(job *podgroup_info.PodGroupInfo, unschedulableTa
 	}
 	taskSubGroup := job.GetSubGroups()[taskSubGroupName]
 
+	addJobFitError := func(message string) {
+		job.AddJobFitError(common_info.NewJobFitErrorWithNodeContext(job.Name, podgroup_info.DefaultSubGroup,
+			job.Namespace, podgroup_info.PodSchedulingErrors, message, allocationError.NodeFilterDetails()))
+	}
+
 	if !gangScheduling || taskSubGroup.GetNumActiveUsedTasks() >= int(taskSubGroup.GetMinAvailable()) {
-		job.AddSimpleJobFitError(
-			podgroup_info.PodSchedulingErrors,
-			fmt.Sprintf("Resources were not found for pod %s/%s due to: %s",
-				unschedulableTask.Namespace, unschedulableTask.Name, allocationError.Error()))
+		addJobFitError(fmt.Sprintf("Resources were not found for pod %s/%s due to: %s",
+			unschedulableTask.Namespace, unschedulableTask.Name, allocationError.Error()))
 		return
 	}
 
 	if len(job.GetSubGroups()) == 1 && taskSubGroup.GetName() == podgroup_info.DefaultSubGroup {
-		job.AddSimpleJobFitError(
-			podgroup_info.PodSchedulingErrors,
-			fmt.Sprintf("Resources were found for %d pods while %d are required for gang scheduling. "+
-				"Additional pods cannot be scheduled due to: %s",
-				numSchedulableTasks, taskSubGroup.GetMinAvailable(), allocationError.Error()))
+		addJobFitError(fmt.Sprintf("Resources were found for %d pods while %d are required for gang scheduling. "+
+			"Additional pods cannot be scheduled due to: %s",
+			numSchedulableTasks, taskSubGroup.GetMinAvailable(), allocationError.Error()))
 		return
 	}
-	job.AddSimpleJobFitError(
-		podgroup_info.PodSchedulingErrors,
-		fmt.Sprintf("Resources were found for %d pods from all sub-groups while sub-group %s requires %d pods for gang scheduling. "+
-			"Additional pods cannot be scheduled in this sub-group due to: %s",
-			numSchedulableTasks, taskSubGroup.GetName(), taskSubGroup.GetMinAvailable(), allocationError.Error()))
+	addJobFitError(fmt.Sprintf("Resources were found for %d pods from all sub-groups while sub-group %s requires %d pods for gang scheduling. "+
+		"Additional pods cannot be scheduled in this sub-group due to: %s",
+		numSchedulableTasks, taskSubGroup.GetName(), taskSubGroup.GetMinAvailable(), allocationError.Error()))
 }
 
 func isGangScheduling(job *podgroup_info.PodGroupInfo) bool {
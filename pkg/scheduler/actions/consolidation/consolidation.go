@@ -139,6 +139,10 @@ func buildPreemptibleFilterFunc(preemptor *podgroup_info.PodGroupInfo, maxPreemp
 			return false
 		}
 
+		if job.DoNotConsolidate {
+			return false
+		}
+
 		if preemptor.UID == job.UID {
 			return false
 		}
@@ -0,0 +1,156 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package consolidation_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "go.uber.org/mock/gomock"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/consolidation"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/integration_tests/integration_tests_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestConsolidationOptOut(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+	testsMetadata := getConsolidationOptOutTestsMetadata()
+
+	for testNumber, testMetadata := range testsMetadata {
+		fmt.Printf("Running test %d/%d: %s\n", testNumber, len(testsMetadata), testMetadata.TestTopologyBasic.Name)
+		ssn := test_utils.BuildSession(testMetadata.TestTopologyBasic, controller)
+		consolidationAction := consolidation.New()
+		consolidationAction.Execute(ssn)
+		test_utils.MatchExpectedAndRealTasks(t, testNumber, testMetadata.TestTopologyBasic, ssn)
+	}
+}
+
+func getConsolidationOptOutTestsMetadata() []integration_tests_utils.TestTopologyMetadata {
+	newJobs := func(doNotConsolidateJob0, doNotConsolidateJob1 bool) []*jobs_fake.TestJobBasic {
+		return []*jobs_fake.TestJobBasic{
+			{
+				Name:                "running_job0",
+				RequiredGPUsPerTask: 2,
+				Priority:            constants.PriorityTrainNumber,
+				QueueName:           "queue0",
+				DoNotConsolidate:    doNotConsolidateJob0,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						NodeName: "node0",
+						State:    pod_status.Running,
+					},
+				},
+			},
+			{
+				Name:                "running_job1",
+				RequiredGPUsPerTask: 2,
+				Priority:            constants.PriorityTrainNumber,
+				QueueName:           "queue0",
+				DoNotConsolidate:    doNotConsolidateJob1,
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						NodeName: "node1",
+						State:    pod_status.Running,
+					},
+				},
+			},
+			{
+				Name:                "pending_job0",
+				RequiredGPUsPerTask: 4,
+				Priority:            constants.PriorityTrainNumber,
+				QueueName:           "queue0",
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						State: pod_status.Pending,
+					},
+				},
+			},
+		}
+	}
+	nodes := map[string]nodes_fake.TestNodeBasic{
+		"node0": {
+			GPUs: 4,
+		},
+		"node1": {
+			GPUs: 4,
+		},
+	}
+	queues := []test_utils.TestQueueBasic{
+		{
+			Name:         "queue0",
+			DeservedGPUs: 2,
+		},
+	}
+
+	return []integration_tests_utils.TestTopologyMetadata{
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name:   "job that opted out of consolidation is not moved",
+				Jobs:   newJobs(true, false),
+				Nodes:  nodes,
+				Queues: queues,
+				JobExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"running_job0": {
+						NodeName:     "node0",
+						GPUsRequired: 2,
+						Status:       pod_status.Running,
+					},
+					"running_job1": {
+						NodeName:     "node0",
+						GPUsRequired: 2,
+						Status:       pod_status.Pipelined,
+					},
+					"pending_job0": {
+						NodeName:     "node1",
+						GPUsRequired: 4,
+						Status:       pod_status.Pipelined,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheEvictions:  1,
+						NumberOfPipelineActions: 2,
+					},
+				},
+			},
+		},
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name:   "no consolidation when all the running jobs opted out",
+				Jobs:   newJobs(true, true),
+				Nodes:  nodes,
+				Queues: queues,
+				JobExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"running_job0": {
+						NodeName:     "node0",
+						GPUsRequired: 2,
+						Status:       pod_status.Running,
+					},
+					"running_job1": {
+						NodeName:     "node1",
+						GPUsRequired: 2,
+						Status:       pod_status.Running,
+					},
+					"pending_job0": {
+						GPUsRequired: 4,
+						Status:       pod_status.Pending,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheEvictions: 0,
+					},
+				},
+			},
+		},
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/integration_tests/integration_tests_utils"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
@@ -784,5 +785,83 @@ func getTopologyAllocateTestsMetadata() []integration_tests_utils.TestTopologyMe
 			},
 			RoundsUntilMatch: 1,
 		},
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name: "Heterogeneous GPU counts - gang fits a rack by the sum of its pod requests",
+				Topologies: []*kaiv1alpha1.Topology{
+					{
+						ObjectMeta: v1.ObjectMeta{
+							Name: "rack-topology",
+						},
+						Spec: kaiv1alpha1.TopologySpec{
+							Levels: []kaiv1alpha1.TopologyLevel{
+								{
+									NodeLabel: "topology.kubernetes.io/rack",
+								},
+							},
+						},
+					},
+				},
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:      "pending_job0",
+						Priority:  constants.PriorityTrainNumber,
+						QueueName: "queue0",
+						RootSubGroupSet: subgroup_info.NewSubGroupSet(subgroup_info.RootSubGroupSetName,
+							&topology_info.TopologyConstraintInfo{
+								Topology:      "rack-topology",
+								RequiredLevel: "topology.kubernetes.io/rack",
+							},
+						),
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								State:        pod_status.Pending,
+								RequiredGPUs: ptr.To(int64(8)),
+							},
+							{
+								State:        pod_status.Pending,
+								RequiredGPUs: ptr.To(int64(4)),
+							},
+							{
+								State:        pod_status.Pending,
+								RequiredGPUs: ptr.To(int64(4)),
+							},
+						},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {
+						GPUs: 8,
+						Labels: map[string]string{
+							"topology.kubernetes.io/rack": "rack1",
+						},
+					},
+					"node1": {
+						GPUs: 8,
+						Labels: map[string]string{
+							"topology.kubernetes.io/rack": "rack1",
+						},
+					},
+				},
+				Queues: []test_utils.TestQueueBasic{
+					{
+						Name:         "queue0",
+						DeservedGPUs: 16,
+					},
+				},
+				JobExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"pending_job0": {
+						GPUsRequired: 16,
+						Status:       pod_status.Running,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheBinds: 3,
+					},
+				},
+			},
+			RoundsUntilMatch: 1,
+		},
 	}
 }
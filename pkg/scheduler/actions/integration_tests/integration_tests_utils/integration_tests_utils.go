@@ -4,7 +4,6 @@
 package integration_tests_utils
 
 import (
-	"fmt"
 	"testing"
 	"time"
 
@@ -16,9 +15,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/reclaim"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/stalegangeviction"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
-	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
-	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
 )
 
@@ -50,12 +47,12 @@ func RunTests(t *testing.T, testsMetadata []TestTopologyMetadata) {
 
 func RunTest(t *testing.T, testMetadata TestTopologyMetadata, testNumber int, controller *Controller) {
 	t.Logf("Running test number: %v, test name: %v", testNumber, testMetadata.TestTopologyBasic.Name)
-	var ssn *framework.Session
+	runner := test_utils.NewCycleRunner(&testMetadata.TestTopologyBasic, schedulerActions, controller)
 
-	runRoundsUntilMatch(testMetadata, controller, &ssn)
+	ssn := runRoundsUntilMatch(testMetadata, runner)
 	ssn = prepareSessionForMatch(ssn, testMetadata, controller)
 	test_utils.MatchExpectedAndRealTasks(t, testNumber, testMetadata.TestTopologyBasic, ssn)
-	runRoundsAfterAndMatch(t, testMetadata, controller, ssn, testNumber)
+	runRoundsAfterAndMatch(t, testMetadata, runner, testNumber)
 }
 
 // prepare session for match by rebuilding the session while preserving the podgroup errors
@@ -71,83 +68,30 @@ func prepareSessionForMatch(ssn *framework.Session, testMetadata TestTopologyMet
 	return ssn
 }
 
-func runRoundsAfterAndMatch(t *testing.T, testMetadata TestTopologyMetadata, controller *Controller, ssn *framework.Session, testNumber int) {
+func runRoundsAfterAndMatch(t *testing.T, testMetadata TestTopologyMetadata, runner *test_utils.CycleRunner, testNumber int) {
 	roundsAfterMatch := defaultRoundsAfterMatch
 	if testMetadata.RoundsAfterMatch != 0 {
 		roundsAfterMatch = testMetadata.RoundsAfterMatch
 	}
 	for i := 0; i < roundsAfterMatch; i++ {
-		runSchedulerOneRound(&testMetadata, controller, &ssn)
+		ssn := runner.RunCycle()
 		test_utils.MatchExpectedAndRealTasks(t, testNumber, testMetadata.TestTopologyBasic, ssn)
 	}
 }
 
-func runRoundsUntilMatch(testMetadata TestTopologyMetadata, controller *Controller, ssn **framework.Session) {
+func runRoundsUntilMatch(testMetadata TestTopologyMetadata, runner *test_utils.CycleRunner) *framework.Session {
 	roundsUntilMatch := defaultRoundsUntilMatch
 	if testMetadata.RoundsUntilMatch != 0 {
 		roundsUntilMatch = testMetadata.RoundsUntilMatch
 	}
+	var ssn *framework.Session
 	for i := 0; i < roundsUntilMatch; i++ {
-		runSchedulerOneRound(&testMetadata, controller, ssn)
+		ssn = runner.RunCycle()
+		// The real sleep lets the informers behind the DRA manager sync between the rounds
 		time.Sleep(testMetadata.SchedulingDuration)
+		runner.Step(testMetadata.SchedulingDuration)
 	}
-}
-
-func runSchedulerOneRound(testMetadata *TestTopologyMetadata, controller *Controller, ssn **framework.Session) {
-	*ssn = test_utils.BuildSession(testMetadata.TestTopologyBasic, controller)
-	for _, action := range schedulerActions {
-		log.InfraLogger.SetAction(string(action.Name()))
-		action.Execute(*ssn)
-	}
-
-	for _, jobMetadata := range testMetadata.Jobs {
-		jobId := common_info.PodGroupID(jobMetadata.Name)
-		job := (*ssn).ClusterInfo.PodGroupInfos[jobId]
-		for taskId, taskMetadata := range jobMetadata.Tasks {
-			task := job.GetAllPodsMap()[common_info.PodID(fmt.Sprintf("%s-%d", jobId, taskId))]
-			switch task.Status {
-			case pod_status.Releasing:
-				if jobMetadata.DeleteJobInTest {
-					taskMetadata.NodeName = task.NodeName
-					taskMetadata.GPUGroups = task.GPUGroups
-					taskMetadata.State = pod_status.Releasing
-				} else {
-					taskMetadata.NodeName = ""
-					taskMetadata.State = pod_status.Pending
-				}
-
-			case pod_status.Pipelined:
-				taskMetadata.NodeName = ""
-				taskMetadata.State = pod_status.Pending
-
-			case pod_status.Binding:
-				taskMetadata.State = pod_status.Running
-				taskMetadata.NodeName = task.NodeName
-				taskMetadata.GPUGroups = task.GPUGroups
-
-			default:
-				taskMetadata.State = task.Status
-				taskMetadata.NodeName = task.NodeName
-				taskMetadata.GPUGroups = task.GPUGroups
-			}
-
-		}
-	}
-	if len(testMetadata.TestDRAObjects.ResourceClaims) > 0 {
-		draManager := (*ssn).InternalK8sPlugins().FrameworkHandle.SharedDRAManager()
-		for _, claim := range testMetadata.TestDRAObjects.ResourceClaims {
-			clusterClaim, err := draManager.ResourceClaims().Get(claim.Namespace, claim.Name)
-			if err != nil {
-				log.InfraLogger.Errorf("Failed to get resource claim %s: %v", claim.Name, err)
-				continue
-			}
-			clusterClaimStatus := clusterClaim.Status
-			if clusterClaimStatus.Allocation != nil || clusterClaimStatus.ReservedFor != nil || clusterClaimStatus.Devices != nil {
-				claim.ClaimStatus = clusterClaimStatus.DeepCopy()
-			}
-		}
-	}
-
+	return ssn
 }
 
 func SetSchedulerActions() {
@@ -58,9 +58,18 @@ func (alloc *preemptAction) Execute(ssn *framework.Session) {
 		jobsOrderByQueues.Len(), ssn.CountLeafQueues())
 
 	smallestFailedJobsByQueue := map[common_info.QueueID]*common.MinimalJobRepresentatives{}
+	evictionBudget := utils.NewEvictionBudget(
+		ssn.Config.EvictionBudgets[string(framework.Preempt)], ssn.ClusterInfo.PodGroupInfos, ssn.Clock().Now())
+	preemptionLimits := utils.NewPreemptionLimits(ssn.PreemptionHistory(), ssn.ClusterInfo.Queues, ssn.Clock().Now(),
+		ssn.IsShadow())
 
 	for !jobsOrderByQueues.IsEmpty() {
 		job := jobsOrderByQueues.PopNextJob()
+		if queue := preemptionLimits.ReachedLimit(job); queue != nil {
+			log.InfraLogger.V(3).Infof("Skipping preemption for job <%s/%s> - queue <%s> reached its preemption limit",
+				job.Namespace, job.Name, queue.Name)
+			continue
+		}
 
 		smallestFailedJobs, found := smallestFailedJobsByQueue[job.Queue]
 		if !found {
@@ -79,14 +88,33 @@ func (alloc *preemptAction) Execute(ssn *framework.Session) {
 
 		metrics.IncPodgroupsConsideredByAction()
 		succeeded, statement, preemptedTasksNames := attemptToPreemptForPreemptor(ssn, job)
+		if succeeded {
+			if queue := preemptionLimits.ExceededLimit(job, statement.EvictedTasks()); queue != nil {
+				log.InfraLogger.V(3).Infof(
+					"Preempting tasks <%v> for job <%s/%s> would exceed the preemption limit of queue <%s>",
+					preemptedTasksNames, job.Namespace, job.Name, queue.Name)
+				statement.Discard()
+				continue
+			}
+		}
+		if succeeded && !evictionBudget.TrySpend(statement.EvictedTasks()) {
+			log.InfraLogger.V(3).Infof(
+				"Preempting tasks <%v> for job <%s/%s> would exceed the eviction budget of the cycle",
+				preemptedTasksNames, job.Namespace, job.Name)
+			statement.Discard()
+			continue
+		}
 		if succeeded {
 			metrics.RegisterPreemptionAttempts()
 			metrics.IncPodgroupScheduledByAction()
 			log.InfraLogger.V(3).Infof(
 				"Successfully preempted for job <%s/%s>, preempted tasks: <%v>",
 				job.Namespace, job.Name, preemptedTasksNames)
+			victims := statement.EvictedTasks()
 			if err := statement.Commit(); err != nil {
 				log.InfraLogger.Errorf("Failed to commit preemption statement: %v", err)
+			} else {
+				preemptionLimits.Record(job, victims)
 			}
 		} else {
 			log.InfraLogger.V(3).Infof("Didn't find a preemption strategy for job <%s/%s>",
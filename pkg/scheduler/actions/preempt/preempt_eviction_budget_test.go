@@ -0,0 +1,88 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package preempt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	. "go.uber.org/mock/gomock"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/preempt"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestPreemptEvictionBudget(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	tests := []struct {
+		name              string
+		budget            conf.EvictionBudget
+		expectedReleasing int
+	}{
+		{name: "no budget", budget: conf.EvictionBudget{}, expectedReleasing: 2},
+		{name: "max pods", budget: conf.EvictionBudget{MaxPods: 1}, expectedReleasing: 1},
+		{name: "max pods per queue", budget: conf.EvictionBudget{MaxPodsPerQueue: 1}, expectedReleasing: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssn := test_utils.BuildSession(getEvictionBudgetTopology(), controller)
+			ssn.Config.EvictionBudgets = map[string]conf.EvictionBudget{string(framework.Preempt): tt.budget}
+			preempt.New().Execute(ssn)
+
+			releasing := 0
+			for _, job := range ssn.ClusterInfo.PodGroupInfos {
+				for _, task := range job.GetAllPodsMap() {
+					if task.Status == pod_status.Releasing {
+						releasing++
+					}
+				}
+			}
+			assert.Equal(t, tt.expectedReleasing, releasing)
+		})
+	}
+}
+
+func getEvictionBudgetTopology() test_utils.TestTopologyBasic {
+	var jobs []*jobs_fake.TestJobBasic
+	for _, name := range []string{"running_job0", "running_job1"} {
+		jobs = append(jobs, &jobs_fake.TestJobBasic{
+			Name:                name,
+			RequiredGPUsPerTask: 1,
+			Priority:            constants.PriorityTrainNumber,
+			QueueName:           "queue0",
+			Tasks:               []*tasks_fake.TestTaskBasic{{NodeName: "node0", State: pod_status.Running}},
+		})
+	}
+	for _, name := range []string{"pending_job0", "pending_job1"} {
+		jobs = append(jobs, &jobs_fake.TestJobBasic{
+			Name:                name,
+			RequiredGPUsPerTask: 1,
+			Priority:            constants.PriorityBuildNumber,
+			QueueName:           "queue0",
+			Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+		})
+	}
+	return test_utils.TestTopologyBasic{
+		Name:   "2 train jobs running, 2 build jobs pending for the same queue",
+		Jobs:   jobs,
+		Nodes:  map[string]nodes_fake.TestNodeBasic{"node0": {GPUs: 2}},
+		Queues: []test_utils.TestQueueBasic{{Name: "queue0", DeservedGPUs: 2}},
+		Mocks: &test_utils.TestMock{
+			CacheRequirements: &test_utils.CacheMocking{
+				NumberOfCacheEvictions:  2,
+				NumberOfPipelineActions: 2,
+			},
+		},
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package preempt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	. "go.uber.org/mock/gomock"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/preempt"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/poddisruptionbudget"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestPreemptPodDisruptionBudgets(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	tests := []struct {
+		name               string
+		mode               string
+		protectedJobs      []string
+		disruptionsAllowed int32
+		pendingGPUs        float64
+		expectedEvictions  int
+		expectedSpared     string
+	}{
+		{
+			name:               "strict mode evicts the unprotected job",
+			mode:               "strict",
+			protectedJobs:      []string{"running_job0"},
+			disruptionsAllowed: 0,
+			pendingGPUs:        1,
+			expectedEvictions:  1,
+			expectedSpared:     "running_job0",
+		},
+		{
+			name:               "best effort mode evicts the unprotected job first",
+			mode:               "bestEffort",
+			protectedJobs:      []string{"running_job1"},
+			disruptionsAllowed: 0,
+			pendingGPUs:        1,
+			expectedEvictions:  1,
+			expectedSpared:     "running_job1",
+		},
+		{
+			name:               "strict mode doesn't evict protected jobs",
+			mode:               "strict",
+			protectedJobs:      []string{"running_job0", "running_job1"},
+			disruptionsAllowed: 0,
+			pendingGPUs:        1,
+			expectedEvictions:  0,
+		},
+		{
+			name:               "best effort mode evicts protected jobs when there is no other victim",
+			mode:               "bestEffort",
+			protectedJobs:      []string{"running_job0", "running_job1"},
+			disruptionsAllowed: 0,
+			pendingGPUs:        1,
+			expectedEvictions:  1,
+		},
+		{
+			name:               "strict mode doesn't evict more pods than the budget allows",
+			mode:               "strict",
+			protectedJobs:      []string{"running_job0", "running_job1"},
+			disruptionsAllowed: 1,
+			pendingGPUs:        2,
+			expectedEvictions:  0,
+		},
+		{
+			name:               "strict mode evicts pods the budget allows",
+			mode:               "strict",
+			protectedJobs:      []string{"running_job0", "running_job1"},
+			disruptionsAllowed: 1,
+			pendingGPUs:        1,
+			expectedEvictions:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssn := test_utils.BuildSession(
+				getPodDisruptionBudgetTopology(tt.protectedJobs, tt.disruptionsAllowed, tt.pendingGPUs), controller)
+			poddisruptionbudget.New(framework.PluginArguments{"mode": tt.mode}).OnSessionOpen(ssn)
+			preempt.New().Execute(ssn)
+
+			var releasing []string
+			for _, job := range ssn.ClusterInfo.PodGroupInfos {
+				for _, task := range job.GetAllPodsMap() {
+					if task.Status == pod_status.Releasing {
+						releasing = append(releasing, job.Name)
+					}
+				}
+			}
+			assert.Len(t, releasing, tt.expectedEvictions)
+			if tt.expectedSpared != "" {
+				assert.NotContains(t, releasing, tt.expectedSpared)
+			}
+		})
+	}
+}
+
+func getPodDisruptionBudgetTopology(
+	protectedJobs []string, disruptionsAllowed int32, pendingGPUs float64,
+) test_utils.TestTopologyBasic {
+	var jobs []*jobs_fake.TestJobBasic
+	for _, name := range []string{"running_job0", "running_job1"} {
+		task := &tasks_fake.TestTaskBasic{NodeName: "node0", State: pod_status.Running}
+		for _, protectedJob := range protectedJobs {
+			if protectedJob == name {
+				task.Labels = map[string]string{"app": "protected"}
+			}
+		}
+		jobs = append(jobs, &jobs_fake.TestJobBasic{
+			Name:                name,
+			Namespace:           "team-a",
+			RequiredGPUsPerTask: 1,
+			Priority:            constants.PriorityTrainNumber,
+			QueueName:           "queue0",
+			Tasks:               []*tasks_fake.TestTaskBasic{task},
+		})
+	}
+	jobs = append(jobs, &jobs_fake.TestJobBasic{
+		Name:                "pending_job0",
+		Namespace:           "team-a",
+		RequiredGPUsPerTask: pendingGPUs,
+		Priority:            constants.PriorityBuildNumber,
+		QueueName:           "queue0",
+		Tasks:               []*tasks_fake.TestTaskBasic{{State: pod_status.Pending}},
+	})
+	return test_utils.TestTopologyBasic{
+		Name:   "2 train jobs running, 1 build job pending for the same queue",
+		Jobs:   jobs,
+		Nodes:  map[string]nodes_fake.TestNodeBasic{"node0": {GPUs: 2}},
+		Queues: []test_utils.TestQueueBasic{{Name: "queue0", DeservedGPUs: 2}},
+		PodDisruptionBudgets: []*policyv1.PodDisruptionBudget{{
+			ObjectMeta: metav1.ObjectMeta{Name: "protected", Namespace: "team-a"},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "protected"}},
+			},
+			Status: policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: disruptionsAllowed},
+		}},
+		Mocks: &test_utils.TestMock{
+			CacheRequirements: &test_utils.CacheMocking{
+				NumberOfCacheEvictions:  2,
+				NumberOfPipelineActions: 2,
+			},
+		},
+	}
+}
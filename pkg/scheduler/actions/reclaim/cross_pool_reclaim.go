@@ -0,0 +1,117 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package reclaim
+
+import (
+	"maps"
+	"slices"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// requestCrossPoolReclaim asks the schedulers of the other node pools that the queues of the unreclaimed jobs are
+// entitled to for their reclaim costs. Jobs whose costs were published move to the node pool with the cheapest reclaim.
+func requestCrossPoolReclaim(ssn *framework.Session, unreclaimedJobs []*podgroup_info.PodGroupInfo) {
+	if ssn.SchedulerParams.PartitionParams == nil || ssn.SchedulerParams.PartitionParams.NodePoolLabelKey == "" {
+		return
+	}
+
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		if job.CrossPoolReclaim.Requested &&
+			(job.GetNumPendingTasks() == 0 || len(getOtherNodePools(ssn, job)) == 0) {
+			job.CrossPoolReclaim.Requested = false
+		}
+	}
+
+	for _, job := range unreclaimedJobs {
+		nodePools := getOtherNodePools(ssn, job)
+		if len(nodePools) == 0 {
+			continue
+		}
+		if !job.CrossPoolReclaim.Requested {
+			log.InfraLogger.V(3).Infof("Requesting the reclaim costs of node pools <%v> for job <%s/%s>",
+				nodePools, job.Namespace, job.Name)
+			job.CrossPoolReclaim.Requested = true
+			continue
+		}
+		nodePool, cost, found := job.CrossPoolReclaim.CheapestNodePool(nodePools)
+		if !found {
+			continue
+		}
+		log.InfraLogger.V(3).Infof("Moving job <%s/%s> to node pool <%s>, where reclaim evicts <%d> pods",
+			job.Namespace, job.Name, nodePool, cost)
+		job.CrossPoolReclaim.TargetNodePool = nodePool
+	}
+}
+
+// getOtherNodePools returns the node pools other than the session's that the queue of the job is entitled to
+func getOtherNodePools(ssn *framework.Session, job *podgroup_info.PodGroupInfo) []string {
+	queue, found := ssn.ClusterInfo.Queues[job.Queue]
+	if !found {
+		return nil
+	}
+	var nodePools []string
+	for _, nodePool := range queue.NodePools {
+		if nodePool != ssn.NodePoolName() {
+			nodePools = append(nodePools, nodePool)
+		}
+	}
+	return nodePools
+}
+
+// publishCrossPoolReclaimCosts simulates reclaim for the jobs of other node pools that ask for the reclaim cost of the
+// session's node pool, and publishes the number of pods that it evicts. The reclaim is discarded: the job reclaims
+// only once it moves to this node pool.
+func (ra *reclaimAction) publishCrossPoolReclaimCosts(ssn *framework.Session, preemptionLimits *utils.PreemptionLimits) {
+	nodePool := ssn.NodePoolName()
+	if nodePool == "" {
+		return
+	}
+
+	for _, jobID := range slices.Sorted(maps.Keys(ssn.ClusterInfo.CrossPoolReclaimJobs)) {
+		job := ssn.ClusterInfo.CrossPoolReclaimJobs[jobID]
+		if _, found := ssn.ClusterInfo.PodGroupInfos[jobID]; found {
+			log.InfraLogger.V(3).Infof("Skipping cross pool reclaim for job <%s/%s> - a job of the same name is "+
+				"scheduled in node pool <%s>", job.Namespace, job.Name, nodePool)
+			continue
+		}
+
+		cost := ra.simulateCrossPoolReclaim(ssn, job, preemptionLimits)
+		publishedCost, published := job.CrossPoolReclaim.Costs[nodePool]
+		if (cost == nil && !published) || (cost != nil && published && *cost == publishedCost) {
+			continue
+		}
+		ssn.Cache.UpdateCrossPoolReclaimCost(job, nodePool, cost)
+	}
+}
+
+// simulateCrossPoolReclaim returns the number of pods that reclaim evicts for the job in the session, or nil if the
+// job can't reclaim in it
+func (ra *reclaimAction) simulateCrossPoolReclaim(
+	ssn *framework.Session, job *podgroup_info.PodGroupInfo, preemptionLimits *utils.PreemptionLimits,
+) *int {
+	ssn.ClusterInfo.PodGroupInfos[job.UID] = job
+	defer delete(ssn.ClusterInfo.PodGroupInfos, job.UID)
+
+	if !job.IsReadyForScheduling() || !ssn.CanReclaimResources(job) || preemptionLimits.ReachedLimit(job) != nil {
+		return nil
+	}
+	succeeded, statement, _ := ra.attemptToReclaimForSpecificJob(ssn, job)
+	if !succeeded {
+		return nil
+	}
+	victims := statement.EvictedTasks()
+	statement.Discard()
+	if preemptionLimits.ExceededLimit(job, victims) != nil {
+		return nil
+	}
+	log.InfraLogger.V(3).Infof("Reclaim for job <%s/%s> of another node pool would evict <%d> pods",
+		job.Namespace, job.Name, len(victims))
+	return ptr.To(len(victims))
+}
@@ -59,10 +59,21 @@ func (ra *reclaimAction) Execute(ssn *framework.Session) {
 		jobsOrderByQueues.Len(), ssn.CountLeafQueues())
 
 	smallestFailedJobsByQueue := map[common_info.QueueID]*common.MinimalJobRepresentatives{}
+	evictionBudget := utils.NewEvictionBudget(
+		ssn.Config.EvictionBudgets[string(framework.Reclaim)], ssn.ClusterInfo.PodGroupInfos, ssn.Clock().Now())
+	preemptionLimits := utils.NewPreemptionLimits(ssn.PreemptionHistory(), ssn.ClusterInfo.Queues, ssn.Clock().Now(),
+		ssn.IsShadow())
 
+	var unreclaimedJobs []*podgroup_info.PodGroupInfo
 	for !jobsOrderByQueues.IsEmpty() {
 		job := jobsOrderByQueues.PopNextJob()
 		if !ssn.CanReclaimResources(job) {
+			unreclaimedJobs = append(unreclaimedJobs, job)
+			continue
+		}
+		if queue := preemptionLimits.ReachedLimit(job); queue != nil {
+			log.InfraLogger.V(3).Infof("Skipping reclaim for job <%s/%s> - queue <%s> reached its preemption limit",
+				job.Namespace, job.Name, queue.Name)
 			continue
 		}
 
@@ -77,26 +88,50 @@ func (ra *reclaimAction) Execute(ssn *framework.Session) {
 				log.InfraLogger.V(3).Infof(
 					"Skipping reclaim for job: <%v/%v> - is not easier to reclaim for than: <%v/%v>",
 					job.Namespace, job.Name, otherJob.Namespace, otherJob.Name)
+				unreclaimedJobs = append(unreclaimedJobs, job)
 				continue
 			}
 		}
 		metrics.IncPodgroupsConsideredByAction()
 		succeeded, statement, reclaimeeTasksNames := ra.attemptToReclaimForSpecificJob(ssn, job)
+		if succeeded {
+			if queue := preemptionLimits.ExceededLimit(job, statement.EvictedTasks()); queue != nil {
+				log.InfraLogger.V(3).Infof(
+					"Reclaiming tasks <%v> for job <%s/%s> would exceed the preemption limit of queue <%s>",
+					reclaimeeTasksNames, job.Namespace, job.Name, queue.Name)
+				statement.Discard()
+				continue
+			}
+		}
+		if succeeded && !evictionBudget.TrySpend(statement.EvictedTasks()) {
+			log.InfraLogger.V(3).Infof(
+				"Reclaiming tasks <%v> for job <%s/%s> would exceed the eviction budget of the cycle",
+				reclaimeeTasksNames, job.Namespace, job.Name)
+			statement.Discard()
+			continue
+		}
 		if succeeded {
 			metrics.IncPodgroupScheduledByAction()
 			log.InfraLogger.V(3).Infof(
 				"Reclaimed resources for job <%s/%s>, evicting reclaimee tasks: <%v>.",
 				job.Namespace, job.Name, reclaimeeTasksNames,
 			)
+			victims := statement.EvictedTasks()
 			if err := statement.Commit(); err != nil {
 				log.InfraLogger.Errorf("Failed to commit reclaim statement: %v", err)
+			} else {
+				preemptionLimits.Record(job, victims)
 			}
 		} else {
 			log.InfraLogger.V(3).Infof("Didn't find a reclaim strategy for job <%s/%s>",
 				job.Namespace, job.Name)
 			smallestFailedJobs.UpdateRepresentative(job)
+			unreclaimedJobs = append(unreclaimedJobs, job)
 		}
 	}
+
+	requestCrossPoolReclaim(ssn, unreclaimedJobs)
+	ra.publishCrossPoolReclaimCosts(ssn, preemptionLimits)
 }
 
 func (ra *reclaimAction) attemptToReclaimForSpecificJob(
@@ -107,37 +142,122 @@ func (ra *reclaimAction) attemptToReclaimForSpecificJob(
 	log.InfraLogger.V(3).Infof("Attempting to reclaim for job: <%v/%v> of queue <%v>, resources: <%v>",
 		reclaimer.Namespace, reclaimer.Name, queue.Name, resReq)
 
-	ssn.OnJobSolutionStart()
-
 	feasibleNodes := common.FeasibleNodesForJob(maps.Values(ssn.ClusterInfo.Nodes), reclaimer)
+
+	// Elastic jobs above their minAvailable are downscaled before any job is evicted
+	if ssn.DownscaleElasticVictimsFirst() {
+		ssn.OnJobSolutionStart()
+		solver := solvers.NewJobsSolver(
+			feasibleNodes,
+			ssn.ReclaimScenarioValidatorFn,
+			getOrderedDownscaleVictimsQueue(ssn, reclaimer),
+			framework.Reclaim)
+		if solved, statement, victimNames := solver.Solve(ssn, reclaimer); solved {
+			log.InfraLogger.V(3).Infof("Downscaling elastic jobs of other queues for job: <%v/%v>",
+				reclaimer.Namespace, reclaimer.Name)
+			return solved, statement, victimNames
+		}
+	}
+
+	// Jobs that borrowed the deserved quota of the reclaimer's queue pay their loans back first
+	lenderQueues := getQueueWithAncestors(ssn, reclaimer.Queue)
+	isBorrower := func(job *podgroup_info.PodGroupInfo) bool {
+		return job.IsBorrowingFrom(lenderQueues...)
+	}
+	if hasVictimCandidates(ssn, reclaimer, isBorrower) {
+		ssn.OnJobSolutionStart()
+		solver := solvers.NewJobsSolver(
+			feasibleNodes,
+			ssn.ReclaimScenarioValidatorFn,
+			getOrderedVictimsQueue(ssn, reclaimer, isBorrower),
+			framework.Reclaim)
+		if solved, statement, victimNames := solver.Solve(ssn, reclaimer); solved {
+			log.InfraLogger.V(3).Infof("Reclaiming loans of queue <%v> for job: <%v/%v>",
+				queue.Name, reclaimer.Namespace, reclaimer.Name)
+			return solved, statement, victimNames
+		}
+	}
+
+	ssn.OnJobSolutionStart()
 	solver := solvers.NewJobsSolver(
 		feasibleNodes,
 		ssn.ReclaimScenarioValidatorFn,
-		getOrderedVictimsQueue(ssn, reclaimer),
+		getOrderedVictimsQueue(ssn, reclaimer, nil),
 		framework.Reclaim)
 	return solver.Solve(ssn, reclaimer)
 }
 
-func getOrderedVictimsQueue(ssn *framework.Session, reclaimer *podgroup_info.PodGroupInfo) solvers.GenerateVictimsQueue {
+func getQueueWithAncestors(ssn *framework.Session, queueID common_info.QueueID) []common_info.QueueID {
+	var queues []common_info.QueueID
+	for queue, found := ssn.ClusterInfo.Queues[queueID]; found; queue, found = ssn.ClusterInfo.Queues[queue.ParentQueue] {
+		queues = append(queues, queue.UID)
+	}
+	return queues
+}
+
+func hasVictimCandidates(
+	ssn *framework.Session, reclaimer *podgroup_info.PodGroupInfo, filter func(*podgroup_info.PodGroupInfo) bool,
+) bool {
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		if job.Queue != reclaimer.Queue && filter(job) {
+			return true
+		}
+	}
+	return false
+}
+
+func getOrderedVictimsQueue(
+	ssn *framework.Session, reclaimer *podgroup_info.PodGroupInfo, filter func(*podgroup_info.PodGroupInfo) bool,
+) solvers.GenerateVictimsQueue {
+	return func() *utils.JobsOrderByQueues {
+		return newVictimsQueue(ssn, getVictimCandidates(ssn, reclaimer, filter))
+	}
+}
+
+func getOrderedDownscaleVictimsQueue(
+	ssn *framework.Session, reclaimer *podgroup_info.PodGroupInfo,
+) solvers.GenerateVictimsQueue {
 	return func() *utils.JobsOrderByQueues {
-		jobsOrderedByQueue := utils.NewJobsOrderByQueues(ssn, utils.JobsOrderInitOptions{
-			FilterNonPreemptible:     true,
-			FilterNonActiveAllocated: true,
-			VictimQueue:              true,
-			MaxJobsQueueDepth:        scheduler_util.QueueCapacityInfinite,
-		})
 		jobs := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{}
-		for _, job := range ssn.ClusterInfo.PodGroupInfos {
-			if job.Queue == reclaimer.Queue {
-				continue
-			}
-			if !ssn.ReclaimVictimFilter(reclaimer, job) {
+		for _, job := range getVictimCandidates(ssn, reclaimer, nil) {
+			representative := podgroup_info.GetDownscaleRepresentative(job, ssn.TaskOrderFn)
+			if representative == nil {
 				continue
 			}
-			jobs[job.UID] = job
+			jobs[job.UID] = representative
 		}
+		return newVictimsQueue(ssn, jobs)
+	}
+}
 
-		jobsOrderedByQueue.InitializeWithJobs(jobs)
-		return &jobsOrderedByQueue
+func getVictimCandidates(
+	ssn *framework.Session, reclaimer *podgroup_info.PodGroupInfo, filter func(*podgroup_info.PodGroupInfo) bool,
+) map[common_info.PodGroupID]*podgroup_info.PodGroupInfo {
+	jobs := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{}
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		if job.Queue == reclaimer.Queue {
+			continue
+		}
+		if filter != nil && !filter(job) {
+			continue
+		}
+		if !ssn.ReclaimVictimFilter(reclaimer, job) {
+			continue
+		}
+		jobs[job.UID] = job
 	}
+	return jobs
+}
+
+func newVictimsQueue(
+	ssn *framework.Session, jobs map[common_info.PodGroupID]*podgroup_info.PodGroupInfo,
+) *utils.JobsOrderByQueues {
+	jobsOrderedByQueue := utils.NewJobsOrderByQueues(ssn, utils.JobsOrderInitOptions{
+		FilterNonPreemptible:     true,
+		FilterNonActiveAllocated: true,
+		VictimQueue:              true,
+		MaxJobsQueueDepth:        scheduler_util.QueueCapacityInfinite,
+	})
+	jobsOrderedByQueue.InitializeWithJobs(jobs)
+	return &jobsOrderedByQueue
 }
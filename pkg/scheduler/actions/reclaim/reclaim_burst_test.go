@@ -0,0 +1,108 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package reclaim_test
+
+import (
+	"testing"
+	"time"
+
+	. "go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/reclaim"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestReclaimBurst(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	job := func(name, queue string, state pod_status.PodStatus, nodeName string) *jobs_fake.TestJobBasic {
+		return &jobs_fake.TestJobBasic{
+			Name:                name,
+			RequiredGPUsPerTask: 1,
+			Priority:            constants.PriorityTrainNumber,
+			QueueName:           queue,
+			Tasks: []*tasks_fake.TestTaskBasic{
+				{
+					NodeName: nodeName,
+					State:    state,
+				},
+			},
+		}
+	}
+	topology := test_utils.TestTopologyBasic{
+		Name: "Queue over its deserved quota is reclaimed once its burst allowance is used",
+		Jobs: []*jobs_fake.TestJobBasic{
+			job("q0_running_job0", "queue0", pod_status.Running, "node0"),
+			job("q0_running_job1", "queue0", pod_status.Running, "node0"),
+			job("q1_pending_job0", "queue1", pod_status.Pending, ""),
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {
+				GPUs: 2,
+			},
+		},
+		Queues: []test_utils.TestQueueBasic{
+			{
+				Name:               "queue0",
+				DeservedGPUs:       1,
+				GPUOverQuotaWeight: 1,
+				Burst: &enginev2.QueueBurst{
+					GPUs:     1,
+					Duration: metav1.Duration{Duration: 10 * time.Minute},
+				},
+			},
+			{
+				Name:               "queue1",
+				DeservedGPUs:       1,
+				GPUOverQuotaWeight: 1,
+			},
+		},
+		Mocks: &test_utils.TestMock{
+			CacheRequirements: &test_utils.CacheMocking{
+				NumberOfCacheEvictions:  1,
+				NumberOfPipelineActions: 1,
+			},
+		},
+	}
+	runner := test_utils.NewCycleRunner(&topology, []framework.Action{reclaim.New()}, controller)
+
+	ssn := runner.RunCycle()
+	if status := taskStatus(ssn, "q1_pending_job0"); status != pod_status.Pending {
+		t.Errorf("expected the reclaimer to stay pending while the burst allowance lasts, got %s", status)
+	}
+
+	runner.Step(11 * time.Minute)
+	ssn = runner.RunCycle()
+	if status := taskStatus(ssn, "q1_pending_job0"); status != pod_status.Pipelined {
+		t.Errorf("expected the reclaimer to be pipelined once the burst allowance is used, got %s", status)
+	}
+	releasing := 0
+	for _, jobName := range []string{"q0_running_job0", "q0_running_job1"} {
+		if taskStatus(ssn, jobName) == pod_status.Releasing {
+			releasing++
+		}
+	}
+	if releasing != 1 {
+		t.Errorf("expected a single job of the bursting queue to be reclaimed, got %d", releasing)
+	}
+}
+
+func taskStatus(ssn *framework.Session, jobName string) pod_status.PodStatus {
+	job := ssn.ClusterInfo.PodGroupInfos[common_info.PodGroupID(jobName)]
+	for _, task := range job.GetAllPodsMap() {
+		return task.Status
+	}
+	return pod_status.Unknown
+}
@@ -0,0 +1,197 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package reclaim_test
+
+import (
+	"testing"
+
+	. "go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/reclaim"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+const nodePoolLabelKey = "kai.scheduler/node-pool"
+
+type crossPoolReclaimCostRecorder struct {
+	cache.Cache
+	costs map[string]*int
+}
+
+func (r *crossPoolReclaimCostRecorder) UpdateCrossPoolReclaimCost(
+	job *podgroup_info.PodGroupInfo, nodePool string, cost *int,
+) {
+	r.costs[job.Name+"/"+nodePool] = cost
+}
+
+func TestReclaimPublishesCrossPoolReclaimCost(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	topology := test_utils.TestTopologyBasic{
+		Name: "Job of another node pool gets the cost of reclaim in the node pool",
+		Jobs: []*jobs_fake.TestJobBasic{
+			crossPoolJob("q0_running_job0", "queue0", pod_status.Running, "node0"),
+			crossPoolJob("q0_running_job1", "queue0", pod_status.Running, "node0"),
+			crossPoolJob("q1_pending_job0", "queue1", pod_status.Pending, ""),
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {GPUs: 2},
+		},
+		Queues: []test_utils.TestQueueBasic{
+			{Name: "queue0", DeservedGPUs: 1, GPUOverQuotaWeight: 1},
+			{Name: "queue1", DeservedGPUs: 1, GPUOverQuotaWeight: 1, NodePools: []string{"pool-b"}},
+		},
+	}
+
+	tests := map[string]struct {
+		publishedCosts map[string]int
+		expectedCosts  map[string]*int
+	}{
+		"publishes the number of evicted pods": {
+			expectedCosts: map[string]*int{"q1_pending_job0/pool-b": ptr.To(1)},
+		},
+		"doesn't publish an unchanged cost": {
+			publishedCosts: map[string]int{"pool-b": 1},
+			expectedCosts:  map[string]*int{},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ssn := test_utils.BuildSession(topology, controller)
+			setNodePool(ssn, "pool-b")
+			recorder := &crossPoolReclaimCostRecorder{Cache: ssn.Cache, costs: map[string]*int{}}
+			ssn.Cache = recorder
+
+			// The session was opened with the job, so its queue requests the job's resources as in the snapshot
+			jobID := common_info.PodGroupID("q1_pending_job0")
+			job := ssn.ClusterInfo.PodGroupInfos[jobID]
+			job.CrossPoolReclaim.Candidate = true
+			job.CrossPoolReclaim.Costs = test.publishedCosts
+			delete(ssn.ClusterInfo.PodGroupInfos, jobID)
+			ssn.ClusterInfo.CrossPoolReclaimJobs = map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{jobID: job}
+
+			reclaim.New().Execute(ssn)
+
+			if len(recorder.costs) != len(test.expectedCosts) {
+				t.Fatalf("expected costs %v, got %v", test.expectedCosts, recorder.costs)
+			}
+			for key, expected := range test.expectedCosts {
+				if cost := recorder.costs[key]; cost == nil || *cost != *expected {
+					t.Errorf("expected cost %d for %s, got %v", *expected, key, cost)
+				}
+			}
+			if _, found := ssn.ClusterInfo.PodGroupInfos[jobID]; found {
+				t.Errorf("expected the job of the other node pool not to be scheduled in the session")
+			}
+			if releasing := countReleasing(ssn, "q0_running_job0", "q0_running_job1"); releasing != 0 {
+				t.Errorf("expected the simulated reclaim to be discarded, got %d releasing pods", releasing)
+			}
+		})
+	}
+}
+
+func TestReclaimMovesJobToCheapestNodePool(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	topology := test_utils.TestTopologyBasic{
+		Name: "Job that can't reclaim in its node pool asks the other node pools of its queue",
+		Jobs: []*jobs_fake.TestJobBasic{
+			crossPoolJob("q0_running_job0", "queue0", pod_status.Running, "node0"),
+			crossPoolJob("q1_pending_job0", "queue1", pod_status.Pending, ""),
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {GPUs: 1},
+		},
+		Queues: []test_utils.TestQueueBasic{
+			{Name: "queue0", DeservedGPUs: 1, GPUOverQuotaWeight: 1},
+			{Name: "queue1", DeservedGPUs: 0, GPUOverQuotaWeight: 1, NodePools: []string{"pool-b", "pool-c"}},
+		},
+	}
+
+	tests := map[string]struct {
+		requested              bool
+		costs                  map[string]int
+		expectedRequested      bool
+		expectedTargetNodePool string
+	}{
+		"requests the costs of the other node pools": {
+			expectedRequested: true,
+		},
+		"keeps requesting until a node pool publishes its cost": {
+			requested:         true,
+			expectedRequested: true,
+		},
+		"moves to the node pool with the cheapest reclaim": {
+			requested:              true,
+			costs:                  map[string]int{"pool-b": 2, "pool-c": 1, "pool-d": 0},
+			expectedRequested:      true,
+			expectedTargetNodePool: "pool-c",
+		},
+		"breaks ties by the order of the node pools of the queue": {
+			requested:              true,
+			costs:                  map[string]int{"pool-b": 1, "pool-c": 1},
+			expectedRequested:      true,
+			expectedTargetNodePool: "pool-b",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ssn := test_utils.BuildSession(topology, controller)
+			setNodePool(ssn, "pool-a")
+			job := ssn.ClusterInfo.PodGroupInfos["q1_pending_job0"]
+			job.CrossPoolReclaim.Requested = test.requested
+			job.CrossPoolReclaim.Costs = test.costs
+
+			reclaim.New().Execute(ssn)
+
+			if job.CrossPoolReclaim.Requested != test.expectedRequested {
+				t.Errorf("expected requested %v, got %v", test.expectedRequested, job.CrossPoolReclaim.Requested)
+			}
+			if job.CrossPoolReclaim.TargetNodePool != test.expectedTargetNodePool {
+				t.Errorf("expected target node pool %q, got %q", test.expectedTargetNodePool,
+					job.CrossPoolReclaim.TargetNodePool)
+			}
+			if releasing := countReleasing(ssn, "q0_running_job0"); releasing != 0 {
+				t.Errorf("expected no reclaim in the node pool of the job, got %d releasing pods", releasing)
+			}
+		})
+	}
+}
+
+func crossPoolJob(name, queue string, state pod_status.PodStatus, nodeName string) *jobs_fake.TestJobBasic {
+	return &jobs_fake.TestJobBasic{
+		Name:                name,
+		RequiredGPUsPerTask: 1,
+		Priority:            constants.PriorityTrainNumber,
+		QueueName:           queue,
+		Tasks: []*tasks_fake.TestTaskBasic{
+			{
+				NodeName: nodeName,
+				State:    state,
+			},
+		},
+	}
+}
+
+func setNodePool(ssn *framework.Session, nodePool string) {
+	ssn.SchedulerParams.PartitionParams = &conf.SchedulingNodePoolParams{
+		NodePoolLabelKey:   nodePoolLabelKey,
+		NodePoolLabelValue: nodePool,
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/integration_tests/integration_tests_utils"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/reclaim"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
@@ -37,6 +38,165 @@ func TestHandleElasticReclaim(t *testing.T) {
 	}
 }
 
+func TestHandleElasticReclaimDownscaleFirst(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+	defer gock.Off()
+	testsMetadata := getTestsElasticDownscaleFirstMetadata()
+
+	for testNumber, testMetadata := range testsMetadata {
+		t.Logf("Running test number: %v, test name: %v,", testNumber, testMetadata.TestTopologyBasic.Name)
+		ssn := test_utils.BuildSession(testMetadata.TestTopologyBasic, controller)
+		ssn.OverrideElasticReclaimStrategy(conf.ElasticReclaimStrategyDownscaleFirst)
+		reclaimAction := reclaim.New()
+		reclaimAction.Execute(ssn)
+
+		test_utils.MatchExpectedAndRealTasks(t, testNumber, testMetadata.TestTopologyBasic, ssn)
+	}
+}
+
+func getTestsElasticDownscaleFirstMetadata() []integration_tests_utils.TestTopologyMetadata {
+	return []integration_tests_utils.TestTopologyMetadata{
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name: "Downscale two elastic jobs instead of evicting a gang job",
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:                "gang-job",
+						RequiredGPUsPerTask: 1,
+						Priority:            constants.PriorityTrainNumber - 10,
+						QueueName:           "queue0",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName: "node0",
+								State:    pod_status.Running,
+							},
+							{
+								NodeName: "node0",
+								State:    pod_status.Running,
+							},
+						},
+					},
+					{
+						Name:                "elastic-job-a",
+						RequiredGPUsPerTask: 1,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue0",
+						RootSubGroupSet:     jobs_fake.DefaultSubGroup(1),
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName: "node0",
+								State:    pod_status.Running,
+							},
+							{
+								NodeName: "node0",
+								State:    pod_status.Running,
+							},
+						},
+					},
+					{
+						Name:                "elastic-job-b",
+						RequiredGPUsPerTask: 1,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue0",
+						RootSubGroupSet:     jobs_fake.DefaultSubGroup(1),
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								NodeName: "node0",
+								State:    pod_status.Running,
+							},
+							{
+								NodeName: "node0",
+								State:    pod_status.Running,
+							},
+						},
+					},
+					{
+						Name:                "pending-job",
+						RequiredGPUsPerTask: 2,
+						Priority:            constants.PriorityTrainNumber,
+						QueueName:           "queue1",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								State: pod_status.Pending,
+							},
+						},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {
+						GPUs: 6,
+					},
+				},
+				Queues: []test_utils.TestQueueBasic{
+					{
+						Name:               "queue0",
+						DeservedGPUs:       4,
+						GPUOverQuotaWeight: 1,
+					},
+					{
+						Name:               "queue1",
+						DeservedGPUs:       2,
+						GPUOverQuotaWeight: 1,
+					},
+				},
+				TaskExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"gang-job-0": {
+						NodeName:             "node0",
+						GPUsRequired:         1,
+						Status:               pod_status.Running,
+						DontValidateGPUGroup: true,
+					},
+					"gang-job-1": {
+						NodeName:             "node0",
+						GPUsRequired:         1,
+						Status:               pod_status.Running,
+						DontValidateGPUGroup: true,
+					},
+					"elastic-job-a-0": {
+						NodeName:             "node0",
+						GPUsRequired:         1,
+						Status:               pod_status.Running,
+						DontValidateGPUGroup: true,
+					},
+					"elastic-job-a-1": {
+						NodeName:             "node0",
+						GPUsRequired:         1,
+						Status:               pod_status.Releasing,
+						DontValidateGPUGroup: true,
+					},
+					"elastic-job-b-0": {
+						NodeName:             "node0",
+						GPUsRequired:         1,
+						Status:               pod_status.Running,
+						DontValidateGPUGroup: true,
+					},
+					"elastic-job-b-1": {
+						NodeName:             "node0",
+						GPUsRequired:         1,
+						Status:               pod_status.Releasing,
+						DontValidateGPUGroup: true,
+					},
+					"pending-job-0": {
+						NodeName:             "node0",
+						GPUsRequired:         2,
+						Status:               pod_status.Pipelined,
+						DontValidateGPUGroup: true,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheBinds:      1,
+						NumberOfCacheEvictions:  2,
+						NumberOfPipelineActions: 1,
+					},
+				},
+			},
+		},
+	}
+}
+
 func getTestsElasticMetadata() []integration_tests_utils.TestTopologyMetadata {
 	return []integration_tests_utils.TestTopologyMetadata{
 		{
@@ -0,0 +1,184 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package reclaim_test
+
+import (
+	"testing"
+
+	. "go.uber.org/mock/gomock"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/integration_tests/integration_tests_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/reclaim"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestHandleLoansReclaim(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+	testsMetadata := getTestsLoansReclaimMetadata()
+	for testNumber, testMetadata := range testsMetadata {
+		t.Logf("Running test number: %v, test name: %v,", testNumber, testMetadata.TestTopologyBasic.Name)
+		ssn := test_utils.BuildSession(testMetadata.TestTopologyBasic, controller)
+		reclaimAction := reclaim.New()
+		reclaimAction.Execute(ssn)
+
+		test_utils.MatchExpectedAndRealTasks(t, testNumber, testMetadata.TestTopologyBasic, ssn)
+	}
+}
+
+func getTestsLoansReclaimMetadata() []integration_tests_utils.TestTopologyMetadata {
+	runningJob := func(name, queue string, lenders ...common_info.QueueID) *jobs_fake.TestJobBasic {
+		return &jobs_fake.TestJobBasic{
+			Name:                name,
+			RequiredGPUsPerTask: 1,
+			Priority:            constants.PriorityTrainNumber,
+			QueueName:           queue,
+			LoanLenders:         lenders,
+			Tasks: []*tasks_fake.TestTaskBasic{
+				{
+					NodeName: "node0",
+					State:    pod_status.Running,
+				},
+			},
+		}
+	}
+	pendingJob := &jobs_fake.TestJobBasic{
+		Name:                "q2_pending_job0",
+		RequiredGPUsPerTask: 1,
+		Priority:            constants.PriorityTrainNumber,
+		QueueName:           "queue2",
+		Tasks: []*tasks_fake.TestTaskBasic{
+			{
+				State: pod_status.Pending,
+			},
+		},
+	}
+	queues := []test_utils.TestQueueBasic{
+		{
+			Name:               "queue0",
+			DeservedGPUs:       1,
+			GPUOverQuotaWeight: 1,
+		},
+		{
+			Name:               "queue1",
+			DeservedGPUs:       0,
+			GPUOverQuotaWeight: 1,
+		},
+		{
+			Name:               "queue2",
+			DeservedGPUs:       2,
+			GPUOverQuotaWeight: 1,
+		},
+	}
+
+	return []integration_tests_utils.TestTopologyMetadata{
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name: "Reclaim from the most over quota queue without loans",
+				Jobs: []*jobs_fake.TestJobBasic{
+					runningJob("q0_running_job0", "queue0"),
+					runningJob("q0_running_job1", "queue0"),
+					runningJob("q1_running_job0", "queue1"),
+					runningJob("q1_running_job1", "queue1"),
+					pendingJob,
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {
+						GPUs: 4,
+					},
+				},
+				Queues: queues,
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheEvictions:  1,
+						NumberOfPipelineActions: 1,
+					},
+				},
+				JobExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"q0_running_job0": {
+						GPUsRequired: 1,
+						Status:       pod_status.Running,
+						NodeName:     "node0",
+					},
+					"q0_running_job1": {
+						GPUsRequired: 1,
+						Status:       pod_status.Running,
+						NodeName:     "node0",
+					},
+					"q1_running_job0": {
+						GPUsRequired: 1,
+						Status:       pod_status.Running,
+						NodeName:     "node0",
+					},
+					"q1_running_job1": {
+						GPUsRequired: 1,
+						Status:       pod_status.Releasing,
+					},
+					"q2_pending_job0": {
+						GPUsRequired: 1,
+						Status:       pod_status.Pipelined,
+						NodeName:     "node0",
+					},
+				},
+			},
+		},
+		{
+			TestTopologyBasic: test_utils.TestTopologyBasic{
+				Name: "Reclaim the job that borrowed the quota of the reclaimer queue first",
+				Jobs: []*jobs_fake.TestJobBasic{
+					runningJob("q0_running_job0", "queue0"),
+					runningJob("q0_running_job1", "queue0", "queue2"),
+					runningJob("q1_running_job0", "queue1"),
+					runningJob("q1_running_job1", "queue1"),
+					pendingJob,
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node0": {
+						GPUs: 4,
+					},
+				},
+				Queues: queues,
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheEvictions:  1,
+						NumberOfPipelineActions: 1,
+					},
+				},
+				JobExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"q0_running_job0": {
+						GPUsRequired: 1,
+						Status:       pod_status.Running,
+						NodeName:     "node0",
+					},
+					"q0_running_job1": {
+						GPUsRequired: 1,
+						Status:       pod_status.Releasing,
+					},
+					"q1_running_job0": {
+						GPUsRequired: 1,
+						Status:       pod_status.Running,
+						NodeName:     "node0",
+					},
+					"q1_running_job1": {
+						GPUsRequired: 1,
+						Status:       pod_status.Running,
+						NodeName:     "node0",
+					},
+					"q2_pending_job0": {
+						GPUsRequired: 1,
+						Status:       pod_status.Pipelined,
+						NodeName:     "node0",
+					},
+				},
+			},
+		},
+	}
+}
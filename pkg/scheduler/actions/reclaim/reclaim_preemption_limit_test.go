@@ -0,0 +1,110 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package reclaim_test
+
+import (
+	"testing"
+	"time"
+
+	. "go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/reclaim"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestReclaimPreemptionLimit(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	job := func(name, queue string, state pod_status.PodStatus, nodeName string) *jobs_fake.TestJobBasic {
+		return &jobs_fake.TestJobBasic{
+			Name:                name,
+			RequiredGPUsPerTask: 1,
+			Priority:            constants.PriorityTrainNumber,
+			QueueName:           queue,
+			Tasks: []*tasks_fake.TestTaskBasic{
+				{
+					NodeName: nodeName,
+					State:    state,
+				},
+			},
+		}
+	}
+	topology := test_utils.TestTopologyBasic{
+		Name: "Queue that reached its preemption limit doesn't reclaim until its window passes",
+		Jobs: []*jobs_fake.TestJobBasic{
+			job("q0_running_job0", "queue0", pod_status.Running, "node0"),
+			job("q0_running_job1", "queue0", pod_status.Running, "node0"),
+			job("q0_running_job2", "queue0", pod_status.Running, "node0"),
+			job("q0_running_job3", "queue0", pod_status.Running, "node0"),
+			job("q1_pending_job0", "queue1", pod_status.Pending, ""),
+			job("q1_pending_job1", "queue1", pod_status.Pending, ""),
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {
+				GPUs: 4,
+			},
+		},
+		Queues: []test_utils.TestQueueBasic{
+			{
+				Name:               "queue0",
+				DeservedGPUs:       1,
+				GPUOverQuotaWeight: 1,
+			},
+			{
+				Name:               "queue1",
+				DeservedGPUs:       3,
+				GPUOverQuotaWeight: 1,
+				PreemptionLimit: &enginev2.QueuePreemptionLimit{
+					MaxVictims: 1,
+					Window:     metav1.Duration{Duration: 10 * time.Minute},
+				},
+			},
+		},
+		Mocks: &test_utils.TestMock{
+			CacheRequirements: &test_utils.CacheMocking{
+				NumberOfCacheEvictions:  2,
+				NumberOfPipelineActions: 2,
+			},
+		},
+	}
+	runner := test_utils.NewCycleRunner(&topology, []framework.Action{reclaim.New()}, controller)
+
+	ssn := runner.RunCycle()
+	if releasing := countReleasing(ssn, "q0_running_job0", "q0_running_job1", "q0_running_job2",
+		"q0_running_job3"); releasing != 1 {
+		t.Errorf("expected a single pod to be reclaimed within the preemption limit, got %d", releasing)
+	}
+
+	runner.Step(time.Minute)
+	ssn = runner.RunCycle()
+	if releasing := countReleasing(ssn, "q0_running_job1", "q0_running_job2", "q0_running_job3"); releasing != 0 {
+		t.Errorf("expected no pods to be reclaimed while the preemption limit is reached, got %d", releasing)
+	}
+
+	runner.Step(10 * time.Minute)
+	ssn = runner.RunCycle()
+	if releasing := countReleasing(ssn, "q0_running_job1", "q0_running_job2", "q0_running_job3"); releasing != 1 {
+		t.Errorf("expected a single pod to be reclaimed once the window passed, got %d", releasing)
+	}
+}
+
+func countReleasing(ssn *framework.Session, jobNames ...string) int {
+	releasing := 0
+	for _, jobName := range jobNames {
+		if taskStatus(ssn, jobName) == pod_status.Releasing {
+			releasing++
+		}
+	}
+	return releasing
+}
@@ -4,8 +4,6 @@
 package stalegangeviction
 
 import (
-	"time"
-
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
@@ -29,6 +27,11 @@ func (action *staleGangEviction) Name() framework.ActionType {
 func (action *staleGangEviction) Execute(ssn *framework.Session) {
 	log.InfraLogger.V(2).Infof("Enter StaleGangEviction ...")
 	defer log.InfraLogger.V(2).Infof("Leaving StaleGangEviction ...")
+	for jobID, replacedJob := range podgroup_info.GetReplacedPodGroups(ssn.ClusterInfo.PodGroupInfos) {
+		if job := ssn.ClusterInfo.PodGroupInfos[jobID]; job.IsFullyRunning() {
+			evictReplacedJob(ssn, replacedJob, job)
+		}
+	}
 	for _, job := range ssn.ClusterInfo.PodGroupInfos {
 		if job.IsStale() {
 			handleStaleJob(ssn, job)
@@ -40,7 +43,7 @@ func (action *staleGangEviction) Execute(ssn *framework.Session) {
 
 func handleStaleJob(ssn *framework.Session, job *podgroup_info.PodGroupInfo) {
 	if job.StalenessInfo.TimeStamp == nil {
-		timeNow := time.Now()
+		timeNow := ssn.Clock().Now()
 		job.StalenessInfo.TimeStamp = &timeNow
 	}
 
@@ -49,7 +52,7 @@ func handleStaleJob(ssn *framework.Session, job *podgroup_info.PodGroupInfo) {
 		return
 	}
 
-	timeInStaleStatus := time.Since(*job.StalenessInfo.TimeStamp)
+	timeInStaleStatus := ssn.Clock().Since(*job.StalenessInfo.TimeStamp)
 	if timeInStaleStatus < ssn.GetGlobalDefaultStalenessGracePeriod() {
 		return
 	}
@@ -82,6 +85,32 @@ func handleStaleJob(ssn *framework.Session, job *podgroup_info.PodGroupInfo) {
 	}
 }
 
+// evictReplacedJob evicts a job once the job replacing it runs, so that a rolling update of a gang never leaves it
+// below its minimum
+func evictReplacedJob(ssn *framework.Session, replacedJob, replacingJob *podgroup_info.PodGroupInfo) {
+	var tasksToEvict []*pod_info.PodInfo
+	for _, task := range replacedJob.GetAllPodsMap() {
+		if pod_status.IsActiveAllocatedStatus(task.Status) {
+			tasksToEvict = append(tasksToEvict, task)
+		}
+	}
+	evictionMetadata := eviction_info.EvictionMetadata{
+		EvictionGangSize: len(tasksToEvict),
+		Action:           string(framework.StaleGangEviction),
+		Preemptor:        nil,
+	}
+	for _, task := range tasksToEvict {
+		reason := api.GetReplacedEvictionMessage(task, replacingJob)
+		if err := ssn.Evict(task, reason, evictionMetadata); err != nil {
+			log.InfraLogger.Errorf("Failed to evict task: <%s/%s> of replaced job <%s> err: %v",
+				task.Namespace, task.Name, replacedJob.Name, err)
+			continue
+		}
+		log.InfraLogger.V(3).Infof("Evicted task: <%v/%v> due to its job being replaced by job <%v/%v>",
+			task.Namespace, task.Name, replacingJob.Namespace, replacingJob.Name)
+	}
+}
+
 func handleNonStaleJob(job *podgroup_info.PodGroupInfo) {
 	if job.StalenessInfo.TimeStamp != nil {
 		job.StalenessInfo.TimeStamp = nil
@@ -9,6 +9,7 @@ import (
 
 	. "go.uber.org/mock/gomock"
 	"gopkg.in/h2non/gock.v1"
+	clocktesting "k8s.io/utils/clock/testing"
 	"k8s.io/utils/pointer"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/stalegangeviction"
@@ -400,6 +401,124 @@ func TestStaleGangEviction(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Evict replaced job once its replacement runs",
+			topology: test_utils.TestTopologyBasic{
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:      "job-1",
+						QueueName: "q-1",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								Name:     "job-1-0",
+								State:    pod_status.Running,
+								NodeName: "node-1",
+							},
+						},
+					},
+					{
+						Name:      "job-2",
+						QueueName: "q-1",
+						Replaces:  "job-1",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								Name:     "job-2-0",
+								State:    pod_status.Running,
+								NodeName: "node-1",
+							},
+						},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node-1": {},
+				},
+				Queues: []test_utils.TestQueueBasic{
+					{
+						Name:        "q-1",
+						ParentQueue: "d-1",
+					},
+				},
+				Departments: []test_utils.TestDepartmentBasic{
+					{
+						Name: "d-1",
+					},
+				},
+				TaskExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"job-1-0": {
+						NodeName: "node-1",
+						Status:   pod_status.Releasing,
+					},
+					"job-2-0": {
+						NodeName: "node-1",
+						Status:   pod_status.Running,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheEvictions: 1,
+					},
+				},
+			},
+		},
+		{
+			name: "Don't evict replaced job while its replacement is binding",
+			topology: test_utils.TestTopologyBasic{
+				Jobs: []*jobs_fake.TestJobBasic{
+					{
+						Name:      "job-1",
+						QueueName: "q-1",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								Name:     "job-1-0",
+								State:    pod_status.Running,
+								NodeName: "node-1",
+							},
+						},
+					},
+					{
+						Name:      "job-2",
+						QueueName: "q-1",
+						Replaces:  "job-1",
+						Tasks: []*tasks_fake.TestTaskBasic{
+							{
+								Name:     "job-2-0",
+								State:    pod_status.Binding,
+								NodeName: "node-1",
+							},
+						},
+					},
+				},
+				Nodes: map[string]nodes_fake.TestNodeBasic{
+					"node-1": {},
+				},
+				Queues: []test_utils.TestQueueBasic{
+					{
+						Name:        "q-1",
+						ParentQueue: "d-1",
+					},
+				},
+				Departments: []test_utils.TestDepartmentBasic{
+					{
+						Name: "d-1",
+					},
+				},
+				TaskExpectedResults: map[string]test_utils.TestExpectedResultBasic{
+					"job-1-0": {
+						NodeName: "node-1",
+						Status:   pod_status.Running,
+					},
+					"job-2-0": {
+						NodeName: "node-1",
+						Status:   pod_status.Binding,
+					},
+				},
+				Mocks: &test_utils.TestMock{
+					CacheRequirements: &test_utils.CacheMocking{
+						NumberOfCacheEvictions: 0,
+					},
+				},
+			},
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			t.Logf("Running test number: %v, test name: %v,", i, test.name)
@@ -413,3 +532,71 @@ func TestStaleGangEviction(t *testing.T) {
 		})
 	}
 }
+
+func TestStaleGangEvictionAfterGracePeriod(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	topology := test_utils.TestTopologyBasic{
+		Jobs: []*jobs_fake.TestJobBasic{
+			{
+				Name:      "job-1",
+				QueueName: "q-1",
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{
+						Name:     "job-1-0",
+						State:    pod_status.Running,
+						NodeName: "node-1",
+					},
+					{
+						Name:     "job-1-1",
+						State:    pod_status.Failed,
+						NodeName: "node-1",
+					},
+				},
+			},
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node-1": {},
+		},
+		Queues: []test_utils.TestQueueBasic{
+			{
+				Name:        "q-1",
+				ParentQueue: "d-1",
+			},
+		},
+		Departments: []test_utils.TestDepartmentBasic{
+			{
+				Name: "d-1",
+			},
+		},
+		Mocks: &test_utils.TestMock{
+			CacheRequirements: &test_utils.CacheMocking{
+				NumberOfCacheEvictions: 1,
+			},
+		},
+		Clock: fakeClock,
+	}
+
+	ssn := test_utils.BuildSession(topology, controller)
+	ssn.OverrideGlobalDefaultStalenessGracePeriod(60 * time.Second)
+	task := ssn.ClusterInfo.PodGroupInfos["job-1"].GetAllPodsMap()["job-1-0"]
+	gangEviction := stalegangeviction.New()
+
+	for _, step := range []struct {
+		elapsed        time.Duration
+		expectedStatus pod_status.PodStatus
+	}{
+		{elapsed: 0, expectedStatus: pod_status.Running},
+		{elapsed: 59 * time.Second, expectedStatus: pod_status.Running},
+		{elapsed: 2 * time.Second, expectedStatus: pod_status.Releasing},
+	} {
+		fakeClock.Step(step.elapsed)
+		gangEviction.Execute(ssn)
+		if task.Status != step.expectedStatus {
+			t.Errorf("expected task status %s after %s, got %s", step.expectedStatus, step.elapsed, task.Status)
+		}
+	}
+}
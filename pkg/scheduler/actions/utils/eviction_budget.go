@@ -0,0 +1,93 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+)
+
+// EvictionBudget tracks the victims evicted by an action in a scheduling cycle, so that a demand spike can't evict
+// more pods and lose more work than the action is allowed to in a cycle, in total and of any single queue.
+type EvictionBudget struct {
+	budget        conf.EvictionBudget
+	jobs          map[common_info.PodGroupID]*podgroup_info.PodGroupInfo
+	now           time.Time
+	pods          int
+	gpuHours      float64
+	queuePods     map[common_info.QueueID]int
+	queueGpuHours map[common_info.QueueID]float64
+}
+
+func NewEvictionBudget(
+	budget conf.EvictionBudget, jobs map[common_info.PodGroupID]*podgroup_info.PodGroupInfo, now time.Time,
+) *EvictionBudget {
+	return &EvictionBudget{
+		budget:        budget,
+		jobs:          jobs,
+		now:           now,
+		queuePods:     map[common_info.QueueID]int{},
+		queueGpuHours: map[common_info.QueueID]float64{},
+	}
+}
+
+// TrySpend counts the victims against the budget. It returns false, without counting them, if evicting them would
+// exceed the budget of the cycle.
+func (eb *EvictionBudget) TrySpend(victims []*pod_info.PodInfo) bool {
+	pods := eb.pods
+	gpuHours := eb.gpuHours
+	queuePods := map[common_info.QueueID]int{}
+	queueGpuHours := map[common_info.QueueID]float64{}
+	for _, victim := range victims {
+		queue, victimGpuHours := eb.getVictimQueueAndGpuHours(victim)
+		if _, found := queuePods[queue]; !found {
+			queuePods[queue] = eb.queuePods[queue]
+			queueGpuHours[queue] = eb.queueGpuHours[queue]
+		}
+		pods++
+		gpuHours += victimGpuHours
+		queuePods[queue]++
+		queueGpuHours[queue] += victimGpuHours
+	}
+
+	if exceeds(pods, eb.budget.MaxPods) || exceeds(gpuHours, eb.budget.MaxGPUHours) {
+		return false
+	}
+	for queue := range queuePods {
+		if exceeds(queuePods[queue], eb.budget.MaxPodsPerQueue) ||
+			exceeds(queueGpuHours[queue], eb.budget.MaxGPUHoursPerQueue) {
+			return false
+		}
+	}
+
+	eb.pods = pods
+	eb.gpuHours = gpuHours
+	for queue := range queuePods {
+		eb.queuePods[queue] = queuePods[queue]
+		eb.queueGpuHours[queue] = queueGpuHours[queue]
+	}
+	return true
+}
+
+// getVictimQueueAndGpuHours returns the queue of the victim, and its GPUs multiplied by the hours its job has been
+// running
+func (eb *EvictionBudget) getVictimQueueAndGpuHours(victim *pod_info.PodInfo) (common_info.QueueID, float64) {
+	job, found := eb.jobs[victim.Job]
+	if !found {
+		return "", 0
+	}
+	if job.LastStartTimestamp == nil || job.LastStartTimestamp.IsZero() || victim.ResReq == nil {
+		return job.Queue, 0
+	}
+	runningHours := max(eb.now.Sub(*job.LastStartTimestamp).Hours(), 0)
+	return job.Queue, victim.ResReq.GetGpusQuota() * runningHours
+}
+
+func exceeds[T int | float64](value, limit T) bool {
+	return limit > 0 && value > limit
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+)
+
+func newVictimJob(name string, queue common_info.QueueID, startTime time.Time) *podgroup_info.PodGroupInfo {
+	job := podgroup_info.NewPodGroupInfo(common_info.PodGroupID(name))
+	job.Queue = queue
+	job.LastStartTimestamp = &startTime
+	return job
+}
+
+func newVictim(job string, gpus float64) *pod_info.PodInfo {
+	return &pod_info.PodInfo{
+		Job:    common_info.PodGroupID(job),
+		ResReq: resource_info.NewResourceRequirementsWithGpus(gpus),
+	}
+}
+
+func TestEvictionBudget(t *testing.T) {
+	now := time.Now()
+	jobs := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{
+		"short-a": newVictimJob("short-a", "queue-a", now.Add(-30*time.Minute)),
+		"long-a":  newVictimJob("long-a", "queue-a", now.Add(-10*time.Hour)),
+		"short-b": newVictimJob("short-b", "queue-b", now.Add(-30*time.Minute)),
+	}
+
+	tests := []struct {
+		name     string
+		budget   conf.EvictionBudget
+		victims  [][]*pod_info.PodInfo
+		expected []bool
+	}{
+		{
+			name:     "no limit",
+			budget:   conf.EvictionBudget{},
+			victims:  [][]*pod_info.PodInfo{{newVictim("long-a", 8), newVictim("long-a", 8)}},
+			expected: []bool{true},
+		},
+		{
+			name:   "max pods",
+			budget: conf.EvictionBudget{MaxPods: 2},
+			victims: [][]*pod_info.PodInfo{
+				{newVictim("short-a", 1)},
+				{newVictim("short-a", 1), newVictim("short-b", 1)},
+				{newVictim("short-b", 1)},
+				{newVictim("short-b", 1)},
+			},
+			expected: []bool{true, false, true, false},
+		},
+		{
+			name:   "max GPU hours",
+			budget: conf.EvictionBudget{MaxGPUHours: 4},
+			victims: [][]*pod_info.PodInfo{
+				{newVictim("long-a", 1)},
+				{newVictim("short-a", 4)},
+				{newVictim("short-b", 4), newVictim("short-b", 4)},
+			},
+			expected: []bool{false, true, false},
+		},
+		{
+			name:   "max pods per queue",
+			budget: conf.EvictionBudget{MaxPodsPerQueue: 1},
+			victims: [][]*pod_info.PodInfo{
+				{newVictim("short-a", 1)},
+				{newVictim("long-a", 1)},
+				{newVictim("short-b", 1)},
+			},
+			expected: []bool{true, false, true},
+		},
+		{
+			name:   "max GPU hours per queue",
+			budget: conf.EvictionBudget{MaxGPUHoursPerQueue: 2},
+			victims: [][]*pod_info.PodInfo{
+				{newVictim("short-a", 2), newVictim("short-b", 2)},
+				{newVictim("short-a", 4)},
+				{newVictim("short-b", 2)},
+			},
+			expected: []bool{true, false, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			budget := NewEvictionBudget(tt.budget, jobs, now)
+			for i, victims := range tt.victims {
+				assert.Equal(t, tt.expected[i], budget.TrySpend(victims), "victims %d", i)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+)
+
+// GangSizeLanes tracks the allocation attempts of every gang size lane in a scheduling cycle, so that huge gangs
+// can't use the whole cycle at the expense of small jobs, and small jobs can't starve huge gangs.
+type GangSizeLanes struct {
+	lanes    []conf.GangSizeLane
+	attempts []int
+}
+
+func NewGangSizeLanes(lanes []conf.GangSizeLane) *GangSizeLanes {
+	sortedLanes := slices.Clone(lanes)
+	slices.SortStableFunc(sortedLanes, func(l, r conf.GangSizeLane) int {
+		return cmp.Compare(maxGangSizeOrder(l.MaxGangSize), maxGangSizeOrder(r.MaxGangSize))
+	})
+	return &GangSizeLanes{
+		lanes:    sortedLanes,
+		attempts: make([]int, len(sortedLanes)),
+	}
+}
+
+// TryAttempt counts an allocation attempt of the job in its lane and returns the lane name. It returns false,
+// without counting the attempt, if the lane already used its budget for the cycle.
+func (gl *GangSizeLanes) TryAttempt(job *podgroup_info.PodGroupInfo) (string, bool) {
+	if len(gl.lanes) == 0 {
+		return "", true
+	}
+
+	laneIndex := gl.laneIndex(GangSize(job))
+	lane := gl.lanes[laneIndex]
+	if lane.MaxAttemptsPerCycle > 0 && gl.attempts[laneIndex] >= lane.MaxAttemptsPerCycle {
+		return lane.Name, false
+	}
+	gl.attempts[laneIndex]++
+	return lane.Name, true
+}
+
+// laneIndex returns the lane with the smallest max gang size that fits the gang. Gangs larger than all the lanes
+// belong to the last lane.
+func (gl *GangSizeLanes) laneIndex(gangSize int) int {
+	for index, lane := range gl.lanes {
+		if lane.MaxGangSize <= 0 || gangSize <= lane.MaxGangSize {
+			return index
+		}
+	}
+	return len(gl.lanes) - 1
+}
+
+// GangSize is the number of pods that have to be allocated together for the job to run
+func GangSize(job *podgroup_info.PodGroupInfo) int {
+	gangSize := 0
+	for _, subGroup := range job.GetSubGroups() {
+		gangSize += int(subGroup.GetMinAvailable())
+	}
+	return max(gangSize, 1)
+}
+
+func maxGangSizeOrder(maxGangSize int) int {
+	if maxGangSize <= 0 {
+		return math.MaxInt
+	}
+	return maxGangSize
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+)
+
+func newGang(name string, minAvailable int32) *podgroup_info.PodGroupInfo {
+	job := podgroup_info.NewPodGroupInfo(common_info.PodGroupID(name))
+	job.GetSubGroups()[podgroup_info.DefaultSubGroup].SetMinAvailable(minAvailable)
+	return job
+}
+
+func TestGangSizeLanes(t *testing.T) {
+	lanes := NewGangSizeLanes([]conf.GangSizeLane{
+		{Name: "large", MaxAttemptsPerCycle: 1},
+		{Name: "medium", MaxGangSize: 64, MaxAttemptsPerCycle: 2},
+		{Name: "small", MaxGangSize: 7},
+	})
+
+	tests := []struct {
+		job          *podgroup_info.PodGroupInfo
+		expectedLane string
+		expectedOk   bool
+	}{
+		{newGang("small-1", 1), "small", true},
+		{newGang("large-1", 128), "large", true},
+		{newGang("large-2", 65), "large", false},
+		{newGang("medium-1", 8), "medium", true},
+		{newGang("medium-2", 64), "medium", true},
+		{newGang("medium-3", 10), "medium", false},
+		{newGang("small-2", 7), "small", true},
+		{newGang("small-3", 0), "small", true},
+	}
+	for _, test := range tests {
+		lane, ok := lanes.TryAttempt(test.job)
+		assert.Equal(t, test.expectedLane, lane, test.job.Name)
+		assert.Equal(t, test.expectedOk, ok, test.job.Name)
+	}
+}
+
+func TestGangSizeLanesLargerThanAllLanes(t *testing.T) {
+	lanes := NewGangSizeLanes([]conf.GangSizeLane{
+		{Name: "small", MaxGangSize: 8, MaxAttemptsPerCycle: 1},
+		{Name: "medium", MaxGangSize: 64, MaxAttemptsPerCycle: 1},
+	})
+
+	lane, ok := lanes.TryAttempt(newGang("huge", 100))
+	assert.Equal(t, "medium", lane)
+	assert.True(t, ok)
+}
+
+func TestGangSizeLanesNotConfigured(t *testing.T) {
+	lanes := NewGangSizeLanes(nil)
+	for i := 0; i < 10; i++ {
+		_, ok := lanes.TryAttempt(newGang("job", 100))
+		assert.True(t, ok)
+	}
+}
@@ -14,6 +14,7 @@ type JobsOrderInitOptions struct {
 	FilterNonPending         bool
 	FilterNonPreemptible     bool
 	FilterNonActiveAllocated bool
+	FilterOutOfScopeQueues   bool
 	VictimQueue              bool
 	MaxJobsQueueDepth        int
 }
@@ -63,6 +64,10 @@ func (jobsOrder *JobsOrderByQueues) InitializeWithJobs(
 			continue
 		}
 
+		if jobsOrder.options.FilterOutOfScopeQueues && !jobsOrder.ssn.IsQueueInScope(job.Queue) {
+			continue
+		}
+
 		jobsOrder.PushJob(job)
 	}
 }
@@ -793,6 +793,43 @@ func TestJobsOrderByQueues_OrphanQueue_AddsJobFitError(t *testing.T) {
 	assert.True(t, jobsOrder.IsEmpty(), "Expected empty jobs order because orphan queue jobs are skipped from scheduling")
 }
 
+func TestJobsOrderByQueues_FilterOutOfScopeQueues(t *testing.T) {
+	ssn := newPrioritySession(t)
+	ssn.ClusterInfo.Queues = map[common_info.QueueID]*queue_info.QueueInfo{
+		"team-a": {UID: "team-a", Name: "team-a"},
+		"team-b": {UID: "team-b", Name: "team-b"},
+	}
+	ssn.ClusterInfo.PodGroupInfos = map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{}
+	for _, queue := range []common_info.QueueID{"team-a", "team-b"} {
+		jobID := common_info.PodGroupID("job-" + queue)
+		podID := common_info.PodID("pod-" + queue)
+		ssn.ClusterInfo.PodGroupInfos[jobID] = &podgroup_info.PodGroupInfo{
+			Name:     string(jobID),
+			UID:      jobID,
+			Priority: 100,
+			Queue:    queue,
+			PodStatusIndex: map[pod_status.PodStatus]pod_info.PodsMap{
+				pod_status.Pending: {podID: {UID: podID}},
+			},
+			PodSets: map[string]*subgroup_info.PodSet{
+				podgroup_info.DefaultSubGroup: subgroup_info.NewPodSet(podgroup_info.DefaultSubGroup, 0, nil).
+					WithPodInfos(pod_info.PodsMap{podID: {UID: podID}}),
+			},
+		}
+	}
+	ssn.LimitToQueues([]common_info.QueueID{"team-a"})
+
+	jobsOrder := NewJobsOrderByQueues(ssn, JobsOrderInitOptions{
+		FilterNonPending:       true,
+		FilterOutOfScopeQueues: true,
+		MaxJobsQueueDepth:      scheduler_util.QueueCapacityInfinite,
+	})
+	jobsOrder.InitializeWithJobs(ssn.ClusterInfo.PodGroupInfos)
+
+	assert.Equal(t, 1, jobsOrder.Len())
+	assert.Equal(t, common_info.PodGroupID("job-team-a"), jobsOrder.PopNextJob().UID)
+}
+
 // TestNLevelQueueHierarchy is a table-driven test for various queue hierarchy configurations.
 // It tests single-level, two-level, three-level, four-level, mixed-depth, and multiple root queue hierarchies.
 func TestNLevelQueueHierarchy(t *testing.T) {
@@ -1041,6 +1078,8 @@ func newCacheMock(t *testing.T) *cache.MockCache {
 		cacheMock.KubeClient(), cacheMock.KubeInformerFactory(), cacheMock.SnapshotSharedLister(),
 	)
 	cacheMock.EXPECT().InternalK8sPlugins().AnyTimes().Return(k8sPlugins)
+	cacheMock.EXPECT().UpdateQueueReclaimable(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	cacheMock.EXPECT().UpdateCrossPoolReclaimCost(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	return cacheMock
 }
 
@@ -0,0 +1,78 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+)
+
+// PreemptionLimits enforces the preemption limits of the queues, so that a demand spike of a single queue can't
+// evict more pods of the cluster than the queue is allowed to in a window, across scheduling cycles
+type PreemptionLimits struct {
+	history *queue_info.PreemptionHistory
+	queues  map[common_info.QueueID]*queue_info.QueueInfo
+	now     time.Time
+}
+
+// NewPreemptionLimits returns the preemption limits of the queues in a session, counting the evictions of the history
+// of the scheduler. Shadow sessions count the evictions on a copy of the history, which is only recorded by the
+// primary session.
+func NewPreemptionLimits(
+	history *queue_info.PreemptionHistory, queues map[common_info.QueueID]*queue_info.QueueInfo, now time.Time,
+	isShadow bool,
+) *PreemptionLimits {
+	if isShadow {
+		history = history.Clone()
+	}
+	history.Prune(queues, now)
+	return &PreemptionLimits{history: history, queues: queues, now: now}
+}
+
+// ReachedLimit returns the queue of the job, or one of its ancestors, that already reached its preemption limit, or
+// nil if pods may still be evicted for the job
+func (pl *PreemptionLimits) ReachedLimit(job *podgroup_info.PodGroupInfo) *queue_info.QueueInfo {
+	return pl.exceededLimit(job, 1)
+}
+
+// ExceededLimit returns the queue of the job, or one of its ancestors, whose preemption limit would be exceeded by
+// evicting the victims for the job, or nil if the victims may be evicted
+func (pl *PreemptionLimits) ExceededLimit(
+	job *podgroup_info.PodGroupInfo, victims []*pod_info.PodInfo,
+) *queue_info.QueueInfo {
+	return pl.exceededLimit(job, len(victims))
+}
+
+// Record counts the victims evicted for the job against the preemption limits of its queue and of its ancestors
+func (pl *PreemptionLimits) Record(job *podgroup_info.PodGroupInfo, victims []*pod_info.PodInfo) {
+	for _, queue := range pl.limitedQueues(job) {
+		pl.history.Record(queue.UID, len(victims), pl.now)
+	}
+}
+
+func (pl *PreemptionLimits) exceededLimit(job *podgroup_info.PodGroupInfo, victims int) *queue_info.QueueInfo {
+	for _, queue := range pl.limitedQueues(job) {
+		if pl.history.Count(queue.UID)+victims > int(queue.PreemptionLimit.MaxVictims) {
+			return queue
+		}
+	}
+	return nil
+}
+
+// limitedQueues returns the queue of the job and its ancestors that have a preemption limit
+func (pl *PreemptionLimits) limitedQueues(job *podgroup_info.PodGroupInfo) []*queue_info.QueueInfo {
+	var limited []*queue_info.QueueInfo
+	visited := map[common_info.QueueID]bool{}
+	for queue, found := pl.queues[job.Queue]; found && !visited[queue.UID]; queue, found = pl.queues[queue.ParentQueue] {
+		visited[queue.UID] = true
+		if queue.PreemptionLimit != nil {
+			limited = append(limited, queue)
+		}
+	}
+	return limited
+}
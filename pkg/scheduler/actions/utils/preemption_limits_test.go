@@ -0,0 +1,95 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+)
+
+func newLimitedQueues(
+	departmentLimit, teamLimit *enginev2.QueuePreemptionLimit,
+) map[common_info.QueueID]*queue_info.QueueInfo {
+	return map[common_info.QueueID]*queue_info.QueueInfo{
+		"department": {UID: "department", Name: "department", PreemptionLimit: departmentLimit},
+		"team-a":     {UID: "team-a", Name: "team-a", ParentQueue: "department", PreemptionLimit: teamLimit},
+		"team-b":     {UID: "team-b", Name: "team-b", ParentQueue: "department"},
+	}
+}
+
+func newPreemptionLimit(maxVictims int32, window time.Duration) *enginev2.QueuePreemptionLimit {
+	return &enginev2.QueuePreemptionLimit{MaxVictims: maxVictims, Window: metav1.Duration{Duration: window}}
+}
+
+func newPreemptor(queue common_info.QueueID) *podgroup_info.PodGroupInfo {
+	job := podgroup_info.NewPodGroupInfo(common_info.PodGroupID("preemptor-" + queue))
+	job.Queue = queue
+	return job
+}
+
+func victims(count int) []*pod_info.PodInfo {
+	pods := make([]*pod_info.PodInfo, count)
+	for i := range pods {
+		pods[i] = &pod_info.PodInfo{}
+	}
+	return pods
+}
+
+func TestPreemptionLimits(t *testing.T) {
+	history := queue_info.NewPreemptionHistory()
+	now := time.Now()
+	queues := newLimitedQueues(newPreemptionLimit(3, time.Hour), newPreemptionLimit(2, 10*time.Minute))
+	teamA, teamB := newPreemptor("team-a"), newPreemptor("team-b")
+
+	limits := NewPreemptionLimits(history, queues, now, false)
+	assert.Nil(t, limits.ExceededLimit(teamA, victims(2)))
+	assert.Equal(t, "team-a", limits.ExceededLimit(teamA, victims(3)).Name)
+	limits.Record(teamA, victims(2))
+	assert.Equal(t, "team-a", limits.ReachedLimit(teamA).Name)
+	assert.Nil(t, limits.ReachedLimit(teamB), "the department limit should leave room for a victim")
+	assert.Equal(t, "department", limits.ExceededLimit(teamB, victims(2)).Name)
+
+	limits = NewPreemptionLimits(history, queues, now.Add(11*time.Minute), false)
+	assert.Nil(t, limits.ReachedLimit(teamA), "the victims should leave the window of the queue")
+	assert.Equal(t, "department", limits.ExceededLimit(teamA, victims(2)).Name,
+		"the victims should stay in the window of the parent queue")
+
+	limits = NewPreemptionLimits(history, queues, now.Add(61*time.Minute), false)
+	assert.Nil(t, limits.ExceededLimit(teamB, victims(3)))
+}
+
+func TestPreemptionLimitsInShadowSessions(t *testing.T) {
+	history := queue_info.NewPreemptionHistory()
+	now := time.Now()
+	queues := newLimitedQueues(nil, newPreemptionLimit(1, time.Hour))
+	preemptor := newPreemptor("team-a")
+
+	shadowLimits := NewPreemptionLimits(history, queues, now, true)
+	shadowLimits.Record(preemptor, victims(1))
+	assert.NotNil(t, shadowLimits.ReachedLimit(preemptor))
+
+	assert.Nil(t, NewPreemptionLimits(history, queues, now, false).ReachedLimit(preemptor),
+		"shadow sessions should not record evictions in the history")
+}
+
+func TestPreemptionLimitsWithoutLimit(t *testing.T) {
+	history := queue_info.NewPreemptionHistory()
+	now := time.Now()
+	queues := newLimitedQueues(nil, newPreemptionLimit(0, time.Hour))
+
+	limits := NewPreemptionLimits(history, queues, now, false)
+	assert.Equal(t, "team-a", limits.ReachedLimit(newPreemptor("team-a")).Name,
+		"a limit of 0 should prevent evictions")
+	assert.Nil(t, limits.ExceededLimit(newPreemptor("team-b"), victims(100)))
+	assert.Nil(t, limits.ExceededLimit(newPreemptor("missing"), victims(100)))
+}
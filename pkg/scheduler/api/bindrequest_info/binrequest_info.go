@@ -19,7 +19,7 @@ func (brm BindRequestMap) GetBindRequestForPod(pod *v1.Pod) *BindRequestInfo {
 	if !found {
 		return nil
 	}
-	if request.IsFailed() {
+	if request.IsFailed() || !request.IsForPod(pod) {
 		return nil
 	}
 
@@ -79,3 +79,17 @@ func (bri *BindRequestInfo) IsFailed() bool {
 	}
 	return bri.BindRequest.Status.FailedAttempts >= *bri.BindRequest.Spec.BackoffLimit
 }
+
+// IsForPod returns false for bind requests that were created for a previous pod with the same name, which its
+// controller replaced. Pods whose containers restart in place keep their UID, and their bind request.
+func (bri *BindRequestInfo) IsForPod(pod *v1.Pod) bool {
+	if pod.UID == "" {
+		return true
+	}
+	for _, owner := range bri.BindRequest.OwnerReferences {
+		if owner.Kind == "Pod" && owner.Name == pod.Name && owner.UID != "" {
+			return owner.UID == pod.UID
+		}
+	}
+	return true
+}
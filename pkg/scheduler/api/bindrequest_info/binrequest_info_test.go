@@ -0,0 +1,70 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package bindrequest_info
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+)
+
+func TestGetBindRequestForPod(t *testing.T) {
+	tests := []struct {
+		name      string
+		ownerUID  types.UID
+		podUID    types.UID
+		phase     string
+		wantFound bool
+	}{
+		{
+			name:      "request of the pod",
+			ownerUID:  "uid-1",
+			podUID:    "uid-1",
+			wantFound: true,
+		},
+		{
+			name:      "request of a replaced pod with the same name",
+			ownerUID:  "uid-1",
+			podUID:    "uid-2",
+			wantFound: false,
+		},
+		{
+			name:      "request without an owner",
+			podUID:    "uid-1",
+			wantFound: true,
+		},
+		{
+			name:      "failed request of the pod",
+			ownerUID:  "uid-1",
+			podUID:    "uid-1",
+			phase:     schedulingv1alpha2.BindRequestPhaseFailed,
+			wantFound: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns", UID: tt.podUID}}
+			bindRequest := &schedulingv1alpha2.BindRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns"},
+				Spec:       schedulingv1alpha2.BindRequestSpec{PodName: "pod"},
+				Status:     schedulingv1alpha2.BindRequestStatus{Phase: tt.phase},
+			}
+			if tt.ownerUID != "" {
+				bindRequest.OwnerReferences = []metav1.OwnerReference{{
+					APIVersion: "v1", Kind: "Pod", Name: "pod", UID: tt.ownerUID,
+				}}
+			}
+			bindRequests := BindRequestMap{NewKeyFromRequest(bindRequest): NewBindRequestInfo(bindRequest)}
+
+			got := bindRequests.GetBindRequestForPod(pod)
+			if (got != nil) != tt.wantFound {
+				t.Errorf("GetBindRequestForPod() = %v, want found %v", got, tt.wantFound)
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 
 	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/bindrequest_info"
@@ -55,23 +56,31 @@ type ClusterInfo struct {
 	StorageClasses              map[common_info.StorageClassID]*storageclass_info.StorageClassInfo
 	ConfigMaps                  map[common_info.ConfigMapID]*configmap_info.ConfigMapInfo
 	Topologies                  []*kaiv1alpha1.Topology
+	SchedulingFreezes           []*kaiv1alpha1.SchedulingFreeze
+	PodDisruptionBudgets        []*policyv1.PodDisruptionBudget
+	// CrossPoolReclaimJobs are pending jobs of other node pools that ask this node pool for its reclaim cost. They are
+	// not scheduled in this node pool, and so are not part of PodGroupInfos.
+	CrossPoolReclaimJobs map[common_info.PodGroupID]*podgroup_info.PodGroupInfo
 
 	MinNodeGPUMemory int64
 }
 
 func NewClusterInfo() *ClusterInfo {
 	return &ClusterInfo{
-		Pods:               []*v1.Pod{},
-		Nodes:              make(map[string]*node_info.NodeInfo),
-		BindRequests:       make(bindrequest_info.BindRequestMap),
-		PodGroupInfos:      make(map[common_info.PodGroupID]*podgroup_info.PodGroupInfo),
-		Queues:             make(map[common_info.QueueID]*queue_info.QueueInfo),
-		QueueResourceUsage: *queue_info.NewClusterUsage(),
-		Departments:        make(map[common_info.QueueID]*queue_info.QueueInfo),
-		StorageClaims:      make(map[storageclaim_info.Key]*storageclaim_info.StorageClaimInfo),
-		StorageCapacities:  make(map[common_info.StorageCapacityID]*storagecapacity_info.StorageCapacityInfo),
-		ConfigMaps:         make(map[common_info.ConfigMapID]*configmap_info.ConfigMapInfo),
-		Topologies:         []*kaiv1alpha1.Topology{},
+		Pods:                 []*v1.Pod{},
+		Nodes:                make(map[string]*node_info.NodeInfo),
+		BindRequests:         make(bindrequest_info.BindRequestMap),
+		PodGroupInfos:        make(map[common_info.PodGroupID]*podgroup_info.PodGroupInfo),
+		Queues:               make(map[common_info.QueueID]*queue_info.QueueInfo),
+		QueueResourceUsage:   *queue_info.NewClusterUsage(),
+		Departments:          make(map[common_info.QueueID]*queue_info.QueueInfo),
+		StorageClaims:        make(map[storageclaim_info.Key]*storageclaim_info.StorageClaimInfo),
+		StorageCapacities:    make(map[common_info.StorageCapacityID]*storagecapacity_info.StorageCapacityInfo),
+		ConfigMaps:           make(map[common_info.ConfigMapID]*configmap_info.ConfigMapInfo),
+		Topologies:           []*kaiv1alpha1.Topology{},
+		SchedulingFreezes:    []*kaiv1alpha1.SchedulingFreeze{},
+		PodDisruptionBudgets: []*policyv1.PodDisruptionBudget{},
+		CrossPoolReclaimJobs: make(map[common_info.PodGroupID]*podgroup_info.PodGroupInfo),
 	}
 }
 
@@ -229,3 +229,24 @@ func (f *JobFitErrorWithQueueContext) ToUnschedulableExplanation() enginev2alpha
 		Details: f.context,
 	}
 }
+
+type JobFitErrorWithNodeContext struct {
+	JobFitErrorBase
+	nodeDetails *enginev2alpha2.NodeFilterDetails
+}
+
+func NewJobFitErrorWithNodeContext(jobName, subGroupName, jobNamespace string, reason enginev2alpha2.UnschedulableReason,
+	message string, nodeDetails *enginev2alpha2.NodeFilterDetails) *JobFitErrorWithNodeContext {
+	return &JobFitErrorWithNodeContext{
+		JobFitErrorBase: *NewJobFitError(jobName, subGroupName, jobNamespace, reason, []string{message}),
+		nodeDetails:     nodeDetails,
+	}
+}
+
+func (f *JobFitErrorWithNodeContext) ToUnschedulableExplanation() enginev2alpha2.UnschedulableExplanation {
+	explanation := f.JobFitErrorBase.ToUnschedulableExplanation()
+	if f.nodeDetails != nil {
+		explanation.Details = &enginev2alpha2.UnschedulableExplanationDetails{NodeDetails: f.nodeDetails}
+	}
+	return explanation
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/dustin/go-humanize"
 
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/k8s_internal"
 )
@@ -164,8 +165,9 @@ func (f *TasksFitError) Error() string {
 }
 
 type TasksFitErrors struct {
-	nodes map[string]*TasksFitError
-	err   string
+	nodes    map[string]*TasksFitError
+	err      string
+	numNodes int
 }
 
 func NewFitErrors() *TasksFitErrors {
@@ -174,10 +176,29 @@ func NewFitErrors() *TasksFitErrors {
 	return f
 }
 
+// Clone returns a copy of the fit errors that is not affected by node errors added to the original
+func (f *TasksFitErrors) Clone() *TasksFitErrors {
+	clone := &TasksFitErrors{
+		nodes:    make(map[string]*TasksFitError, len(f.nodes)),
+		err:      f.err,
+		numNodes: f.numNodes,
+	}
+	for nodeName, fitError := range f.nodes {
+		clone.nodes[nodeName] = fitError
+	}
+	return clone
+}
+
 func (f *TasksFitErrors) SetError(err string) {
 	f.err = err
 }
 
+// SetNumNodes sets the number of nodes that the task was considered for, so that the node errors are reported as a
+// part of all the nodes, e.g. "3/5 node(s) didn't have enough resources: GPUs"
+func (f *TasksFitErrors) SetNumNodes(numNodes int) {
+	f.numNodes = numNodes
+}
+
 func (f *TasksFitErrors) SetNodeError(nodeName string, err error) {
 	var fe *TasksFitError
 	switch obj := err.(type) {
@@ -195,6 +216,7 @@ func (f *TasksFitErrors) AddNodeErrors(errors *TasksFitErrors) {
 	for nodeName, fitError := range errors.nodes {
 		f.nodes[nodeName] = fitError
 	}
+	f.numNodes = max(f.numNodes, errors.numNodes)
 }
 
 func (f *TasksFitErrors) DetailedError() string {
@@ -211,34 +233,58 @@ func (f *TasksFitErrors) DetailedError() string {
 }
 
 func (f *TasksFitErrors) Error() string {
-	reasons := make(map[string]int)
-
-	sortReasonsHistogram := func() []string {
-		for _, node := range f.nodes {
-			for _, reason := range node.Reasons {
-				reasons[reason]++
-			}
-		}
-
-		var reasonStrings []string
-		for k, v := range reasons {
-			reasonStrings = append(reasonStrings, fmt.Sprintf("%v %v", v, k))
-		}
-		sort.Strings(reasonStrings)
-		return reasonStrings
-	}
 	if f.err == "" {
 		f.err = ResourcesWereNotFoundMsg
 	}
 	reasonMsg := f.err
 
-	nodeReasonsHistogram := sortReasonsHistogram()
+	var nodeReasonsHistogram []string
+	for reason, numNodes := range f.nodeReasonsHistogram() {
+		if f.numNodes > 0 {
+			nodeReasonsHistogram = append(nodeReasonsHistogram, fmt.Sprintf("%v/%v %v", numNodes, f.numNodes, reason))
+		} else {
+			nodeReasonsHistogram = append(nodeReasonsHistogram, fmt.Sprintf("%v %v", numNodes, reason))
+		}
+	}
+	sort.Strings(nodeReasonsHistogram)
 	if len(nodeReasonsHistogram) > 0 {
 		reasonMsg += fmt.Sprintf(": %v.", strings.Join(nodeReasonsHistogram, ". \n"))
 	}
 	return reasonMsg
 }
 
+// NodeFilterDetails returns the number of nodes that rejected the task for each reason, with the most common reasons
+// first, or nil if no node rejected the task
+func (f *TasksFitErrors) NodeFilterDetails() *enginev2alpha2.NodeFilterDetails {
+	if len(f.nodes) == 0 {
+		return nil
+	}
+	details := &enginev2alpha2.NodeFilterDetails{
+		TotalNodes: max(f.numNodes, len(f.nodes)),
+	}
+	for reason, numNodes := range f.nodeReasonsHistogram() {
+		details.FilterFailures = append(details.FilterFailures,
+			enginev2alpha2.NodeFilterFailure{Reason: reason, Nodes: numNodes})
+	}
+	sort.Slice(details.FilterFailures, func(i, j int) bool {
+		if details.FilterFailures[i].Nodes != details.FilterFailures[j].Nodes {
+			return details.FilterFailures[i].Nodes > details.FilterFailures[j].Nodes
+		}
+		return details.FilterFailures[i].Reason < details.FilterFailures[j].Reason
+	})
+	return details
+}
+
+func (f *TasksFitErrors) nodeReasonsHistogram() map[string]int {
+	reasons := make(map[string]int)
+	for _, node := range f.nodes {
+		for _, reason := range node.Reasons {
+			reasons[reason]++
+		}
+	}
+	return reasons
+}
+
 type NotFoundError struct {
 	Name string
 }
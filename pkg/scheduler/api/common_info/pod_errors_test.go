@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"testing"
 
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
 )
 
@@ -196,3 +197,50 @@ func TestNewFitErrorInsufficientResource(t *testing.T) {
 		})
 	}
 }
+
+func TestFitErrors_Clone(t *testing.T) {
+	original := NewFitErrors()
+	original.SetError("error")
+	original.SetNodeError("node-a", NewFitError("pod", "ns", "", "reason-a"))
+
+	clone := original.Clone()
+	added := NewFitErrors()
+	added.SetNodeError("node-b", NewFitError("pod", "ns", "", "reason-b"))
+	original.AddNodeErrors(added)
+
+	if len(clone.nodes) != 1 || clone.err != "error" {
+		t.Errorf("Clone() = %v, want only the errors before the clone", clone.nodes)
+	}
+	if len(original.nodes) != 2 {
+		t.Errorf("AddNodeErrors() on the original = %v, want 2 nodes", original.nodes)
+	}
+}
+
+func TestFitErrors_NodeReasonsOutOfAllNodes(t *testing.T) {
+	fitErrors := NewFitErrors()
+	fitErrors.SetNumNodes(5)
+	fitErrors.SetNodeError("node1", NewFitError("t1", "n1", "", "node(s) didn't have enough resources: GPUs"))
+	fitErrors.SetNodeError("node2", NewFitError("t1", "n1", "", "node(s) didn't have enough resources: GPUs"))
+	fitErrors.SetNodeError("node3", NewFitError("t1", "n1", "", "node(s) didn't match the node affinity"))
+
+	want := "no nodes with enough resources were found: 1/5 node(s) didn't match the node affinity. \n" +
+		"2/5 node(s) didn't have enough resources: GPUs."
+	if got := fitErrors.Error(); got != want {
+		t.Errorf("Error() = %v, want %v", got, want)
+	}
+
+	wantDetails := &enginev2alpha2.NodeFilterDetails{
+		TotalNodes: 5,
+		FilterFailures: []enginev2alpha2.NodeFilterFailure{
+			{Reason: "node(s) didn't have enough resources: GPUs", Nodes: 2},
+			{Reason: "node(s) didn't match the node affinity", Nodes: 1},
+		},
+	}
+	if got := fitErrors.NodeFilterDetails(); !reflect.DeepEqual(got, wantDetails) {
+		t.Errorf("NodeFilterDetails() = %v, want %v", got, wantDetails)
+	}
+
+	if got := NewFitErrors().NodeFilterDetails(); got != nil {
+		t.Errorf("NodeFilterDetails() without node errors = %v, want nil", got)
+	}
+}
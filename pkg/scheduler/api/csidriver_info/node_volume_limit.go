@@ -0,0 +1,58 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package csidriver_info
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/storageclaim_info"
+)
+
+// NodeVolumeLimit is the number of volumes of a CSI driver that can be attached to a node, as reported by the CSINode
+// of the node, together with the storage claims of the driver that are used by pods on the node.
+type NodeVolumeLimit struct {
+	Driver common_info.CSIDriverID
+	Limit  int
+	// AttachedClaims holds the number of pods on the node that use every attached claim
+	AttachedClaims map[storageclaim_info.Key]int
+}
+
+func NewNodeVolumeLimit(driver common_info.CSIDriverID, limit int) *NodeVolumeLimit {
+	return &NodeVolumeLimit{
+		Driver:         driver,
+		Limit:          limit,
+		AttachedClaims: map[storageclaim_info.Key]int{},
+	}
+}
+
+func (nvl *NodeVolumeLimit) AddClaim(key storageclaim_info.Key) {
+	nvl.AttachedClaims[key]++
+}
+
+func (nvl *NodeVolumeLimit) RemoveClaim(key storageclaim_info.Key) {
+	nvl.AttachedClaims[key]--
+	if nvl.AttachedClaims[key] <= 0 {
+		delete(nvl.AttachedClaims, key)
+	}
+}
+
+// AreClaimsAttachable returns an error if attaching the claims that are not attached yet would exceed the limit
+func (nvl *NodeVolumeLimit) AreClaimsAttachable(keys []storageclaim_info.Key) error {
+	newClaims := map[storageclaim_info.Key]bool{}
+	for _, key := range keys {
+		if _, attached := nvl.AttachedClaims[key]; !attached {
+			newClaims[key] = true
+		}
+	}
+	if len(newClaims) == 0 {
+		return nil
+	}
+
+	if attached := len(nvl.AttachedClaims) + len(newClaims); attached > nvl.Limit {
+		return fmt.Errorf("node(s) exceed max volume count of csi driver %s: %d volumes requested, %d "+
+			"attached, limit is %d", nvl.Driver, len(newClaims), len(nvl.AttachedClaims), nvl.Limit)
+	}
+	return nil
+}
@@ -24,14 +24,17 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	"go.uber.org/multierr"
 	"golang.org/x/exp/maps"
 	v1 "k8s.io/api/core/v1"
 
 	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	commonresources "github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info/resources"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/csidriver_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_affinity"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
@@ -78,8 +81,13 @@ type NodeInfo struct {
 	Used *resource_info.Resource
 
 	Allocatable *resource_info.Resource
+	// The resources kept for pods of other schedulers, which are not part of the idle resources
+	ReservedForOtherSchedulers *resource_info.Resource
 
 	AccessibleStorageCapacities map[common_info.StorageClassID][]*sc_info.StorageCapacityInfo
+	// CSIVolumeLimits holds the attach limit of the CSI driver of every storage class. Storage classes of the same
+	// driver share the same limit.
+	CSIVolumeLimits map[common_info.StorageClassID]*csidriver_info.NodeVolumeLimit
 
 	PodInfos               map[common_info.PodID]*pod_info.PodInfo
 	MaxTaskNum             int
@@ -109,6 +117,7 @@ func NewNodeInfo(node *v1.Node, podAffinityInfo pod_affinity.NodePodAffinityInfo
 		Allocatable: resource_info.ResourceFromResourceList(node.Status.Allocatable),
 
 		AccessibleStorageCapacities: map[common_info.StorageClassID][]*sc_info.StorageCapacityInfo{},
+		CSIVolumeLimits:             map[common_info.StorageClassID]*csidriver_info.NodeVolumeLimit{},
 
 		PodInfos:               make(map[common_info.PodID]*pod_info.PodInfo),
 		MemoryOfEveryGpuOnNode: gpuMemory,
@@ -132,6 +141,48 @@ func NewNodeInfo(node *v1.Node, podAffinityInfo pod_affinity.NodePodAffinityInfo
 	return nodeInfo
 }
 
+// Clone returns a copy of the node info, with the given pod affinity info of the same pods, whose resources and tasks
+// can change without changing the node info it was copied from. The storage capacities and volume limits of the node
+// are shared with the copy.
+func (ni *NodeInfo) Clone(podAffinityInfo pod_affinity.NodePodAffinityInfo) *NodeInfo {
+	podInfos := make(map[common_info.PodID]*pod_info.PodInfo, len(ni.PodInfos))
+	for key, podInfo := range ni.PodInfos {
+		podInfos[key] = podInfo.Clone()
+	}
+
+	var reservedForOtherSchedulers *resource_info.Resource
+	if ni.ReservedForOtherSchedulers != nil {
+		reservedForOtherSchedulers = ni.ReservedForOtherSchedulers.Clone()
+	}
+
+	return &NodeInfo{
+		Name: ni.Name,
+		Node: ni.Node,
+
+		Releasing: ni.Releasing.Clone(),
+		Idle:      ni.Idle.Clone(),
+		Used:      ni.Used.Clone(),
+
+		Allocatable:                ni.Allocatable.Clone(),
+		ReservedForOtherSchedulers: reservedForOtherSchedulers,
+
+		AccessibleStorageCapacities: maps.Clone(ni.AccessibleStorageCapacities),
+		CSIVolumeLimits:             maps.Clone(ni.CSIVolumeLimits),
+
+		PodInfos:               podInfos,
+		MaxTaskNum:             ni.MaxTaskNum,
+		MemoryOfEveryGpuOnNode: ni.MemoryOfEveryGpuOnNode,
+		GpuMemorySynced:        ni.GpuMemorySynced,
+		LegacyMIGTasks:         maps.Clone(ni.LegacyMIGTasks),
+
+		HasDRAGPUs: ni.HasDRAGPUs,
+
+		GpuSharingNodeInfo: *ni.GpuSharingNodeInfo.Clone(),
+
+		PodAffinityInfo: podAffinityInfo,
+	}
+}
+
 func (ni *NodeInfo) NonAllocatedResources() *resource_info.Resource {
 	nonAllocatedResource := resource_info.EmptyResource()
 	nonAllocatedResource.Add(ni.Idle)
@@ -165,6 +216,26 @@ func (ni *NodeInfo) IsTaskAllocatable(task *pod_info.PodInfo) bool {
 	return true
 }
 
+// NumTaskCopiesAllocatable returns the number of pods with the resource requests of the task that fit the idle
+// resources of the node. Pods that share GPUs are counted once, as their placement depends on the GPUs they share.
+func (ni *NodeInfo) NumTaskCopiesAllocatable(task *pod_info.PodInfo) int {
+	maxCopies := ni.MaxTaskNum - len(ni.PodInfos)
+	if maxCopies <= 0 || !ni.IsTaskAllocatable(task) {
+		return 0
+	}
+	if task.IsSharedGPURequest() {
+		return 1
+	}
+
+	idle := ni.Idle.Clone()
+	copies := 0
+	for copies < maxCopies && ni.lessEqualTaskToNodeResources(task.ResReq, idle) {
+		idle.SubResourceRequirements(task.ResReq)
+		copies++
+	}
+	return copies
+}
+
 func (ni *NodeInfo) IsTaskAllocatableOnReleasingOrIdle(task *pod_info.PodInfo) bool {
 	nodeNonAllocatedResources := ni.NonAllocatedResources()
 
@@ -183,8 +254,34 @@ func (ni *NodeInfo) IsTaskAllocatableOnReleasingOrIdle(task *pod_info.PodInfo) b
 	return true
 }
 
+// IsTaskAllocatableOnIdleOrReleasedBy returns whether the task fits the idle resources of the node and the resources
+// of its terminating pods that are expected to be released by the deadline. Shared GPUs of terminating pods are
+// counted as released regardless of the deadline.
+func (ni *NodeInfo) IsTaskAllocatableOnIdleOrReleasedBy(task *pod_info.PodInfo, now, deadline time.Time) bool {
+	nodeNonAllocatedResources := ni.NonAllocatedResources()
+	nodeNonAllocatedResources.Sub(ni.ReleasedAfter(now, deadline))
+	return ni.isTaskAllocatableOnNonAllocatedResources(task, nodeNonAllocatedResources)
+}
+
+// ReleasedAfter returns the releasing resources of the node, excluding shared GPUs, that belong to terminating pods
+// that are expected to be released after the deadline
+func (ni *NodeInfo) ReleasedAfter(now, deadline time.Time) *resource_info.Resource {
+	releasedAfter := resource_info.EmptyResource()
+	for _, task := range ni.PodInfos {
+		if task.Status != pod_status.Releasing || !ni.shouldAddTaskResources(task) {
+			continue
+		}
+		if task.EstimatedReleaseTime(now).After(deadline) {
+			releasedAfter.Add(getAcceptedTaskResourceWithoutSharedGPU(task))
+		}
+	}
+	return releasedAfter
+}
+
 // isTaskStorageAllocatable iterates over a pod's volumes. For all unbound PVCs, which use a CSI storage, we check the
-// node's ability to access this StorageCapacity, and calls ArePVCsAllocatable. For all other types of storage, we rely
+// node's ability to access this StorageCapacity, and calls ArePVCsAllocatable. For all PVCs which use a CSI storage, we
+// check that the attach limit of the driver on the node is not exceeded, counting the volumes of tasks allocated in the
+// session, so that a gang isn't allocated on nodes its volumes can't attach to. For all other types of storage, we rely
 // on the predicates.
 func (ni *NodeInfo) isTaskStorageAllocatable(task *pod_info.PodInfo) (bool, error) {
 	if deletedStorageClaims := task.GetDeletedStorageClaimsNames(); len(deletedStorageClaims) > 0 {
@@ -208,10 +305,29 @@ func (ni *NodeInfo) isTaskStorageAllocatable(task *pod_info.PodInfo) (bool, erro
 		}
 	}
 
+	if attachErr := ni.areTaskVolumesAttachable(task); attachErr != nil {
+		err = multierr.Append(err, attachErr)
+	}
+
 	allocatable := err == nil
 	return allocatable, err
 }
 
+func (ni *NodeInfo) areTaskVolumesAttachable(task *pod_info.PodInfo) error {
+	claimsByLimit := map[*csidriver_info.NodeVolumeLimit][]storageclaim_info.Key{}
+	for _, claim := range task.GetAllStorageClaims() {
+		if limit, found := ni.CSIVolumeLimits[claim.StorageClass]; found {
+			claimsByLimit[limit] = append(claimsByLimit[limit], claim.Key)
+		}
+	}
+
+	var err error
+	for limit, claims := range claimsByLimit {
+		err = multierr.Append(err, limit.AreClaimsAttachable(claims))
+	}
+	return err
+}
+
 func isTaskStorageAllocatableOnCapacities(pvcs []*storageclaim_info.StorageClaimInfo,
 	capacities []*sc_info.StorageCapacityInfo) bool {
 	for _, capacity := range capacities {
@@ -418,6 +534,12 @@ func (ni *NodeInfo) AddTasksToNode(podInfos []*pod_info.PodInfo,
 }
 
 func (ni *NodeInfo) addTaskStorage(task *pod_info.PodInfo) {
+	for _, claim := range task.GetAllStorageClaims() {
+		if limit, found := ni.CSIVolumeLimits[claim.StorageClass]; found {
+			limit.AddClaim(claim.Key)
+		}
+	}
+
 	claims := task.GetUnboundOrReleasingStorageClaimsByStorageClass()
 	for storageClass, storageClassClaims := range claims {
 		for _, claim := range storageClassClaims {
@@ -518,6 +640,12 @@ func (ni *NodeInfo) removeTaskResources(task *pod_info.PodInfo) {
 }
 
 func (ni *NodeInfo) removeTaskStorage(task *pod_info.PodInfo) {
+	for _, claim := range task.GetAllStorageClaims() {
+		if limit, found := ni.CSIVolumeLimits[claim.StorageClass]; found {
+			limit.RemoveClaim(claim.Key)
+		}
+	}
+
 	claims := task.GetUnboundOrReleasingStorageClaimsByStorageClass()
 	for storageClass, storageClassClaims := range claims {
 		for _, claim := range storageClassClaims {
@@ -640,8 +768,7 @@ func (ni *NodeInfo) isValidGpuPortion(res *resource_info.ResourceRequirements) b
 func getNodeGpuMemory(node *v1.Node) (int64, bool) {
 	gpuMemoryLabelValue, err := strconv.ParseInt(node.Labels[GpuMemoryLabel], 10, 64)
 	if err != nil {
-		log.InfraLogger.V(6).Infof("Could not find gpu memory label %v on node %v", GpuMemoryLabel, node.Name)
-		return DefaultGpuMemory, false
+		return getNodeAcceleratorMemory(node)
 	}
 
 	// This code is a fix to cover for the Gpu-feature-discovery bug
@@ -653,6 +780,17 @@ func getNodeGpuMemory(node *v1.Node) (int64, bool) {
 	return gpuMemoryLabelValue - (gpuMemoryLabelValue % 100), true // Floor the memory count to make sure its divided by 100 so there will not be 2 jobs that get same bytes
 }
 
+// getNodeAcceleratorMemory returns the device memory published by the node labels of other accelerator vendors
+func getNodeAcceleratorMemory(node *v1.Node) (int64, bool) {
+	deviceMemory, err := commonresources.NodeDeviceMemory(node)
+	if err != nil {
+		log.InfraLogger.V(6).Infof("Could not find gpu memory of node %v: %v", node.Name, err)
+		return DefaultGpuMemory, false
+	}
+	gpuMemory := int64(deviceMemory)
+	return gpuMemory - (gpuMemory % 100), true
+}
+
 func checkGpuMemoryIsInMib(gpuMemoryValue int64) bool {
 	return gpuMemoryValue < TibInMib
 }
@@ -661,6 +799,12 @@ func convertBytesToMib(gpuMemoryValue int64) int64 {
 	return gpuMemoryValue / BitToMib
 }
 
+// IsCordoned returns true if the node is marked unschedulable. Pods that already run on the node keep running, so
+// their usage still counts against their queues, but the node's capacity is not available for scheduling.
+func (ni *NodeInfo) IsCordoned() bool {
+	return ni.Node != nil && ni.Node.Spec.Unschedulable
+}
+
 func (ni *NodeInfo) IsCPUOnlyNode() bool {
 	if ni.IsMIGEnabled() {
 		return false
@@ -703,7 +847,8 @@ func (ni *NodeInfo) GetRequiredInitQuota(pi *pod_info.PodInfo) *podgroup_info.Jo
 	if len(pi.ResReq.MigResources()) != 0 {
 		quota.GPU = pi.ResReq.GetGpusQuota()
 	} else {
-		quota.GPU = ni.getGpuMemoryFractionalOnNode(ni.GetResourceGpuMemory(pi.ResReq))
+		quota.GPU = ni.getGpuMemoryFractionalOnNode(ni.GetResourceGpuMemory(pi.ResReq)) *
+			float64(max(pi.ResReq.GetNumOfGpuDevices(), 1))
 	}
 	quota.MilliCPU = pi.ResReq.Cpu()
 	quota.Memory = pi.ResReq.Memory()
@@ -14,9 +14,11 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/csidriver_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_affinity"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
@@ -124,6 +126,8 @@ func TestNodeInfoStorage_AddPod(t *testing.T) {
 		AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{
 			storageCapacity.StorageClass: {expectedStorageCapacity},
 		},
+
+		CSIVolumeLimits: map[common_info.StorageClassID]*csidriver_info.NodeVolumeLimit{},
 	}
 	for _, podInfo := range node1ExpectedNodeInfo.PodInfos {
 		node1ExpectedNodeInfo.setAcceptedResources(podInfo)
@@ -287,3 +291,56 @@ func TestIsTaskStorageAllocatableStorageCapacity(t *testing.T) {
 	assert.Error(t, err)
 	assert.False(t, allocatable)
 }
+
+func TestIsTaskStorageAllocatableVolumeLimit(t *testing.T) {
+	testNamespace := "test"
+	storageClass := common_info.StorageClassID("storage-class")
+	newClaim := func(name string) *storageclaim_info.StorageClaimInfo {
+		return &storageclaim_info.StorageClaimInfo{
+			Key:          storageclaim_info.NewKey(testNamespace, name),
+			Name:         name,
+			Namespace:    testNamespace,
+			Size:         resource.NewQuantity(10, resource.BinarySI),
+			Phase:        v1.ClaimBound,
+			StorageClass: storageClass,
+		}
+	}
+	newPod := func(name string, claims ...*storageclaim_info.StorageClaimInfo) *pod_info.PodInfo {
+		podInfo := pod_info.NewTaskInfo(&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace, UID: types.UID(name)},
+		})
+		for _, claim := range claims {
+			podInfo.UpsertStorageClaim(claim)
+		}
+		return podInfo
+	}
+
+	controller := NewController(t)
+	nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(controller)
+	nodePodAffinityInfo.EXPECT().AddPod(Any()).AnyTimes()
+	nodePodAffinityInfo.EXPECT().RemovePod(Any()).AnyTimes()
+	nodeInfo := NewNodeInfo(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}, nodePodAffinityInfo)
+	nodeInfo.CSIVolumeLimits[storageClass] = csidriver_info.NewNodeVolumeLimit("csi-driver", 2)
+
+	sharedClaim := newClaim("shared")
+	firstPod := newPod("first", sharedClaim, newClaim("first"))
+	allocatable, err := nodeInfo.isTaskStorageAllocatable(firstPod)
+	assert.Nil(t, err)
+	assert.True(t, allocatable)
+	assert.Nil(t, nodeInfo.AddTask(firstPod))
+
+	sharingPod := newPod("sharing", sharedClaim)
+	allocatable, err = nodeInfo.isTaskStorageAllocatable(sharingPod)
+	assert.Nil(t, err, "attached volumes don't count against the limit again")
+	assert.True(t, allocatable)
+
+	secondPod := newPod("second", newClaim("second"))
+	allocatable, err = nodeInfo.isTaskStorageAllocatable(secondPod)
+	assert.Error(t, err)
+	assert.False(t, allocatable)
+
+	assert.Nil(t, nodeInfo.RemoveTask(firstPod))
+	allocatable, err = nodeInfo.isTaskStorageAllocatable(secondPod)
+	assert.Nil(t, err)
+	assert.True(t, allocatable)
+}
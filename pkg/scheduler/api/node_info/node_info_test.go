@@ -36,6 +36,7 @@ import (
 
 	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/csidriver_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_affinity"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
@@ -124,6 +125,7 @@ func TestNodeInfo_AddPod(t *testing.T) {
 		MemoryOfEveryGpuOnNode:      DefaultGpuMemory,
 		GpuSharingNodeInfo:          *newGpuSharingNodeInfo(),
 		AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{},
+		CSIVolumeLimits:             map[common_info.StorageClassID]*csidriver_info.NodeVolumeLimit{},
 	}
 	for _, podInfo := range node1ExpectedNodeInfo.PodInfos {
 		node1ExpectedNodeInfo.setAcceptedResources(podInfo)
@@ -173,6 +175,7 @@ func TestNodeInfo_RemovePod(t *testing.T) {
 		MemoryOfEveryGpuOnNode:      DefaultGpuMemory,
 		GpuSharingNodeInfo:          *newGpuSharingNodeInfo(),
 		AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{},
+		CSIVolumeLimits:             map[common_info.StorageClassID]*csidriver_info.NodeVolumeLimit{},
 	}
 	node1ExpectedNodeInfo.setAcceptedResources(pod1PodInfo)
 	node1ExpectedNodeInfo.setAcceptedResources(pod3PodInfo)
@@ -239,6 +242,7 @@ func TestAddRemovePods(t *testing.T) {
 					return sharingMaps
 				}(),
 				AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{},
+				CSIVolumeLimits:             map[common_info.StorageClassID]*csidriver_info.NodeVolumeLimit{},
 			},
 			removedPodsNodeInfo: &NodeInfo{
 				Name:                   "n1",
@@ -257,6 +261,7 @@ func TestAddRemovePods(t *testing.T) {
 					return sharingMaps
 				}(),
 				AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{},
+				CSIVolumeLimits:             map[common_info.StorageClassID]*csidriver_info.NodeVolumeLimit{},
 			},
 		},
 		{
@@ -306,6 +311,7 @@ func TestAddRemovePods(t *testing.T) {
 					return sharingMaps
 				}(),
 				AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{},
+				CSIVolumeLimits:             map[common_info.StorageClassID]*csidriver_info.NodeVolumeLimit{},
 			},
 			removedPodsNodeInfo: &NodeInfo{
 				Name:                   "n1",
@@ -326,6 +332,7 @@ func TestAddRemovePods(t *testing.T) {
 					return sharingMaps
 				}(),
 				AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{},
+				CSIVolumeLimits:             map[common_info.StorageClassID]*csidriver_info.NodeVolumeLimit{},
 			},
 		},
 		{
@@ -381,6 +388,7 @@ func TestAddRemovePods(t *testing.T) {
 					return sharingMaps
 				}(),
 				AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{},
+				CSIVolumeLimits:             map[common_info.StorageClassID]*csidriver_info.NodeVolumeLimit{},
 			},
 			removedPodsNodeInfo: &NodeInfo{
 				Name:                   "n1",
@@ -399,6 +407,7 @@ func TestAddRemovePods(t *testing.T) {
 					return sharingMaps
 				}(),
 				AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{},
+				CSIVolumeLimits:             map[common_info.StorageClassID]*csidriver_info.NodeVolumeLimit{},
 			},
 		},
 	}
@@ -619,6 +628,56 @@ func TestIsTaskAllocatableOnReleasingOrIdle(t *testing.T) {
 	}
 }
 
+func TestIsTaskAllocatableOnIdleOrReleasedBy(t *testing.T) {
+	now := time.Now()
+	controller := NewController(t)
+	nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(controller)
+	nodePodAffinityInfo.EXPECT().AddPod(Any()).Times(3)
+	ni := NewNodeInfo(
+		common_info.BuildNode("n1", common_info.BuildResourceListWithGPU("8000m", "8G", "8")), nodePodAffinityInfo)
+
+	terminatingPods := map[string]time.Time{"releasing-soon": now.Add(10 * time.Second), "releasing-late": now.Add(time.Hour)}
+	for name, deletionTime := range terminatingPods {
+		pod := common_info.BuildPod("p1", name, "n1", v1.PodRunning,
+			common_info.BuildResourceListWithGPU("1000m", "1G", "3"),
+			[]metav1.OwnerReference{}, make(map[string]string), map[string]string{})
+		pod.DeletionTimestamp = &metav1.Time{Time: deletionTime}
+		addJobAnnotation(pod)
+		assert.NoError(t, ni.AddTask(pod_info.NewTaskInfo(pod)))
+	}
+	runningPod := common_info.BuildPod("p1", "running", "n1", v1.PodRunning,
+		common_info.BuildResourceListWithGPU("1000m", "1G", "1"),
+		[]metav1.OwnerReference{}, make(map[string]string), map[string]string{})
+	addJobAnnotation(runningPod)
+	assert.NoError(t, ni.AddTask(pod_info.NewTaskInfo(runningPod)))
+
+	newTask := func(gpus string) *pod_info.PodInfo {
+		pod := common_info.BuildPod("p1", "pending", "", v1.PodPending,
+			common_info.BuildResourceListWithGPU("1000m", "1G", gpus),
+			[]metav1.OwnerReference{}, make(map[string]string), map[string]string{})
+		addJobAnnotation(pod)
+		return pod_info.NewTaskInfo(pod)
+	}
+
+	tests := map[string]struct {
+		gpus     string
+		deadline time.Time
+		expected bool
+	}{
+		"fits the idle resources":                     {gpus: "1", deadline: now, expected: true},
+		"fits the resources released by the deadline": {gpus: "4", deadline: now.Add(time.Minute), expected: true},
+		"needs resources released after the deadline": {gpus: "5", deadline: now.Add(time.Minute), expected: false},
+		"fits the resources of all terminating pods":  {gpus: "7", deadline: now.Add(2 * time.Hour), expected: true},
+		"exceeds the resources of the node":           {gpus: "8", deadline: now.Add(2 * time.Hour), expected: false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ni.IsTaskAllocatableOnIdleOrReleasedBy(newTask(tt.gpus), now, tt.deadline))
+		})
+	}
+	assert.Equal(t, float64(3), ni.ReleasedAfter(now, now.Add(time.Minute)).GPUs())
+}
+
 func runAllocatableTest(
 	t *testing.T, testData allocatableTestData, testName string,
 	testedFunction allocatableTestFunction,
@@ -675,6 +734,77 @@ func runAllocatableTest(
 	}
 }
 
+func TestNumTaskCopiesAllocatable(t *testing.T) {
+	tests := map[string]struct {
+		nodeResources          v1.ResourceList
+		podsResources          []v1.ResourceList
+		podResourcesToAllocate v1.ResourceList
+		expected               int
+	}{
+		"copies limited by idle gpus": {
+			nodeResources:          common_info.BuildResourceListWithGPU("8000m", "8G", "4"),
+			podsResources:          []v1.ResourceList{common_info.BuildResourceListWithGPU("1000m", "1G", "1")},
+			podResourcesToAllocate: common_info.BuildResourceListWithGPU("1000m", "1G", "1"),
+			expected:               3,
+		},
+		"copies limited by idle cpu": {
+			nodeResources:          common_info.BuildResourceListWithGPU("3000m", "8G", "4"),
+			podsResources:          []v1.ResourceList{},
+			podResourcesToAllocate: common_info.BuildResourceListWithGPU("1000m", "1G", "1"),
+			expected:               3,
+		},
+		"copies limited by the number of pods of the node": {
+			nodeResources: func() v1.ResourceList {
+				resources := common_info.BuildResourceListWithGPU("8000m", "8G", "4")
+				resources[v1.ResourcePods] = resource.MustParse("2")
+				return resources
+			}(),
+			podsResources:          []v1.ResourceList{common_info.BuildResourceList("1000m", "1G")},
+			podResourcesToAllocate: common_info.BuildResourceListWithGPU("1000m", "1G", "1"),
+			expected:               1,
+		},
+		"task that doesn't fit": {
+			nodeResources:          common_info.BuildResourceListWithGPU("8000m", "8G", "1"),
+			podsResources:          []v1.ResourceList{common_info.BuildResourceListWithGPU("1000m", "1G", "1")},
+			podResourcesToAllocate: common_info.BuildResourceListWithGPU("1000m", "1G", "1"),
+			expected:               0,
+		},
+	}
+
+	for testName, testData := range tests {
+		t.Run(testName, func(t *testing.T) {
+			node := common_info.BuildNode("n1", testData.nodeResources)
+			if _, found := node.Status.Allocatable[v1.ResourcePods]; !found {
+				node.Status.Allocatable[v1.ResourcePods] = resource.MustParse("110")
+			}
+
+			controller := NewController(t)
+			nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(controller)
+			nodePodAffinityInfo.EXPECT().AddPod(Any()).Times(len(testData.podsResources))
+
+			ni := NewNodeInfo(node, nodePodAffinityInfo)
+			for ind, podResources := range testData.podsResources {
+				pod := common_info.BuildPod(
+					fmt.Sprintf("p%d", ind), "p1", "n1", v1.PodRunning, podResources,
+					[]metav1.OwnerReference{}, make(map[string]string), map[string]string{})
+				addJobAnnotation(pod)
+				if err := ni.AddTask(pod_info.NewTaskInfo(pod)); err != nil {
+					t.Fatalf("failed to add pod %d: %v", ind, err)
+				}
+			}
+			pod := common_info.BuildPod(
+				"podToAllocate", "p1", "", v1.PodPending, testData.podResourcesToAllocate,
+				[]metav1.OwnerReference{}, make(map[string]string), map[string]string{})
+			addJobAnnotation(pod)
+
+			copies := ni.NumTaskCopiesAllocatable(pod_info.NewTaskInfo(pod))
+			if copies != testData.expected {
+				t.Errorf("expected %d copies, got %d", testData.expected, copies)
+			}
+		})
+	}
+}
+
 func TestGpuOperatorHasMemoryError_MibInput(t *testing.T) {
 	testNode := common_info.BuildNode("n1", common_info.BuildResourceList("8000m", "10G"))
 	testNode.Labels[GpuMemoryLabel] = "4096"
@@ -1151,3 +1281,16 @@ func TestIsCPUOnlyNode_DRA(t *testing.T) {
 	}
 	assert.True(t, cpuOnlyNode.IsCPUOnlyNode(), "node without GPUs and without HasDRAGPUs should be CPU-only")
 }
+
+func TestGetNodeGpuMemory_OtherAcceleratorVendors(t *testing.T) {
+	testNode := common_info.BuildNode("n1", common_info.BuildResourceList("8000m", "10G"))
+	testNode.Labels["beta.amd.com/gpu.vram.16G"] = "1"
+	gpuMemoryInMb, ok := getNodeGpuMemory(testNode)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, int64(16000), gpuMemoryInMb)
+
+	testNode = common_info.BuildNode("n2", common_info.BuildResourceList("8000m", "10G"))
+	gpuMemoryInMb, ok = getNodeGpuMemory(testNode)
+	assert.Equal(t, false, ok)
+	assert.Equal(t, int64(DefaultGpuMemory), gpuMemoryInMb)
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package node_info
+
+import (
+	"math"
+	"strconv"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// ReserveForOtherSchedulers removes from the idle resources of the node the resources that pods of other schedulers
+// may use without the scheduler knowing about them yet, so that the schedulers sharing the node don't allocate the
+// same resources:
+//   - Pods nominated to the node by other schedulers, which are about to be bound to it.
+//   - The percentage of the node set by the OtherSchedulersReservedPercentage annotation, which covers pods that other
+//     schedulers assumed on the node and didn't bind yet. Pods of other schedulers that are already on the node use
+//     this percentage first.
+func (ni *NodeInfo) ReserveForOtherSchedulers(schedulerName string, nominatedPods []*pod_info.PodInfo) {
+	nominated := resource_info.EmptyResource()
+	for _, pod := range nominatedPods {
+		nominated.AddResourceRequirements(pod.ResReq)
+	}
+
+	reserved := nominated
+	if percentage := ni.otherSchedulersReservedPercentage(); percentage > 0 {
+		reservedShare := resource_info.NewResource(
+			ni.Allocatable.Cpu()*percentage/100,
+			ni.Allocatable.Memory()*percentage/100,
+			math.Ceil(ni.Allocatable.GPUs()*percentage/100))
+		reservedShare.Sub(ni.otherSchedulersUsedResources(schedulerName))
+		reserved = maxResource(nominated, reservedShare)
+	}
+
+	reserved = minResource(reserved, ni.Idle)
+	ni.Idle.Sub(reserved)
+	ni.ReservedForOtherSchedulers = reserved
+
+	log.InfraLogger.V(6).Infof("Node <%s> reserved <%v> for pods of other schedulers", ni.Name, reserved)
+}
+
+func (ni *NodeInfo) otherSchedulersReservedPercentage() float64 {
+	value, found := ni.Node.Annotations[commonconstants.OtherSchedulersReservedPercentage]
+	if !found {
+		return 0
+	}
+	percentage, err := strconv.ParseFloat(value, 64)
+	if err != nil || percentage < 0 || percentage > 100 {
+		log.InfraLogger.V(2).Warnf("Invalid %s annotation value <%s> on node <%s>, expected a percentage",
+			commonconstants.OtherSchedulersReservedPercentage, value, ni.Name)
+		return 0
+	}
+	return percentage
+}
+
+func (ni *NodeInfo) otherSchedulersUsedResources(schedulerName string) *resource_info.Resource {
+	used := resource_info.EmptyResource()
+	for _, podInfo := range ni.PodInfos {
+		if podInfo.Pod.Spec.SchedulerName != schedulerName &&
+			pod_status.IsActiveUsedStatus(podInfo.Status) &&
+			!pod_info.IsKaiUtilityPod(podInfo.Pod) {
+			used.AddResourceRequirements(podInfo.ResReq)
+		}
+	}
+	return used
+}
+
+func maxResource(l, r *resource_info.Resource) *resource_info.Resource {
+	return resource_info.NewResource(
+		max(l.Cpu(), r.Cpu(), 0), max(l.Memory(), r.Memory(), 0), max(l.GPUs(), r.GPUs(), 0))
+}
+
+func minResource(l, r *resource_info.Resource) *resource_info.Resource {
+	return resource_info.NewResource(
+		max(min(l.Cpu(), r.Cpu()), 0), max(min(l.Memory(), r.Memory()), 0), max(min(l.GPUs(), r.GPUs()), 0))
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package node_info
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_affinity"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+)
+
+const (
+	kaiSchedulerName   = "kai-scheduler"
+	otherSchedulerName = "default-scheduler"
+)
+
+func TestReserveForOtherSchedulers(t *testing.T) {
+	tests := []struct {
+		name             string
+		reservedPercent  string
+		pods             []*v1.Pod
+		nominatedPods    []*v1.Pod
+		expectedReserved *resource_info.Resource
+		expectedIdle     *resource_info.Resource
+	}{
+		{
+			name:             "nothing to reserve",
+			expectedReserved: common_info.BuildResourceWithGpu("0", "0", "0"),
+			expectedIdle:     common_info.BuildResourceWithGpu("8", "10G", "8"),
+		},
+		{
+			name: "pod nominated by another scheduler",
+			nominatedPods: []*v1.Pod{
+				buildSchedulerPod("p1", "", otherSchedulerName, "2", "2G", "2"),
+			},
+			expectedReserved: common_info.BuildResourceWithGpu("2", "2G", "2"),
+			expectedIdle:     common_info.BuildResourceWithGpu("6", "8G", "6"),
+		},
+		{
+			name:             "reserved percentage",
+			reservedPercent:  "25",
+			expectedReserved: common_info.BuildResourceWithGpu("2", "2.5G", "2"),
+			expectedIdle:     common_info.BuildResourceWithGpu("6", "7.5G", "6"),
+		},
+		{
+			name:             "reserved percentage is rounded up to whole gpus",
+			reservedPercent:  "10",
+			expectedReserved: common_info.BuildResourceWithGpu("800m", "1G", "1"),
+			expectedIdle:     common_info.BuildResourceWithGpu("7200m", "9G", "7"),
+		},
+		{
+			name:            "reserved percentage with pods of another scheduler on the node",
+			reservedPercent: "25",
+			pods: []*v1.Pod{
+				buildSchedulerPod("p1", "n1", otherSchedulerName, "1", "1G", "1"),
+			},
+			expectedReserved: common_info.BuildResourceWithGpu("1", "1.5G", "1"),
+			expectedIdle:     common_info.BuildResourceWithGpu("6", "7.5G", "6"),
+		},
+		{
+			name:            "reserved percentage with pods of the scheduler on the node",
+			reservedPercent: "25",
+			pods: []*v1.Pod{
+				buildSchedulerPod("p1", "n1", kaiSchedulerName, "1", "1G", "1"),
+			},
+			expectedReserved: common_info.BuildResourceWithGpu("2", "2.5G", "2"),
+			expectedIdle:     common_info.BuildResourceWithGpu("5", "6.5G", "5"),
+		},
+		{
+			name:            "nominated pods exceeding the reserved percentage",
+			reservedPercent: "25",
+			pods: []*v1.Pod{
+				buildSchedulerPod("p1", "n1", otherSchedulerName, "1", "1G", "1"),
+			},
+			nominatedPods: []*v1.Pod{
+				buildSchedulerPod("p2", "", otherSchedulerName, "1", "1G", "3"),
+			},
+			expectedReserved: common_info.BuildResourceWithGpu("1", "1.5G", "3"),
+			expectedIdle:     common_info.BuildResourceWithGpu("6", "7.5G", "4"),
+		},
+		{
+			name:            "reservation is limited by the idle resources",
+			reservedPercent: "25",
+			pods: []*v1.Pod{
+				buildSchedulerPod("p1", "n1", kaiSchedulerName, "7", "1G", "7"),
+			},
+			expectedReserved: common_info.BuildResourceWithGpu("1", "2.5G", "1"),
+			expectedIdle:     common_info.BuildResourceWithGpu("0", "6.5G", "0"),
+		},
+		{
+			name:             "invalid reserved percentage",
+			reservedPercent:  "a quarter",
+			expectedReserved: common_info.BuildResourceWithGpu("0", "0", "0"),
+			expectedIdle:     common_info.BuildResourceWithGpu("8", "10G", "8"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := common_info.BuildNode("n1", common_info.BuildResourceListWithGPU("8", "10G", "8"))
+			if tt.reservedPercent != "" {
+				node.Annotations[commonconstants.OtherSchedulersReservedPercentage] = tt.reservedPercent
+			}
+			controller := gomock.NewController(t)
+			nodePodAffinityInfo := pod_affinity.NewMockNodePodAffinityInfo(controller)
+			nodePodAffinityInfo.EXPECT().AddPod(gomock.Any()).Times(len(tt.pods))
+			nodeInfo := NewNodeInfo(node, nodePodAffinityInfo)
+			for _, pod := range tt.pods {
+				assert.NoError(t, nodeInfo.AddTask(pod_info.NewTaskInfo(pod)))
+			}
+			var nominatedPods []*pod_info.PodInfo
+			for _, pod := range tt.nominatedPods {
+				nominatedPods = append(nominatedPods, pod_info.NewTaskInfo(pod))
+			}
+
+			nodeInfo.ReserveForOtherSchedulers(kaiSchedulerName, nominatedPods)
+
+			assertResourceEqual(t, tt.expectedReserved, nodeInfo.ReservedForOtherSchedulers)
+			assertResourceEqual(t, tt.expectedIdle, nodeInfo.Idle)
+		})
+	}
+}
+
+func buildSchedulerPod(name, nodeName, schedulerName, cpu, memory, gpus string) *v1.Pod {
+	pod := common_info.BuildPod("ns", name, nodeName, v1.PodRunning,
+		common_info.BuildResourceListWithGPU(cpu, memory, gpus), []metav1.OwnerReference{},
+		map[string]string{}, map[string]string{})
+	pod.Spec.SchedulerName = schedulerName
+	if nodeName == "" {
+		pod.Status.Phase = v1.PodPending
+		pod.Status.NominatedNodeName = "n1"
+	}
+	return pod
+}
+
+func assertResourceEqual(t *testing.T, expected, actual *resource_info.Resource) {
+	assert.InDelta(t, expected.Cpu(), actual.Cpu(), 0.001)
+	assert.InDelta(t, expected.Memory(), actual.Memory(), 0.001)
+	assert.InDelta(t, expected.GPUs(), actual.GPUs(), 0.001)
+}
@@ -21,8 +21,10 @@ package pod_info
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	resourceapi "k8s.io/api/resource/v1"
@@ -65,6 +67,13 @@ const (
 
 type PodsMap map[common_info.PodID]*PodInfo
 
+// GpuCountRange is the range of whole GPUs requested by a pod. The scheduler allocates as many GPUs as it can, up
+// to Max and no less than Min.
+type GpuCountRange struct {
+	Min int64
+	Max int64
+}
+
 type PodInfo struct {
 	UID common_info.PodID
 	Job common_info.PodGroupID
@@ -85,6 +94,9 @@ type PodInfo struct {
 
 	GPUGroups []string
 
+	// GpuCountRange is set for pods requesting a range of GPUs
+	GpuCountRange *GpuCountRange
+
 	NodeName        string
 	Status          pod_status.PodStatus
 	IsVirtualStatus bool
@@ -161,13 +173,35 @@ func (pi *PodInfo) UpsertStorageClaim(claimInfo *storageclaim_info.StorageClaimI
 	pi.storageClaims[claimInfo.Key] = claimInfo
 }
 
+// EstimatedReleaseTime returns when the resources of a terminating pod are expected to be released: at the end of
+// its grace period, or, for a pod that the session evicts and isn't deleted yet, at the end of the grace period that
+// its deletion would give it. Pods that exit on termination release their resources earlier.
+func (pi *PodInfo) EstimatedReleaseTime(now time.Time) time.Time {
+	if pi.Pod == nil {
+		return now
+	}
+	if pi.Pod.DeletionTimestamp != nil {
+		return pi.Pod.DeletionTimestamp.Time
+	}
+	gracePeriod := int64(v1.DefaultTerminationGracePeriodSeconds)
+	if pi.Pod.Spec.TerminationGracePeriodSeconds != nil {
+		gracePeriod = *pi.Pod.Spec.TerminationGracePeriodSeconds
+	}
+	return now.Add(time.Duration(gracePeriod) * time.Second)
+}
+
 func NewTaskInfo(pod *v1.Pod, draPodClaims ...*resourceapi.ResourceClaim) *PodInfo {
 	return NewTaskInfoWithBindRequest(pod, nil, draPodClaims...)
 }
 
 func NewTaskInfoWithBindRequest(pod *v1.Pod, bindRequest *bindrequest_info.BindRequestInfo, draPodClaims ...*resourceapi.ResourceClaim) *PodInfo {
-	initResreq := getPodResourceRequest(pod)
+	return NewTaskInfoWithResourceRequest(pod, GetPodResourceRequest(pod), bindRequest, draPodClaims...)
+}
 
+// NewTaskInfoWithResourceRequest creates a PodInfo from a pre-computed pod resource request (see GetPodResourceRequest).
+// The PodInfo takes ownership of initResreq.
+func NewTaskInfoWithResourceRequest(pod *v1.Pod, initResreq *resource_info.ResourceRequirements,
+	bindRequest *bindrequest_info.BindRequestInfo, draPodClaims ...*resourceapi.ResourceClaim) *PodInfo {
 	nodeName := pod.Spec.NodeName
 	if nodeName == "" && bindRequest != nil {
 		nodeName = bindRequest.BindRequest.Spec.SelectedNode
@@ -212,6 +246,7 @@ func (pi *PodInfo) Clone() *PodInfo {
 		ResReq:               pi.ResReq.Clone(),
 		AcceptedResource:     pi.AcceptedResource.Clone(),
 		GPUGroups:            pi.GPUGroups,
+		GpuCountRange:        pi.GpuCountRange,
 		ResourceClaimInfo:    pi.ResourceClaimInfo.Clone(),
 		ResourceRequestType:  pi.ResourceRequestType,
 		ResourceReceivedType: pi.ResourceReceivedType,
@@ -300,39 +335,10 @@ func getPodGroupID(pod *v1.Pod) common_info.PodGroupID {
 	return ""
 }
 
-func getPodResourceRequest(pod *v1.Pod) *resource_info.ResourceRequirements {
-	result := getPodResourceWithoutInitContainers(pod)
-
-	// take max_resource(sum_pod, any_init_container)
-	for _, container := range pod.Spec.InitContainers {
-		err := result.SetMaxResource(resource_info.RequirementsFromResourceList(container.Resources.Requests))
-		if err != nil {
-			log.InfraLogger.Errorf("Failed to calculate pod required resources for pod %s/%s. Error: %s",
-				pod.Namespace, pod.Name, err.Error())
-		}
-	}
-
-	if pod.Spec.Overhead != nil {
-		overheadReq := resource_info.RequirementsFromResourceList(pod.Spec.Overhead)
-		result.Add(&overheadReq.BaseResource)
-	}
-
-	return result
-}
-
-// getPodResourceWithoutInitContainers returns Pod's resource request, it does not contain
-// init containers' resource request.
-func getPodResourceWithoutInitContainers(pod *v1.Pod) *resource_info.ResourceRequirements {
-	podResourcesList := v1.ResourceList{}
-	for _, container := range pod.Spec.Containers {
-		for key := range container.Resources.Requests {
-			resourceSum := podResourcesList[key]
-			resourceSum.Add(container.Resources.Requests[key])
-			podResourcesList[key] = resourceSum
-		}
-	}
-
-	return resource_info.RequirementsFromResourceList(podResourcesList)
+// GetPodResourceRequest returns the resources required to launch the pod, accounting for init containers, sidecars
+// and the pod overhead (see resources.PodRequests).
+func GetPodResourceRequest(pod *v1.Pod) *resource_info.ResourceRequirements {
+	return resource_info.RequirementsFromResourceList(resources.PodRequests(pod))
 }
 
 func getTaskStatus(pod *v1.Pod, bindRequest *bindrequest_info.BindRequestInfo) pod_status.PodStatus {
@@ -364,14 +370,26 @@ func getTaskStatus(pod *v1.Pod, bindRequest *bindrequest_info.BindRequestInfo) p
 	case v1.PodUnknown:
 		return pod_status.Unknown
 	case v1.PodSucceeded:
+		if isReleasingGpuSharing(pod) {
+			return pod_status.Releasing
+		}
 		return pod_status.Succeeded
 	case v1.PodFailed:
+		if isReleasingGpuSharing(pod) {
+			return pod_status.Releasing
+		}
 		return pod_status.Failed
 	}
 
 	return pod_status.Unknown
 }
 
+// isReleasingGpuSharing returns true for terminated GPU sharing pods whose GPU reservation the binder didn't release
+// yet, whose share of the GPU is still held on the node
+func isReleasingGpuSharing(pod *v1.Pod) bool {
+	return slices.Contains(pod.Finalizers, commonconstants.GpuSharingReleaseFinalizer)
+}
+
 func (pi *PodInfo) updatePodAdditionalFields(bindRequest *bindrequest_info.BindRequestInfo, draPodClaims ...*resourceapi.ResourceClaim) {
 	if bindRequest != nil && len(bindRequest.BindRequest.Spec.SelectedGPUGroups) > 0 {
 		pi.GPUGroups = bindRequest.BindRequest.Spec.SelectedGPUGroups
@@ -413,6 +431,8 @@ func (pi *PodInfo) updatePodAdditionalFields(bindRequest *bindrequest_info.BindR
 		}
 	}
 
+	pi.updateGpuCountRange(bindRequest)
+
 	if len(draPodClaims) > 0 {
 		draGpus := resources.ExtractDRAGPUResourcesFromClaims(draPodClaims)
 		pi.ResReq.GpuResourceRequirement.SetDraGpus(draGpus)
@@ -424,6 +444,46 @@ func (pi *PodInfo) updatePodAdditionalFields(bindRequest *bindrequest_info.BindR
 	}
 }
 
+// updateGpuCountRange sets the resource request of pods requesting a range of GPUs. The GPUs are allocated as whole
+// shared GPU devices, so that the scheduler can choose the number of devices. Pods that were already allocated request
+// the number of GPUs they were granted.
+func (pi *PodInfo) updateGpuCountRange(bindRequest *bindrequest_info.BindRequestInfo) {
+	if !resources.RequestsGPUCountRange(pi.Pod) || pi.IsSharedGPURequest() || pi.ResReq.GPUs() > 0 {
+		return
+	}
+	minCount, maxCount, err := resources.GetGPUCountRange(pi.Pod)
+	if err != nil {
+		log.InfraLogger.V(2).Infof("Could not parse the GPU count range of pod %s/%s: %v",
+			pi.Namespace, pi.Name, err)
+		return
+	}
+
+	pi.GpuCountRange = &GpuCountRange{Min: minCount, Max: maxCount}
+	pi.ResourceRequestType = RequestTypeFraction
+	count := maxCount
+	if grantedCount := getGrantedGpuCount(pi.Pod, bindRequest); grantedCount > 0 {
+		count = grantedCount
+	}
+	pi.SetGpuCount(count)
+}
+
+// SetGpuCount sets the number of GPUs requested by a pod requesting a range of GPUs
+func (pi *PodInfo) SetGpuCount(count int64) {
+	pi.ResReq.GpuResourceRequirement = *resource_info.NewGpuResourceRequirementWithMultiFraction(count, 1, 0)
+}
+
+func getGrantedGpuCount(pod *v1.Pod, bindRequest *bindrequest_info.BindRequestInfo) int64 {
+	if bindRequest != nil && bindRequest.BindRequest.Spec.ReceivedGPU != nil &&
+		bindRequest.BindRequest.Spec.ReceivedGPU.Count > 0 {
+		return int64(bindRequest.BindRequest.Spec.ReceivedGPU.Count)
+	}
+	grantedCount, err := strconv.ParseInt(pod.Annotations[commonconstants.GpuCountGranted], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return grantedCount
+}
+
 // updateLegacyMigResourceRequestFromAnnotations updates the mig resource request of legacy MIG pods
 func (pi *PodInfo) updateLegacyMigResourceRequestFromAnnotations() {
 	for annotationName, annotationValue := range pi.Pod.Annotations {
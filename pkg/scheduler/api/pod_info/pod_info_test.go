@@ -22,9 +22,12 @@ package pod_info
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"gotest.tools/assert"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
@@ -146,50 +149,38 @@ func TestGetPodResourceRequest(t *testing.T) {
 			},
 			expectedResource: resource_info.NewResourceRequirements(1, 4000, 3000000000),
 		},
-	}
-	for i, test := range tests {
-		req := getPodResourceRequest(test.pod)
-		if !reflect.DeepEqual(req, test.expectedResource) {
-			t.Errorf("case %d(%s) failed: \n expected %v, \n got: %v \n",
-				i, test.name, test.expectedResource, req)
-		}
-	}
-}
-
-func TestGetPodResourceWithoutInitContainers(t *testing.T) {
-	tests := []struct {
-		name             string
-		pod              *v1.Pod
-		expectedResource *resource_info.ResourceRequirements
-	}{
 		{
-			name: "get resource for pod without init containers",
+			name: "pod with a sidecar",
 			pod: &v1.Pod{
 				Spec: v1.PodSpec{
-					Containers: []v1.Container{
+					InitContainers: []v1.Container{
 						{
+							RestartPolicy: ptr.To(v1.ContainerRestartPolicyAlways),
 							Resources: v1.ResourceRequirements{
-								Requests: common_info.BuildResourceList("1000m", "1G"),
+								Requests: common_info.BuildResourceList("500m", "1G"),
 							},
 						},
+					},
+					Containers: []v1.Container{
 						{
 							Resources: v1.ResourceRequirements{
-								Requests: common_info.BuildResourceList("2000m", "1G"),
+								Requests: common_info.BuildResourceListWithGPU("1000m", "1G", "1"),
 							},
 						},
 					},
 				},
 			},
-			expectedResource: resource_info.RequirementsFromResourceList(common_info.BuildResourceList("3000m", "2G")),
+			expectedResource: resource_info.NewResourceRequirements(1, 1500, 2000000000),
 		},
 		{
-			name: "get resource for pod with init containers",
+			name: "init container runs next to the sidecars started before it",
 			pod: &v1.Pod{
 				Spec: v1.PodSpec{
 					InitContainers: []v1.Container{
 						{
+							RestartPolicy: ptr.To(v1.ContainerRestartPolicyAlways),
 							Resources: v1.ResourceRequirements{
-								Requests: common_info.BuildResourceList("2000m", "5G"),
+								Requests: common_info.BuildResourceList("500m", "1G"),
 							},
 						},
 						{
@@ -197,6 +188,12 @@ func TestGetPodResourceWithoutInitContainers(t *testing.T) {
 								Requests: common_info.BuildResourceList("2000m", "1G"),
 							},
 						},
+						{
+							RestartPolicy: ptr.To(v1.ContainerRestartPolicyAlways),
+							Resources: v1.ResourceRequirements{
+								Requests: common_info.BuildResourceList("500m", "1G"),
+							},
+						},
 					},
 					Containers: []v1.Container{
 						{
@@ -204,20 +201,37 @@ func TestGetPodResourceWithoutInitContainers(t *testing.T) {
 								Requests: common_info.BuildResourceList("1000m", "1G"),
 							},
 						},
+					},
+				},
+			},
+			expectedResource: resource_info.NewResourceRequirements(0, 2500, 3000000000),
+		},
+		{
+			name: "init container with overhead",
+			pod: &v1.Pod{
+				Spec: v1.PodSpec{
+					InitContainers: []v1.Container{
 						{
 							Resources: v1.ResourceRequirements{
-								Requests: common_info.BuildResourceList("2000m", "1G"),
+								Requests: common_info.BuildResourceList("4000m", "1G"),
 							},
 						},
 					},
+					Containers: []v1.Container{
+						{
+							Resources: v1.ResourceRequirements{
+								Requests: common_info.BuildResourceList("1000m", "2G"),
+							},
+						},
+					},
+					Overhead: common_info.BuildResourceList("1000m", "1G"),
 				},
 			},
-			expectedResource: resource_info.RequirementsFromResourceList(common_info.BuildResourceList("3000m", "2G")),
+			expectedResource: resource_info.NewResourceRequirements(0, 5000, 3000000000),
 		},
 	}
-
 	for i, test := range tests {
-		req := getPodResourceWithoutInitContainers(test.pod)
+		req := GetPodResourceRequest(test.pod)
 		if !reflect.DeepEqual(req, test.expectedResource) {
 			t.Errorf("case %d(%s) failed: \n expected %v, \n got: %v \n",
 				i, test.name, test.expectedResource, req)
@@ -490,6 +504,59 @@ func TestPodInfo_updatePodAdditionalFields(t *testing.T) {
 				GPUGroups:            nil,
 			},
 		},
+		{
+			"Gpu count range request",
+			podFields{
+				Job:       common_info.FakePogGroupId,
+				Name:      "p1",
+				Namespace: "ns1",
+				Status:    pod_status.Pending,
+				Pod: common_info.BuildPod("ns1", "p1", "", v1.PodPending,
+					common_info.BuildResourceList("2000m", "2G"),
+					nil,
+					map[string]string{},
+					map[string]string{
+						commonconstants.GpuCountMin: "4",
+						commonconstants.GpuCountMax: "8",
+					}),
+			},
+			expected{
+				InitResreq: &resource_info.ResourceRequirements{
+					GpuResourceRequirement: *resource_info.NewGpuResourceRequirementWithMultiFraction(
+						8, 1, 0),
+					BaseResource: *resource_info.EmptyBaseResource(),
+				},
+				ResourceRequestType: "Fraction",
+				IsChiefPod:          true,
+			},
+		},
+		{
+			"Gpu count range request with granted gpus",
+			podFields{
+				Job:       common_info.FakePogGroupId,
+				Name:      "p1",
+				Namespace: "ns1",
+				Status:    pod_status.Running,
+				Pod: common_info.BuildPod("ns1", "p1", "node1", v1.PodRunning,
+					common_info.BuildResourceList("2000m", "2G"),
+					nil,
+					map[string]string{},
+					map[string]string{
+						commonconstants.GpuCountMin:     "4",
+						commonconstants.GpuCountMax:     "8",
+						commonconstants.GpuCountGranted: "6",
+					}),
+			},
+			expected{
+				InitResreq: &resource_info.ResourceRequirements{
+					GpuResourceRequirement: *resource_info.NewGpuResourceRequirementWithMultiFraction(
+						6, 1, 0),
+					BaseResource: *resource_info.EmptyBaseResource(),
+				},
+				ResourceRequestType: "Fraction",
+				IsChiefPod:          true,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -595,3 +662,81 @@ func TestIsRequireAnyKindOfGPU_DRA(t *testing.T) {
 	assert.Assert(t, pi.IsRequireAnyKindOfGPU(), "pod with only DRA GPU requests should require GPU")
 	assert.Assert(t, !pi.IsCPUOnlyRequest(), "pod with only DRA GPU requests should not be CPU-only")
 }
+
+func TestGetTaskStatus_GpuSharingReleaseFinalizer(t *testing.T) {
+	tests := []struct {
+		name       string
+		phase      v1.PodPhase
+		finalizers []string
+		want       pod_status.PodStatus
+	}{
+		{
+			name:  "succeeded pod",
+			phase: v1.PodSucceeded,
+			want:  pod_status.Succeeded,
+		},
+		{
+			name:       "succeeded pod with unreleased gpu sharing",
+			phase:      v1.PodSucceeded,
+			finalizers: []string{commonconstants.GpuSharingReleaseFinalizer},
+			want:       pod_status.Releasing,
+		},
+		{
+			name:       "failed pod with unreleased gpu sharing",
+			phase:      v1.PodFailed,
+			finalizers: []string{commonconstants.GpuSharingReleaseFinalizer},
+			want:       pod_status.Releasing,
+		},
+		{
+			name:       "failed pod with another finalizer",
+			phase:      v1.PodFailed,
+			finalizers: []string{"other/finalizer"},
+			want:       pod_status.Failed,
+		},
+		{
+			name:       "running pod with gpu sharing",
+			phase:      v1.PodRunning,
+			finalizers: []string{commonconstants.GpuSharingReleaseFinalizer},
+			want:       pod_status.Running,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{Status: v1.PodStatus{Phase: tt.phase}}
+			pod.Finalizers = tt.finalizers
+			assert.Equal(t, getTaskStatus(pod, nil), tt.want)
+		})
+	}
+}
+
+func TestEstimatedReleaseTime(t *testing.T) {
+	now := time.Now()
+	deletionTime := now.Add(10 * time.Minute)
+	tests := []struct {
+		name string
+		pod  *v1.Pod
+		want time.Time
+	}{
+		{
+			name: "deleted pod is released at the end of its grace period",
+			pod:  &v1.Pod{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &metav1.Time{Time: deletionTime}}},
+			want: deletionTime,
+		},
+		{
+			name: "evicted pod is released after its termination grace period",
+			pod:  &v1.Pod{Spec: v1.PodSpec{TerminationGracePeriodSeconds: ptr.To(int64(3600))}},
+			want: now.Add(time.Hour),
+		},
+		{
+			name: "evicted pod without a termination grace period is released after the default grace period",
+			pod:  &v1.Pod{},
+			want: now.Add(v1.DefaultTerminationGracePeriodSeconds * time.Second),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pi := &PodInfo{Pod: tt.pod}
+			assert.Equal(t, pi.EstimatedReleaseTime(now), tt.want)
+		})
+	}
+}
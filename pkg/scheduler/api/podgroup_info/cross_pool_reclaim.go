@@ -0,0 +1,70 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package podgroup_info
+
+import (
+	"maps"
+	"strconv"
+	"strings"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+// CrossPoolReclaimInfo is the state of the reclaim for a pending job in the other node pools that its queue is
+// entitled to. The scheduler of the job's node pool requests the reclaim costs with a label on the pod group, the
+// schedulers of the other node pools publish their costs in annotations, and the job moves to the node pool with the
+// cheapest reclaim by changing its node pool label.
+type CrossPoolReclaimInfo struct {
+	// Requested is true while the job asks the schedulers of the other node pools for their reclaim costs
+	Requested bool
+	// Candidate is true for jobs of other node pools, for which the scheduler only simulates reclaim
+	Candidate bool
+	// Costs are the numbers of pods that reclaim evicts for the job in the other node pools. Node pools in which no
+	// reclaim was found for the job are missing.
+	Costs map[string]int
+	// TargetNodePool is the node pool that the job moves to in order to reclaim there
+	TargetNodePool string
+}
+
+// CheapestNodePool returns the node pool of the given ones in which reclaim evicts the fewest pods for the job, and
+// the number of pods. Ties are broken by the order of the node pools.
+func (cpr *CrossPoolReclaimInfo) CheapestNodePool(nodePools []string) (string, int, bool) {
+	cheapest, cheapestCost, found := "", 0, false
+	for _, nodePool := range nodePools {
+		cost, hasCost := cpr.Costs[nodePool]
+		if !hasCost || (found && cost >= cheapestCost) {
+			continue
+		}
+		cheapest, cheapestCost, found = nodePool, cost, true
+	}
+	return cheapest, cheapestCost, found
+}
+
+func (cpr *CrossPoolReclaimInfo) clone() CrossPoolReclaimInfo {
+	clone := *cpr
+	clone.Costs = maps.Clone(cpr.Costs)
+	return clone
+}
+
+func newCrossPoolReclaimInfo(pg *enginev2alpha2.PodGroup) CrossPoolReclaimInfo {
+	info := CrossPoolReclaimInfo{
+		Requested: pg.Labels[commonconstants.CrossPoolReclaimLabelKey] == "true",
+	}
+	for key, value := range pg.Annotations {
+		nodePool, found := strings.CutPrefix(key, commonconstants.CrossPoolReclaimCostPrefix)
+		if !found {
+			continue
+		}
+		cost, err := strconv.Atoi(value)
+		if err != nil || cost < 0 {
+			continue
+		}
+		if info.Costs == nil {
+			info.Costs = map[string]int{}
+		}
+		info.Costs[nodePool] = cost
+	}
+	return info
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package podgroup_info
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+func TestNewCrossPoolReclaimInfo(t *testing.T) {
+	tests := []struct {
+		name        string
+		labels      map[string]string
+		annotations map[string]string
+		expected    CrossPoolReclaimInfo
+	}{
+		{
+			name:     "not requested",
+			expected: CrossPoolReclaimInfo{},
+		},
+		{
+			name:     "requested",
+			labels:   map[string]string{commonconstants.CrossPoolReclaimLabelKey: "true"},
+			expected: CrossPoolReclaimInfo{Requested: true},
+		},
+		{
+			name:   "costs",
+			labels: map[string]string{commonconstants.CrossPoolReclaimLabelKey: "true"},
+			annotations: map[string]string{
+				commonconstants.CrossPoolReclaimCostPrefix + "pool-b": "2",
+				commonconstants.CrossPoolReclaimCostPrefix + "pool-c": "0",
+				commonconstants.CrossPoolReclaimCostPrefix + "pool-d": "-1",
+				commonconstants.CrossPoolReclaimCostPrefix + "pool-e": "many",
+				"other": "3",
+			},
+			expected: CrossPoolReclaimInfo{Requested: true, Costs: map[string]int{"pool-b": 2, "pool-c": 0}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pg := &enginev2alpha2.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Labels: test.labels, Annotations: test.annotations},
+			}
+			assert.Equal(t, test.expected, newCrossPoolReclaimInfo(pg))
+		})
+	}
+}
+
+func TestCrossPoolReclaimInfo_CheapestNodePool(t *testing.T) {
+	info := CrossPoolReclaimInfo{Costs: map[string]int{"pool-b": 2, "pool-c": 1, "pool-d": 1, "pool-e": 0}}
+
+	nodePool, cost, found := info.CheapestNodePool([]string{"pool-b", "pool-d", "pool-c"})
+	assert.True(t, found)
+	assert.Equal(t, "pool-d", nodePool)
+	assert.Equal(t, 1, cost)
+
+	_, _, found = info.CheapestNodePool([]string{"pool-f"})
+	assert.False(t, found)
+}
+
+func TestCrossPoolReclaimInfo_Clone(t *testing.T) {
+	info := CrossPoolReclaimInfo{Requested: true, Costs: map[string]int{"pool-b": 2}}
+	clone := info.clone()
+	clone.Costs["pool-b"] = 3
+
+	assert.True(t, clone.Requested)
+	assert.Equal(t, 2, info.Costs["pool-b"])
+}
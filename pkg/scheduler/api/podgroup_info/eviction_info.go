@@ -22,6 +22,34 @@ func GetTasksToEvict(job *PodGroupInfo, subGroupOrderFn, taskOrderFn common_info
 	return getTasksToEvictWithSubGroups(job, reverseSubGroupOrderFn, reverseTaskOrderFn)
 }
 
+// GetDownscaleRepresentative returns a representative of the job with only its active allocated tasks above the
+// minAvailable of their subgroups, in eviction order, and without minAvailable, so that evicting any of its tasks only
+// downscales the job. Returns nil if no subgroup of the job is above its minAvailable.
+func GetDownscaleRepresentative(job *PodGroupInfo, taskOrderFn common_info.LessFn) *PodGroupInfo {
+	reverseTaskOrderFn := func(l interface{}, r interface{}) bool {
+		return taskOrderFn(r, l)
+	}
+
+	var surplusTasks []*pod_info.PodInfo
+	for _, subGroup := range job.GetSubGroups() {
+		numSurplusTasks := subGroup.GetNumActiveAllocatedTasks() - int(subGroup.GetMinAvailable())
+		if numSurplusTasks <= 0 {
+			continue
+		}
+		tasksPriorityQueue := getTasksToEvictPriorityQueue(subGroup, reverseTaskOrderFn)
+		surplusTasks = append(surplusTasks, getTasksToEvictFromQueue(tasksPriorityQueue, numSurplusTasks)...)
+	}
+	if len(surplusTasks) == 0 {
+		return nil
+	}
+
+	representative := job.CloneWithTasks(surplusTasks)
+	for _, subGroup := range representative.GetSubGroups() {
+		subGroup.SetMinAvailable(0)
+	}
+	return representative
+}
+
 func getTasksToEvictWithSubGroups(
 	job *PodGroupInfo, reverseSubGroupOrderFn, reverseTaskOrderFn common_info.LessFn,
 ) ([]*pod_info.PodInfo, bool) {
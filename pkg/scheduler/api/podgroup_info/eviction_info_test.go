@@ -104,3 +104,62 @@ func TestGetTasksToEvict_Table(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDownscaleRepresentative(t *testing.T) {
+	tests := []struct {
+		name                 string
+		job                  func() *PodGroupInfo
+		expectedSurplusTasks []string
+		expectRepresentative bool
+	}{
+		{
+			name: "AboveMinAvailable",
+			job: func() *PodGroupInfo {
+				pg := NewPodGroupInfo("pg1")
+				pg.GetSubGroups()[DefaultSubGroup].SetMinAvailable(1)
+				pg.AddTaskInfo(simpleTask("pod-a", "", pod_status.Running))
+				pg.AddTaskInfo(simpleTask("pod-b", "", pod_status.Running))
+				pg.AddTaskInfo(simpleTask("pod-c", "", pod_status.Running))
+				pg.AddTaskInfo(simpleTask("pod-d", "", pod_status.Pending))
+				return pg
+			},
+			expectedSurplusTasks: []string{"pod-b", "pod-c"},
+			expectRepresentative: true,
+		},
+		{
+			name: "ExactlyAtMinAvailable",
+			job: func() *PodGroupInfo {
+				pg := NewPodGroupInfo("pg1")
+				pg.GetSubGroups()[DefaultSubGroup].SetMinAvailable(2)
+				pg.AddTaskInfo(simpleTask("pod-a", "", pod_status.Running))
+				pg.AddTaskInfo(simpleTask("pod-b", "", pod_status.Running))
+				pg.AddTaskInfo(simpleTask("pod-c", "", pod_status.Pending))
+				return pg
+			},
+			expectRepresentative: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := tt.job()
+			representative := GetDownscaleRepresentative(job, tasksOrderFn)
+			if !tt.expectRepresentative {
+				assert.Nil(t, representative)
+				return
+			}
+
+			var surplusTasks []string
+			for _, task := range representative.GetAllPodsMap() {
+				surplusTasks = append(surplusTasks, task.Name)
+			}
+			assert.ElementsMatch(t, tt.expectedSurplusTasks, surplusTasks)
+			assert.Equal(t, int32(0), representative.GetSubGroups()[DefaultSubGroup].GetMinAvailable())
+			assert.Equal(t, int32(1), job.GetSubGroups()[DefaultSubGroup].GetMinAvailable())
+
+			tasksToEvict, hasMoreTasks := GetTasksToEvict(representative, subGroupOrderFn, tasksOrderFn)
+			assert.Len(t, tasksToEvict, 1)
+			assert.True(t, hasMoreTasks)
+		})
+	}
+}
@@ -22,6 +22,7 @@ package podgroup_info
 import (
 	"crypto/sha256"
 	"fmt"
+	"strings"
 	"time"
 
 	"golang.org/x/exp/maps"
@@ -31,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
@@ -58,6 +60,13 @@ type StalenessInfo struct {
 	Stale     bool
 }
 
+// StartSkewInfo describes a gang whose pods started further apart than allowed
+type StartSkewInfo struct {
+	Skew        time.Duration
+	MaxSkew     time.Duration
+	SlowestTask string
+}
+
 type PodGroupInfos struct {
 	PodGroupInfos []*PodGroupInfo
 }
@@ -73,6 +82,16 @@ type PodGroupInfo struct {
 
 	Priority       int32
 	Preemptibility enginev2alpha2.Preemptibility
+	// WorkloadClass is how the job is accounted, reclaimed and placed, resolved from the podgroup and its queue. Empty
+	// when neither sets it.
+	WorkloadClass enginev2alpha2.WorkloadClass
+	// EvictionMethod is how the pods of the job are evicted, resolved from its priority class and queue
+	EvictionMethod enginev2.EvictionMethod
+	// GPUDeviceSelection is how the devices of a node are selected for the fractional GPU tasks of the job, resolved
+	// from its priority class and queue. Empty when neither sets it.
+	GPUDeviceSelection enginev2.GPUDeviceSelectionPolicy
+	// DoNotConsolidate is true if the job opted out of being moved by consolidation, and its queue allows it
+	DoNotConsolidate bool
 
 	JobFitErrors   []common_info.JobFitError
 	TasksFitErrors map[common_info.PodID]*common_info.TasksFitErrors
@@ -81,8 +100,26 @@ type PodGroupInfo struct {
 
 	CreationTimestamp  metav1.Time
 	LastStartTimestamp *time.Time
-	PodGroup           *enginev2alpha2.PodGroup
-	PodGroupUID        types.UID
+	// LoanLenders are the queues whose unused deserved quota was borrowed to allocate the job
+	LoanLenders []common_info.QueueID
+	// ScavengedFrom is the queue of a job that exceeded the limit of its queue and runs under the scavenger queue,
+	// which is its Queue while it is allocated. Empty for jobs that run under their own queue.
+	ScavengedFrom common_info.QueueID
+	// StartTimePrediction is the estimated start time of a pending job, written to the pod group's status
+	StartTimePrediction *enginev2alpha2.StartTimePrediction
+	// RelaxedConstraints are the soft constraints the scheduler dropped, written to the pod group's status
+	RelaxedConstraints []enginev2alpha2.RelaxedConstraint
+	// Preemptions records the preemptions and reclaims of the job, written to the pod group's status
+	Preemptions *enginev2alpha2.PodGroupPreemptions
+	PodGroup    *enginev2alpha2.PodGroup
+	PodGroupUID types.UID
+	// StartSkew is set when the pods of the job started further apart than allowed, and is reported as an event
+	StartSkew *StartSkewInfo
+	// PlaceableReplicas is the number of pods of an elastic job that could be placed immediately, written to the pod
+	// group's status
+	PlaceableReplicas *int32
+	// CrossPoolReclaim is the state of the reclaim for the job in other node pools that its queue is entitled to
+	CrossPoolReclaim CrossPoolReclaimInfo
 
 	RootSubGroupSet *subgroup_info.SubGroupSet
 	PodSets         map[string]*subgroup_info.PodSet
@@ -90,6 +127,7 @@ type PodGroupInfo struct {
 	StalenessInfo
 
 	schedulingConstraintsSignature common_info.SchedulingConstraintsSignature
+	preemptionRecorded             bool
 
 	// inner cache
 	tasksToAllocate             []*pod_info.PodInfo
@@ -147,6 +185,13 @@ func (pgi *PodGroupInfo) IsPreemptibleJob() bool {
 	return pgi.Preemptibility == enginev2alpha2.Preemptible
 }
 
+func (pgi *PodGroupInfo) GetPriorityClassName() string {
+	if pgi.PodGroup == nil {
+		return ""
+	}
+	return pgi.PodGroup.Spec.PriorityClassName
+}
+
 func (pgi *PodGroupInfo) SetPodGroup(pg *enginev2alpha2.PodGroup) {
 	pgi.Name = pg.Name
 	pgi.Namespace = pg.Namespace
@@ -155,6 +200,8 @@ func (pgi *PodGroupInfo) SetPodGroup(pg *enginev2alpha2.PodGroup) {
 	pgi.CreationTimestamp = pg.GetCreationTimestamp()
 	pgi.PodGroup = pg
 	pgi.PodGroupUID = pg.UID
+	pgi.RelaxedConstraints = pg.Status.RelaxedConstraints
+	pgi.Preemptions = pg.Status.Preemptions
 	err := pgi.setSubGroups(pg)
 	if err != nil {
 		log.InfraLogger.V(7).Warnf("Failed to set subgroups for podgroup <%s> err: %v",
@@ -182,11 +229,62 @@ func (pgi *PodGroupInfo) SetPodGroup(pg *enginev2alpha2.PodGroup) {
 		}
 	}
 
+	if pg.Annotations[commonconstants.LoanLenders] != "" {
+		for _, lender := range strings.Split(pg.Annotations[commonconstants.LoanLenders], ",") {
+			pgi.LoanLenders = append(pgi.LoanLenders, common_info.QueueID(lender))
+		}
+	}
+	pgi.CrossPoolReclaim = newCrossPoolReclaimInfo(pg)
+
 	log.InfraLogger.V(7).Infof(
 		"SetPodGroup. podGroupName=<%s>, PodGroupUID=<%s> pgi.PodGroupIndex=<%d>",
 		pgi.Name, pgi.PodGroupUID)
 }
 
+// Scavenge moves the job to the scavenger queue, or back to the queue it was scavenged from if scavengerQueue is empty
+func (pgi *PodGroupInfo) Scavenge(scavengerQueue common_info.QueueID) {
+	if scavengerQueue == "" {
+		if pgi.ScavengedFrom != "" {
+			pgi.Queue = pgi.ScavengedFrom
+			pgi.ScavengedFrom = ""
+		}
+		return
+	}
+	if pgi.ScavengedFrom == "" {
+		pgi.ScavengedFrom = pgi.Queue
+	}
+	pgi.Queue = scavengerQueue
+}
+
+// AddLoanLenders records queues whose unused deserved quota was borrowed by the job
+func (pgi *PodGroupInfo) AddLoanLenders(lenders ...common_info.QueueID) {
+	for _, lender := range lenders {
+		if !slices.Contains(pgi.LoanLenders, lender) {
+			pgi.LoanLenders = append(pgi.LoanLenders, lender)
+		}
+	}
+}
+
+// IsConstraintRelaxed returns true if the scheduler dropped the soft constraint of the job
+func (pgi *PodGroupInfo) IsConstraintRelaxed(constraint enginev2alpha2.SoftConstraint) bool {
+	for _, relaxed := range pgi.RelaxedConstraints {
+		if relaxed.Constraint == constraint {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBorrowingFrom returns true if the job borrowed the deserved quota of any of the given queues
+func (pgi *PodGroupInfo) IsBorrowingFrom(queues ...common_info.QueueID) bool {
+	for _, queue := range queues {
+		if slices.Contains(pgi.LoanLenders, queue) {
+			return true
+		}
+	}
+	return false
+}
+
 func (pgi *PodGroupInfo) setSubGroups(podGroup *enginev2alpha2.PodGroup) error {
 	rootSubGroupSet, err := subgroup_info.FromPodGroup(podGroup)
 	if err != nil {
@@ -447,6 +545,24 @@ func (pgi *PodGroupInfo) ShouldPipelineJob() bool {
 	return false
 }
 
+// RecordPreemption counts a preemption or reclaim of the job. Evictions of several pods of the job in the same
+// session are counted once.
+func (pgi *PodGroupInfo) RecordPreemption(preemptionTime time.Time) {
+	if pgi.preemptionRecorded {
+		return
+	}
+	pgi.preemptionRecorded = true
+
+	count := int32(1)
+	if pgi.Preemptions != nil {
+		count = pgi.Preemptions.Count + 1
+	}
+	pgi.Preemptions = &enginev2alpha2.PodGroupPreemptions{
+		Count:              count,
+		LastPreemptionTime: ptr.To(metav1.NewTime(preemptionTime)),
+	}
+}
+
 func (pgi *PodGroupInfo) Clone() *PodGroupInfo {
 	return pgi.CloneWithTasks(maps.Values(pgi.GetAllPodsMap()))
 }
@@ -459,6 +575,17 @@ func (pgi *PodGroupInfo) CloneWithTasks(tasks []*pod_info.PodInfo) *PodGroupInfo
 		Queue:          pgi.Queue,
 		Priority:       pgi.Priority,
 		Preemptibility: pgi.Preemptibility,
+		EvictionMethod: pgi.EvictionMethod,
+		WorkloadClass:  pgi.WorkloadClass,
+		LoanLenders:    slices.Clone(pgi.LoanLenders),
+		ScavengedFrom:  pgi.ScavengedFrom,
+
+		GPUDeviceSelection: pgi.GPUDeviceSelection,
+		DoNotConsolidate:   pgi.DoNotConsolidate,
+		CrossPoolReclaim:   pgi.CrossPoolReclaim.clone(),
+
+		RelaxedConstraints: slices.Clone(pgi.RelaxedConstraints),
+		Preemptions:        pgi.Preemptions,
 
 		Allocated: resource_info.EmptyResource(),
 
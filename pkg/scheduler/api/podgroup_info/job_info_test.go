@@ -22,12 +22,14 @@ package podgroup_info
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
@@ -1365,6 +1367,156 @@ func TestPodGroupInfo_GetSchedulingConstraintsSignature(t *testing.T) {
 			},
 			expectEqual: false,
 		},
+		{
+			// PodGroup A:              PodGroup B:
+			// root []                  root []
+			//   └─ podset-1 []           └─ podset-1 [unique nodes] <--- DIFFERENT
+			//        └─ pod-1 (pending)       └─ pod-1 (pending)
+			name: "different PodSet unique nodes - expects not equal",
+			podGroupA: func() *PodGroupInfo {
+				rootSubGroupSet := subgroup_info.NewSubGroupSet(subgroup_info.RootSubGroupSetName, nil)
+				podSet := subgroup_info.NewPodSet("podset-1", 1, nil)
+				rootSubGroupSet.AddPodSet(podSet)
+
+				pgi := &PodGroupInfo{
+					UID:             "pg-1",
+					RootSubGroupSet: rootSubGroupSet,
+					PodSets:         rootSubGroupSet.GetAllPodSets(),
+				}
+				podSet.AssignTask(createPendingTask("pod-1"))
+				return pgi
+			},
+			podGroupB: func() *PodGroupInfo {
+				rootSubGroupSet := subgroup_info.NewSubGroupSet(subgroup_info.RootSubGroupSetName, nil)
+				podSet := subgroup_info.NewPodSet("podset-1", 1, nil)
+				podSet.SetUniqueNodes(true)
+				rootSubGroupSet.AddPodSet(podSet)
+
+				pgi := &PodGroupInfo{
+					UID:             "pg-2",
+					RootSubGroupSet: rootSubGroupSet,
+					PodSets:         rootSubGroupSet.GetAllPodSets(),
+				}
+				podSet.AssignTask(createPendingTask("pod-1"))
+				return pgi
+			},
+			expectEqual: false,
+		},
+		{
+			// PodGroup A:                      PodGroup B:
+			// root []                          root [unique nodes] <--- DIFFERENT
+			//   └─ middle []                     └─ middle []
+			//        └─ podset-1 []                   └─ podset-1 []
+			//             └─ pod-1 (pending)               └─ pod-1 (pending)
+			name: "different top SubGroupSet unique nodes - expects not equal",
+			podGroupA: func() *PodGroupInfo {
+				rootSubGroupSet := subgroup_info.NewSubGroupSet(subgroup_info.RootSubGroupSetName, nil)
+				middleSubGroupSet := subgroup_info.NewSubGroupSet("middle", nil)
+				podSet := subgroup_info.NewPodSet("podset-1", 1, nil)
+				middleSubGroupSet.AddPodSet(podSet)
+				rootSubGroupSet.AddSubGroup(middleSubGroupSet)
+
+				pgi := &PodGroupInfo{
+					UID:             "pg-1",
+					RootSubGroupSet: rootSubGroupSet,
+					PodSets:         rootSubGroupSet.GetAllPodSets(),
+				}
+				podSet.AssignTask(createPendingTask("pod-1"))
+				return pgi
+			},
+			podGroupB: func() *PodGroupInfo {
+				rootSubGroupSet := subgroup_info.NewSubGroupSet(subgroup_info.RootSubGroupSetName, nil)
+				rootSubGroupSet.SetUniqueNodes(true)
+				middleSubGroupSet := subgroup_info.NewSubGroupSet("middle", nil)
+				podSet := subgroup_info.NewPodSet("podset-1", 1, nil)
+				middleSubGroupSet.AddPodSet(podSet)
+				rootSubGroupSet.AddSubGroup(middleSubGroupSet)
+
+				pgi := &PodGroupInfo{
+					UID:             "pg-2",
+					RootSubGroupSet: rootSubGroupSet,
+					PodSets:         rootSubGroupSet.GetAllPodSets(),
+				}
+				podSet.AssignTask(createPendingTask("pod-1"))
+				return pgi
+			},
+			expectEqual: false,
+		},
+		{
+			// PodGroup A:              PodGroup B:
+			// root []                  root []
+			//   └─ podset-1 []           └─ podset-1 [NVLink domain] <--- DIFFERENT
+			//        └─ pod-1 (pending)       └─ pod-1 (pending)
+			name: "different PodSet GPU interconnect - expects not equal",
+			podGroupA: func() *PodGroupInfo {
+				rootSubGroupSet := subgroup_info.NewSubGroupSet(subgroup_info.RootSubGroupSetName, nil)
+				podSet := subgroup_info.NewPodSet("podset-1", 1, nil)
+				rootSubGroupSet.AddPodSet(podSet)
+
+				pgi := &PodGroupInfo{
+					UID:             "pg-1",
+					RootSubGroupSet: rootSubGroupSet,
+					PodSets:         rootSubGroupSet.GetAllPodSets(),
+				}
+				podSet.AssignTask(createPendingTask("pod-1"))
+				return pgi
+			},
+			podGroupB: func() *PodGroupInfo {
+				rootSubGroupSet := subgroup_info.NewSubGroupSet(subgroup_info.RootSubGroupSetName, nil)
+				podSet := subgroup_info.NewPodSet("podset-1", 1, nil)
+				podSet.SetGPUInterconnect(v2alpha2.NVLinkDomain)
+				rootSubGroupSet.AddPodSet(podSet)
+
+				pgi := &PodGroupInfo{
+					UID:             "pg-2",
+					RootSubGroupSet: rootSubGroupSet,
+					PodSets:         rootSubGroupSet.GetAllPodSets(),
+				}
+				podSet.AssignTask(createPendingTask("pod-1"))
+				return pgi
+			},
+			expectEqual: false,
+		},
+		{
+			// PodGroup A:                      PodGroup B:
+			// root []                          root []
+			//   └─ middle []                     └─ middle [NVLink domain] <--- DIFFERENT
+			//        └─ podset-1 []                   └─ podset-1 []
+			//             └─ pod-1 (pending)               └─ pod-1 (pending)
+			name: "different middle SubGroupSet GPU interconnect - expects not equal",
+			podGroupA: func() *PodGroupInfo {
+				rootSubGroupSet := subgroup_info.NewSubGroupSet(subgroup_info.RootSubGroupSetName, nil)
+				middleSubGroupSet := subgroup_info.NewSubGroupSet("middle", nil)
+				podSet := subgroup_info.NewPodSet("podset-1", 1, nil)
+				middleSubGroupSet.AddPodSet(podSet)
+				rootSubGroupSet.AddSubGroup(middleSubGroupSet)
+
+				pgi := &PodGroupInfo{
+					UID:             "pg-1",
+					RootSubGroupSet: rootSubGroupSet,
+					PodSets:         rootSubGroupSet.GetAllPodSets(),
+				}
+				podSet.AssignTask(createPendingTask("pod-1"))
+				return pgi
+			},
+			podGroupB: func() *PodGroupInfo {
+				rootSubGroupSet := subgroup_info.NewSubGroupSet(subgroup_info.RootSubGroupSetName, nil)
+				middleSubGroupSet := subgroup_info.NewSubGroupSet("middle", nil)
+				middleSubGroupSet.SetGPUInterconnect(v2alpha2.NVLinkDomain)
+				podSet := subgroup_info.NewPodSet("podset-1", 1, nil)
+				middleSubGroupSet.AddPodSet(podSet)
+				rootSubGroupSet.AddSubGroup(middleSubGroupSet)
+
+				pgi := &PodGroupInfo{
+					UID:             "pg-2",
+					RootSubGroupSet: rootSubGroupSet,
+					PodSets:         rootSubGroupSet.GetAllPodSets(),
+				}
+				podSet.AssignTask(createPendingTask("pod-1"))
+				return pgi
+			},
+			expectEqual: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1575,3 +1727,28 @@ func TestPodGroupInfo_IsStale(t *testing.T) {
 		}
 	}
 }
+
+func TestPodGroupInfo_RecordPreemption(t *testing.T) {
+	firstPreemption := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	secondPreemption := firstPreemption.Add(time.Hour)
+
+	pgi := NewPodGroupInfo("test-podgroup")
+	pgi.RecordPreemption(firstPreemption)
+	pgi.RecordPreemption(firstPreemption.Add(time.Second))
+	if pgi.Preemptions.Count != 1 || !pgi.Preemptions.LastPreemptionTime.Time.Equal(firstPreemption) {
+		t.Errorf("RecordPreemption() in a single session got %+v, want a single preemption at %v",
+			pgi.Preemptions, firstPreemption)
+	}
+
+	// The next session starts from the preemptions recorded in the pod group's status
+	next := NewPodGroupInfo("test-podgroup")
+	next.Preemptions = pgi.Preemptions
+	next.RecordPreemption(secondPreemption)
+	if next.Preemptions.Count != 2 || !next.Preemptions.LastPreemptionTime.Time.Equal(secondPreemption) {
+		t.Errorf("RecordPreemption() in the next session got %+v, want 2 preemptions, the last at %v",
+			next.Preemptions, secondPreemption)
+	}
+	if pgi.Preemptions.Count != 1 {
+		t.Errorf("RecordPreemption() changed the preemptions of the previous session to %+v", pgi.Preemptions)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package podgroup_info
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+)
+
+// GetReplacedPodGroups maps the jobs that replace another job, as set by the replaces field of their PodGroup, to the
+// job they replace. Replaced jobs that don't exist, that belong to another queue, or that have no active allocated
+// tasks are left out.
+func GetReplacedPodGroups(
+	jobs map[common_info.PodGroupID]*PodGroupInfo,
+) map[common_info.PodGroupID]*PodGroupInfo {
+	replaced := map[common_info.PodGroupID]*PodGroupInfo{}
+	var jobsByName map[types.NamespacedName]*PodGroupInfo
+	for _, job := range jobs {
+		if job.PodGroup == nil || job.PodGroup.Spec.Replaces == "" {
+			continue
+		}
+		if jobsByName == nil {
+			jobsByName = indexByName(jobs)
+		}
+		replacedJob, found := jobsByName[types.NamespacedName{Namespace: job.Namespace,
+			Name: job.PodGroup.Spec.Replaces}]
+		if !found || replacedJob == job || replacedJob.Queue != job.Queue ||
+			replacedJob.GetActiveAllocatedTasksCount() == 0 {
+			continue
+		}
+		replaced[job.UID] = replacedJob
+	}
+	return replaced
+}
+
+// IsFullyRunning returns whether all the alive tasks of the job are running and its gang is satisfied
+func (pgi *PodGroupInfo) IsFullyRunning() bool {
+	if !pgi.IsGangSatisfied() {
+		return false
+	}
+	for _, task := range pgi.GetAllPodsMap() {
+		if pod_status.IsAliveStatus(task.Status) && task.Status != pod_status.Running {
+			return false
+		}
+	}
+	return pgi.GetNumAliveTasks() > 0
+}
+
+func indexByName(jobs map[common_info.PodGroupID]*PodGroupInfo) map[types.NamespacedName]*PodGroupInfo {
+	jobsByName := make(map[types.NamespacedName]*PodGroupInfo, len(jobs))
+	for _, job := range jobs {
+		jobsByName[types.NamespacedName{Namespace: job.Namespace, Name: job.Name}] = job
+	}
+	return jobsByName
+}
@@ -21,18 +21,15 @@ func FromPodGroup(podGroup *v2alpha2.PodGroup) (*SubGroupSet, error) {
 
 	var topologyConstraint *topology_info.TopologyConstraintInfo
 	if podGroup.Spec.TopologyConstraint.Topology != "" {
-		topologyConstraint = &topology_info.TopologyConstraintInfo{
-			Topology:       podGroup.Spec.TopologyConstraint.Topology,
-			RequiredLevel:  podGroup.Spec.TopologyConstraint.RequiredTopologyLevel,
-			PreferredLevel: podGroup.Spec.TopologyConstraint.PreferredTopologyLevel,
-		}
+		topologyConstraint = newTopologyConstraintInfo(&podGroup.Spec.TopologyConstraint, &podGroup.Status)
 	}
 	root := NewSubGroupSet(RootSubGroupSetName, topologyConstraint)
+	root.SetUniqueNodes(podGroup.Spec.UniqueNodes)
 	subGroupSets := map[string]*SubGroupSet{
 		RootSubGroupSetName: root,
 	}
 	podSets := map[string]*PodSet{}
-	createSubGroupInfos(allSubGroups, children, subGroupSets, podSets)
+	createSubGroupInfos(allSubGroups, children, subGroupSets, podSets, &podGroup.Status)
 
 	err = addToParent(allSubGroups, subGroupSets, podSets)
 	if err != nil {
@@ -59,26 +56,51 @@ func mapSubGroupsAndChildren(podGroup *v2alpha2.PodGroup) (map[string]*v2alpha2.
 }
 
 func createSubGroupInfos(allSubGroups map[string]*v2alpha2.SubGroup, children map[string][]string,
-	subGroupSets map[string]*SubGroupSet, podSets map[string]*PodSet,
+	subGroupSets map[string]*SubGroupSet, podSets map[string]*PodSet, status *v2alpha2.PodGroupStatus,
 ) {
 	for name, subGroup := range allSubGroups {
 		var topologyConstrainInfo *topology_info.TopologyConstraintInfo
 		if subGroup.TopologyConstraint != nil {
-			topologyConstrainInfo = &topology_info.TopologyConstraintInfo{
-				Topology:       subGroup.TopologyConstraint.Topology,
-				RequiredLevel:  subGroup.TopologyConstraint.RequiredTopologyLevel,
-				PreferredLevel: subGroup.TopologyConstraint.PreferredTopologyLevel,
-			}
+			topologyConstrainInfo = newTopologyConstraintInfo(subGroup.TopologyConstraint, status)
 		}
 		_, hasChildren := children[name]
 		if hasChildren {
 			subGroupSets[name] = NewSubGroupSet(name, topologyConstrainInfo)
+			subGroupSets[name].SetUniqueNodes(subGroup.UniqueNodes)
+			subGroupSets[name].SetGPUInterconnect(subGroup.GPUInterconnect)
 		} else {
 			podSets[name] = NewPodSet(name, max(subGroup.MinMember, 1), topologyConstrainInfo)
+			podSets[name].SetUniqueNodes(subGroup.UniqueNodes)
+			podSets[name].SetGPUInterconnect(subGroup.GPUInterconnect)
 		}
 	}
 }
 
+// newTopologyConstraintInfo returns the topology constraint without the levels that the scheduler relaxed, or nil if
+// no level is left
+func newTopologyConstraintInfo(
+	constraint *v2alpha2.TopologyConstraint, status *v2alpha2.PodGroupStatus,
+) *topology_info.TopologyConstraintInfo {
+	constraintInfo := &topology_info.TopologyConstraintInfo{
+		Topology:            constraint.Topology,
+		RequiredLevel:       constraint.RequiredTopologyLevel,
+		PreferredLevel:      constraint.PreferredTopologyLevel,
+		SubGroupSpreadLevel: constraint.SubGroupSpreadTopologyLevel,
+		PreferredWeight:     constraint.PreferredTopologyWeight,
+	}
+	if status.IsConstraintRelaxed(v2alpha2.PreferredTopologyConstraint) {
+		constraintInfo.PreferredLevel = ""
+	}
+	if status.IsConstraintRelaxed(v2alpha2.SubGroupSpreadConstraint) {
+		constraintInfo.SubGroupSpreadLevel = ""
+	}
+	if constraintInfo.RequiredLevel == "" && constraintInfo.PreferredLevel == "" &&
+		constraintInfo.SubGroupSpreadLevel == "" && len(status.RelaxedConstraints) > 0 {
+		return nil
+	}
+	return constraintInfo
+}
+
 func addToParent(allSubGroups map[string]*v2alpha2.SubGroup, subGroupSets map[string]*SubGroupSet,
 	podSets map[string]*PodSet) error {
 	for name, subGroupSet := range subGroupSets {
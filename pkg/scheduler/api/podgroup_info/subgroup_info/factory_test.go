@@ -37,7 +37,9 @@ func equalTopologyConstraint(got, want *topology_info.TopologyConstraintInfo) bo
 
 	return got.Topology == want.Topology &&
 		got.RequiredLevel == want.RequiredLevel &&
-		got.PreferredLevel == want.PreferredLevel
+		got.PreferredLevel == want.PreferredLevel &&
+		got.SubGroupSpreadLevel == want.SubGroupSpreadLevel &&
+		ptr.Equal(got.PreferredWeight, want.PreferredWeight)
 }
 
 func checkGroupStructure(t *testing.T, got *SubGroupSet, want *wantGroup) {
@@ -48,7 +50,7 @@ func checkGroupStructure(t *testing.T, got *SubGroupSet, want *wantGroup) {
 		t.Fatalf("expected SubGroupSet name %q, got %q", want.Name, got.GetName())
 	}
 	if !equalTopologyConstraint(got.GetTopologyConstraint(), want.TopologyConstraint) {
-		t.Fatalf("expected topology constraint %q, got %q", want.TopologyConstraint, got.GetTopologyConstraint())
+		t.Fatalf("expected topology constraint %v, got %v", want.TopologyConstraint, got.GetTopologyConstraint())
 	}
 	// Check PodSets
 	if len(got.podSets) != len(want.PodSets) {
@@ -72,7 +74,7 @@ func checkGroupStructure(t *testing.T, got *SubGroupSet, want *wantGroup) {
 						wp.Name, wp.MinMember, gp.GetMinAvailable())
 				}
 				if !equalTopologyConstraint(gp.GetTopologyConstraint(), wp.TopologyConstraint) {
-					t.Errorf("SubGroupSet %q: expected topology constraint %q got %q",
+					t.Errorf("SubGroupSet %q: expected topology constraint %v got %v",
 						wp.Name, wp.TopologyConstraint, gp.GetTopologyConstraint())
 				}
 			}
@@ -222,8 +224,9 @@ func TestFromPodGroup_FullTree(t *testing.T) {
 							PreferredTopologyLevel: "zone",
 						}},
 						{Name: "leaf1", Parent: ptr.To("rootchild"), MinMember: 2, TopologyConstraint: &v2alpha2.TopologyConstraint{
-							Topology:              "topology",
-							RequiredTopologyLevel: "rack",
+							Topology:                "topology",
+							RequiredTopologyLevel:   "rack",
+							PreferredTopologyWeight: ptr.To(int32(0)),
 						}},
 						{Name: "leaf2", Parent: ptr.To("rootchild"), MinMember: 3, TopologyConstraint: &v2alpha2.TopologyConstraint{
 							Topology:              "topology",
@@ -243,8 +246,9 @@ func TestFromPodGroup_FullTree(t *testing.T) {
 								Name:      "leaf1",
 								MinMember: 2,
 								TopologyConstraint: &topology_info.TopologyConstraintInfo{
-									Topology:      "topology",
-									RequiredLevel: "rack",
+									Topology:        "topology",
+									RequiredLevel:   "rack",
+									PreferredWeight: ptr.To(int32(0)),
 								},
 							},
 							{
@@ -265,6 +269,54 @@ func TestFromPodGroup_FullTree(t *testing.T) {
 				PodSets: nil,
 			},
 		},
+		{
+			name: "relaxed topology constraints",
+			podGroup: &v2alpha2.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns9", Name: "relaxed"},
+				Spec: v2alpha2.PodGroupSpec{
+					TopologyConstraint: v2alpha2.TopologyConstraint{
+						Topology:                    "topology",
+						PreferredTopologyLevel:      "zone",
+						SubGroupSpreadTopologyLevel: "rack",
+					},
+					SubGroups: []v2alpha2.SubGroup{
+						{Name: "leaf1", MinMember: 2, TopologyConstraint: &v2alpha2.TopologyConstraint{
+							Topology:               "topology",
+							RequiredTopologyLevel:  "zone",
+							PreferredTopologyLevel: "rack",
+						}},
+						{Name: "leaf2", MinMember: 2, TopologyConstraint: &v2alpha2.TopologyConstraint{
+							Topology:               "topology",
+							PreferredTopologyLevel: "rack",
+						}},
+					},
+				},
+				Status: v2alpha2.PodGroupStatus{
+					RelaxedConstraints: []v2alpha2.RelaxedConstraint{
+						{Constraint: v2alpha2.PreferredTopologyConstraint},
+					},
+				},
+			},
+			want: &wantGroup{
+				Name:   RootSubGroupSetName,
+				Groups: nil,
+				PodSets: []*wantPodSet{
+					{
+						Name:      "leaf1",
+						MinMember: 2,
+						TopologyConstraint: &topology_info.TopologyConstraintInfo{
+							Topology:      "topology",
+							RequiredLevel: "zone",
+						},
+					},
+					{Name: "leaf2", MinMember: 2},
+				},
+				TopologyConstraint: &topology_info.TopologyConstraintInfo{
+					Topology:            "topology",
+					SubGroupSpreadLevel: "rack",
+				},
+			},
+		},
 		{
 			name: "empty subgroups",
 			podGroup: &v2alpha2.PodGroup{
@@ -375,3 +427,71 @@ func TestFromPodGroup_FullTree(t *testing.T) {
 		})
 	}
 }
+
+func TestFromPodGroup_UniqueNodes(t *testing.T) {
+	podGroup := &v2alpha2.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "unique"},
+		Spec: v2alpha2.PodGroupSpec{
+			UniqueNodes: true,
+			SubGroups: []v2alpha2.SubGroup{
+				{Name: "replicas", UniqueNodes: true},
+				{Name: "replica-a", Parent: ptr.To("replicas"), MinMember: 1, UniqueNodes: true},
+				{Name: "replica-b", Parent: ptr.To("replicas"), MinMember: 1},
+			},
+		},
+	}
+
+	root, err := FromPodGroup(podGroup)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !root.IsUniqueNodes() {
+		t.Errorf("expected the root SubGroupSet to require unique nodes")
+	}
+	if replicas := root.GetChildGroups()[0]; !replicas.IsUniqueNodes() {
+		t.Errorf("expected SubGroupSet %q to require unique nodes", replicas.GetName())
+	}
+	podSets := root.GetAllPodSets()
+	if !podSets["replica-a"].IsUniqueNodes() || podSets["replica-b"].IsUniqueNodes() {
+		t.Errorf("expected only PodSet replica-a to require unique nodes")
+	}
+
+	clone := root.Clone()
+	if !clone.IsUniqueNodes() || !clone.GetAllPodSets()["replica-a"].IsUniqueNodes() {
+		t.Errorf("expected the clone to keep the unique nodes requirements")
+	}
+}
+
+func TestFromPodGroup_GPUInterconnect(t *testing.T) {
+	podGroup := &v2alpha2.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "tensor-parallel"},
+		Spec: v2alpha2.PodGroupSpec{
+			SubGroups: []v2alpha2.SubGroup{
+				{Name: "shards", GPUInterconnect: v2alpha2.NVLinkDomain},
+				{Name: "shard-a", Parent: ptr.To("shards"), MinMember: 1, GPUInterconnect: v2alpha2.NVLinkDomain},
+				{Name: "shard-b", Parent: ptr.To("shards"), MinMember: 1},
+			},
+		},
+	}
+
+	root, err := FromPodGroup(podGroup)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if root.GetGPUInterconnect() != "" {
+		t.Errorf("expected the root SubGroupSet not to require a GPU interconnect")
+	}
+	if shards := root.GetChildGroups()[0]; shards.GetGPUInterconnect() != v2alpha2.NVLinkDomain {
+		t.Errorf("expected SubGroupSet %q to require an NVLink domain", shards.GetName())
+	}
+	podSets := root.GetAllPodSets()
+	if podSets["shard-a"].GetGPUInterconnect() != v2alpha2.NVLinkDomain || podSets["shard-b"].GetGPUInterconnect() != "" {
+		t.Errorf("expected only PodSet shard-a to require an NVLink domain")
+	}
+
+	clone := root.Clone()
+	if clone.GetChildGroups()[0].GetGPUInterconnect() != v2alpha2.NVLinkDomain ||
+		clone.GetAllPodSets()["shard-a"].GetGPUInterconnect() != v2alpha2.NVLinkDomain {
+		t.Errorf("expected the clone to keep the GPU interconnect requirements")
+	}
+}
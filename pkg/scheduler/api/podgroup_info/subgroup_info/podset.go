@@ -146,7 +146,10 @@ func (ps *PodSet) GetNumPendingTasks() int {
 }
 
 func (ps *PodSet) Clone() *PodSet {
-	return NewPodSet(ps.GetName(), ps.GetMinAvailable(), ps.GetTopologyConstraint())
+	podSet := NewPodSet(ps.GetName(), ps.GetMinAvailable(), ps.GetTopologyConstraint())
+	podSet.SetUniqueNodes(ps.IsUniqueNodes())
+	podSet.SetGPUInterconnect(ps.GetGPUInterconnect())
+	return podSet
 }
 
 func (ps *PodSet) GetSchedulingConstraintsSignature() common_info.SchedulingConstraintsSignature {
@@ -165,13 +168,13 @@ func (ps *PodSet) GetSchedulingConstraintsSignature() common_info.SchedulingCons
 func (ps *PodSet) generateSchedulingConstraintsSignature() common_info.SchedulingConstraintsSignature {
 	hash := sha256.New()
 
-	// Topology Constraints
+	// SubGroup Constraints
 	// Use separator between levels so that different hierarchy orderings produce different hashes.
 	// e.g., root="" + podset="x" should differ from root="x" + podset=""
-	hash.Write([]byte(ps.GetTopologyConstraint().GetSchedulingConstraintsSignature()))
+	hash.Write([]byte(ps.getSchedulingConstraintsSignature()))
 	for parent := ps.GetParent(); parent != nil; parent = parent.GetParent() {
 		hash.Write([]byte("|"))
-		hash.Write([]byte(parent.GetTopologyConstraint().GetSchedulingConstraintsSignature()))
+		hash.Write([]byte(parent.getSchedulingConstraintsSignature()))
 	}
 
 	// Pods
@@ -4,6 +4,9 @@
 package subgroup_info
 
 import (
+	"fmt"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/topology_info"
 )
 
@@ -11,6 +14,8 @@ type SubGroupInfo struct {
 	parent             *SubGroupSet
 	name               string
 	topologyConstraint *topology_info.TopologyConstraintInfo
+	uniqueNodes        bool
+	gpuInterconnect    v2alpha2.GPUInterconnect
 }
 
 func newSubGroupInfo(name string, topologyConstraint *topology_info.TopologyConstraintInfo) *SubGroupInfo {
@@ -29,6 +34,32 @@ func (sgi *SubGroupInfo) GetTopologyConstraint() *topology_info.TopologyConstrai
 	return sgi.topologyConstraint
 }
 
+// IsUniqueNodes returns true if the pods of the subgroup must be placed on different nodes
+func (sgi *SubGroupInfo) IsUniqueNodes() bool {
+	return sgi.uniqueNodes
+}
+
+func (sgi *SubGroupInfo) SetUniqueNodes(uniqueNodes bool) {
+	sgi.uniqueNodes = uniqueNodes
+}
+
+// GetGPUInterconnect returns the GPU interconnect domain that the pods of the subgroup must share, or an empty value
+// if they may be placed on any GPUs
+func (sgi *SubGroupInfo) GetGPUInterconnect() v2alpha2.GPUInterconnect {
+	return sgi.gpuInterconnect
+}
+
+func (sgi *SubGroupInfo) SetGPUInterconnect(gpuInterconnect v2alpha2.GPUInterconnect) {
+	sgi.gpuInterconnect = gpuInterconnect
+}
+
+// getSchedulingConstraintsSignature returns the signature of the constraints that the subgroup sets on the placement
+// of its pods
+func (sgi *SubGroupInfo) getSchedulingConstraintsSignature() string {
+	return fmt.Sprintf("%s:%t:%s", sgi.topologyConstraint.GetSchedulingConstraintsSignature(), sgi.uniqueNodes,
+		sgi.gpuInterconnect)
+}
+
 func (sgi *SubGroupInfo) SetParent(parent *SubGroupSet) {
 	sgi.parent = parent
 }
@@ -43,6 +43,8 @@ func (sgs *SubGroupSet) GetChildPodSets() []*PodSet {
 
 func (sgs *SubGroupSet) Clone() *SubGroupSet {
 	root := NewSubGroupSet(sgs.name, sgs.topologyConstraint)
+	root.SetUniqueNodes(sgs.uniqueNodes)
+	root.SetGPUInterconnect(sgs.gpuInterconnect)
 	for _, podSet := range sgs.podSets {
 		clonePodSet := podSet.Clone()
 		root.AddPodSet(clonePodSet)
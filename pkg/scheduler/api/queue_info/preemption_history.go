@@ -0,0 +1,76 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package queue_info
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+)
+
+// PreemptionHistory records when pods were evicted for the workloads of each queue with a preemption limit. It is
+// owned by the scheduler and outlives the sessions, so that the pods evicted for a queue are counted in the following
+// cycles.
+type PreemptionHistory struct {
+	mutex     sync.Mutex
+	evictions map[common_info.QueueID][]time.Time
+}
+
+func NewPreemptionHistory() *PreemptionHistory {
+	return &PreemptionHistory{
+		evictions: map[common_info.QueueID][]time.Time{},
+	}
+}
+
+// Clone returns a copy of the history
+func (ph *PreemptionHistory) Clone() *PreemptionHistory {
+	ph.mutex.Lock()
+	defer ph.mutex.Unlock()
+
+	evictions := make(map[common_info.QueueID][]time.Time, len(ph.evictions))
+	for queueID, times := range ph.evictions {
+		evictions[queueID] = append([]time.Time{}, times...)
+	}
+	return &PreemptionHistory{evictions: evictions}
+}
+
+// Prune removes the evictions that are out of the window of their queue, and the evictions of queues that no longer
+// exist or no longer have a preemption limit
+func (ph *PreemptionHistory) Prune(queues map[common_info.QueueID]*QueueInfo, now time.Time) {
+	ph.mutex.Lock()
+	defer ph.mutex.Unlock()
+
+	for queueID, times := range ph.evictions {
+		queue, found := queues[queueID]
+		if !found || queue.PreemptionLimit == nil {
+			delete(ph.evictions, queueID)
+			continue
+		}
+		windowStart := now.Add(-queue.PreemptionLimit.Window.Duration)
+		inWindow := times[:0]
+		for _, evictionTime := range times {
+			if evictionTime.After(windowStart) {
+				inWindow = append(inWindow, evictionTime)
+			}
+		}
+		ph.evictions[queueID] = inWindow
+	}
+}
+
+// Count returns the number of pods evicted for the queue that are still in the history
+func (ph *PreemptionHistory) Count(queueID common_info.QueueID) int {
+	ph.mutex.Lock()
+	defer ph.mutex.Unlock()
+	return len(ph.evictions[queueID])
+}
+
+// Record adds the pods evicted for the queue at the given time to the history
+func (ph *PreemptionHistory) Record(queueID common_info.QueueID, pods int, now time.Time) {
+	ph.mutex.Lock()
+	defer ph.mutex.Unlock()
+	for range pods {
+		ph.evictions[queueID] = append(ph.evictions[queueID], now)
+	}
+}
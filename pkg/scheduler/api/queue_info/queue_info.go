@@ -20,8 +20,11 @@ limitations under the License.
 package queue_info
 
 import (
+	"time"
+
 	"golang.org/x/exp/slices"
 
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
@@ -40,6 +43,36 @@ type QueueInfo struct {
 	CreationTimestamp metav1.Time
 	PreemptMinRuntime *metav1.Duration
 	ReclaimMinRuntime *metav1.Duration
+	// MaxPodGroupMinRuntime caps the min runtime PodGroups of the queue can set. Nil when the queue does not set it.
+	MaxPodGroupMinRuntime *metav1.Duration
+	// PriorityQuotaCaps maps a priority class name to the maximal fraction of the queue's deserved quota
+	PriorityQuotaCaps map[string]float64
+	// LoanPaybackMultiplier multiplies the over-quota weight of the queue while it has outstanding loans.
+	// Zero when loan payback is disabled for the queue.
+	LoanPaybackMultiplier float64
+	// EvictionMethod is how the pods of the queue's workloads are evicted. Empty when the queue does not set it.
+	EvictionMethod enginev2.EvictionMethod
+	// Preemptibility is the preemptibility settings of the queue's workloads. Nil when the queue does not set it.
+	Preemptibility *enginev2.QueuePreemptibility
+	// WorkloadClasses is the workload class settings of the queue's workloads. Nil when the queue does not set it.
+	WorkloadClasses *enginev2.QueueWorkloadClasses
+	// GPUDeviceSelection is how the devices of a node are selected for the queue's fractional GPU workloads. Empty
+	// when the queue does not set it.
+	GPUDeviceSelection enginev2.GPUDeviceSelectionPolicy
+	// ReportedReclaimable is the reclaimable resources in the status of the queue, by node pool
+	ReportedReclaimable map[string]v1.ResourceList
+	// Burst is the allowance of the queue to exceed its deserved GPU quota. Nil when the queue does not set it.
+	Burst *enginev2.QueueBurst
+	// Budget is the GPU hours the queue can consume in a period. Nil when the queue does not set it.
+	Budget *enginev2.QueueBudget
+	// BudgetStatus is the consumption of the budget reported in the status of the queue
+	BudgetStatus *enginev2.QueueBudgetStatus
+	// Consolidation is the consolidation settings of the queue's workloads. Nil when the queue does not set it.
+	Consolidation *enginev2.QueueConsolidation
+	// PreemptionLimit caps the pods evicted for the queue's workloads in a window. Nil when the queue does not set it.
+	PreemptionLimit *enginev2.QueuePreemptionLimit
+	// NodePools are the other node pools in which the queue is entitled to its quota
+	NodePools []string
 }
 
 func NewQueueInfo(queue *enginev2.Queue) *QueueInfo {
@@ -63,9 +96,38 @@ func NewQueueInfo(queue *enginev2.Queue) *QueueInfo {
 		CreationTimestamp: queue.CreationTimestamp,
 		PreemptMinRuntime: queue.Spec.PreemptMinRuntime,
 		ReclaimMinRuntime: queue.Spec.ReclaimMinRuntime,
+
+		MaxPodGroupMinRuntime: queue.Spec.MaxPodGroupMinRuntime,
+		PriorityQuotaCaps:     getPriorityQuotaCaps(queue.Spec.PriorityQuotaCaps),
+
+		LoanPaybackMultiplier: getLoanPaybackMultiplier(queue.Spec.LoanPayback),
+		EvictionMethod:        queue.Spec.EvictionMethod,
+		Preemptibility:        queue.Spec.Preemptibility,
+		WorkloadClasses:       queue.Spec.WorkloadClasses,
+		GPUDeviceSelection:    queue.Spec.GPUDeviceSelection,
+		ReportedReclaimable:   queue.Status.Reclaimable,
+		Burst:                 queue.Spec.Burst,
+		Budget:                queue.Spec.Budget,
+		BudgetStatus:          queue.Status.Budget,
+		Consolidation:         queue.Spec.Consolidation,
+		PreemptionLimit:       queue.Spec.PreemptionLimit,
+		NodePools:             queue.Spec.NodePools,
 	}
 }
 
+// Clone returns a copy of the queue info without its child queues, which are added by the hierarchy of the queues
+// of the snapshot it is cloned into
+func (q *QueueInfo) Clone() *QueueInfo {
+	clone := *q
+	clone.ChildQueues = []common_info.QueueID{}
+	return &clone
+}
+
+// IsBudgetExhausted returns true if the queue consumed its GPU hours budget in the period that includes the given time
+func (q *QueueInfo) IsBudgetExhausted(now time.Time) bool {
+	return q.Budget != nil && q.Budget.IsExhausted(q.BudgetStatus, now)
+}
+
 func (q *QueueInfo) IsLeafQueue() bool {
 	return len(q.ChildQueues) == 0
 }
@@ -77,14 +139,41 @@ func (q *QueueInfo) AddChildQueue(queue common_info.QueueID) {
 	q.ChildQueues = append(q.ChildQueues, queue)
 }
 
+func getPriorityQuotaCaps(caps []enginev2.PriorityQuotaCap) map[string]float64 {
+	if len(caps) == 0 {
+		return nil
+	}
+
+	priorityQuotaCaps := make(map[string]float64, len(caps))
+	for _, quotaCap := range caps {
+		priorityQuotaCaps[quotaCap.PriorityClassName] = float64(quotaCap.MaxQuotaPercentage) / 100
+	}
+	return priorityQuotaCaps
+}
+
+func getLoanPaybackMultiplier(loanPayback *enginev2.LoanPayback) float64 {
+	if loanPayback == nil {
+		return 0
+	}
+	if loanPayback.OverQuotaWeightMultiplier == nil {
+		return commonconstants.DefaultLoanPaybackWeightMultiplier
+	}
+	return *loanPayback.OverQuotaWeightMultiplier
+}
+
 func getQueueQuota(queue enginev2.Queue) QueueQuota {
 	if queue.Spec.Resources == nil {
 		return QueueQuota{}
 	}
 
-	return QueueQuota{
+	quota := QueueQuota{
 		GPU:    ResourceQuota(queue.Spec.Resources.GPU),
 		CPU:    ResourceQuota(queue.Spec.Resources.CPU),
 		Memory: ResourceQuota(queue.Spec.Resources.Memory),
 	}
+	// The GPU quota of a queue with a quota rollout is the quota applied so far by the queue controller
+	if queue.Spec.QuotaRollout != nil && queue.Status.QuotaRollout != nil {
+		quota.GPU.Quota = queue.Status.QuotaRollout.AppliedGPUQuota
+	}
+	return quota
 }
@@ -194,6 +194,49 @@ func TestNewQueueInfo(t *testing.T) {
 				CreationTimestamp: metav1.Time{},
 			},
 		},
+		{
+			name: "queue with quota rollout",
+			queue: &enginev2.Queue{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "queue",
+				},
+				Spec: enginev2.QueueSpec{
+					Resources: &enginev2.QueueResources{
+						GPU: enginev2.QueueResource{
+							Quota:           8,
+							OverQuotaWeight: 1,
+							Limit:           -1,
+						},
+					},
+					QuotaRollout: &enginev2.QueueQuotaRollout{
+						StepPercentage: 25,
+						StepInterval:   metav1.Duration{Duration: time.Hour},
+					},
+				},
+				Status: enginev2.QueueStatus{
+					QuotaRollout: &enginev2.QueueQuotaRolloutStatus{
+						Phase:           enginev2.QuotaRolloutProgressing,
+						FromGPUQuota:    4,
+						TargetGPUQuota:  8,
+						AppliedGPUQuota: 5,
+					},
+				},
+			},
+			expected: QueueInfo{
+				UID:         "queue",
+				Name:        "queue",
+				ChildQueues: []common_info.QueueID{},
+				Resources: QueueQuota{
+					GPU: ResourceQuota{
+						Quota:           5,
+						OverQuotaWeight: 1,
+						Limit:           -1,
+					},
+				},
+				Priority:          100,
+				CreationTimestamp: metav1.Time{},
+			},
+		},
 	}
 
 	for _, tt := range tests {
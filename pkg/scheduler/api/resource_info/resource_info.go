@@ -26,6 +26,7 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 
+	commonresources "github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info/resources"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/k8s_internal"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
@@ -53,12 +54,12 @@ func NewResource(milliCPU float64, memory float64, gpus float64) *Resource {
 func ResourceFromResourceList(rList v1.ResourceList) *Resource {
 	r := EmptyResource()
 	for rName, rQuant := range rList {
-		switch rName {
-		case v1.ResourceCPU:
+		switch {
+		case rName == v1.ResourceCPU:
 			r.milliCpu += float64(rQuant.MilliValue())
-		case v1.ResourceMemory:
+		case rName == v1.ResourceMemory:
 			r.memory += float64(rQuant.Value())
-		case GPUResourceName, amdGpuResourceName:
+		case commonresources.IsAcceleratorResource(rName):
 			r.gpus += float64(rQuant.Value())
 		default:
 			if IsMigResource(rName) {
@@ -84,8 +85,8 @@ func (r *Resource) Sub(other *Resource) {
 }
 
 func (r *Resource) Get(rn v1.ResourceName) float64 {
-	switch rn {
-	case GPUResourceName, amdGpuResourceName:
+	switch {
+	case commonresources.IsAcceleratorResource(rn):
 		return r.gpus
 	default:
 		return r.BaseResource.Get(rn)
@@ -10,12 +10,12 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	commonresources "github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/k8s_internal"
 )
 
 const (
-	GPUResourceName    = "nvidia.com/gpu"
-	amdGpuResourceName = "amd.com/gpu"
+	GPUResourceName = "nvidia.com/gpu"
 )
 
 type ResourceRequirements struct {
@@ -44,12 +44,12 @@ func NewResourceRequirementsWithGpus(gpus float64) *ResourceRequirements {
 func RequirementsFromResourceList(rl v1.ResourceList) *ResourceRequirements {
 	r := EmptyResourceRequirements()
 	for rName, rQuant := range rl {
-		switch rName {
-		case v1.ResourceCPU:
+		switch {
+		case rName == v1.ResourceCPU:
 			r.milliCpu += float64(rQuant.MilliValue())
-		case v1.ResourceMemory:
+		case rName == v1.ResourceMemory:
 			r.memory += float64(rQuant.Value())
-		case GPUResourceName, amdGpuResourceName:
+		case commonresources.IsAcceleratorResource(rName):
 			if rQuant.Value() >= wholeGpuPortion {
 				r.count += rQuant.Value()
 				r.portion = wholeGpuPortion
@@ -165,8 +165,8 @@ func (r *ResourceRequirements) DetailedString() string {
 }
 
 func (r *ResourceRequirements) Get(rn v1.ResourceName) float64 {
-	switch rn {
-	case GPUResourceName, amdGpuResourceName:
+	switch {
+	case commonresources.IsAcceleratorResource(rn):
 		return r.GPUs()
 	default:
 		if IsMigResource(rn) {
@@ -4,10 +4,14 @@
 package storageclass_info
 
 import (
+	v1 "k8s.io/api/core/v1"
+
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 )
 
 type StorageClassInfo struct {
 	ID          common_info.StorageClassID
 	Provisioner string
+	// AllowedTopologies restricts the nodes on which volumes of the storage class can be provisioned
+	AllowedTopologies []v1.TopologySelectorTerm
 }
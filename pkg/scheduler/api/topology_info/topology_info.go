@@ -11,13 +11,24 @@ import (
 )
 
 type TopologyConstraintInfo struct {
-	PreferredLevel string
-	RequiredLevel  string
-	Topology       string
+	PreferredLevel      string
+	RequiredLevel       string
+	SubGroupSpreadLevel string
+	Topology            string
+	// PreferredWeight is the percentage of the topology packing score applied to the nodes in the preferred level
+	// domains, nil when the weight is inherited from the parent sub-group
+	PreferredWeight *int32
 
 	schedulingConstraintsSignature common_info.SchedulingConstraintsSignature
 }
 
+func (tc *TopologyConstraintInfo) GetPreferredWeight() *int32 {
+	if tc == nil {
+		return nil
+	}
+	return tc.PreferredWeight
+}
+
 func (tc *TopologyConstraintInfo) GetSchedulingConstraintsSignature() common_info.SchedulingConstraintsSignature {
 	if tc == nil {
 		return ""
@@ -32,7 +43,7 @@ func (tc *TopologyConstraintInfo) GetSchedulingConstraintsSignature() common_inf
 
 func (tc *TopologyConstraintInfo) generateSchedulingConstraintsSignature() common_info.SchedulingConstraintsSignature {
 	hash := sha256.New()
-	hash.Write([]byte(fmt.Sprintf("%s:%s:%s", tc.Topology, tc.RequiredLevel, tc.PreferredLevel)))
+	hash.Write([]byte(fmt.Sprintf("%s:%s:%s:%s", tc.Topology, tc.RequiredLevel, tc.PreferredLevel, tc.SubGroupSpreadLevel)))
 
 	return common_info.SchedulingConstraintsSignature(fmt.Sprintf("%x", hash.Sum(nil)))
 }
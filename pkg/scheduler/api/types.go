@@ -76,6 +76,15 @@ type BindRequestMutateFn func(pod *pod_info.PodInfo, nodeName string) map[string
 // PreJobAllocationFn is used for notifying on job allocation start
 type PreJobAllocationFn func(job *podgroup_info.PodGroupInfo)
 
+// PostJobAllocationFn is used for notifying on a committed job allocation
+type PostJobAllocationFn func(job *podgroup_info.PodGroupInfo)
+
+// DeferJobBindFn returns true when the bind of an allocated job should be deferred, in which case the job is pipelined
+type DeferJobBindFn func(job *podgroup_info.PodGroupInfo) bool
+
+// PostActionsFn is called once all the actions of the session were executed, before the session is closed
+type PostActionsFn func()
+
 // CompareQueueFn is used to compare two queues for ordering based on their jobs and victims.
 type CompareQueueFn func(
 	lQ, rQ *queue_info.QueueInfo,
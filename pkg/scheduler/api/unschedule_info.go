@@ -5,6 +5,7 @@ package api
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
@@ -114,6 +115,16 @@ func getGangEvictionWithUndefinedSubGroupMessage(taskNamespace, taskName, subGro
 		subGroup, taskNamespace, taskName)
 }
 
+func GetReplacedEvictionMessage(replacedTask *pod_info.PodInfo, replacingJob *podgroup_info.PodGroupInfo) string {
+	return fmt.Sprintf("Pod %s/%s was evicted because workload %s/%s replaced it and is running",
+		replacedTask.Namespace, replacedTask.Name, replacingJob.Namespace, replacingJob.Name)
+}
+
+func GetStartSkewEvictionMessage(task *pod_info.PodInfo, startSkew *podgroup_info.StartSkewInfo) string {
+	return fmt.Sprintf("Pod %s/%s was evicted because the pods of its gang started %s apart, more than the allowed %s",
+		task.Namespace, task.Name, startSkew.Skew.Round(time.Second), startSkew.MaxSkew)
+}
+
 func GetPreemptMessage(preemptorJob *podgroup_info.PodGroupInfo, preempteeTask *pod_info.PodInfo) string {
 	return fmt.Sprintf("Pod %s/%s was preempted by higher priority workload %s/%s", preempteeTask.Namespace,
 		preempteeTask.Name, preemptorJob.Namespace, preemptorJob.Name)
@@ -27,6 +27,7 @@ import (
 
 	"go.uber.org/multierr"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -37,12 +38,14 @@ import (
 	listv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/record"
 	k8sframework "k8s.io/kubernetes/pkg/scheduler/framework"
+	"k8s.io/utils/clock"
 
 	kubeaischedulerver "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned"
 	kubeaischedulerschema "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned/scheme"
 	kubeaischedulerinfo "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/informers/externalversions"
 	enginelisters "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/listers/scheduling/v2alpha2"
 	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	featuregates "github.com/NVIDIA/KAI-scheduler/pkg/common/feature_gates"
 	draversionawareclient "github.com/NVIDIA/KAI-scheduler/pkg/common/resources/dra_version_aware_client"
@@ -92,6 +95,9 @@ type SchedulerCacheParams struct {
 	NumOfStatusRecordingWorkers int
 	UpdatePodEvictionCondition  bool
 	DiscoveryClient             discovery.DiscoveryInterface
+	// Clock is the source of the current time of the snapshots, such as the expiry of scheduling freezes and the
+	// staleness of the usage data. The real clock is used when it isn't set.
+	Clock clock.PassiveClock
 }
 
 type SchedulerCache struct {
@@ -132,6 +138,10 @@ func newSchedulerCache(schedulerCacheParams *SchedulerCacheParams) *SchedulerCac
 	}
 
 	schedulerName := schedulerCacheParams.SchedulerName
+	cacheClock := schedulerCacheParams.Clock
+	if cacheClock == nil {
+		cacheClock = clock.RealClock{}
+	}
 
 	// Prepare event clients.
 	broadcaster := record.NewBroadcaster()
@@ -163,11 +173,12 @@ func newSchedulerCache(schedulerCacheParams *SchedulerCacheParams) *SchedulerCac
 		sc.usageLister = usagedb.NewUsageLister(schedulerCacheParams.UsageDBClient,
 			&schedulerCacheParams.UsageDBParams.FetchInterval.Duration,
 			&schedulerCacheParams.UsageDBParams.StalenessPeriod.Duration,
-			&schedulerCacheParams.UsageDBParams.WaitTimeout.Duration)
+			&schedulerCacheParams.UsageDBParams.WaitTimeout.Duration, cacheClock)
 	}
 
 	clusterInfo, err := cluster_info.New(sc.informerFactory, sc.kubeAiSchedulerInformerFactory, sc.usageLister, sc.schedulingNodePoolParams,
-		sc.restrictNodeScheduling, &sc.K8sClusterPodAffinityInfo, sc.scheduleCSIStorage, sc.fullHierarchyFairness, sc.StatusUpdater)
+		sc.restrictNodeScheduling, &sc.K8sClusterPodAffinityInfo, sc.scheduleCSIStorage, sc.fullHierarchyFairness, sc.StatusUpdater,
+		schedulerName, cacheClock)
 
 	if err != nil {
 		log.InfraLogger.Errorf("Failed to create cluster info object: %v", err)
@@ -230,23 +241,30 @@ func (sc *SchedulerCache) Evict(evictedPod *v1.Pod, evictedPodGroup *podgroup_in
 		return fmt.Errorf("received an eviction attempt for a terminated task: <%v/%v>", pod.Namespace, pod.Name)
 	}
 
-	sc.evict(pod, podGroup, evictionMetadata, message)
+	sc.evict(pod, podGroup, evictedPodGroup.EvictionMethod, evictionMetadata, message)
 	return nil
 }
 
-func (sc *SchedulerCache) evict(evictedPod *v1.Pod, evictedPodGroup *enginev2alpha2.PodGroup, evictionMetadata eviction_info.EvictionMetadata, message string) {
+func (sc *SchedulerCache) evict(evictedPod *v1.Pod, evictedPodGroup *enginev2alpha2.PodGroup,
+	evictionMethod enginev2.EvictionMethod, evictionMetadata eviction_info.EvictionMetadata, message string) {
 	sc.workersWaitGroup.Add(1)
 	go func() {
 		defer sc.workersWaitGroup.Done()
-		if len(message) > 0 {
+		// The eviction API can refuse the eviction, so the pod group is reported as evicted only once it succeeded
+		reportAfterEviction := evictionMethod == enginev2.EvictionMethodEvictionAPI
+		if len(message) > 0 && !reportAfterEviction {
 			sc.StatusUpdater.Evicted(evictedPodGroup, evictionMetadata, message)
 		}
 
-		log.InfraLogger.V(6).Infof("Evicting pod %v/%v, reason: %v, message: %v",
-			evictedPod.Namespace, evictedPod.Name, status.Preempted, message)
-		err := sc.Evictor.Evict(evictedPod, message)
+		log.InfraLogger.V(6).Infof("Evicting pod %v/%v, reason: %v, method: %v, message: %v",
+			evictedPod.Namespace, evictedPod.Name, status.Preempted, evictionMethod, message)
+		err := sc.Evictor.Evict(evictedPod, message, evictionMethod)
 		if err != nil {
 			log.InfraLogger.Errorf("Failed to evict pod: %v/%v, error: %v", evictedPod.Namespace, evictedPod.Name, err)
+			return
+		}
+		if len(message) > 0 && reportAfterEviction {
+			sc.StatusUpdater.Evicted(evictedPodGroup, evictionMetadata, message)
 		}
 	}()
 }
@@ -323,8 +341,29 @@ func (sc *SchedulerCache) createBindRequest(podInfo *pod_info.PodInfo, nodeName
 		},
 	}
 
-	_, err := sc.kubeAiSchedulerClient.SchedulingV1alpha2().BindRequests(
-		podInfo.Namespace).Create(context.TODO(), bindRequest, metav1.CreateOptions{})
+	bindRequests := sc.kubeAiSchedulerClient.SchedulingV1alpha2().BindRequests(podInfo.Namespace)
+	_, err := bindRequests.Create(context.TODO(), bindRequest, metav1.CreateOptions{})
+	if !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	// The pod may have been replaced by its controller after the bind request of the previous pod with the same
+	// name was created. That request doesn't apply to the new pod, so it is replaced.
+	existing, getErr := bindRequests.Get(context.TODO(), bindRequest.Name, metav1.GetOptions{})
+	if getErr != nil {
+		return err
+	}
+	if bindrequest_info.NewBindRequestInfo(existing).IsForPod(podInfo.Pod) {
+		return err
+	}
+	log.InfraLogger.V(3).Infof("Replacing bind request <%s/%s> of a previous pod with the same name",
+		existing.Namespace, existing.Name)
+	if err = bindRequests.Delete(context.TODO(), existing.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &existing.UID},
+	}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	_, err = bindRequests.Create(context.TODO(), bindRequest, metav1.CreateOptions{})
 	return err
 }
 
@@ -365,6 +404,14 @@ func (sc *SchedulerCache) TaskPipelined(task *pod_info.PodInfo, message string)
 	sc.StatusUpdater.Pipelined(task.Pod, message)
 }
 
+func (sc *SchedulerCache) UpdateQueueReclaimable(queueName, nodePool string, reclaimable v1.ResourceList) {
+	sc.StatusUpdater.PatchQueueReclaimable(queueName, nodePool, reclaimable)
+}
+
+func (sc *SchedulerCache) UpdateCrossPoolReclaimCost(job *podgroup_info.PodGroupInfo, nodePool string, cost *int) {
+	sc.StatusUpdater.PatchPodGroupCrossPoolReclaimCost(job.PodGroup, nodePool, cost)
+}
+
 // +kubebuilder:rbac:groups="scheduling.run.ai",resources=bindrequests,verbs=delete
 
 // Clean Stale BindRequest
@@ -422,6 +469,10 @@ func (sc *SchedulerCache) KubeInformerFactory() informers.SharedInformerFactory
 	return sc.informerFactory
 }
 
+func (sc *SchedulerCache) KAISchedulerInformerFactory() kubeaischedulerinfo.SharedInformerFactory {
+	return sc.kubeAiSchedulerInformerFactory
+}
+
 func (sc *SchedulerCache) SnapshotSharedLister() k8sframework.NodeInfoLister {
 	return &sc.K8sClusterPodAffinityInfo
 }
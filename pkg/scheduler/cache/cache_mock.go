@@ -12,6 +12,7 @@ package cache
 import (
 	reflect "reflect"
 
+	externalversions "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/informers/externalversions"
 	api "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	eviction_info "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
 	pod_info "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
@@ -105,6 +106,20 @@ func (mr *MockCacheMockRecorder) InternalK8sPlugins() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InternalK8sPlugins", reflect.TypeOf((*MockCache)(nil).InternalK8sPlugins))
 }
 
+// KAISchedulerInformerFactory mocks base method.
+func (m *MockCache) KAISchedulerInformerFactory() externalversions.SharedInformerFactory {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KAISchedulerInformerFactory")
+	ret0, _ := ret[0].(externalversions.SharedInformerFactory)
+	return ret0
+}
+
+// KAISchedulerInformerFactory indicates an expected call of KAISchedulerInformerFactory.
+func (mr *MockCacheMockRecorder) KAISchedulerInformerFactory() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KAISchedulerInformerFactory", reflect.TypeOf((*MockCache)(nil).KAISchedulerInformerFactory))
+}
+
 // KubeClient mocks base method.
 func (m *MockCache) KubeClient() kubernetes.Interface {
 	m.ctrl.T.Helper()
@@ -200,6 +215,30 @@ func (mr *MockCacheMockRecorder) TaskPipelined(task, message any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskPipelined", reflect.TypeOf((*MockCache)(nil).TaskPipelined), task, message)
 }
 
+// UpdateCrossPoolReclaimCost mocks base method.
+func (m *MockCache) UpdateCrossPoolReclaimCost(job *podgroup_info.PodGroupInfo, nodePool string, cost *int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateCrossPoolReclaimCost", job, nodePool, cost)
+}
+
+// UpdateCrossPoolReclaimCost indicates an expected call of UpdateCrossPoolReclaimCost.
+func (mr *MockCacheMockRecorder) UpdateCrossPoolReclaimCost(job, nodePool, cost any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCrossPoolReclaimCost", reflect.TypeOf((*MockCache)(nil).UpdateCrossPoolReclaimCost), job, nodePool, cost)
+}
+
+// UpdateQueueReclaimable mocks base method.
+func (m *MockCache) UpdateQueueReclaimable(queueName, nodePool string, reclaimable v1.ResourceList) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateQueueReclaimable", queueName, nodePool, reclaimable)
+}
+
+// UpdateQueueReclaimable indicates an expected call of UpdateQueueReclaimable.
+func (mr *MockCacheMockRecorder) UpdateQueueReclaimable(queueName, nodePool, reclaimable any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQueueReclaimable", reflect.TypeOf((*MockCache)(nil).UpdateQueueReclaimable), queueName, nodePool, reclaimable)
+}
+
 // WaitForCacheSync mocks base method.
 func (m *MockCache) WaitForCacheSync(stopCh <-chan struct{}) {
 	m.ctrl.T.Helper()
@@ -21,15 +21,21 @@ package cluster_info
 
 import (
 	"fmt"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
 	resourceapi "k8s.io/api/resource/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/clock"
 
 	kubeAiSchedulerinfo "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/informers/externalversions"
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	pg "github.com/NVIDIA/KAI-scheduler/pkg/common/podgroup"
@@ -41,6 +47,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_affinity"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
@@ -64,6 +71,11 @@ type ClusterInfo struct {
 	nodePoolSelector         labels.Selector
 	fairnessLevelType        FairnessLevelType
 	collectUsageData         bool
+	podRequestCache          *podRequestCache
+	nodeSnapshotCache        *nodeSnapshotCache
+	queueSnapshotCache       *queueSnapshotCache
+	schedulerName            string
+	clock                    clock.PassiveClock
 }
 
 type FairnessLevelType string
@@ -85,14 +97,38 @@ func New(
 	includeCSIStorageObjects bool,
 	fullHierarchyFairness bool,
 	podGroupSync status_updater.PodGroupsSync,
+	schedulerName string,
+	clock clock.PassiveClock,
 ) (*ClusterInfo, error) {
 	indexers := cache.Indexers{
 		podByPodGroupIndexerName: podByPodGroupIndexer,
 	}
-	err := informerFactory.Core().V1().Pods().Informer().AddIndexers(indexers)
+	podInformer := informerFactory.Core().V1().Pods().Informer()
+	err := podInformer.AddIndexers(indexers)
 	if err != nil {
 		return nil, err
 	}
+	if err = podInformer.SetTransform(newPodInterner().transform); err != nil {
+		return nil, err
+	}
+	requestCache := newPodRequestCache()
+	if _, err = podInformer.AddEventHandler(requestCache.eventHandler()); err != nil {
+		return nil, err
+	}
+	queueCache := newQueueSnapshotCache()
+	queueInformer := kubeAiSchedulerInformerFactory.Scheduling().V2().Queues().Informer()
+	if _, err = queueInformer.AddEventHandler(queueCache.eventHandler()); err != nil {
+		return nil, err
+	}
+	// The storage objects of the snapshot are linked to its nodes and pods, so the nodes can't be kept between
+	// snapshots when they are included
+	var nodeCache *nodeSnapshotCache
+	if !includeCSIStorageObjects {
+		nodeCache = newNodeSnapshotCache()
+		if err = nodeCache.addEventHandlers(informerFactory, kubeAiSchedulerInformerFactory); err != nil {
+			return nil, err
+		}
+	}
 	nodePoolSelector, err := nodePoolParams.GetLabelSelector()
 	if err != nil {
 		return nil, fmt.Errorf("error getting nodes selector: %s", err)
@@ -113,6 +149,11 @@ func New(
 		fairnessLevelType:        fairnessLevelType,
 		podGroupSync:             podGroupSync,
 		collectUsageData:         usageLister != nil,
+		podRequestCache:          requestCache,
+		nodeSnapshotCache:        nodeCache,
+		queueSnapshotCache:       queueCache,
+		schedulerName:            schedulerName,
+		clock:                    clock,
 	}, nil
 }
 
@@ -129,7 +170,7 @@ func (c *ClusterInfo) Snapshot() (*api.ClusterInfo, error) {
 		return nil, fmt.Errorf("error snapshotting pods: %w", err)
 	}
 
-	snapshot.Nodes, snapshot.MinNodeGPUMemory, err = c.snapshotNodes(c.clusterPodAffinityInfo)
+	nodes, err := c.listNodes()
 	if err != nil {
 		err = errors.WithStack(fmt.Errorf("error snapshotting nodes: %w", err))
 		return nil, err
@@ -139,17 +180,16 @@ func (c *ClusterInfo) Snapshot() (*api.ClusterInfo, error) {
 		err = errors.WithStack(fmt.Errorf("error listing resource claims: %w", err))
 		return nil, err
 	}
-	snapshot.BindRequests, snapshot.BindRequestsForDeletedNodes, err = c.snapshotBindRequests(snapshot.Nodes)
+	snapshot.BindRequests, snapshot.BindRequestsForDeletedNodes, err = c.snapshotBindRequests(nodes)
 	if err != nil {
 		err = errors.WithStack(fmt.Errorf("error snapshotting bind requests: %w", err))
 		return nil, err
 	}
 
-	snapshot.Pods, err = c.addTasksToNodes(allPods, existingPods, snapshot.Nodes, snapshot.BindRequests, snapshot.ResourceClaims)
-	if err != nil {
-		err = errors.WithStack(fmt.Errorf("error adding tasks to nodes: %w", err))
-		return nil, err
-	}
+	snapshot.Nodes, snapshot.Pods = c.snapshotNodes(
+		nodes, allPods, existingPods, snapshot.BindRequests, snapshot.ResourceClaims)
+	snapshot.MinNodeGPUMemory = getMinNodeGPUMemory(snapshot.Nodes)
+	c.reserveNodesForOtherSchedulers(snapshot.Nodes, existingPods)
 
 	queues, err := c.snapshotQueues()
 	if err != nil {
@@ -173,6 +213,11 @@ func (c *ClusterInfo) Snapshot() (*api.ClusterInfo, error) {
 		return nil, err
 	}
 
+	snapshot.CrossPoolReclaimJobs, err = c.snapshotCrossPoolReclaimJobs(snapshot.Queues, existingPods)
+	if err != nil {
+		return nil, err
+	}
+
 	snapshot.ConfigMaps, err = c.snapshotConfigMaps()
 	if err != nil {
 		return nil, err
@@ -183,6 +228,16 @@ func (c *ClusterInfo) Snapshot() (*api.ClusterInfo, error) {
 		return nil, err
 	}
 
+	snapshot.SchedulingFreezes, err = c.snapshotSchedulingFreezes(c.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.PodDisruptionBudgets, err = c.dataLister.ListPodDisruptionBudgets()
+	if err != nil {
+		return nil, fmt.Errorf("error listing pod disruption budgets: %w", err)
+	}
+
 	if c.includeCSIStorageObjects {
 		log.InfraLogger.V(7).Infof("Advanced CSI scheduling enabled - snapshotting CSI storage objects")
 
@@ -211,6 +266,13 @@ func (c *ClusterInfo) Snapshot() (*api.ClusterInfo, error) {
 		snapshot.StorageClaims = filterStorageClaims(snapshot.StorageClaims, snapshot.StorageClasses)
 
 		linkStorageObjects(snapshot.StorageClaims, snapshot.StorageCapacities, existingPods, snapshot.Nodes)
+		restrictNodesAccessibleCapacities(snapshot.StorageClasses, snapshot.Nodes)
+
+		volumeLimits, err := c.snapshotCSINodeVolumeLimits()
+		if err != nil {
+			return nil, err
+		}
+		setNodesVolumeLimits(volumeLimits, snapshot.StorageClasses, existingPods, snapshot.Nodes)
 	} else {
 		log.InfraLogger.V(7).Infof("Advanced CSI scheduling not enabled - not snapshotting CSI storage objects")
 	}
@@ -226,31 +288,103 @@ func (c *ClusterInfo) Snapshot() (*api.ClusterInfo, error) {
 	return snapshot, nil
 }
 
-func (c *ClusterInfo) snapshotNodes(
-	clusterPodAffinityInfo pod_affinity.ClusterPodAffinityInfo,
-) (map[string]*node_info.NodeInfo, int64, error) {
+func (c *ClusterInfo) listNodes() ([]*v1.Node, error) {
 	nodes, err := c.dataLister.ListNodes()
 	if err != nil {
-		return nil, 0, fmt.Errorf("error listing nodes: %w", err)
+		return nil, fmt.Errorf("error listing nodes: %w", err)
 	}
 	if c.restrictNodeScheduling {
 		nodes = filterUnmarkedNodes(nodes)
 	}
+	return nodes, nil
+}
 
-	var minGPUMemory int64 = node_info.DefaultGpuMemory
+// snapshotNodes returns the nodes with the pods on them, and adds the pods to the existing pods. The nodes that didn't
+// change since the previous snapshot are cloned from the node snapshot cache, and the rest are built from their pods
+// and stored in it.
+func (c *ClusterInfo) snapshotNodes(nodes []*v1.Node, allPods []*v1.Pod,
+	existingPods map[common_info.PodID]*pod_info.PodInfo, bindRequests bindrequest_info.BindRequestMap,
+	draResourceClaims []*resourceapi.ResourceClaim) (map[string]*node_info.NodeInfo, []*v1.Pod) {
+	cachedNodes := c.nodeSnapshotCache.unchangedEntries(nodes)
+	nodeNames := sets.New[string](noNodeName)
+	for _, node := range nodes {
+		nodeNames.Insert(node.Name)
+	}
+
+	// Pod infos are built only for the pods of changed nodes and for unassigned pods
+	var podsToBuild []*v1.Pod
+	podsOfCachedNodes := map[string][]*v1.Pod{}
+	for _, pod := range allPods {
+		nodeName := getPodNodeName(pod, bindRequests)
+		if _, found := cachedNodes[nodeName]; found {
+			podsOfCachedNodes[nodeName] = append(podsOfCachedNodes[nodeName], pod)
+		} else if nodeNames.Has(nodeName) {
+			podsToBuild = append(podsToBuild, pod)
+		}
+	}
+	for nodeName, entry := range cachedNodes {
+		if !entry.hasPods(podsOfCachedNodes[nodeName], bindRequests) {
+			log.InfraLogger.V(6).Infof("Pods of node %s changed before their deltas were applied", nodeName)
+			delete(cachedNodes, nodeName)
+			podsToBuild = append(podsToBuild, podsOfCachedNodes[nodeName]...)
+		}
+	}
+	nodePodInfosMap, nodeReservationPodInfosMap := c.getNodeToPodInfosMap(podsToBuild, bindRequests, draResourceClaims)
+
+	// Nodes that are cached are built outside of the session, and are registered in its pod affinity info when they are
+	// cloned into it
+	var podAffinityInfo pod_affinity.ClusterPodAffinityInfo = unregisteredPodAffinityInfo{}
+	if c.nodeSnapshotCache == nil {
+		podAffinityInfo = c.clusterPodAffinityInfo
+	}
+	builtNodes := map[string]*node_info.NodeInfo{}
+	for _, node := range nodes {
+		if _, found := cachedNodes[node.Name]; !found {
+			builtNodes[node.Name] = node_info.NewNodeInfo(node, NewK8sNodePodAffinityInfo(node, podAffinityInfo))
+		}
+	}
+	c.populateDRAGPUs(builtNodes)
+	log.InfraLogger.V(4).Infof("Building %d nodes, and cloning %d unchanged nodes", len(builtNodes), len(cachedNodes))
 
-	resultNodes := map[string]*node_info.NodeInfo{}
+	resultNodes := make(map[string]*node_info.NodeInfo, len(nodes))
+	var resultPods []*v1.Pod
+	builtEntries := map[string]*nodeSnapshotCacheEntry{}
 	for _, node := range nodes {
-		podAffinityInfo := NewK8sNodePodAffinityInfo(node, clusterPodAffinityInfo)
-		resultNodes[node.Name] = node_info.NewNodeInfo(node, podAffinityInfo)
-		nodeGPUMemory := resultNodes[node.Name].MemoryOfEveryGpuOnNode
-		if nodeGPUMemory > node_info.DefaultGpuMemory {
-			minGPUMemory = min(minGPUMemory, resultNodes[node.Name].MemoryOfEveryGpuOnNode)
+		if nodeInfo, found := builtNodes[node.Name]; found {
+			podInfos := slices.Concat(nodeReservationPodInfosMap[node.Name], nodePodInfosMap[node.Name])
+			if c.nodeSnapshotCache == nil {
+				resultNodes[node.Name] = nodeInfo
+				resultPods = append(resultPods, addTasksToNode(nodeInfo, podInfos, existingPods)...)
+				continue
+			}
+			addTasksToNode(nodeInfo, podInfos, map[common_info.PodID]*pod_info.PodInfo{})
+			builtEntries[node.Name] = newNodeSnapshotCacheEntry(nodeInfo, podInfos)
+			cachedNodes[node.Name] = builtEntries[node.Name]
+		}
+
+		entry := cachedNodes[node.Name]
+		resultNodes[node.Name] = entry.clone(c.clusterPodAffinityInfo, existingPods, bindRequests)
+		for _, podInfo := range entry.podInfos {
+			resultPods = append(resultPods, podInfo.Pod)
 		}
 	}
+	c.nodeSnapshotCache.store(builtEntries, nodes)
 
-	c.populateDRAGPUs(resultNodes)
-	return resultNodes, minGPUMemory, nil
+	// Add generated podInfos to existingPodsMap
+	for _, podInfo := range nodePodInfosMap[noNodeName] {
+		existingPods[podInfo.UID] = podInfo
+	}
+	return resultNodes, resultPods
+}
+
+func getMinNodeGPUMemory(nodes map[string]*node_info.NodeInfo) int64 {
+	var minGPUMemory int64 = node_info.DefaultGpuMemory
+	for _, node := range nodes {
+		if node.MemoryOfEveryGpuOnNode > node_info.DefaultGpuMemory {
+			minGPUMemory = min(minGPUMemory, node.MemoryOfEveryGpuOnNode)
+		}
+	}
+	return minGPUMemory
 }
 
 // populateDRAGPUs counts GPUs from DRA ResourceSlices for nodes that don't have extended resources.
@@ -287,50 +421,53 @@ func (c *ClusterInfo) populateDRAGPUs(nodes map[string]*node_info.NodeInfo) {
 	}
 }
 
-func (c *ClusterInfo) addTasksToNodes(allPods []*v1.Pod, existingPodsMap map[common_info.PodID]*pod_info.PodInfo,
-	nodes map[string]*node_info.NodeInfo, bindRequests bindrequest_info.BindRequestMap, draResourceClaims []*resourceapi.ResourceClaim) (
-	[]*v1.Pod, error) {
+func addTasksToNode(node *node_info.NodeInfo, podInfos []*pod_info.PodInfo,
+	existingPodsMap map[common_info.PodID]*pod_info.PodInfo) []*v1.Pod {
+	resultPods := node.AddTasksToNode(podInfos, existingPodsMap)
 
-	nodePodInfosMap, nodeReservationPodInfosMap, err := c.getNodeToPodInfosMap(allPods, bindRequests, draResourceClaims)
-	if err != nil {
-		return nil, err
+	podNames := ""
+	for _, pi := range node.PodInfos {
+		podNames = fmt.Sprintf("%v, %v", podNames, pi.Name)
 	}
+	log.InfraLogger.V(6).Infof("Node: %v, indexed %d pods: %v", node.Name, len(node.PodInfos), podNames)
+	return resultPods
+}
 
-	var resultPods []*v1.Pod
-	for _, node := range nodes {
-		reservationPodInfos := nodeReservationPodInfosMap[node.Name]
-		result := node.AddTasksToNode(reservationPodInfos, existingPodsMap)
-		resultPods = append(resultPods, result...)
-
-		podInfos := nodePodInfosMap[node.Name]
-		result = node.AddTasksToNode(podInfos, existingPodsMap)
-		resultPods = append(resultPods, result...)
-
-		podNames := ""
-		for _, pi := range node.PodInfos {
-			podNames = fmt.Sprintf("%v, %v", podNames, pi.Name)
+// reserveNodesForOtherSchedulers keeps the resources that pods of other schedulers may use out of the idle resources of
+// the nodes. Pods of other schedulers that are nominated to a node are about to be bound to it, so their resources are
+// reserved on that node.
+func (c *ClusterInfo) reserveNodesForOtherSchedulers(nodes map[string]*node_info.NodeInfo,
+	existingPodsMap map[common_info.PodID]*pod_info.PodInfo) {
+	nominatedPods := map[string][]*pod_info.PodInfo{}
+	for _, podInfo := range existingPodsMap {
+		nominatedNodeName := podInfo.Pod.Status.NominatedNodeName
+		if podInfo.NodeName != noNodeName || nominatedNodeName == "" ||
+			podInfo.Pod.Spec.SchedulerName == c.schedulerName || podInfo.Status != pod_status.Pending {
+			continue
 		}
-		log.InfraLogger.V(6).Infof("Node: %v, indexed %d pods: %v", node.Name, len(node.PodInfos), podNames)
+		nominatedPods[nominatedNodeName] = append(nominatedPods[nominatedNodeName], podInfo)
 	}
 
-	// Add generated podInfos to existingPodsMap
-	for _, podInfo := range nodePodInfosMap[noNodeName] {
-		existingPodsMap[podInfo.UID] = podInfo
+	for _, node := range nodes {
+		node.ReserveForOtherSchedulers(c.schedulerName, nominatedPods[node.Name])
 	}
-	return resultPods, nil
 }
 
-func (c *ClusterInfo) snapshotBindRequests(nodes map[string]*node_info.NodeInfo) (
+func (c *ClusterInfo) snapshotBindRequests(nodes []*v1.Node) (
 	bindrequest_info.BindRequestMap, []*bindrequest_info.BindRequestInfo, error) {
 	bindRequests, err := c.dataLister.ListBindRequests()
 	if err != nil {
 		return nil, nil, fmt.Errorf("error listing bind requests: %w", err)
 	}
+	nodeNames := sets.New[string]()
+	for _, node := range nodes {
+		nodeNames.Insert(node.Name)
+	}
 
 	result := bindrequest_info.BindRequestMap{}
 	requestsForDeletedNodes := []*bindrequest_info.BindRequestInfo{}
 	for _, bindRequest := range bindRequests {
-		if _, found := nodes[bindRequest.Spec.SelectedNode]; !found {
+		if !nodeNames.Has(bindRequest.Spec.SelectedNode) {
 			if c.nodePoolSelector.Matches(labels.Set(bindRequest.Labels)) {
 				bri := bindrequest_info.NewBindRequestInfo(bindRequest)
 				requestsForDeletedNodes = append(requestsForDeletedNodes, bri)
@@ -370,38 +507,125 @@ func (c *ClusterInfo) snapshotPodGroups(
 
 	result := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{}
 	for _, podGroup := range podGroups {
-		podGroupID := common_info.PodGroupID(podGroup.Name)
-		podGroupInfo := podgroup_info.NewPodGroupInfo(podGroupID)
+		podGroupInfo, err := c.snapshotPodGroup(podGroup, defaultPriority, existingQueues, existingPods)
+		if err != nil {
+			return nil, err
+		}
+		result[common_info.PodGroupID(podGroup.Name)] = podGroupInfo
+	}
 
-		if err := validatePodgroupQueue(existingQueues, podGroup); err != nil {
-			log.InfraLogger.V(7).Infof("Queue validation failed for podgroup <%s/%s>: %v",
-				podGroup.Namespace, podGroup.Name, err)
-			podGroupInfo.AddSimpleJobFitError(enginev2alpha2.QueueDoesNotExist, err.Error())
-		} else {
-			c.setPodGroupPriorityAndPreemptibility(podGroupInfo, podGroup, defaultPriority)
+	return result, nil
+}
+
+func (c *ClusterInfo) snapshotPodGroup(
+	podGroup *enginev2alpha2.PodGroup,
+	defaultPriority int32,
+	existingQueues map[common_info.QueueID]*queue_info.QueueInfo,
+	existingPods map[common_info.PodID]*pod_info.PodInfo,
+) (*podgroup_info.PodGroupInfo, error) {
+	podGroupID := common_info.PodGroupID(podGroup.Name)
+	podGroupInfo := podgroup_info.NewPodGroupInfo(podGroupID)
+
+	if err := validatePodgroupQueue(existingQueues, podGroup); err != nil {
+		log.InfraLogger.V(7).Infof("Queue validation failed for podgroup <%s/%s>: %v",
+			podGroup.Namespace, podGroup.Name, err)
+		podGroupInfo.AddSimpleJobFitError(enginev2alpha2.QueueDoesNotExist, err.Error())
+	} else {
+		c.setPodGroupPriorityAndPreemptibility(podGroupInfo, podGroup, defaultPriority, existingQueues)
+		c.setPodGroupEvictionMethod(podGroupInfo, podGroup, existingQueues)
+		c.setPodGroupGPUDeviceSelection(podGroupInfo, podGroup, existingQueues)
+		setPodGroupDoNotConsolidate(podGroupInfo, podGroup, existingQueues)
+	}
+
+	c.setPodGroupWithIndex(podGroup, podGroupInfo)
+	rawPods, err := c.dataLister.ListPodByIndex(podByPodGroupIndexerName, podGroup.Name)
+	if err != nil {
+		log.InfraLogger.Errorf("failed to get indexed pods: %s", err)
+		return nil, err
+	}
+	for _, rawPod := range rawPods {
+		pod, ok := rawPod.(*v1.Pod)
+		if !ok {
+			log.InfraLogger.Errorf("Snapshot podGroups: Error getting pod from rawPod: %v", rawPod)
+		}
+		podInfo := c.getPodInfo(pod, existingPods)
+		applyPodGroupSchedulingConstraints(podInfo, podGroup)
+		podGroupInfo.AddTaskInfo(podInfo)
+	}
+	setPodGroupScavengerQueue(podGroupInfo, podGroup, existingQueues)
+
+	return podGroupInfo, nil
+}
+
+// snapshotCrossPoolReclaimJobs returns the pending jobs of other node pools that ask for the reclaim cost of this node
+// pool, for queues that are entitled to this node pool
+func (c *ClusterInfo) snapshotCrossPoolReclaimJobs(
+	existingQueues map[common_info.QueueID]*queue_info.QueueInfo,
+	existingPods map[common_info.PodID]*pod_info.PodInfo,
+) (map[common_info.PodGroupID]*podgroup_info.PodGroupInfo, error) {
+	result := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{}
+	if !c.isCrossPoolReclaimEnabled() {
+		return result, nil
+	}
+
+	podGroups, err := c.dataLister.ListCrossPoolReclaimPodGroups()
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("error listing cross pool reclaim podgroups: %w", err))
+	}
+	var defaultPriority int32
+	if len(podGroups) > 0 {
+		if defaultPriority, err = getDefaultPriority(c.dataLister); err != nil {
+			return nil, err
 		}
+	}
 
-		c.setPodGroupWithIndex(podGroup, podGroupInfo)
-		rawPods, err := c.dataLister.ListPodByIndex(podByPodGroupIndexerName, podGroup.Name)
+	for _, podGroup := range podGroups {
+		nodePool := utils.GetNodePoolNameFromLabels(podGroup.Labels, c.nodePoolParams.NodePoolLabelKey)
+		if nodePool == c.nodePoolParams.NodePoolLabelValue {
+			continue
+		}
+		if _, found := existingQueues[common_info.QueueID(podGroup.Spec.Queue)]; !found {
+			continue
+		}
+		podGroupInfo, err := c.snapshotPodGroup(podGroup.DeepCopy(), defaultPriority, existingQueues, existingPods)
 		if err != nil {
-			log.InfraLogger.Errorf("failed to get indexed pods: %s", err)
 			return nil, err
 		}
-		for _, rawPod := range rawPods {
-			pod, ok := rawPod.(*v1.Pod)
-			if !ok {
-				log.InfraLogger.Errorf("Snapshot podGroups: Error getting pod from rawPod: %v", rawPod)
-			}
-			podInfo := c.getPodInfo(pod, existingPods)
-			podGroupInfo.AddTaskInfo(podInfo)
+		if podGroupInfo.GetNumPendingTasks() == 0 {
+			continue
 		}
-
-		result[common_info.PodGroupID(podGroup.Name)] = podGroupInfo
+		podGroupInfo.CrossPoolReclaim.Candidate = true
+		result[podGroupInfo.UID] = podGroupInfo
 	}
-
 	return result, nil
 }
 
+// isCrossPoolReclaimEnabled returns whether the scheduler schedules a named node pool, which queues of other node
+// pools can be entitled to
+func (c *ClusterInfo) isCrossPoolReclaimEnabled() bool {
+	return c.nodePoolParams != nil && c.nodePoolParams.NodePoolLabelKey != "" &&
+		c.nodePoolParams.NodePoolLabelValue != ""
+}
+
+// setPodGroupScavengerQueue moves jobs that are allocated under the scavenger queue back to it. Jobs that are no
+// longer allocated are scheduled under their own queue again.
+func setPodGroupScavengerQueue(
+	podGroupInfo *podgroup_info.PodGroupInfo,
+	podGroup *enginev2alpha2.PodGroup,
+	existingQueues map[common_info.QueueID]*queue_info.QueueInfo,
+) {
+	scavengerQueue := common_info.QueueID(podGroup.Labels[constants.ScavengerQueueLabelKey])
+	if scavengerQueue == "" || podGroupInfo.GetNumAllocatedTasks() == 0 {
+		return
+	}
+	if _, found := existingQueues[scavengerQueue]; !found {
+		log.InfraLogger.V(2).Warnf("Scavenger queue <%s> of podgroup <%s/%s> does not exist",
+			scavengerQueue, podGroup.Namespace, podGroup.Name)
+		return
+	}
+	podGroupInfo.Scavenge(scavengerQueue)
+}
+
 func validatePodgroupQueue(existingQueues map[common_info.QueueID]*queue_info.QueueInfo, podGroup *enginev2alpha2.PodGroup) error {
 	_, queueExists := existingQueues[common_info.QueueID(podGroup.Spec.Queue)]
 	if !queueExists {
@@ -414,16 +638,170 @@ func (c *ClusterInfo) setPodGroupPriorityAndPreemptibility(
 	podGroupInfo *podgroup_info.PodGroupInfo,
 	podGroup *enginev2alpha2.PodGroup,
 	defaultPriority int32,
+	existingQueues map[common_info.QueueID]*queue_info.QueueInfo,
 ) {
 	podGroupInfo.Priority = getPodGroupPriority(podGroup, defaultPriority, c.dataLister)
 	log.InfraLogger.V(7).Infof("The priority of job <%s/%s> is <%s/%d>",
 		podGroup.Namespace, podGroup.Name, podGroup.Spec.PriorityClassName, podGroupInfo.Priority)
 
-	podGroupInfo.Preemptibility = pg.CalculatePreemptibility(podGroup.Spec.Preemptibility, podGroupInfo.Priority)
+	podGroupInfo.WorkloadClass = pg.ApplyQueueWorkloadClass(podGroup.Spec.WorkloadClass,
+		getQueueWorkloadClasses(common_info.QueueID(podGroup.Spec.Queue), existingQueues))
+
+	// The workload class of the podgroup determines its preemptibility, within the preemptibility settings of its queue
+	preemptibility := podGroup.Spec.Preemptibility
+	if classPreemptibility := podGroupInfo.WorkloadClass.Preemptibility(); classPreemptibility != "" {
+		preemptibility = classPreemptibility
+	}
+	preemptibility = pg.ApplyQueuePreemptibility(preemptibility,
+		getQueuePreemptibility(common_info.QueueID(podGroup.Spec.Queue), existingQueues))
+	podGroupInfo.Preemptibility = pg.CalculatePreemptibility(preemptibility, podGroupInfo.Priority)
 	log.InfraLogger.V(7).Infof("The preemptibility of job <%s/%s> is <%s>",
 		podGroup.Namespace, podGroup.Name, podGroupInfo.Preemptibility)
 }
 
+// getQueuePreemptibility returns the preemptibility settings of the queue or of its closest ancestor that sets them
+func getQueuePreemptibility(
+	queueID common_info.QueueID, existingQueues map[common_info.QueueID]*queue_info.QueueInfo,
+) *enginev2.QueuePreemptibility {
+	queue, found := existingQueues[queueID]
+	for found {
+		if queue.Preemptibility != nil {
+			return queue.Preemptibility
+		}
+		queue, found = existingQueues[queue.ParentQueue]
+	}
+	return nil
+}
+
+// getQueueWorkloadClasses returns the workload class settings of the queue or of its closest ancestor that sets them
+func getQueueWorkloadClasses(
+	queueID common_info.QueueID, existingQueues map[common_info.QueueID]*queue_info.QueueInfo,
+) *enginev2.QueueWorkloadClasses {
+	queue, found := existingQueues[queueID]
+	for found {
+		if queue.WorkloadClasses != nil {
+			return queue.WorkloadClasses
+		}
+		queue, found = existingQueues[queue.ParentQueue]
+	}
+	return nil
+}
+
+// setPodGroupDoNotConsolidate opts the pod group out of consolidation when it is annotated to, unless its queue or
+// the queue's closest ancestor that sets consolidation settings doesn't allow opting out.
+func setPodGroupDoNotConsolidate(
+	podGroupInfo *podgroup_info.PodGroupInfo,
+	podGroup *enginev2alpha2.PodGroup,
+	existingQueues map[common_info.QueueID]*queue_info.QueueInfo,
+) {
+	doNotConsolidate, err := strconv.ParseBool(podGroup.Annotations[constants.DoNotConsolidate])
+	if err != nil || !doNotConsolidate {
+		return
+	}
+
+	queue, found := existingQueues[common_info.QueueID(podGroup.Spec.Queue)]
+	for found {
+		if queue.Consolidation != nil {
+			if !queue.Consolidation.IsOptOutAllowed() {
+				log.InfraLogger.V(4).Infof("Queue <%s> doesn't allow podgroup <%s/%s> to opt out of consolidation",
+					queue.Name, podGroup.Namespace, podGroup.Name)
+				return
+			}
+			break
+		}
+		queue, found = existingQueues[queue.ParentQueue]
+	}
+	podGroupInfo.DoNotConsolidate = true
+}
+
+// setPodGroupEvictionMethod sets the eviction method of the pod group from the annotation of its priority class,
+// falling back to the eviction method of its queue or of the queue's closest ancestor that sets one.
+func (c *ClusterInfo) setPodGroupEvictionMethod(
+	podGroupInfo *podgroup_info.PodGroupInfo,
+	podGroup *enginev2alpha2.PodGroup,
+	existingQueues map[common_info.QueueID]*queue_info.QueueInfo,
+) {
+	podGroupInfo.EvictionMethod = enginev2.EvictionMethodDelete
+	if method, found := c.getPriorityClassEvictionMethod(podGroup.Spec.PriorityClassName); found {
+		podGroupInfo.EvictionMethod = method
+		return
+	}
+
+	queue, found := existingQueues[common_info.QueueID(podGroup.Spec.Queue)]
+	for found {
+		if queue.EvictionMethod != "" {
+			podGroupInfo.EvictionMethod = queue.EvictionMethod
+			return
+		}
+		queue, found = existingQueues[queue.ParentQueue]
+	}
+}
+
+func (c *ClusterInfo) getPriorityClassEvictionMethod(priorityClassName string) (enginev2.EvictionMethod, bool) {
+	method, found := c.getPriorityClassAnnotation(priorityClassName, constants.EvictionMethod)
+	if !found {
+		return "", false
+	}
+	if !isValidEvictionMethod(enginev2.EvictionMethod(method)) {
+		log.InfraLogger.Warningf("Priority class <%s> has an unknown eviction method <%s>, ignoring it",
+			priorityClassName, method)
+		return "", false
+	}
+	return enginev2.EvictionMethod(method), true
+}
+
+func isValidEvictionMethod(method enginev2.EvictionMethod) bool {
+	switch method {
+	case enginev2.EvictionMethodDelete, enginev2.EvictionMethodEvictionAPI, enginev2.EvictionMethodCustom:
+		return true
+	default:
+		return false
+	}
+}
+
+// setPodGroupGPUDeviceSelection sets the GPU device selection policy of the pod group from the annotation of its
+// priority class, falling back to the policy of its queue or of the queue's closest ancestor that sets one.
+func (c *ClusterInfo) setPodGroupGPUDeviceSelection(
+	podGroupInfo *podgroup_info.PodGroupInfo,
+	podGroup *enginev2alpha2.PodGroup,
+	existingQueues map[common_info.QueueID]*queue_info.QueueInfo,
+) {
+	policy, found := c.getPriorityClassAnnotation(podGroup.Spec.PriorityClassName, constants.GPUDeviceSelection)
+	if found {
+		if isValidGPUDeviceSelection(enginev2.GPUDeviceSelectionPolicy(policy)) {
+			podGroupInfo.GPUDeviceSelection = enginev2.GPUDeviceSelectionPolicy(policy)
+			return
+		}
+		log.InfraLogger.Warningf("Priority class <%s> has an unknown GPU device selection policy <%s>, ignoring it",
+			podGroup.Spec.PriorityClassName, policy)
+	}
+
+	queue, found := existingQueues[common_info.QueueID(podGroup.Spec.Queue)]
+	for found {
+		if queue.GPUDeviceSelection != "" {
+			podGroupInfo.GPUDeviceSelection = queue.GPUDeviceSelection
+			return
+		}
+		queue, found = existingQueues[queue.ParentQueue]
+	}
+}
+
+func isValidGPUDeviceSelection(policy enginev2.GPUDeviceSelectionPolicy) bool {
+	return policy == enginev2.GPUDeviceSelectionPack || policy == enginev2.GPUDeviceSelectionSpread
+}
+
+func (c *ClusterInfo) getPriorityClassAnnotation(priorityClassName string, key string) (string, bool) {
+	if priorityClassName == "" {
+		return "", false
+	}
+	priorityClass, err := c.dataLister.GetPriorityClassByName(priorityClassName)
+	if err != nil {
+		return "", false
+	}
+	value, found := priorityClass.Annotations[key]
+	return value, found
+}
+
 func (c *ClusterInfo) getPodInfo(
 	pod *v1.Pod, existingPods map[common_info.PodID]*pod_info.PodInfo,
 ) *pod_info.PodInfo {
@@ -435,19 +813,31 @@ func (c *ClusterInfo) getPodInfo(
 	if !found {
 		log.InfraLogger.V(6).Infof("Pod %s/%s/%s not found in existing pods, adding", pod.Namespace,
 			pod.Name, pod.UID)
-		podInfo = pod_info.NewTaskInfo(pod)
+		podInfo = pod_info.NewTaskInfoWithResourceRequest(pod, c.podRequestCache.resourceRequest(pod), nil)
 		existingPods[common_info.PodID(pod.UID)] = podInfo
 	}
 	return podInfo
 }
 
+// applyPodGroupSchedulingConstraints applies the tolerations and node selector of the pod group to its unassigned
+// pods, for pods that were created before their pod group and so weren't mutated by the admission webhook.
+func applyPodGroupSchedulingConstraints(podInfo *pod_info.PodInfo, podGroup *enginev2alpha2.PodGroup) {
+	if podInfo.NodeName != "" || (len(podGroup.Spec.Tolerations) == 0 && len(podGroup.Spec.NodeSelector) == 0) {
+		return
+	}
+	pod := podInfo.Pod.DeepCopy()
+	if pg.ApplySchedulingConstraints(pod, podGroup.Spec.Tolerations, podGroup.Spec.NodeSelector) {
+		podInfo.Pod = pod
+	}
+}
+
 func (c *ClusterInfo) setPodGroupWithIndex(podGroup *enginev2alpha2.PodGroup, podGroupInfo *podgroup_info.PodGroupInfo) {
 	podGroupInfo.SetPodGroup(podGroup)
 }
 
 func (c *ClusterInfo) getNodeToPodInfosMap(allPods []*v1.Pod,
 	bindRequests bindrequest_info.BindRequestMap, draResourceClaims []*resourceapi.ResourceClaim) (
-	map[string][]*pod_info.PodInfo, map[string][]*pod_info.PodInfo, error) {
+	map[string][]*pod_info.PodInfo, map[string][]*pod_info.PodInfo) {
 	nodePodInfosMap := map[string][]*pod_info.PodInfo{}
 	nodeReservationPodInfosMap := map[string][]*pod_info.PodInfo{}
 	draClaimMap := resource_info.ResourceClaimSliceToMap(draResourceClaims)
@@ -456,7 +846,8 @@ func (c *ClusterInfo) getNodeToPodInfosMap(allPods []*v1.Pod,
 	for _, pod := range allPods {
 		podBindRequest := bindRequests.GetBindRequestForPod(pod)
 		draPodClaims := resource_info.GetDraPodClaims(pod, draClaimMap, podsToClaimsMap)
-		podInfo := pod_info.NewTaskInfoWithBindRequest(pod, podBindRequest, draPodClaims...)
+		podInfo := pod_info.NewTaskInfoWithResourceRequest(
+			pod, c.podRequestCache.resourceRequest(pod), podBindRequest, draPodClaims...)
 
 		if pod_info.IsResourceReservationTask(podInfo.Pod) {
 			podInfos := nodeReservationPodInfosMap[podInfo.NodeName]
@@ -468,7 +859,18 @@ func (c *ClusterInfo) getNodeToPodInfosMap(allPods []*v1.Pod,
 			nodePodInfosMap[podInfo.NodeName] = podInfos
 		}
 	}
-	return nodePodInfosMap, nodeReservationPodInfosMap, nil
+	return nodePodInfosMap, nodeReservationPodInfosMap
+}
+
+// getPodNodeName returns the node of the pod, or the node selected for it by its bind request if it isn't bound yet
+func getPodNodeName(pod *v1.Pod, bindRequests bindrequest_info.BindRequestMap) string {
+	if pod.Spec.NodeName != "" {
+		return pod.Spec.NodeName
+	}
+	if bindRequest := bindRequests.GetBindRequestForPod(pod); bindRequest != nil {
+		return bindRequest.BindRequest.Spec.SelectedNode
+	}
+	return noNodeName
 }
 
 func (c *ClusterInfo) snapshotConfigMaps() (map[common_info.ConfigMapID]*configmap_info.ConfigMapInfo, error) {
@@ -494,6 +896,28 @@ func (c *ClusterInfo) snapshotTopologies() ([]*kaiv1alpha1.Topology, error) {
 	return topologies, nil
 }
 
+// snapshotSchedulingFreezes returns the scheduling freezes that pause the node pool of the scheduler at the given
+// time
+func (c *ClusterInfo) snapshotSchedulingFreezes(now time.Time) ([]*kaiv1alpha1.SchedulingFreeze, error) {
+	freezes, err := c.dataLister.ListSchedulingFreezes()
+	if err != nil {
+		return nil, fmt.Errorf("error listing scheduling freezes: %w", err)
+	}
+
+	var result []*kaiv1alpha1.SchedulingFreeze
+	for _, freeze := range freezes {
+		if !freeze.AppliesToNodePool(c.nodePoolParams.NodePoolLabelValue) {
+			continue
+		}
+		if !freeze.IsActive(now) {
+			log.InfraLogger.V(6).Infof("Scheduling freeze %s expired at %v", freeze.Name, freeze.Spec.ExpiresAt)
+			continue
+		}
+		result = append(result, freeze)
+	}
+	return result, nil
+}
+
 func getDefaultPriority(dataLister data_lister.DataLister) (int32, error) {
 	defaultPriority, found := int32(constants.DefaultPodGroupPriority), false
 	priorityClasses, err := dataLister.ListPriorityClasses()
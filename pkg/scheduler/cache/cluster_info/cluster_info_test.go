@@ -6,6 +6,8 @@ package cluster_info
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"testing"
 	"time"
 
@@ -24,6 +26,8 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/clock"
+	clocktesting "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 
 	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
@@ -463,17 +467,12 @@ func TestSnapshotNodes(t *testing.T) {
 			)
 			existingPods := map[common_info.PodID]*pod_info.PodInfo{}
 
-			controller := gomock.NewController(t)
-			clusterPodAffinityInfo := pod_affinity.NewMockClusterPodAffinityInfo(controller)
-			clusterPodAffinityInfo.EXPECT().UpdateNodeAffinity(gomock.Any()).AnyTimes()
-			clusterPodAffinityInfo.EXPECT().AddNode(gomock.Any(), gomock.Any()).AnyTimes()
-
 			allPods, _ := clusterInfo.dataLister.ListPods()
-			nodes, _, err := clusterInfo.snapshotNodes(clusterPodAffinityInfo)
+			listedNodes, err := clusterInfo.listNodes()
 			if err != nil {
 				assert.FailNow(t, fmt.Sprintf("SnapshotNode got error in test %s", t.Name()), err)
 			}
-			pods, err := clusterInfo.addTasksToNodes(allPods, existingPods, nodes, nil, nil)
+			nodes, pods := clusterInfo.snapshotNodes(listedNodes, allPods, existingPods, nil, nil)
 
 			assert.Equal(t, len(test.resultNodes), len(nodes))
 			assert.Equal(t, test.resultPodsLen, len(pods))
@@ -487,6 +486,47 @@ func TestSnapshotNodes(t *testing.T) {
 	}
 }
 
+func TestSnapshotReservesNodesForOtherSchedulers(t *testing.T) {
+	newNode := func(name string, annotations map[string]string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations},
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{"cpu": resource.MustParse("10")},
+			},
+		}
+	}
+	newNominatedPod := func(name, schedulerName, nominatedNode string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-ns", UID: types.UID(name)},
+			Spec: corev1.PodSpec{
+				SchedulerName: schedulerName,
+				Containers: []corev1.Container{{
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{"cpu": resource.MustParse("3")},
+					},
+				}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodPending, NominatedNodeName: nominatedNode},
+		}
+	}
+
+	clusterInfo := newClusterInfoTests(t, clusterInfoTestParams{
+		kubeObjects: []runtime.Object{
+			newNode("node-1", nil),
+			newNode("node-2", map[string]string{commonconstants.OtherSchedulersReservedPercentage: "20"}),
+			newNode("node-3", nil),
+			newNominatedPod("other-scheduler-pod", "default-scheduler", "node-1"),
+			newNominatedPod("kai-pod", commonconstants.DefaultSchedulerName, "node-3"),
+		},
+	})
+	snapshot, err := clusterInfo.Snapshot()
+	assert.NoError(t, err)
+
+	assert.Equal(t, float64(7000), snapshot.Nodes["node-1"].Idle.Cpu())
+	assert.Equal(t, float64(8000), snapshot.Nodes["node-2"].Idle.Cpu())
+	assert.Equal(t, float64(10000), snapshot.Nodes["node-3"].Idle.Cpu())
+}
+
 func TestBindRequests(t *testing.T) {
 	examplePodName := "pod-1"
 	namespace1 := "namespace-1"
@@ -1492,6 +1532,237 @@ func TestGetPodGroupPriority(t *testing.T) {
 	assert.Equal(t, int32(2), priority)
 }
 
+func TestSetPodGroupEvictionMethod(t *testing.T) {
+	queues := map[common_info.QueueID]*queue_info.QueueInfo{
+		"department": {UID: "department", EvictionMethod: enginev2.EvictionMethodEvictionAPI},
+		"team":       {UID: "team", ParentQueue: "department"},
+		"custom":     {UID: "custom", ParentQueue: "department", EvictionMethod: enginev2.EvictionMethodCustom},
+		"default":    {UID: "default"},
+	}
+	kubeObjects := []runtime.Object{
+		&v12.PriorityClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "delete-priority",
+				Annotations: map[string]string{commonconstants.EvictionMethod: "Delete"},
+			},
+		},
+		&v12.PriorityClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "invalid-priority",
+				Annotations: map[string]string{commonconstants.EvictionMethod: "Explode"},
+			},
+		},
+		&v12.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "plain-priority"}},
+	}
+	clusterInfo := newClusterInfoTests(t, clusterInfoTestParams{kubeObjects: kubeObjects})
+
+	tests := []struct {
+		name              string
+		queue             string
+		priorityClassName string
+		expected          enginev2.EvictionMethod
+	}{
+		{name: "no method set", queue: "default", expected: enginev2.EvictionMethodDelete},
+		{name: "method of the queue", queue: "custom", expected: enginev2.EvictionMethodCustom},
+		{name: "method inherited from the parent queue", queue: "team", expected: enginev2.EvictionMethodEvictionAPI},
+		{name: "priority class without a method", queue: "team", priorityClassName: "plain-priority",
+			expected: enginev2.EvictionMethodEvictionAPI},
+		{name: "priority class method overrides the queue", queue: "team", priorityClassName: "delete-priority",
+			expected: enginev2.EvictionMethodDelete},
+		{name: "invalid priority class method is ignored", queue: "custom", priorityClassName: "invalid-priority",
+			expected: enginev2.EvictionMethodCustom},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			podGroup := &enginev2alpha2.PodGroup{
+				Spec: enginev2alpha2.PodGroupSpec{Queue: tt.queue, PriorityClassName: tt.priorityClassName},
+			}
+			podGroupInfo := podgroup_info.NewPodGroupInfo("pg")
+			clusterInfo.setPodGroupEvictionMethod(podGroupInfo, podGroup, queues)
+			assert.Equal(t, tt.expected, podGroupInfo.EvictionMethod)
+		})
+	}
+}
+
+func TestSetPodGroupDoNotConsolidate(t *testing.T) {
+	queues := map[common_info.QueueID]*queue_info.QueueInfo{
+		"department": {UID: "department", Consolidation: &enginev2.QueueConsolidation{AllowOptOut: ptr.To(false)}},
+		"team":       {UID: "team", ParentQueue: "department"},
+		"stateful": {UID: "stateful", ParentQueue: "department",
+			Consolidation: &enginev2.QueueConsolidation{AllowOptOut: ptr.To(true)}},
+		"default": {UID: "default"},
+	}
+
+	tests := []struct {
+		name       string
+		queue      string
+		annotation string
+		expected   bool
+	}{
+		{name: "not annotated", queue: "default", expected: false},
+		{name: "opted out", queue: "default", annotation: "true", expected: true},
+		{name: "annotated with false", queue: "default", annotation: "false", expected: false},
+		{name: "invalid annotation is ignored", queue: "default", annotation: "always", expected: false},
+		{name: "opt out not allowed by the queue", queue: "department", annotation: "true", expected: false},
+		{name: "opt out not allowed by the parent queue", queue: "team", annotation: "true", expected: false},
+		{name: "opt out allowed by the queue", queue: "stateful", annotation: "true", expected: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			podGroup := &enginev2alpha2.PodGroup{Spec: enginev2alpha2.PodGroupSpec{Queue: tt.queue}}
+			if tt.annotation != "" {
+				podGroup.Annotations = map[string]string{commonconstants.DoNotConsolidate: tt.annotation}
+			}
+			podGroupInfo := podgroup_info.NewPodGroupInfo("pg")
+			setPodGroupDoNotConsolidate(podGroupInfo, podGroup, queues)
+			assert.Equal(t, tt.expected, podGroupInfo.DoNotConsolidate)
+		})
+	}
+}
+
+func TestSetPodGroupGPUDeviceSelection(t *testing.T) {
+	queues := map[common_info.QueueID]*queue_info.QueueInfo{
+		"department": {UID: "department", GPUDeviceSelection: enginev2.GPUDeviceSelectionSpread},
+		"team":       {UID: "team", ParentQueue: "department"},
+		"packed":     {UID: "packed", ParentQueue: "department", GPUDeviceSelection: enginev2.GPUDeviceSelectionPack},
+		"default":    {UID: "default"},
+	}
+	kubeObjects := []runtime.Object{
+		&v12.PriorityClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "pack-priority",
+				Annotations: map[string]string{commonconstants.GPUDeviceSelection: "Pack"},
+			},
+		},
+		&v12.PriorityClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "invalid-priority",
+				Annotations: map[string]string{commonconstants.GPUDeviceSelection: "Scatter"},
+			},
+		},
+	}
+	clusterInfo := newClusterInfoTests(t, clusterInfoTestParams{kubeObjects: kubeObjects})
+
+	tests := []struct {
+		name              string
+		queue             string
+		priorityClassName string
+		expected          enginev2.GPUDeviceSelectionPolicy
+	}{
+		{name: "no policy set", queue: "default"},
+		{name: "policy of the queue", queue: "packed", expected: enginev2.GPUDeviceSelectionPack},
+		{name: "policy inherited from the parent queue", queue: "team", expected: enginev2.GPUDeviceSelectionSpread},
+		{name: "priority class policy overrides the queue", queue: "team", priorityClassName: "pack-priority",
+			expected: enginev2.GPUDeviceSelectionPack},
+		{name: "invalid priority class policy is ignored", queue: "team", priorityClassName: "invalid-priority",
+			expected: enginev2.GPUDeviceSelectionSpread},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			podGroup := &enginev2alpha2.PodGroup{
+				Spec: enginev2alpha2.PodGroupSpec{Queue: tt.queue, PriorityClassName: tt.priorityClassName},
+			}
+			podGroupInfo := podgroup_info.NewPodGroupInfo("pg")
+			clusterInfo.setPodGroupGPUDeviceSelection(podGroupInfo, podGroup, queues)
+			assert.Equal(t, tt.expected, podGroupInfo.GPUDeviceSelection)
+		})
+	}
+}
+
+func TestSetPodGroupPreemptibility(t *testing.T) {
+	queues := map[common_info.QueueID]*queue_info.QueueInfo{
+		"department": {UID: "department", Preemptibility: &enginev2.QueuePreemptibility{
+			Default: enginev2alpha2.Preemptible, AllowOverride: ptr.To(false),
+		}},
+		"team": {UID: "team", ParentQueue: "department"},
+		"open": {UID: "open", ParentQueue: "department", Preemptibility: &enginev2.QueuePreemptibility{
+			Default: enginev2alpha2.Preemptible,
+		}},
+		"default": {UID: "default"},
+	}
+	clusterInfo := newClusterInfoTests(t, clusterInfoTestParams{})
+
+	tests := []struct {
+		name           string
+		queue          string
+		preemptibility enginev2alpha2.Preemptibility
+		expected       enginev2alpha2.Preemptibility
+	}{
+		{name: "no queue settings", queue: "default", preemptibility: enginev2alpha2.NonPreemptible,
+			expected: enginev2alpha2.NonPreemptible},
+		{name: "queue default applied", queue: "open", expected: enginev2alpha2.Preemptible},
+		{name: "override allowed", queue: "open", preemptibility: enginev2alpha2.NonPreemptible,
+			expected: enginev2alpha2.NonPreemptible},
+		{name: "override not allowed", queue: "department", preemptibility: enginev2alpha2.NonPreemptible,
+			expected: enginev2alpha2.Preemptible},
+		{name: "settings inherited from the parent queue", queue: "team",
+			preemptibility: enginev2alpha2.NonPreemptible, expected: enginev2alpha2.Preemptible},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			podGroup := &enginev2alpha2.PodGroup{
+				Spec: enginev2alpha2.PodGroupSpec{
+					Queue: tt.queue, Preemptibility: tt.preemptibility, PriorityClassName: "missing-priority",
+				},
+			}
+			podGroupInfo := podgroup_info.NewPodGroupInfo("pg")
+			clusterInfo.setPodGroupPriorityAndPreemptibility(podGroupInfo, podGroup, 1000, queues)
+			assert.Equal(t, tt.expected, podGroupInfo.Preemptibility)
+		})
+	}
+}
+
+func TestSetPodGroupWorkloadClass(t *testing.T) {
+	queues := map[common_info.QueueID]*queue_info.QueueInfo{
+		"department": {UID: "department", WorkloadClasses: &enginev2.QueueWorkloadClasses{
+			Default: enginev2alpha2.BurstableGPU,
+			Allowed: []enginev2alpha2.WorkloadClass{enginev2alpha2.BestEffortGPU},
+		}},
+		"team": {UID: "team", ParentQueue: "department"},
+		"strict": {UID: "strict", Preemptibility: &enginev2.QueuePreemptibility{
+			Default: enginev2alpha2.Preemptible, AllowOverride: ptr.To(false),
+		}},
+		"default": {UID: "default"},
+	}
+	clusterInfo := newClusterInfoTests(t, clusterInfoTestParams{})
+
+	tests := []struct {
+		name                   string
+		queue                  string
+		workloadClass          enginev2alpha2.WorkloadClass
+		preemptibility         enginev2alpha2.Preemptibility
+		expectedClass          enginev2alpha2.WorkloadClass
+		expectedPreemptibility enginev2alpha2.Preemptibility
+	}{
+		{name: "no workload class", queue: "default", expectedPreemptibility: enginev2alpha2.NonPreemptible},
+		{name: "guaranteed is non-preemptible", queue: "default", workloadClass: enginev2alpha2.Guaranteed,
+			preemptibility: enginev2alpha2.Preemptible, expectedClass: enginev2alpha2.Guaranteed,
+			expectedPreemptibility: enginev2alpha2.NonPreemptible},
+		{name: "best-effort is preemptible", queue: "default", workloadClass: enginev2alpha2.BestEffortGPU,
+			expectedClass: enginev2alpha2.BestEffortGPU, expectedPreemptibility: enginev2alpha2.Preemptible},
+		{name: "queue default applied", queue: "department", expectedClass: enginev2alpha2.BurstableGPU,
+			expectedPreemptibility: enginev2alpha2.Preemptible},
+		{name: "class not allowed by the parent queue", queue: "team", workloadClass: enginev2alpha2.Guaranteed,
+			expectedClass: enginev2alpha2.BurstableGPU, expectedPreemptibility: enginev2alpha2.Preemptible},
+		{name: "queue preemptibility not overridden", queue: "strict", workloadClass: enginev2alpha2.Guaranteed,
+			expectedClass: enginev2alpha2.Guaranteed, expectedPreemptibility: enginev2alpha2.Preemptible},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			podGroup := &enginev2alpha2.PodGroup{
+				Spec: enginev2alpha2.PodGroupSpec{
+					Queue: tt.queue, WorkloadClass: tt.workloadClass, Preemptibility: tt.preemptibility,
+					PriorityClassName: "missing-priority",
+				},
+			}
+			podGroupInfo := podgroup_info.NewPodGroupInfo("pg")
+			clusterInfo.setPodGroupPriorityAndPreemptibility(podGroupInfo, podGroup, 1000, queues)
+			assert.Equal(t, tt.expectedClass, podGroupInfo.WorkloadClass)
+			assert.Equal(t, tt.expectedPreemptibility, podGroupInfo.Preemptibility)
+		})
+	}
+}
+
 func TestSnapshotStorageObjects(t *testing.T) {
 	kubeObjects := []runtime.Object{
 		&storage.CSIDriver{
@@ -1717,6 +1988,39 @@ func TestGetDefaultPriorityWithError(t *testing.T) {
 	assert.Equal(t, int32(50), priority)
 }
 
+func TestApplyPodGroupSchedulingConstraints(t *testing.T) {
+	podGroup := &enginev2alpha2.PodGroup{
+		Spec: enginev2alpha2.PodGroupSpec{
+			Tolerations: []corev1.Toleration{
+				{Key: "dedicated", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+			},
+			NodeSelector: map[string]string{"pool": "a100", "zone": "a"},
+		},
+	}
+	newPod := func(nodeName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns", UID: "pod-uid"},
+			Spec: corev1.PodSpec{
+				NodeName:     nodeName,
+				NodeSelector: map[string]string{"zone": "b"},
+			},
+		}
+	}
+
+	pendingPod := newPod("")
+	pendingPodInfo := pod_info.NewTaskInfo(pendingPod)
+	applyPodGroupSchedulingConstraints(pendingPodInfo, podGroup)
+	assert.Equal(t, map[string]string{"pool": "a100", "zone": "b"}, pendingPodInfo.Pod.Spec.NodeSelector)
+	assert.Equal(t, podGroup.Spec.Tolerations, pendingPodInfo.Pod.Spec.Tolerations)
+	assert.Equal(t, map[string]string{"zone": "b"}, pendingPod.Spec.NodeSelector,
+		"the pod of the informer cache must not be modified")
+
+	runningPodInfo := pod_info.NewTaskInfo(newPod("node-1"))
+	applyPodGroupSchedulingConstraints(runningPodInfo, podGroup)
+	assert.Equal(t, map[string]string{"zone": "b"}, runningPodInfo.Pod.Spec.NodeSelector)
+	assert.Empty(t, runningPodInfo.Pod.Spec.Tolerations)
+}
+
 func TestPodGroupWithIndex(t *testing.T) {
 	podGroup := &enginev2alpha2.PodGroup{
 		ObjectMeta: metav1.ObjectMeta{
@@ -2189,7 +2493,6 @@ func TestSnapshotWithListerErrors(t *testing.T) {
 			func(mdl *data_lister.MockDataLister) {
 				mdl.EXPECT().ListPods().Return([]*corev1.Pod{}, nil)
 				mdl.EXPECT().ListNodes().Return([]*corev1.Node{}, nil)
-				mdl.EXPECT().ListResourceSlicesByNode().Return(map[string][]*resourceapi.ResourceSlice{}, nil)
 				mdl.EXPECT().ListResourceClaims().Return([]*resourceapi.ResourceClaim{}, nil)
 				mdl.EXPECT().ListBindRequests().Return(nil, fmt.Errorf(successErrorMsg))
 			},
@@ -2228,7 +2531,8 @@ func TestNewClusterInfoErrorPartitionSelector(t *testing.T) {
 		NodePoolLabelKey:   "@!A",
 		NodePoolLabelValue: "!@#",
 	}
-	_, err := New(informerFactory, kubeAiSchedulerInformerFactory, nil, params, false, clusterPodAffinityInfo, false, true, nil)
+	_, err := New(informerFactory, kubeAiSchedulerInformerFactory, nil, params, false, clusterPodAffinityInfo, false, true, nil,
+		commonconstants.DefaultSchedulerName, clock.RealClock{})
 
 	assert.NotNil(t, err)
 }
@@ -2259,7 +2563,7 @@ func TestNewClusterInfoAddIndexerFails(t *testing.T) {
 	clusterPodAffinityInfo.EXPECT().AddNode(gomock.Any(), gomock.Any()).AnyTimes()
 
 	_, err = New(informerFactory, kubeAiSchedulerInformerFactory, nil, nil, false,
-		clusterPodAffinityInfo, false, true, nil)
+		clusterPodAffinityInfo, false, true, nil, commonconstants.DefaultSchedulerName, clock.RealClock{})
 	assert.NotNil(t, err, "Expected error for conflicting indexers")
 }
 
@@ -2294,10 +2598,11 @@ func newClusterInfoTestsInner(t *testing.T, kubeObjects, kaiSchedulerObjects []r
 
 	fakeUsageClient := fakeusage.FakeClient{}
 	fakeUsageClient.SetResourceUsage(clusterUsage, clusterUsageErr)
-	usageLister := usagedb.NewUsageLister(&fakeUsageClient, ptr.To(10*time.Microsecond), ptr.To(10*time.Second), ptr.To(10*time.Second))
+	usageLister := usagedb.NewUsageLister(&fakeUsageClient, ptr.To(10*time.Microsecond), ptr.To(10*time.Second), ptr.To(10*time.Second),
+		clock.RealClock{})
 
 	clusterInfo, _ := New(informerFactory, kubeAiSchedulerInformerFactory, usageLister, nodePoolParams, false,
-		clusterPodAffinityInfo, true, fullHierarchyFairness, nil)
+		clusterPodAffinityInfo, true, fullHierarchyFairness, nil, commonconstants.DefaultSchedulerName, clock.RealClock{})
 
 	stopCh := context.Background().Done()
 	informerFactory.Start(stopCh)
@@ -2372,6 +2677,56 @@ func TestSnapshotPodsInPartition(t *testing.T) {
 	assert.Equal(t, "pod1", snapshot.Pods[0].Name)
 }
 
+func TestSnapshotSchedulingFreezes(t *testing.T) {
+	newFreeze := func(name, nodePool string, expiresAt *metav1.Time) *kaiv1alpha1.SchedulingFreeze {
+		return &kaiv1alpha1.SchedulingFreeze{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: kaiv1alpha1.SchedulingFreezeSpec{
+				NodePool:  nodePool,
+				Reason:    "incident",
+				ExpiresAt: expiresAt,
+			},
+		}
+	}
+	hourAgo := metav1.NewTime(time.Now().Add(-time.Hour))
+	inAnHour := metav1.NewTime(time.Now().Add(time.Hour))
+
+	clusterInfo := newClusterInfoTestsInner(
+		t, []runtime.Object{},
+		[]runtime.Object{
+			newFreeze("cluster-wide", "", nil),
+			newFreeze("same-pool", "foo", &inAnHour),
+			newFreeze("other-pool", "bar", nil),
+			newFreeze("expired", "foo", &hourAgo),
+		},
+		&conf.SchedulingNodePoolParams{
+			NodePoolLabelKey:   nodePoolNameLabel,
+			NodePoolLabelValue: "foo",
+		},
+		true,
+		nil, nil, // usage and usageErr
+	)
+	snapshot, err := clusterInfo.Snapshot()
+	assert.Nil(t, err)
+
+	var freezeNames []string
+	for _, freeze := range snapshot.SchedulingFreezes {
+		freezeNames = append(freezeNames, freeze.Name)
+	}
+	assert.ElementsMatch(t, []string{"cluster-wide", "same-pool"}, freezeNames)
+
+	// The expiry is evaluated at the time of the scheduler's clock
+	clusterInfo.clock = clocktesting.NewFakeClock(time.Now().Add(2 * time.Hour))
+	snapshot, err = clusterInfo.Snapshot()
+	assert.Nil(t, err)
+
+	freezeNames = nil
+	for _, freeze := range snapshot.SchedulingFreezes {
+		freezeNames = append(freezeNames, freeze.Name)
+	}
+	assert.ElementsMatch(t, []string{"cluster-wide"}, freezeNames)
+}
+
 func newCompletedPod(pod *corev1.Pod) *corev1.Pod {
 	newPod := pod.DeepCopy()
 	newPod.Status.Phase = corev1.PodSucceeded
@@ -2472,7 +2827,6 @@ func TestSnapshotNodesWithDRAGPUs(t *testing.T) {
 			}
 
 			mockLister := data_lister.NewMockDataLister(ctrl)
-			mockLister.EXPECT().ListNodes().Return(test.nodes, nil)
 			mockLister.EXPECT().ListResourceSlicesByNode().Return(slicesByNode, nil)
 
 			clusterPodAffinityInfo := pod_affinity.NewMockClusterPodAffinityInfo(ctrl)
@@ -2486,8 +2840,7 @@ func TestSnapshotNodesWithDRAGPUs(t *testing.T) {
 				clusterPodAffinityInfo: clusterPodAffinityInfo,
 			}
 
-			nodes, _, err := ci.snapshotNodes(clusterPodAffinityInfo)
-			assert.NoError(t, err)
+			nodes, _ := ci.snapshotNodes(test.nodes, nil, map[common_info.PodID]*pod_info.PodInfo{}, nil, nil)
 
 			for nodeName, expectedGPUs := range test.expectedDRAGPUs {
 				nodeInfo, found := nodes[nodeName]
@@ -2519,3 +2872,73 @@ func createTestResourceSlice(name, nodeName, driver string, deviceCount int) *re
 		},
 	}
 }
+
+func TestSnapshotCrossPoolReclaim(t *testing.T) {
+	newQueue := func(name, nodePool, parent string, nodePools ...string) *enginev2.Queue {
+		return &enginev2.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{nodePoolNameLabel: nodePool}},
+			Spec: enginev2.QueueSpec{
+				ParentQueue: parent,
+				Resources:   &enginev2.QueueResources{},
+				NodePools:   nodePools,
+			},
+		}
+	}
+	newPodGroup := func(name, nodePool, queue string, requested bool) *enginev2alpha2.PodGroup {
+		labels := map[string]string{nodePoolNameLabel: nodePool}
+		if requested {
+			labels[commonconstants.CrossPoolReclaimLabelKey] = "true"
+		}
+		return &enginev2alpha2.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", Labels: labels},
+			Spec:       enginev2alpha2.PodGroupSpec{Queue: queue, MinMember: 1},
+		}
+	}
+	newPendingPod := func(name, podGroup string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "ns",
+				UID:         types.UID(name),
+				Annotations: map[string]string{commonconstants.PodGroupAnnotationForPod: podGroup},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodPending},
+		}
+	}
+
+	clusterInfo := newClusterInfoTestsInner(
+		t,
+		[]runtime.Object{
+			newPendingPod("requesting-pod", "requesting"),
+			newPendingPod("not-entitled-pod", "not-entitled"),
+		},
+		[]runtime.Object{
+			newQueue("dept", "foo", ""),
+			newQueue("queue-foo", "foo", "dept"),
+			newQueue("dept-bar", "bar", "", "foo"),
+			newQueue("queue-bar", "bar", "dept-bar", "foo", "baz"),
+			newQueue("queue-bar-only", "bar", "dept-bar"),
+			newPodGroup("requesting", "bar", "queue-bar", true),
+			newPodGroup("not-requesting", "bar", "queue-bar", false),
+			newPodGroup("not-entitled", "bar", "queue-bar-only", true),
+		},
+		&conf.SchedulingNodePoolParams{
+			NodePoolLabelKey:   nodePoolNameLabel,
+			NodePoolLabelValue: "foo",
+		},
+		true,
+		nil, nil, // usage and usageErr
+	)
+	snapshot, err := clusterInfo.Snapshot()
+	assert.Nil(t, err)
+
+	assert.ElementsMatch(t,
+		[]common_info.QueueID{"dept", "queue-foo", "dept-bar", "queue-bar"}, slices.Collect(maps.Keys(snapshot.Queues)))
+	assert.Empty(t, snapshot.PodGroupInfos)
+	assert.Len(t, snapshot.CrossPoolReclaimJobs, 1)
+	job := snapshot.CrossPoolReclaimJobs["requesting"]
+	if assert.NotNil(t, job) {
+		assert.True(t, job.CrossPoolReclaim.Candidate)
+		assert.Equal(t, 1, job.GetNumPendingTasks())
+	}
+}
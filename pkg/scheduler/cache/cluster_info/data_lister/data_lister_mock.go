@@ -19,6 +19,7 @@ import (
 	queue_info "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
 	gomock "go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
+	v13 "k8s.io/api/policy/v1"
 	v10 "k8s.io/api/resource/v1"
 	v11 "k8s.io/api/scheduling/v1"
 	v12 "k8s.io/api/storage/v1"
@@ -63,6 +64,21 @@ func (mr *MockDataListerMockRecorder) GetPriorityClassByName(name any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriorityClassByName", reflect.TypeOf((*MockDataLister)(nil).GetPriorityClassByName), name)
 }
 
+// ListAllQueues mocks base method.
+func (m *MockDataLister) ListAllQueues() ([]*v2.Queue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllQueues")
+	ret0, _ := ret[0].([]*v2.Queue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllQueues indicates an expected call of ListAllQueues.
+func (mr *MockDataListerMockRecorder) ListAllQueues() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllQueues", reflect.TypeOf((*MockDataLister)(nil).ListAllQueues))
+}
+
 // ListBindRequests mocks base method.
 func (m *MockDataLister) ListBindRequests() ([]*v1alpha2.BindRequest, error) {
 	m.ctrl.T.Helper()
@@ -93,6 +109,21 @@ func (mr *MockDataListerMockRecorder) ListCSIDrivers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCSIDrivers", reflect.TypeOf((*MockDataLister)(nil).ListCSIDrivers))
 }
 
+// ListCSINodes mocks base method.
+func (m *MockDataLister) ListCSINodes() ([]*v12.CSINode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCSINodes")
+	ret0, _ := ret[0].([]*v12.CSINode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCSINodes indicates an expected call of ListCSINodes.
+func (mr *MockDataListerMockRecorder) ListCSINodes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCSINodes", reflect.TypeOf((*MockDataLister)(nil).ListCSINodes))
+}
+
 // ListCSIStorageCapacities mocks base method.
 func (m *MockDataLister) ListCSIStorageCapacities() ([]*v12.CSIStorageCapacity, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +154,21 @@ func (mr *MockDataListerMockRecorder) ListConfigMaps() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfigMaps", reflect.TypeOf((*MockDataLister)(nil).ListConfigMaps))
 }
 
+// ListCrossPoolReclaimPodGroups mocks base method.
+func (m *MockDataLister) ListCrossPoolReclaimPodGroups() ([]*v2alpha2.PodGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCrossPoolReclaimPodGroups")
+	ret0, _ := ret[0].([]*v2alpha2.PodGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCrossPoolReclaimPodGroups indicates an expected call of ListCrossPoolReclaimPodGroups.
+func (mr *MockDataListerMockRecorder) ListCrossPoolReclaimPodGroups() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossPoolReclaimPodGroups", reflect.TypeOf((*MockDataLister)(nil).ListCrossPoolReclaimPodGroups))
+}
+
 // ListNodes mocks base method.
 func (m *MockDataLister) ListNodes() ([]*v1.Node, error) {
 	m.ctrl.T.Helper()
@@ -168,6 +214,21 @@ func (mr *MockDataListerMockRecorder) ListPodByIndex(index, value any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPodByIndex", reflect.TypeOf((*MockDataLister)(nil).ListPodByIndex), index, value)
 }
 
+// ListPodDisruptionBudgets mocks base method.
+func (m *MockDataLister) ListPodDisruptionBudgets() ([]*v13.PodDisruptionBudget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPodDisruptionBudgets")
+	ret0, _ := ret[0].([]*v13.PodDisruptionBudget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPodDisruptionBudgets indicates an expected call of ListPodDisruptionBudgets.
+func (mr *MockDataListerMockRecorder) ListPodDisruptionBudgets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPodDisruptionBudgets", reflect.TypeOf((*MockDataLister)(nil).ListPodDisruptionBudgets))
+}
+
 // ListPodGroups mocks base method.
 func (m *MockDataLister) ListPodGroups() ([]*v2alpha2.PodGroup, error) {
 	m.ctrl.T.Helper()
@@ -273,6 +334,21 @@ func (mr *MockDataListerMockRecorder) ListResourceUsage() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceUsage", reflect.TypeOf((*MockDataLister)(nil).ListResourceUsage))
 }
 
+// ListSchedulingFreezes mocks base method.
+func (m *MockDataLister) ListSchedulingFreezes() ([]*v1alpha1.SchedulingFreeze, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSchedulingFreezes")
+	ret0, _ := ret[0].([]*v1alpha1.SchedulingFreeze)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchedulingFreezes indicates an expected call of ListSchedulingFreezes.
+func (mr *MockDataListerMockRecorder) ListSchedulingFreezes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedulingFreezes", reflect.TypeOf((*MockDataLister)(nil).ListSchedulingFreezes))
+}
+
 // ListStorageClasses mocks base method.
 func (m *MockDataLister) ListStorageClasses() ([]*v12.StorageClass, error) {
 	m.ctrl.T.Helper()
@@ -5,6 +5,7 @@ package data_lister
 
 import (
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	resourceapi "k8s.io/api/resource/v1"
 	scheduling "k8s.io/api/scheduling/v1"
 	storage "k8s.io/api/storage/v1"
@@ -19,8 +20,10 @@ import (
 type DataLister interface {
 	ListPods() ([]*v1.Pod, error)
 	ListPodGroups() ([]*schedulingv2alpha2.PodGroup, error)
+	ListCrossPoolReclaimPodGroups() ([]*schedulingv2alpha2.PodGroup, error)
 	ListNodes() ([]*v1.Node, error)
 	ListQueues() ([]*schedulingv2.Queue, error)
+	ListAllQueues() ([]*schedulingv2.Queue, error)
 	ListPriorityClasses() ([]*scheduling.PriorityClass, error)
 	GetPriorityClassByName(name string) (*scheduling.PriorityClass, error)
 	ListPodByIndex(index, value string) ([]interface{}, error)
@@ -28,9 +31,12 @@ type DataLister interface {
 	ListCSIStorageCapacities() ([]*storage.CSIStorageCapacity, error)
 	ListStorageClasses() ([]*storage.StorageClass, error)
 	ListCSIDrivers() ([]*storage.CSIDriver, error)
+	ListCSINodes() ([]*storage.CSINode, error)
 	ListBindRequests() ([]*schedulingv1alpha2.BindRequest, error)
 	ListConfigMaps() ([]*v1.ConfigMap, error)
 	ListTopologies() ([]*kaiv1alpha1.Topology, error)
+	ListSchedulingFreezes() ([]*kaiv1alpha1.SchedulingFreeze, error)
+	ListPodDisruptionBudgets() ([]*policyv1.PodDisruptionBudget, error)
 	ListResourceUsage() (*queue_info.ClusterUsage, error)
 	// ListResourceSlicesByNode returns ResourceSlices grouped by node name.
 	ListResourceSlicesByNode() (map[string][]*resourceapi.ResourceSlice, error)
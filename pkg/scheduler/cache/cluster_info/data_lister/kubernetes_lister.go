@@ -7,12 +7,14 @@ import (
 	"fmt"
 
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	resourceapi "k8s.io/api/resource/v1"
 	v14 "k8s.io/api/scheduling/v1"
 	storage "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/informers"
 	listv1 "k8s.io/client-go/listers/core/v1"
+	policylistv1 "k8s.io/client-go/listers/policy/v1"
 	resourcev1 "k8s.io/client-go/listers/resource/v1"
 	schedv1 "k8s.io/client-go/listers/scheduling/v1"
 	v12 "k8s.io/client-go/listers/storage/v1"
@@ -25,6 +27,7 @@ import (
 	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
 	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache/usagedb"
 
@@ -46,12 +49,17 @@ type k8sLister struct {
 	storageCapacityLister  v12.CSIStorageCapacityLister
 	storageClassLister     v12.StorageClassLister
 	csiDriverLister        v12.CSIDriverLister
+	csiNodeLister          v12.CSINodeLister
 	draResourceClaimLister resourcev1.ResourceClaimLister
 
 	bindRequestLister scheudlinglistv1alpha2.BindRequestLister
 
 	kaiTopologyLister kaiv1alpha1Listers.TopologyLister
 
+	schedulingFreezeLister kaiv1alpha1Listers.SchedulingFreezeLister
+
+	pdbLister policylistv1.PodDisruptionBudgetLister
+
 	resourceSliceLister resourcev1.ResourceSliceLister
 	resourceClaimLister resourcev1.ResourceClaimLister
 
@@ -62,6 +70,8 @@ type k8sLister struct {
 
 var _ DataLister = &k8sLister{}
 
+var crossPoolReclaimSelector = labels.SelectorFromSet(labels.Set{commonconstants.CrossPoolReclaimLabelKey: "true"})
+
 func New(
 	informerFactory informers.SharedInformerFactory, kubeAiSchedulerInformerFactory kubeAiSchedulerInfo.SharedInformerFactory,
 	usageLister *usagedb.UsageLister,
@@ -81,6 +91,7 @@ func New(
 		storageCapacityLister:  informerFactory.Storage().V1().CSIStorageCapacities().Lister(),
 		storageClassLister:     informerFactory.Storage().V1().StorageClasses().Lister(),
 		csiDriverLister:        informerFactory.Storage().V1().CSIDrivers().Lister(),
+		csiNodeLister:          informerFactory.Storage().V1().CSINodes().Lister(),
 		draResourceClaimLister: informerFactory.Resource().V1().ResourceClaims().Lister(),
 
 		bindRequestLister:   kubeAiSchedulerInformerFactory.Scheduling().V1alpha2().BindRequests().Lister(),
@@ -88,6 +99,10 @@ func New(
 		resourceSliceLister: informerFactory.Resource().V1().ResourceSlices().Lister(),
 		resourceClaimLister: informerFactory.Resource().V1().ResourceClaims().Lister(),
 
+		schedulingFreezeLister: kubeAiSchedulerInformerFactory.Kai().V1alpha1().SchedulingFreezes().Lister(),
+
+		pdbLister: informerFactory.Policy().V1().PodDisruptionBudgets().Lister(),
+
 		partitionSelector: partitionSelector,
 	}
 }
@@ -104,6 +119,12 @@ func (k *k8sLister) ListPodGroups() ([]*enginev2alpha2.PodGroup, error) {
 	return k.podGroupLister.List(k.partitionSelector)
 }
 
+// ListCrossPoolReclaimPodGroups returns the pod groups of all node pools that request the reclaim costs of other
+// node pools
+func (k *k8sLister) ListCrossPoolReclaimPodGroups() ([]*enginev2alpha2.PodGroup, error) {
+	return k.podGroupLister.List(crossPoolReclaimSelector)
+}
+
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
 
 func (k *k8sLister) ListNodes() ([]*v1.Node, error) {
@@ -116,6 +137,11 @@ func (k *k8sLister) ListQueues() ([]*enginev2.Queue, error) {
 	return k.queueLister.List(k.partitionSelector)
 }
 
+// ListAllQueues returns the queues of all node pools
+func (k *k8sLister) ListAllQueues() ([]*enginev2.Queue, error) {
+	return k.queueLister.List(labels.Everything())
+}
+
 func (k *k8sLister) ListResourceUsage() (*queue_info.ClusterUsage, error) {
 	if k.usageLister == nil {
 		return queue_info.NewClusterUsage(), fmt.Errorf("usage lister is not set")
@@ -162,6 +188,10 @@ func (k *k8sLister) ListCSIDrivers() ([]*storage.CSIDriver, error) {
 	return k.csiDriverLister.List(labels.Everything())
 }
 
+func (k *k8sLister) ListCSINodes() ([]*storage.CSINode, error) {
+	return k.csiNodeLister.List(labels.Everything())
+}
+
 // +kubebuilder:rbac:groups="scheduling.run.ai",resources=bindrequests,verbs=get;list;watch
 
 func (k *k8sLister) ListBindRequests() ([]*schedulingv1alpha2.BindRequest, error) {
@@ -180,6 +210,18 @@ func (k *k8sLister) ListTopologies() ([]*kaiv1alpha1.Topology, error) {
 	return k.kaiTopologyLister.List(labels.Everything())
 }
 
+// +kubebuilder:rbac:groups="kai.scheduler",resources=schedulingfreezes,verbs=get;list;watch
+
+func (k *k8sLister) ListSchedulingFreezes() ([]*kaiv1alpha1.SchedulingFreeze, error) {
+	return k.schedulingFreezeLister.List(labels.Everything())
+}
+
+// +kubebuilder:rbac:groups="policy",resources=poddisruptionbudgets,verbs=get;list;watch
+
+func (k *k8sLister) ListPodDisruptionBudgets() ([]*policyv1.PodDisruptionBudget, error) {
+	return k.pdbLister.List(labels.Everything())
+}
+
 // +kubebuilder:rbac:groups="resource.k8s.io",resources=resourceslices,verbs=get;list;watch
 
 func (k *k8sLister) ListResourceSlicesByNode() (map[string][]*resourceapi.ResourceSlice, error) {
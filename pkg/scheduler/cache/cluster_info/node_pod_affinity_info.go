@@ -36,6 +36,23 @@ func NewK8sNodePodAffinityInfo(
 	}
 }
 
+// clone returns a copy of the pod affinity info of the node, with the same pods, that is registered in the given
+// cluster pod affinity info
+func (ni *K8sNodePodAffinityInfo) clone(
+	clusterPodAffinityInfo pod_affinity.ClusterPodAffinityInfo,
+) *K8sNodePodAffinityInfo {
+	nodeInfo := ni.NodeInfo.SnapshotConcrete()
+	clusterPodAffinityInfo.AddNode(ni.name, nodeInfo)
+
+	clone := &K8sNodePodAffinityInfo{
+		NodeInfo:               nodeInfo,
+		clusterPodAffinityInfo: clusterPodAffinityInfo,
+		name:                   ni.name,
+	}
+	clusterPodAffinityInfo.UpdateNodeAffinity(clone)
+	return clone
+}
+
 func (ni *K8sNodePodAffinityInfo) AddPod(pod *v1.Pod) {
 	ni.NodeInfo.AddPod(pod)
 	ni.clusterPodAffinityInfo.UpdateNodeAffinity(ni)
@@ -61,3 +78,11 @@ func (ni *K8sNodePodAffinityInfo) HasPodsWithPodAntiAffinity() bool {
 func (ni *K8sNodePodAffinityInfo) Name() string {
 	return ni.name
 }
+
+// unregisteredPodAffinityInfo is the cluster pod affinity info of nodes that aren't part of a session, which are
+// registered in the cluster pod affinity info of a session once they are cloned into it
+type unregisteredPodAffinityInfo struct{}
+
+func (unregisteredPodAffinityInfo) UpdateNodeAffinity(pod_affinity.NodePodAffinityInfo) {}
+
+func (unregisteredPodAffinityInfo) AddNode(string, *k8sframework.NodeInfo) {}
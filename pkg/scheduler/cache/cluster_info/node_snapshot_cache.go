@@ -0,0 +1,290 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster_info
+
+import (
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	kubeAiSchedulerinfo "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/informers/externalversions"
+	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/bindrequest_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_affinity"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+)
+
+// nodeSnapshotCache keeps the nodes of the previous snapshot, with the pods on them, between snapshots. The informer
+// deltas of nodes, pods, bind requests, resource slices and resource claims mark the nodes that they affect as changed,
+// so that a snapshot only rebuilds the changed nodes and clones the rest. The cached nodes are never handed to a
+// session, since the session mutates its nodes and pods in place while simulating allocations and evictions.
+type nodeSnapshotCache struct {
+	mutex        sync.Mutex
+	entries      map[string]*nodeSnapshotCacheEntry
+	changedNodes sets.Set[string]
+	// podNodes are the nodes of the cached pods, and selectedNodes are the nodes selected by the bind requests of pods
+	podNodes      map[types.UID]string
+	selectedNodes map[bindrequest_info.Key]string
+}
+
+type nodeSnapshotCacheEntry struct {
+	nodeInfo        *node_info.NodeInfo
+	podAffinityInfo *K8sNodePodAffinityInfo
+	// podInfos are the pods of the node in the order they were added to it, and pods are the pod and bind request
+	// objects that they were built from
+	podInfos []*pod_info.PodInfo
+	pods     map[types.UID]nodeSnapshotCachePod
+}
+
+type nodeSnapshotCachePod struct {
+	pod         *v1.Pod
+	bindRequest *schedulingv1alpha2.BindRequest
+}
+
+func newNodeSnapshotCache() *nodeSnapshotCache {
+	return &nodeSnapshotCache{
+		entries:       map[string]*nodeSnapshotCacheEntry{},
+		changedNodes:  sets.New[string](),
+		podNodes:      map[types.UID]string{},
+		selectedNodes: map[bindrequest_info.Key]string{},
+	}
+}
+
+func newNodeSnapshotCacheEntry(nodeInfo *node_info.NodeInfo, podInfos []*pod_info.PodInfo) *nodeSnapshotCacheEntry {
+	pods := make(map[types.UID]nodeSnapshotCachePod, len(podInfos))
+	for _, podInfo := range podInfos {
+		pods[podInfo.Pod.UID] = nodeSnapshotCachePod{pod: podInfo.Pod, bindRequest: bindRequestObject(podInfo.BindRequest)}
+	}
+	return &nodeSnapshotCacheEntry{
+		nodeInfo:        nodeInfo,
+		podAffinityInfo: nodeInfo.PodAffinityInfo.(*K8sNodePodAffinityInfo),
+		podInfos:        podInfos,
+		pods:            pods,
+	}
+}
+
+func (nsc *nodeSnapshotCache) addEventHandlers(informerFactory informers.SharedInformerFactory,
+	kubeAiSchedulerInformerFactory kubeAiSchedulerinfo.SharedInformerFactory) error {
+	handlers := []struct {
+		informer cache.SharedIndexInformer
+		handler  cache.ResourceEventHandler
+	}{
+		{informerFactory.Core().V1().Pods().Informer(), nsc.podEventHandler()},
+		{informerFactory.Core().V1().Nodes().Informer(), nsc.nodeEventHandler()},
+		{kubeAiSchedulerInformerFactory.Scheduling().V1alpha2().BindRequests().Informer(), nsc.bindRequestEventHandler()},
+		{informerFactory.Resource().V1().ResourceSlices().Informer(), nsc.resourceSliceEventHandler()},
+		{informerFactory.Resource().V1().ResourceClaims().Informer(), nsc.resourceClaimEventHandler()},
+	}
+	for _, h := range handlers {
+		if _, err := h.informer.AddEventHandler(h.handler); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (nsc *nodeSnapshotCache) podEventHandler() cache.ResourceEventHandler {
+	return deltaEventHandler(func(pod *v1.Pod, _ bool) {
+		nsc.mutex.Lock()
+		defer nsc.mutex.Unlock()
+		nsc.markChanged(pod.Spec.NodeName, nsc.podNodes[pod.UID],
+			nsc.selectedNodes[bindrequest_info.NewKeyFromPod(pod)])
+	})
+}
+
+func (nsc *nodeSnapshotCache) nodeEventHandler() cache.ResourceEventHandler {
+	return deltaEventHandler(func(node *v1.Node, _ bool) {
+		nsc.mutex.Lock()
+		defer nsc.mutex.Unlock()
+		nsc.markChanged(node.Name)
+	})
+}
+
+func (nsc *nodeSnapshotCache) bindRequestEventHandler() cache.ResourceEventHandler {
+	return deltaEventHandler(func(bindRequest *schedulingv1alpha2.BindRequest, removed bool) {
+		nsc.mutex.Lock()
+		defer nsc.mutex.Unlock()
+		key := bindrequest_info.NewKeyFromRequest(bindRequest)
+		if !removed {
+			nsc.selectedNodes[key] = bindRequest.Spec.SelectedNode
+		} else if nsc.selectedNodes[key] == bindRequest.Spec.SelectedNode {
+			delete(nsc.selectedNodes, key)
+		}
+		nsc.markChanged(bindRequest.Spec.SelectedNode)
+	})
+}
+
+// resourceSliceEventHandler marks the nodes of changed resource slices. Slices that aren't local to a node don't add
+// GPUs to the nodes, so they don't change them.
+func (nsc *nodeSnapshotCache) resourceSliceEventHandler() cache.ResourceEventHandler {
+	return deltaEventHandler(func(slice *resourceapi.ResourceSlice, _ bool) {
+		if slice.Spec.NodeName == nil || (slice.Spec.AllNodes != nil && *slice.Spec.AllNodes) {
+			return
+		}
+		nsc.mutex.Lock()
+		defer nsc.mutex.Unlock()
+		nsc.markChanged(*slice.Spec.NodeName)
+	})
+}
+
+// resourceClaimEventHandler marks the nodes of the pods that own changed resource claims, or that the claims are
+// reserved for. The pods on a node that use a claim are always in its reservations.
+func (nsc *nodeSnapshotCache) resourceClaimEventHandler() cache.ResourceEventHandler {
+	return deltaEventHandler(func(claim *resourceapi.ResourceClaim, _ bool) {
+		nsc.mutex.Lock()
+		defer nsc.mutex.Unlock()
+		for _, ownerReference := range claim.OwnerReferences {
+			if ownerReference.Kind == resource_info.PodOwnerKind {
+				nsc.markChanged(nsc.podNodes[ownerReference.UID])
+			}
+		}
+		for _, reservedFor := range claim.Status.ReservedFor {
+			if reservedFor.Resource == resource_info.ReservedForPodPlural {
+				nsc.markChanged(nsc.podNodes[reservedFor.UID])
+			}
+		}
+	})
+}
+
+// markChanged marks the nodes with the given names as changed. The mutex must be held.
+func (nsc *nodeSnapshotCache) markChanged(nodeNames ...string) {
+	for _, nodeName := range nodeNames {
+		if nodeName != noNodeName {
+			nsc.changedNodes.Insert(nodeName)
+		}
+	}
+}
+
+// unchangedEntries drops the entries of the nodes that changed since the previous snapshot, and returns the entries
+// of the given nodes that are left
+func (nsc *nodeSnapshotCache) unchangedEntries(nodes []*v1.Node) map[string]*nodeSnapshotCacheEntry {
+	result := map[string]*nodeSnapshotCacheEntry{}
+	if nsc == nil {
+		return result
+	}
+
+	nsc.mutex.Lock()
+	defer nsc.mutex.Unlock()
+	for nodeName := range nsc.changedNodes {
+		nsc.deleteEntry(nodeName)
+	}
+	nsc.changedNodes = sets.New[string]()
+
+	for _, node := range nodes {
+		// The informer stores a new object for every update of the node, even if its delta wasn't applied yet
+		if entry, found := nsc.entries[node.Name]; found && entry.nodeInfo.Node == node {
+			result[node.Name] = entry
+		}
+	}
+	return result
+}
+
+// store replaces the entries of the built nodes, and drops the entries of nodes that aren't in the snapshot anymore
+func (nsc *nodeSnapshotCache) store(builtEntries map[string]*nodeSnapshotCacheEntry, nodes []*v1.Node) {
+	if nsc == nil {
+		return
+	}
+
+	nodeNames := sets.New[string]()
+	for _, node := range nodes {
+		nodeNames.Insert(node.Name)
+	}
+
+	nsc.mutex.Lock()
+	defer nsc.mutex.Unlock()
+	for nodeName := range nsc.entries {
+		if !nodeNames.Has(nodeName) {
+			nsc.deleteEntry(nodeName)
+		}
+	}
+	for nodeName, entry := range builtEntries {
+		nsc.deleteEntry(nodeName)
+		nsc.entries[nodeName] = entry
+		for uid := range entry.pods {
+			nsc.podNodes[uid] = nodeName
+		}
+	}
+}
+
+// deleteEntry deletes the entry of the node and the nodes of its pods. The mutex must be held.
+func (nsc *nodeSnapshotCache) deleteEntry(nodeName string) {
+	entry, found := nsc.entries[nodeName]
+	if !found {
+		return
+	}
+	for uid := range entry.pods {
+		if nsc.podNodes[uid] == nodeName {
+			delete(nsc.podNodes, uid)
+		}
+	}
+	delete(nsc.entries, nodeName)
+}
+
+// hasPods returns true if the entry was built from the given pods and their bind requests. The handlers of the
+// informers run after their stores are updated, so a snapshot can list pods whose deltas weren't applied yet.
+func (e *nodeSnapshotCacheEntry) hasPods(pods []*v1.Pod, bindRequests bindrequest_info.BindRequestMap) bool {
+	if len(pods) != len(e.pods) {
+		return false
+	}
+	for _, pod := range pods {
+		cachedPod, found := e.pods[pod.UID]
+		if !found || cachedPod.pod != pod ||
+			cachedPod.bindRequest != bindRequestObject(bindRequests.GetBindRequestForPod(pod)) {
+			return false
+		}
+	}
+	return true
+}
+
+// clone returns a copy of the node of the entry for a session, and adds copies of its pods to the existing pods
+func (e *nodeSnapshotCacheEntry) clone(clusterPodAffinityInfo pod_affinity.ClusterPodAffinityInfo,
+	existingPods map[common_info.PodID]*pod_info.PodInfo, bindRequests bindrequest_info.BindRequestMap,
+) *node_info.NodeInfo {
+	for _, podInfo := range e.podInfos {
+		clone := podInfo.Clone()
+		clone.BindRequest = bindRequests.GetBindRequestForPod(podInfo.Pod)
+		existingPods[clone.UID] = clone
+	}
+	return e.nodeInfo.Clone(e.podAffinityInfo.clone(clusterPodAffinityInfo))
+}
+
+func bindRequestObject(bindRequest *bindrequest_info.BindRequestInfo) *schedulingv1alpha2.BindRequest {
+	if bindRequest == nil {
+		return nil
+	}
+	return bindRequest.BindRequest
+}
+
+// deltaEventHandler calls applyDelta with the objects that the informer adds, updates and deletes. An update applies
+// the removal of the old object and then the new object.
+func deltaEventHandler[T any](applyDelta func(obj T, removed bool)) cache.ResourceEventHandler {
+	apply := func(obj interface{}, removed bool) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		if typed, ok := obj.(T); ok {
+			applyDelta(typed, removed)
+		}
+	}
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			apply(obj, false)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			apply(oldObj, true)
+			apply(newObj, false)
+		},
+		DeleteFunc: func(obj interface{}) {
+			apply(obj, true)
+		},
+	}
+}
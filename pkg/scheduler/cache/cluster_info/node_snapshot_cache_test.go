@@ -0,0 +1,280 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster_info
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	kubeAiSchedulerInfo "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/informers/externalversions"
+	schedulingv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_affinity"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+)
+
+func newNodeCacheTestNode(name string) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{v1.ResourceCPU: resource.MustParse("10"), v1.ResourcePods: resource.MustParse("110")},
+		},
+	}
+}
+
+func newNodeCacheTestPod(name, nodeName string, phase v1.PodPhase) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", UID: types.UID(name)},
+		Spec: v1.PodSpec{
+			NodeName:      nodeName,
+			SchedulerName: commonconstants.DefaultSchedulerName,
+			Containers: []v1.Container{{
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")},
+				},
+			}},
+		},
+		Status: v1.PodStatus{Phase: phase},
+	}
+}
+
+// newNodeCacheTestClusterInfo returns a cluster info that keeps its nodes between snapshots, and the client of its
+// informers
+func newNodeCacheTestClusterInfo(t *testing.T, includeCSIStorageObjects bool, kubeObjects []runtime.Object,
+	kaiSchedulerObjects []runtime.Object) (*ClusterInfo, kubernetes.Interface) {
+	kubeClient, kubeAiSchedulerClient := newFakeClients(kubeObjects, kaiSchedulerObjects)
+	informerFactory := informers.NewSharedInformerFactory(kubeClient, 0)
+	kubeAiSchedulerInformerFactory := kubeAiSchedulerInfo.NewSharedInformerFactory(kubeAiSchedulerClient, 0)
+
+	clusterPodAffinityInfo := pod_affinity.NewMockClusterPodAffinityInfo(gomock.NewController(t))
+	clusterPodAffinityInfo.EXPECT().UpdateNodeAffinity(gomock.Any()).AnyTimes()
+	clusterPodAffinityInfo.EXPECT().AddNode(gomock.Any(), gomock.Any()).AnyTimes()
+
+	clusterInfo, err := New(informerFactory, kubeAiSchedulerInformerFactory, nil, &conf.SchedulingNodePoolParams{},
+		false, clusterPodAffinityInfo, includeCSIStorageObjects, true, nil, commonconstants.DefaultSchedulerName,
+		clock.RealClock{})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	informerFactory.Start(ctx.Done())
+	informerFactory.WaitForCacheSync(ctx.Done())
+	kubeAiSchedulerInformerFactory.Start(ctx.Done())
+	kubeAiSchedulerInformerFactory.WaitForCacheSync(ctx.Done())
+	return clusterInfo, kubeClient
+}
+
+func TestSnapshotClonesUnchangedNodes(t *testing.T) {
+	clusterInfo, _ := newNodeCacheTestClusterInfo(t, false, []runtime.Object{
+		newNodeCacheTestNode("node-1"),
+		newNodeCacheTestPod("pod-1", "node-1", v1.PodRunning),
+	}, nil)
+
+	first, err := clusterInfo.Snapshot()
+	require.NoError(t, err)
+	entry := clusterInfo.nodeSnapshotCache.entries["node-1"]
+	require.NotNil(t, entry)
+
+	// The session changes its nodes and pods in place
+	podInfo := first.Nodes["node-1"].PodInfos["ns/pod-1"]
+	require.NoError(t, first.Nodes["node-1"].RemoveTask(podInfo))
+	podInfo.Status = pod_status.Releasing
+	first.Nodes["node-1"].Idle.Sub(resource_info.NewResource(1000, 0, 0))
+
+	second, err := clusterInfo.Snapshot()
+	require.NoError(t, err)
+	assert.Same(t, entry, clusterInfo.nodeSnapshotCache.entries["node-1"])
+	assert.NotSame(t, first.Nodes["node-1"], second.Nodes["node-1"])
+	assert.Equal(t, float64(8000), second.Nodes["node-1"].Idle.Cpu())
+	assert.Equal(t, float64(2000), second.Nodes["node-1"].Used.Cpu())
+	require.Contains(t, second.Nodes["node-1"].PodInfos, common_info.PodID("ns/pod-1"))
+	assert.Equal(t, pod_status.Running, second.Nodes["node-1"].PodInfos["ns/pod-1"].Status)
+	assert.Len(t, second.Pods, 1)
+}
+
+func TestSnapshotRebuildsChangedNodes(t *testing.T) {
+	clusterInfo, kubeClient := newNodeCacheTestClusterInfo(t, false, []runtime.Object{
+		newNodeCacheTestNode("node-1"),
+		newNodeCacheTestNode("node-2"),
+		newNodeCacheTestPod("pod-1", "node-1", v1.PodRunning),
+	}, nil)
+	_, err := clusterInfo.Snapshot()
+	require.NoError(t, err)
+	node2Entry := clusterInfo.nodeSnapshotCache.entries["node-2"]
+
+	_, err = kubeClient.CoreV1().Pods("ns").Create(context.Background(),
+		newNodeCacheTestPod("pod-2", "node-1", v1.PodRunning), metav1.CreateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		pods, err := clusterInfo.dataLister.ListPods()
+		return err == nil && len(pods) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	snapshot, err := clusterInfo.Snapshot()
+	require.NoError(t, err)
+	assert.Equal(t, float64(6000), snapshot.Nodes["node-1"].Idle.Cpu())
+	assert.Len(t, snapshot.Nodes["node-1"].PodInfos, 2)
+	assert.Same(t, node2Entry, clusterInfo.nodeSnapshotCache.entries["node-2"])
+
+	node := newNodeCacheTestNode("node-2")
+	node.Status.Allocatable[v1.ResourceCPU] = resource.MustParse("20")
+	_, err = kubeClient.CoreV1().Nodes().Update(context.Background(), node, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		snapshot, err = clusterInfo.Snapshot()
+		return err == nil && snapshot.Nodes["node-2"].Idle.Cpu() == 20000
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, kubeClient.CoreV1().Nodes().Delete(context.Background(), "node-2", metav1.DeleteOptions{}))
+	assert.Eventually(t, func() bool {
+		snapshot, err = clusterInfo.Snapshot()
+		return err == nil && len(snapshot.Nodes) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.NotContains(t, clusterInfo.nodeSnapshotCache.entries, "node-2")
+}
+
+func TestSnapshotOfCachedNodesMatchesBuiltNodes(t *testing.T) {
+	bindRequest := &schedulingv1alpha2.BindRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "binding-pod", Namespace: "ns"},
+		Spec: schedulingv1alpha2.BindRequestSpec{
+			PodName: "binding-pod", SelectedNode: "node-2", ReceivedResourceType: "Regular",
+		},
+	}
+	releasingPod := newNodeCacheTestPod("releasing-pod", "node-1", v1.PodRunning)
+	releasingPod.DeletionTimestamp = ptr.To(metav1.Now())
+	nominatedPod := newNodeCacheTestPod("nominated-pod", "", v1.PodPending)
+	nominatedPod.Spec.SchedulerName = "default-scheduler"
+	nominatedPod.Status.NominatedNodeName = "node-2"
+	kubeObjects := []runtime.Object{
+		newNodeCacheTestNode("node-1"),
+		newNodeCacheTestNode("node-2"),
+		newNodeCacheTestPod("running-pod", "node-1", v1.PodRunning),
+		newNodeCacheTestPod("succeeded-pod", "node-1", v1.PodSucceeded),
+		releasingPod,
+		newNodeCacheTestPod("binding-pod", "", v1.PodPending),
+		newNodeCacheTestPod("pending-pod", "", v1.PodPending),
+		nominatedPod,
+	}
+
+	builtClusterInfo, _ := newNodeCacheTestClusterInfo(t, true, kubeObjects, []runtime.Object{bindRequest})
+	require.Nil(t, builtClusterInfo.nodeSnapshotCache)
+	built, err := builtClusterInfo.Snapshot()
+	require.NoError(t, err)
+
+	cachedClusterInfo, _ := newNodeCacheTestClusterInfo(t, false, kubeObjects, []runtime.Object{bindRequest})
+	_, err = cachedClusterInfo.Snapshot()
+	require.NoError(t, err)
+	cached, err := cachedClusterInfo.Snapshot()
+	require.NoError(t, err)
+
+	assertSnapshotNodesEqual(t, built, cached)
+	assert.Equal(t, pod_status.Binding, cached.Nodes["node-2"].PodInfos["ns/binding-pod"].Status)
+	assert.Equal(t, float64(6000), cached.Nodes["node-2"].Idle.Cpu())
+}
+
+func assertSnapshotNodesEqual(t *testing.T, expected, actual *api.ClusterInfo) {
+	assert.ElementsMatch(t, expected.Pods, actual.Pods)
+	assert.Equal(t, expected.MinNodeGPUMemory, actual.MinNodeGPUMemory)
+	require.Equal(t, len(expected.Nodes), len(actual.Nodes))
+	for name, expectedNode := range expected.Nodes {
+		actualNode := actual.Nodes[name]
+		require.NotNil(t, actualNode, name)
+		assert.Equal(t, expectedNode.Idle, actualNode.Idle, name)
+		assert.Equal(t, expectedNode.Used, actualNode.Used, name)
+		assert.Equal(t, expectedNode.Releasing, actualNode.Releasing, name)
+		assert.Equal(t, expectedNode.ReservedForOtherSchedulers, actualNode.ReservedForOtherSchedulers, name)
+		assert.Equal(t, len(expectedNode.PodInfos), len(actualNode.PodInfos), name)
+		for key, podInfo := range expectedNode.PodInfos {
+			require.Contains(t, actualNode.PodInfos, key)
+			assert.Equal(t, podInfo.Status, actualNode.PodInfos[key].Status, key)
+		}
+	}
+	for _, podGroup := range expected.PodGroupInfos {
+		for _, podInfo := range podGroup.GetAllPodsMap() {
+			assert.Equal(t, podInfo.Status, actual.PodGroupInfos[podGroup.UID].GetAllPodsMap()[podInfo.UID].Status)
+		}
+	}
+}
+
+func TestNodeSnapshotCacheEventHandlers(t *testing.T) {
+	nodeCache := newNodeSnapshotCache()
+	nodeCache.podNodes["pod-1"] = "node-1"
+	handlerFuncs := func(handler cache.ResourceEventHandler) cache.ResourceEventHandlerFuncs {
+		return handler.(cache.ResourceEventHandlerFuncs)
+	}
+
+	bindRequest := &schedulingv1alpha2.BindRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "bind-pod-2", Namespace: "ns"},
+		Spec:       schedulingv1alpha2.BindRequestSpec{PodName: "pod-2", SelectedNode: "node-2"},
+	}
+	handlerFuncs(nodeCache.bindRequestEventHandler()).AddFunc(bindRequest)
+	assert.Equal(t, []string{"node-2"}, sortedChangedNodes(nodeCache))
+
+	// A pod that isn't bound yet changes the node selected by its bind request
+	handlerFuncs(nodeCache.podEventHandler()).UpdateFunc(
+		newNodeCacheTestPod("pod-2", "", v1.PodPending), newNodeCacheTestPod("pod-2", "node-3", v1.PodPending))
+	assert.Equal(t, []string{"node-2", "node-3"}, sortedChangedNodes(nodeCache))
+
+	handlerFuncs(nodeCache.bindRequestEventHandler()).DeleteFunc(
+		cache.DeletedFinalStateUnknown{Key: "ns/bind-pod-2", Obj: bindRequest})
+	assert.Empty(t, nodeCache.selectedNodes)
+
+	nodeCache.changedNodes.Clear()
+	handlerFuncs(nodeCache.resourceClaimEventHandler()).AddFunc(&resourceapi.ResourceClaim{
+		Status: resourceapi.ResourceClaimStatus{ReservedFor: []resourceapi.ResourceClaimConsumerReference{
+			{Resource: resource_info.ReservedForPodPlural, UID: "pod-1"},
+		}},
+	})
+	assert.Equal(t, []string{"node-1"}, sortedChangedNodes(nodeCache))
+
+	nodeCache.changedNodes.Clear()
+	handlerFuncs(nodeCache.resourceSliceEventHandler()).AddFunc(&resourceapi.ResourceSlice{
+		Spec: resourceapi.ResourceSliceSpec{AllNodes: ptr.To(true)},
+	})
+	handlerFuncs(nodeCache.resourceSliceEventHandler()).AddFunc(&resourceapi.ResourceSlice{
+		Spec: resourceapi.ResourceSliceSpec{NodeName: ptr.To("node-4")},
+	})
+	assert.Equal(t, []string{"node-4"}, sortedChangedNodes(nodeCache))
+}
+
+func sortedChangedNodes(nodeCache *nodeSnapshotCache) []string {
+	return sets.List(nodeCache.changedNodes)
+}
+
+func TestNodeSnapshotCacheEntryHasPods(t *testing.T) {
+	pod := newNodeCacheTestPod("pod-1", "node-1", v1.PodRunning)
+	podInfo := pod_info.NewTaskInfo(pod)
+	node := newNodeCacheTestNode("node-1")
+	nodeInfo := node_info.NewNodeInfo(node, NewK8sNodePodAffinityInfo(node, unregisteredPodAffinityInfo{}))
+	require.NoError(t, nodeInfo.AddTask(podInfo))
+	entry := newNodeSnapshotCacheEntry(nodeInfo, []*pod_info.PodInfo{podInfo})
+
+	assert.True(t, entry.hasPods([]*v1.Pod{pod}, nil))
+	assert.False(t, entry.hasPods(nil, nil))
+	// The informer stores a new object for every update of a pod
+	assert.False(t, entry.hasPods([]*v1.Pod{pod.DeepCopy()}, nil))
+}
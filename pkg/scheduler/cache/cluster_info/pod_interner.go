@@ -0,0 +1,133 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster_info
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"runtime"
+	"sync"
+	"unique"
+	"unsafe"
+	"weak"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// podInterner deduplicates the parts of pods that are identical across the replicas of a workload, before the pods
+// are stored by the pod informer. Pods with identical container specs, volumes, tolerations or affinity share a single
+// copy of them, and the strings of their labels, annotations and node selectors are shared with other pods. Managed
+// fields, which the scheduler never reads, are dropped.
+// Interned values are referenced weakly, so they are released once the last pod that uses them is deleted. Pods in
+// the informer cache must never be mutated in place, which holds even more so for interned values that are shared
+// between pods.
+type podInterner struct {
+	containers  *sliceInterner[v1.Container]
+	volumes     *sliceInterner[v1.Volume]
+	tolerations *sliceInterner[v1.Toleration]
+	affinities  *sliceInterner[v1.Affinity]
+}
+
+func newPodInterner() *podInterner {
+	return &podInterner{
+		containers:  newSliceInterner[v1.Container](),
+		volumes:     newSliceInterner[v1.Volume](),
+		tolerations: newSliceInterner[v1.Toleration](),
+		affinities:  newSliceInterner[v1.Affinity](),
+	}
+}
+
+// transform is a cache.TransformFunc that interns the pods stored by the informer. Other objects are returned as is.
+func (pi *podInterner) transform(obj interface{}) (interface{}, error) {
+	pod, ok := obj.(*v1.Pod)
+	if !ok {
+		return obj, nil
+	}
+
+	pod.ManagedFields = nil
+	pod.Labels = internStringMap(pod.Labels)
+	pod.Annotations = internStringMap(pod.Annotations)
+
+	pod.Spec.Containers = pi.containers.intern(pod.Spec.Containers)
+	pod.Spec.InitContainers = pi.containers.intern(pod.Spec.InitContainers)
+	pod.Spec.Volumes = pi.volumes.intern(pod.Spec.Volumes)
+	pod.Spec.Tolerations = pi.tolerations.intern(pod.Spec.Tolerations)
+	pod.Spec.NodeSelector = internStringMap(pod.Spec.NodeSelector)
+	if pod.Spec.Affinity != nil {
+		pod.Spec.Affinity = &pi.affinities.intern([]v1.Affinity{*pod.Spec.Affinity})[0]
+	}
+	return pod, nil
+}
+
+// internStringMap returns a copy of the map whose keys and values are shared with identical strings of other pods
+func internStringMap(values map[string]string) map[string]string {
+	if len(values) == 0 {
+		return values
+	}
+	interned := make(map[string]string, len(values))
+	for key, value := range values {
+		interned[unique.Make(key).Value()] = unique.Make(value).Value()
+	}
+	return interned
+}
+
+type sliceInternerKey [sha256.Size]byte
+
+type internedSlice[T any] struct {
+	first  weak.Pointer[T]
+	length int
+}
+
+// sliceInterner returns a shared copy of slices with identical content. Slices are compared by the hash of their JSON
+// representation, and are kept only as long as some pod references them.
+type sliceInterner[T any] struct {
+	mutex   sync.Mutex
+	entries map[sliceInternerKey]internedSlice[T]
+}
+
+func newSliceInterner[T any]() *sliceInterner[T] {
+	return &sliceInterner[T]{
+		entries: map[sliceInternerKey]internedSlice[T]{},
+	}
+}
+
+func (si *sliceInterner[T]) intern(values []T) []T {
+	if len(values) == 0 {
+		return values
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return values
+	}
+	key := sliceInternerKey(sha256.Sum256(data))
+
+	si.mutex.Lock()
+	defer si.mutex.Unlock()
+	if entry, found := si.entries[key]; found {
+		if first := entry.first.Value(); first != nil {
+			return unsafe.Slice(first, entry.length)
+		}
+	}
+
+	// the capacity is capped so that appending to the shared slice never writes into its backing array
+	values = values[:len(values):len(values)]
+	entry := internedSlice[T]{first: weak.Make(&values[0]), length: len(values)}
+	si.entries[key] = entry
+	runtime.AddCleanup(&values[0], si.release, internedSliceRelease[T]{key: key, first: entry.first})
+	return values
+}
+
+type internedSliceRelease[T any] struct {
+	key   sliceInternerKey
+	first weak.Pointer[T]
+}
+
+// release removes a collected slice from the interner, unless it was already replaced by a newer copy
+func (si *sliceInterner[T]) release(released internedSliceRelease[T]) {
+	si.mutex.Lock()
+	defer si.mutex.Unlock()
+	if entry, found := si.entries[released.key]; found && entry.first == released.first {
+		delete(si.entries, released.key)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster_info
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newInternerTestPod(name string, cpu string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:          name,
+			Namespace:     "ns",
+			Labels:        map[string]string{"app": "train"},
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name:  "worker",
+					Image: "trainer:latest",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu)},
+					},
+				},
+			},
+			Tolerations: []v1.Toleration{{Key: "gpu", Operator: v1.TolerationOpExists}},
+			Affinity: &v1.Affinity{NodeAffinity: &v1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{},
+			}},
+		},
+	}
+}
+
+func transformPod(t *testing.T, interner *podInterner, pod *v1.Pod) *v1.Pod {
+	obj, err := interner.transform(pod)
+	assert.NoError(t, err)
+	return obj.(*v1.Pod)
+}
+
+func TestPodInternerSharesIdenticalSpecs(t *testing.T) {
+	interner := newPodInterner()
+
+	first := transformPod(t, interner, newInternerTestPod("pod-1", "1"))
+	second := transformPod(t, interner, newInternerTestPod("pod-2", "1"))
+	other := transformPod(t, interner, newInternerTestPod("pod-3", "2"))
+
+	assert.Same(t, &first.Spec.Containers[0], &second.Spec.Containers[0])
+	assert.Same(t, &first.Spec.Tolerations[0], &second.Spec.Tolerations[0])
+	assert.Same(t, first.Spec.Affinity, second.Spec.Affinity)
+	assert.NotSame(t, &first.Spec.Containers[0], &other.Spec.Containers[0])
+	assert.Equal(t, "2", other.Spec.Containers[0].Resources.Requests.Cpu().String())
+
+	assert.Nil(t, first.ManagedFields)
+	assert.Equal(t, map[string]string{"app": "train"}, second.Labels)
+	assert.Equal(t, "pod-2", second.Name)
+}
+
+func TestPodInternerCapsSharedSlices(t *testing.T) {
+	interner := newPodInterner()
+	first := transformPod(t, interner, newInternerTestPod("pod-1", "1"))
+	second := transformPod(t, interner, newInternerTestPod("pod-2", "1"))
+
+	extended := append(first.Spec.Containers, v1.Container{Name: "sidecar"})
+	assert.Len(t, second.Spec.Containers, 1)
+	assert.NotSame(t, &extended[0], &second.Spec.Containers[0])
+}
+
+func TestPodInternerIgnoresOtherObjects(t *testing.T) {
+	interner := newPodInterner()
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+
+	obj, err := interner.transform(node)
+	assert.NoError(t, err)
+	assert.Same(t, node, obj)
+}
+
+func TestSliceInternerRelease(t *testing.T) {
+	interner := newSliceInterner[v1.Toleration]()
+	interned := interner.intern([]v1.Toleration{{Key: "gpu", Operator: v1.TolerationOpExists}})
+	var key sliceInternerKey
+	for entryKey := range interner.entries {
+		key = entryKey
+	}
+
+	// a release of a previous copy doesn't remove the current one
+	interner.release(internedSliceRelease[v1.Toleration]{key: key})
+	assert.Len(t, interner.entries, 1)
+
+	interner.release(internedSliceRelease[v1.Toleration]{key: key, first: interner.entries[key].first})
+	assert.Empty(t, interner.entries)
+	assert.Len(t, interned, 1)
+}
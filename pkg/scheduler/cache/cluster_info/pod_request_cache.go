@@ -0,0 +1,103 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster_info
+
+import (
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+)
+
+type podRequestCacheEntry struct {
+	resourceVersion string
+	resReq          *resource_info.ResourceRequirements
+}
+
+// podRequestCache keeps the aggregated resource request of every pod between snapshots. The requests are updated from
+// the pod informer deltas as pods are added, updated and deleted, so that a snapshot only parses the requests of pods
+// that changed since their last delta was applied. The nodes and queues of the snapshot are kept by the
+// nodeSnapshotCache and queueSnapshotCache.
+type podRequestCache struct {
+	mutex   sync.RWMutex
+	entries map[types.UID]podRequestCacheEntry
+}
+
+func newPodRequestCache() *podRequestCache {
+	return &podRequestCache{
+		entries: map[types.UID]podRequestCacheEntry{},
+	}
+}
+
+func (prc *podRequestCache) eventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if pod, ok := obj.(*v1.Pod); ok {
+				prc.update(pod)
+			}
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			if pod, ok := newObj.(*v1.Pod); ok {
+				prc.update(pod)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if pod, ok := obj.(*v1.Pod); ok {
+				prc.invalidate(pod.UID)
+			}
+		},
+	}
+}
+
+// resourceRequest returns a copy of the pod's resource request, computing and caching it if the delta of the pod
+// wasn't applied yet.
+func (prc *podRequestCache) resourceRequest(pod *v1.Pod) *resource_info.ResourceRequirements {
+	if prc == nil || pod.UID == "" || pod.ResourceVersion == "" {
+		return pod_info.GetPodResourceRequest(pod)
+	}
+
+	prc.mutex.RLock()
+	entry, found := prc.entries[pod.UID]
+	prc.mutex.RUnlock()
+	if found && entry.resourceVersion == pod.ResourceVersion {
+		return entry.resReq.Clone()
+	}
+	return prc.update(pod).Clone()
+}
+
+// update computes the resource request of the pod if it changed since it was cached, and returns the cached request
+func (prc *podRequestCache) update(pod *v1.Pod) *resource_info.ResourceRequirements {
+	if pod.UID == "" || pod.ResourceVersion == "" {
+		return pod_info.GetPodResourceRequest(pod)
+	}
+
+	prc.mutex.RLock()
+	entry, found := prc.entries[pod.UID]
+	prc.mutex.RUnlock()
+	if found && entry.resourceVersion == pod.ResourceVersion {
+		return entry.resReq
+	}
+
+	resReq := pod_info.GetPodResourceRequest(pod)
+	prc.mutex.Lock()
+	prc.entries[pod.UID] = podRequestCacheEntry{
+		resourceVersion: pod.ResourceVersion,
+		resReq:          resReq,
+	}
+	prc.mutex.Unlock()
+	return resReq
+}
+
+func (prc *podRequestCache) invalidate(uid types.UID) {
+	prc.mutex.Lock()
+	defer prc.mutex.Unlock()
+	delete(prc.entries, uid)
+}
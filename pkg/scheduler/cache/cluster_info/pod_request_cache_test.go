@@ -0,0 +1,92 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster_info
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func newRequestCacheTestPod(resourceVersion string, cpu string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "pod-1",
+			Namespace:       "ns",
+			UID:             "pod-1-uid",
+			ResourceVersion: resourceVersion,
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu)},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestPodRequestCacheReusesUnchangedPods(t *testing.T) {
+	requestCache := newPodRequestCache()
+
+	first := requestCache.resourceRequest(newRequestCacheTestPod("1", "1"))
+	assert.Equal(t, float64(1000), first.Cpu())
+	assert.Len(t, requestCache.entries, 1)
+
+	// Same resourceVersion - the cached request is returned even if the spec object differs
+	cached := requestCache.resourceRequest(newRequestCacheTestPod("1", "2"))
+	assert.Equal(t, float64(1000), cached.Cpu())
+
+	updated := requestCache.resourceRequest(newRequestCacheTestPod("2", "2"))
+	assert.Equal(t, float64(2000), updated.Cpu())
+}
+
+func TestPodRequestCacheReturnsCopies(t *testing.T) {
+	requestCache := newPodRequestCache()
+	pod := newRequestCacheTestPod("1", "1")
+
+	first := requestCache.resourceRequest(pod)
+	first.BaseResource.Add(first.BaseResource.Clone())
+
+	second := requestCache.resourceRequest(pod)
+	assert.Equal(t, float64(1000), second.Cpu())
+}
+
+func TestPodRequestCacheEvictsDeletedPods(t *testing.T) {
+	requestCache := newPodRequestCache()
+	pod := newRequestCacheTestPod("1", "1")
+	requestCache.resourceRequest(pod)
+
+	handler := requestCache.eventHandler().(cache.ResourceEventHandlerFuncs)
+	handler.DeleteFunc(cache.DeletedFinalStateUnknown{Key: "ns/pod-1", Obj: pod})
+	assert.Empty(t, requestCache.entries)
+}
+
+func TestPodRequestCacheSkipsPodsWithoutVersion(t *testing.T) {
+	requestCache := newPodRequestCache()
+	requestCache.resourceRequest(newRequestCacheTestPod("", "1"))
+	assert.Empty(t, requestCache.entries)
+}
+
+func TestPodRequestCacheAppliesInformerDeltas(t *testing.T) {
+	requestCache := newPodRequestCache()
+	handler := requestCache.eventHandler().(cache.ResourceEventHandlerFuncs)
+
+	handler.AddFunc(newRequestCacheTestPod("1", "1"))
+	assert.Equal(t, float64(1000), requestCache.entries["pod-1-uid"].resReq.Cpu())
+
+	handler.UpdateFunc(newRequestCacheTestPod("1", "1"), newRequestCacheTestPod("2", "2"))
+	assert.Equal(t, "2", requestCache.entries["pod-1-uid"].resourceVersion)
+
+	// The request of the pod is read from the applied delta - a spec that differs for the same resourceVersion isn't
+	// parsed again
+	cached := requestCache.resourceRequest(newRequestCacheTestPod("2", "3"))
+	assert.Equal(t, float64(2000), cached.Cpu())
+}
@@ -5,6 +5,7 @@ package cluster_info
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -13,6 +14,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/utils"
 )
 
 const (
@@ -54,21 +56,26 @@ func (c *ClusterInfo) snapshotQueues() (map[common_info.QueueID]*queue_info.Queu
 		err = errors.WithStack(fmt.Errorf("error listing queues: %w", err))
 		return nil, err
 	}
+	crossPoolQueues, err := c.listCrossPoolQueues()
+	if err != nil {
+		return nil, err
+	}
+	queues = append(queues, crossPoolQueues...)
 
 	result := map[common_info.QueueID]*queue_info.QueueInfo{}
 	if c.fairnessLevelType == FullFairness {
 		for _, queue := range queues {
-			queueInfo := queue_info.NewQueueInfo(queue)
+			queueInfo := c.queueSnapshotCache.queueInfo(queue)
 			result[queueInfo.UID] = queueInfo
 		}
 	} else if c.fairnessLevelType == ProjectLevelFairness {
-		defaultParentQueue := c.getDefaultParentQueue()
+		defaultParentQueue := c.queueSnapshotCache.defaultParent(c.getDefaultParentQueue)
 		result[defaultParentQueue.UID] = defaultParentQueue
 
 		for _, queue := range queues {
 			if len(queue.Spec.ParentQueue) > 0 {
-				queue.Spec.ParentQueue = defaultQueueName
-				queueInfo := queue_info.NewQueueInfo(queue)
+				queueInfo := c.queueSnapshotCache.queueInfo(queue)
+				queueInfo.ParentQueue = defaultQueueName
 				result[queueInfo.UID] = queueInfo
 			}
 		}
@@ -77,6 +84,27 @@ func (c *ClusterInfo) snapshotQueues() (map[common_info.QueueID]*queue_info.Queu
 	return result, nil
 }
 
+// listCrossPoolQueues returns the queues of other node pools that are entitled to their quota in this node pool
+func (c *ClusterInfo) listCrossPoolQueues() ([]*enginev2.Queue, error) {
+	if !c.isCrossPoolReclaimEnabled() {
+		return nil, nil
+	}
+	allQueues, err := c.dataLister.ListAllQueues()
+	if err != nil {
+		return nil, errors.WithStack(fmt.Errorf("error listing queues of all node pools: %w", err))
+	}
+
+	var result []*enginev2.Queue
+	for _, queue := range allQueues {
+		nodePool := utils.GetNodePoolNameFromLabels(queue.Labels, c.nodePoolParams.NodePoolLabelKey)
+		if nodePool != c.nodePoolParams.NodePoolLabelValue &&
+			slices.Contains(queue.Spec.NodePools, c.nodePoolParams.NodePoolLabelValue) {
+			result = append(result, queue)
+		}
+	}
+	return result, nil
+}
+
 func (c *ClusterInfo) snapshotQueueResourceUsage() (*queue_info.ClusterUsage, error) {
 	if !c.collectUsageData {
 		return nil, nil
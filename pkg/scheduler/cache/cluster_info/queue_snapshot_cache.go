@@ -0,0 +1,72 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster_info
+
+import (
+	"sync"
+
+	"k8s.io/client-go/tools/cache"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+)
+
+// queueSnapshotCache keeps the queue infos of the previous snapshot between snapshots. The queue informer deltas drop
+// the infos of the queues that changed, so that a snapshot only rebuilds the changed queues and clones the rest.
+type queueSnapshotCache struct {
+	mutex   sync.Mutex
+	entries map[string]queueSnapshotCacheEntry
+	// defaultParentQueue is the parent of all the queues when the fairness is at the project level
+	defaultParentQueue *queue_info.QueueInfo
+}
+
+type queueSnapshotCacheEntry struct {
+	queue     *enginev2.Queue
+	queueInfo *queue_info.QueueInfo
+}
+
+func newQueueSnapshotCache() *queueSnapshotCache {
+	return &queueSnapshotCache{
+		entries: map[string]queueSnapshotCacheEntry{},
+	}
+}
+
+func (qsc *queueSnapshotCache) eventHandler() cache.ResourceEventHandler {
+	return deltaEventHandler(func(queue *enginev2.Queue, _ bool) {
+		qsc.mutex.Lock()
+		defer qsc.mutex.Unlock()
+		delete(qsc.entries, queue.Name)
+	})
+}
+
+// queueInfo returns a copy of the info of the queue, building and caching it if the queue changed since it was cached
+func (qsc *queueSnapshotCache) queueInfo(queue *enginev2.Queue) *queue_info.QueueInfo {
+	if qsc == nil {
+		return queue_info.NewQueueInfo(queue)
+	}
+
+	qsc.mutex.Lock()
+	defer qsc.mutex.Unlock()
+	// The informer stores a new object for every update of the queue, even if its delta wasn't applied yet
+	entry, found := qsc.entries[queue.Name]
+	if !found || entry.queue != queue {
+		entry = queueSnapshotCacheEntry{queue: queue, queueInfo: queue_info.NewQueueInfo(queue)}
+		qsc.entries[queue.Name] = entry
+	}
+	return entry.queueInfo.Clone()
+}
+
+// defaultParent returns a copy of the default parent queue, which keeps its creation time between snapshots
+func (qsc *queueSnapshotCache) defaultParent(newDefaultParent func() *queue_info.QueueInfo) *queue_info.QueueInfo {
+	if qsc == nil {
+		return newDefaultParent()
+	}
+
+	qsc.mutex.Lock()
+	defer qsc.mutex.Unlock()
+	if qsc.defaultParentQueue == nil {
+		qsc.defaultParentQueue = newDefaultParent()
+	}
+	return qsc.defaultParentQueue.Clone()
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster_info
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+)
+
+func TestQueueSnapshotCacheQueueInfo(t *testing.T) {
+	queueCache := newQueueSnapshotCache()
+	queue := &enginev2.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "queue-1"},
+		Spec:       enginev2.QueueSpec{ParentQueue: "department-1"},
+	}
+
+	first := queueCache.queueInfo(queue)
+	first.AddChildQueue("queue-2")
+	first.ParentQueue = "default"
+	second := queueCache.queueInfo(queue)
+	assert.NotSame(t, first, second)
+	assert.Equal(t, common_info.QueueID("department-1"), second.ParentQueue)
+	assert.Empty(t, second.ChildQueues)
+	entry := queueCache.entries["queue-1"]
+
+	// The informer stores a new object for every update of the queue
+	updatedQueue := queue.DeepCopy()
+	updatedQueue.Spec.ParentQueue = "department-2"
+	assert.Equal(t, common_info.QueueID("department-2"), queueCache.queueInfo(updatedQueue).ParentQueue)
+	assert.NotSame(t, entry.queueInfo, queueCache.entries["queue-1"].queueInfo)
+
+	queueCache.eventHandler().(cache.ResourceEventHandlerFuncs).DeleteFunc(updatedQueue)
+	assert.NotContains(t, queueCache.entries, "queue-1")
+}
+
+func TestQueueSnapshotCacheDefaultParent(t *testing.T) {
+	queueCache := newQueueSnapshotCache()
+	newDefaultParent := func() *queue_info.QueueInfo {
+		return queue_info.NewQueueInfo(&enginev2.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", CreationTimestamp: metav1.Now()},
+		})
+	}
+
+	first := queueCache.defaultParent(newDefaultParent)
+	first.AddChildQueue("queue-1")
+	second := queueCache.defaultParent(newDefaultParent)
+	assert.NotSame(t, first, second)
+	assert.Equal(t, first.CreationTimestamp, second.CreationTimestamp)
+	assert.Empty(t, second.ChildQueues)
+}
@@ -8,7 +8,9 @@ import (
 
 	"github.com/pkg/errors"
 	storage "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/component-helpers/storage/ephemeral"
+	v1helper "k8s.io/kubernetes/pkg/apis/core/v1/helper"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/csidriver_info"
@@ -65,8 +67,33 @@ func (c *ClusterInfo) snapshotStorageClasses() (map[common_info.StorageClassID]*
 		}
 
 		result[common_info.StorageClassID(sc.Name)] = &storageclass_info.StorageClassInfo{
-			ID:          common_info.StorageClassID(sc.Name),
-			Provisioner: sc.Provisioner,
+			ID:                common_info.StorageClassID(sc.Name),
+			Provisioner:       sc.Provisioner,
+			AllowedTopologies: sc.AllowedTopologies,
+		}
+	}
+
+	return result, nil
+}
+
+// snapshotCSINodeVolumeLimits returns the number of volumes of every CSI driver that can be attached to every node
+func (c *ClusterInfo) snapshotCSINodeVolumeLimits() (map[string]map[common_info.CSIDriverID]int, error) {
+	csiNodes, err := c.dataLister.ListCSINodes()
+	if err != nil {
+		err = errors.WithStack(fmt.Errorf("error listing csinodes: %w", err))
+		return nil, err
+	}
+
+	result := map[string]map[common_info.CSIDriverID]int{}
+	for _, csiNode := range csiNodes {
+		for _, driver := range csiNode.Spec.Drivers {
+			if driver.Allocatable == nil || driver.Allocatable.Count == nil {
+				continue
+			}
+			if result[csiNode.Name] == nil {
+				result[csiNode.Name] = map[common_info.CSIDriverID]int{}
+			}
+			result[csiNode.Name][common_info.CSIDriverID(driver.Name)] = int(*driver.Allocatable.Count)
 		}
 	}
 
@@ -144,6 +171,70 @@ func setNodesAccessibleCapacities(storageCapacities map[common_info.StorageCapac
 	handleMultiCapacityNodes(nodes)
 }
 
+// restrictNodesAccessibleCapacities removes the capacities of storage classes whose allowed topologies don't include
+// the node, so that pending claims of these storage classes are not allocated on it.
+func restrictNodesAccessibleCapacities(
+	storageClasses map[common_info.StorageClassID]*storageclass_info.StorageClassInfo,
+	nodes map[string]*node_info.NodeInfo,
+) {
+	for _, node := range nodes {
+		for storageClassID := range node.AccessibleStorageCapacities {
+			storageClass, found := storageClasses[storageClassID]
+			if !found || len(storageClass.AllowedTopologies) == 0 {
+				continue
+			}
+			if !v1helper.MatchTopologySelectorTerms(storageClass.AllowedTopologies, labels.Set(node.Node.Labels)) {
+				log.InfraLogger.V(6).Infof("Node %s is not in the allowed topologies of storageclass %s",
+					node.Name, storageClassID)
+				delete(node.AccessibleStorageCapacities, storageClassID)
+			}
+		}
+	}
+}
+
+// setNodesVolumeLimits sets the attach limits of the CSI drivers of the storage classes on the nodes, and counts the
+// claims of the pods on every node against them.
+func setNodesVolumeLimits(
+	volumeLimits map[string]map[common_info.CSIDriverID]int,
+	storageClasses map[common_info.StorageClassID]*storageclass_info.StorageClassInfo,
+	existingPods map[common_info.PodID]*pod_info.PodInfo,
+	nodes map[string]*node_info.NodeInfo,
+) {
+	for nodeName, driverLimits := range volumeLimits {
+		node, found := nodes[nodeName]
+		if !found {
+			continue
+		}
+		limits := map[common_info.CSIDriverID]*csidriver_info.NodeVolumeLimit{}
+		for _, storageClass := range storageClasses {
+			driver := common_info.CSIDriverID(storageClass.Provisioner)
+			limit, found := driverLimits[driver]
+			if !found {
+				continue
+			}
+			if limits[driver] == nil {
+				limits[driver] = csidriver_info.NewNodeVolumeLimit(driver, limit)
+			}
+			node.CSIVolumeLimits[storageClass.ID] = limits[driver]
+		}
+	}
+
+	for _, pod := range existingPods {
+		if !pod_status.IsActiveUsedStatus(pod.Status) {
+			continue
+		}
+		node, found := nodes[pod.NodeName]
+		if !found {
+			continue
+		}
+		for _, claim := range pod.GetAllStorageClaims() {
+			if limit, found := node.CSIVolumeLimits[claim.StorageClass]; found {
+				limit.AddClaim(claim.Key)
+			}
+		}
+	}
+}
+
 // we currently don't know how to handle cases where there are multiple accessible storage capacities per node per storageclass.
 func handleMultiCapacityNodes(nodes map[string]*node_info.NodeInfo) {
 	for _, node := range nodes {
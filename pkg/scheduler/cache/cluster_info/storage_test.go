@@ -14,10 +14,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/csidriver_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/storagecapacity_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/storageclaim_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/storageclass_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants/status"
 )
 
@@ -424,3 +426,73 @@ func TestSetNodesAccessibleCapacities_DisableMultiCapacity(t *testing.T) {
 	assert.Equal(t, 0, len(nodes["node-1"].AccessibleStorageCapacities[storageClass]))
 	assert.Equal(t, 1, len(nodes["node-2"].AccessibleStorageCapacities[storageClass]))
 }
+
+func TestRestrictNodesAccessibleCapacities(t *testing.T) {
+	capacity, err := storagecapacity_info.NewStorageCapacityInfo(&v12.CSIStorageCapacity{
+		ObjectMeta:       metav1.ObjectMeta{Name: "capacity-1", UID: "capacity-1-uid"},
+		StorageClassName: storageClass,
+	})
+	assert.Nil(t, err)
+	storageClasses := map[common_info.StorageClassID]*storageclass_info.StorageClassInfo{
+		storageClass: {
+			ID:          storageClass,
+			Provisioner: "csi-driver",
+			AllowedTopologies: []v1.TopologySelectorTerm{{
+				MatchLabelExpressions: []v1.TopologySelectorLabelRequirement{
+					{Key: "zone", Values: []string{"zone-a"}},
+				},
+			}},
+		},
+	}
+	newNode := func(name, zone string) *node_info.NodeInfo {
+		return &node_info.NodeInfo{
+			Name: name,
+			Node: &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"zone": zone}}},
+			AccessibleStorageCapacities: map[common_info.StorageClassID][]*storagecapacity_info.StorageCapacityInfo{
+				storageClass: {capacity},
+			},
+		}
+	}
+	nodes := map[string]*node_info.NodeInfo{
+		"node-1": newNode("node-1", "zone-a"),
+		"node-2": newNode("node-2", "zone-b"),
+	}
+
+	restrictNodesAccessibleCapacities(storageClasses, nodes)
+
+	assert.Len(t, nodes["node-1"].AccessibleStorageCapacities[storageClass], 1)
+	assert.NotContains(t, nodes["node-2"].AccessibleStorageCapacities, common_info.StorageClassID(storageClass))
+}
+
+func TestSetNodesVolumeLimits(t *testing.T) {
+	storageClasses := map[common_info.StorageClassID]*storageclass_info.StorageClassInfo{
+		storageClass:      {ID: storageClass, Provisioner: "csi-driver"},
+		"storage-class-2": {ID: "storage-class-2", Provisioner: "csi-driver"},
+		"other-driver":    {ID: "other-driver", Provisioner: "other-csi-driver"},
+	}
+	nodes := map[string]*node_info.NodeInfo{
+		"node-1": {
+			Name:            "node-1",
+			CSIVolumeLimits: map[common_info.StorageClassID]*csidriver_info.NodeVolumeLimit{},
+		},
+	}
+	pod := pod_info.NewTaskInfo(&v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: testNamespace, UID: "pod-uid"},
+		Spec:       v1.PodSpec{NodeName: "node-1"},
+		Status:     v1.PodStatus{Phase: v1.PodRunning},
+	})
+	pod.UpsertStorageClaim(&storageclaim_info.StorageClaimInfo{
+		Key: ownedClaimKey, Name: ownedClaimName, Namespace: testNamespace, StorageClass: storageClass,
+	})
+	existingPods := map[common_info.PodID]*pod_info.PodInfo{pod.UID: pod}
+
+	setNodesVolumeLimits(map[string]map[common_info.CSIDriverID]int{"node-1": {"csi-driver": 3}},
+		storageClasses, existingPods, nodes)
+
+	limits := nodes["node-1"].CSIVolumeLimits
+	assert.Len(t, limits, 2)
+	assert.Same(t, limits[storageClass], limits["storage-class-2"],
+		"storage classes of the same driver share its limit")
+	assert.Equal(t, 3, limits[storageClass].Limit)
+	assert.Equal(t, map[storageclaim_info.Key]int{ownedClaimKey: 1}, limits[storageClass].AttachedClaims)
+}
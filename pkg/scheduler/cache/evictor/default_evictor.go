@@ -7,12 +7,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/k8s_internal"
 )
 
@@ -28,9 +33,10 @@ func New(kubeClient kubernetes.Interface, updatePodCondition bool) *defaultEvict
 	}
 }
 
-// +kubebuilder:rbac:groups="",resources=pods,verbs=delete
+// +kubebuilder:rbac:groups="",resources=pods,verbs=delete;patch
+// +kubebuilder:rbac:groups="",resources=pods/eviction,verbs=create
 
-func (de *defaultEvictor) Evict(pod *v1.Pod, message string) error {
+func (de *defaultEvictor) Evict(pod *v1.Pod, message string, method enginev2.EvictionMethod) error {
 	if de.shouldUpdatePodCondition {
 		err := de.updatePodCondition(pod, message)
 		if err != nil {
@@ -38,8 +44,39 @@ func (de *defaultEvictor) Evict(pod *v1.Pod, message string) error {
 		}
 	}
 
-	return de.kubeClient.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name,
-		metav1.DeleteOptions{})
+	switch method {
+	case enginev2.EvictionMethodEvictionAPI:
+		err := de.kubeClient.PolicyV1().Evictions(pod.Namespace).Evict(context.Background(), &policyv1.Eviction{
+			ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+		})
+		if apierrors.IsTooManyRequests(err) {
+			return fmt.Errorf("eviction is blocked by a pod disruption budget: %w", err)
+		}
+		return err
+	case enginev2.EvictionMethodCustom:
+		return de.requestEviction(pod)
+	default:
+		return de.kubeClient.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name,
+			metav1.DeleteOptions{})
+	}
+}
+
+// requestEviction annotates the pod with an eviction request, for a workload-specific controller to delete it
+func (de *defaultEvictor) requestEviction(pod *v1.Pod) error {
+	patchData, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{
+				constants.EvictionRequested: time.Now().UTC().Format(time.RFC3339),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = de.kubeClient.CoreV1().Pods(pod.Namespace).Patch(
+		context.Background(), pod.Name, types.MergePatchType, patchData, metav1.PatchOptions{},
+	)
+	return err
 }
 
 func (de *defaultEvictor) updatePodCondition(pod *v1.Pod, message string) error {
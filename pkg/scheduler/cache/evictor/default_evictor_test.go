@@ -0,0 +1,105 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package evictor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+func TestEvict(t *testing.T) {
+	tests := []struct {
+		name              string
+		method            enginev2.EvictionMethod
+		blockedByPDB      bool
+		expectedVerb      string
+		expectedResource  string
+		expectedDeleted   bool
+		expectedRequested bool
+		expectErr         bool
+	}{
+		{
+			name:             "default method deletes the pod",
+			expectedVerb:     "delete",
+			expectedResource: "pods",
+			expectedDeleted:  true,
+		},
+		{
+			name:             "delete method deletes the pod",
+			method:           enginev2.EvictionMethodDelete,
+			expectedVerb:     "delete",
+			expectedResource: "pods",
+			expectedDeleted:  true,
+		},
+		{
+			name:             "eviction API method evicts the pod",
+			method:           enginev2.EvictionMethodEvictionAPI,
+			expectedVerb:     "create",
+			expectedResource: "pods/eviction",
+		},
+		{
+			name:             "eviction blocked by a pod disruption budget",
+			method:           enginev2.EvictionMethodEvictionAPI,
+			blockedByPDB:     true,
+			expectedVerb:     "create",
+			expectedResource: "pods/eviction",
+			expectErr:        true,
+		},
+		{
+			name:              "custom method requests the eviction",
+			method:            enginev2.EvictionMethodCustom,
+			expectedVerb:      "patch",
+			expectedResource:  "pods",
+			expectedRequested: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns"}}
+			kubeClient := fake.NewSimpleClientset(pod)
+			if tt.blockedByPDB {
+				kubeClient.PrependReactor("create", "pods/eviction",
+					func(action k8stesting.Action) (bool, runtime.Object, error) {
+						return true, nil, apierrors.NewTooManyRequests("cannot evict pod", 10)
+					})
+			}
+
+			err := New(kubeClient, false).Evict(pod, "preempted", tt.method)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			actions := kubeClient.Actions()
+			assert.Len(t, actions, 1)
+			assert.Equal(t, tt.expectedVerb, actions[0].GetVerb())
+			resource := actions[0].GetResource().Resource
+			if actions[0].GetSubresource() != "" {
+				resource += "/" + actions[0].GetSubresource()
+			}
+			assert.Equal(t, tt.expectedResource, resource)
+
+			clusterPod, err := kubeClient.CoreV1().Pods("ns").Get(context.Background(), "pod", metav1.GetOptions{})
+			if tt.expectedDeleted {
+				assert.True(t, apierrors.IsNotFound(err))
+				return
+			}
+			assert.NoError(t, err)
+			_, requested := clusterPod.Annotations[constants.EvictionRequested]
+			assert.Equal(t, tt.expectedRequested, requested)
+		})
+	}
+}
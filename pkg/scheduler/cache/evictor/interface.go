@@ -5,8 +5,10 @@ package evictor
 
 import (
 	v1 "k8s.io/api/core/v1"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 )
 
 type Interface interface {
-	Evict(pod *v1.Pod, message string) error
+	Evict(pod *v1.Pod, message string, method enginev2.EvictionMethod) error
 }
@@ -25,6 +25,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	k8sframework "k8s.io/kubernetes/pkg/scheduler/framework"
 
+	kubeaischedulerinfo "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/informers/externalversions"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
@@ -41,8 +42,11 @@ type Cache interface {
 	Evict(ssnPod *v1.Pod, job *podgroup_info.PodGroupInfo, evictionMetadata eviction_info.EvictionMetadata, message string) error
 	RecordJobStatusEvent(job *podgroup_info.PodGroupInfo) error
 	TaskPipelined(task *pod_info.PodInfo, message string)
+	UpdateQueueReclaimable(queueName, nodePool string, reclaimable v1.ResourceList)
+	UpdateCrossPoolReclaimCost(job *podgroup_info.PodGroupInfo, nodePool string, cost *int)
 	KubeClient() kubernetes.Interface
 	KubeInformerFactory() informers.SharedInformerFactory
+	KAISchedulerInformerFactory() kubeaischedulerinfo.SharedInformerFactory
 	SnapshotSharedLister() k8sframework.NodeInfoLister
 	InternalK8sPlugins() *k8splugins.K8sPlugins
 	WaitForWorkers(stopCh <-chan struct{})
@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
 )
@@ -35,6 +36,14 @@ func (su *defaultStatusUpdater) keyForPodLabelsPayload(name, namespace string, u
 	return updatePayloadKey(types.NamespacedName{Name: name, Namespace: namespace}.String() + "_" + string(uid) + "-Labels")
 }
 
+func (su *defaultStatusUpdater) keyForCrossPoolReclaimCostPayload(name, namespace string, uid types.UID) updatePayloadKey {
+	return updatePayloadKey(types.NamespacedName{Name: name, Namespace: namespace}.String() + "_" + string(uid) + "-CrossPoolReclaimCost")
+}
+
+func (su *defaultStatusUpdater) keyForQueueReclaimablePayload(name, nodePool string) updatePayloadKey {
+	return updatePayloadKey(name + "_" + nodePool + "-Reclaimable")
+}
+
 func (su *defaultStatusUpdater) processPayload(ctx context.Context, payload *updatePayload) {
 	updateData, found := su.loadInflightUpdate(payload)
 	if !found {
@@ -46,6 +55,8 @@ func (su *defaultStatusUpdater) processPayload(ctx context.Context, payload *upd
 		su.updatePod(ctx, payload.key, updateData.patchData, updateData.subResources, updateData.object)
 	case podGroupType:
 		su.updatePodGroup(ctx, payload.key, updateData)
+	case queueType:
+		su.updateQueue(ctx, payload.key, updateData.patchData, updateData.subResources, updateData.object)
 	}
 }
 
@@ -57,6 +68,8 @@ func (su *defaultStatusUpdater) loadInflightUpdate(payload *updatePayload) (*inf
 		data, found = su.inFlightPods.Load(payload.key)
 	case payload.objectType == podGroupType:
 		data, found = su.inFlightPodGroups.Load(payload.key)
+	case payload.objectType == queueType:
+		data, found = su.inFlightQueues.Load(payload.key)
 	}
 
 	if !found {
@@ -97,8 +110,12 @@ func (su *defaultStatusUpdater) updatePodGroup(
 	}
 
 	if len(updateData.patchData) > 0 {
+		patchType := updateData.patchType
+		if patchType == "" {
+			patchType = types.JSONPatchType
+		}
 		_, patchErr = su.kaiClient.SchedulingV2alpha2().PodGroups(podGroup.Namespace).Patch(
-			ctx, podGroup.Name, types.JSONPatchType, updateData.patchData, metav1.PatchOptions{}, updateData.subResources...,
+			ctx, podGroup.Name, patchType, updateData.patchData, metav1.PatchOptions{}, updateData.subResources...,
 		)
 	}
 
@@ -139,12 +156,32 @@ func (su *defaultStatusUpdater) updatePodGroup(
 	}
 }
 
+// +kubebuilder:rbac:groups="scheduling.run.ai",resources=queues/status,verbs=patch
+
+// updateQueue applies a patch to the queue. Failed patches aren't retried, since the patch is recalculated in the
+// next scheduling cycle.
+func (su *defaultStatusUpdater) updateQueue(
+	ctx context.Context, key updatePayloadKey, patchData []byte, subResources []string, object runtime.Object,
+) {
+	queue := object.(*enginev2.Queue)
+	_, err := su.kaiClient.SchedulingV2().Queues("").Patch(
+		ctx, queue.Name, types.MergePatchType, patchData, metav1.PatchOptions{}, subResources...,
+	)
+	if err != nil {
+		log.StatusUpdaterLogger.V(1).Errorf("Failed to patch queue %s: %v", queue.Name, err)
+	}
+
+	su.inFlightQueues.Delete(key)
+}
+
 func (su *defaultStatusUpdater) updateInFlightObject(key updatePayloadKey, objectType string, object *inflightUpdate) {
 	switch objectType {
 	case podType:
 		su.inFlightPods.Store(key, object)
 	case podGroupType:
 		su.inFlightPodGroups.Store(key, object)
+	case queueType:
+		su.inFlightQueues.Store(key, object)
 	}
 }
 
@@ -7,7 +7,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,10 +17,13 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 
 	kai "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned"
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
@@ -34,6 +39,7 @@ import (
 const (
 	podType      = "pod"
 	podGroupType = "podgroup"
+	queueType    = "queue"
 
 	// Eviction event annotations
 	evictionGangSize                    = "num-evicted-pods"
@@ -52,6 +58,7 @@ type updatePayload struct {
 type inflightUpdate struct {
 	object       runtime.Object
 	patchData    []byte
+	patchType    types.PatchType
 	updateStatus bool
 	subResources []string
 }
@@ -70,6 +77,7 @@ type defaultStatusUpdater struct {
 
 	inFlightPodGroups sync.Map
 	inFlightPods      sync.Map
+	inFlightQueues    sync.Map
 
 	appliedPodGroupUpdates sync.Map
 }
@@ -169,6 +177,71 @@ func (su *defaultStatusUpdater) Pipelined(pod *v1.Pod, message string) {
 	su.recorder.Eventf(pod, v1.EventTypeNormal, "Pipelined", message)
 }
 
+// PatchQueueReclaimable sets the reclaimable resources of the node pool in the status of the queue. The status
+// holds the resources of every node pool, and a merge patch only replaces those of the given node pool.
+func (su *defaultStatusUpdater) PatchQueueReclaimable(queueName, nodePool string, reclaimable v1.ResourceList) {
+	patchBytes, err := json.Marshal(map[string]any{
+		"status": map[string]any{
+			"reclaimable": map[string]any{
+				nodePool: reclaimable,
+			},
+		},
+	})
+	if err != nil {
+		log.InfraLogger.Errorf("Failed to create patch for the reclaimable resources of queue <%s>: %v",
+			queueName, err)
+		return
+	}
+
+	su.pushToUpdateQueue(
+		&updatePayload{
+			key:        su.keyForQueueReclaimablePayload(queueName, nodePool),
+			objectType: queueType,
+		},
+		&inflightUpdate{
+			object:       &enginev2.Queue{ObjectMeta: metav1.ObjectMeta{Name: queueName}},
+			patchData:    patchBytes,
+			subResources: []string{"status"},
+		},
+	)
+}
+
+// PatchPodGroupCrossPoolReclaimCost sets the number of pods that reclaim evicts for the pod group in the node pool,
+// or removes it when cost is nil. The schedulers of several node pools publish their costs on the same pod group, and
+// a merge patch only replaces the annotation of the given node pool.
+func (su *defaultStatusUpdater) PatchPodGroupCrossPoolReclaimCost(
+	podGroup *enginev2alpha2.PodGroup, nodePool string, cost *int,
+) {
+	var value any
+	if cost != nil {
+		value = strconv.Itoa(*cost)
+	}
+	patchBytes, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]any{
+				commonconstants.CrossPoolReclaimCostPrefix + nodePool: value,
+			},
+		},
+	})
+	if err != nil {
+		log.InfraLogger.Errorf("Failed to create patch for the cross pool reclaim cost of podgroup <%s/%s>: %v",
+			podGroup.Namespace, podGroup.Name, err)
+		return
+	}
+
+	su.pushToUpdateQueue(
+		&updatePayload{
+			key:        su.keyForCrossPoolReclaimCostPayload(podGroup.Name, podGroup.Namespace, podGroup.UID),
+			objectType: podGroupType,
+		},
+		&inflightUpdate{
+			object:    podGroup,
+			patchData: patchBytes,
+			patchType: types.MergePatchType,
+		},
+	)
+}
+
 func (su *defaultStatusUpdater) PatchPodLabels(pod *v1.Pod, labels map[string]any) {
 	log.InfraLogger.V(6).Infof("Patching pod labels for %s/%s", pod.Namespace, pod.Name)
 
@@ -205,6 +278,12 @@ func (su *defaultStatusUpdater) RecordJobStatusEvent(job *podgroup_info.PodGroup
 	if job.StalenessInfo.Stale {
 		su.recordStaleJobEvent(job)
 	}
+	if job.StartSkew != nil {
+		su.recordStartSkewEvent(job)
+	}
+	if job.CrossPoolReclaim.TargetNodePool != "" {
+		su.recordCrossPoolReclaimEvent(job)
+	}
 
 	updatePodgroupStatus := false
 	if job.GetNumPendingTasks() > 0 || job.GetNumGatedTasks() > 0 {
@@ -217,6 +296,18 @@ func (su *defaultStatusUpdater) RecordJobStatusEvent(job *podgroup_info.PodGroup
 		}
 		updatePodgroupStatus = su.recordUnschedulablePodGroup(job)
 	}
+	if setPodGroupStartTimePrediction(job.PodGroup, job.StartTimePrediction) {
+		updatePodgroupStatus = true
+	}
+	if setPodGroupRelaxedConstraints(job.PodGroup, job.RelaxedConstraints) {
+		updatePodgroupStatus = true
+	}
+	if setPodGroupPreemptions(job.PodGroup, job.Preemptions) {
+		updatePodgroupStatus = true
+	}
+	if setPodGroupPlaceableReplicas(job.PodGroup, job.PlaceableReplicas) {
+		updatePodgroupStatus = true
+	}
 
 	if len(patchData) > 0 || updatePodgroupStatus {
 		su.pushToUpdateQueue(
@@ -275,6 +366,18 @@ func (su *defaultStatusUpdater) recordStaleJobEvent(job *podgroup_info.PodGroupI
 	su.recorder.Eventf(job.PodGroup, v1.EventTypeNormal, "StaleJob", message)
 }
 
+func (su *defaultStatusUpdater) recordStartSkewEvent(job *podgroup_info.PodGroupInfo) {
+	message := fmt.Sprintf("Pods of the job started %s apart, more than the allowed %s. Slowest pod is %s",
+		job.StartSkew.Skew.Round(time.Second), job.StartSkew.MaxSkew, job.StartSkew.SlowestTask)
+	su.recorder.Eventf(job.PodGroup, v1.EventTypeWarning, "GangStartSkew", message)
+}
+
+func (su *defaultStatusUpdater) recordCrossPoolReclaimEvent(job *podgroup_info.PodGroupInfo) {
+	message := fmt.Sprintf("Moved to node pool %s, where reclaim evicts the fewest pods for the job",
+		job.CrossPoolReclaim.TargetNodePool)
+	su.recorder.Eventf(job.PodGroup, v1.EventTypeNormal, "CrossPoolReclaim", message)
+}
+
 func (su *defaultStatusUpdater) recordJobNotReadyEvent(job *podgroup_info.PodGroupInfo) {
 	message := fmt.Sprintf("Job is not ready for scheduling.")
 	for _, subGroup := range job.GetSubGroups() {
@@ -378,7 +481,11 @@ func (su *defaultStatusUpdater) updatePodGroupAnnotations(job *podgroup_info.Pod
 	old := job.PodGroup.DeepCopy()
 	updatedStaleTime := setPodGroupStaleTimeStamp(job.PodGroup, job.StalenessInfo.TimeStamp)
 	updatedStartTime := setPodGroupLastStartTimeStamp(job.PodGroup, job.LastStartTimestamp)
-	if !updatedStaleTime && !updatedStartTime {
+	updatedLoanLenders := setPodGroupLoanLenders(job.PodGroup, job.LoanLenders)
+	updatedScavengerQueue := setPodGroupScavengerQueueLabel(job.PodGroup, job)
+	updatedCrossPoolReclaim := setPodGroupCrossPoolReclaim(job.PodGroup, job.CrossPoolReclaim, su.nodePoolLabelKey)
+	if !updatedStaleTime && !updatedStartTime && !updatedLoanLenders && !updatedScavengerQueue &&
+		!updatedCrossPoolReclaim {
 		return nil, nil
 	}
 
@@ -485,6 +592,161 @@ func setPodGroupLastStartTimeStamp(podGroup *enginev2alpha2.PodGroup, startTimeS
 	return true
 }
 
+func setPodGroupLoanLenders(podGroup *enginev2alpha2.PodGroup, lenders []common_info.QueueID) bool {
+	if podGroup.Annotations == nil {
+		podGroup.Annotations = make(map[string]string)
+	}
+
+	if len(lenders) == 0 {
+		if _, found := podGroup.Annotations[commonconstants.LoanLenders]; !found {
+			return false
+		}
+
+		delete(podGroup.Annotations, commonconstants.LoanLenders)
+		return true
+	}
+
+	lenderNames := make([]string, 0, len(lenders))
+	for _, lender := range lenders {
+		lenderNames = append(lenderNames, string(lender))
+	}
+	value := strings.Join(lenderNames, ",")
+	if podGroup.Annotations[commonconstants.LoanLenders] == value {
+		return false
+	}
+
+	podGroup.Annotations[commonconstants.LoanLenders] = value
+	return true
+}
+
+// setPodGroupScavengerQueueLabel labels the pod groups of jobs that run under the scavenger queue with it, so that
+// their resources are accounted to the scavenger queue rather than to their own queue
+func setPodGroupScavengerQueueLabel(podGroup *enginev2alpha2.PodGroup, job *podgroup_info.PodGroupInfo) bool {
+	if job.ScavengedFrom == "" {
+		if _, found := podGroup.Labels[commonconstants.ScavengerQueueLabelKey]; !found {
+			return false
+		}
+
+		delete(podGroup.Labels, commonconstants.ScavengerQueueLabelKey)
+		return true
+	}
+
+	if podGroup.Labels[commonconstants.ScavengerQueueLabelKey] == string(job.Queue) {
+		return false
+	}
+	if podGroup.Labels == nil {
+		podGroup.Labels = make(map[string]string)
+	}
+	podGroup.Labels[commonconstants.ScavengerQueueLabelKey] = string(job.Queue)
+	return true
+}
+
+// setPodGroupCrossPoolReclaim moves the pod group to the node pool it reclaims in, or requests the reclaim costs of
+// the other node pools with the cross pool reclaim label. Costs are removed once the pod group stops requesting them.
+func setPodGroupCrossPoolReclaim(
+	podGroup *enginev2alpha2.PodGroup, crossPoolReclaim podgroup_info.CrossPoolReclaimInfo, nodePoolLabelKey string,
+) bool {
+	updated := false
+	if crossPoolReclaim.TargetNodePool != "" && nodePoolLabelKey != "" &&
+		podGroup.Labels[nodePoolLabelKey] != crossPoolReclaim.TargetNodePool {
+		if podGroup.Labels == nil {
+			podGroup.Labels = make(map[string]string)
+		}
+		podGroup.Labels[nodePoolLabelKey] = crossPoolReclaim.TargetNodePool
+		crossPoolReclaim.Requested = false
+		updated = true
+	}
+
+	if crossPoolReclaim.Requested {
+		if podGroup.Labels[commonconstants.CrossPoolReclaimLabelKey] == "true" {
+			return updated
+		}
+		if podGroup.Labels == nil {
+			podGroup.Labels = make(map[string]string)
+		}
+		podGroup.Labels[commonconstants.CrossPoolReclaimLabelKey] = "true"
+		return true
+	}
+
+	if _, found := podGroup.Labels[commonconstants.CrossPoolReclaimLabelKey]; found {
+		delete(podGroup.Labels, commonconstants.CrossPoolReclaimLabelKey)
+		updated = true
+	}
+	for key := range podGroup.Annotations {
+		if strings.HasPrefix(key, commonconstants.CrossPoolReclaimCostPrefix) {
+			delete(podGroup.Annotations, key)
+			updated = true
+		}
+	}
+	return updated
+}
+
+func setPodGroupStartTimePrediction(
+	podGroup *enginev2alpha2.PodGroup, prediction *enginev2alpha2.StartTimePrediction,
+) bool {
+	current := podGroup.Status.StartTimePrediction
+	if prediction == nil {
+		if current == nil {
+			return false
+		}
+
+		podGroup.Status.StartTimePrediction = nil
+		return true
+	}
+
+	if current != nil && current.QueuePosition == prediction.QueuePosition && current.Reason == prediction.Reason &&
+		current.ExpectedStartTime.Equal(prediction.ExpectedStartTime) {
+		return false
+	}
+
+	updated := prediction.DeepCopy()
+	updated.LastUpdateTime = metav1.Now()
+	podGroup.Status.StartTimePrediction = updated
+	return true
+}
+
+func setPodGroupRelaxedConstraints(
+	podGroup *enginev2alpha2.PodGroup, relaxedConstraints []enginev2alpha2.RelaxedConstraint,
+) bool {
+	if slices.EqualFunc(podGroup.Status.RelaxedConstraints, relaxedConstraints,
+		func(current, relaxed enginev2alpha2.RelaxedConstraint) bool {
+			return current.Constraint == relaxed.Constraint
+		}) {
+		return false
+	}
+
+	podGroup.Status.RelaxedConstraints = slices.Clone(relaxedConstraints)
+	return true
+}
+
+func setPodGroupPreemptions(
+	podGroup *enginev2alpha2.PodGroup, preemptions *enginev2alpha2.PodGroupPreemptions,
+) bool {
+	if preemptions == nil {
+		return false
+	}
+	current := podGroup.Status.Preemptions
+	if current != nil && current.Count == preemptions.Count &&
+		current.LastPreemptionTime.Equal(preemptions.LastPreemptionTime) {
+		return false
+	}
+
+	podGroup.Status.Preemptions = preemptions.DeepCopy()
+	return true
+}
+
+func setPodGroupPlaceableReplicas(podGroup *enginev2alpha2.PodGroup, placeableReplicas *int32) bool {
+	if ptr.Equal(podGroup.Status.PlaceableReplicas, placeableReplicas) {
+		return false
+	}
+
+	podGroup.Status.PlaceableReplicas = nil
+	if placeableReplicas != nil {
+		podGroup.Status.PlaceableReplicas = ptr.To(*placeableReplicas)
+	}
+	return true
+}
+
 func setPodGroupSchedulingCondition(podGroup *enginev2alpha2.PodGroup, schedulingCondition *enginev2alpha2.SchedulingCondition) bool {
 	currentSchedulingConditionIndex := utils.GetSchedulingConditionIndex(podGroup, schedulingCondition.NodePool)
 	lastSchedulingCondition := utils.GetLastSchedulingCondition(podGroup)
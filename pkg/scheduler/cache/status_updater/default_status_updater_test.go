@@ -4,6 +4,7 @@
 package status_updater
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"testing"
@@ -482,6 +483,194 @@ func TestUpdatePodGroupStaleTimeStamp(t *testing.T) {
 	}
 }
 
+func TestSetPodGroupStartTimePrediction(t *testing.T) {
+	expectedStartTime := metav1.NewTime(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	lastUpdateTime := metav1.NewTime(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))
+	existingPrediction := &enginev2alpha2.StartTimePrediction{
+		ExpectedStartTime: &expectedStartTime,
+		QueuePosition:     2,
+		Reason:            enginev2alpha2.WaitingForRunningJobs,
+		LastUpdateTime:    lastUpdateTime,
+	}
+
+	tests := []struct {
+		name                   string
+		current                *enginev2alpha2.StartTimePrediction
+		prediction             *enginev2alpha2.StartTimePrediction
+		expectedUpdated        bool
+		expectedQueuePosition  *int32
+		expectedLastUpdateTime bool
+	}{
+		{
+			name: "no prediction to set or clear",
+		},
+		{
+			name:            "clear a prediction of a job that is no longer pending",
+			current:         existingPrediction.DeepCopy(),
+			expectedUpdated: true,
+		},
+		{
+			name: "set a new prediction",
+			prediction: &enginev2alpha2.StartTimePrediction{
+				ExpectedStartTime: &expectedStartTime,
+				QueuePosition:     1,
+				Reason:            enginev2alpha2.WaitingForRunningJobs,
+			},
+			expectedUpdated:       true,
+			expectedQueuePosition: ptr.To(int32(1)),
+		},
+		{
+			name:    "same prediction keeps the last update time",
+			current: existingPrediction.DeepCopy(),
+			prediction: &enginev2alpha2.StartTimePrediction{
+				ExpectedStartTime: &expectedStartTime,
+				QueuePosition:     2,
+				Reason:            enginev2alpha2.WaitingForRunningJobs,
+			},
+			expectedQueuePosition:  ptr.To(int32(2)),
+			expectedLastUpdateTime: true,
+		},
+		{
+			name:    "changed queue position",
+			current: existingPrediction.DeepCopy(),
+			prediction: &enginev2alpha2.StartTimePrediction{
+				ExpectedStartTime: &expectedStartTime,
+				QueuePosition:     1,
+				Reason:            enginev2alpha2.WaitingForRunningJobs,
+			},
+			expectedUpdated:       true,
+			expectedQueuePosition: ptr.To(int32(1)),
+		},
+		{
+			name:    "start time that can no longer be predicted",
+			current: existingPrediction.DeepCopy(),
+			prediction: &enginev2alpha2.StartTimePrediction{
+				QueuePosition: 2,
+				Reason:        enginev2alpha2.NoHistory,
+			},
+			expectedUpdated:       true,
+			expectedQueuePosition: ptr.To(int32(2)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			podGroup := &enginev2alpha2.PodGroup{
+				Status: enginev2alpha2.PodGroupStatus{StartTimePrediction: tt.current},
+			}
+
+			updated := setPodGroupStartTimePrediction(podGroup, tt.prediction)
+
+			assert.Equal(t, tt.expectedUpdated, updated)
+			prediction := podGroup.Status.StartTimePrediction
+			if tt.expectedQueuePosition == nil {
+				assert.Nil(t, prediction)
+				return
+			}
+			assert.Equal(t, *tt.expectedQueuePosition, prediction.QueuePosition)
+			assert.Equal(t, tt.expectedLastUpdateTime, prediction.LastUpdateTime.Equal(&lastUpdateTime))
+		})
+	}
+}
+
+func TestSetPodGroupRelaxedConstraints(t *testing.T) {
+	relaxationTime := metav1.NewTime(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))
+	preferredTopology := enginev2alpha2.RelaxedConstraint{
+		Constraint:     enginev2alpha2.PreferredTopologyConstraint,
+		RelaxationTime: relaxationTime,
+	}
+	subGroupSpread := enginev2alpha2.RelaxedConstraint{
+		Constraint:     enginev2alpha2.SubGroupSpreadConstraint,
+		RelaxationTime: metav1.Now(),
+	}
+
+	tests := []struct {
+		name               string
+		current            []enginev2alpha2.RelaxedConstraint
+		relaxedConstraints []enginev2alpha2.RelaxedConstraint
+		expectedUpdated    bool
+	}{
+		{
+			name: "no relaxed constraints",
+		},
+		{
+			name:               "same relaxed constraints",
+			current:            []enginev2alpha2.RelaxedConstraint{preferredTopology},
+			relaxedConstraints: []enginev2alpha2.RelaxedConstraint{preferredTopology},
+		},
+		{
+			name:               "newly relaxed constraint",
+			current:            []enginev2alpha2.RelaxedConstraint{preferredTopology},
+			relaxedConstraints: []enginev2alpha2.RelaxedConstraint{preferredTopology, subGroupSpread},
+			expectedUpdated:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			podGroup := &enginev2alpha2.PodGroup{
+				Status: enginev2alpha2.PodGroupStatus{RelaxedConstraints: tt.current},
+			}
+
+			updated := setPodGroupRelaxedConstraints(podGroup, tt.relaxedConstraints)
+
+			assert.Equal(t, tt.expectedUpdated, updated)
+			assert.Equal(t, len(tt.relaxedConstraints), len(podGroup.Status.RelaxedConstraints))
+			for i, relaxed := range tt.relaxedConstraints {
+				assert.Equal(t, relaxed.Constraint, podGroup.Status.RelaxedConstraints[i].Constraint)
+				assert.True(t, relaxed.RelaxationTime.Equal(&podGroup.Status.RelaxedConstraints[i].RelaxationTime))
+			}
+		})
+	}
+}
+
+func TestSetPodGroupPreemptions(t *testing.T) {
+	preemptionTime := metav1.NewTime(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))
+	laterPreemptionTime := metav1.NewTime(preemptionTime.Add(time.Hour))
+
+	tests := []struct {
+		name            string
+		current         *enginev2alpha2.PodGroupPreemptions
+		preemptions     *enginev2alpha2.PodGroupPreemptions
+		expectedUpdated bool
+	}{
+		{
+			name: "never preempted",
+		},
+		{
+			name:        "same preemptions",
+			current:     &enginev2alpha2.PodGroupPreemptions{Count: 1, LastPreemptionTime: &preemptionTime},
+			preemptions: &enginev2alpha2.PodGroupPreemptions{Count: 1, LastPreemptionTime: &preemptionTime},
+		},
+		{
+			name:            "first preemption",
+			preemptions:     &enginev2alpha2.PodGroupPreemptions{Count: 1, LastPreemptionTime: &preemptionTime},
+			expectedUpdated: true,
+		},
+		{
+			name:            "another preemption",
+			current:         &enginev2alpha2.PodGroupPreemptions{Count: 1, LastPreemptionTime: &preemptionTime},
+			preemptions:     &enginev2alpha2.PodGroupPreemptions{Count: 2, LastPreemptionTime: &laterPreemptionTime},
+			expectedUpdated: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			podGroup := &enginev2alpha2.PodGroup{
+				Status: enginev2alpha2.PodGroupStatus{Preemptions: tt.current},
+			}
+
+			updated := setPodGroupPreemptions(podGroup, tt.preemptions)
+
+			assert.Equal(t, tt.expectedUpdated, updated)
+			if tt.preemptions == nil {
+				assert.Nil(t, podGroup.Status.Preemptions)
+				return
+			}
+			assert.Equal(t, tt.preemptions.Count, podGroup.Status.Preemptions.Count)
+			assert.True(t, tt.preemptions.LastPreemptionTime.Equal(podGroup.Status.Preemptions.LastPreemptionTime))
+		})
+	}
+}
+
 func getTimePointer(ts string) *time.Time {
 	t, err := time.Parse(time.RFC3339, ts)
 	if err != nil {
@@ -812,3 +1001,117 @@ func waitForIncrease(callCount *int) error {
 	}
 	return errors.New("update calls did not increase")
 }
+
+func TestSetPodGroupCrossPoolReclaim(t *testing.T) {
+	costAnnotation := commonconstants.CrossPoolReclaimCostPrefix + "pool-b"
+	for _, test := range []struct {
+		name                string
+		labels              map[string]string
+		annotations         map[string]string
+		crossPoolReclaim    podgroup_info.CrossPoolReclaimInfo
+		expectedLabels      map[string]string
+		expectedAnnotations map[string]string
+		expectedUpdated     bool
+	}{
+		{
+			name:            "no cross pool reclaim",
+			labels:          map[string]string{nodePoolLabelKey: "pool-a"},
+			expectedLabels:  map[string]string{nodePoolLabelKey: "pool-a"},
+			expectedUpdated: false,
+		},
+		{
+			name:             "request the reclaim costs",
+			labels:           map[string]string{nodePoolLabelKey: "pool-a"},
+			crossPoolReclaim: podgroup_info.CrossPoolReclaimInfo{Requested: true},
+			expectedLabels: map[string]string{
+				nodePoolLabelKey: "pool-a", commonconstants.CrossPoolReclaimLabelKey: "true"},
+			expectedUpdated: true,
+		},
+		{
+			name: "keep requesting the reclaim costs",
+			labels: map[string]string{
+				nodePoolLabelKey: "pool-a", commonconstants.CrossPoolReclaimLabelKey: "true"},
+			annotations:      map[string]string{costAnnotation: "2"},
+			crossPoolReclaim: podgroup_info.CrossPoolReclaimInfo{Requested: true},
+			expectedLabels: map[string]string{
+				nodePoolLabelKey: "pool-a", commonconstants.CrossPoolReclaimLabelKey: "true"},
+			expectedAnnotations: map[string]string{costAnnotation: "2"},
+			expectedUpdated:     false,
+		},
+		{
+			name: "stop requesting the reclaim costs",
+			labels: map[string]string{
+				nodePoolLabelKey: "pool-a", commonconstants.CrossPoolReclaimLabelKey: "true"},
+			annotations:         map[string]string{costAnnotation: "2", "other": "value"},
+			expectedLabels:      map[string]string{nodePoolLabelKey: "pool-a"},
+			expectedAnnotations: map[string]string{"other": "value"},
+			expectedUpdated:     true,
+		},
+		{
+			name: "move to the target node pool",
+			labels: map[string]string{
+				nodePoolLabelKey: "pool-a", commonconstants.CrossPoolReclaimLabelKey: "true"},
+			annotations: map[string]string{costAnnotation: "2"},
+			crossPoolReclaim: podgroup_info.CrossPoolReclaimInfo{
+				Requested: true, Costs: map[string]int{"pool-b": 2}, TargetNodePool: "pool-b"},
+			expectedLabels:  map[string]string{nodePoolLabelKey: "pool-b"},
+			expectedUpdated: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			podGroup := &enginev2alpha2.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Labels: test.labels, Annotations: test.annotations},
+			}
+			updated := setPodGroupCrossPoolReclaim(podGroup, test.crossPoolReclaim, nodePoolLabelKey)
+
+			assert.Equal(t, test.expectedUpdated, updated)
+			assert.Equal(t, test.expectedLabels, podGroup.Labels)
+			if len(test.expectedAnnotations) == 0 {
+				assert.Empty(t, podGroup.Annotations)
+			} else {
+				assert.Equal(t, test.expectedAnnotations, podGroup.Annotations)
+			}
+		})
+	}
+}
+
+func TestDefaultStatusUpdater_PatchPodGroupCrossPoolReclaimCost(t *testing.T) {
+	otherCostAnnotation := commonconstants.CrossPoolReclaimCostPrefix + "pool-c"
+	costAnnotation := commonconstants.CrossPoolReclaimCostPrefix + "pool-b"
+	podGroup := &enginev2alpha2.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "pg",
+			Namespace:   "ns",
+			Annotations: map[string]string{otherCostAnnotation: "3"},
+		},
+	}
+	kubeClient := fake.NewSimpleClientset()
+	kubeAiSchedClient := kubeaischedfake.NewSimpleClientset(podGroup)
+	statusUpdater := New(kubeClient, kubeAiSchedClient, record.NewFakeRecorder(100), 1, false, nodePoolLabelKey)
+
+	stopCh := make(chan struct{})
+	statusUpdater.Run(stopCh)
+	defer close(stopCh)
+
+	getAnnotations := func() map[string]string {
+		current, err := kubeAiSchedClient.SchedulingV2alpha2().PodGroups("ns").Get(
+			context.Background(), "pg", metav1.GetOptions{})
+		if err != nil {
+			return nil
+		}
+		return current.Annotations
+	}
+
+	statusUpdater.PatchPodGroupCrossPoolReclaimCost(podGroup, "pool-b", ptr.To(2))
+	assert.Eventually(t, func() bool {
+		return getAnnotations()[costAnnotation] == "2"
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "3", getAnnotations()[otherCostAnnotation])
+
+	statusUpdater.PatchPodGroupCrossPoolReclaimCost(podGroup, "pool-b", nil)
+	assert.Eventually(t, func() bool {
+		_, found := getAnnotations()[costAnnotation]
+		return !found
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "3", getAnnotations()[otherCostAnnotation])
+}
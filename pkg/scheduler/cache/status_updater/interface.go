@@ -23,6 +23,8 @@ type Interface interface {
 	Pipelined(pod *v1.Pod, message string)
 	PatchPodLabels(pod *v1.Pod, labels map[string]interface{})
 	RecordJobStatusEvent(job *podgroup_info.PodGroupInfo) error
+	PatchQueueReclaimable(queueName, nodePool string, reclaimable v1.ResourceList)
+	PatchPodGroupCrossPoolReclaimCost(podGroup *enginev2alpha2.PodGroup, nodePool string, cost *int)
 
 	Run(stopCh <-chan struct{})
 }
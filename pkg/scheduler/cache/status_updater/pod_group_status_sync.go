@@ -89,10 +89,23 @@ func (su *defaultStatusUpdater) syncPodGroup(inFlightPodGroup, snapshotPodGroup
 		snapshotPodGroup.Annotations[commonconstants.LastStartTimeStamp] = inFlightPodGroup.Annotations[commonconstants.LastStartTimeStamp]
 	}
 
+	if inFlightScavengerQueue, found := inFlightPodGroup.Labels[commonconstants.ScavengerQueueLabelKey]; found {
+		if snapshotPodGroup.Labels == nil {
+			snapshotPodGroup.Labels = make(map[string]string)
+		}
+		snapshotPodGroup.Labels[commonconstants.ScavengerQueueLabelKey] = inFlightScavengerQueue
+	} else {
+		delete(snapshotPodGroup.Labels, commonconstants.ScavengerQueueLabelKey)
+	}
+
 	statusComparison := compareSchedulingConditions(inFlightPodGroup, snapshotPodGroup)
 
 	if statusComparison == equalStatuses || statusComparison == snapshotStatusIsOlder {
 		snapshotPodGroup.Status.SchedulingConditions = inFlightPodGroup.Status.SchedulingConditions
+		snapshotPodGroup.Status.StartTimePrediction = inFlightPodGroup.Status.StartTimePrediction
+		snapshotPodGroup.Status.RelaxedConstraints = inFlightPodGroup.Status.RelaxedConstraints
+		snapshotPodGroup.Status.Preemptions = inFlightPodGroup.Status.Preemptions
+		snapshotPodGroup.Status.PlaceableReplicas = inFlightPodGroup.Status.PlaceableReplicas
 	}
 	if statusComparison == equalStatuses && (!lastStartTimestampUpdated || !staleTimeStampUpdated) {
 		statusComparison = snapshotStatusIsOlder
@@ -8,6 +8,8 @@ import (
 	"sync"
 	"time"
 
+	"k8s.io/utils/clock"
+
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache/usagedb/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
@@ -25,9 +27,11 @@ type UsageLister struct {
 	fetchInterval      time.Duration
 	stalenessPeriod    time.Duration
 	waitTimeout        time.Duration
+	clock              clock.PassiveClock
 }
 
-func NewUsageLister(client api.Interface, fetchInterval, stalenessPeriod, waitTimeout *time.Duration) *UsageLister {
+func NewUsageLister(client api.Interface, fetchInterval, stalenessPeriod, waitTimeout *time.Duration,
+	clock clock.PassiveClock) *UsageLister {
 	if fetchInterval == nil {
 		log.InfraLogger.V(3).Infof("fetchInterval is not set, using default: %s", defaultFetchInterval)
 		fetchInterval = &defaultFetchInterval
@@ -54,6 +58,7 @@ func NewUsageLister(client api.Interface, fetchInterval, stalenessPeriod, waitTi
 		fetchInterval:   *fetchInterval,
 		stalenessPeriod: *stalenessPeriod,
 		waitTimeout:     *waitTimeout,
+		clock:           clock,
 	}
 }
 
@@ -72,8 +77,8 @@ func (l *UsageLister) GetResourceUsage() (*queue_info.ClusterUsage, error) {
 	}
 
 	var err error
-	if time.Since(*l.lastUsageDataTime) > l.stalenessPeriod {
-		err = fmt.Errorf("usage data is stale, last update: %s, staleness period: %s, time since last update: %s", l.lastUsageDataTime, l.stalenessPeriod, time.Since(*l.lastUsageDataTime))
+	if sinceUpdate := l.clock.Since(*l.lastUsageDataTime); sinceUpdate > l.stalenessPeriod {
+		err = fmt.Errorf("usage data is stale, last update: %s, staleness period: %s, time since last update: %s", l.lastUsageDataTime, l.stalenessPeriod, sinceUpdate)
 	}
 
 	return l.lastUsageData, err
@@ -136,13 +141,14 @@ func (l *UsageLister) WaitForCacheSync(stopCh <-chan struct{}) bool {
 }
 
 func (l *UsageLister) fetchAndUpdateUsage() {
-	now := time.Now()
+	queryStart := time.Now()
 	usage, err := l.client.GetResourceUsage()
 	if err != nil {
 		log.InfraLogger.V(1).Errorf("failed to fetch usage data: %v", err)
 		return
 	}
-	metrics.UpdateUsageQueryLatency(time.Since(now))
+	metrics.UpdateUsageQueryLatency(time.Since(queryStart))
+	now := l.clock.Now()
 
 	l.lastUsageDataMutex.Lock()
 	defer l.lastUsageDataMutex.Unlock()
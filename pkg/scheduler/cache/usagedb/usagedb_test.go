@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/clock"
+	clocktesting "k8s.io/utils/clock/testing"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
@@ -62,7 +64,7 @@ func TestNewUsageLister(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			lister := NewUsageLister(&fake.FakeClient{}, tt.fetchInterval, tt.stalenessPeriod, nil)
+			lister := NewUsageLister(&fake.FakeClient{}, tt.fetchInterval, tt.stalenessPeriod, nil, clock.RealClock{})
 			assert.Equal(t, tt.wantInterval, lister.fetchInterval)
 			assert.Equal(t, tt.wantStaleness, lister.stalenessPeriod)
 			assert.NotNil(t, lister.lastUsageData)
@@ -118,7 +120,7 @@ func TestGetResourceUsage(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			lister := NewUsageLister(&fake.FakeClient{}, nil, nil, nil)
+			lister := NewUsageLister(&fake.FakeClient{}, nil, nil, nil, clock.RealClock{})
 			if tt.setupLister != nil {
 				tt.setupLister(lister)
 			}
@@ -136,3 +138,16 @@ func TestGetResourceUsage(t *testing.T) {
 		})
 	}
 }
+
+func TestGetResourceUsageStalenessByClock(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	lister := NewUsageLister(&fake.FakeClient{}, nil, nil, nil, fakeClock)
+	lister.fetchAndUpdateUsage()
+
+	_, err := lister.GetResourceUsage()
+	assert.NoError(t, err)
+
+	fakeClock.Step(lister.stalenessPeriod + time.Second)
+	_, err = lister.GetResourceUsage()
+	assert.Error(t, err, "the usage data is stale by the time of the lister's clock")
+}
@@ -20,10 +20,13 @@ limitations under the License.
 package conf
 
 import (
+	"maps"
 	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/utils/clock"
 
 	usagedbapi "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache/usagedb/api"
 )
@@ -43,8 +46,30 @@ type SchedulerParams struct {
 	DetailedFitErrors                 bool                      `json:"detailedFitErrors,omitempty"`
 	UpdatePodEvictionCondition        bool                      `json:"updatePodEvictionCondition,omitempty"`
 	QueueLabelKey                     string                    `json:"queueLabelKey,omitempty"`
+	ElasticReclaimStrategy            string                    `json:"elasticReclaimStrategy,omitempty"`
+	// DryRun makes the scheduler run its cycles without acting on their decisions. The binds and evictions of the
+	// cycles are logged and exported instead of executed, and the status of jobs, pods and queues isn't updated.
+	DryRun bool `json:"dryRun,omitempty"`
+	// ReleasingHorizon limits the terminating pods that the allocate action pipelines pods on to those that are
+	// expected to release their resources within it. Zero doesn't limit them.
+	ReleasingHorizon time.Duration `json:"releasingHorizon,omitempty"`
+	// StatsEndpoint serves a JSON snapshot of the queues, node pool, actions, pending jobs and recent evictions of the
+	// last scheduling cycle on the plugin server, for dashboards.
+	StatsEndpoint bool `json:"statsEndpoint,omitempty"`
+
+	// Clock is the source of the current time of the scheduling cycles. The real clock is used when it isn't set,
+	// tests set a fake clock to control the time that actions and plugins see.
+	Clock clock.PassiveClock `json:"-"`
 }
 
+const (
+	// ElasticReclaimStrategyEvict considers elastic jobs like any other reclaim victims
+	ElasticReclaimStrategyEvict = "evict"
+	// ElasticReclaimStrategyDownscaleFirst tries to reclaim resources by downscaling elastic jobs to their
+	// minAvailable before evicting any job
+	ElasticReclaimStrategyDownscaleFirst = "downscale-first"
+)
+
 // SchedulerConfiguration defines the configuration of scheduler.
 type SchedulerConfiguration struct {
 	// Actions defines the actions list of scheduler in order
@@ -56,8 +81,142 @@ type SchedulerConfiguration struct {
 	// QueueDepthPerAction max number of jobs to try for action per queue
 	QueueDepthPerAction map[string]int `yaml:"queueDepthPerAction,omitempty" json:"queueDepthPerAction,omitempty"`
 
+	// ActionPeriods is the minimal interval between runs of an action. Actions without a period run every cycle.
+	ActionPeriods map[string]metav1.Duration `yaml:"actionPeriods,omitempty" json:"actionPeriods,omitempty"`
+
 	// UsageDBConfig defines configuration for the usage db client
 	UsageDBConfig *usagedbapi.UsageDBConfig `yaml:"usageDBConfig,omitempty" json:"usageDBConfig,omitempty"`
+
+	// GangSizeLanes splits the jobs tried by the allocate action into lanes by gang size, each with its own budget
+	// of allocation attempts per cycle
+	GangSizeLanes []GangSizeLane `yaml:"gangSizeLanes,omitempty" json:"gangSizeLanes,omitempty"`
+
+	// EvictionBudgets limits the pods and GPU hours that the preempt and reclaim actions may evict in a cycle, by
+	// action name
+	EvictionBudgets map[string]EvictionBudget `yaml:"evictionBudgets,omitempty" json:"evictionBudgets,omitempty"`
+
+	// Scavenging lets preemptible jobs of some priority classes that exceed the limit of their queue run under a
+	// cluster-wide scavenger queue
+	Scavenging *Scavenging `yaml:"scavenging,omitempty" json:"scavenging,omitempty"`
+
+	// Shadow is a second configuration that is evaluated on the snapshot of every scheduling cycle without acting on
+	// its decisions, and whose differences from the decisions of this configuration are exported as metrics
+	Shadow *ShadowConfiguration `yaml:"shadow,omitempty" json:"shadow,omitempty"`
+
+	// EventTriggers defines the cluster events that trigger a micro-cycle, which runs the allocate action for the
+	// queues affected by the events without waiting for the next scheduling cycle
+	EventTriggers *EventTriggers `yaml:"eventTriggers,omitempty" json:"eventTriggers,omitempty"`
+}
+
+// EventTriggers defines the events that trigger micro-cycles. Only events of the node pool of the scheduler trigger
+// its micro-cycles. Events that arrive while a cycle runs, or within MinInterval of the previous micro-cycle, are
+// merged into the next micro-cycle.
+type EventTriggers struct {
+	// NodeAdded triggers a micro-cycle of all queues when a node joins the node pool
+	NodeAdded bool `yaml:"nodeAdded,omitempty" json:"nodeAdded,omitempty"`
+	// MinReleasedGPUs triggers a micro-cycle of all queues once pods of the node pool that finished or were deleted
+	// have released at least this many GPUs since the last cycle. 0 disables the trigger.
+	MinReleasedGPUs float64 `yaml:"minReleasedGPUs,omitempty" json:"minReleasedGPUs,omitempty"`
+	// QuotaRaised triggers a micro-cycle of a queue and its child queues when the quota or the limit of the queue is
+	// raised
+	QuotaRaised bool `yaml:"quotaRaised,omitempty" json:"quotaRaised,omitempty"`
+	// MinInterval is the minimal interval between the start of a scheduling cycle and the following micro-cycle
+	MinInterval metav1.Duration `yaml:"minInterval,omitempty" json:"minInterval,omitempty"`
+}
+
+func (e *EventTriggers) DeepCopy() *EventTriggers {
+	out := new(EventTriggers)
+	*out = *e
+	return out
+}
+
+// ShadowConfiguration defines the actions and plugins of the shadow sessions. The other settings of the shadow
+// sessions are those of the primary configuration. Actions and tiers that aren't set are the primary's, so that a
+// shadow configuration may change just the plugins, or just the actions.
+type ShadowConfiguration struct {
+	// Name identifies the shadow configuration in the metrics of its evaluation
+	Name string `yaml:"name" json:"name"`
+	// Actions defines the actions list of the shadow sessions in order
+	Actions string `yaml:"actions,omitempty" json:"actions,omitempty"`
+	// Tiers defines the plugins of the shadow sessions in different tiers
+	Tiers []Tier `yaml:"tiers,omitempty" json:"tiers,omitempty"`
+}
+
+func (s *ShadowConfiguration) DeepCopy() *ShadowConfiguration {
+	out := new(ShadowConfiguration)
+	out.Name = s.Name
+	out.Actions = s.Actions
+	if s.Tiers != nil {
+		out.Tiers = make([]Tier, len(s.Tiers))
+		for i, tier := range s.Tiers {
+			out.Tiers[i].Plugins = make([]PluginOption, len(tier.Plugins))
+			for j, plugin := range tier.Plugins {
+				out.Tiers[i].Plugins[j] = plugin
+				out.Tiers[i].Plugins[j].Arguments = maps.Clone(plugin.Arguments)
+			}
+		}
+	}
+	return out
+}
+
+// ShadowSchedulerConfiguration returns the configuration of the shadow sessions, or nil if no shadow configuration
+// is set
+func (c *SchedulerConfiguration) ShadowSchedulerConfiguration() *SchedulerConfiguration {
+	if c.Shadow == nil {
+		return nil
+	}
+	shadowConfig := *c
+	shadowConfig.Shadow = nil
+	if c.Shadow.Actions != "" {
+		shadowConfig.Actions = c.Shadow.Actions
+	}
+	if len(c.Shadow.Tiers) > 0 {
+		shadowConfig.Tiers = c.Shadow.Tiers
+	}
+	return &shadowConfig
+}
+
+// Scavenging defines the scavenger queue that preemptible jobs exceeding the limit of their queue overflow to. The
+// scavenger queue is a regular queue, which is expected to have no deserved quota and the lowest priority, so that
+// the jobs running under it are the first to be reclaimed.
+type Scavenging struct {
+	// Queue is the name of the scavenger queue
+	Queue string `yaml:"queue" json:"queue"`
+	// PriorityClasses are the priority classes of the jobs that may overflow to the scavenger queue
+	PriorityClasses []string `yaml:"priorityClasses,omitempty" json:"priorityClasses,omitempty"`
+}
+
+func (s *Scavenging) DeepCopy() *Scavenging {
+	out := new(Scavenging)
+	out.Queue = s.Queue
+	if s.PriorityClasses != nil {
+		out.PriorityClasses = make([]string, len(s.PriorityClasses))
+		copy(out.PriorityClasses, s.PriorityClasses)
+	}
+	return out
+}
+
+// EvictionBudget defines the victims that an action may evict in a cycle. The GPU hours of a victim are its GPUs
+// multiplied by the time its job has been running, which is the work lost by evicting it. 0 means no limit.
+type EvictionBudget struct {
+	// MaxPods max number of pods evicted by the action in a cycle
+	MaxPods int `yaml:"maxPods,omitempty" json:"maxPods,omitempty"`
+	// MaxGPUHours max GPU hours of the pods evicted by the action in a cycle
+	MaxGPUHours float64 `yaml:"maxGPUHours,omitempty" json:"maxGPUHours,omitempty"`
+	// MaxPodsPerQueue max number of pods of a single queue evicted by the action in a cycle
+	MaxPodsPerQueue int `yaml:"maxPodsPerQueue,omitempty" json:"maxPodsPerQueue,omitempty"`
+	// MaxGPUHoursPerQueue max GPU hours of the pods of a single queue evicted by the action in a cycle
+	MaxGPUHoursPerQueue float64 `yaml:"maxGPUHoursPerQueue,omitempty" json:"maxGPUHoursPerQueue,omitempty"`
+}
+
+// GangSizeLane defines a lane of jobs with a gang size up to MaxGangSize
+type GangSizeLane struct {
+	// Name of the lane, used for logging
+	Name string `yaml:"name" json:"name"`
+	// MaxGangSize is the largest number of pods required by the gangs of the lane. 0 means no limit.
+	MaxGangSize int `yaml:"maxGangSize,omitempty" json:"maxGangSize,omitempty"`
+	// MaxAttemptsPerCycle max number of allocation attempts of jobs of the lane in a cycle. 0 means no limit.
+	MaxAttemptsPerCycle int `yaml:"maxAttemptsPerCycle,omitempty" json:"maxAttemptsPerCycle,omitempty"`
 }
 
 // Tier defines plugin tier
@@ -22,6 +22,7 @@ package conf_util
 import (
 	"fmt"
 	"io/ioutil"
+	"slices"
 	"strings"
 
 	"sigs.k8s.io/yaml"
@@ -122,10 +123,123 @@ func loadSchedulerConf(confStr string) (*conf.SchedulerConfiguration, error) {
 	if _, err := GetActionsFromConfig(schedulerConf); err != nil {
 		return nil, err
 	}
+	if err := validatePluginsArguments(schedulerConf); err != nil {
+		return nil, err
+	}
+	if err := validateActionPeriods(schedulerConf); err != nil {
+		return nil, err
+	}
+	if err := validateEvictionBudgets(schedulerConf); err != nil {
+		return nil, err
+	}
+	if err := validateScavenging(schedulerConf); err != nil {
+		return nil, err
+	}
+	if err := validateShadow(schedulerConf); err != nil {
+		return nil, err
+	}
+	if err := validateEventTriggers(schedulerConf); err != nil {
+		return nil, err
+	}
 
 	return schedulerConf, nil
 }
 
+func validatePluginsArguments(conf *conf.SchedulerConfiguration) error {
+	for _, tier := range conf.Tiers {
+		for _, pluginOption := range tier.Plugins {
+			if err := framework.ValidatePluginArguments(pluginOption.Name, pluginOption.Arguments); err != nil {
+				return fmt.Errorf("invalid arguments for plugin %s: %w", pluginOption.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validateActionPeriods(conf *conf.SchedulerConfiguration) error {
+	actions, err := GetActionsFromConfig(conf)
+	if err != nil {
+		return err
+	}
+	for actionName, period := range conf.ActionPeriods {
+		if !slices.ContainsFunc(actions, func(action framework.Action) bool {
+			return string(action.Name()) == actionName
+		}) {
+			return fmt.Errorf("actionPeriods configures action %s, which isn't one of the actions %s",
+				actionName, conf.Actions)
+		}
+		if period.Duration < 0 {
+			return fmt.Errorf("the period of action %s must not be negative, got %v", actionName, period.Duration)
+		}
+	}
+	return nil
+}
+
+func validateEvictionBudgets(schedulerConf *conf.SchedulerConfiguration) error {
+	for actionName, budget := range schedulerConf.EvictionBudgets {
+		if actionName != string(framework.Preempt) && actionName != string(framework.Reclaim) {
+			return fmt.Errorf("evictionBudgets configures action %s, only the %s and %s actions have eviction budgets",
+				actionName, framework.Preempt, framework.Reclaim)
+		}
+		if budget.MaxPods < 0 || budget.MaxGPUHours < 0 || budget.MaxPodsPerQueue < 0 || budget.MaxGPUHoursPerQueue < 0 {
+			return fmt.Errorf("the eviction budget of action %s must not be negative, got %+v", actionName, budget)
+		}
+	}
+	return nil
+}
+
+func validateScavenging(schedulerConf *conf.SchedulerConfiguration) error {
+	if schedulerConf.Scavenging == nil {
+		return nil
+	}
+	if schedulerConf.Scavenging.Queue == "" {
+		return fmt.Errorf("scavenging must set the scavenger queue")
+	}
+	if len(schedulerConf.Scavenging.PriorityClasses) == 0 {
+		return fmt.Errorf("scavenging must set the priority classes of the jobs that may overflow to queue %s",
+			schedulerConf.Scavenging.Queue)
+	}
+	return nil
+}
+
+func validateShadow(schedulerConf *conf.SchedulerConfiguration) error {
+	shadowConf := schedulerConf.ShadowSchedulerConfiguration()
+	if shadowConf == nil {
+		return nil
+	}
+	if schedulerConf.Shadow.Name == "" {
+		return fmt.Errorf("shadow must set the name of the shadow configuration")
+	}
+	if _, err := GetActionsFromConfig(shadowConf); err != nil {
+		return fmt.Errorf("invalid actions for shadow %s: %w", schedulerConf.Shadow.Name, err)
+	}
+	if err := validatePluginsArguments(shadowConf); err != nil {
+		return fmt.Errorf("invalid plugins for shadow %s: %w", schedulerConf.Shadow.Name, err)
+	}
+	return nil
+}
+
+func validateEventTriggers(schedulerConf *conf.SchedulerConfiguration) error {
+	triggers := schedulerConf.EventTriggers
+	if triggers == nil {
+		return nil
+	}
+	if triggers.MinReleasedGPUs < 0 || triggers.MinInterval.Duration < 0 {
+		return fmt.Errorf("eventTriggers must not be negative, got %+v", *triggers)
+	}
+	actions, err := GetActionsFromConfig(schedulerConf)
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(actions, func(action framework.Action) bool {
+		return action.Name() == framework.Allocate
+	}) {
+		return fmt.Errorf("eventTriggers trigger micro-cycles of the %s action, which isn't one of the actions %s",
+			framework.Allocate, schedulerConf.Actions)
+	}
+	return nil
+}
+
 func readSchedulerConf(confPath string) (string, error) {
 	if len(confPath) == 0 {
 		return "", nil
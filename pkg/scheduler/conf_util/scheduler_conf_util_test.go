@@ -7,7 +7,9 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions"
@@ -45,6 +47,9 @@ func TestResolveConfigurationFromFile(t *testing.T) {
 					QueueDepthPerAction: map[string]int{
 						"consolidation": 10,
 					},
+					ActionPeriods: map[string]metav1.Duration{
+						"consolidation": {Duration: 5 * time.Minute},
+					},
 				},
 			},
 			want: &conf.SchedulerConfiguration{
@@ -61,6 +66,9 @@ func TestResolveConfigurationFromFile(t *testing.T) {
 				QueueDepthPerAction: map[string]int{
 					"consolidation": 10,
 				},
+				ActionPeriods: map[string]metav1.Duration{
+					"consolidation": {Duration: 5 * time.Minute},
+				},
 			},
 			wantErr: false,
 		},
@@ -116,6 +124,267 @@ func TestResolveConfigurationFromFile(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "invalid config - unknown queue order strategy",
+			args: args{
+				config: &conf.SchedulerConfiguration{
+					Actions: "consolidation",
+					Tiers: []conf.Tier{
+						{
+							Plugins: []conf.PluginOption{
+								{
+									Name:      "proportion",
+									Arguments: map[string]string{"queueOrderStrategy": "roundRobin"},
+								},
+							},
+						},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "invalid config - period of an action that isn't configured",
+			args: args{
+				config: &conf.SchedulerConfiguration{
+					Actions: "allocate",
+					Tiers: []conf.Tier{
+						{
+							Plugins: []conf.PluginOption{
+								{
+									Name: "n1",
+								},
+							},
+						},
+					},
+					ActionPeriods: map[string]metav1.Duration{
+						"consolidation": {Duration: 5 * time.Minute},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "invalid config - eviction budget of an action without evictions",
+			args: args{
+				config: &conf.SchedulerConfiguration{
+					Actions: "allocate",
+					Tiers: []conf.Tier{
+						{
+							Plugins: []conf.PluginOption{
+								{
+									Name: "n1",
+								},
+							},
+						},
+					},
+					EvictionBudgets: map[string]conf.EvictionBudget{
+						"allocate": {MaxPods: 10},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "invalid config - eviction budget of negative limits",
+			args: args{
+				config: &conf.SchedulerConfiguration{
+					Actions: "allocate",
+					Tiers: []conf.Tier{
+						{
+							Plugins: []conf.PluginOption{
+								{
+									Name: "n1",
+								},
+							},
+						},
+					},
+					EvictionBudgets: map[string]conf.EvictionBudget{
+						"reclaim": {MaxGPUHours: -1},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "invalid config - scavenging without priority classes",
+			args: args{
+				config: &conf.SchedulerConfiguration{
+					Actions: "allocate",
+					Tiers: []conf.Tier{
+						{
+							Plugins: []conf.PluginOption{
+								{
+									Name: "n1",
+								},
+							},
+						},
+					},
+					Scavenging: &conf.Scavenging{Queue: "scavenger"},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "valid config - shadow with its own actions",
+			args: args{
+				config: &conf.SchedulerConfiguration{
+					Actions: "allocate",
+					Tiers: []conf.Tier{
+						{
+							Plugins: []conf.PluginOption{
+								{
+									Name: "n1",
+								},
+							},
+						},
+					},
+					Shadow: &conf.ShadowConfiguration{Name: "with-consolidation", Actions: "allocate, consolidation"},
+				},
+			},
+			want: &conf.SchedulerConfiguration{
+				Actions: "allocate",
+				Tiers: []conf.Tier{
+					{
+						Plugins: []conf.PluginOption{
+							{
+								Name: "n1",
+							},
+						},
+					},
+				},
+				Shadow: &conf.ShadowConfiguration{Name: "with-consolidation", Actions: "allocate, consolidation"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid config - shadow with a wrong action",
+			args: args{
+				config: &conf.SchedulerConfiguration{
+					Actions: "allocate",
+					Tiers: []conf.Tier{
+						{
+							Plugins: []conf.PluginOption{
+								{
+									Name: "n1",
+								},
+							},
+						},
+					},
+					Shadow: &conf.ShadowConfiguration{Name: "wrong-action", Actions: "action1"},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "invalid config - shadow without a name",
+			args: args{
+				config: &conf.SchedulerConfiguration{
+					Actions: "allocate",
+					Tiers: []conf.Tier{
+						{
+							Plugins: []conf.PluginOption{
+								{
+									Name: "n1",
+								},
+							},
+						},
+					},
+					Shadow: &conf.ShadowConfiguration{Actions: "allocate"},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "valid config - event triggers",
+			args: args{
+				config: &conf.SchedulerConfiguration{
+					Actions: "allocate, reclaim",
+					Tiers: []conf.Tier{
+						{
+							Plugins: []conf.PluginOption{
+								{
+									Name: "n1",
+								},
+							},
+						},
+					},
+					EventTriggers: &conf.EventTriggers{
+						NodeAdded:       true,
+						MinReleasedGPUs: 8,
+						MinInterval:     metav1.Duration{Duration: 2 * time.Second},
+					},
+				},
+			},
+			want: &conf.SchedulerConfiguration{
+				Actions: "allocate, reclaim",
+				Tiers: []conf.Tier{
+					{
+						Plugins: []conf.PluginOption{
+							{
+								Name: "n1",
+							},
+						},
+					},
+				},
+				EventTriggers: &conf.EventTriggers{
+					NodeAdded:       true,
+					MinReleasedGPUs: 8,
+					MinInterval:     metav1.Duration{Duration: 2 * time.Second},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid config - event triggers without the allocate action",
+			args: args{
+				config: &conf.SchedulerConfiguration{
+					Actions: "reclaim",
+					Tiers: []conf.Tier{
+						{
+							Plugins: []conf.PluginOption{
+								{
+									Name: "n1",
+								},
+							},
+						},
+					},
+					EventTriggers: &conf.EventTriggers{QuotaRaised: true},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "invalid config - event triggers with a negative interval",
+			args: args{
+				config: &conf.SchedulerConfiguration{
+					Actions: "allocate",
+					Tiers: []conf.Tier{
+						{
+							Plugins: []conf.PluginOption{
+								{
+									Name: "n1",
+								},
+							},
+						},
+					},
+					EventTriggers: &conf.EventTriggers{
+						NodeAdded:   true,
+						MinInterval: metav1.Duration{Duration: -time.Second},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
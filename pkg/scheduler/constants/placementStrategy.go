@@ -6,6 +6,7 @@ package constants
 const (
 	SpreadStrategy  = "spread"
 	BinpackStrategy = "binpack"
+	AutoStrategy    = "auto"
 	GPUResource     = "gpu"
 	CPUResource     = "cpu"
 )
@@ -0,0 +1,49 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// isEvictionBlockedByBudget returns whether the eviction API would refuse to evict the victim because of a pod
+// disruption budget. Only victims that are evicted through the eviction API are checked. An elastic victim is
+// blocked when none of its pods can be evicted, other victims are blocked when not all their pods can be evicted.
+func (ssn *Session) isEvictionBlockedByBudget(victim *podgroup_info.PodGroupInfo) bool {
+	if victim.EvictionMethod != enginev2.EvictionMethodEvictionAPI ||
+		ssn.ClusterInfo == nil || len(ssn.ClusterInfo.PodDisruptionBudgets) == 0 {
+		return false
+	}
+
+	for _, pdb := range ssn.ClusterInfo.PodDisruptionBudgets {
+		if pdb.Namespace != victim.Namespace {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			continue
+		}
+		var coveredPods int32
+		for _, task := range victim.GetAllPodsMap() {
+			if task.Status == pod_status.Running && task.Pod != nil && selector.Matches(labels.Set(task.Pod.Labels)) {
+				coveredPods++
+			}
+		}
+		if coveredPods == 0 {
+			continue
+		}
+		if pdb.Status.DisruptionsAllowed == 0 || (!victim.IsElastic() && coveredPods > pdb.Status.DisruptionsAllowed) {
+			log.InfraLogger.V(5).Infof("Victim <%s/%s> is evicted through the eviction API and is protected by "+
+				"pod disruption budget %s", victim.Namespace, victim.Name, pdb.Name)
+			return true
+		}
+	}
+	return false
+}
@@ -20,17 +20,23 @@ limitations under the License.
 package framework
 
 import (
+	"context"
 	"net/http"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/tracing"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
 )
 
-func OpenSession(cache cache.Cache, config *conf.SchedulerConfiguration,
-	schedulerParams *conf.SchedulerParams, sessionId string, mux *http.ServeMux) (*Session, error) {
+func OpenSession(ctx context.Context, cache cache.Cache, config *conf.SchedulerConfiguration,
+	schedulerParams *conf.SchedulerParams, sessionId string, mux *http.ServeMux, options SessionOptions,
+) (*Session, error) {
 	openSessionStart := time.Now()
 	defer metrics.UpdateOpenSessionDuration(openSessionStart)
 
@@ -38,11 +44,33 @@ func OpenSession(cache cache.Cache, config *conf.SchedulerConfiguration,
 		server = newPluginServer(mux)
 	}
 
-	ssn, err := openSession(cache, sessionId, *schedulerParams, mux)
+	ssn, err := openSession(cache, sessionId, *schedulerParams, mux, options)
+	if err != nil {
+		return nil, err
+	}
+	openPlugins(ctx, ssn, config)
+
+	return ssn, nil
+}
+
+// OpenShadowSession opens a session that evaluates the configuration on a snapshot of the cache without acting on
+// its decisions. The cache is expected to record the binds and evictions of the session instead of executing them.
+// The plugins of a shadow session don't register HTTP handlers, and the status of its jobs isn't recorded.
+func OpenShadowSession(ctx context.Context, cache cache.Cache, config *conf.SchedulerConfiguration,
+	schedulerParams *conf.SchedulerParams, sessionId string, options SessionOptions) (*Session, error) {
+	ssn, err := openSession(cache, sessionId, *schedulerParams, nil, options)
 	if err != nil {
 		return nil, err
 	}
+	ssn.shadow = true
+	openPlugins(ctx, ssn, config)
+
+	return ssn, nil
+}
+
+func openPlugins(ctx context.Context, ssn *Session, config *conf.SchedulerConfiguration) {
 	ssn.Config = config
+	ssn.ctx = ctx
 
 	for _, tier := range config.Tiers {
 		for _, pluginOption := range tier.Plugins {
@@ -56,12 +84,13 @@ func OpenSession(cache cache.Cache, config *conf.SchedulerConfiguration,
 			ssn.plugins[plugin.Name()] = plugin
 
 			onSessionOpenPluginStart := time.Now()
+			_, span := tracing.Tracer().Start(ctx, "plugin.OnSessionOpen",
+				trace.WithAttributes(attribute.String("plugin", plugin.Name())))
 			plugin.OnSessionOpen(ssn)
+			span.End()
 			metrics.UpdatePluginDuration(plugin.Name(), metrics.OnSessionOpen, metrics.Duration(onSessionOpenPluginStart))
 		}
 	}
-
-	return ssn, nil
 }
 
 func CloseSession(ssn *Session) {
@@ -0,0 +1,42 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import "sync"
+
+// PluginStates holds the state of plugins that is kept across sessions, such as the history of previous cycles.
+// Plugin instances are created for every session, so the state is owned by the scheduler and passed to every session
+// it opens.
+type PluginStates struct {
+	mutex  sync.Mutex
+	states map[string]any
+}
+
+func NewPluginStates() *PluginStates {
+	return &PluginStates{
+		states: map[string]any{},
+	}
+}
+
+// Get returns the state stored under the key, creating it with newState if it isn't stored yet
+func (ps *PluginStates) Get(key string, newState func() any) any {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	state, found := ps.states[key]
+	if !found {
+		state = newState()
+		ps.states[key] = state
+	}
+	return state
+}
+
+// SessionOptions holds what the scheduler passes to the sessions that it opens
+type SessionOptions struct {
+	// PluginStates holds the state of plugins that is kept across sessions. Sessions without it start from an empty
+	// state.
+	PluginStates *PluginStates
+	// MicroCycle is set for the sessions of micro-cycles, which run between the scheduling cycles for triggered events
+	MicroCycle bool
+}
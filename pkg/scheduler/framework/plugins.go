@@ -25,8 +25,12 @@ var pluginMutex sync.Mutex
 
 type PluginBuilder func(PluginArguments) Plugin
 
+// PluginArgumentsValidator rejects plugin arguments that the plugin can't work with
+type PluginArgumentsValidator func(PluginArguments) error
+
 // Plugin management
 var pluginBuilders = map[string]PluginBuilder{}
+var pluginArgumentsValidators = map[string]PluginArgumentsValidator{}
 
 func RegisterPluginBuilder(name string, pc func(PluginArguments) Plugin) {
 	pluginMutex.Lock()
@@ -43,6 +47,25 @@ func GetPluginBuilder(name string) (PluginBuilder, bool) {
 	return pb, found
 }
 
+func RegisterPluginArgumentsValidator(name string, validator PluginArgumentsValidator) {
+	pluginMutex.Lock()
+	defer pluginMutex.Unlock()
+
+	pluginArgumentsValidators[name] = validator
+}
+
+// ValidatePluginArguments validates the arguments of a plugin, if the plugin registered a validator for them
+func ValidatePluginArguments(name string, arguments PluginArguments) error {
+	pluginMutex.Lock()
+	validator, found := pluginArgumentsValidators[name]
+	pluginMutex.Unlock()
+
+	if !found {
+		return nil
+	}
+	return validator(arguments)
+}
+
 // Action management
 var actionMap = map[ActionType]Action{}
 
@@ -20,15 +20,21 @@ limitations under the License.
 package framework
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"sort"
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/apimachinery/pkg/types"
 	ksf "k8s.io/kube-scheduler/framework"
+	"k8s.io/utils/clock"
 
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/tracing"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
@@ -36,6 +42,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/k8s_internal"
@@ -78,12 +85,26 @@ type Session struct {
 	PredicateFns                          []api.PredicateFn
 	BindRequestMutateFns                  []api.BindRequestMutateFn
 	PreJobAllocationFns                   []api.PreJobAllocationFn
+	PostJobAllocationFns                  []api.PostJobAllocationFn
+	DeferJobBindFns                       []api.DeferJobBindFn
+	PostActionsFns                        []api.PostActionsFn
 
 	Config          *conf.SchedulerConfiguration
 	plugins         map[string]Plugin
 	eventHandlers   []*EventHandler
 	SchedulerParams conf.SchedulerParams
 	mux             *http.ServeMux
+	ctx             context.Context
+	shadow          bool
+	// queueScope holds the queues whose jobs the actions of a micro-cycle try to schedule, nil if the session
+	// schedules the jobs of all queues
+	queueScope map[common_info.QueueID]bool
+	// preemptionHistory holds the evictions for the queues with a preemption limit in the previous cycles
+	preemptionHistory *queue_info.PreemptionHistory
+	// pluginStates holds the state of plugins that is kept across the sessions of the scheduler
+	pluginStates *PluginStates
+	// microCycle is set for the sessions of micro-cycles
+	microCycle bool
 
 	k8sResourceStateCache sync.Map
 }
@@ -92,6 +113,88 @@ func (ssn *Session) Statement() *Statement {
 	return &Statement{ssn: ssn, sessionID: ssn.ID}
 }
 
+// Context returns the context of the scheduling cycle, or of the running action, carrying its trace span
+func (ssn *Session) Context() context.Context {
+	if ssn.ctx == nil {
+		return context.Background()
+	}
+	return ssn.ctx
+}
+
+// SetContext replaces the context of the session, so that spans started by an action are nested under its span
+func (ssn *Session) SetContext(ctx context.Context) {
+	ssn.ctx = ctx
+}
+
+// IsShadow returns true if the session evaluates a shadow configuration, whose decisions are recorded but never acted
+// on. Plugins must not change the cluster, or state that outlives the session, in shadow sessions.
+func (ssn *Session) IsShadow() bool {
+	return ssn.shadow
+}
+
+// PreemptionHistory returns the evictions for the queues with a preemption limit in the previous cycles of the
+// scheduler, or an empty history if the session wasn't given the history of the scheduler
+func (ssn *Session) PreemptionHistory() *queue_info.PreemptionHistory {
+	if ssn.preemptionHistory == nil {
+		ssn.preemptionHistory = queue_info.NewPreemptionHistory()
+	}
+	return ssn.preemptionHistory
+}
+
+// SetPreemptionHistory sets the evictions for the queues with a preemption limit that outlive the session
+func (ssn *Session) SetPreemptionHistory(history *queue_info.PreemptionHistory) {
+	ssn.preemptionHistory = history
+}
+
+// IsMicroCycle returns true if the session runs a micro-cycle for triggered events between the scheduling cycles.
+// Plugins that count cycles should only count the sessions that aren't micro-cycles, so that the count doesn't
+// depend on how often events are triggered.
+func (ssn *Session) IsMicroCycle() bool {
+	return ssn.microCycle
+}
+
+// PluginState returns the state of a plugin stored under the key, creating it with newState if it isn't stored yet.
+// Sessions that weren't given the plugin states of the scheduler start from an empty state.
+func (ssn *Session) PluginState(key string, newState func() any) any {
+	if ssn.pluginStates == nil {
+		ssn.pluginStates = NewPluginStates()
+	}
+	return ssn.pluginStates.Get(key, newState)
+}
+
+// SetPluginStates sets the state of plugins that is kept across sessions. It must be set before the plugins of the
+// session are opened.
+func (ssn *Session) SetPluginStates(states *PluginStates) {
+	ssn.pluginStates = states
+}
+
+// LimitToQueues limits the jobs that the actions of the session try to schedule to the jobs of the given queues and
+// of their descendant queues
+func (ssn *Session) LimitToQueues(queues []common_info.QueueID) {
+	ssn.queueScope = make(map[common_info.QueueID]bool, len(queues))
+	for _, queueID := range queues {
+		ssn.queueScope[queueID] = true
+	}
+}
+
+// IsQueueInScope returns true if the actions of the session should try to schedule the jobs of the queue
+func (ssn *Session) IsQueueInScope(queueID common_info.QueueID) bool {
+	if ssn.queueScope == nil {
+		return true
+	}
+	for range len(ssn.ClusterInfo.Queues) {
+		if ssn.queueScope[queueID] {
+			return true
+		}
+		queue, found := ssn.ClusterInfo.Queues[queueID]
+		if !found || queue.ParentQueue == "" {
+			return false
+		}
+		queueID = queue.ParentQueue
+	}
+	return false
+}
+
 func (ssn *Session) GetSessionStateForResource(uid types.UID) k8s_internal.SessionState {
 	state, _ := ssn.k8sResourceStateCache.LoadOrStore(uid, k8s_internal.NewSessionState())
 	return state.(k8s_internal.SessionState)
@@ -108,7 +211,11 @@ func (ssn *Session) GetNodes() []ksf.NodeInfo {
 }
 
 func (ssn *Session) BindPod(pod *pod_info.PodInfo) error {
+	ctx, span := ssn.startBindRequestSpan(pod)
+	defer span.End()
+
 	bindRequestAnnotations := ssn.MutateBindRequestAnnotations(pod, pod.NodeName)
+	bindRequestAnnotations = tracing.InjectToAnnotations(ctx, bindRequestAnnotations)
 	if err := ssn.Cache.Bind(pod, pod.NodeName, bindRequestAnnotations); err != nil {
 		return err
 	}
@@ -123,6 +230,22 @@ func (ssn *Session) BindPod(pod *pod_info.PodInfo) error {
 	return nil
 }
 
+// startBindRequestSpan continues the trace started for the pod group by the podgrouper, linking it to the
+// scheduling cycle span, so that grouping, scheduling and binding of a workload share a single trace.
+func (ssn *Session) startBindRequestSpan(pod *pod_info.PodInfo) (context.Context, trace.Span) {
+	parentCtx := ssn.Context()
+	if podGroup, found := ssn.ClusterInfo.PodGroupInfos[pod.Job]; found && podGroup.PodGroup != nil {
+		parentCtx = tracing.ExtractFromAnnotations(parentCtx, podGroup.PodGroup.Annotations)
+	}
+	return tracing.Tracer().Start(parentCtx, "scheduler.BindRequest",
+		trace.WithLinks(trace.LinkFromContext(ssn.Context())),
+		trace.WithAttributes(
+			attribute.String("pod", pod.Namespace+"/"+pod.Name),
+			attribute.String("node", pod.NodeName),
+			attribute.String("session", ssn.ID),
+		))
+}
+
 func (ssn *Session) Evict(pod *pod_info.PodInfo, message string, evictionMetadata eviction_info.EvictionMetadata) error {
 	podGroup, found := ssn.ClusterInfo.PodGroupInfos[pod.Job]
 	if !found {
@@ -201,6 +324,7 @@ func (ssn *Session) FittingNode(task *pod_info.PodInfo, node *node_info.NodeInfo
 	var fitErrors *common_info.TasksFitErrors
 	if writeFittingDelta {
 		fitErrors = common_info.NewFitErrors()
+		fitErrors.SetNumNodes(len(ssn.ClusterInfo.Nodes))
 	}
 
 	job := ssn.ClusterInfo.PodGroupInfos[task.Job]
@@ -337,7 +461,8 @@ func (ssn *Session) clear() {
 	ssn.JobOrderFns = nil
 }
 
-func openSession(cache cache.Cache, sessionId string, schedulerParams conf.SchedulerParams, mux *http.ServeMux) (*Session, error) {
+func openSession(cache cache.Cache, sessionId string, schedulerParams conf.SchedulerParams, mux *http.ServeMux,
+	options SessionOptions) (*Session, error) {
 	ssn := &Session{
 		ID:    sessionId,
 		Cache: cache,
@@ -347,6 +472,8 @@ func openSession(cache cache.Cache, sessionId string, schedulerParams conf.Sched
 		plugins:               map[string]Plugin{},
 		SchedulerParams:       schedulerParams,
 		mux:                   mux,
+		pluginStates:          options.PluginStates,
+		microCycle:            options.MicroCycle,
 		k8sResourceStateCache: sync.Map{},
 	}
 
@@ -368,6 +495,11 @@ func closeSession(ssn *Session) {
 	log.InfraLogger.V(6).Infof("Close Session %v with <%d> Jobs and <%d> Queues",
 		ssn.ID, len(ssn.ClusterInfo.PodGroupInfos), len(ssn.ClusterInfo.Queues))
 
+	if ssn.shadow {
+		ssn.clear()
+		return
+	}
+
 	// Push all jobs for status update into the channel
 	for _, job := range ssn.ClusterInfo.PodGroupInfos {
 		if err := ssn.Cache.RecordJobStatusEvent(job); err != nil {
@@ -394,6 +526,15 @@ func (ssn *Session) UseSchedulingSignatures() bool {
 	return ssn.SchedulerParams.UseSchedulingSignatures
 }
 
+// Clock returns the source of the current time of the session, which actions and plugins should use instead of
+// time.Now so that tests can control it
+func (ssn *Session) Clock() clock.PassiveClock {
+	if ssn.SchedulerParams.Clock == nil {
+		return clock.RealClock{}
+	}
+	return ssn.SchedulerParams.Clock
+}
+
 func (ssn *Session) GetJobsDepth(action ActionType) int {
 	maxJobs, foundForAction := ssn.Config.QueueDepthPerAction[string(action)]
 	if !foundForAction {
@@ -402,6 +543,22 @@ func (ssn *Session) GetJobsDepth(action ActionType) int {
 	return maxJobs
 }
 
+// SchedulingFreezeOf returns a scheduling freeze that pauses the action in the session, or nil if the action isn't
+// paused. Allocate is paused by every freeze, and the actions that evict pods only by freezes that include preemption.
+func (ssn *Session) SchedulingFreezeOf(action ActionType) *kaiv1alpha1.SchedulingFreeze {
+	for _, freeze := range ssn.ClusterInfo.SchedulingFreezes {
+		switch action {
+		case Allocate:
+			return freeze
+		case Consolidation, Reclaim, Preempt:
+			if freeze.Spec.IncludePreemption {
+				return freeze
+			}
+		}
+	}
+	return nil
+}
+
 func (ssn *Session) CountLeafQueues() int {
 	cnt := 0
 	for _, queue := range ssn.ClusterInfo.Queues {
@@ -427,6 +584,15 @@ func (ssn *Session) AllowConsolidatingReclaim() bool {
 	return ssn.SchedulerParams.AllowConsolidatingReclaim
 }
 
+func (ssn *Session) ReleasingHorizon() time.Duration {
+	return ssn.SchedulerParams.ReleasingHorizon
+}
+
+// DownscaleElasticVictimsFirst returns whether reclaim downscales elastic jobs before evicting any job
+func (ssn *Session) DownscaleElasticVictimsFirst() bool {
+	return ssn.SchedulerParams.ElasticReclaimStrategy == conf.ElasticReclaimStrategyDownscaleFirst
+}
+
 func (ssn *Session) GetGlobalDefaultStalenessGracePeriod() time.Duration {
 	return ssn.SchedulerParams.GlobalDefaultStalenessGracePeriod
 }
@@ -441,6 +607,11 @@ func (ssn *Session) OverrideAllowConsolidatingReclaim(allowConsolidatingReclaim
 	ssn.SchedulerParams.AllowConsolidatingReclaim = allowConsolidatingReclaim
 }
 
+// OverrideElasticReclaimStrategy overrides the strategy returned by DownscaleElasticVictimsFirst. Use for testing purposes.
+func (ssn *Session) OverrideElasticReclaimStrategy(strategy string) {
+	ssn.SchedulerParams.ElasticReclaimStrategy = strategy
+}
+
 func (ssn *Session) GetSchedulerName() string {
 	return ssn.SchedulerParams.SchedulerName
 }
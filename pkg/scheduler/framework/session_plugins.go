@@ -114,6 +114,18 @@ func (ssn *Session) AddPreJobAllocationFn(fn api.PreJobAllocationFn) {
 	ssn.PreJobAllocationFns = append(ssn.PreJobAllocationFns, fn)
 }
 
+func (ssn *Session) AddPostJobAllocationFn(fn api.PostJobAllocationFn) {
+	ssn.PostJobAllocationFns = append(ssn.PostJobAllocationFns, fn)
+}
+
+func (ssn *Session) AddDeferJobBindFn(fn api.DeferJobBindFn) {
+	ssn.DeferJobBindFns = append(ssn.DeferJobBindFns, fn)
+}
+
+func (ssn *Session) AddPostActionsFn(fn api.PostActionsFn) {
+	ssn.PostActionsFns = append(ssn.PostActionsFns, fn)
+}
+
 func (ssn *Session) CanReclaimResources(reclaimer *podgroup_info.PodGroupInfo) bool {
 	for _, canReclaimFn := range ssn.CanReclaimResourcesFns {
 		return canReclaimFn(reclaimer)
@@ -123,6 +135,9 @@ func (ssn *Session) CanReclaimResources(reclaimer *podgroup_info.PodGroupInfo) b
 }
 
 func (ssn *Session) ReclaimVictimFilter(reclaimer *podgroup_info.PodGroupInfo, victim *podgroup_info.PodGroupInfo) bool {
+	if ssn.isEvictionBlockedByBudget(victim) {
+		return false
+	}
 	for _, rf := range ssn.ReclaimVictimFilterFns {
 		if !rf(reclaimer, victim) {
 			return false
@@ -143,6 +158,9 @@ func (ssn *Session) ReclaimScenarioValidatorFn(scenario api.ScenarioInfo) bool {
 }
 
 func (ssn *Session) PreemptVictimFilter(preemptor *podgroup_info.PodGroupInfo, victim *podgroup_info.PodGroupInfo) bool {
+	if ssn.isEvictionBlockedByBudget(victim) {
+		return false
+	}
 	for _, pf := range ssn.PreemptVictimFilterFns {
 		if !pf(preemptor, victim) {
 			return false
@@ -165,7 +183,7 @@ func (ssn *Session) PreemptScenarioValidator(
 }
 
 func (ssn *Session) AddHttpHandler(path string, handler func(http.ResponseWriter, *http.Request)) {
-	if server == nil {
+	if server == nil || ssn.shadow {
 		return
 	}
 	err := server.registerPlugin(path, handler)
@@ -453,3 +471,27 @@ func (ssn *Session) PreJobAllocation(job *podgroup_info.PodGroupInfo) {
 		preJobAllocationFn(job)
 	}
 }
+
+func (ssn *Session) PostJobAllocation(job *podgroup_info.PodGroupInfo) {
+	for _, postJobAllocationFn := range ssn.PostJobAllocationFns {
+		postJobAllocationFn(job)
+	}
+}
+
+// ShouldDeferJobBind returns true if any plugin defers the bind of the allocated job. Every plugin is called, so that
+// each of them can prepare the job's nodes.
+func (ssn *Session) ShouldDeferJobBind(job *podgroup_info.PodGroupInfo) bool {
+	deferBind := false
+	for _, deferJobBindFn := range ssn.DeferJobBindFns {
+		if deferJobBindFn(job) {
+			deferBind = true
+		}
+	}
+	return deferBind
+}
+
+func (ssn *Session) PostActions() {
+	for _, postActionsFn := range ssn.PostActionsFns {
+		postActionsFn()
+	}
+}
@@ -6,10 +6,17 @@ SPDX-License-Identifier: Apache-2.0
 package framework
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
@@ -91,6 +98,31 @@ func TestMutateBindRequestAnnotations(t *testing.T) {
 	}
 }
 
+func TestShouldDeferJobBind(t *testing.T) {
+	calls := 0
+	deferBind := func(*podgroup_info.PodGroupInfo) bool { calls++; return true }
+	bind := func(*podgroup_info.PodGroupInfo) bool { calls++; return false }
+
+	tests := []struct {
+		name          string
+		deferFns      []api.DeferJobBindFn
+		expectedDefer bool
+	}{
+		{name: "no defer functions", expectedDefer: false},
+		{name: "no function defers", deferFns: []api.DeferJobBindFn{bind, bind}, expectedDefer: false},
+		{name: "one function defers", deferFns: []api.DeferJobBindFn{deferBind, bind}, expectedDefer: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = 0
+			ssn := &Session{DeferJobBindFns: tt.deferFns}
+			assert.Equal(t, tt.expectedDefer, ssn.ShouldDeferJobBind(podgroup_info.NewPodGroupInfo("job")))
+			assert.Equal(t, len(tt.deferFns), calls, "every defer function is called")
+		})
+	}
+}
+
 func TestPartitionMultiImplementation(t *testing.T) {
 	nodes := []*node_info.NodeInfo{
 		{
@@ -162,3 +194,88 @@ func TestPartitionMultiImplementation(t *testing.T) {
 	assert.Equal(t, partitions[3][0].Name, "cluster1rack1-1")
 	assert.Equal(t, partitions[3][1].Name, "cluster1rack1-2")
 }
+
+func TestPreemptVictimFilterEvictionBudget(t *testing.T) {
+	newVictim := func(method enginev2.EvictionMethod, podLabels map[string]string, pods int) *podgroup_info.PodGroupInfo {
+		var tasks []*pod_info.PodInfo
+		for i := 0; i < pods; i++ {
+			tasks = append(tasks, pod_info.NewTaskInfo(&v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: fmt.Sprintf("pod-%d", i), Namespace: "ns", UID: types.UID(fmt.Sprintf("pod-%d", i)),
+					Labels: podLabels,
+				},
+				Spec:   v1.PodSpec{NodeName: "node"},
+				Status: v1.PodStatus{Phase: v1.PodRunning},
+			}))
+		}
+		victim := podgroup_info.NewPodGroupInfo("victim", tasks...)
+		victim.Namespace = "ns"
+		victim.EvictionMethod = method
+		return victim
+	}
+	newBudget := func(disruptionsAllowed int32) *policyv1.PodDisruptionBudget {
+		return &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: "pdb", Namespace: "ns"},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+			},
+			Status: policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: disruptionsAllowed},
+		}
+	}
+	covered := map[string]string{"app": "db"}
+
+	tests := []struct {
+		name            string
+		victim          *podgroup_info.PodGroupInfo
+		budget          *policyv1.PodDisruptionBudget
+		expectedAllowed bool
+	}{
+		{
+			name:            "deleted victim is not checked",
+			victim:          newVictim(enginev2.EvictionMethodDelete, covered, 1),
+			budget:          newBudget(0),
+			expectedAllowed: true,
+		},
+		{
+			name:            "eviction API victim protected by a budget",
+			victim:          newVictim(enginev2.EvictionMethodEvictionAPI, covered, 1),
+			budget:          newBudget(0),
+			expectedAllowed: false,
+		},
+		{
+			name:            "eviction API victim allowed by its budget",
+			victim:          newVictim(enginev2.EvictionMethodEvictionAPI, covered, 1),
+			budget:          newBudget(1),
+			expectedAllowed: true,
+		},
+		{
+			name:            "eviction API victim not selected by the budget",
+			victim:          newVictim(enginev2.EvictionMethodEvictionAPI, map[string]string{"app": "web"}, 1),
+			budget:          newBudget(0),
+			expectedAllowed: true,
+		},
+		{
+			name:            "elastic eviction API victim can spare some pods",
+			victim:          newVictim(enginev2.EvictionMethodEvictionAPI, covered, 2),
+			budget:          newBudget(1),
+			expectedAllowed: true,
+		},
+		{
+			name:            "elastic eviction API victim can't spare any pod",
+			victim:          newVictim(enginev2.EvictionMethodEvictionAPI, covered, 2),
+			budget:          newBudget(0),
+			expectedAllowed: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssn := &Session{ClusterInfo: &api.ClusterInfo{
+				PodDisruptionBudgets: []*policyv1.PodDisruptionBudget{tt.budget},
+			}}
+			preemptor := podgroup_info.NewPodGroupInfo("preemptor")
+			assert.Equal(t, tt.expectedAllowed, ssn.PreemptVictimFilter(preemptor, tt.victim))
+			assert.Equal(t, tt.expectedAllowed, ssn.ReclaimVictimFilter(preemptor, tt.victim))
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+)
+
+func TestSchedulingFreezeOf(t *testing.T) {
+	tests := []struct {
+		name             string
+		freezes          []*kaiv1alpha1.SchedulingFreeze
+		expectedFrozenBy map[ActionType]string
+	}{
+		{
+			name:             "no freeze",
+			expectedFrozenBy: map[ActionType]string{},
+		},
+		{
+			name: "allocation freeze",
+			freezes: []*kaiv1alpha1.SchedulingFreeze{
+				{ObjectMeta: metav1.ObjectMeta{Name: "allocation"}},
+			},
+			expectedFrozenBy: map[ActionType]string{Allocate: "allocation"},
+		},
+		{
+			name: "preemption freeze",
+			freezes: []*kaiv1alpha1.SchedulingFreeze{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "preemption"},
+					Spec:       kaiv1alpha1.SchedulingFreezeSpec{IncludePreemption: true},
+				},
+			},
+			expectedFrozenBy: map[ActionType]string{
+				Allocate:      "preemption",
+				Consolidation: "preemption",
+				Reclaim:       "preemption",
+				Preempt:       "preemption",
+			},
+		},
+		{
+			name: "allocation and preemption freezes",
+			freezes: []*kaiv1alpha1.SchedulingFreeze{
+				{ObjectMeta: metav1.ObjectMeta{Name: "allocation"}},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "preemption"},
+					Spec:       kaiv1alpha1.SchedulingFreezeSpec{IncludePreemption: true},
+				},
+			},
+			expectedFrozenBy: map[ActionType]string{
+				Allocate:      "allocation",
+				Consolidation: "preemption",
+				Reclaim:       "preemption",
+				Preempt:       "preemption",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ssn := &Session{ClusterInfo: &api.ClusterInfo{SchedulingFreezes: tt.freezes}}
+			for _, action := range []ActionType{Allocate, Consolidation, Reclaim, Preempt, StaleGangEviction} {
+				freeze := ssn.SchedulingFreezeOf(action)
+				expectedFreeze, frozen := tt.expectedFrozenBy[action]
+				if !frozen {
+					assert.Nil(t, freeze, "action %s", action)
+					continue
+				}
+				if assert.NotNil(t, freeze, "action %s", action) {
+					assert.Equal(t, expectedFreeze, freeze.Name, "action %s", action)
+				}
+			}
+		})
+	}
+}
+
+func TestClock(t *testing.T) {
+	ssn := &Session{}
+	assert.Equal(t, clock.RealClock{}, ssn.Clock())
+
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	ssn.SchedulerParams.Clock = fakeClock
+	fakeClock.SetTime(fakeClock.Now().Add(time.Hour))
+	assert.Equal(t, time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC), ssn.Clock().Now())
+	assert.Equal(t, time.Duration(0), ssn.Clock().Since(fakeClock.Now()))
+}
+
+func TestOpenShadowSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	// The mock fails the test if the shadow session records the status of its jobs
+	cacheMock := cache.NewMockCache(ctrl)
+	cacheMock.EXPECT().Snapshot().Return(&api.ClusterInfo{
+		PodGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{
+			"job-1": podgroup_info.NewPodGroupInfo("job-1"),
+		},
+	}, nil)
+
+	previousServer := server
+	server = newPluginServer(http.NewServeMux())
+	defer func() { server = previousServer }()
+
+	ssn, err := OpenShadowSession(context.Background(), cacheMock, &conf.SchedulerConfiguration{},
+		&conf.SchedulerParams{}, "shadow", SessionOptions{})
+	assert.NoError(t, err)
+	assert.True(t, ssn.IsShadow())
+
+	ssn.AddHttpHandler("/shadow", func(http.ResponseWriter, *http.Request) {})
+	assert.NotContains(t, server.registeredPlugins, "/shadow")
+
+	CloseSession(ssn)
+}
+
+func TestPluginState(t *testing.T) {
+	newCounter := func() any { return new(int) }
+
+	states := NewPluginStates()
+	first := &Session{pluginStates: states}
+	*first.PluginState("counter", newCounter).(*int) = 1
+	second := &Session{pluginStates: states}
+	assert.Equal(t, 1, *second.PluginState("counter", newCounter).(*int),
+		"sessions of the same scheduler share the plugin state")
+	assert.Equal(t, 0, *second.PluginState("other", newCounter).(*int), "plugin states are kept by key")
+
+	withoutStates := &Session{}
+	assert.Equal(t, 0, *withoutStates.PluginState("counter", newCounter).(*int),
+		"a session without the scheduler's plugin states starts from an empty state")
+}
+
+func TestIsQueueInScope(t *testing.T) {
+	ssn := &Session{ClusterInfo: &api.ClusterInfo{
+		Queues: map[common_info.QueueID]*queue_info.QueueInfo{
+			"department-a": {UID: "department-a"},
+			"team-a":       {UID: "team-a", ParentQueue: "department-a"},
+			"department-b": {UID: "department-b"},
+			"team-b":       {UID: "team-b", ParentQueue: "department-b"},
+		},
+	}}
+	assert.True(t, ssn.IsQueueInScope("team-b"), "all queues are in the scope of a session that isn't limited")
+
+	ssn.LimitToQueues([]common_info.QueueID{"department-a"})
+	assert.True(t, ssn.IsQueueInScope("department-a"))
+	assert.True(t, ssn.IsQueueInScope("team-a"))
+	assert.False(t, ssn.IsQueueInScope("department-b"))
+	assert.False(t, ssn.IsQueueInScope("team-b"))
+	assert.False(t, ssn.IsQueueInScope("unknown"))
+}
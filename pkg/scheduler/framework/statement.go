@@ -138,6 +138,9 @@ func (s *Statement) commitEvict(reclaimee *pod_info.PodInfo, evictOp evictOperat
 		return err
 	}
 	reclaimee.IsVirtualStatus = false
+	if evictOp.evictionMetadata.Action == string(Preempt) || evictOp.evictionMetadata.Action == string(Reclaim) {
+		reclaimeePodGroup.RecordPreemption(s.ssn.Clock().Now())
+	}
 
 	return nil
 }
@@ -498,6 +501,17 @@ func (s *Statement) ConvertAllAllocatedToPipelined(jobID common_info.PodGroupID)
 	return nil
 }
 
+// EvictedTasks returns the tasks evicted by the statement that weren't undone
+func (s *Statement) EvictedTasks() []*pod_info.PodInfo {
+	var tasks []*pod_info.PodInfo
+	for i, op := range s.operations {
+		if op.Name() == evict && s.operationValid(i) {
+			tasks = append(tasks, op.TaskInfo())
+		}
+	}
+	return tasks
+}
+
 func (s *Statement) clearOperations() {
 	s.operations = []Operation{}
 }
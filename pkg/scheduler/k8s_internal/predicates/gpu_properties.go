@@ -0,0 +1,96 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package predicates
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	ksf "k8s.io/kube-scheduler/framework"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+const mibInBytes = 1024 * 1024
+
+// GpuPropertiesPredicate filters nodes by the GPU properties published in GPU feature discovery labels,
+// according to the minimal GPU memory and compute capability annotations of the pod.
+type GpuPropertiesPredicate struct{}
+
+func NewGpuPropertiesPredicate() *GpuPropertiesPredicate {
+	return &GpuPropertiesPredicate{}
+}
+
+func (_ *GpuPropertiesPredicate) isPreFilterRequired(_ *v1.Pod) bool {
+	return false
+}
+
+func (_ *GpuPropertiesPredicate) isFilterRequired(pod *v1.Pod) bool {
+	_, minMemoryFound := pod.Annotations[constants.MinGpuMemory]
+	_, minComputeCapabilityFound := pod.Annotations[constants.MinGpuComputeCapability]
+	return minMemoryFound || minComputeCapabilityFound
+}
+
+func (_ *GpuPropertiesPredicate) Filter(_ context.Context, _ ksf.CycleState, pod *v1.Pod, nodeInfo ksf.NodeInfo) *ksf.Status {
+	nodeLabels := nodeInfo.Node().Labels
+
+	if value, found := pod.Annotations[constants.MinGpuMemory]; found {
+		minMemory, err := resource.ParseQuantity(value)
+		if err != nil {
+			return ksf.NewStatus(ksf.UnschedulableAndUnresolvable,
+				fmt.Sprintf("invalid %s annotation %q: %v", constants.MinGpuMemory, value, err))
+		}
+		nodeGpuMemoryMib, err := strconv.ParseInt(nodeLabels[constants.NvidiaGpuMemory], 10, 64)
+		if err != nil {
+			return ksf.NewStatus(ksf.Unschedulable,
+				fmt.Sprintf("node GPU memory is unknown, %s label is missing or invalid", constants.NvidiaGpuMemory))
+		}
+		if nodeGpuMemoryMib*mibInBytes < minMemory.Value() {
+			return ksf.NewStatus(ksf.Unschedulable,
+				fmt.Sprintf("node GPU memory %dMi is less than the required %s", nodeGpuMemoryMib, value))
+		}
+	}
+
+	if value, found := pod.Annotations[constants.MinGpuComputeCapability]; found {
+		minMajor, minMinor, err := parseComputeCapability(value)
+		if err != nil {
+			return ksf.NewStatus(ksf.UnschedulableAndUnresolvable,
+				fmt.Sprintf("invalid %s annotation %q: %v", constants.MinGpuComputeCapability, value, err))
+		}
+		nodeMajor, majorErr := strconv.Atoi(nodeLabels[constants.GpuComputeMajorLabel])
+		nodeMinor, minorErr := strconv.Atoi(nodeLabels[constants.GpuComputeMinorLabel])
+		if majorErr != nil || minorErr != nil {
+			return ksf.NewStatus(ksf.Unschedulable,
+				fmt.Sprintf("node GPU compute capability is unknown, %s and %s labels are missing or invalid",
+					constants.GpuComputeMajorLabel, constants.GpuComputeMinorLabel))
+		}
+		if nodeMajor < minMajor || (nodeMajor == minMajor && nodeMinor < minMinor) {
+			return ksf.NewStatus(ksf.Unschedulable,
+				fmt.Sprintf("node GPU compute capability %d.%d is less than the required %s", nodeMajor, nodeMinor, value))
+		}
+	}
+
+	return nil
+}
+
+// parseComputeCapability parses a "<major>.<minor>" compute capability, the minor version defaults to 0
+func parseComputeCapability(value string) (int, int, error) {
+	majorStr, minorStr, hasMinor := strings.Cut(strings.TrimSpace(value), ".")
+	major, err := strconv.Atoi(majorStr)
+	if err != nil || major < 0 {
+		return 0, 0, fmt.Errorf("expected <major>.<minor>")
+	}
+	if !hasMinor {
+		return major, 0, nil
+	}
+	minor, err := strconv.Atoi(minorStr)
+	if err != nil || minor < 0 {
+		return 0, 0, fmt.Errorf("expected <major>.<minor>")
+	}
+	return major, minor, nil
+}
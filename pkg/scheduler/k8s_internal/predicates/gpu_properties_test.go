@@ -0,0 +1,131 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package predicates
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ksf "k8s.io/kube-scheduler/framework"
+	k8sframework "k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+func TestGpuPropertiesPredicate(t *testing.T) {
+	a100Labels := map[string]string{
+		constants.NvidiaGpuMemory:      "81920",
+		constants.GpuComputeMajorLabel: "8",
+		constants.GpuComputeMinorLabel: "0",
+	}
+	t4Labels := map[string]string{
+		constants.NvidiaGpuMemory:      "15360",
+		constants.GpuComputeMajorLabel: "7",
+		constants.GpuComputeMinorLabel: "5",
+	}
+
+	tests := []struct {
+		name               string
+		podAnnotations     map[string]string
+		nodeLabels         map[string]string
+		expectedRequired   bool
+		expectedStatusCode ksf.Code
+	}{
+		{
+			name:               "no annotations",
+			nodeLabels:         t4Labels,
+			expectedRequired:   false,
+			expectedStatusCode: ksf.Success,
+		},
+		{
+			name:               "enough gpu memory",
+			podAnnotations:     map[string]string{constants.MinGpuMemory: "40Gi"},
+			nodeLabels:         a100Labels,
+			expectedRequired:   true,
+			expectedStatusCode: ksf.Success,
+		},
+		{
+			name:               "not enough gpu memory",
+			podAnnotations:     map[string]string{constants.MinGpuMemory: "40Gi"},
+			nodeLabels:         t4Labels,
+			expectedRequired:   true,
+			expectedStatusCode: ksf.Unschedulable,
+		},
+		{
+			name:               "missing gpu memory label",
+			podAnnotations:     map[string]string{constants.MinGpuMemory: "1Gi"},
+			nodeLabels:         map[string]string{},
+			expectedRequired:   true,
+			expectedStatusCode: ksf.Unschedulable,
+		},
+		{
+			name:               "invalid gpu memory annotation",
+			podAnnotations:     map[string]string{constants.MinGpuMemory: "lots"},
+			nodeLabels:         a100Labels,
+			expectedRequired:   true,
+			expectedStatusCode: ksf.UnschedulableAndUnresolvable,
+		},
+		{
+			name:               "compute capability satisfied",
+			podAnnotations:     map[string]string{constants.MinGpuComputeCapability: "8.0"},
+			nodeLabels:         a100Labels,
+			expectedRequired:   true,
+			expectedStatusCode: ksf.Success,
+		},
+		{
+			name:               "compute capability major only",
+			podAnnotations:     map[string]string{constants.MinGpuComputeCapability: "7"},
+			nodeLabels:         t4Labels,
+			expectedRequired:   true,
+			expectedStatusCode: ksf.Success,
+		},
+		{
+			name:               "compute capability too low",
+			podAnnotations:     map[string]string{constants.MinGpuComputeCapability: "8.0"},
+			nodeLabels:         t4Labels,
+			expectedRequired:   true,
+			expectedStatusCode: ksf.Unschedulable,
+		},
+		{
+			name:               "compute capability minor too low",
+			podAnnotations:     map[string]string{constants.MinGpuComputeCapability: "7.6"},
+			nodeLabels:         t4Labels,
+			expectedRequired:   true,
+			expectedStatusCode: ksf.Unschedulable,
+		},
+		{
+			name:               "invalid compute capability annotation",
+			podAnnotations:     map[string]string{constants.MinGpuComputeCapability: "ampere"},
+			nodeLabels:         a100Labels,
+			expectedRequired:   true,
+			expectedStatusCode: ksf.UnschedulableAndUnresolvable,
+		},
+		{
+			name: "both annotations",
+			podAnnotations: map[string]string{
+				constants.MinGpuMemory:            "16Gi",
+				constants.MinGpuComputeCapability: "7.5",
+			},
+			nodeLabels:         t4Labels,
+			expectedRequired:   true,
+			expectedStatusCode: ksf.Unschedulable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Annotations: tt.podAnnotations}}
+			nodeInfo := k8sframework.NewNodeInfo()
+			nodeInfo.SetNode(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node", Labels: tt.nodeLabels}})
+
+			predicate := NewGpuPropertiesPredicate()
+			assert.Equal(t, tt.expectedRequired, predicate.isFilterRequired(pod))
+			status := predicate.Filter(context.Background(), nil, pod, nodeInfo)
+			assert.Equal(t, tt.expectedStatusCode, status.Code())
+		})
+	}
+}
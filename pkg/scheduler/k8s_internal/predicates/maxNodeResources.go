@@ -61,6 +61,10 @@ func (mnr *MaxNodeResourcesPredicate) PreFilter(_ context.Context, _ ksf.CycleSt
 
 	draPodClaims := resource_info.GetDraPodClaims(pod, mnr.resourceClaimsMap, mnr.podsToClaimsMap)
 	podInfo := pod_info.NewTaskInfo(pod, draPodClaims...)
+	if podInfo.GpuCountRange != nil {
+		// A pod requesting a range of GPUs only has to fit in a node with its minimal number of GPUs
+		podInfo.SetGpuCount(podInfo.GpuCountRange.Min)
+	}
 
 	podGpuResources := podInfo.ResReq.GPUs() + float64(podInfo.ResReq.GetDraGpusCount())
 	if podGpuResources > mnr.maxResources.GPUs() {
@@ -11,7 +11,6 @@ import (
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/interpodaffinity"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/nodeaffinity"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/nodeports"
-	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/tainttoleration"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/volumebinding"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
@@ -29,6 +28,7 @@ const (
 	NodeScheduler          = "NodeScheduler"
 	MaxNodePoolResources   = "MaxNodePoolResources"
 	ConfigMap              = "ConfigMap"
+	GpuProperties          = "GpuProperties"
 )
 
 func predicateRequired(_ *v1.Pod) bool {
@@ -91,7 +91,7 @@ func NewSessionPredicates(ssn *framework.Session) k8s_internal.SessionPredicates
 			IsPreFilterRequired: predicateNotRequired,
 			PreFilter:           nil,
 			IsFilterRequired:    predicateRequired,
-			Filter:              k8s_internal.FitPredicateConverter(ssn, plugin.(*tainttoleration.TaintToleration)),
+			Filter:              NewTaintTolerationFilter(ssn, plugin),
 		}
 	}
 
@@ -161,6 +161,15 @@ func NewSessionPredicates(ssn *framework.Session) k8s_internal.SessionPredicates
 		Filter:              nil,
 	}
 
+	gpuPropertiesPredicate := NewGpuPropertiesPredicate()
+	predicates[GpuProperties] = k8s_internal.SessionPredicate{
+		Name:                GpuProperties,
+		IsPreFilterRequired: gpuPropertiesPredicate.isPreFilterRequired,
+		PreFilter:           nil,
+		IsFilterRequired:    gpuPropertiesPredicate.isFilterRequired,
+		Filter:              k8s_internal.FitPredicateConverter(ssn, gpuPropertiesPredicate),
+	}
+
 	return predicates
 }
 
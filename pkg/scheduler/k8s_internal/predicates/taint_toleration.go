@@ -0,0 +1,39 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package predicates
+
+import (
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	k8sframework "k8s.io/kubernetes/pkg/scheduler/framework"
+	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/tainttoleration"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/k8s_internal"
+)
+
+// NewTaintTolerationFilter returns a function that wraps k8s internal taint toleration filter, while ignoring the
+// taints that the scheduler sets on nodes dedicated to a pod group. These taints keep the pods of other schedulers off
+// the nodes, and the pods of the scheduler are kept off them by the dedicatednodes plugin.
+func NewTaintTolerationFilter(ssn *framework.Session, plugin k8sframework.Plugin) k8s_internal.FitPredicateFilter {
+	filterFunc := k8s_internal.FitPredicateConverter(ssn, plugin.(*tainttoleration.TaintToleration))
+	return func(pod *v1.Pod, nodeInfo *k8sframework.NodeInfo) (bool, []string, error) {
+		node := nodeInfo.Node()
+		if node == nil || !slices.ContainsFunc(node.Spec.Taints, isDedicatedNodeTaint) {
+			return filterFunc(pod, nodeInfo)
+		}
+
+		undedicatedNode := *node
+		undedicatedNode.Spec.Taints = slices.DeleteFunc(slices.Clone(node.Spec.Taints), isDedicatedNodeTaint)
+		nodeInfo.SetNode(&undedicatedNode)
+		defer nodeInfo.SetNode(node)
+		return filterFunc(pod, nodeInfo)
+	}
+}
+
+func isDedicatedNodeTaint(taint v1.Taint) bool {
+	return taint.Key == constants.DedicatedNodeTaintKey
+}
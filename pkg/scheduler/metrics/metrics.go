@@ -20,6 +20,7 @@ limitations under the License.
 package metrics
 
 import (
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -34,6 +35,10 @@ const (
 	OnSessionClose = "OnSessionClose"
 )
 
+// suspended mutes the updates of the scheduling metrics while shadow sessions run, so that the metrics only report
+// the primary configuration. The metrics updated by the cache, and the shadow evaluation metrics, are never muted.
+var suspended atomic.Bool
+
 var (
 	currentAction               string
 	e2eSchedulingLatency        prometheus.Gauge
@@ -54,8 +59,30 @@ var (
 	queueCPUUsage               *prometheus.GaugeVec
 	queueMemoryUsage            *prometheus.GaugeVec
 	queueGPUUsage               *prometheus.GaugeVec
+	queueReclaimableCPU         *prometheus.GaugeVec
+	queueReclaimableMemory      *prometheus.GaugeVec
+	queueReclaimableGPU         *prometheus.GaugeVec
+	maintenanceCapacityCPU      prometheus.Gauge
+	maintenanceCapacityMemory   prometheus.Gauge
+	maintenanceCapacityGPU      prometheus.Gauge
+	maintenanceUsageCPU         prometheus.Gauge
+	maintenanceUsageMemory      prometheus.Gauge
+	maintenanceUsageGPU         prometheus.Gauge
 	usageQueryLatency           *prometheus.HistogramVec
 	podGroupEvictedPodsTotal    *prometheus.CounterVec
+	autoPlacementStrategy       *prometheus.GaugeVec
+	autoPlacementFragmentation  prometheus.Gauge
+	autoPlacementBlockedDemand  prometheus.Gauge
+	autoPlacementSwitches       *prometheus.CounterVec
+	nodeInFlightPods            *prometheus.GaugeVec
+	nodeInFlightGPUs            *prometheus.GaugeVec
+	shadowPlacementDifferences  *prometheus.CounterVec
+	shadowEvictionDifferences   *prometheus.CounterVec
+	shadowEvaluationLatency     *prometheus.GaugeVec
+	microCycles                 *prometheus.CounterVec
+	microCycleLatency           prometheus.Gauge
+	dryRunPlacements            prometheus.Gauge
+	dryRunEvictions             *prometheus.GaugeVec
 )
 
 func init() {
@@ -193,6 +220,62 @@ func InitMetrics(namespace string) {
 			Help:      "GPU usage of queue, as a gauge. Units depend on UsageDB configuration",
 		}, []string{"queue_name"})
 
+	queueReclaimableCPU = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "queue_reclaimable_cpu_cores",
+			Help:      "CPU that the queue could get by reclaiming over fair share usage of other queues, as a gauge. Value is in Cores",
+		}, []string{"queue_name"})
+	queueReclaimableMemory = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "queue_reclaimable_memory_gb",
+			Help:      "Memory that the queue could get by reclaiming over fair share usage of other queues, as a gauge. Value is in GB",
+		}, []string{"queue_name"})
+	queueReclaimableGPU = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "queue_reclaimable_gpu",
+			Help:      "GPUs that the queue could get by reclaiming over fair share usage of other queues, as a gauge. Values in GPU devices",
+		}, []string{"queue_name"})
+
+	maintenanceCapacityCPU = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "maintenance_capacity_cpu_cores",
+			Help:      "CPU capacity of cordoned nodes, as a gauge. Value is in Cores",
+		})
+	maintenanceCapacityMemory = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "maintenance_capacity_memory_gb",
+			Help:      "Memory capacity of cordoned nodes, as a gauge. Value is in GB",
+		})
+	maintenanceCapacityGPU = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "maintenance_capacity_gpu",
+			Help:      "GPU capacity of cordoned nodes, as a gauge. Values in GPU devices",
+		})
+	maintenanceUsageCPU = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "maintenance_usage_cpu_cores",
+			Help:      "CPU allocated to pods running on cordoned nodes, as a gauge. Value is in Cores",
+		})
+	maintenanceUsageMemory = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "maintenance_usage_memory_gb",
+			Help:      "Memory allocated to pods running on cordoned nodes, as a gauge. Value is in GB",
+		})
+	maintenanceUsageGPU = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "maintenance_usage_gpu",
+			Help:      "GPUs allocated to pods running on cordoned nodes, as a gauge. Values in GPU devices",
+		})
+
 	usageQueryLatency = promauto.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: namespace,
@@ -207,38 +290,145 @@ func InitMetrics(namespace string) {
 			Name:      "pod_group_evicted_pods_total",
 			Help:      "Total number of pods evicted per pod group",
 		}, []string{"podgroup", "namespace", "uid", "nodepool", "action"})
+
+	autoPlacementStrategy = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "auto_placement_gpu_strategy",
+			Help:      "GPU placement strategy currently chosen by the auto placement strategy, set to 1",
+		}, []string{"strategy"})
+	autoPlacementFragmentation = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "auto_placement_gpu_fragmentation",
+			Help:      "Share of the non-allocated GPUs that are on nodes with allocated GPUs, as seen by the auto placement strategy",
+		})
+	autoPlacementBlockedDemand = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "auto_placement_blocked_whole_gpu_demand",
+			Help:      "GPUs requested by pending whole GPU pods that don't fit in the non-allocated GPUs of any node. Values in GPU devices",
+		})
+	autoPlacementSwitches = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "auto_placement_gpu_strategy_switches_total",
+			Help:      "Number of switches of the auto placement strategy, by the strategy switched to and the reason",
+		}, []string{"strategy", "reason"})
+
+	nodeInFlightPods = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "node_inflight_allocation_pods",
+			Help:      "Number of pods allocated to the node that are not bound yet, as a gauge",
+		}, []string{"node"})
+	nodeInFlightGPUs = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "node_inflight_allocation_gpus",
+			Help:      "GPUs of the pods allocated to the node that are not bound yet, as a gauge. Values in GPU devices",
+		}, []string{"node"})
+
+	shadowPlacementDifferences = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "shadow_placement_differences_total",
+			Help:      "Number of pods placed differently by the shadow configuration than by the primary configuration, by the difference",
+		}, []string{"shadow", "difference"})
+	shadowEvictionDifferences = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "shadow_eviction_differences_total",
+			Help:      "Number of pods evicted by only one of the shadow and primary configurations, by the difference and the evicting action",
+		}, []string{"shadow", "difference", "action"})
+	shadowEvaluationLatency = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "shadow_evaluation_latency_milliseconds",
+			Help:      "Latency of the evaluation of the shadow configuration in the last scheduling cycle in milliseconds, as a gauge",
+		}, []string{"shadow"})
+	microCycles = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "micro_cycles_total",
+			Help:      "Number of micro-cycles triggered by cluster events, by the trigger",
+		}, []string{"trigger"})
+	microCycleLatency = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "micro_cycle_latency_milliseconds",
+			Help:      "Latency of the last micro-cycle in milliseconds, as a gauge",
+		})
+	dryRunPlacements = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "dry_run_placements",
+			Help:      "Number of pods that the last scheduling cycle would have bound in dry-run mode, as a gauge",
+		})
+	dryRunEvictions = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "dry_run_evictions",
+			Help:      "Number of pods that the last scheduling cycle would have evicted in dry-run mode, by the evicting action, as a gauge",
+		}, []string{"action"})
+}
+
+// SuspendUpdates mutes the updates of the scheduling metrics until the returned function is called
+func SuspendUpdates() (resume func()) {
+	suspended.Store(true)
+	return func() {
+		suspended.Store(false)
+	}
 }
 
 // UpdateOpenSessionDuration updates latency for open session, including all plugins
 func UpdateOpenSessionDuration(startTime time.Time) {
+	if suspended.Load() {
+		return
+	}
 	duration := Duration(startTime).Milliseconds()
 	openSessionLatency.Set(float64(duration))
 }
 
 // UpdateCloseSessionDuration updates latency for close session, including all plugins
 func UpdateCloseSessionDuration(startTime time.Time) {
+	if suspended.Load() {
+		return
+	}
 	duration := Duration(startTime).Milliseconds()
 	closeSessionLatency.Set(float64(duration))
 }
 
 // UpdatePluginDuration updates latency for every plugin
 func UpdatePluginDuration(pluginName, OnSessionStatus string, duration time.Duration) {
+	if suspended.Load() {
+		return
+	}
 	pluginSchedulingLatency.WithLabelValues(pluginName, OnSessionStatus).Set(float64(duration.Milliseconds()))
 }
 
 // UpdateActionDuration updates latency for every action
 func UpdateActionDuration(actionName string, duration time.Duration) {
+	if suspended.Load() {
+		return
+	}
 	actionSchedulingLatency.WithLabelValues(actionName).Set(float64(duration.Milliseconds()))
 }
 
 // UpdateE2eDuration updates entire end to end scheduling latency
 func UpdateE2eDuration(startTime time.Time) {
+	if suspended.Load() {
+		return
+	}
 	duration := Duration(startTime).Milliseconds()
 	e2eSchedulingLatency.Set(float64(duration))
 }
 
 // UpdateTaskScheduleDuration updates single task scheduling latency
 func UpdateTaskScheduleDuration(duration time.Duration) {
+	if suspended.Load() {
+		return
+	}
 	taskSchedulingLatency.Observe(float64(duration.Milliseconds()))
 }
 
@@ -247,18 +437,30 @@ func SetCurrentAction(action string) {
 }
 
 func IncPodgroupScheduledByAction() {
+	if suspended.Load() {
+		return
+	}
 	podgroupsScheduledByAction.WithLabelValues(currentAction).Inc()
 }
 
 func IncPodgroupsConsideredByAction() {
+	if suspended.Load() {
+		return
+	}
 	podgroupsConsideredByAction.WithLabelValues(currentAction).Inc()
 }
 
 func IncScenarioSimulatedByAction() {
+	if suspended.Load() {
+		return
+	}
 	scenariosSimulatedByAction.WithLabelValues(currentAction).Inc()
 }
 
 func IncScenarioFilteredByAction() {
+	if suspended.Load() {
+		return
+	}
 	scenariosFilteredByAction.WithLabelValues(currentAction).Inc()
 }
 
@@ -270,12 +472,18 @@ func UpdateTaskBindDuration(startTime time.Time) {
 
 // UpdateQueueFairShare updates fair share of queue for a resource
 func UpdateQueueFairShare(queueName string, cpu, memory, gpu float64) {
+	if suspended.Load() {
+		return
+	}
 	queueFairShareCPU.WithLabelValues(queueName).Set(cpu)
 	queueFairShareMemory.WithLabelValues(queueName).Set(memory)
 	queueFairShareGPU.WithLabelValues(queueName).Set(gpu)
 }
 
 func ResetQueueFairShare() {
+	if suspended.Load() {
+		return
+	}
 	queueFairShareCPU.Reset()
 	queueFairShareMemory.Reset()
 	queueFairShareGPU.Reset()
@@ -283,23 +491,64 @@ func ResetQueueFairShare() {
 
 // UpdateQueueUsage updates usage of queue for a resource
 func UpdateQueueUsage(queueName string, cpu, memory, gpu float64) {
+	if suspended.Load() {
+		return
+	}
 	queueCPUUsage.WithLabelValues(queueName).Set(cpu)
 	queueMemoryUsage.WithLabelValues(queueName).Set(memory)
 	queueGPUUsage.WithLabelValues(queueName).Set(gpu)
 }
 
 func ResetQueueUsage() {
+	if suspended.Load() {
+		return
+	}
 	queueCPUUsage.Reset()
 	queueMemoryUsage.Reset()
 	queueGPUUsage.Reset()
 }
 
+// UpdateQueueReclaimable updates the resources that the queue could get by reclaiming resources from other queues
+func UpdateQueueReclaimable(queueName string, cpu, memory, gpu float64) {
+	if suspended.Load() {
+		return
+	}
+	queueReclaimableCPU.WithLabelValues(queueName).Set(cpu)
+	queueReclaimableMemory.WithLabelValues(queueName).Set(memory)
+	queueReclaimableGPU.WithLabelValues(queueName).Set(gpu)
+}
+
+func ResetQueueReclaimable() {
+	if suspended.Load() {
+		return
+	}
+	queueReclaimableCPU.Reset()
+	queueReclaimableMemory.Reset()
+	queueReclaimableGPU.Reset()
+}
+
+// UpdateMaintenanceCapacity updates the capacity of cordoned nodes, and the part of it used by running pods
+func UpdateMaintenanceCapacity(capacityCPU, capacityMemory, capacityGPU, usageCPU, usageMemory, usageGPU float64) {
+	if suspended.Load() {
+		return
+	}
+	maintenanceCapacityCPU.Set(capacityCPU)
+	maintenanceCapacityMemory.Set(capacityMemory)
+	maintenanceCapacityGPU.Set(capacityGPU)
+	maintenanceUsageCPU.Set(usageCPU)
+	maintenanceUsageMemory.Set(usageMemory)
+	maintenanceUsageGPU.Set(usageGPU)
+}
+
 func UpdateUsageQueryLatency(latency time.Duration) {
 	usageQueryLatency.WithLabelValues().Observe(float64(latency.Milliseconds()))
 }
 
 // RegisterPreemptionAttempts records number of attempts for preemption
 func RegisterPreemptionAttempts() {
+	if suspended.Load() {
+		return
+	}
 	preemptionAttempts.Inc()
 }
 
@@ -308,6 +557,79 @@ func RecordPodGroupEvictedPods(name, namespace, uid, nodepool, action string, co
 	podGroupEvictedPodsTotal.WithLabelValues(name, namespace, uid, nodepool, action).Add(float64(count))
 }
 
+// UpdateAutoPlacement updates the GPU placement strategy chosen by the auto placement strategy, and the telemetry it
+// was chosen by
+func UpdateAutoPlacement(strategy string, fragmentation, blockedWholeGpuDemand float64) {
+	if suspended.Load() {
+		return
+	}
+	autoPlacementStrategy.Reset()
+	autoPlacementStrategy.WithLabelValues(strategy).Set(1)
+	autoPlacementFragmentation.Set(fragmentation)
+	autoPlacementBlockedDemand.Set(blockedWholeGpuDemand)
+}
+
+// IncAutoPlacementSwitches records a switch of the auto placement strategy
+func IncAutoPlacementSwitches(strategy, reason string) {
+	if suspended.Load() {
+		return
+	}
+	autoPlacementSwitches.WithLabelValues(strategy, reason).Inc()
+}
+
+// UpdateNodeInFlightAllocations updates the pods allocated to the node that are not bound yet
+func UpdateNodeInFlightAllocations(nodeName string, pods int, gpus float64) {
+	if suspended.Load() {
+		return
+	}
+	nodeInFlightPods.WithLabelValues(nodeName).Set(float64(pods))
+	nodeInFlightGPUs.WithLabelValues(nodeName).Set(gpus)
+}
+
+func ResetNodeInFlightAllocations() {
+	if suspended.Load() {
+		return
+	}
+	nodeInFlightPods.Reset()
+	nodeInFlightGPUs.Reset()
+}
+
+// RecordShadowPlacementDifferences records pods that the shadow configuration placed differently than the primary
+// configuration in a scheduling cycle
+func RecordShadowPlacementDifferences(shadow, difference string, count int) {
+	shadowPlacementDifferences.WithLabelValues(shadow, difference).Add(float64(count))
+}
+
+// RecordShadowEvictionDifferences records pods that only one of the shadow and primary configurations evicted in a
+// scheduling cycle
+func RecordShadowEvictionDifferences(shadow, difference, action string, count int) {
+	shadowEvictionDifferences.WithLabelValues(shadow, difference, action).Add(float64(count))
+}
+
+// UpdateShadowEvaluationDuration updates the latency of the evaluation of the shadow configuration
+func UpdateShadowEvaluationDuration(shadow string, startTime time.Time) {
+	duration := Duration(startTime).Milliseconds()
+	shadowEvaluationLatency.WithLabelValues(shadow).Set(float64(duration))
+}
+
+// RecordMicroCycle records a micro-cycle triggered by the given triggers and updates its latency
+func RecordMicroCycle(triggers []string, startTime time.Time) {
+	for _, trigger := range triggers {
+		microCycles.WithLabelValues(trigger).Inc()
+	}
+	microCycleLatency.Set(float64(Duration(startTime).Milliseconds()))
+}
+
+// UpdateDryRunDecisions updates the number of pods that the last scheduling cycle would have bound and evicted in
+// dry-run mode
+func UpdateDryRunDecisions(placements int, evictions map[string]int) {
+	dryRunPlacements.Set(float64(placements))
+	dryRunEvictions.Reset()
+	for action, count := range evictions {
+		dryRunEvictions.WithLabelValues(action).Set(float64(count))
+	}
+}
+
 // Duration get the time since specified start
 func Duration(start time.Time) time.Duration {
 	return time.Since(start)
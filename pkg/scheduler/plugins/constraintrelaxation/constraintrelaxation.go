@@ -0,0 +1,130 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package constraintrelaxation
+
+import (
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const pluginName = "constraintrelaxation"
+
+type constraintRelaxationPlugin struct {
+	failedCycles *failedCyclesTracker
+}
+
+func New(_ framework.PluginArguments) framework.Plugin {
+	return &constraintRelaxationPlugin{}
+}
+
+func (crp *constraintRelaxationPlugin) Name() string {
+	return pluginName
+}
+
+func (crp *constraintRelaxationPlugin) OnSessionOpen(_ *framework.Session) {}
+
+// OnSessionClose counts the consecutive sessions that the jobs failed to be scheduled in, and relaxes the next soft
+// constraint of the jobs that reached the number of failed cycles of their policy. The relaxed constraints are
+// written to the pod groups' status with the rest of the session's results, and apply from the next session. Shadow
+// sessions don't count failed cycles, since their results are not written, and neither do micro-cycles, which only
+// try to schedule the jobs of some queues.
+func (crp *constraintRelaxationPlugin) OnSessionClose(ssn *framework.Session) {
+	if ssn.IsShadow() || ssn.IsMicroCycle() {
+		return
+	}
+	crp.failedCycles = ssn.PluginState(pluginName, func() any { return newFailedCyclesTracker() }).(*failedCyclesTracker)
+	now := metav1.Now()
+	tracked := map[common_info.PodGroupID]bool{}
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		if job.PodGroup == nil || job.PodGroup.Spec.ConstraintRelaxation == nil {
+			continue
+		}
+		relaxation := job.PodGroup.Spec.ConstraintRelaxation
+		constraint, found := nextConstraint(job, relaxation)
+		if !found {
+			continue
+		}
+		tracked[job.UID] = true
+
+		if !failedToSchedule(job) {
+			crp.failedCycles.reset(job.UID)
+			continue
+		}
+		if crp.failedCycles.increment(job.UID) < relaxation.FailedCycles {
+			continue
+		}
+
+		crp.failedCycles.reset(job.UID)
+		job.RelaxedConstraints = append(slices.Clone(job.RelaxedConstraints), enginev2alpha2.RelaxedConstraint{
+			Constraint:     constraint,
+			RelaxationTime: now,
+		})
+		log.InfraLogger.V(3).Infof("Relaxed the %s constraint of job <%s/%s> after %d failed scheduling cycles",
+			constraint, job.Namespace, job.Name, relaxation.FailedCycles)
+	}
+	crp.failedCycles.retain(tracked)
+}
+
+// failedToSchedule returns true if the job is pending and the session tried and failed to allocate it
+func failedToSchedule(job *podgroup_info.PodGroupInfo) bool {
+	if job.GetActiveAllocatedTasksCount() > 0 || job.GetNumPendingTasks() == 0 {
+		return false
+	}
+	return len(job.JobFitErrors) > 0 || len(job.TasksFitErrors) > 0
+}
+
+// nextConstraint returns the first constraint of the policy that the job sets and that is not relaxed yet
+func nextConstraint(
+	job *podgroup_info.PodGroupInfo, relaxation *enginev2alpha2.ConstraintRelaxation,
+) (enginev2alpha2.SoftConstraint, bool) {
+	constraints := relaxation.Constraints
+	if len(constraints) == 0 {
+		constraints = enginev2alpha2.DefaultRelaxedConstraints()
+	}
+	for _, constraint := range constraints {
+		if job.IsConstraintRelaxed(constraint) || !hasConstraint(job.PodGroup, constraint) {
+			continue
+		}
+		return constraint, true
+	}
+	return "", false
+}
+
+func hasConstraint(podGroup *enginev2alpha2.PodGroup, constraint enginev2alpha2.SoftConstraint) bool {
+	switch constraint {
+	case enginev2alpha2.PreferredTopologyConstraint:
+		return hasTopologyLevel(podGroup, func(topologyConstraint *enginev2alpha2.TopologyConstraint) string {
+			return topologyConstraint.PreferredTopologyLevel
+		})
+	case enginev2alpha2.PreferredNodeAffinityConstraint:
+		return len(podGroup.Spec.PreferredNodeAffinityTerms) > 0
+	case enginev2alpha2.SubGroupSpreadConstraint:
+		return hasTopologyLevel(podGroup, func(topologyConstraint *enginev2alpha2.TopologyConstraint) string {
+			return topologyConstraint.SubGroupSpreadTopologyLevel
+		})
+	}
+	return false
+}
+
+// hasTopologyLevel returns true if the topology constraint of the pod group or of any of its subgroups sets the level
+func hasTopologyLevel(
+	podGroup *enginev2alpha2.PodGroup, level func(*enginev2alpha2.TopologyConstraint) string,
+) bool {
+	if level(&podGroup.Spec.TopologyConstraint) != "" {
+		return true
+	}
+	for _, subGroup := range podGroup.Spec.SubGroups {
+		if subGroup.TopologyConstraint != nil && level(subGroup.TopologyConstraint) != "" {
+			return true
+		}
+	}
+	return false
+}
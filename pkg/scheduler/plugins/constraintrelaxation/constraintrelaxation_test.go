@@ -0,0 +1,171 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package constraintrelaxation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+func TestOnSessionClose(t *testing.T) {
+	job := newJob(enginev2alpha2.PodGroupSpec{
+		TopologyConstraint: enginev2alpha2.TopologyConstraint{
+			Topology:               "topology",
+			PreferredTopologyLevel: "rack",
+		},
+		PreferredNodeAffinityTerms: []v1.PreferredSchedulingTerm{{Weight: 1}},
+		ConstraintRelaxation:       &enginev2alpha2.ConstraintRelaxation{FailedCycles: 2},
+	}, pod_status.Pending)
+	plugin := &constraintRelaxationPlugin{}
+	states := framework.NewPluginStates()
+	closeSession := func() {
+		job.AddSimpleJobFitError(podgroup_info.PodSchedulingErrors, "no fit")
+		plugin.OnSessionClose(newSession(states, job))
+	}
+
+	closeSession()
+	assert.Empty(t, job.RelaxedConstraints)
+	closeSession()
+	assert.Equal(t, []enginev2alpha2.SoftConstraint{enginev2alpha2.PreferredTopologyConstraint},
+		relaxedConstraints(job))
+
+	closeSession()
+	closeSession()
+	assert.Equal(t, []enginev2alpha2.SoftConstraint{
+		enginev2alpha2.PreferredTopologyConstraint, enginev2alpha2.PreferredNodeAffinityConstraint,
+	}, relaxedConstraints(job), "the pod group has no subgroup spread to relax")
+
+	closeSession()
+	assert.Len(t, job.RelaxedConstraints, 2)
+	assert.Empty(t, plugin.failedCycles.cycles, "jobs with no constraint left to relax are not tracked")
+}
+
+func TestOnSessionClose_ScheduledJobResetsFailedCycles(t *testing.T) {
+	spec := enginev2alpha2.PodGroupSpec{
+		PreferredNodeAffinityTerms: []v1.PreferredSchedulingTerm{{Weight: 1}},
+		ConstraintRelaxation:       &enginev2alpha2.ConstraintRelaxation{FailedCycles: 2},
+	}
+	pendingJob := newJob(spec, pod_status.Pending)
+	pendingJob.AddSimpleJobFitError(podgroup_info.PodSchedulingErrors, "no fit")
+	plugin := &constraintRelaxationPlugin{}
+	states := framework.NewPluginStates()
+
+	plugin.OnSessionClose(newSession(states, pendingJob))
+	assert.Equal(t, int32(1), plugin.failedCycles.cycles[pendingJob.UID])
+
+	plugin.OnSessionClose(newSession(states, newJob(spec, pod_status.Allocated)))
+	assert.NotContains(t, plugin.failedCycles.cycles, pendingJob.UID)
+
+	plugin.OnSessionClose(newSession(states, pendingJob))
+	assert.Empty(t, pendingJob.RelaxedConstraints)
+}
+
+func Test_nextConstraint(t *testing.T) {
+	subGroupSpread := enginev2alpha2.PodGroupSpec{
+		SubGroups: []enginev2alpha2.SubGroup{
+			{Name: "parent", TopologyConstraint: &enginev2alpha2.TopologyConstraint{
+				Topology:                    "topology",
+				SubGroupSpreadTopologyLevel: "zone",
+			}},
+		},
+		PreferredNodeAffinityTerms: []v1.PreferredSchedulingTerm{{Weight: 1}},
+	}
+
+	tests := []struct {
+		name        string
+		spec        enginev2alpha2.PodGroupSpec
+		constraints []enginev2alpha2.SoftConstraint
+		relaxed     []enginev2alpha2.SoftConstraint
+		expected    enginev2alpha2.SoftConstraint
+		found       bool
+	}{
+		{
+			name:     "default order skips constraints that are not set",
+			spec:     subGroupSpread,
+			expected: enginev2alpha2.PreferredNodeAffinityConstraint,
+			found:    true,
+		},
+		{
+			name:        "configured order",
+			spec:        subGroupSpread,
+			constraints: []enginev2alpha2.SoftConstraint{enginev2alpha2.SubGroupSpreadConstraint},
+			expected:    enginev2alpha2.SubGroupSpreadConstraint,
+			found:       true,
+		},
+		{
+			name:     "already relaxed constraints are skipped",
+			spec:     subGroupSpread,
+			relaxed:  []enginev2alpha2.SoftConstraint{enginev2alpha2.PreferredNodeAffinityConstraint},
+			expected: enginev2alpha2.SubGroupSpreadConstraint,
+			found:    true,
+		},
+		{
+			name: "no constraint left",
+			spec: subGroupSpread,
+			relaxed: []enginev2alpha2.SoftConstraint{
+				enginev2alpha2.PreferredNodeAffinityConstraint, enginev2alpha2.SubGroupSpreadConstraint,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := newJob(tt.spec, pod_status.Pending)
+			for _, constraint := range tt.relaxed {
+				job.RelaxedConstraints = append(job.RelaxedConstraints,
+					enginev2alpha2.RelaxedConstraint{Constraint: constraint})
+			}
+
+			constraint, found := nextConstraint(job, &enginev2alpha2.ConstraintRelaxation{
+				FailedCycles: 1,
+				Constraints:  tt.constraints,
+			})
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.expected, constraint)
+		})
+	}
+}
+
+func newJob(spec enginev2alpha2.PodGroupSpec, status pod_status.PodStatus) *podgroup_info.PodGroupInfo {
+	job := podgroup_info.NewPodGroupInfo("job")
+	job.SetPodGroup(&enginev2alpha2.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "ns"},
+		Spec:       spec,
+	})
+	job.AddTaskInfo(&pod_info.PodInfo{
+		UID:       "task",
+		Job:       "job",
+		Name:      "task",
+		Namespace: "ns",
+		Status:    status,
+		Pod:       &v1.Pod{},
+	})
+	return job
+}
+
+func newSession(states *framework.PluginStates, job *podgroup_info.PodGroupInfo) *framework.Session {
+	ssn := &framework.Session{ClusterInfo: &api.ClusterInfo{
+		PodGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{job.UID: job},
+	}}
+	ssn.SetPluginStates(states)
+	return ssn
+}
+
+func relaxedConstraints(job *podgroup_info.PodGroupInfo) []enginev2alpha2.SoftConstraint {
+	var constraints []enginev2alpha2.SoftConstraint
+	for _, relaxed := range job.RelaxedConstraints {
+		constraints = append(constraints, relaxed.Constraint)
+	}
+	return constraints
+}
@@ -0,0 +1,46 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package constraintrelaxation
+
+import (
+	"sync"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+)
+
+// failedCyclesTracker counts the consecutive scheduling sessions that each job failed to be scheduled in. The counts
+// are kept in memory, so they start over when the scheduler restarts, while the relaxed constraints are kept in the
+// pod groups' status.
+type failedCyclesTracker struct {
+	mutex  sync.Mutex
+	cycles map[common_info.PodGroupID]int32
+}
+
+func newFailedCyclesTracker() *failedCyclesTracker {
+	return &failedCyclesTracker{cycles: map[common_info.PodGroupID]int32{}}
+}
+
+func (t *failedCyclesTracker) increment(jobID common_info.PodGroupID) int32 {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.cycles[jobID]++
+	return t.cycles[jobID]
+}
+
+func (t *failedCyclesTracker) reset(jobID common_info.PodGroupID) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	delete(t.cycles, jobID)
+}
+
+// retain forgets the jobs that are no longer tracked, e.g. deleted jobs or jobs with no constraint left to relax
+func (t *failedCyclesTracker) retain(jobIDs map[common_info.PodGroupID]bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	for jobID := range t.cycles {
+		if !jobIDs[jobID] {
+			delete(t.cycles, jobID)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package datasetlocality
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+const (
+	pluginName = "datasetlocality"
+
+	// defaultNodeLabel is the label that Fluid sets on the nodes that cache a dataset
+	defaultNodeLabel = "fluid.io/s-{namespace}-{dataset}"
+
+	namespacePlaceholder = "{namespace}"
+	datasetPlaceholder   = "{dataset}"
+
+	cachedDatasetsScore = scores.ResourceType
+)
+
+type datasetLocalityPlugin struct {
+	nodeLabel string
+
+	// jobLabels holds the node labels of the datasets of the jobs with pending tasks in the session
+	jobLabels map[common_info.PodGroupID][]string
+}
+
+func New(arguments framework.PluginArguments) framework.Plugin {
+	nodeLabel := arguments.GetString("nodeLabel", defaultNodeLabel)
+	if err := validateNodeLabel(nodeLabel); err != nil {
+		log.InfraLogger.Warningf("%v. Using default value of %s", err, defaultNodeLabel)
+		nodeLabel = defaultNodeLabel
+	}
+	return &datasetLocalityPlugin{
+		nodeLabel: nodeLabel,
+	}
+}
+
+// ValidateArguments rejects datasetlocality plugin arguments that can't be used
+func ValidateArguments(arguments framework.PluginArguments) error {
+	return validateNodeLabel(arguments.GetString("nodeLabel", defaultNodeLabel))
+}
+
+func validateNodeLabel(nodeLabel string) error {
+	if !strings.Contains(nodeLabel, datasetPlaceholder) {
+		return fmt.Errorf("nodeLabel must contain %s, got %q", datasetPlaceholder, nodeLabel)
+	}
+	return nil
+}
+
+func (dlp *datasetLocalityPlugin) Name() string {
+	return pluginName
+}
+
+func (dlp *datasetLocalityPlugin) OnSessionOpen(ssn *framework.Session) {
+	dlp.jobLabels = map[common_info.PodGroupID][]string{}
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		if job.GetNumPendingTasks() == 0 {
+			continue
+		}
+		if labels := dlp.datasetNodeLabels(job); len(labels) > 0 {
+			dlp.jobLabels[job.UID] = labels
+		}
+	}
+
+	ssn.AddNodeOrderFn(dlp.nodeOrderFn)
+}
+
+func (dlp *datasetLocalityPlugin) OnSessionClose(_ *framework.Session) {}
+
+// nodeOrderFn prefers the nodes that cache the datasets of the job, in proportion to the share of its datasets that
+// they cache
+func (dlp *datasetLocalityPlugin) nodeOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
+	labels, found := dlp.jobLabels[task.Job]
+	if !found || node.Node == nil {
+		return 0, nil
+	}
+	cached := 0
+	for _, label := range labels {
+		if value, found := node.Node.Labels[label]; found && value != "false" {
+			cached++
+		}
+	}
+	return cachedDatasetsScore * float64(cached) / float64(len(labels)), nil
+}
+
+// datasetNodeLabels returns the node labels of the datasets listed in the datasets annotation of the job's PodGroup.
+// Datasets are in the namespace of the job, unless they are given as <namespace>/<name>.
+func (dlp *datasetLocalityPlugin) datasetNodeLabels(job *podgroup_info.PodGroupInfo) []string {
+	if job.PodGroup == nil {
+		return nil
+	}
+	value := job.PodGroup.Annotations[constants.Datasets]
+	if value == "" {
+		return nil
+	}
+
+	var labels []string
+	for _, dataset := range strings.Split(value, ",") {
+		dataset = strings.TrimSpace(dataset)
+		if dataset == "" {
+			continue
+		}
+		namespace := job.Namespace
+		if datasetNamespace, name, found := strings.Cut(dataset, "/"); found {
+			namespace, dataset = datasetNamespace, name
+		}
+		label := strings.ReplaceAll(dlp.nodeLabel, namespacePlaceholder, namespace)
+		labels = append(labels, strings.ReplaceAll(label, datasetPlaceholder, dataset))
+	}
+	return labels
+}
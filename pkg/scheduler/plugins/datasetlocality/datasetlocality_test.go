@@ -0,0 +1,127 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package datasetlocality
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+func TestDatasetNodeLabels(t *testing.T) {
+	tests := []struct {
+		name      string
+		nodeLabel string
+		datasets  string
+		expected  []string
+	}{
+		{name: "no datasets"},
+		{
+			name:     "dataset in the namespace of the job",
+			datasets: "imagenet",
+			expected: []string{"fluid.io/s-research-imagenet"},
+		},
+		{
+			name:     "datasets in several namespaces",
+			datasets: "imagenet, shared/coco,",
+			expected: []string{"fluid.io/s-research-imagenet", "fluid.io/s-shared-coco"},
+		},
+		{
+			name:      "custom node label",
+			nodeLabel: "cache.example.com/{dataset}",
+			datasets:  "imagenet",
+			expected:  []string{"cache.example.com/imagenet"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arguments := framework.PluginArguments{}
+			if tt.nodeLabel != "" {
+				arguments["nodeLabel"] = tt.nodeLabel
+			}
+			dlp := New(arguments).(*datasetLocalityPlugin)
+			assert.Equal(t, tt.expected, dlp.datasetNodeLabels(newJob("job", tt.datasets)))
+		})
+	}
+}
+
+func TestNodeOrderFn(t *testing.T) {
+	dlp := New(framework.PluginArguments{}).(*datasetLocalityPlugin)
+	dlp.jobLabels = map[common_info.PodGroupID][]string{
+		"job": dlp.datasetNodeLabels(newJob("job", "imagenet,coco")),
+	}
+	task := &pod_info.PodInfo{UID: "pod", Job: "job", Status: pod_status.Pending}
+	otherTask := &pod_info.PodInfo{UID: "other-pod", Job: "other-job", Status: pod_status.Pending}
+
+	tests := []struct {
+		name     string
+		task     *pod_info.PodInfo
+		node     *node_info.NodeInfo
+		expected float64
+	}{
+		{
+			name: "node caching all the datasets", task: task,
+			node: newNode("node-a", map[string]string{
+				"fluid.io/s-research-imagenet": "true", "fluid.io/s-research-coco": "true",
+			}),
+			expected: cachedDatasetsScore,
+		},
+		{
+			name:     "node caching some of the datasets",
+			task:     task,
+			node:     newNode("node-b", map[string]string{"fluid.io/s-research-imagenet": "true"}),
+			expected: cachedDatasetsScore / 2,
+		},
+		{
+			name: "dataset label set to false", task: task,
+			node: newNode("node-c", map[string]string{"fluid.io/s-research-imagenet": "false"}),
+		},
+		{name: "node without cached datasets", task: task, node: newNode("node-d", nil)},
+		{
+			name: "job without datasets", task: otherTask,
+			node: newNode("node-a", map[string]string{"fluid.io/s-research-imagenet": "true"}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, err := dlp.nodeOrderFn(tt.task, tt.node)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, score)
+		})
+	}
+}
+
+func TestValidateArguments(t *testing.T) {
+	assert.NoError(t, ValidateArguments(framework.PluginArguments{}))
+	assert.NoError(t, ValidateArguments(framework.PluginArguments{"nodeLabel": "cache.example.com/{dataset}"}))
+	assert.Error(t, ValidateArguments(framework.PluginArguments{"nodeLabel": "cache.example.com/cached"}))
+}
+
+func newJob(name, datasets string) *podgroup_info.PodGroupInfo {
+	job := podgroup_info.NewPodGroupInfo(common_info.PodGroupID(name))
+	job.Namespace = "research"
+	job.PodGroup = &enginev2alpha2.PodGroup{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "research"}}
+	if datasets != "" {
+		job.PodGroup.Annotations = map[string]string{constants.Datasets: datasets}
+	}
+	return job
+}
+
+func newNode(name string, labels map[string]string) *node_info.NodeInfo {
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	return &node_info.NodeInfo{Name: name, Node: node}
+}
@@ -0,0 +1,201 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package dedicatednodes
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const (
+	pluginName           = "dedicatednodes"
+	defaultLeaseDuration = 10 * time.Minute
+)
+
+// dedicatedNodesPlugin dedicates the nodes of the running pod groups that ask for it. The nodes are tainted, so that
+// the pods of other schedulers aren't placed on them while the pod group runs, and the pods of other pod groups aren't
+// allocated to them by the scheduler. The taints are leased, and the leases are renewed for as long as the pod groups
+// run on the nodes.
+type dedicatedNodesPlugin struct {
+	leaseDuration time.Duration
+
+	// owners are the UIDs of the pod groups that the nodes are dedicated to, by node name
+	owners map[string]types.UID
+}
+
+func New(arguments framework.PluginArguments) framework.Plugin {
+	leaseDuration, err := arguments.GetDuration("leaseDuration", defaultLeaseDuration)
+	if err != nil || leaseDuration <= 0 {
+		log.InfraLogger.Warningf("leaseDuration must be a positive duration, got %q. Using default value of %s",
+			arguments["leaseDuration"], defaultLeaseDuration)
+		leaseDuration = defaultLeaseDuration
+	}
+	return &dedicatedNodesPlugin{
+		leaseDuration: leaseDuration,
+	}
+}
+
+// ValidateArguments rejects dedicatednodes plugin arguments that can't be parsed
+func ValidateArguments(arguments framework.PluginArguments) error {
+	leaseDuration, err := arguments.GetDuration("leaseDuration", defaultLeaseDuration)
+	if err != nil {
+		return fmt.Errorf("invalid leaseDuration: %w", err)
+	}
+	if leaseDuration <= 0 {
+		return fmt.Errorf("leaseDuration must be positive, got %s", leaseDuration)
+	}
+	return nil
+}
+
+func (dnp *dedicatedNodesPlugin) Name() string {
+	return pluginName
+}
+
+func (dnp *dedicatedNodesPlugin) OnSessionOpen(ssn *framework.Session) {
+	now := ssn.Clock().Now()
+	dnp.owners = dedicatedNodeOwners(ssn.ClusterInfo.PodGroupInfos, ssn.ClusterInfo.Nodes, now)
+	if ssn.Cache != nil && !ssn.IsShadow() {
+		dnp.updateTaints(sharedKubeNodeTainter(ssn.Cache.KubeClient()), ssn.ClusterInfo.Nodes, now)
+	}
+	ssn.AddPredicateFn(dnp.predicateFn)
+}
+
+func (dnp *dedicatedNodesPlugin) OnSessionClose(_ *framework.Session) {}
+
+// predicateFn keeps the tasks of other pod groups off dedicated nodes, unless they tolerate the dedicated node taint
+func (dnp *dedicatedNodesPlugin) predicateFn(
+	task *pod_info.PodInfo, job *podgroup_info.PodGroupInfo, node *node_info.NodeInfo,
+) error {
+	owner, dedicated := dnp.owners[node.Name]
+	if !dedicated || owner == job.PodGroupUID || toleratesDedicatedNodeTaint(task.Pod, owner) {
+		return nil
+	}
+	return common_info.NewFitError(task.Name, task.Namespace, node.Name,
+		fmt.Sprintf("node is dedicated to pod group <%s>", owner))
+}
+
+// updateTaints taints the dedicated nodes that aren't tainted for their owners, or whose lease is about to expire,
+// and removes the taints of nodes that are no longer dedicated
+func (dnp *dedicatedNodesPlugin) updateTaints(tainter nodeTainter, nodes map[string]*node_info.NodeInfo,
+	now time.Time) {
+	for nodeName, node := range nodes {
+		owner, dedicated := dnp.owners[nodeName]
+		taint := dedicatedNodeTaint(node.Node)
+		switch {
+		case dedicated && (taint == nil || types.UID(taint.Value) != owner ||
+			leaseExpiry(node.Node).Sub(now) < dnp.leaseDuration/2):
+			log.InfraLogger.V(4).Infof("Dedicating node <%s> to pod group <%s>", nodeName, owner)
+			tainter.dedicate(nodeName, owner, now.Add(dnp.leaseDuration))
+		case !dedicated && taint != nil:
+			log.InfraLogger.V(4).Infof("Releasing node <%s> dedicated to pod group <%s>", nodeName, taint.Value)
+			tainter.release(nodeName)
+		}
+	}
+}
+
+// dedicatedNodeOwners returns the pod groups that the nodes are dedicated to, by node name. A node is dedicated to
+// a pod group that asks for dedicated nodes while pods of the pod group are allocated to the node. If pods of several
+// such pod groups are allocated to the node, the node stays dedicated to the pod group that holds its lease.
+func dedicatedNodeOwners(jobs map[common_info.PodGroupID]*podgroup_info.PodGroupInfo,
+	nodes map[string]*node_info.NodeInfo, now time.Time) map[string]types.UID {
+	owners := map[string]types.UID{}
+	for _, job := range jobs {
+		if !isDedicated(job) {
+			continue
+		}
+		for _, task := range job.GetAllPodsMap() {
+			if !pod_status.AllocatedStatus(task.Status) || task.NodeName == "" {
+				continue
+			}
+			node, found := nodes[task.NodeName]
+			if !found {
+				continue
+			}
+			owner, found := owners[task.NodeName]
+			if !found || isPreferredOwner(job.PodGroupUID, owner, leaseHolder(node.Node, now)) {
+				owners[task.NodeName] = job.PodGroupUID
+			}
+		}
+	}
+	return owners
+}
+
+func isDedicated(job *podgroup_info.PodGroupInfo) bool {
+	return job.PodGroup != nil && job.PodGroup.Annotations[constants.DedicatedNodes] == "true"
+}
+
+// isPreferredOwner returns true if a node should be dedicated to the candidate pod group rather than to its current
+// owner. The pod group that holds the lease of the node is preferred, and otherwise the choice is arbitrary but stable.
+func isPreferredOwner(candidate, owner, leaseHolder types.UID) bool {
+	if candidate == leaseHolder || owner == leaseHolder {
+		return candidate == leaseHolder
+	}
+	return candidate < owner
+}
+
+// leaseHolder returns the pod group that the node is dedicated to by its taint, if the lease of the taint hasn't
+// expired
+func leaseHolder(node *v1.Node, now time.Time) types.UID {
+	taint := dedicatedNodeTaint(node)
+	if taint == nil || !leaseExpiry(node).After(now) {
+		return ""
+	}
+	return types.UID(taint.Value)
+}
+
+func dedicatedNodeTaint(node *v1.Node) *v1.Taint {
+	if node == nil {
+		return nil
+	}
+	index := slices.IndexFunc(node.Spec.Taints, isDedicatedNodeTaint)
+	if index < 0 {
+		return nil
+	}
+	return &node.Spec.Taints[index]
+}
+
+// leaseExpiry returns the expiry of the lease of the dedicated node taint, or the zero time if the node has no valid
+// lease
+func leaseExpiry(node *v1.Node) time.Time {
+	expiry, err := time.Parse(time.RFC3339, node.Annotations[constants.DedicatedNodeLeaseExpiry])
+	if err != nil {
+		return time.Time{}
+	}
+	return expiry
+}
+
+func toleratesDedicatedNodeTaint(pod *v1.Pod, owner types.UID) bool {
+	if pod == nil {
+		return false
+	}
+	taint := newDedicatedNodeTaint(owner)
+	return slices.ContainsFunc(pod.Spec.Tolerations, func(toleration v1.Toleration) bool {
+		return toleration.ToleratesTaint(&taint)
+	})
+}
+
+func newDedicatedNodeTaint(owner types.UID) v1.Taint {
+	return v1.Taint{
+		Key:    constants.DedicatedNodeTaintKey,
+		Value:  string(owner),
+		Effect: v1.TaintEffectNoSchedule,
+	}
+}
+
+func isDedicatedNodeTaint(taint v1.Taint) bool {
+	return taint.Key == constants.DedicatedNodeTaintKey
+}
@@ -0,0 +1,240 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package dedicatednodes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+var now = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func TestDedicatedNodeOwners(t *testing.T) {
+	tests := []struct {
+		name     string
+		jobs     []*podgroup_info.PodGroupInfo
+		nodes    []*node_info.NodeInfo
+		expected map[string]types.UID
+	}{
+		{
+			name: "running dedicated job",
+			jobs: []*podgroup_info.PodGroupInfo{
+				newJob("a", true, newTask("a-0", "node-a", pod_status.Running),
+					newTask("a-1", "node-b", pod_status.Bound)),
+			},
+			nodes:    []*node_info.NodeInfo{newNode("node-a", "", time.Time{}), newNode("node-b", "", time.Time{})},
+			expected: map[string]types.UID{"node-a": "a", "node-b": "a"},
+		},
+		{
+			name:     "job that doesn't ask for dedicated nodes",
+			jobs:     []*podgroup_info.PodGroupInfo{newJob("a", false, newTask("a-0", "node-a", pod_status.Running))},
+			nodes:    []*node_info.NodeInfo{newNode("node-a", "", time.Time{})},
+			expected: map[string]types.UID{},
+		},
+		{
+			name: "completed and pipelined tasks",
+			jobs: []*podgroup_info.PodGroupInfo{
+				newJob("a", true, newTask("a-0", "node-a", pod_status.Succeeded),
+					newTask("a-1", "node-b", pod_status.Pipelined)),
+			},
+			nodes:    []*node_info.NodeInfo{newNode("node-a", "a", now.Add(time.Minute)), newNode("node-b", "", time.Time{})},
+			expected: map[string]types.UID{},
+		},
+		{
+			name: "node shared by dedicated jobs stays with the lease holder",
+			jobs: []*podgroup_info.PodGroupInfo{
+				newJob("a", true, newTask("a-0", "node-a", pod_status.Running)),
+				newJob("b", true, newTask("b-0", "node-a", pod_status.Running)),
+			},
+			nodes:    []*node_info.NodeInfo{newNode("node-a", "b", now.Add(time.Minute))},
+			expected: map[string]types.UID{"node-a": "b"},
+		},
+		{
+			name: "node shared by dedicated jobs with an expired lease",
+			jobs: []*podgroup_info.PodGroupInfo{
+				newJob("a", true, newTask("a-0", "node-a", pod_status.Running)),
+				newJob("b", true, newTask("b-0", "node-a", pod_status.Running)),
+			},
+			nodes:    []*node_info.NodeInfo{newNode("node-a", "b", now.Add(-time.Minute))},
+			expected: map[string]types.UID{"node-a": "a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobs := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{}
+			for _, job := range tt.jobs {
+				jobs[job.UID] = job
+			}
+			assert.Equal(t, tt.expected, dedicatedNodeOwners(jobs, nodesMap(tt.nodes...), now))
+		})
+	}
+}
+
+func TestUpdateTaints(t *testing.T) {
+	tests := []struct {
+		name              string
+		owners            map[string]types.UID
+		node              *node_info.NodeInfo
+		expectedDedicated map[string]types.UID
+		expectedReleased  []string
+	}{
+		{
+			name:              "untainted dedicated node",
+			owners:            map[string]types.UID{"node-a": "a"},
+			node:              newNode("node-a", "", time.Time{}),
+			expectedDedicated: map[string]types.UID{"node-a": "a"},
+		},
+		{
+			name:   "lease far from expiry",
+			owners: map[string]types.UID{"node-a": "a"},
+			node:   newNode("node-a", "a", now.Add(8*time.Minute)),
+		},
+		{
+			name:              "lease about to expire",
+			owners:            map[string]types.UID{"node-a": "a"},
+			node:              newNode("node-a", "a", now.Add(time.Minute)),
+			expectedDedicated: map[string]types.UID{"node-a": "a"},
+		},
+		{
+			name:              "node tainted for another pod group",
+			owners:            map[string]types.UID{"node-a": "a"},
+			node:              newNode("node-a", "b", now.Add(8*time.Minute)),
+			expectedDedicated: map[string]types.UID{"node-a": "a"},
+		},
+		{
+			name:             "node no longer dedicated",
+			owners:           map[string]types.UID{},
+			node:             newNode("node-a", "a", now.Add(8*time.Minute)),
+			expectedReleased: []string{"node-a"},
+		},
+		{
+			name:   "untainted node",
+			owners: map[string]types.UID{},
+			node:   newNode("node-a", "", time.Time{}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := New(framework.PluginArguments{}).(*dedicatedNodesPlugin)
+			plugin.owners = tt.owners
+			tainter := &fakeNodeTainter{}
+			plugin.updateTaints(tainter, nodesMap(tt.node), now)
+
+			assert.Equal(t, tt.expectedDedicated, tainter.dedicated)
+			assert.Equal(t, tt.expectedReleased, tainter.released)
+			for _, expiry := range tainter.leaseExpiries {
+				assert.Equal(t, now.Add(defaultLeaseDuration), expiry)
+			}
+		})
+	}
+}
+
+func TestPredicateFn(t *testing.T) {
+	plugin := New(framework.PluginArguments{}).(*dedicatedNodesPlugin)
+	plugin.owners = map[string]types.UID{"node-a": "a"}
+	dedicatedNode := newNode("node-a", "a", now.Add(time.Minute))
+	otherNode := newNode("node-b", "", time.Time{})
+
+	owner := newJob("a", true)
+	other := newJob("b", false)
+	task := newTask("task", "", pod_status.Pending)
+	tolerating := newTask("tolerating", "", pod_status.Pending)
+	tolerating.Pod.Spec.Tolerations = []v1.Toleration{
+		{Key: constants.DedicatedNodeTaintKey, Operator: v1.TolerationOpExists},
+	}
+
+	assert.NoError(t, plugin.predicateFn(task, owner, dedicatedNode))
+	assert.Error(t, plugin.predicateFn(task, other, dedicatedNode))
+	assert.NoError(t, plugin.predicateFn(tolerating, other, dedicatedNode))
+	assert.NoError(t, plugin.predicateFn(task, other, otherNode))
+}
+
+func TestValidateArguments(t *testing.T) {
+	assert.NoError(t, ValidateArguments(framework.PluginArguments{}))
+	assert.NoError(t, ValidateArguments(framework.PluginArguments{"leaseDuration": "30m"}))
+	assert.Error(t, ValidateArguments(framework.PluginArguments{"leaseDuration": "forever"}))
+	assert.Error(t, ValidateArguments(framework.PluginArguments{"leaseDuration": "0s"}))
+}
+
+type fakeNodeTainter struct {
+	dedicated     map[string]types.UID
+	leaseExpiries []time.Time
+	released      []string
+}
+
+func (f *fakeNodeTainter) dedicate(nodeName string, owner types.UID, leaseExpiry time.Time) {
+	if f.dedicated == nil {
+		f.dedicated = map[string]types.UID{}
+	}
+	f.dedicated[nodeName] = owner
+	f.leaseExpiries = append(f.leaseExpiries, leaseExpiry)
+}
+
+func (f *fakeNodeTainter) release(nodeName string) {
+	f.released = append(f.released, nodeName)
+}
+
+func newJob(name string, dedicated bool, tasks ...*pod_info.PodInfo) *podgroup_info.PodGroupInfo {
+	job := podgroup_info.NewPodGroupInfo(common_info.PodGroupID(name))
+	podGroup := &enginev2alpha2.PodGroup{ObjectMeta: metav1.ObjectMeta{
+		Name:      name,
+		Namespace: "ns",
+		UID:       types.UID(name),
+	}}
+	if dedicated {
+		podGroup.Annotations = map[string]string{constants.DedicatedNodes: "true"}
+	}
+	job.SetPodGroup(podGroup)
+	for _, task := range tasks {
+		task.Job = job.UID
+		job.AddTaskInfo(task)
+	}
+	return job
+}
+
+func newTask(name, nodeName string, status pod_status.PodStatus) *pod_info.PodInfo {
+	return &pod_info.PodInfo{
+		UID:       common_info.PodID(name),
+		Name:      name,
+		Namespace: "ns",
+		NodeName:  nodeName,
+		Status:    status,
+		Pod:       &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"}},
+	}
+}
+
+func newNode(name string, owner types.UID, leaseExpiry time.Time) *node_info.NodeInfo {
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if owner != "" {
+		node.Spec.Taints = []v1.Taint{newDedicatedNodeTaint(owner)}
+		node.Annotations = map[string]string{
+			constants.DedicatedNodeLeaseExpiry: leaseExpiry.Format(time.RFC3339),
+		}
+	}
+	return &node_info.NodeInfo{Name: name, Node: node}
+}
+
+func nodesMap(nodes ...*node_info.NodeInfo) map[string]*node_info.NodeInfo {
+	result := map[string]*node_info.NodeInfo{}
+	for _, node := range nodes {
+		result[node.Name] = node
+	}
+	return result
+}
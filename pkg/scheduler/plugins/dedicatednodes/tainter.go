@@ -0,0 +1,165 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package dedicatednodes
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const requestTimeout = 10 * time.Second
+
+type nodeTainter interface {
+	// dedicate taints the node for the pod group, with a lease that expires at the given time
+	dedicate(nodeName string, owner types.UID, leaseExpiry time.Time)
+	// release removes the dedicated node taint and its lease from the node
+	release(nodeName string)
+}
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=update
+
+const (
+	tainterWorkers    = 4
+	tainterMaxRetries = 5
+)
+
+var (
+	sharedTainterOnce sync.Once
+	sharedTainter     *kubeNodeTainter
+)
+
+// sharedKubeNodeTainter returns the tainter of the scheduler process. The plugin is created for every session, while
+// the updates of the taints outlive the session that requested them.
+func sharedKubeNodeTainter(kubeClient kubernetes.Interface) *kubeNodeTainter {
+	sharedTainterOnce.Do(func() {
+		sharedTainter = newKubeNodeTainter(kubeClient)
+	})
+	return sharedTainter
+}
+
+// kubeNodeTainter updates the taints of the nodes in the background, from a workqueue of node names. Only the latest
+// requested update of a node is applied, and a node is updated by a single worker at a time. The taints of a node are a
+// list that can't be merged by a patch, so the node is updated, and the update is retried on conflicts with other
+// writers.
+type kubeNodeTainter struct {
+	kubeClient kubernetes.Interface
+	queue      workqueue.TypedRateLimitingInterface[string]
+
+	mutex sync.Mutex
+	// pendingUpdates are the updates that weren't applied yet, by node name
+	pendingUpdates map[string]func(node *v1.Node)
+}
+
+func newKubeNodeTainter(kubeClient kubernetes.Interface) *kubeNodeTainter {
+	t := &kubeNodeTainter{
+		kubeClient: kubeClient,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "dedicated-node-tainter"},
+		),
+		pendingUpdates: map[string]func(node *v1.Node){},
+	}
+	for range tainterWorkers {
+		go t.runWorker()
+	}
+	return t
+}
+
+func (t *kubeNodeTainter) dedicate(nodeName string, owner types.UID, leaseExpiry time.Time) {
+	t.enqueue(nodeName, func(node *v1.Node) {
+		node.Spec.Taints = append(slices.DeleteFunc(node.Spec.Taints, isDedicatedNodeTaint),
+			newDedicatedNodeTaint(owner))
+		if node.Annotations == nil {
+			node.Annotations = map[string]string{}
+		}
+		node.Annotations[constants.DedicatedNodeLeaseExpiry] = leaseExpiry.UTC().Format(time.RFC3339)
+	})
+}
+
+func (t *kubeNodeTainter) release(nodeName string) {
+	t.enqueue(nodeName, func(node *v1.Node) {
+		node.Spec.Taints = slices.DeleteFunc(node.Spec.Taints, isDedicatedNodeTaint)
+		delete(node.Annotations, constants.DedicatedNodeLeaseExpiry)
+	})
+}
+
+func (t *kubeNodeTainter) stop() {
+	t.queue.ShutDown()
+}
+
+func (t *kubeNodeTainter) enqueue(nodeName string, update func(node *v1.Node)) {
+	t.mutex.Lock()
+	t.pendingUpdates[nodeName] = update
+	t.mutex.Unlock()
+	t.queue.Add(nodeName)
+}
+
+func (t *kubeNodeTainter) runWorker() {
+	for t.processNextNode() {
+	}
+}
+
+func (t *kubeNodeTainter) processNextNode() bool {
+	nodeName, shutdown := t.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer t.queue.Done(nodeName)
+
+	t.mutex.Lock()
+	update := t.pendingUpdates[nodeName]
+	delete(t.pendingUpdates, nodeName)
+	t.mutex.Unlock()
+	if update == nil {
+		t.queue.Forget(nodeName)
+		return true
+	}
+
+	err := t.updateNode(nodeName, update)
+	if err == nil || errors.IsNotFound(err) {
+		t.queue.Forget(nodeName)
+		return true
+	}
+	if t.queue.NumRequeues(nodeName) >= tainterMaxRetries {
+		log.InfraLogger.Errorf("Failed to update the dedicated node taint of node <%s>: %v", nodeName, err)
+		t.queue.Forget(nodeName)
+		return true
+	}
+
+	// A newer update of the node replaces the failed one
+	t.mutex.Lock()
+	if _, found := t.pendingUpdates[nodeName]; !found {
+		t.pendingUpdates[nodeName] = update
+	}
+	t.mutex.Unlock()
+	t.queue.AddRateLimited(nodeName)
+	return true
+}
+
+func (t *kubeNodeTainter) updateNode(nodeName string, update func(node *v1.Node)) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		node, err := t.kubeClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		update(node)
+		_, err = t.kubeClient.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+		return err
+	})
+}
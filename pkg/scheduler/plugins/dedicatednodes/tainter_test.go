@@ -0,0 +1,70 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package dedicatednodes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+)
+
+func TestKubeNodeTainter(t *testing.T) {
+	otherTaint := v1.Taint{Key: "other", Effect: v1.TaintEffectNoExecute}
+	kubeClient := kubefake.NewClientset(&v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-a"},
+		Spec:       v1.NodeSpec{Taints: []v1.Taint{otherTaint}},
+	})
+	tainter := newKubeNodeTainter(kubeClient)
+	defer tainter.stop()
+
+	getNode := func() *v1.Node {
+		node, err := kubeClient.CoreV1().Nodes().Get(context.Background(), "node-a", metav1.GetOptions{})
+		require.NoError(t, err)
+		return node
+	}
+
+	tainter.dedicate("node-a", "a", now)
+	assert.Eventually(t, func() bool {
+		return leaseHolder(getNode(), now.Add(-time.Minute)) == "a"
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Contains(t, getNode().Spec.Taints, otherTaint)
+
+	tainter.dedicate("node-a", "b", now)
+	tainter.release("node-a")
+	assert.Eventually(t, func() bool {
+		node := getNode()
+		return dedicatedNodeTaint(node) == nil && node.Annotations[constants.DedicatedNodeLeaseExpiry] == ""
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []v1.Taint{otherTaint}, getNode().Spec.Taints)
+}
+
+func TestKubeNodeTainterRetriesFailedUpdates(t *testing.T) {
+	kubeClient := kubefake.NewClientset(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-a"}})
+	failures := 2
+	kubeClient.PrependReactor("update", "nodes", func(k8stesting.Action) (bool, runtime.Object, error) {
+		if failures > 0 {
+			failures--
+			return true, nil, assert.AnError
+		}
+		return false, nil, nil
+	})
+	tainter := newKubeNodeTainter(kubeClient)
+	defer tainter.stop()
+
+	tainter.dedicate("node-a", "a", now)
+	assert.Eventually(t, func() bool {
+		node, err := kubeClient.CoreV1().Nodes().Get(context.Background(), "node-a", metav1.GetOptions{})
+		return err == nil && dedicatedNodeTaint(node) != nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
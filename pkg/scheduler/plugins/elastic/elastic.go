@@ -4,8 +4,14 @@
 package elastic
 
 import (
+	"k8s.io/utils/ptr"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
 )
 
 type elasticPlugin struct{}
@@ -64,4 +70,57 @@ func minAvailableState(pgi *podgroup_info.PodGroupInfo) (bool, bool, bool) {
 	return false, !exactlyAtMinAvailable, exactlyAtMinAvailable
 }
 
-func (pp *elasticPlugin) OnSessionClose(_ *framework.Session) {}
+// OnSessionClose estimates how many pods of every elastic job could be placed once the session's allocations are
+// known, so that the controllers of the jobs can scale them to what fits
+func (pp *elasticPlugin) OnSessionClose(ssn *framework.Session) {
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		job.PlaceableReplicas = placeableReplicas(ssn, job)
+	}
+}
+
+// placeableReplicas returns the number of allocated pods of the job, and of copies of one of its pods that fit the
+// idle resources of the nodes it passes the predicates on. Jobs that are not elastic and didn't ask for a placement
+// preview return nil.
+func placeableReplicas(ssn *framework.Session, job *podgroup_info.PodGroupInfo) *int32 {
+	if !job.IsElastic() && (job.PodGroup == nil || job.PodGroup.Annotations[constants.PlacementPreview] != "true") {
+		return nil
+	}
+	replica, replicas := replicaTask(job)
+	if replica == nil {
+		return nil
+	}
+
+	if err := ssn.PrePredicateFn(replica, job); err == nil {
+		for _, node := range ssn.ClusterInfo.Nodes {
+			if ssn.FittingNode(replica, node, false) {
+				replicas += node.NumTaskCopiesAllocatable(replica)
+			}
+		}
+	}
+	log.InfraLogger.V(6).Infof("%d replicas of job <%s/%s> can be placed", replicas, job.Namespace, job.Name)
+	return ptr.To(int32(replicas))
+}
+
+// replicaTask returns the pod that new pods of the job are expected to be copies of, a pending pod if the job has
+// one or else an allocated one, and the number of allocated pods of the job
+func replicaTask(job *podgroup_info.PodGroupInfo) (*pod_info.PodInfo, int) {
+	var pending, allocated *pod_info.PodInfo
+	numAllocated := 0
+	for _, task := range job.GetAllPodsMap() {
+		switch {
+		case task.Status == pod_status.Pending:
+			if pending == nil || task.Name < pending.Name {
+				pending = task
+			}
+		case pod_status.IsActiveAllocatedStatus(task.Status):
+			numAllocated++
+			if allocated == nil || task.Name < allocated.Name {
+				allocated = task
+			}
+		}
+	}
+	if pending != nil {
+		return pending, numAllocated
+	}
+	return allocated, numAllocated
+}
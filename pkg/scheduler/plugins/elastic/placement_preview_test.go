@@ -0,0 +1,90 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package elastic_test
+
+import (
+	"testing"
+
+	. "go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info/subgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/elastic"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func TestPlaceableReplicas(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+
+	for testNumber, testData := range []struct {
+		name                      string
+		rootSubGroupSet           *subgroup_info.SubGroupSet
+		placementPreview          bool
+		expectedPlaceableReplicas *int32
+	}{
+		{
+			name:                      "elastic job",
+			rootSubGroupSet:           jobs_fake.DefaultSubGroup(1),
+			expectedPlaceableReplicas: ptr.To(int32(6)),
+		},
+		{
+			name:            "gang job",
+			rootSubGroupSet: jobs_fake.DefaultSubGroup(2),
+		},
+		{
+			name:                      "gang job with a placement preview",
+			rootSubGroupSet:           jobs_fake.DefaultSubGroup(2),
+			placementPreview:          true,
+			expectedPlaceableReplicas: ptr.To(int32(6)),
+		},
+	} {
+		t.Logf("Running test %d: %s", testNumber, testData.name)
+
+		topology := test_utils.TestTopologyBasic{
+			Name: testData.name,
+			Jobs: []*jobs_fake.TestJobBasic{
+				{
+					Name:                "job0",
+					RequiredGPUsPerTask: 1,
+					QueueName:           "queue0",
+					RootSubGroupSet:     testData.rootSubGroupSet,
+					Tasks: []*tasks_fake.TestTaskBasic{
+						{State: pod_status.Running, NodeName: "node0"},
+						{State: pod_status.Pending},
+					},
+				},
+			},
+			Nodes: map[string]nodes_fake.TestNodeBasic{
+				"node0": {GPUs: 4},
+				"node1": {GPUs: 2},
+			},
+			Queues: []test_utils.TestQueueBasic{
+				{
+					Name:         "queue0",
+					DeservedGPUs: 6,
+				},
+			},
+		}
+
+		ssn := test_utils.BuildSession(topology, controller)
+		job := ssn.ClusterInfo.PodGroupInfos["job0"]
+		if testData.placementPreview {
+			job.PodGroup.Annotations = map[string]string{constants.PlacementPreview: "true"}
+		}
+
+		elastic.New(nil).OnSessionClose(ssn)
+
+		if !ptr.Equal(job.PlaceableReplicas, testData.expectedPlaceableReplicas) {
+			t.Errorf("test %d: expected %v placeable replicas, got %v", testNumber,
+				ptr.Deref(testData.expectedPlaceableReplicas, -1), ptr.Deref(job.PlaceableReplicas, -1))
+		}
+	}
+}
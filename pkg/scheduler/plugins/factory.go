@@ -21,25 +21,39 @@ package plugins
 
 import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/constraintrelaxation"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/datasetlocality"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/dedicatednodes"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/dynamicresources"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/elastic"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/gangstartskew"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/gpupack"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/gpusharingorder"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/gpuspread"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/imageprepull"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/inflightallocations"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/kubeflow"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/minruntime"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/nodeavailability"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/nodeplacement"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/nodeusage"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/nominatednode"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/podaffinity"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/poddisruptionbudget"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/predicates"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/preferrednodeaffinity"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/priority"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/ray"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/reflectjoborder"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/releasesimulation"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/requeueboost"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/resourcetype"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/snapshot"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/starttimeprediction"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/stickyplacement"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/subgrouporder"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/subgroupreadiness"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/taskorder"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/topology"
 )
@@ -56,20 +70,44 @@ func InitDefaultPlugins() {
 	framework.RegisterPluginBuilder("gpuspread", gpuspread.New)
 	framework.RegisterPluginBuilder("resourcetype", resourcetype.New)
 	framework.RegisterPluginBuilder("podaffinity", podaffinity.New)
+	framework.RegisterPluginBuilder("preferrednodeaffinity", preferrednodeaffinity.New)
 	framework.RegisterPluginBuilder("elastic", elastic.New)
 	framework.RegisterPluginBuilder("kubeflow", kubeflow.New)
 	framework.RegisterPluginBuilder("ray", ray.New)
 	framework.RegisterPluginBuilder("taskorder", taskorder.New)
 	framework.RegisterPluginBuilder("subgrouporder", subgrouporder.New)
+	framework.RegisterPluginBuilder("subgroupreadiness", subgroupreadiness.New)
+	framework.RegisterPluginArgumentsValidator("subgroupreadiness", subgroupreadiness.ValidateArguments)
 	framework.RegisterPluginBuilder("dynamicresources", dynamicresources.New)
 	framework.RegisterPluginBuilder("topology", topology.New)
+	framework.RegisterPluginBuilder("nodeusage", nodeusage.New)
+	framework.RegisterPluginArgumentsValidator("nodeusage", nodeusage.ValidateArguments)
+	framework.RegisterPluginBuilder("imageprepull", imageprepull.New)
+	framework.RegisterPluginArgumentsValidator("imageprepull", imageprepull.ValidateArguments)
+	framework.RegisterPluginBuilder("dedicatednodes", dedicatednodes.New)
+	framework.RegisterPluginArgumentsValidator("dedicatednodes", dedicatednodes.ValidateArguments)
+	framework.RegisterPluginBuilder("stickyplacement", stickyplacement.New)
+	framework.RegisterPluginArgumentsValidator("stickyplacement", stickyplacement.ValidateArguments)
+	framework.RegisterPluginBuilder("datasetlocality", datasetlocality.New)
+	framework.RegisterPluginArgumentsValidator("datasetlocality", datasetlocality.ValidateArguments)
 
 	// Plugins for Queues
 	framework.RegisterPluginBuilder("proportion", proportion.New)
+	framework.RegisterPluginArgumentsValidator("proportion", proportion.ValidateArguments)
 	framework.RegisterPluginBuilder("minruntime", minruntime.New)
+	framework.RegisterPluginBuilder("poddisruptionbudget", poddisruptionbudget.New)
+	framework.RegisterPluginArgumentsValidator("poddisruptionbudget", poddisruptionbudget.ValidateArguments)
 
 	// Other Plugins
 	framework.RegisterPluginBuilder("snapshot", snapshot.New)
+	framework.RegisterPluginBuilder("starttimeprediction", starttimeprediction.New)
+	framework.RegisterPluginBuilder("gangstartskew", gangstartskew.New)
+	framework.RegisterPluginArgumentsValidator("gangstartskew", gangstartskew.ValidateArguments)
+	framework.RegisterPluginBuilder("releasesimulation", releasesimulation.New)
+	framework.RegisterPluginBuilder("inflightallocations", inflightallocations.New)
+	framework.RegisterPluginBuilder("constraintrelaxation", constraintrelaxation.New)
+	framework.RegisterPluginBuilder("requeueboost", requeueboost.New)
+	framework.RegisterPluginArgumentsValidator("requeueboost", requeueboost.ValidateArguments)
 
 	// Always register the Job Order Plugin last.
 	framework.RegisterPluginBuilder("reflectjoborder", reflectjoborder.New)
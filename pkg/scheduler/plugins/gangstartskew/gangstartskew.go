@@ -0,0 +1,188 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gangstartskew
+
+import (
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const (
+	pluginName     = "gangstartskew"
+	defaultMaxSkew = time.Minute
+)
+
+type gangStartSkewPlugin struct {
+	maxSkew  time.Duration
+	evict    bool
+	reported *reportedGangs
+}
+
+func New(arguments framework.PluginArguments) framework.Plugin {
+	maxSkew, err := arguments.GetDuration("maxSkew", defaultMaxSkew)
+	if err != nil || maxSkew <= 0 {
+		log.InfraLogger.Warningf("maxSkew must be a positive duration, got %q. Using default value of %s",
+			arguments["maxSkew"], defaultMaxSkew)
+		maxSkew = defaultMaxSkew
+	}
+	evict, err := arguments.GetBool("evict", false)
+	if err != nil {
+		log.InfraLogger.Warningf("Failed to parse evict: %v. Using default value of false", err)
+	}
+
+	return &gangStartSkewPlugin{
+		maxSkew: maxSkew,
+		evict:   evict,
+	}
+}
+
+// ValidateArguments rejects gangstartskew plugin arguments that can't be parsed
+func ValidateArguments(arguments framework.PluginArguments) error {
+	maxSkew, err := arguments.GetDuration("maxSkew", defaultMaxSkew)
+	if err != nil {
+		return fmt.Errorf("invalid maxSkew: %w", err)
+	}
+	if maxSkew <= 0 {
+		return fmt.Errorf("maxSkew must be positive, got %s", maxSkew)
+	}
+	if _, err := arguments.GetBool("evict", false); err != nil {
+		return fmt.Errorf("invalid evict: %w", err)
+	}
+	return nil
+}
+
+func (gsp *gangStartSkewPlugin) Name() string {
+	return pluginName
+}
+
+func (gsp *gangStartSkewPlugin) OnSessionOpen(ssn *framework.Session) {
+	gsp.reported = ssn.PluginState(pluginName, func() any { return newReportedGangs() }).(*reportedGangs)
+	// Shadow sessions report on a copy, so that the gang starts are still reported by the primary session
+	if ssn.IsShadow() {
+		gsp.reported = gsp.reported.clone()
+	}
+	now := ssn.Clock().Now()
+	gsp.reported.prune(ssn.ClusterInfo.PodGroupInfos)
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		startSkew := measureStartSkew(job, now, gsp.maxSkew)
+		if startSkew == nil || !gsp.reported.add(job.UID, *job.LastStartTimestamp) {
+			continue
+		}
+		log.InfraLogger.V(3).Infof("Pods of job <%s> started %s apart, more than the allowed %s. Slowest pod: <%s>",
+			job.NamespacedName, startSkew.Skew, startSkew.MaxSkew, startSkew.SlowestTask)
+		job.StartSkew = startSkew
+		if gsp.evict {
+			evictJob(ssn, job, startSkew)
+		}
+	}
+}
+
+func (gsp *gangStartSkewPlugin) OnSessionClose(_ *framework.Session) {}
+
+// measureStartSkew returns how far apart the pods of the job's last gang start started, if it's more than maxSkew.
+// Pods that are bound but haven't started yet count as starting now, so that slow joiners are caught before they
+// start. Pods created after the gang started, such as replacements of failed pods, are not part of the gang start.
+func measureStartSkew(job *podgroup_info.PodGroupInfo, now time.Time, maxSkew time.Duration,
+) *podgroup_info.StartSkewInfo {
+	if job.LastStartTimestamp == nil {
+		return nil
+	}
+
+	var firstStart, lastStart time.Time
+	var lastTask, notStartedTask *pod_info.PodInfo
+	for _, task := range job.GetAllPodsMap() {
+		if !pod_status.IsActiveAllocatedStatus(task.Status) || task.Pod == nil ||
+			task.Pod.CreationTimestamp.After(*job.LastStartTimestamp) {
+			continue
+		}
+		startTime, started := podStartTime(task.Pod)
+		if !started {
+			notStartedTask = task
+			continue
+		}
+		if startTime.IsZero() {
+			continue
+		}
+		if firstStart.IsZero() || startTime.Before(firstStart) {
+			firstStart = startTime
+		}
+		if lastTask == nil || startTime.After(lastStart) {
+			lastStart = startTime
+			lastTask = task
+		}
+	}
+	if lastTask == nil {
+		return nil
+	}
+	if notStartedTask != nil {
+		lastStart = now
+		lastTask = notStartedTask
+	}
+
+	skew := lastStart.Sub(firstStart)
+	if skew <= maxSkew {
+		return nil
+	}
+	return &podgroup_info.StartSkewInfo{
+		Skew:        skew,
+		MaxSkew:     maxSkew,
+		SlowestTask: fmt.Sprintf("%s/%s", lastTask.Namespace, lastTask.Name),
+	}
+}
+
+// podStartTime returns the time the first container of the pod started. The start time of pods whose containers
+// restarted is unknown, and is returned as zero.
+func podStartTime(pod *v1.Pod) (time.Time, bool) {
+	var startTime time.Time
+	started := false
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.RestartCount > 0 {
+			return time.Time{}, true
+		}
+		var containerStart time.Time
+		switch {
+		case status.State.Running != nil:
+			containerStart = status.State.Running.StartedAt.Time
+		case status.State.Terminated != nil:
+			containerStart = status.State.Terminated.StartedAt.Time
+		default:
+			continue
+		}
+		if !started || containerStart.Before(startTime) {
+			startTime = containerStart
+		}
+		started = true
+	}
+	return startTime, started
+}
+
+func evictJob(ssn *framework.Session, job *podgroup_info.PodGroupInfo, startSkew *podgroup_info.StartSkewInfo) {
+	var tasksToEvict []*pod_info.PodInfo
+	for _, task := range job.GetAllPodsMap() {
+		if pod_status.IsActiveAllocatedStatus(task.Status) {
+			tasksToEvict = append(tasksToEvict, task)
+		}
+	}
+	evictionMetadata := eviction_info.EvictionMetadata{
+		EvictionGangSize: len(tasksToEvict),
+		Action:           pluginName,
+		Preemptor:        nil,
+	}
+	for _, task := range tasksToEvict {
+		if err := ssn.Evict(task, api.GetStartSkewEvictionMessage(task, startSkew), evictionMetadata); err != nil {
+			log.InfraLogger.Errorf("Failed to evict task <%s/%s> of job <%s>: %v",
+				task.Namespace, task.Name, job.NamespacedName, err)
+		}
+	}
+}
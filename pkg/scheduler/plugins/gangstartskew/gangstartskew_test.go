@@ -0,0 +1,197 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gangstartskew
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+type testTask struct {
+	name         string
+	status       pod_status.PodStatus
+	createdAfter time.Duration
+	startedAfter *time.Duration
+	restarted    bool
+}
+
+func Test_measureStartSkew(t *testing.T) {
+	gangStart := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	maxSkew := time.Minute
+	seconds := func(s int) *time.Duration { d := time.Duration(s) * time.Second; return &d }
+
+	tests := []struct {
+		name     string
+		tasks    []testTask
+		now      time.Time
+		noStart  bool
+		expected *podgroup_info.StartSkewInfo
+	}{
+		{
+			name: "pods started within the allowed skew",
+			tasks: []testTask{
+				{name: "a", status: pod_status.Running, startedAfter: seconds(10)},
+				{name: "b", status: pod_status.Running, startedAfter: seconds(60)},
+			},
+			now: gangStart.Add(time.Hour),
+		},
+		{
+			name: "pods started further apart than the allowed skew",
+			tasks: []testTask{
+				{name: "a", status: pod_status.Running, startedAfter: seconds(10)},
+				{name: "b", status: pod_status.Running, startedAfter: seconds(100)},
+			},
+			now:      gangStart.Add(time.Hour),
+			expected: &podgroup_info.StartSkewInfo{Skew: 90 * time.Second, MaxSkew: maxSkew, SlowestTask: "ns/b"},
+		},
+		{
+			name: "bound pod that hasn't started within the allowed skew",
+			tasks: []testTask{
+				{name: "a", status: pod_status.Running, startedAfter: seconds(10)},
+				{name: "b", status: pod_status.Bound},
+			},
+			now:      gangStart.Add(80 * time.Second),
+			expected: &podgroup_info.StartSkewInfo{Skew: 70 * time.Second, MaxSkew: maxSkew, SlowestTask: "ns/b"},
+		},
+		{
+			name: "bound pod that may still start within the allowed skew",
+			tasks: []testTask{
+				{name: "a", status: pod_status.Running, startedAfter: seconds(10)},
+				{name: "b", status: pod_status.Bound},
+			},
+			now: gangStart.Add(30 * time.Second),
+		},
+		{
+			name: "no pod started yet",
+			tasks: []testTask{
+				{name: "a", status: pod_status.Bound},
+				{name: "b", status: pod_status.Bound},
+			},
+			now: gangStart.Add(time.Hour),
+		},
+		{
+			name: "replacement pod created after the gang started",
+			tasks: []testTask{
+				{name: "a", status: pod_status.Running, startedAfter: seconds(10)},
+				{name: "b", status: pod_status.Bound, createdAfter: time.Hour},
+			},
+			now: gangStart.Add(2 * time.Hour),
+		},
+		{
+			name: "pod whose containers restarted",
+			tasks: []testTask{
+				{name: "a", status: pod_status.Running, startedAfter: seconds(10)},
+				{name: "b", status: pod_status.Running, startedAfter: seconds(600), restarted: true},
+			},
+			now: gangStart.Add(time.Hour),
+		},
+		{
+			name: "released pods are not part of the gang",
+			tasks: []testTask{
+				{name: "a", status: pod_status.Running, startedAfter: seconds(10)},
+				{name: "b", status: pod_status.Releasing, startedAfter: seconds(600)},
+			},
+			now: gangStart.Add(time.Hour),
+		},
+		{
+			name: "job that never started",
+			tasks: []testTask{
+				{name: "a", status: pod_status.Running, startedAfter: seconds(10)},
+				{name: "b", status: pod_status.Running, startedAfter: seconds(600)},
+			},
+			now:     gangStart.Add(time.Hour),
+			noStart: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := newJob(gangStart, tt.tasks)
+			if tt.noStart {
+				job.LastStartTimestamp = nil
+			}
+			assert.Equal(t, tt.expected, measureStartSkew(job, tt.now, maxSkew))
+		})
+	}
+}
+
+func TestReportedGangs(t *testing.T) {
+	gangStart := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	r := newReportedGangs()
+
+	assert.True(t, r.add("job", gangStart))
+	assert.False(t, r.add("job", gangStart))
+	assert.True(t, r.add("job", gangStart.Add(time.Hour)), "a new gang start of the job is reported again")
+
+	r.prune(map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{})
+	assert.True(t, r.add("job", gangStart.Add(time.Hour)), "pruned jobs are forgotten")
+}
+
+func TestValidateArguments(t *testing.T) {
+	tests := []struct {
+		name      string
+		arguments framework.PluginArguments
+		expectErr bool
+	}{
+		{name: "defaults", arguments: framework.PluginArguments{}},
+		{name: "valid arguments", arguments: framework.PluginArguments{"maxSkew": "30s", "evict": "true"}},
+		{name: "invalid maxSkew", arguments: framework.PluginArguments{"maxSkew": "soon"}, expectErr: true},
+		{name: "non positive maxSkew", arguments: framework.PluginArguments{"maxSkew": "0s"}, expectErr: true},
+		{name: "invalid evict", arguments: framework.PluginArguments{"evict": "maybe"}, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateArguments(tt.arguments)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func newJob(gangStart time.Time, tasks []testTask) *podgroup_info.PodGroupInfo {
+	job := podgroup_info.NewPodGroupInfo("job")
+	job.LastStartTimestamp = &gangStart
+	for _, task := range tasks {
+		pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:              task.name,
+			Namespace:         "ns",
+			CreationTimestamp: metav1.NewTime(gangStart.Add(-time.Minute + task.createdAfter)),
+		}}
+		if task.startedAfter != nil {
+			restartCount := int32(0)
+			if task.restarted {
+				restartCount = 1
+			}
+			pod.Status.ContainerStatuses = []v1.ContainerStatus{{
+				State: v1.ContainerState{Running: &v1.ContainerStateRunning{
+					StartedAt: metav1.NewTime(gangStart.Add(*task.startedAfter)),
+				}},
+				RestartCount: restartCount,
+			}}
+		}
+		job.AddTaskInfo(&pod_info.PodInfo{
+			UID:       common_info.PodID(task.name),
+			Job:       "job",
+			Name:      task.name,
+			Namespace: "ns",
+			Status:    task.status,
+			Pod:       pod,
+		})
+	}
+	return job
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gangstartskew
+
+import (
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+)
+
+// reportedGangs tracks the gang starts that were already reported across scheduling sessions, so that every gang
+// start is alerted on, or evicted, once. A gang start is identified by the job and its last start timestamp.
+type reportedGangs struct {
+	mutex  sync.Mutex
+	starts map[common_info.PodGroupID]time.Time
+}
+
+func newReportedGangs() *reportedGangs {
+	return &reportedGangs{
+		starts: map[common_info.PodGroupID]time.Time{},
+	}
+}
+
+// add records the gang start and returns false if it was already reported
+func (r *reportedGangs) add(jobID common_info.PodGroupID, startTime time.Time) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if reportedStart, found := r.starts[jobID]; found && reportedStart.Equal(startTime) {
+		return false
+	}
+	r.starts[jobID] = startTime
+	return true
+}
+
+// clone returns a copy of the reported gang starts
+func (r *reportedGangs) clone() *reportedGangs {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return &reportedGangs{starts: maps.Clone(r.starts)}
+}
+
+// prune forgets the jobs that don't exist anymore
+func (r *reportedGangs) prune(jobs map[common_info.PodGroupID]*podgroup_info.PodGroupInfo) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for jobID := range r.starts {
+		if _, found := jobs[jobID]; !found {
+			delete(r.starts, jobID)
+		}
+	}
+}
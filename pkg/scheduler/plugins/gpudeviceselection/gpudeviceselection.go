@@ -0,0 +1,68 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gpudeviceselection
+
+import (
+	"fmt"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// OrderFn returns a GPU order function that scores the devices by the GPU device selection policy of the task's job,
+// and by defaultOrderFn for jobs that don't set a policy.
+func OrderFn(ssn *framework.Session, defaultOrderFn api.GpuOrderFn) api.GpuOrderFn {
+	return func(task *pod_info.PodInfo, node *node_info.NodeInfo, gpuIdx string) (float64, error) {
+		job, found := ssn.ClusterInfo.PodGroupInfos[task.Job]
+		if !found || job.GPUDeviceSelection == "" {
+			return defaultOrderFn(task, node, gpuIdx)
+		}
+		return Score(job.GPUDeviceSelection, task, node, gpuIdx)
+	}
+}
+
+// Score returns the score of a device of the node for the task by the policy. Pack scores devices by their used
+// portion, so that fractions fill shared devices first, and spread by their free portion, with whole GPUs scored
+// as free devices.
+func Score(
+	policy enginev2.GPUDeviceSelectionPolicy, task *pod_info.PodInfo, node *node_info.NodeInfo, gpuIdx string,
+) (float64, error) {
+	if gpuIdx == pod_info.WholeGpuIndicator {
+		return wholeGpuScore(policy)
+	}
+
+	usedGpuPortion, err := node.GetUsedGpuPortion(gpuIdx)
+	if err != nil {
+		return 0, err
+	}
+
+	var score float64
+	switch policy {
+	case enginev2.GPUDeviceSelectionPack:
+		score = usedGpuPortion
+	case enginev2.GPUDeviceSelectionSpread:
+		score = 1 - usedGpuPortion
+	default:
+		return 0, fmt.Errorf("unknown GPU device selection policy <%s>", policy)
+	}
+	log.InfraLogger.V(7).Infof(
+		"Estimating Task: <%v/%v> Job: <%v> for gpuIdx: <%s> on node: <%s> with policy <%s>. Score: %f",
+		task.Namespace, task.Name, task.Job, gpuIdx, node.Name, policy, score)
+	return score, nil
+}
+
+func wholeGpuScore(policy enginev2.GPUDeviceSelectionPolicy) (float64, error) {
+	switch policy {
+	case enginev2.GPUDeviceSelectionPack:
+		return 0, nil
+	case enginev2.GPUDeviceSelectionSpread:
+		return 1, nil
+	default:
+		return 0, fmt.Errorf("unknown GPU device selection policy <%s>", policy)
+	}
+}
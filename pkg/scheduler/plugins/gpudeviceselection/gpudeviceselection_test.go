@@ -0,0 +1,82 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package gpudeviceselection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+func TestScore(t *testing.T) {
+	node := &node_info.NodeInfo{
+		Name:                   "node",
+		MemoryOfEveryGpuOnNode: 1000,
+		GpuSharingNodeInfo: node_info.GpuSharingNodeInfo{
+			UsedSharedGPUsMemory: map[string]int64{"shared": 250},
+		},
+	}
+	task := pod_info.NewTaskInfo(&v1.Pod{})
+
+	tests := []struct {
+		name     string
+		policy   enginev2.GPUDeviceSelectionPolicy
+		gpuIdx   string
+		expected float64
+		wantErr  bool
+	}{
+		{name: "pack shared device", policy: enginev2.GPUDeviceSelectionPack, gpuIdx: "shared", expected: 0.25},
+		{name: "pack whole device", policy: enginev2.GPUDeviceSelectionPack, gpuIdx: pod_info.WholeGpuIndicator},
+		{name: "spread shared device", policy: enginev2.GPUDeviceSelectionSpread, gpuIdx: "shared", expected: 0.75},
+		{name: "spread whole device", policy: enginev2.GPUDeviceSelectionSpread, gpuIdx: pod_info.WholeGpuIndicator,
+			expected: 1},
+		{name: "unknown policy", policy: "Scatter", gpuIdx: "shared", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, err := Score(tt.policy, task, node, tt.gpuIdx)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.expected, score)
+		})
+	}
+}
+
+func TestOrderFn(t *testing.T) {
+	node := &node_info.NodeInfo{
+		Name:                   "node",
+		MemoryOfEveryGpuOnNode: 1000,
+		GpuSharingNodeInfo: node_info.GpuSharingNodeInfo{
+			UsedSharedGPUsMemory: map[string]int64{"shared": 250},
+		},
+	}
+	spreadJob := podgroup_info.NewPodGroupInfo("spread-job")
+	spreadJob.GPUDeviceSelection = enginev2.GPUDeviceSelectionSpread
+	ssn := &framework.Session{ClusterInfo: &api.ClusterInfo{
+		PodGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{
+			"spread-job":  spreadJob,
+			"default-job": podgroup_info.NewPodGroupInfo("default-job"),
+		},
+	}}
+	packOrderFn := func(task *pod_info.PodInfo, node *node_info.NodeInfo, gpuIdx string) (float64, error) {
+		return Score(enginev2.GPUDeviceSelectionPack, task, node, gpuIdx)
+	}
+	orderFn := OrderFn(ssn, packOrderFn)
+
+	score, err := orderFn(&pod_info.PodInfo{Job: "default-job"}, node, "shared")
+	assert.NoError(t, err)
+	assert.Equal(t, 0.25, score, "jobs without a policy are scored by the default order function")
+
+	score, err = orderFn(&pod_info.PodInfo{Job: "spread-job"}, node, "shared")
+	assert.NoError(t, err)
+	assert.Equal(t, 0.75, score, "the policy of the job overrides the default order function")
+}
@@ -4,10 +4,11 @@
 package gpupack
 
 import (
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
-	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/gpudeviceselection"
 )
 
 const pluginName = "gpupack"
@@ -23,24 +24,11 @@ func (gpp *gpuPackPlugin) Name() string {
 }
 
 func (gpp *gpuPackPlugin) OnSessionOpen(ssn *framework.Session) {
-	ssn.AddGPUOrderFn(gpuOrderFn)
+	ssn.AddGPUOrderFn(gpudeviceselection.OrderFn(ssn, gpuOrderFn))
 }
 
 func (gpp *gpuPackPlugin) OnSessionClose(_ *framework.Session) {}
 
 func gpuOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo, gpuIdx string) (float64, error) {
-	if gpuIdx == pod_info.WholeGpuIndicator {
-		return 0, nil
-	}
-
-	usedGpuPortion, err := node.GetUsedGpuPortion(gpuIdx)
-	if err != nil {
-		return 0, err
-	}
-
-	score := usedGpuPortion
-	log.InfraLogger.V(7).Infof(
-		"Estimating Task: <%v/%v> Job: <%v> for gpuIdx: <%s> on node: <%s>. Score: %f",
-		task.Namespace, task.Name, task.Job, gpuIdx, node.Name, score)
-	return score, nil
+	return gpudeviceselection.Score(enginev2.GPUDeviceSelectionPack, task, node, gpuIdx)
 }
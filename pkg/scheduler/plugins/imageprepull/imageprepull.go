@@ -0,0 +1,199 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package imageprepull
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+const (
+	pluginName     = "imageprepull"
+	defaultTimeout = 5 * time.Minute
+)
+
+type imagePrepullPlugin struct {
+	timeout time.Duration
+	waits   *prepullWaits
+
+	prepuller *prepuller
+	nodes     map[string]*node_info.NodeInfo
+	clock     clock.PassiveClock
+	// shadow is set in shadow sessions, which wait on a copy of the waits and don't create or delete pre-pull pods
+	shadow bool
+}
+
+func New(arguments framework.PluginArguments) framework.Plugin {
+	timeout, err := arguments.GetDuration("timeout", defaultTimeout)
+	if err != nil || timeout <= 0 {
+		log.InfraLogger.Warningf("timeout must be a positive duration, got %q. Using default value of %s",
+			arguments["timeout"], defaultTimeout)
+		timeout = defaultTimeout
+	}
+	return &imagePrepullPlugin{
+		timeout: timeout,
+		clock:   clock.RealClock{},
+	}
+}
+
+// ValidateArguments rejects imageprepull plugin arguments that can't be parsed
+func ValidateArguments(arguments framework.PluginArguments) error {
+	timeout, err := arguments.GetDuration("timeout", defaultTimeout)
+	if err != nil {
+		return fmt.Errorf("invalid timeout: %w", err)
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("timeout must be positive, got %s", timeout)
+	}
+	return nil
+}
+
+func (ipp *imagePrepullPlugin) Name() string {
+	return pluginName
+}
+
+func (ipp *imagePrepullPlugin) OnSessionOpen(ssn *framework.Session) {
+	ipp.prepuller = &prepuller{
+		kubeClient: ssn.Cache.KubeClient(),
+		podLister:  ssn.Cache.KubeInformerFactory().Core().V1().Pods().Lister(),
+	}
+	ipp.nodes = ssn.ClusterInfo.Nodes
+	ipp.clock = ssn.Clock()
+	ipp.waits = ssn.PluginState(pluginName, func() any { return newPrepullWaits() }).(*prepullWaits)
+	if ssn.IsShadow() {
+		ipp.shadow = true
+		ipp.waits = ipp.waits.clone()
+	}
+
+	for _, wait := range ipp.waits.prune(ssn.ClusterInfo.PodGroupInfos) {
+		if !ipp.shadow {
+			ipp.prepuller.cleanup(wait.namespace, wait.name)
+		}
+	}
+
+	ssn.AddDeferJobBindFn(ipp.deferJobBind)
+	ssn.AddNodeOrderFn(ipp.nodeOrderFn)
+}
+
+func (ipp *imagePrepullPlugin) OnSessionClose(_ *framework.Session) {}
+
+// deferJobBind defers the bind of the job while the images of its allocated tasks are missing on their nodes, and
+// pulls them. The bind is deferred up to the timeout since the job started waiting.
+func (ipp *imagePrepullPlugin) deferJobBind(job *podgroup_info.PodGroupInfo) bool {
+	var prepullPods []*v1.Pod
+	if job.PodGroup != nil {
+		prepullPods = ipp.prepuller.prepullPods(job.Namespace, job.PodGroup.Name)
+	}
+	allocatedTasks := job.GetAllPodsMap()
+	tasks := make([]*pod_info.PodInfo, 0, len(allocatedTasks))
+	for _, task := range allocatedTasks {
+		if task.Status == pod_status.Allocated {
+			tasks = append(tasks, task)
+		}
+	}
+	missing := missingImages(tasks, ipp.nodes, prepullPods)
+	if len(missing) == 0 {
+		return false
+	}
+
+	now := ipp.clock.Now()
+	since := ipp.waits.start(job, now)
+	if now.Sub(since) >= ipp.timeout {
+		log.InfraLogger.V(3).Infof("Timed out waiting for the images of job <%s> on nodes %v, binding it",
+			job.NamespacedName, sortedNodeNames(missing))
+		return false
+	}
+	if job.PodGroup == nil {
+		return false
+	}
+
+	for nodeName, images := range missing {
+		if ipp.waits.addNode(job.UID, nodeName) && !hasPrepullPod(prepullPods, nodeName) && !ipp.shadow {
+			ipp.prepuller.pull(job.PodGroup, nodeName, images, pullSecrets(tasks), ipp.timeout)
+		}
+	}
+	log.InfraLogger.V(3).Infof("Deferring the bind of job <%s> until its images are pulled on nodes %v",
+		job.NamespacedName, sortedNodeNames(missing))
+	return true
+}
+
+// nodeOrderFn prefers the nodes that pull the images of a waiting job, so the job is allocated to the same nodes
+// once its images are pulled
+func (ipp *imagePrepullPlugin) nodeOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
+	if ipp.waits.hasNode(task.Job, node.Name) {
+		return scores.ResourceType, nil
+	}
+	return 0, nil
+}
+
+// missingImages returns the images of the tasks that are missing on the tasks' nodes, by node name. Images are
+// present on a node if the node reports them, or if a pre-pull pod on the node pulled them.
+func missingImages(tasks []*pod_info.PodInfo, nodes map[string]*node_info.NodeInfo, prepullPods []*v1.Pod,
+) map[string][]string {
+	missing := map[string][]string{}
+	for _, task := range tasks {
+		if task.Pod == nil || task.NodeName == "" {
+			continue
+		}
+		node, found := nodes[task.NodeName]
+		if !found {
+			continue
+		}
+		present := nodeImages(node.Node)
+		for _, pod := range prepullPods {
+			if pod.Spec.NodeName != task.NodeName {
+				continue
+			}
+			for image := range pulledImages(pod) {
+				present[image] = true
+			}
+		}
+		for _, image := range podImages(task.Pod) {
+			if !present[image] && !slices.Contains(missing[task.NodeName], image) {
+				missing[task.NodeName] = append(missing[task.NodeName], image)
+			}
+		}
+	}
+	return missing
+}
+
+func hasPrepullPod(prepullPods []*v1.Pod, nodeName string) bool {
+	return slices.ContainsFunc(prepullPods, func(pod *v1.Pod) bool { return pod.Spec.NodeName == nodeName })
+}
+
+func pullSecrets(tasks []*pod_info.PodInfo) []v1.LocalObjectReference {
+	var secrets []v1.LocalObjectReference
+	for _, task := range tasks {
+		if task.Pod == nil {
+			continue
+		}
+		for _, secret := range task.Pod.Spec.ImagePullSecrets {
+			if !slices.Contains(secrets, secret) {
+				secrets = append(secrets, secret)
+			}
+		}
+	}
+	return secrets
+}
+
+func sortedNodeNames(missing map[string][]string) []string {
+	nodeNames := make([]string, 0, len(missing))
+	for nodeName := range missing {
+		nodeNames = append(nodeNames, nodeName)
+	}
+	slices.Sort(nodeNames)
+	return nodeNames
+}
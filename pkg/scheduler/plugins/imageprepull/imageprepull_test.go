@@ -0,0 +1,168 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package imageprepull
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+func TestNormalizeImageName(t *testing.T) {
+	tests := []struct {
+		image    string
+		expected string
+	}{
+		{image: "nginx", expected: "docker.io/library/nginx:latest"},
+		{image: "nginx:1.25", expected: "docker.io/library/nginx:1.25"},
+		{image: "team/trainer:v1", expected: "docker.io/team/trainer:v1"},
+		{image: "nvcr.io/nvidia/pytorch:24.01-py3", expected: "nvcr.io/nvidia/pytorch:24.01-py3"},
+		{image: "localhost:5000/trainer", expected: "localhost:5000/trainer:latest"},
+		{image: "localhost/trainer:v1", expected: "localhost/trainer:v1"},
+		{image: "nvcr.io/nvidia/pytorch@sha256:abc", expected: "nvcr.io/nvidia/pytorch@sha256:abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			assert.Equal(t, tt.expected, normalizeImageName(tt.image))
+		})
+	}
+}
+
+func TestMissingImages(t *testing.T) {
+	nodes := map[string]*node_info.NodeInfo{
+		"node-a": newNode("node-a", "docker.io/library/trainer:v1"),
+		"node-b": newNode("node-b"),
+		"node-c": newNode("node-c"),
+	}
+	prepulled := newPrepullPod("node-c", "trainer:v1", "sidecar:v1")
+	prepulled.Status.ContainerStatuses = []v1.ContainerStatus{
+		{Name: "image-0", ImageID: "docker.io/library/trainer@sha256:abc"},
+		{Name: "image-1", State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ErrImagePull"}}},
+	}
+
+	tests := []struct {
+		name        string
+		tasks       []*pod_info.PodInfo
+		prepullPods []*v1.Pod
+		expected    map[string][]string
+	}{
+		{
+			name:     "images present on the node",
+			tasks:    []*pod_info.PodInfo{newTask("a", "node-a", "trainer:v1")},
+			expected: map[string][]string{},
+		},
+		{
+			name: "images missing on a node",
+			tasks: []*pod_info.PodInfo{
+				newTask("a", "node-a", "trainer:v1"),
+				newTask("b", "node-b", "trainer:v1", "sidecar:v1"),
+			},
+			expected: map[string][]string{
+				"node-b": {"docker.io/library/trainer:v1", "docker.io/library/sidecar:v1"},
+			},
+		},
+		{
+			name:        "images pulled by a pre-pull pod",
+			tasks:       []*pod_info.PodInfo{newTask("c", "node-c", "trainer:v1", "sidecar:v1")},
+			prepullPods: []*v1.Pod{prepulled},
+			expected:    map[string][]string{"node-c": {"docker.io/library/sidecar:v1"}},
+		},
+		{
+			name: "tasks that share a node",
+			tasks: []*pod_info.PodInfo{
+				newTask("b-1", "node-b", "trainer:v1"),
+				newTask("b-2", "node-b", "trainer:v1"),
+			},
+			expected: map[string][]string{"node-b": {"docker.io/library/trainer:v1"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, missingImages(tt.tasks, nodes, tt.prepullPods))
+		})
+	}
+}
+
+func TestBuildPrepullPod(t *testing.T) {
+	podGroup := &enginev2alpha2.PodGroup{ObjectMeta: metav1.ObjectMeta{Name: "pg", Namespace: "ns", UID: "pg-uid"}}
+	secrets := []v1.LocalObjectReference{{Name: "registry"}}
+
+	pod := buildPrepullPod(podGroup, "node-a", []string{"trainer:v1", "sidecar:v1"}, secrets, time.Minute)
+
+	assert.Equal(t, "pg-prepull-", pod.GenerateName)
+	assert.Equal(t, "ns", pod.Namespace)
+	assert.Equal(t, map[string]string{prepullLabel: "pg"}, pod.Labels)
+	assert.Equal(t, "pg-uid", string(pod.OwnerReferences[0].UID))
+	assert.Equal(t, "node-a", pod.Spec.NodeName)
+	assert.Equal(t, secrets, pod.Spec.ImagePullSecrets)
+	assert.Equal(t, int64(60), *pod.Spec.ActiveDeadlineSeconds)
+	assert.Equal(t, v1.RestartPolicyNever, pod.Spec.RestartPolicy)
+	assert.Len(t, pod.Spec.Containers, 2)
+	assert.Equal(t, "sidecar:v1", pod.Spec.Containers[1].Image)
+}
+
+func TestPrepullWaits(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	w := newPrepullWaits()
+	job := podgroup_info.NewPodGroupInfo("job", &pod_info.PodInfo{UID: "pod", Job: "job", Status: pod_status.Pending})
+
+	assert.Equal(t, now, w.start(job, now))
+	assert.Equal(t, now, w.start(job, now.Add(time.Minute)), "a waiting job keeps its start time")
+	assert.True(t, w.addNode("job", "node-a"))
+	assert.False(t, w.addNode("job", "node-a"))
+	assert.True(t, w.hasNode("job", "node-a"))
+	assert.False(t, w.hasNode("job", "node-b"))
+
+	assert.Empty(t, w.prune(map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{"job": job}))
+	finished := w.prune(map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{})
+	assert.Len(t, finished, 1)
+	assert.False(t, w.hasNode("job", "node-a"))
+}
+
+func TestValidateArguments(t *testing.T) {
+	assert.NoError(t, ValidateArguments(framework.PluginArguments{}))
+	assert.NoError(t, ValidateArguments(framework.PluginArguments{"timeout": "10m"}))
+	assert.Error(t, ValidateArguments(framework.PluginArguments{"timeout": "later"}))
+	assert.Error(t, ValidateArguments(framework.PluginArguments{"timeout": "-1m"}))
+}
+
+func newNode(name string, images ...string) *node_info.NodeInfo {
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if len(images) > 0 {
+		node.Status.Images = []v1.ContainerImage{{Names: images}}
+	}
+	return &node_info.NodeInfo{Name: name, Node: node}
+}
+
+func newTask(name, nodeName string, images ...string) *pod_info.PodInfo {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"}}
+	for _, image := range images {
+		pod.Spec.Containers = append(pod.Spec.Containers, v1.Container{Image: image})
+	}
+	return &pod_info.PodInfo{
+		UID:      common_info.PodID(name),
+		Name:     name,
+		NodeName: nodeName,
+		Status:   pod_status.Allocated,
+		Pod:      pod,
+	}
+}
+
+func newPrepullPod(nodeName string, images ...string) *v1.Pod {
+	podGroup := &enginev2alpha2.PodGroup{ObjectMeta: metav1.ObjectMeta{Name: "pg", Namespace: "ns"}}
+	return buildPrepullPod(podGroup, nodeName, images, nil, time.Minute)
+}
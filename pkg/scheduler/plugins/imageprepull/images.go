@@ -0,0 +1,86 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package imageprepull
+
+import (
+	"slices"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	defaultRegistry  = "docker.io"
+	defaultNamespace = "library"
+	defaultTag       = "latest"
+)
+
+// normalizeImageName completes an image reference with the default registry, repository namespace and tag, the way
+// container runtimes report the images of a node
+func normalizeImageName(image string) string {
+	name := image
+	if !strings.Contains(name, "@") {
+		lastPart := name[strings.LastIndex(name, "/")+1:]
+		if !strings.Contains(lastPart, ":") {
+			name += ":" + defaultTag
+		}
+	}
+
+	firstPart, _, found := strings.Cut(name, "/")
+	if !found {
+		return defaultRegistry + "/" + defaultNamespace + "/" + name
+	}
+	if !strings.ContainsAny(firstPart, ".:") && firstPart != "localhost" {
+		return defaultRegistry + "/" + name
+	}
+	return name
+}
+
+// podImages returns the normalized images of the pod's init and regular containers
+func podImages(pod *v1.Pod) []string {
+	var images []string
+	for _, containers := range [][]v1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for _, container := range containers {
+			image := normalizeImageName(container.Image)
+			if !slices.Contains(images, image) {
+				images = append(images, image)
+			}
+		}
+	}
+	return images
+}
+
+// nodeImages returns the normalized images that the node reports as present
+func nodeImages(node *v1.Node) map[string]bool {
+	images := map[string]bool{}
+	if node == nil {
+		return images
+	}
+	for _, image := range node.Status.Images {
+		for _, name := range image.Names {
+			images[normalizeImageName(name)] = true
+		}
+	}
+	return images
+}
+
+// pulledImages returns the normalized images that the pre-pull pod has pulled
+func pulledImages(pod *v1.Pod) map[string]bool {
+	containerImages := map[string]string{}
+	for _, container := range pod.Spec.Containers {
+		containerImages[container.Name] = container.Image
+	}
+
+	images := map[string]bool{}
+	for _, status := range pod.Status.ContainerStatuses {
+		image, found := containerImages[status.Name]
+		if !found {
+			continue
+		}
+		if status.ImageID != "" || status.State.Running != nil || status.State.Terminated != nil {
+			images[normalizeImageName(image)] = true
+		}
+	}
+	return images
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package imageprepull
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	listersv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/utils/ptr"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const (
+	// prepullLabel is set on the pre-pull pods with the name of the pod group they pull images for
+	prepullLabel     = "kai.scheduler/image-prepull"
+	prepullNodeLabel = "kai.scheduler/image-prepull-node"
+	requestTimeout   = 10 * time.Second
+)
+
+// +kubebuilder:rbac:groups="",resources=pods,verbs=create;delete
+
+// prepuller pulls images on nodes by running pods that use them, pinned to the nodes. The pods run in the namespace
+// of the pod group, so that they use the same image pull secrets, and are owned by the pod group.
+type prepuller struct {
+	kubeClient kubernetes.Interface
+	podLister  listersv1.PodLister
+}
+
+// prepullPods returns the pre-pull pods of the pod group
+func (p *prepuller) prepullPods(namespace, podGroupName string) []*v1.Pod {
+	pods, err := p.podLister.Pods(namespace).List(labels.SelectorFromSet(labels.Set{prepullLabel: podGroupName}))
+	if err != nil {
+		log.InfraLogger.V(4).Warnf("Failed to list the pre-pull pods of pod group <%s/%s>: %v",
+			namespace, podGroupName, err)
+		return nil
+	}
+	return pods
+}
+
+// pull creates a pre-pull pod on the node in the background
+func (p *prepuller) pull(podGroup *enginev2alpha2.PodGroup, nodeName string, images []string,
+	pullSecrets []v1.LocalObjectReference, timeout time.Duration) {
+	pod := buildPrepullPod(podGroup, nodeName, images, pullSecrets, timeout)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		defer cancel()
+		if _, err := p.kubeClient.CoreV1().Pods(pod.Namespace).Create(ctx, pod, metav1.CreateOptions{}); err != nil {
+			log.InfraLogger.Errorf("Failed to create a pre-pull pod for pod group <%s/%s> on node <%s>: %v",
+				podGroup.Namespace, podGroup.Name, nodeName, err)
+		}
+	}()
+}
+
+// cleanup deletes the pre-pull pods of the pod group in the background
+func (p *prepuller) cleanup(namespace, podGroupName string) {
+	pods := p.prepullPods(namespace, podGroupName)
+	if len(pods) == 0 {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		defer cancel()
+		for _, pod := range pods {
+			err := p.kubeClient.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				log.InfraLogger.Errorf("Failed to delete pre-pull pod <%s/%s>: %v", pod.Namespace, pod.Name, err)
+			}
+		}
+	}()
+}
+
+func buildPrepullPod(podGroup *enginev2alpha2.PodGroup, nodeName string, images []string,
+	pullSecrets []v1.LocalObjectReference, timeout time.Duration) *v1.Pod {
+	var containers []v1.Container
+	for i, image := range images {
+		containers = append(containers, v1.Container{
+			Name:            fmt.Sprintf("image-%d", i),
+			Image:           image,
+			ImagePullPolicy: v1.PullIfNotPresent,
+			// The pod only pulls the image, so it doesn't matter if the image has no such command
+			Command: []string{"true"},
+		})
+	}
+
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: podGroup.Name + "-prepull-",
+			Namespace:    podGroup.Namespace,
+			Labels:       map[string]string{prepullLabel: podGroup.Name},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: enginev2alpha2.SchemeGroupVersion.String(),
+				Kind:       "PodGroup",
+				Name:       podGroup.Name,
+				UID:        podGroup.UID,
+			}},
+		},
+		Spec: v1.PodSpec{
+			NodeName:                      nodeName,
+			Containers:                    containers,
+			ImagePullSecrets:              pullSecrets,
+			RestartPolicy:                 v1.RestartPolicyNever,
+			ActiveDeadlineSeconds:         ptr.To(int64(timeout.Seconds())),
+			TerminationGracePeriodSeconds: ptr.To(int64(0)),
+			Tolerations:                   []v1.Toleration{{Operator: v1.TolerationOpExists}},
+		},
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package imageprepull
+
+import (
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+)
+
+type jobWait struct {
+	namespace string
+	name      string
+	since     time.Time
+	nodes     map[string]bool
+}
+
+// prepullWaits tracks the jobs whose bind is deferred until their images are pulled, across scheduling sessions
+type prepullWaits struct {
+	mutex sync.Mutex
+	jobs  map[common_info.PodGroupID]*jobWait
+}
+
+func newPrepullWaits() *prepullWaits {
+	return &prepullWaits{
+		jobs: map[common_info.PodGroupID]*jobWait{},
+	}
+}
+
+// start returns the time the job started waiting for its images, starting the wait now if it isn't waiting yet
+func (w *prepullWaits) start(job *podgroup_info.PodGroupInfo, now time.Time) time.Time {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	wait, found := w.jobs[job.UID]
+	if !found {
+		wait = &jobWait{namespace: job.Namespace, name: job.Name, since: now, nodes: map[string]bool{}}
+		w.jobs[job.UID] = wait
+	}
+	return wait.since
+}
+
+// addNode records that the job's images are being pulled on the node, and returns false if it was already recorded
+func (w *prepullWaits) addNode(jobID common_info.PodGroupID, nodeName string) bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	wait, found := w.jobs[jobID]
+	if !found || wait.nodes[nodeName] {
+		return false
+	}
+	wait.nodes[nodeName] = true
+	return true
+}
+
+// hasNode returns true if the images of the waiting job are being pulled on the node
+func (w *prepullWaits) hasNode(jobID common_info.PodGroupID, nodeName string) bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	wait, found := w.jobs[jobID]
+	return found && wait.nodes[nodeName]
+}
+
+// clone returns a copy of the waits
+func (w *prepullWaits) clone() *prepullWaits {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	jobs := make(map[common_info.PodGroupID]*jobWait, len(w.jobs))
+	for jobID, wait := range w.jobs {
+		waitCopy := *wait
+		waitCopy.nodes = maps.Clone(wait.nodes)
+		jobs[jobID] = &waitCopy
+	}
+	return &prepullWaits{jobs: jobs}
+}
+
+// prune stops the waits of the jobs that don't exist anymore or have no pending tasks, and returns them
+func (w *prepullWaits) prune(jobs map[common_info.PodGroupID]*podgroup_info.PodGroupInfo) []*jobWait {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	var finished []*jobWait
+	for jobID, wait := range w.jobs {
+		if job, found := jobs[jobID]; found && job.GetNumPendingTasks() > 0 {
+			continue
+		}
+		finished = append(finished, wait)
+		delete(w.jobs, jobID)
+	}
+	return finished
+}
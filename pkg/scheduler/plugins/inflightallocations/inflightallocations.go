@@ -0,0 +1,91 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package inflightallocations
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
+)
+
+const pluginName = "inflightallocations"
+
+// reportStore holds the report of the last session for the http handler, which serves it between sessions
+type reportStore struct {
+	mutex  sync.RWMutex
+	report *InFlightAllocations
+}
+
+func (s *reportStore) set(report *InFlightAllocations) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.report = report
+}
+
+func (s *reportStore) get() *InFlightAllocations {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.report
+}
+
+type inFlightAllocationsPlugin struct {
+	reports *reportStore
+}
+
+func New(_ framework.PluginArguments) framework.Plugin {
+	return &inFlightAllocationsPlugin{}
+}
+
+func (p *inFlightAllocationsPlugin) Name() string {
+	return pluginName
+}
+
+func (p *inFlightAllocationsPlugin) OnSessionOpen(ssn *framework.Session) {
+	if ssn.IsShadow() {
+		return
+	}
+	p.reports = ssn.PluginState(pluginName, func() any { return &reportStore{} }).(*reportStore)
+	report := BuildReport(ssn)
+	p.reports.set(report)
+	updateMetrics(report)
+	ssn.AddHttpHandler("/get-inflight-allocations", p.serveInFlightAllocations)
+}
+
+func (p *inFlightAllocationsPlugin) OnSessionClose(_ *framework.Session) {}
+
+func updateMetrics(report *InFlightAllocations) {
+	metrics.ResetNodeInFlightAllocations()
+	for nodeName, allocations := range report.Nodes {
+		metrics.UpdateNodeInFlightAllocations(nodeName, len(allocations.Pods), allocations.InFlight.GPUs)
+	}
+}
+
+func (p *inFlightAllocationsPlugin) serveInFlightAllocations(w http.ResponseWriter, r *http.Request) {
+	report := p.reports.get()
+	if report == nil {
+		http.Error(w, "In-flight allocations data not ready", http.StatusServiceUnavailable)
+		return
+	}
+	if nodeName := r.URL.Query().Get("node"); nodeName != "" {
+		filtered := &InFlightAllocations{
+			NodePool:  report.NodePool,
+			Timestamp: report.Timestamp,
+			Nodes:     map[string]*NodeAllocations{},
+		}
+		if allocations, found := report.Nodes[nodeName]; found {
+			filtered.Nodes[nodeName] = allocations
+		}
+		report = filtered
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		http.Error(w, "Failed to encode in-flight allocations data", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package inflightallocations
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServeInFlightAllocations(t *testing.T) {
+	plugin := &inFlightAllocationsPlugin{reports: &reportStore{}}
+	rr := httptest.NewRecorder()
+	plugin.serveInFlightAllocations(rr, httptest.NewRequest(http.MethodGet, "/get-inflight-allocations", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+
+	plugin.reports.set(&InFlightAllocations{
+		NodePool: "default",
+		Nodes: map[string]*NodeAllocations{
+			"node0": {
+				Bound:    Resources{GPUs: 1},
+				InFlight: Resources{GPUs: 2},
+				Pods:     []InFlightPod{{Namespace: "test", Name: "pod-0", Requested: Resources{GPUs: 2}}},
+			},
+			"node1": {
+				InFlight: Resources{GPUs: 1},
+				Pods:     []InFlightPod{{Namespace: "test", Name: "pod-1", Requested: Resources{GPUs: 1}}},
+			},
+		},
+	})
+	tests := []struct {
+		name          string
+		url           string
+		expectedNodes []string
+	}{
+		{
+			name:          "all nodes",
+			url:           "/get-inflight-allocations",
+			expectedNodes: []string{"node0", "node1"},
+		},
+		{
+			name:          "node with in-flight allocations",
+			url:           "/get-inflight-allocations?node=node0",
+			expectedNodes: []string{"node0"},
+		},
+		{
+			name:          "node without in-flight allocations",
+			url:           "/get-inflight-allocations?node=node2",
+			expectedNodes: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			plugin.serveInFlightAllocations(rr, httptest.NewRequest(http.MethodGet, tt.url, nil))
+			require.Equal(t, http.StatusOK, rr.Code)
+
+			var report InFlightAllocations
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&report))
+			assert.Equal(t, "default", report.NodePool)
+			nodeNames := []string{}
+			for nodeName := range report.Nodes {
+				nodeNames = append(nodeNames, nodeName)
+			}
+			assert.ElementsMatch(t, tt.expectedNodes, nodeNames)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package inflightallocations
+
+import (
+	"sort"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+type Resources struct {
+	GPUs     float64 `json:"gpus"`
+	MilliCPU float64 `json:"milli_cpu"`
+	Memory   float64 `json:"memory"`
+}
+
+// InFlightPod is a pod that the scheduler allocated to a node, and that the binder didn't bind yet
+type InFlightPod struct {
+	Namespace      string    `json:"namespace"`
+	Name           string    `json:"name"`
+	PodGroup       string    `json:"pod_group"`
+	BindRequest    string    `json:"bind_request,omitempty"`
+	Age            string    `json:"age,omitempty"`
+	FailedAttempts int32     `json:"failed_attempts"`
+	Requested      Resources `json:"requested"`
+}
+
+// NodeAllocations splits the resources allocated on a node between the pods that are bound to it, and the pods that
+// wait for the binder
+type NodeAllocations struct {
+	// Bound are the resources of the pods bound to the node, which kubectl describe node reports
+	Bound Resources `json:"bound"`
+	// InFlight are the resources of the pods allocated to the node that are not bound yet
+	InFlight Resources `json:"in_flight"`
+	// Pods are the pods allocated to the node that are not bound yet, oldest first
+	Pods []InFlightPod `json:"pods"`
+}
+
+// InFlightAllocations is the state of the in-flight allocations, as seen at the start of a scheduling session
+type InFlightAllocations struct {
+	NodePool  string                      `json:"node_pool"`
+	Timestamp time.Time                   `json:"timestamp"`
+	Nodes     map[string]*NodeAllocations `json:"nodes"`
+}
+
+// BuildReport collects the pods that are allocated to nodes and not bound yet, as seen by the session
+func BuildReport(ssn *framework.Session) *InFlightAllocations {
+	now := ssn.Clock().Now()
+	report := &InFlightAllocations{
+		NodePool:  nodePoolName(ssn),
+		Timestamp: now,
+		Nodes:     map[string]*NodeAllocations{},
+	}
+	for _, node := range ssn.ClusterInfo.Nodes {
+		if allocations := nodeAllocations(node, now); allocations != nil {
+			report.Nodes[node.Name] = allocations
+		}
+	}
+	return report
+}
+
+// nodeAllocations returns the allocations of the node, or nil if no pod is in-flight to the node
+func nodeAllocations(node *node_info.NodeInfo, now time.Time) *NodeAllocations {
+	var inFlightTasks []*pod_info.PodInfo
+	inFlight := resource_info.EmptyResource()
+	for _, task := range node.PodInfos {
+		if task.Status != pod_status.Binding {
+			continue
+		}
+		inFlightTasks = append(inFlightTasks, task)
+		inFlight.AddResourceRequirements(task.ResReq)
+	}
+	if len(inFlightTasks) == 0 {
+		return nil
+	}
+	sort.Slice(inFlightTasks, func(i, j int) bool {
+		return bindRequestTime(inFlightTasks[i]).Before(bindRequestTime(inFlightTasks[j]))
+	})
+
+	bound := node.Used.Clone()
+	bound.Sub(inFlight)
+	allocations := &NodeAllocations{
+		Bound:    toResources(bound),
+		InFlight: toResources(inFlight),
+	}
+	for _, task := range inFlightTasks {
+		pod := InFlightPod{
+			Namespace: task.Namespace,
+			Name:      task.Name,
+			PodGroup:  string(task.Job),
+			Requested: toResources(requestedResources(task)),
+		}
+		if task.BindRequest != nil {
+			pod.BindRequest = task.BindRequest.Name
+			pod.Age = now.Sub(bindRequestTime(task)).Round(time.Second).String()
+			pod.FailedAttempts = task.BindRequest.BindRequest.Status.FailedAttempts
+		}
+		allocations.Pods = append(allocations.Pods, pod)
+	}
+	return allocations
+}
+
+func requestedResources(task *pod_info.PodInfo) *resource_info.Resource {
+	requested := resource_info.EmptyResource()
+	requested.AddResourceRequirements(task.ResReq)
+	return requested
+}
+
+func bindRequestTime(task *pod_info.PodInfo) time.Time {
+	if task.BindRequest == nil {
+		return time.Time{}
+	}
+	return task.BindRequest.BindRequest.CreationTimestamp.Time
+}
+
+func toResources(resource *resource_info.Resource) Resources {
+	return Resources{
+		GPUs:     resource.GPUs(),
+		MilliCPU: resource.Cpu(),
+		Memory:   resource.Memory(),
+	}
+}
+
+func nodePoolName(ssn *framework.Session) string {
+	if nodePool := ssn.NodePoolName(); nodePool != "" {
+		return nodePool
+	}
+	return constants.DefaultNodePoolName
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package inflightallocations_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "go.uber.org/mock/gomock"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/inflightallocations"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func newTestTopology() test_utils.TestTopologyBasic {
+	job := func(name string, gpus float64, state pod_status.PodStatus, nodeName string) *jobs_fake.TestJobBasic {
+		return &jobs_fake.TestJobBasic{
+			Name:                name,
+			Namespace:           "test",
+			RequiredGPUsPerTask: gpus,
+			Priority:            constants.PriorityTrainNumber,
+			QueueName:           "queue0",
+			Tasks: []*tasks_fake.TestTaskBasic{
+				{NodeName: nodeName, State: state},
+			},
+		}
+	}
+	return test_utils.TestTopologyBasic{
+		Name: "in-flight allocations",
+		Jobs: []*jobs_fake.TestJobBasic{
+			job("running_job", 1, pod_status.Running, "node0"),
+			job("binding_job", 2, pod_status.Binding, "node0"),
+			job("other_running_job", 1, pod_status.Running, "node1"),
+			job("pending_job", 1, pod_status.Pending, ""),
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {GPUs: 4},
+			"node1": {GPUs: 4},
+		},
+		Queues: []test_utils.TestQueueBasic{
+			{Name: "queue0", DeservedGPUs: 8},
+		},
+	}
+}
+
+func TestBuildReport(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+	ssn := test_utils.BuildSession(newTestTopology(), controller)
+
+	report := inflightallocations.BuildReport(ssn)
+
+	assert.Equal(t, "default", report.NodePool)
+	require.Len(t, report.Nodes, 1)
+	allocations := report.Nodes["node0"]
+	require.NotNil(t, allocations)
+	assert.Equal(t, 1.0, allocations.Bound.GPUs)
+	assert.Equal(t, 2.0, allocations.InFlight.GPUs)
+	require.Len(t, allocations.Pods, 1)
+	assert.Equal(t, "test", allocations.Pods[0].Namespace)
+	assert.Equal(t, "binding_job", allocations.Pods[0].PodGroup)
+	assert.Equal(t, 2.0, allocations.Pods[0].Requested.GPUs)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
 )
 
 const (
@@ -34,6 +35,7 @@ type minruntimePlugin struct {
 	reclaimProtectionCache map[common_info.PodGroupID]map[common_info.PodGroupID]bool
 
 	resolver *resolver
+	clock    clock.PassiveClock
 }
 
 func parseMinRuntime(arguments framework.PluginArguments, minRuntimeConfig string) metav1.Duration {
@@ -50,7 +52,7 @@ func parseMinRuntime(arguments framework.PluginArguments, minRuntimeConfig strin
 }
 
 func New(arguments framework.PluginArguments) framework.Plugin {
-	plugin := &minruntimePlugin{}
+	plugin := &minruntimePlugin{clock: clock.RealClock{}}
 
 	plugin.defaultReclaimMinRuntime = parseMinRuntime(arguments, defaultReclaimMinRuntimeConfig)
 	plugin.defaultPreemptMinRuntime = parseMinRuntime(arguments, defaultPreemptMinRuntimeConfig)
@@ -81,6 +83,7 @@ func (mr *minruntimePlugin) OnSessionOpen(ssn *framework.Session) {
 	ssn.AddReclaimScenarioValidatorFn(mr.reclaimScenarioValidatorFn)
 	ssn.AddPreemptScenarioValidatorFn(mr.preemptScenarioValidatorFn)
 	mr.queues = ssn.ClusterInfo.Queues
+	mr.clock = ssn.Clock()
 	mr.preemptProtectionCache = make(map[common_info.PodGroupID]bool)
 	mr.reclaimProtectionCache = make(map[common_info.PodGroupID]map[common_info.PodGroupID]bool)
 	mr.resolver = NewResolver(mr.queues, mr.defaultPreemptMinRuntime, mr.defaultReclaimMinRuntime)
@@ -157,12 +160,13 @@ func (mr *minruntimePlugin) isReclaimMinRuntimeProtected(pendingJob *podgroup_in
 		log.InfraLogger.Errorf("Failed to get reclaim min runtime for pending job %v and victim %v: %v", pendingJob.NamespacedName, victim.NamespacedName, err)
 		minRuntime = mr.defaultReclaimMinRuntime
 	}
+	minRuntime = mr.applyPodGroupMinRuntime(victim, victimQueue, minRuntime)
 
 	// If the victim's last start time plus minimum runtime is greater than current time,
 	// the victim is protected from reclaim
 	if victim.LastStartTimestamp != nil && !victim.LastStartTimestamp.IsZero() {
 		protectedUntil := victim.LastStartTimestamp.Add(minRuntime.Duration)
-		protected := time.Now().Before(protectedUntil)
+		protected := mr.clock.Now().Before(protectedUntil)
 		mr.cacheReclaimProtection(pendingJob, victim, protected)
 		return protected
 	}
@@ -180,12 +184,13 @@ func (mr *minruntimePlugin) isPreemptMinRuntimeProtected(_ *podgroup_info.PodGro
 		log.InfraLogger.Errorf("Failed to get preempt min runtime for victim %v: %v", victim.NamespacedName, err)
 		minRuntime = mr.defaultPreemptMinRuntime
 	}
+	minRuntime = mr.applyPodGroupMinRuntime(victim, victimQueue, minRuntime)
 
 	// If the victim's last start time plus minimum runtime is greater than current time,
 	// the victim is protected from preemption
 	if victim.LastStartTimestamp != nil && !victim.LastStartTimestamp.IsZero() {
 		protectedUntil := victim.LastStartTimestamp.Add(minRuntime.Duration)
-		protected := time.Now().Before(protectedUntil)
+		protected := mr.clock.Now().Before(protectedUntil)
 		mr.cachePreemptProtection(victim, protected)
 		return protected
 	}
@@ -193,6 +198,27 @@ func (mr *minruntimePlugin) isPreemptMinRuntimeProtected(_ *podgroup_info.PodGro
 	return false
 }
 
+// applyPodGroupMinRuntime lengthens the min-runtime resolved from the queues with the min-runtime set on the victim's
+// PodGroup, capped by the max-pod-group-min-runtime of its queue. PodGroups can't shorten the min-runtime of their
+// queue, and can't set one at all unless their queue allows it.
+func (mr *minruntimePlugin) applyPodGroupMinRuntime(
+	victim *podgroup_info.PodGroupInfo, victimQueue *queue_info.QueueInfo, queueMinRuntime metav1.Duration,
+) metav1.Duration {
+	if victim.PodGroup == nil || victim.PodGroup.Spec.MinRuntimeBeforePreemption == nil {
+		return queueMinRuntime
+	}
+	maxMinRuntime, found := mr.resolver.getMaxPodGroupMinRuntime(victimQueue)
+	if !found {
+		return queueMinRuntime
+	}
+
+	podGroupMinRuntime := min(victim.PodGroup.Spec.MinRuntimeBeforePreemption.Duration, maxMinRuntime.Duration)
+	if podGroupMinRuntime > queueMinRuntime.Duration {
+		return metav1.Duration{Duration: podGroupMinRuntime}
+	}
+	return queueMinRuntime
+}
+
 func (mr *minruntimePlugin) cachePreemptProtection(victim *podgroup_info.PodGroupInfo, protected bool) {
 	mr.preemptProtectionCache[victim.UID] = protected
 }
@@ -10,7 +10,9 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
 
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
@@ -92,6 +94,7 @@ var _ = Describe("MinRuntime Plugin", func() {
 			preemptProtectionCache:   make(map[common_info.PodGroupID]bool),
 			reclaimProtectionCache:   make(map[common_info.PodGroupID]map[common_info.PodGroupID]bool),
 			resolver:                 NewResolver(queues, defaultPreemptDuration, defaultReclaimDuration),
+			clock:                    clock.RealClock{},
 		}
 	})
 
@@ -211,6 +214,81 @@ var _ = Describe("MinRuntime Plugin", func() {
 		})
 	})
 
+	Describe("PodGroup minRuntimeBeforePreemption", func() {
+		withMinRuntime := func(pg *podgroup_info.PodGroupInfo, minRuntime time.Duration) *podgroup_info.PodGroupInfo {
+			pg.PodGroup = &enginev2alpha2.PodGroup{
+				Spec: enginev2alpha2.PodGroupSpec{
+					MinRuntimeBeforePreemption: &metav1.Duration{Duration: minRuntime},
+				},
+			}
+			return pg
+		}
+
+		allowPodGroupMinRuntime := func(queue common_info.QueueID, maxMinRuntime time.Duration) {
+			queues[queue].MaxPodGroupMinRuntime = &metav1.Duration{Duration: maxMinRuntime}
+		}
+
+		It("should protect a victim beyond its queue preempt min runtime", func() {
+			allowPodGroupMinRuntime("prod-team2", time.Hour)
+			pendingJob := createPodGroup("pending-job", "dev-team1", nil, 1, 1)
+			start := time.Now().Add(-30 * time.Second)
+			victim := withMinRuntime(createPodGroup("victim-job", "prod-team2", &start, 1, 1), time.Minute)
+
+			// prod-team2 preempt min runtime is 15s, the PodGroup requires 1m
+			Expect(plugin.preemptFilterFn(pendingJob, victim)).To(BeFalse())
+		})
+
+		It("should protect a victim beyond its queue reclaim min runtime", func() {
+			allowPodGroupMinRuntime("prod-team2", time.Hour)
+			pendingJob := createPodGroup("pending-job", "dev-team1", nil, 1, 1)
+			start := time.Now().Add(-40 * time.Second)
+			victim := withMinRuntime(createPodGroup("victim-job", "prod-team2", &start, 1, 1), time.Minute)
+
+			// prod-team2 reclaim min runtime is 35s, the PodGroup requires 1m
+			Expect(plugin.reclaimFilterFn(pendingJob, victim)).To(BeFalse())
+		})
+
+		It("should ignore the PodGroup min runtime when the queue doesn't allow it", func() {
+			pendingJob := createPodGroup("pending-job", "dev-team1", nil, 1, 1)
+			start := time.Now().Add(-40 * time.Second)
+			victim := withMinRuntime(createPodGroup("victim-job", "prod-team2", &start, 1, 1), time.Minute)
+
+			Expect(plugin.preemptFilterFn(pendingJob, victim)).To(BeTrue())
+			Expect(plugin.reclaimFilterFn(pendingJob, victim)).To(BeTrue())
+		})
+
+		It("should not allow a shorter PodGroup min runtime than the queue", func() {
+			allowPodGroupMinRuntime("prod-team2", time.Hour)
+			pendingJob := createPodGroup("pending-job", "dev-team1", nil, 1, 1)
+			start := time.Now().Add(-10 * time.Second)
+			victim := withMinRuntime(createPodGroup("victim-job", "prod-team2", &start, 1, 1), 5*time.Second)
+
+			Expect(plugin.preemptFilterFn(pendingJob, victim)).To(BeFalse())
+			Expect(plugin.reclaimFilterFn(pendingJob, victim)).To(BeFalse())
+		})
+
+		It("should cap the PodGroup min runtime by the queue maximum", func() {
+			allowPodGroupMinRuntime("prod-team2", 45*time.Second)
+			pendingJob := createPodGroup("pending-job", "dev-team1", nil, 1, 1)
+			start := time.Now().Add(-50 * time.Second)
+			victim := withMinRuntime(createPodGroup("victim-job", "prod-team2", &start, 1, 1), time.Hour)
+
+			Expect(plugin.preemptFilterFn(pendingJob, victim)).To(BeTrue())
+			Expect(plugin.reclaimFilterFn(pendingJob, victim)).To(BeTrue())
+		})
+
+		It("should inherit the maximum from a parent queue", func() {
+			parent := queues["prod-team2"].ParentQueue
+			Expect(parent).NotTo(BeEmpty())
+			allowPodGroupMinRuntime(parent, time.Hour)
+			pendingJob := createPodGroup("pending-job", "dev-team1", nil, 1, 1)
+			start := time.Now().Add(-40 * time.Second)
+			victim := withMinRuntime(createPodGroup("victim-job", "prod-team2", &start, 1, 1), time.Minute)
+
+			Expect(plugin.preemptFilterFn(pendingJob, victim)).To(BeFalse())
+		})
+	})
+
 	Describe("preemptScenarioValidatorFn", func() {
 		Context("when validating preemption scenario for elastic jobs", func() {
 			It("should return true if not enough tasks are being preempted", func() {
@@ -66,6 +66,17 @@ func (r *resolver) resolvePreemptMinRuntime(
 	return minRuntime, nil
 }
 
+// getMaxPodGroupMinRuntime returns the max-pod-group-min-runtime of the closest queue in the tree that sets it,
+// starting from the leaf-queue
+func (r *resolver) getMaxPodGroupMinRuntime(queue *queue_info.QueueInfo) (metav1.Duration, bool) {
+	for currentQueue := queue; currentQueue != nil; currentQueue = r.queues[currentQueue.ParentQueue] {
+		if currentQueue.MaxPodGroupMinRuntime != nil {
+			return *currentQueue.MaxPodGroupMinRuntime, true
+		}
+	}
+	return metav1.Duration{}, false
+}
+
 // getReclaimMinRuntime returns min-runtime for reclaims
 // Depending on the resolveMethod, it will use either:
 // 1. queue: Starting from the leaf-queue, walk the tree (similar to preempt)
@@ -0,0 +1,160 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeplacement
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
+)
+
+const (
+	fragmentationHighKey     = "gpuAutoFragmentationHigh"
+	fragmentationLowKey      = "gpuAutoFragmentationLow"
+	minSessionsInStrategyKey = "gpuAutoMinSessionsInStrategy"
+
+	defaultFragmentationHigh     = 0.5
+	defaultFragmentationLow      = 0.2
+	defaultMinSessionsInStrategy = 10
+
+	reasonFragmentation         = "fragmentation"
+	reasonBlockedWholeGpuDemand = "blocked-whole-gpu-demand"
+	reasonLowFragmentation      = "low-fragmentation"
+)
+
+type autoPlacementConfig struct {
+	// fragmentationHigh is the GPU fragmentation from which spread is switched to binpack
+	fragmentationHigh float64
+	// fragmentationLow is the GPU fragmentation below which binpack is switched back to spread
+	fragmentationLow float64
+	// minSessionsInStrategy is the number of scheduling cycles a strategy is kept before it can be switched again
+	minSessionsInStrategy int
+}
+
+// autoPlacementState is the GPU placement strategy chosen by the auto strategy of the scheduler's node pool. It is
+// kept in the plugin state of the scheduler, so that the strategy is kept across sessions.
+type autoPlacementState struct {
+	strategy           string
+	sessionsInStrategy int
+}
+
+func newAutoPlacementState() *autoPlacementState {
+	return &autoPlacementState{strategy: constants.BinpackStrategy}
+}
+
+type gpuTelemetry struct {
+	// fragmentation is the share of the non-allocated GPUs that are on nodes with allocated GPUs, which can't be
+	// used by pods requesting all the GPUs of a node
+	fragmentation float64
+	// blockedWholeGpuDemand is the number of GPUs requested by pending whole GPU pods that don't fit in the
+	// non-allocated GPUs of any node
+	blockedWholeGpuDemand float64
+}
+
+func newAutoPlacementConfig(arguments framework.PluginArguments) autoPlacementConfig {
+	config := autoPlacementConfig{}
+	var err error
+	if config.fragmentationHigh, err = arguments.GetFloat64(fragmentationHighKey, defaultFragmentationHigh); err != nil {
+		log.InfraLogger.Warningf("Failed to parse %s: %v. Using default value of %v",
+			fragmentationHighKey, err, defaultFragmentationHigh)
+	}
+	if config.fragmentationLow, err = arguments.GetFloat64(fragmentationLowKey, defaultFragmentationLow); err != nil {
+		log.InfraLogger.Warningf("Failed to parse %s: %v. Using default value of %v",
+			fragmentationLowKey, err, defaultFragmentationLow)
+	}
+	if config.fragmentationLow > config.fragmentationHigh {
+		log.InfraLogger.Warningf("%s must not be higher than %s, got %v and %v. Using default values of %v and %v",
+			fragmentationLowKey, fragmentationHighKey, config.fragmentationLow, config.fragmentationHigh,
+			defaultFragmentationLow, defaultFragmentationHigh)
+		config.fragmentationLow, config.fragmentationHigh = defaultFragmentationLow, defaultFragmentationHigh
+	}
+	if config.minSessionsInStrategy, err = arguments.GetInt(
+		minSessionsInStrategyKey, defaultMinSessionsInStrategy); err != nil {
+		log.InfraLogger.Warningf("Failed to parse %s: %v. Using default value of %v",
+			minSessionsInStrategyKey, err, defaultMinSessionsInStrategy)
+	}
+	return config
+}
+
+// strategyForSession returns the GPU placement strategy of the session. Spread is switched to binpack when the GPUs
+// get fragmented or pending whole GPU pods don't fit in any node, and binpack is switched back to spread only when
+// the fragmentation drops below a lower threshold and no whole GPU pod is blocked, so that the strategy doesn't flip
+// on every session. A strategy is also kept for a minimal number of scheduling cycles. Micro-cycles use the current
+// strategy without updating it, so that switching doesn't depend on how often they are triggered.
+func (s *autoPlacementState) strategyForSession(ssn *framework.Session, config autoPlacementConfig) string {
+	if ssn.IsMicroCycle() {
+		return s.strategy
+	}
+	telemetry := gpuTelemetryOf(ssn.ClusterInfo.Nodes, ssn.ClusterInfo.PodGroupInfos)
+	s.update(telemetry, config)
+	metrics.UpdateAutoPlacement(s.strategy, telemetry.fragmentation, telemetry.blockedWholeGpuDemand)
+	return s.strategy
+}
+
+func (s *autoPlacementState) update(telemetry gpuTelemetry, config autoPlacementConfig) {
+	s.sessionsInStrategy++
+	if s.sessionsInStrategy < config.minSessionsInStrategy {
+		return
+	}
+
+	nextStrategy, reason := s.strategy, ""
+	switch s.strategy {
+	case constants.SpreadStrategy:
+		if telemetry.blockedWholeGpuDemand > 0 {
+			nextStrategy, reason = constants.BinpackStrategy, reasonBlockedWholeGpuDemand
+		} else if telemetry.fragmentation >= config.fragmentationHigh {
+			nextStrategy, reason = constants.BinpackStrategy, reasonFragmentation
+		}
+	default:
+		if telemetry.blockedWholeGpuDemand == 0 && telemetry.fragmentation <= config.fragmentationLow {
+			nextStrategy, reason = constants.SpreadStrategy, reasonLowFragmentation
+		}
+	}
+	if nextStrategy == s.strategy {
+		return
+	}
+
+	log.InfraLogger.V(2).Infof("Switching GPU placement strategy from %s to %s due to %s: fragmentation <%v>, "+
+		"blocked whole GPU demand <%v>", s.strategy, nextStrategy, reason, telemetry.fragmentation,
+		telemetry.blockedWholeGpuDemand)
+	metrics.IncAutoPlacementSwitches(nextStrategy, reason)
+	s.strategy = nextStrategy
+	s.sessionsInStrategy = 0
+}
+
+func gpuTelemetryOf(
+	nodes map[string]*node_info.NodeInfo, podGroupInfos map[common_info.PodGroupID]*podgroup_info.PodGroupInfo,
+) gpuTelemetry {
+	var nonAllocated, fragmented, maxNodeNonAllocated float64
+	for _, node := range nodes {
+		allocatable := node.Allocatable.Get(resource_info.GPUResourceName)
+		if allocatable == 0 {
+			continue
+		}
+		nodeNonAllocated := node.NonAllocatedResource(resource_info.GPUResourceName)
+		nonAllocated += nodeNonAllocated
+		if nodeNonAllocated < allocatable {
+			fragmented += nodeNonAllocated
+		}
+		maxNodeNonAllocated = max(maxNodeNonAllocated, nodeNonAllocated)
+	}
+
+	telemetry := gpuTelemetry{}
+	if nonAllocated > 0 {
+		telemetry.fragmentation = fragmented / nonAllocated
+	}
+	for _, job := range podGroupInfos {
+		for _, task := range job.GetPendingTasks() {
+			if task.IsRegularGPURequest() && task.ResReq.GPUs() > maxNodeNonAllocated {
+				telemetry.blockedWholeGpuDemand += task.ResReq.GPUs()
+			}
+		}
+	}
+	return telemetry
+}
@@ -0,0 +1,186 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeplacement
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+func TestGpuTelemetryOf(t *testing.T) {
+	tests := []struct {
+		name              string
+		nodes             map[string]*node_info.NodeInfo
+		pendingTaskGPUs   []string
+		expectedTelemetry gpuTelemetry
+	}{
+		{
+			name: "free nodes",
+			nodes: map[string]*node_info.NodeInfo{
+				"n1": buildGpuNode(8, 8),
+				"n2": buildGpuNode(8, 8),
+			},
+			pendingTaskGPUs:   []string{"8"},
+			expectedTelemetry: gpuTelemetry{fragmentation: 0, blockedWholeGpuDemand: 0},
+		},
+		{
+			name: "fragmented nodes with blocked whole gpu pods",
+			nodes: map[string]*node_info.NodeInfo{
+				"n1":  buildGpuNode(8, 3),
+				"n2":  buildGpuNode(8, 1),
+				"n3":  buildGpuNode(4, 4),
+				"cpu": buildGpuNode(0, 0),
+			},
+			pendingTaskGPUs:   []string{"8", "6", "4", "1", "0.5"},
+			expectedTelemetry: gpuTelemetry{fragmentation: 0.5, blockedWholeGpuDemand: 14},
+		},
+		{
+			name: "full nodes",
+			nodes: map[string]*node_info.NodeInfo{
+				"n1": buildGpuNode(8, 0),
+			},
+			pendingTaskGPUs:   []string{"1"},
+			expectedTelemetry: gpuTelemetry{fragmentation: 0, blockedWholeGpuDemand: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tasks []*pod_info.PodInfo
+			for i, gpus := range tt.pendingTaskGPUs {
+				tasks = append(tasks, buildPendingTask(i, gpus))
+			}
+			podGroupInfos := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{
+				"pg": podgroup_info.NewPodGroupInfo("pg", tasks...),
+			}
+
+			assert.Equal(t, tt.expectedTelemetry, gpuTelemetryOf(tt.nodes, podGroupInfos))
+		})
+	}
+}
+
+func TestAutoPlacementHysteresis(t *testing.T) {
+	config := newAutoPlacementConfig(framework.PluginArguments{
+		fragmentationHighKey:     "0.6",
+		fragmentationLowKey:      "0.2",
+		minSessionsInStrategyKey: "2",
+	})
+	state := &autoPlacementState{strategy: constants.BinpackStrategy}
+
+	steps := []struct {
+		telemetry        gpuTelemetry
+		expectedStrategy string
+	}{
+		// The strategy is kept for the minimal number of sessions
+		{gpuTelemetry{fragmentation: 0}, constants.BinpackStrategy},
+		{gpuTelemetry{fragmentation: 0}, constants.SpreadStrategy},
+		{gpuTelemetry{fragmentation: 0.7}, constants.SpreadStrategy},
+		// Fragmentation between the thresholds keeps the current strategy
+		{gpuTelemetry{fragmentation: 0.4}, constants.SpreadStrategy},
+		{gpuTelemetry{fragmentation: 0.6}, constants.BinpackStrategy},
+		{gpuTelemetry{fragmentation: 0.4}, constants.BinpackStrategy},
+		{gpuTelemetry{fragmentation: 0.4}, constants.BinpackStrategy},
+		{gpuTelemetry{fragmentation: 0.1, blockedWholeGpuDemand: 8}, constants.BinpackStrategy},
+		{gpuTelemetry{fragmentation: 0.1}, constants.SpreadStrategy},
+		{gpuTelemetry{fragmentation: 0.1, blockedWholeGpuDemand: 8}, constants.SpreadStrategy},
+		{gpuTelemetry{fragmentation: 0.1, blockedWholeGpuDemand: 8}, constants.BinpackStrategy},
+	}
+	for i, step := range steps {
+		state.update(step.telemetry, config)
+		assert.Equal(t, step.expectedStrategy, state.strategy, "step %d", i)
+	}
+}
+
+func TestAutoPlacementAcrossSessions(t *testing.T) {
+	framework.RegisterPluginBuilder(pluginName, New)
+	ctrl := gomock.NewController(t)
+	mockCache := cache.NewMockCache(ctrl)
+	mockCache.EXPECT().Snapshot().AnyTimes().DoAndReturn(func() (*api.ClusterInfo, error) {
+		return api.NewClusterInfo(), nil
+	})
+	config := &conf.SchedulerConfiguration{Tiers: []conf.Tier{{Plugins: []conf.PluginOption{{
+		Name: pluginName,
+		Arguments: map[string]string{
+			constants.GPUResource:    constants.AutoStrategy,
+			minSessionsInStrategyKey: "2",
+		},
+	}}}}}
+
+	states := framework.NewPluginStates()
+	openSession := func(microCycle bool) {
+		_, err := framework.OpenSession(context.Background(), mockCache, config, &conf.SchedulerParams{}, "1", nil,
+			framework.SessionOptions{PluginStates: states, MicroCycle: microCycle})
+		assert.NoError(t, err)
+	}
+	strategy := func() string {
+		return states.Get(pluginName, nil).(*autoPlacementState).strategy
+	}
+
+	openSession(false)
+	assert.Equal(t, constants.BinpackStrategy, strategy(), "a strategy is kept for the minimal number of cycles")
+	for range 3 {
+		openSession(true)
+	}
+	assert.Equal(t, constants.BinpackStrategy, strategy(), "micro-cycles don't count towards the minimal cycles")
+	openSession(false)
+	assert.Equal(t, constants.SpreadStrategy, strategy(), "the strategy is kept across the sessions of the scheduler")
+}
+
+func TestNewAutoPlacementConfig(t *testing.T) {
+	config := newAutoPlacementConfig(framework.PluginArguments{
+		fragmentationHighKey: "0.1",
+		fragmentationLowKey:  "0.3",
+	})
+	assert.Equal(t, autoPlacementConfig{
+		fragmentationHigh:     defaultFragmentationHigh,
+		fragmentationLow:      defaultFragmentationLow,
+		minSessionsInStrategy: defaultMinSessionsInStrategy,
+	}, config)
+}
+
+func buildGpuNode(allocatable, idle float64) *node_info.NodeInfo {
+	return &node_info.NodeInfo{
+		Node:        &v1.Node{},
+		Idle:        resource_info.NewResource(0, 0, idle),
+		Allocatable: resource_info.NewResource(0, 0, allocatable),
+		Releasing:   resource_info.EmptyResource(),
+	}
+}
+
+func buildPendingTask(index int, gpus string) *pod_info.PodInfo {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pod",
+			UID:  types.UID(string(rune('a' + index))),
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{}},
+		},
+		Status: v1.PodStatus{Phase: v1.PodPending},
+	}
+	if quantity := resource.MustParse(gpus); quantity.Cmp(resource.MustParse("1")) >= 0 {
+		pod.Spec.Containers[0].Resources.Requests = v1.ResourceList{resource_info.GPUResourceName: quantity}
+	} else {
+		pod.Annotations = map[string]string{"gpu-fraction": gpus}
+	}
+	return pod_info.NewTaskInfo(pod)
+}
@@ -6,18 +6,28 @@ package nodeplacement
 import (
 	v1 "k8s.io/api/core/v1"
 
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
 )
 
+const pluginName = "nodeplacement"
+
 type allocationRange struct {
 	minAllocatable, maxAllocatable float64
 }
 
+type gpuPlacementFns struct {
+	preOrderFn  api.NodePreOrderFn
+	taskScoreFn api.NodeOrderFn
+}
+
 type nodePlacementPlugin struct {
 	// Arguments given for the plugin
 	pluginArguments framework.PluginArguments
@@ -26,7 +36,14 @@ type nodePlacementPlugin struct {
 	gpuTaskScoreFn  api.NodeOrderFn
 	cpuTaskScoreFn  api.NodeOrderFn
 
+	// workloadClassGPUFns are the GPU placement functions of the workload classes with their own strategy
+	workloadClassGPUFns map[enginev2alpha2.WorkloadClass]gpuPlacementFns
+	podGroupInfos       map[common_info.PodGroupID]*podgroup_info.PodGroupInfo
+
 	podAllocatableRange map[string]allocationRange
+
+	autoPlacement       *autoPlacementState
+	autoPlacementConfig autoPlacementConfig
 }
 
 // New function returns nodePlacementPlugin object
@@ -43,22 +60,43 @@ func New(arguments framework.PluginArguments) framework.Plugin {
 		}
 	}
 
-	return &nodePlacementPlugin{pluginArguments: args}
+	plugin := &nodePlacementPlugin{pluginArguments: args}
+	if args[constants.GPUResource] == constants.AutoStrategy {
+		plugin.autoPlacementConfig = newAutoPlacementConfig(args)
+	}
+	return plugin
 }
 
 func (pp *nodePlacementPlugin) Name() string {
-	return "nodeplacement"
+	return pluginName
 }
 
 func (pp *nodePlacementPlugin) OnSessionOpen(ssn *framework.Session) {
 	pp.podAllocatableRange = make(map[string]allocationRange)
 
-	// pack tasks by default
-	pp.gpuTaskScoreFn = pp.nodeResourcePack(resource_info.GPUResourceName)
-	pp.gpuPreOrderFn = pp.setBinpackPreOrder
-	if pp.pluginArguments[constants.GPUResource] == constants.SpreadStrategy {
-		pp.gpuPreOrderFn = noopPreOrderFn
-		pp.gpuTaskScoreFn = nodeResourceSpread(resource_info.GPUResourceName)
+	gpuStrategy := pp.pluginArguments[constants.GPUResource]
+	if gpuStrategy == constants.AutoStrategy {
+		pp.autoPlacement = ssn.PluginState(pluginName, func() any { return newAutoPlacementState() }).(*autoPlacementState)
+		// Shadow sessions choose the strategy on a copy of the state, which is only kept by the primary session
+		if ssn.IsShadow() {
+			autoPlacementCopy := *pp.autoPlacement
+			pp.autoPlacement = &autoPlacementCopy
+		}
+		gpuStrategy = pp.autoPlacement.strategyForSession(ssn, pp.autoPlacementConfig)
+	}
+
+	gpuFns := pp.gpuPlacementFnsFor(gpuStrategy)
+	pp.gpuPreOrderFn = gpuFns.preOrderFn
+	pp.gpuTaskScoreFn = gpuFns.taskScoreFn
+
+	pp.podGroupInfos = ssn.ClusterInfo.PodGroupInfos
+	pp.workloadClassGPUFns = map[enginev2alpha2.WorkloadClass]gpuPlacementFns{}
+	for _, workloadClass := range []enginev2alpha2.WorkloadClass{
+		enginev2alpha2.Guaranteed, enginev2alpha2.BurstableGPU, enginev2alpha2.BestEffortGPU,
+	} {
+		if strategy, found := pp.pluginArguments[string(workloadClass)]; found {
+			pp.workloadClassGPUFns[workloadClass] = pp.gpuPlacementFnsFor(strategy)
+		}
 	}
 
 	pp.cpuTaskScoreFn = pp.nodeResourcePack(v1.ResourceCPU)
@@ -72,10 +110,41 @@ func (pp *nodePlacementPlugin) OnSessionOpen(ssn *framework.Session) {
 	ssn.AddNodeOrderFn(pp.nodeOrderFn)
 }
 
+// gpuPlacementFnsFor returns the placement functions of GPU tasks for the strategy, packing tasks by default
+func (pp *nodePlacementPlugin) gpuPlacementFnsFor(strategy string) gpuPlacementFns {
+	if strategy == constants.SpreadStrategy {
+		return gpuPlacementFns{
+			preOrderFn:  noopPreOrderFn,
+			taskScoreFn: nodeResourceSpread(resource_info.GPUResourceName),
+		}
+	}
+	return gpuPlacementFns{
+		preOrderFn:  pp.setBinpackPreOrder,
+		taskScoreFn: pp.nodeResourcePack(resource_info.GPUResourceName),
+	}
+}
+
+// gpuPlacementFnsOf returns the GPU placement functions of the workload class of the task's job, if the class has its
+// own strategy
+func (pp *nodePlacementPlugin) gpuPlacementFnsOf(task *pod_info.PodInfo) (gpuPlacementFns, bool) {
+	if task == nil || len(pp.workloadClassGPUFns) == 0 {
+		return gpuPlacementFns{}, false
+	}
+	job, found := pp.podGroupInfos[task.Job]
+	if !found {
+		return gpuPlacementFns{}, false
+	}
+	fns, found := pp.workloadClassGPUFns[job.WorkloadClass]
+	return fns, found
+}
+
 func (pp *nodePlacementPlugin) nodeOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
 	if task != nil && task.IsCPUOnlyRequest() {
 		return pp.cpuTaskScoreFn(task, node)
 	}
+	if fns, found := pp.gpuPlacementFnsOf(task); found {
+		return fns.taskScoreFn(task, node)
+	}
 	return pp.gpuTaskScoreFn(task, node)
 }
 
@@ -83,6 +152,9 @@ func (pp *nodePlacementPlugin) nodePreOrderFn(task *pod_info.PodInfo, fittingNod
 	if task != nil && task.IsCPUOnlyRequest() {
 		return pp.cpuPreOrderFn(task, fittingNodes)
 	}
+	if fns, found := pp.gpuPlacementFnsOf(task); found {
+		return fns.preOrderFn(task, fittingNodes)
+	}
 	return pp.gpuPreOrderFn(task, fittingNodes)
 }
 
@@ -0,0 +1,63 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeplacement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+func TestWorkloadClassGPUStrategy(t *testing.T) {
+	tests := []struct {
+		name                 string
+		workloadClass        enginev2alpha2.WorkloadClass
+		expectPreferUsedNode bool
+	}{
+		{name: "job without a workload class uses the gpu strategy", expectPreferUsedNode: true},
+		{name: "workload class without a strategy uses the gpu strategy", workloadClass: enginev2alpha2.Guaranteed,
+			expectPreferUsedNode: true},
+		{name: "workload class with its own strategy", workloadClass: enginev2alpha2.BestEffortGPU,
+			expectPreferUsedNode: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := buildPendingTask(0, "1")
+			task.Job = "pg"
+			job := podgroup_info.NewPodGroupInfo("pg", task)
+			job.WorkloadClass = tt.workloadClass
+			ssn := &framework.Session{ClusterInfo: &api.ClusterInfo{
+				PodGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{"pg": job},
+			}}
+
+			plugin := New(framework.PluginArguments{
+				constants.GPUResource:                constants.BinpackStrategy,
+				string(enginev2alpha2.BestEffortGPU): constants.SpreadStrategy,
+			}).(*nodePlacementPlugin)
+			plugin.OnSessionOpen(ssn)
+
+			usedNode, freeNode := buildGpuNode(4, 1), buildGpuNode(4, 4)
+			assert.NoError(t, plugin.nodePreOrderFn(task, []*node_info.NodeInfo{usedNode, freeNode}))
+			usedScore := nodeScore(t, plugin, task, usedNode)
+			freeScore := nodeScore(t, plugin, task, freeNode)
+			assert.Equal(t, tt.expectPreferUsedNode, usedScore > freeScore,
+				"used node score %v, free node score %v", usedScore, freeScore)
+		})
+	}
+}
+
+func nodeScore(t *testing.T, plugin *nodePlacementPlugin, task *pod_info.PodInfo, node *node_info.NodeInfo) float64 {
+	score, err := plugin.nodeOrderFn(task, node)
+	assert.NoError(t, err)
+	return score
+}
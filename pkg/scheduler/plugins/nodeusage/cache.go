@@ -0,0 +1,86 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeusage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// usageCache holds the last node usage fetched from the source. The source is queried at most once per refresh
+// interval, in the background, so that scheduling sessions never wait for it. It is created once per scheduler and
+// kept in its plugin state.
+type usageCache struct {
+	mutex sync.Mutex
+
+	sourceKey string
+	source    usageSource
+
+	usages      map[string]nodeUsage
+	lastUpdate  time.Time
+	lastAttempt time.Time
+	fetching    bool
+}
+
+func newUsageCache() *usageCache {
+	return &usageCache{}
+}
+
+// setSource replaces the source of the cache, dropping the usage it fetched, if the source arguments changed
+func (c *usageCache) setSource(key string, build func() (usageSource, error)) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.source != nil && c.sourceKey == key {
+		return nil
+	}
+	source, err := build()
+	if err != nil {
+		return err
+	}
+	c.sourceKey = key
+	c.source = source
+	c.usages = nil
+	c.lastUpdate = time.Time{}
+	c.lastAttempt = time.Time{}
+	return nil
+}
+
+// get returns the usage of the nodes if it is not older than the staleness period, and starts a refresh if the last
+// attempt is older than the refresh interval
+func (c *usageCache) get(now time.Time, refreshInterval, stalenessPeriod time.Duration) map[string]nodeUsage {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.source != nil && !c.fetching && now.Sub(c.lastAttempt) >= refreshInterval {
+		c.fetching = true
+		c.lastAttempt = now
+		go c.refresh(c.source)
+	}
+
+	if c.usages == nil || now.Sub(c.lastUpdate) > stalenessPeriod {
+		return nil
+	}
+	return c.usages
+}
+
+func (c *usageCache) refresh(source usageSource) {
+	usages, err := source.fetch(context.Background())
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.fetching = false
+	if err != nil {
+		log.InfraLogger.V(2).Warnf("Failed to fetch node usage: %v", err)
+		return
+	}
+	if source != c.source {
+		return
+	}
+	c.usages = usages
+	c.lastUpdate = time.Now()
+}
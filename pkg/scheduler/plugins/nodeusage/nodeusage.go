@@ -0,0 +1,166 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeusage
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+const (
+	pluginName             = "nodeusage"
+	defaultRefreshInterval = 30 * time.Second
+	defaultStalenessPeriod = 5 * time.Minute
+	defaultQueryTimeout    = 10 * time.Second
+	defaultThreshold       = 0.5
+	defaultWeight          = scores.ResourceType
+)
+
+type nodeUsagePlugin struct {
+	arguments       framework.PluginArguments
+	cache           *usageCache
+	refreshInterval time.Duration
+	stalenessPeriod time.Duration
+	threshold       float64
+	weight          float64
+
+	usages map[string]nodeUsage
+}
+
+func New(arguments framework.PluginArguments) framework.Plugin {
+	refreshInterval, err := arguments.GetDuration("refreshInterval", defaultRefreshInterval)
+	if err != nil {
+		log.InfraLogger.Warningf("Failed to parse refreshInterval: %v. Using default value of %s",
+			err, defaultRefreshInterval)
+	}
+	stalenessPeriod, err := arguments.GetDuration("stalenessPeriod", defaultStalenessPeriod)
+	if err != nil {
+		log.InfraLogger.Warningf("Failed to parse stalenessPeriod: %v. Using default value of %s",
+			err, defaultStalenessPeriod)
+	}
+	threshold, err := arguments.GetFloat64("threshold", defaultThreshold)
+	if err != nil || threshold < 0 || threshold >= 1 {
+		log.InfraLogger.Warningf("threshold must be in [0, 1), got %q. Using default value of %v",
+			arguments["threshold"], defaultThreshold)
+		threshold = defaultThreshold
+	}
+	weight, err := arguments.GetFloat64("weight", defaultWeight)
+	if err != nil || weight < 0 {
+		log.InfraLogger.Warningf("weight must be a non-negative number, got %q. Using default value of %v",
+			arguments["weight"], defaultWeight)
+		weight = defaultWeight
+	}
+
+	return &nodeUsagePlugin{
+		arguments:       arguments,
+		refreshInterval: refreshInterval,
+		stalenessPeriod: stalenessPeriod,
+		threshold:       threshold,
+		weight:          weight,
+	}
+}
+
+// ValidateArguments rejects nodeusage plugin arguments that can't be parsed
+func ValidateArguments(arguments framework.PluginArguments) error {
+	switch source := arguments.GetString("source", metricsServerSource); source {
+	case metricsServerSource:
+	case prometheusSource:
+		if arguments.GetString("prometheusAddress", "") == "" {
+			return fmt.Errorf("prometheusAddress is required for the %s source", prometheusSource)
+		}
+	default:
+		return fmt.Errorf("unknown source %q, expected %s or %s", source, metricsServerSource, prometheusSource)
+	}
+	for _, key := range []string{"refreshInterval", "stalenessPeriod", "queryTimeout"} {
+		if _, err := arguments.GetDuration(key, 0); err != nil {
+			return fmt.Errorf("invalid %s: %w", key, err)
+		}
+	}
+	threshold, err := arguments.GetFloat64("threshold", defaultThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid threshold: %w", err)
+	}
+	if threshold < 0 || threshold >= 1 {
+		return fmt.Errorf("threshold must be in [0, 1), got %v", threshold)
+	}
+	weight, err := arguments.GetFloat64("weight", defaultWeight)
+	if err != nil {
+		return fmt.Errorf("invalid weight: %w", err)
+	}
+	if weight < 0 {
+		return fmt.Errorf("weight must be non-negative, got %v", weight)
+	}
+	return nil
+}
+
+func (nup *nodeUsagePlugin) Name() string {
+	return pluginName
+}
+
+func (nup *nodeUsagePlugin) OnSessionOpen(ssn *framework.Session) {
+	nup.cache = ssn.PluginState(pluginName, func() any { return newUsageCache() }).(*usageCache)
+	// Shadow sessions read the usage of the primary session's source
+	if ssn.Cache != nil && !ssn.IsShadow() {
+		if err := nup.cache.setSource(nup.sourceKey(), func() (usageSource, error) {
+			return nup.buildSource(ssn)
+		}); err != nil {
+			log.InfraLogger.Errorf("Failed to create the node usage source: %v", err)
+		}
+	}
+	nup.usages = nup.cache.get(ssn.Clock().Now(), nup.refreshInterval, nup.stalenessPeriod)
+	ssn.AddNodeOrderFn(nup.nodeOrderFn)
+}
+
+func (nup *nodeUsagePlugin) sourceKey() string {
+	return fmt.Sprintf("%v", map[string]string(nup.arguments))
+}
+
+func (nup *nodeUsagePlugin) buildSource(ssn *framework.Session) (usageSource, error) {
+	queryTimeout, err := nup.arguments.GetDuration("queryTimeout", defaultQueryTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if nup.arguments.GetString("source", metricsServerSource) == prometheusSource {
+		return newPrometheusUsageSource(nup.arguments.GetString("prometheusAddress", ""), queryTimeout,
+			nup.arguments.GetString("cpuQuery", defaultCPUQuery),
+			nup.arguments.GetString("memoryQuery", defaultMemoryQuery),
+			nup.arguments.GetString("gpuQuery", defaultGPUQuery))
+	}
+	return &metricsServerUsageSource{
+		kubeClient:   ssn.Cache.KubeClient(),
+		nodeLister:   ssn.Cache.KubeInformerFactory().Core().V1().Nodes().Lister(),
+		queryTimeout: queryTimeout,
+	}, nil
+}
+
+// nodeOrderFn scores nodes by their actual usage: nodes whose usage is below the threshold get the full weight, and
+// the score drops linearly to 0 as the usage grows from the threshold to 100%. The GPU usage of a node is only
+// considered for tasks that request GPUs. Nodes with no usage data are not penalized.
+func (nup *nodeUsagePlugin) nodeOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
+	usage, found := nup.usages[node.Name]
+	if !found {
+		return nup.weight, nil
+	}
+
+	pressure := math.Max(usage.cpu, usage.memory)
+	if !task.IsCPUOnlyRequest() {
+		pressure = math.Max(pressure, usage.gpu)
+	}
+	penalty := math.Min(math.Max(pressure-nup.threshold, 0)/(1-nup.threshold), 1)
+	score := nup.weight * (1 - penalty)
+
+	log.InfraLogger.V(7).Infof(
+		"Estimating Task: <%v/%v> Job: <%v> for node: <%s> by node usage. Pressure: %f, Score: %f",
+		task.Namespace, task.Name, task.Job, node.Name, pressure, score)
+	return score, nil
+}
+
+func (nup *nodeUsagePlugin) OnSessionClose(_ *framework.Session) {}
@@ -0,0 +1,170 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeusage
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+type fakeSource struct {
+	mutex   sync.Mutex
+	calls   int
+	usages  map[string]nodeUsage
+	err     error
+	fetched chan struct{}
+}
+
+func newFakeSource(usages map[string]nodeUsage, err error) *fakeSource {
+	return &fakeSource{usages: usages, err: err, fetched: make(chan struct{}, 10)}
+}
+
+func (s *fakeSource) fetch(_ context.Context) (map[string]nodeUsage, error) {
+	s.mutex.Lock()
+	s.calls++
+	s.mutex.Unlock()
+	defer func() { s.fetched <- struct{}{} }()
+	return s.usages, s.err
+}
+
+func (s *fakeSource) callCount() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.calls
+}
+
+// waitForRefresh waits until the cache stored the result of the fetch the fake source returned
+func waitForRefresh(t *testing.T, cache *usageCache, source *fakeSource) {
+	select {
+	case <-source.fetched:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the node usage fetch")
+	}
+	assert.Eventually(t, func() bool {
+		cache.mutex.Lock()
+		defer cache.mutex.Unlock()
+		return !cache.fetching
+	}, 5*time.Second, time.Millisecond)
+}
+
+func TestNodeOrderFn(t *testing.T) {
+	usages := map[string]nodeUsage{
+		"idle":     {cpu: 0.1, memory: 0.2, gpu: 0.0},
+		"hot-cpu":  {cpu: 0.9, memory: 0.2, gpu: 0.0},
+		"full-mem": {cpu: 0.1, memory: 1.0, gpu: 0.0},
+		"hot-gpu":  {cpu: 0.1, memory: 0.2, gpu: 0.75},
+	}
+
+	tests := []struct {
+		name          string
+		node          string
+		gpuTask       bool
+		expectedScore float64
+	}{
+		{name: "node without usage data", node: "unknown", expectedScore: 10},
+		{name: "usage below threshold", node: "idle", expectedScore: 10},
+		{name: "high cpu usage", node: "hot-cpu", expectedScore: 2},
+		{name: "full memory", node: "full-mem", expectedScore: 0},
+		{name: "high gpu usage for cpu task", node: "hot-gpu", expectedScore: 10},
+		{name: "high gpu usage for gpu task", node: "hot-gpu", gpuTask: true, expectedScore: 5},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plugin := New(framework.PluginArguments{}).(*nodeUsagePlugin)
+			plugin.usages = usages
+
+			gpus := 0.0
+			if test.gpuTask {
+				gpus = 1
+			}
+			task := &pod_info.PodInfo{Name: "task", ResReq: resource_info.NewResourceRequirements(gpus, 500, 0)}
+			node := &node_info.NodeInfo{Name: test.node}
+
+			score, err := plugin.nodeOrderFn(task, node)
+			assert.NoError(t, err)
+			assert.InDelta(t, test.expectedScore, score, 1e-9)
+		})
+	}
+}
+
+func TestUsageCache(t *testing.T) {
+	usages := map[string]nodeUsage{"node-1": {cpu: 0.8}}
+	source := newFakeSource(usages, nil)
+	cache := newUsageCache()
+	assert.NoError(t, cache.setSource("a", func() (usageSource, error) { return source, nil }))
+
+	now := time.Now()
+	assert.Nil(t, cache.get(now, time.Minute, 5*time.Minute), "no usage before the first fetch")
+	waitForRefresh(t, cache, source)
+
+	assert.Equal(t, usages, cache.get(now.Add(time.Second), time.Minute, 5*time.Minute))
+	assert.Equal(t, 1, source.callCount(), "fetched again within the refresh interval")
+
+	assert.Equal(t, usages, cache.get(now.Add(2*time.Minute), time.Minute, time.Hour))
+	waitForRefresh(t, cache, source)
+	assert.Equal(t, 2, source.callCount(), "not fetched after the refresh interval")
+
+	assert.Nil(t, cache.get(time.Now().Add(time.Hour), 2*time.Hour, 5*time.Minute), "returned stale usage")
+
+	assert.NoError(t, cache.setSource("a", func() (usageSource, error) {
+		return nil, errors.New("rebuilt the source with unchanged arguments")
+	}))
+}
+
+func TestUsageCacheKeepsUsageOnFetchError(t *testing.T) {
+	usages := map[string]nodeUsage{"node-1": {cpu: 0.8}}
+	source := newFakeSource(usages, nil)
+	cache := newUsageCache()
+	assert.NoError(t, cache.setSource("a", func() (usageSource, error) { return source, nil }))
+
+	now := time.Now()
+	cache.get(now, time.Minute, time.Hour)
+	waitForRefresh(t, cache, source)
+
+	source.err = errors.New("prometheus is down")
+	cache.get(now.Add(2*time.Minute), time.Minute, time.Hour)
+	waitForRefresh(t, cache, source)
+	assert.Equal(t, usages, cache.get(now.Add(3*time.Minute), time.Hour, time.Hour))
+}
+
+func TestValidateArguments(t *testing.T) {
+	tests := []struct {
+		name      string
+		arguments framework.PluginArguments
+		expectErr bool
+	}{
+		{name: "defaults", arguments: framework.PluginArguments{}},
+		{name: "prometheus", arguments: framework.PluginArguments{
+			"source": "prometheus", "prometheusAddress": "http://prometheus:9090", "refreshInterval": "1m"}},
+		{name: "prometheus without address", arguments: framework.PluginArguments{"source": "prometheus"},
+			expectErr: true},
+		{name: "unknown source", arguments: framework.PluginArguments{"source": "datadog"}, expectErr: true},
+		{name: "invalid refresh interval", arguments: framework.PluginArguments{"refreshInterval": "often"},
+			expectErr: true},
+		{name: "threshold out of range", arguments: framework.PluginArguments{"threshold": "1"}, expectErr: true},
+		{name: "negative weight", arguments: framework.PluginArguments{"weight": "-1"}, expectErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateArguments(test.arguments)
+			if test.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
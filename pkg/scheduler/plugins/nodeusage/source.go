@@ -0,0 +1,179 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeusage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	promapi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	listersv1 "k8s.io/client-go/listers/core/v1"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const (
+	metricsServerSource = "metrics-server"
+	prometheusSource    = "prometheus"
+
+	nodeMetricsPath = "/apis/metrics.k8s.io/v1beta1/nodes"
+	nodeLabel       = "node"
+
+	defaultCPUQuery    = `1 - avg by (node) (rate(node_cpu_seconds_total{mode="idle"}[5m]))`
+	defaultMemoryQuery = `1 - avg by (node) (node_memory_MemAvailable_bytes / node_memory_MemTotal_bytes)`
+	defaultGPUQuery    = `avg by (node) (label_replace(DCGM_FI_DEV_GPU_UTIL, "node", "$1", "Hostname", "(.*)")) / 100`
+)
+
+// nodeUsage is the fraction of the allocatable resources of a node that is actually in use, between 0 and 1
+type nodeUsage struct {
+	cpu    float64
+	memory float64
+	gpu    float64
+}
+
+type usageSource interface {
+	fetch(ctx context.Context) (map[string]nodeUsage, error)
+}
+
+// metricsServerUsageSource reads the CPU and memory usage of nodes from the metrics API served by metrics-server.
+// The metrics API has no GPU usage.
+type metricsServerUsageSource struct {
+	kubeClient   kubernetes.Interface
+	nodeLister   listersv1.NodeLister
+	queryTimeout time.Duration
+}
+
+// +kubebuilder:rbac:groups="metrics.k8s.io",resources=nodes,verbs=get;list
+
+func (s *metricsServerUsageSource) fetch(ctx context.Context) (map[string]nodeUsage, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.queryTimeout)
+	defer cancel()
+
+	raw, err := s.kubeClient.CoreV1().RESTClient().Get().AbsPath(nodeMetricsPath).Do(ctx).Raw()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node metrics: %w", err)
+	}
+	nodeMetrics := &metricsv1beta1.NodeMetricsList{}
+	if err = json.Unmarshal(raw, nodeMetrics); err != nil {
+		return nil, fmt.Errorf("failed to parse node metrics: %w", err)
+	}
+
+	nodes, err := s.nodeLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	allocatable := map[string]v1.ResourceList{}
+	for _, node := range nodes {
+		allocatable[node.Name] = node.Status.Allocatable
+	}
+
+	usages := map[string]nodeUsage{}
+	for _, metrics := range nodeMetrics.Items {
+		nodeAllocatable, found := allocatable[metrics.Name]
+		if !found {
+			continue
+		}
+		usages[metrics.Name] = nodeUsage{
+			cpu:    usedFraction(metrics.Usage, nodeAllocatable, v1.ResourceCPU),
+			memory: usedFraction(metrics.Usage, nodeAllocatable, v1.ResourceMemory),
+		}
+	}
+	return usages, nil
+}
+
+func usedFraction(usage, allocatable v1.ResourceList, resource v1.ResourceName) float64 {
+	allocatableQuantity, found := allocatable[resource]
+	if !found || allocatableQuantity.IsZero() {
+		return 0
+	}
+	usedQuantity := usage[resource]
+	return usedQuantity.AsApproximateFloat64() / allocatableQuantity.AsApproximateFloat64()
+}
+
+// prometheusUsageSource reads the usage of nodes from prometheus. Every query returns the fraction of a resource that
+// is used on each node, labeled by node name.
+type prometheusUsageSource struct {
+	client       promv1.API
+	queryTimeout time.Duration
+	cpuQuery     string
+	memoryQuery  string
+	gpuQuery     string
+}
+
+func newPrometheusUsageSource(address string, queryTimeout time.Duration, cpuQuery, memoryQuery, gpuQuery string,
+) (*prometheusUsageSource, error) {
+	client, err := promapi.NewClient(promapi.Config{Address: address})
+	if err != nil {
+		return nil, fmt.Errorf("error creating prometheus client: %v", err)
+	}
+	return &prometheusUsageSource{
+		client:       promv1.NewAPI(client),
+		queryTimeout: queryTimeout,
+		cpuQuery:     cpuQuery,
+		memoryQuery:  memoryQuery,
+		gpuQuery:     gpuQuery,
+	}, nil
+}
+
+func (s *prometheusUsageSource) fetch(ctx context.Context) (map[string]nodeUsage, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.queryTimeout)
+	defer cancel()
+
+	usages := map[string]nodeUsage{}
+	queries := []struct {
+		query string
+		set   func(usage *nodeUsage, value float64)
+	}{
+		{s.cpuQuery, func(usage *nodeUsage, value float64) { usage.cpu = value }},
+		{s.memoryQuery, func(usage *nodeUsage, value float64) { usage.memory = value }},
+		{s.gpuQuery, func(usage *nodeUsage, value float64) { usage.gpu = value }},
+	}
+	for _, query := range queries {
+		if query.query == "" {
+			continue
+		}
+		values, err := s.queryByNode(ctx, query.query)
+		if err != nil {
+			return nil, err
+		}
+		for nodeName, value := range values {
+			usage := usages[nodeName]
+			query.set(&usage, value)
+			usages[nodeName] = usage
+		}
+	}
+	return usages, nil
+}
+
+func (s *prometheusUsageSource) queryByNode(ctx context.Context, query string) (map[string]float64, error) {
+	result, warnings, err := s.client.Query(ctx, query, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("error querying prometheus with %q: %w", query, err)
+	}
+	if len(warnings) > 0 {
+		log.InfraLogger.V(4).Warnf("Warnings querying prometheus with %q: %v", query, warnings)
+	}
+	vector, ok := result.(model.Vector)
+	if !ok {
+		return nil, fmt.Errorf("unexpected result type %s for query %q, expected a vector", result.Type(), query)
+	}
+
+	values := map[string]float64{}
+	for _, sample := range vector {
+		nodeName := string(sample.Metric[nodeLabel])
+		if nodeName == "" {
+			continue
+		}
+		values[nodeName] = float64(sample.Value)
+	}
+	return values, nil
+}
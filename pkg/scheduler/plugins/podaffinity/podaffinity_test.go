@@ -4,6 +4,7 @@
 package podaffinity
 
 import (
+	"context"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -199,11 +200,13 @@ func testPodPreferredAffinity(testData testInput, clusterAffinityInfo pod_affini
 
 	sessionId := "1"
 	ssn, err := framework.OpenSession(
+		context.Background(),
 		mockCache,
 		&conf.SchedulerConfiguration{Tiers: []conf.Tier{}},
 		&conf.SchedulerParams{},
 		sessionId,
 		nil,
+		framework.SessionOptions{},
 	)
 	Expect(err).To(Succeed())
 
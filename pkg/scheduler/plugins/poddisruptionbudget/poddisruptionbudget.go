@@ -0,0 +1,209 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package poddisruptionbudget
+
+import (
+	"fmt"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const (
+	pluginName = "poddisruptionbudget"
+
+	modeArgument   = "mode"
+	modeStrict     = "strict"
+	modeBestEffort = "bestEffort"
+)
+
+// budget is a pod disruption budget in the session
+type budget struct {
+	name               string
+	disruptionsAllowed int32
+	// pods are the pods covered by the budget that were running when the session opened
+	pods []*pod_info.PodInfo
+}
+
+type podDisruptionBudgetPlugin struct {
+	strict bool
+
+	podBudgets map[common_info.PodID][]*budget
+	// jobPods are the pods of every job that are covered by a budget
+	jobPods map[common_info.PodGroupID][]*pod_info.PodInfo
+}
+
+func New(arguments framework.PluginArguments) framework.Plugin {
+	mode := arguments[modeArgument]
+	if mode == "" {
+		mode = modeStrict
+	}
+	if mode != modeStrict && mode != modeBestEffort {
+		log.InfraLogger.Warningf("mode must be %s or %s, got %q. Using default value of %s",
+			modeStrict, modeBestEffort, mode, modeStrict)
+		mode = modeStrict
+	}
+	return &podDisruptionBudgetPlugin{strict: mode == modeStrict}
+}
+
+// ValidateArguments rejects poddisruptionbudget plugin arguments with an unknown mode
+func ValidateArguments(arguments framework.PluginArguments) error {
+	mode, found := arguments[modeArgument]
+	if found && mode != modeStrict && mode != modeBestEffort {
+		return fmt.Errorf("mode must be %s or %s, got %q", modeStrict, modeBestEffort, mode)
+	}
+	return nil
+}
+
+func (pdbp *podDisruptionBudgetPlugin) Name() string {
+	return pluginName
+}
+
+func (pdbp *podDisruptionBudgetPlugin) OnSessionOpen(ssn *framework.Session) {
+	pdbp.podBudgets, pdbp.jobPods = coveredPods(ssn.ClusterInfo.PodDisruptionBudgets, ssn.ClusterInfo.PodGroupInfos)
+
+	ssn.AddJobOrderFn(pdbp.jobOrderFn)
+	if pdbp.strict {
+		ssn.AddPreemptVictimFilterFn(pdbp.victimFilterFn)
+		ssn.AddReclaimVictimFilterFn(pdbp.victimFilterFn)
+		ssn.AddPreemptScenarioValidatorFn(pdbp.scenarioValidatorFn)
+		ssn.AddReclaimScenarioValidatorFn(pdbp.scenarioValidatorFn)
+	}
+}
+
+func (pdbp *podDisruptionBudgetPlugin) OnSessionClose(_ *framework.Session) {
+	pdbp.podBudgets = nil
+	pdbp.jobPods = nil
+}
+
+// coveredPods matches the running pods of the jobs to the budgets that select them
+func coveredPods(pdbs []*policyv1.PodDisruptionBudget, jobs map[common_info.PodGroupID]*podgroup_info.PodGroupInfo) (
+	map[common_info.PodID][]*budget, map[common_info.PodGroupID][]*pod_info.PodInfo) {
+	type namespacedBudget struct {
+		*budget
+		selector labels.Selector
+	}
+	budgetsByNamespace := map[string][]namespacedBudget{}
+	for _, pdb := range pdbs {
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			log.InfraLogger.Warningf("Failed to parse the selector of pod disruption budget %s/%s: %v",
+				pdb.Namespace, pdb.Name, err)
+			continue
+		}
+		budgetsByNamespace[pdb.Namespace] = append(budgetsByNamespace[pdb.Namespace], namespacedBudget{
+			budget: &budget{
+				name:               fmt.Sprintf("%s/%s", pdb.Namespace, pdb.Name),
+				disruptionsAllowed: pdb.Status.DisruptionsAllowed,
+			},
+			selector: selector,
+		})
+	}
+
+	podBudgets := map[common_info.PodID][]*budget{}
+	jobPods := map[common_info.PodGroupID][]*pod_info.PodInfo{}
+	if len(budgetsByNamespace) == 0 {
+		return podBudgets, jobPods
+	}
+	for _, job := range jobs {
+		for _, task := range job.GetAllPodsMap() {
+			if task.Status != pod_status.Running || task.Pod == nil {
+				continue
+			}
+			for _, b := range budgetsByNamespace[task.Namespace] {
+				if !b.selector.Matches(labels.Set(task.Pod.Labels)) {
+					continue
+				}
+				b.pods = append(b.pods, task)
+				podBudgets[task.UID] = append(podBudgets[task.UID], b.budget)
+			}
+			if len(podBudgets[task.UID]) > 0 {
+				jobPods[job.UID] = append(jobPods[job.UID], task)
+			}
+		}
+	}
+	return podBudgets, jobPods
+}
+
+// violatedBudget returns a budget whose disruptions would exceed the allowed disruptions if the victims were evicted,
+// in addition to the pods that were already evicted in the session, or nil if no budget is violated
+func (pdbp *podDisruptionBudgetPlugin) violatedBudget(victims []*pod_info.PodInfo) *budget {
+	victimUIDs := map[common_info.PodID]bool{}
+	checked := map[*budget]bool{}
+	var budgets []*budget
+	for _, victim := range victims {
+		victimUIDs[victim.UID] = true
+		for _, b := range pdbp.podBudgets[victim.UID] {
+			if !checked[b] {
+				checked[b] = true
+				budgets = append(budgets, b)
+			}
+		}
+	}
+
+	for _, b := range budgets {
+		var disruptions int32
+		for _, pod := range b.pods {
+			if victimUIDs[pod.UID] || pod.Status != pod_status.Running {
+				disruptions++
+			}
+		}
+		if disruptions > b.disruptionsAllowed {
+			return b
+		}
+	}
+	return nil
+}
+
+// isProtected returns whether evicting all the covered pods of the job would violate a budget
+func (pdbp *podDisruptionBudgetPlugin) isProtected(job *podgroup_info.PodGroupInfo) bool {
+	pods := pdbp.jobPods[job.UID]
+	return len(pods) > 0 && pdbp.violatedBudget(pods) != nil
+}
+
+// jobOrderFn orders the jobs whose eviction would violate a budget before the other jobs, so they are the last to be
+// picked as victims
+func (pdbp *podDisruptionBudgetPlugin) jobOrderFn(l, r interface{}) int {
+	lProtected := pdbp.isProtected(l.(*podgroup_info.PodGroupInfo))
+	rProtected := pdbp.isProtected(r.(*podgroup_info.PodGroupInfo))
+	if lProtected == rProtected {
+		return 0
+	}
+	if lProtected {
+		return -1
+	}
+	return 1
+}
+
+// victimFilterFn rejects victims whose eviction would violate a budget. Elastic victims can be partially evicted, so
+// they are checked by the scenario validator.
+func (pdbp *podDisruptionBudgetPlugin) victimFilterFn(_ *podgroup_info.PodGroupInfo, victim *podgroup_info.PodGroupInfo) bool {
+	if victim.IsElastic() {
+		return true
+	}
+	return !pdbp.isProtected(victim)
+}
+
+func (pdbp *podDisruptionBudgetPlugin) scenarioValidatorFn(scenario api.ScenarioInfo) bool {
+	var victims []*pod_info.PodInfo
+	for _, victimInfo := range scenario.GetVictims() {
+		victims = append(victims, victimInfo.Tasks...)
+	}
+	if b := pdbp.violatedBudget(victims); b != nil {
+		preemptor := scenario.GetPreemptor()
+		log.InfraLogger.V(5).Infof("Evicting victims for job <%s/%s> would violate pod disruption budget %s",
+			preemptor.Namespace, preemptor.Name, b.name)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package poddisruptionbudget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+func newPod(uid, job, namespace string, status pod_status.PodStatus, labels map[string]string) *pod_info.PodInfo {
+	return &pod_info.PodInfo{
+		UID:       common_info.PodID(uid),
+		Job:       common_info.PodGroupID(job),
+		Name:      uid,
+		Namespace: namespace,
+		Status:    status,
+		Pod: &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: uid, Namespace: namespace, Labels: labels},
+		},
+	}
+}
+
+func newPDB(name, namespace string, selector *metav1.LabelSelector, disruptionsAllowed int32) *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       policyv1.PodDisruptionBudgetSpec{Selector: selector},
+		Status:     policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: disruptionsAllowed},
+	}
+}
+
+func TestCoveredPods(t *testing.T) {
+	appLabels := map[string]string{"app": "web"}
+	jobs := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{
+		"web": podgroup_info.NewPodGroupInfo("web",
+			newPod("web-0", "web", "ns", pod_status.Running, appLabels),
+			newPod("web-1", "web", "ns", pod_status.Pending, appLabels),
+		),
+		"other-ns": podgroup_info.NewPodGroupInfo("other-ns",
+			newPod("other-ns-0", "other-ns", "other", pod_status.Running, appLabels),
+		),
+		"batch": podgroup_info.NewPodGroupInfo("batch",
+			newPod("batch-0", "batch", "ns", pod_status.Running, map[string]string{"app": "batch"}),
+		),
+	}
+	pdbs := []*policyv1.PodDisruptionBudget{
+		newPDB("web", "ns", &metav1.LabelSelector{MatchLabels: appLabels}, 0),
+		newPDB("all", "ns", &metav1.LabelSelector{}, 1),
+		newPDB("none", "ns", nil, 0),
+	}
+
+	podBudgets, jobPods := coveredPods(pdbs, jobs)
+
+	var budgetNames []string
+	for _, b := range podBudgets["web-0"] {
+		budgetNames = append(budgetNames, b.name)
+	}
+	assert.ElementsMatch(t, []string{"ns/web", "ns/all"}, budgetNames)
+	assert.NotContains(t, podBudgets, common_info.PodID("web-1"), "pending pods are not disrupted")
+	assert.NotContains(t, podBudgets, common_info.PodID("other-ns-0"))
+	assert.Len(t, podBudgets["batch-0"], 1)
+	assert.Len(t, jobPods["web"], 1)
+	assert.NotContains(t, jobPods, common_info.PodGroupID("other-ns"))
+}
+
+func TestViolatedBudget(t *testing.T) {
+	labels := map[string]string{"app": "web"}
+	pods := []*pod_info.PodInfo{
+		newPod("web-0", "web-0", "ns", pod_status.Running, labels),
+		newPod("web-1", "web-1", "ns", pod_status.Running, labels),
+		newPod("web-2", "web-2", "ns", pod_status.Running, labels),
+	}
+	jobs := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{}
+	for _, pod := range pods {
+		jobs[pod.Job] = podgroup_info.NewPodGroupInfo(pod.Job, pod)
+	}
+	plugin := &podDisruptionBudgetPlugin{}
+	plugin.podBudgets, plugin.jobPods = coveredPods(
+		[]*policyv1.PodDisruptionBudget{newPDB("web", "ns", &metav1.LabelSelector{MatchLabels: labels}, 1)}, jobs)
+
+	assert.Nil(t, plugin.violatedBudget(nil))
+	assert.Nil(t, plugin.violatedBudget(pods[:1]))
+	assert.NotNil(t, plugin.violatedBudget(pods[:2]))
+	assert.False(t, plugin.isProtected(jobs["web-0"]))
+
+	// Pods evicted earlier in the session spend the budget
+	pods[0].Status = pod_status.Releasing
+	assert.NotNil(t, plugin.violatedBudget(pods[1:2]))
+	assert.True(t, plugin.isProtected(jobs["web-1"]))
+	assert.Equal(t, -1, plugin.jobOrderFn(jobs["web-1"], jobs["web-0"]))
+}
+
+func TestNew(t *testing.T) {
+	assert.True(t, New(framework.PluginArguments{}).(*podDisruptionBudgetPlugin).strict)
+	assert.True(t, New(framework.PluginArguments{"mode": "strict"}).(*podDisruptionBudgetPlugin).strict)
+	assert.False(t, New(framework.PluginArguments{"mode": "bestEffort"}).(*podDisruptionBudgetPlugin).strict)
+	assert.True(t, New(framework.PluginArguments{"mode": "sometimes"}).(*podDisruptionBudgetPlugin).strict)
+}
+
+func TestValidateArguments(t *testing.T) {
+	assert.NoError(t, ValidateArguments(framework.PluginArguments{}))
+	assert.NoError(t, ValidateArguments(framework.PluginArguments{"mode": "strict"}))
+	assert.NoError(t, ValidateArguments(framework.PluginArguments{"mode": "bestEffort"}))
+	assert.Error(t, ValidateArguments(framework.PluginArguments{"mode": "sometimes"}))
+}
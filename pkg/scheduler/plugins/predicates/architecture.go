@@ -0,0 +1,64 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package predicates
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// evaluateTaskArchitecture keeps the GPU tasks of a pod group on nodes of a single architecture. In clusters that
+// mix amd64 and arm64 GPU nodes, a workload that does not select an architecture could otherwise be split between
+// them, while its images and collective communication libraries usually support only one.
+func evaluateTaskArchitecture(
+	task *pod_info.PodInfo, job *podgroup_info.PodGroupInfo, node *node_info.NodeInfo,
+	nodes map[string]*node_info.NodeInfo,
+) error {
+	if !task.IsRequireAnyKindOfGPU() {
+		return nil
+	}
+	nodeArch, found := nodeArchitecture(node)
+	if !found {
+		return nil
+	}
+
+	for _, jobTask := range job.GetAllPodsMap() {
+		if jobTask.UID == task.UID || jobTask.NodeName == "" || !pod_status.IsAliveStatus(jobTask.Status) ||
+			!jobTask.IsRequireAnyKindOfGPU() {
+			continue
+		}
+		jobTaskNode, found := nodes[jobTask.NodeName]
+		if !found {
+			continue
+		}
+		jobArch, found := nodeArchitecture(jobTaskNode)
+		if !found || jobArch == nodeArch {
+			continue
+		}
+
+		log.InfraLogger.V(6).Infof("Task <%s/%s> will not be allocated to node <%s> of architecture <%s>, "+
+			"task <%s/%s> of its pod group runs on architecture <%s>",
+			task.Namespace, task.Name, node.Name, nodeArch, jobTask.Namespace, jobTask.Name, jobArch)
+		return common_info.NewFitError(task.Name, task.Namespace, node.Name,
+			fmt.Sprintf("node architecture %s does not match architecture %s of the pod group's GPU pods",
+				nodeArch, jobArch))
+	}
+	return nil
+}
+
+func nodeArchitecture(node *node_info.NodeInfo) (string, bool) {
+	if node.Node == nil {
+		return "", false
+	}
+	arch, found := node.Node.Labels[v1.LabelArchStable]
+	return arch, found && arch != ""
+}
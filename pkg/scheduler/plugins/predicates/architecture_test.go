@@ -0,0 +1,110 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package predicates
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+)
+
+func Test_evaluateTaskArchitecture(t *testing.T) {
+	nodes := map[string]*node_info.NodeInfo{
+		"amd64-node":   newArchNode("amd64-node", "amd64"),
+		"amd64-node-2": newArchNode("amd64-node-2", "amd64"),
+		"arm64-node":   newArchNode("arm64-node", "arm64"),
+		"no-arch-node": newArchNode("no-arch-node", ""),
+	}
+
+	tests := []struct {
+		name      string
+		task      *pod_info.PodInfo
+		jobTasks  []*pod_info.PodInfo
+		node      string
+		expectErr bool
+	}{
+		{
+			name:     "first GPU task of the pod group",
+			task:     newArchTask("task-0", "", pod_status.Pending, 1),
+			jobTasks: []*pod_info.PodInfo{newArchTask("task-1", "", pod_status.Pending, 1)},
+			node:     "arm64-node",
+		},
+		{
+			name:     "GPU task on the architecture of the pod group",
+			task:     newArchTask("task-0", "", pod_status.Pending, 1),
+			jobTasks: []*pod_info.PodInfo{newArchTask("task-1", "amd64-node", pod_status.Allocated, 1)},
+			node:     "amd64-node-2",
+		},
+		{
+			name:      "GPU task on a different architecture than the pod group",
+			task:      newArchTask("task-0", "", pod_status.Pending, 1),
+			jobTasks:  []*pod_info.PodInfo{newArchTask("task-1", "amd64-node", pod_status.Running, 1)},
+			node:      "arm64-node",
+			expectErr: true,
+		},
+		{
+			name:     "CPU task on a different architecture than the pod group",
+			task:     newArchTask("task-0", "", pod_status.Pending, 0),
+			jobTasks: []*pod_info.PodInfo{newArchTask("task-1", "amd64-node", pod_status.Running, 1)},
+			node:     "arm64-node",
+		},
+		{
+			name:     "pod group CPU task on a different architecture",
+			task:     newArchTask("task-0", "", pod_status.Pending, 1),
+			jobTasks: []*pod_info.PodInfo{newArchTask("task-1", "amd64-node", pod_status.Running, 0)},
+			node:     "arm64-node",
+		},
+		{
+			name:     "pod group GPU task that is no longer alive",
+			task:     newArchTask("task-0", "", pod_status.Pending, 1),
+			jobTasks: []*pod_info.PodInfo{newArchTask("task-1", "amd64-node", pod_status.Succeeded, 1)},
+			node:     "arm64-node",
+		},
+		{
+			name:     "node without an architecture label",
+			task:     newArchTask("task-0", "", pod_status.Pending, 1),
+			jobTasks: []*pod_info.PodInfo{newArchTask("task-1", "amd64-node", pod_status.Running, 1)},
+			node:     "no-arch-node",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := podgroup_info.NewPodGroupInfo("job", append(tt.jobTasks, tt.task)...)
+			err := evaluateTaskArchitecture(tt.task, job, nodes[tt.node], nodes)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("evaluateTaskArchitecture() error = %v, expected error: %v", err, tt.expectErr)
+			}
+		})
+	}
+}
+
+func newArchNode(name, arch string) *node_info.NodeInfo {
+	labels := map[string]string{}
+	if arch != "" {
+		labels[v1.LabelArchStable] = arch
+	}
+	return &node_info.NodeInfo{
+		Name: name,
+		Node: &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}},
+	}
+}
+
+func newArchTask(name, nodeName string, status pod_status.PodStatus, gpus float64) *pod_info.PodInfo {
+	return &pod_info.PodInfo{
+		UID:       common_info.PodID(name),
+		Name:      name,
+		Namespace: "default",
+		NodeName:  nodeName,
+		Status:    status,
+		ResReq:    resource_info.NewResourceRequirementsWithGpus(gpus),
+	}
+}
@@ -113,6 +113,12 @@ func (pp *predicatesPlugin) OnSessionOpen(ssn *framework.Session) {
 		return pp.evaluateTaskOnPredicates(task, job, node, k8sPredicates,
 			ssn.IsTaskAllocationOnNodeOverCapacityFn, ssn.IsRestrictNodeSchedulingEnabled, pp.skipPredicates)
 	})
+
+	ssn.AddPredicateFn(func(task *pod_info.PodInfo, job *podgroup_info.PodGroupInfo, node *node_info.NodeInfo) error {
+		return evaluateTaskArchitecture(task, job, node, ssn.ClusterInfo.Nodes)
+	})
+
+	ssn.AddPredicateFn(evaluateTaskUniqueNodes)
 }
 
 func evaluateTaskOnPrePredicate(task *pod_info.PodInfo, k8sPredicates k8s_internal.SessionPredicates,
@@ -0,0 +1,79 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package predicates
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info/subgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// evaluateTaskUniqueNodes keeps the pods of a pod group, or of a subgroup, that requires unique nodes on different
+// nodes. Pods that are allocated in the current session are already on their nodes, so a gang that is allocated
+// pod by pod never gets two of its pods on the same node.
+func evaluateTaskUniqueNodes(task *pod_info.PodInfo, job *podgroup_info.PodGroupInfo, node *node_info.NodeInfo) error {
+	taskScopes := uniqueNodesScopes(job, task)
+	if len(taskScopes) == 0 {
+		return nil
+	}
+
+	for _, nodeTask := range node.PodInfos {
+		if nodeTask.Job != job.UID || nodeTask.UID == task.UID ||
+			!pod_status.IsActiveAllocatedStatus(nodeTask.Status) {
+			continue
+		}
+		for _, scope := range uniqueNodesScopes(job, nodeTask) {
+			if !slices.Contains(taskScopes, scope) {
+				continue
+			}
+			log.InfraLogger.V(6).Infof("Task <%s/%s> will not be allocated to node <%s>, task <%s/%s> of %s "+
+				"already runs on it", task.Namespace, task.Name, node.Name, nodeTask.Namespace, nodeTask.Name,
+				uniqueNodesScopeName(scope))
+			return common_info.NewFitError(task.Name, task.Namespace, node.Name,
+				fmt.Sprintf("node already has a pod of %s, which requires unique nodes",
+					uniqueNodesScopeName(scope)))
+		}
+	}
+	return nil
+}
+
+// uniqueNodesScopes returns the subgroups of the task, from its own subgroup up to the pod group, that require
+// their pods to be on different nodes
+func uniqueNodesScopes(job *podgroup_info.PodGroupInfo, task *pod_info.PodInfo) []*subgroup_info.SubGroupInfo {
+	subGroupName := podgroup_info.DefaultSubGroup
+	if task.SubGroupName != "" {
+		subGroupName = task.SubGroupName
+	}
+	podSet, found := job.GetSubGroups()[subGroupName]
+	if !found {
+		return nil
+	}
+
+	var scopes []*subgroup_info.SubGroupInfo
+	subGroup := &podSet.SubGroupInfo
+	for {
+		if subGroup.IsUniqueNodes() {
+			scopes = append(scopes, subGroup)
+		}
+		parent := subGroup.GetParent()
+		if parent == nil {
+			return scopes
+		}
+		subGroup = &parent.SubGroupInfo
+	}
+}
+
+func uniqueNodesScopeName(scope *subgroup_info.SubGroupInfo) string {
+	if scope.GetName() == subgroup_info.RootSubGroupSetName {
+		return "the pod group"
+	}
+	return fmt.Sprintf("subgroup %s", scope.GetName())
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package predicates
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+)
+
+func Test_evaluateTaskUniqueNodes(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       enginev2alpha2.PodGroupSpec
+		task       *pod_info.PodInfo
+		otherTask  *pod_info.PodInfo
+		expectErrs bool
+	}{
+		{
+			name:      "pod group without unique nodes",
+			task:      newUniqueNodesTask("task-0", "", pod_status.Pending),
+			otherTask: newUniqueNodesTask("task-1", "", pod_status.Running),
+		},
+		{
+			name:       "pod group with unique nodes",
+			spec:       enginev2alpha2.PodGroupSpec{UniqueNodes: true},
+			task:       newUniqueNodesTask("task-0", "", pod_status.Pending),
+			otherTask:  newUniqueNodesTask("task-1", "", pod_status.Allocated),
+			expectErrs: true,
+		},
+		{
+			name:      "pod group with unique nodes and a releasing pod on the node",
+			spec:      enginev2alpha2.PodGroupSpec{UniqueNodes: true},
+			task:      newUniqueNodesTask("task-0", "", pod_status.Pending),
+			otherTask: newUniqueNodesTask("task-1", "", pod_status.Releasing),
+		},
+		{
+			name:       "subgroup with unique nodes",
+			spec:       uniqueNodesSubGroupsSpec(),
+			task:       newUniqueNodesTask("task-0", "workers", pod_status.Pending),
+			otherTask:  newUniqueNodesTask("task-1", "workers", pod_status.Running),
+			expectErrs: true,
+		},
+		{
+			name:      "pods of different subgroups",
+			spec:      uniqueNodesSubGroupsSpec(),
+			task:      newUniqueNodesTask("task-0", "workers", pod_status.Pending),
+			otherTask: newUniqueNodesTask("task-1", "leader", pod_status.Running),
+		},
+		{
+			name:       "child subgroups of a parent with unique nodes",
+			spec:       uniqueNodesSubGroupsSpec(),
+			task:       newUniqueNodesTask("task-0", "replica-a", pod_status.Pending),
+			otherTask:  newUniqueNodesTask("task-1", "replica-b", pod_status.Running),
+			expectErrs: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := podgroup_info.NewPodGroupInfo("job")
+			job.SetPodGroup(&enginev2alpha2.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "default"},
+				Spec:       tt.spec,
+			})
+			job.AddTaskInfo(tt.task)
+			job.AddTaskInfo(tt.otherTask)
+
+			node := &node_info.NodeInfo{
+				Name:     "node-0",
+				PodInfos: map[common_info.PodID]*pod_info.PodInfo{tt.otherTask.UID: tt.otherTask},
+			}
+			err := evaluateTaskUniqueNodes(tt.task, job, node)
+			if (err != nil) != tt.expectErrs {
+				t.Errorf("evaluateTaskUniqueNodes() error = %v, expected error: %v", err, tt.expectErrs)
+			}
+
+			emptyNode := &node_info.NodeInfo{Name: "node-1", PodInfos: map[common_info.PodID]*pod_info.PodInfo{}}
+			if err := evaluateTaskUniqueNodes(tt.task, job, emptyNode); err != nil {
+				t.Errorf("evaluateTaskUniqueNodes() on an empty node error = %v", err)
+			}
+		})
+	}
+}
+
+func uniqueNodesSubGroupsSpec() enginev2alpha2.PodGroupSpec {
+	return enginev2alpha2.PodGroupSpec{
+		SubGroups: []enginev2alpha2.SubGroup{
+			{Name: "leader", MinMember: 1},
+			{Name: "workers", MinMember: 1, UniqueNodes: true},
+			{Name: "replicas", UniqueNodes: true},
+			{Name: "replica-a", MinMember: 1, Parent: ptr.To("replicas")},
+			{Name: "replica-b", MinMember: 1, Parent: ptr.To("replicas")},
+		},
+	}
+}
+
+func newUniqueNodesTask(name, subGroupName string, status pod_status.PodStatus) *pod_info.PodInfo {
+	nodeName := ""
+	if status != pod_status.Pending {
+		nodeName = "node-0"
+	}
+	return &pod_info.PodInfo{
+		UID:          common_info.PodID(name),
+		Job:          "job",
+		Name:         name,
+		Namespace:    "default",
+		NodeName:     nodeName,
+		SubGroupName: subGroupName,
+		Status:       status,
+	}
+}
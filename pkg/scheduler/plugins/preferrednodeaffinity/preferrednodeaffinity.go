@@ -0,0 +1,115 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package preferrednodeaffinity
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+type preferredNodeAffinityPlugin struct {
+	podGroupInfos map[common_info.PodGroupID]*podgroup_info.PodGroupInfo
+	// taskTerms holds the parsed preferred terms of every task the nodes were ordered for, so that the terms are
+	// parsed once per task rather than for every node
+	taskTerms map[common_info.PodID]*taskPreferredTerms
+}
+
+type taskPreferredTerms struct {
+	terms       *nodeaffinity.PreferredSchedulingTerms
+	totalWeight int64
+}
+
+func New(_ framework.PluginArguments) framework.Plugin {
+	return &preferredNodeAffinityPlugin{}
+}
+
+func (pp *preferredNodeAffinityPlugin) Name() string {
+	return "preferrednodeaffinity"
+}
+
+func (pp *preferredNodeAffinityPlugin) OnSessionOpen(ssn *framework.Session) {
+	pp.podGroupInfos = ssn.ClusterInfo.PodGroupInfos
+	pp.taskTerms = map[common_info.PodID]*taskPreferredTerms{}
+	ssn.AddNodePreOrderFn(pp.nodePreOrderFn)
+	ssn.AddNodeOrderFn(pp.nodeOrderFn)
+}
+
+func (pp *preferredNodeAffinityPlugin) nodePreOrderFn(task *pod_info.PodInfo, _ []*node_info.NodeInfo) error {
+	if _, found := pp.taskTerms[task.UID]; found {
+		return nil
+	}
+	terms, err := pp.parseTaskTerms(task)
+	if err != nil {
+		return err
+	}
+	pp.taskTerms[task.UID] = terms
+	return nil
+}
+
+func (pp *preferredNodeAffinityPlugin) nodeOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
+	terms, found := pp.taskTerms[task.UID]
+	if !found {
+		var err error
+		if terms, err = pp.parseTaskTerms(task); err != nil {
+			return 0, err
+		}
+	}
+	if terms == nil || node.Node == nil {
+		return 0, nil
+	}
+
+	score := scores.K8sPlugins * float64(terms.terms.Score(node.Node)) / float64(terms.totalWeight)
+	log.InfraLogger.V(7).Infof(
+		"Estimating Task: <%v/%v> Job: <%v> for node: <%s> by preferred node affinity. Score: %f",
+		task.Namespace, task.Name, task.Job, node.Name, score)
+	return score, nil
+}
+
+// parseTaskTerms parses the preferred terms of the task's pod group merged with those of the task. It returns nil if
+// the pod group has no preferred terms, or if the scheduler relaxed them.
+func (pp *preferredNodeAffinityPlugin) parseTaskTerms(task *pod_info.PodInfo) (*taskPreferredTerms, error) {
+	job, found := pp.podGroupInfos[task.Job]
+	if !found || job.PodGroup == nil || len(job.PodGroup.Spec.PreferredNodeAffinityTerms) == 0 {
+		return nil, nil
+	}
+	if job.IsConstraintRelaxed(enginev2alpha2.PreferredNodeAffinityConstraint) {
+		return nil, nil
+	}
+
+	terms := mergedPreferredTerms(job.PodGroup.Spec.PreferredNodeAffinityTerms, task.Pod)
+	totalWeight := int64(0)
+	for _, term := range terms {
+		totalWeight += int64(term.Weight)
+	}
+	if totalWeight <= 0 {
+		return nil, nil
+	}
+
+	preferredTerms, err := nodeaffinity.NewPreferredSchedulingTerms(terms)
+	if err != nil {
+		log.InfraLogger.V(6).Infof("Failed to parse preferred node affinity of task <%s/%s>: %v",
+			task.Namespace, task.Name, err)
+		return nil, err
+	}
+	return &taskPreferredTerms{terms: preferredTerms, totalWeight: totalWeight}, nil
+}
+
+func mergedPreferredTerms(podGroupTerms []v1.PreferredSchedulingTerm, pod *v1.Pod) []v1.PreferredSchedulingTerm {
+	terms := append([]v1.PreferredSchedulingTerm{}, podGroupTerms...)
+	if pod == nil || pod.Spec.Affinity == nil || pod.Spec.Affinity.NodeAffinity == nil {
+		return terms
+	}
+	return append(terms, pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution...)
+}
+
+func (pp *preferredNodeAffinityPlugin) OnSessionClose(_ *framework.Session) {}
@@ -0,0 +1,151 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package preferrednodeaffinity
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+func TestPreferredNodeAffinity(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "PreferredNodeAffinity Suite")
+}
+
+func preferredTerm(weight int32, key, value string) v1.PreferredSchedulingTerm {
+	return v1.PreferredSchedulingTerm{
+		Weight: weight,
+		Preference: v1.NodeSelectorTerm{
+			MatchExpressions: []v1.NodeSelectorRequirement{
+				{Key: key, Operator: v1.NodeSelectorOpIn, Values: []string{value}},
+			},
+		},
+	}
+}
+
+var _ = Describe("PreferredNodeAffinity Scoring tests", func() {
+	const jobID = common_info.PodGroupID("job-1")
+
+	cases := map[string]struct {
+		podGroupTerms []v1.PreferredSchedulingTerm
+		podTerms      []v1.PreferredSchedulingTerm
+		nodeLabels    map[string]string
+		relaxed       bool
+		expectedScore float64
+	}{
+		"No podgroup terms": {
+			podTerms:      []v1.PreferredSchedulingTerm{preferredTerm(10, "zone", "a")},
+			nodeLabels:    map[string]string{"zone": "a"},
+			expectedScore: 0,
+		},
+		"Podgroup term matches node": {
+			podGroupTerms: []v1.PreferredSchedulingTerm{preferredTerm(10, "zone", "a")},
+			nodeLabels:    map[string]string{"zone": "a"},
+			expectedScore: scores.K8sPlugins,
+		},
+		"Podgroup term does not match node": {
+			podGroupTerms: []v1.PreferredSchedulingTerm{preferredTerm(10, "zone", "a")},
+			nodeLabels:    map[string]string{"zone": "b"},
+			expectedScore: 0,
+		},
+		"Podgroup and pod terms are merged": {
+			podGroupTerms: []v1.PreferredSchedulingTerm{preferredTerm(30, "zone", "a")},
+			podTerms:      []v1.PreferredSchedulingTerm{preferredTerm(10, "rack", "r1")},
+			nodeLabels:    map[string]string{"zone": "a", "rack": "r2"},
+			expectedScore: scores.K8sPlugins * 0.75,
+		},
+		"Relaxed podgroup terms": {
+			podGroupTerms: []v1.PreferredSchedulingTerm{preferredTerm(10, "zone", "a")},
+			nodeLabels:    map[string]string{"zone": "a"},
+			relaxed:       true,
+			expectedScore: 0,
+		},
+	}
+	for caseName, caseSpec := range cases {
+		It(caseName, func() {
+			pod := &v1.Pod{}
+			if len(caseSpec.podTerms) > 0 {
+				pod.Spec.Affinity = &v1.Affinity{
+					NodeAffinity: &v1.NodeAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: caseSpec.podTerms,
+					},
+				}
+			}
+			var status enginev2alpha2.PodGroupStatus
+			if caseSpec.relaxed {
+				status.RelaxedConstraints = []enginev2alpha2.RelaxedConstraint{
+					{Constraint: enginev2alpha2.PreferredNodeAffinityConstraint},
+				}
+			}
+			job := podgroup_info.NewPodGroupInfo(jobID)
+			job.SetPodGroup(&enginev2alpha2.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "job-1", Namespace: "ns"},
+				Spec: enginev2alpha2.PodGroupSpec{
+					PreferredNodeAffinityTerms: caseSpec.podGroupTerms,
+				},
+				Status: status,
+			})
+			plugin := &preferredNodeAffinityPlugin{
+				podGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{jobID: job},
+			}
+			node := &node_info.NodeInfo{
+				Name: "node-1",
+				Node: &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: caseSpec.nodeLabels}},
+			}
+
+			score, err := plugin.nodeOrderFn(&pod_info.PodInfo{Job: jobID, Pod: pod}, node)
+			Expect(err).To(BeNil())
+			Expect(score).To(Equal(caseSpec.expectedScore))
+		})
+	}
+})
+
+var _ = Describe("PreferredNodeAffinity pre-order tests", func() {
+	It("parses the terms of a task once and reuses them for every node", func() {
+		const jobID = common_info.PodGroupID("job-1")
+		job := podgroup_info.NewPodGroupInfo(jobID)
+		job.SetPodGroup(&enginev2alpha2.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "job-1", Namespace: "ns"},
+			Spec: enginev2alpha2.PodGroupSpec{
+				PreferredNodeAffinityTerms: []v1.PreferredSchedulingTerm{preferredTerm(10, "zone", "a")},
+			},
+		})
+		plugin := &preferredNodeAffinityPlugin{
+			podGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{jobID: job},
+			taskTerms:     map[common_info.PodID]*taskPreferredTerms{},
+		}
+		task := &pod_info.PodInfo{UID: "task-1", Job: jobID, Pod: &v1.Pod{}}
+		newNode := func(name, zone string) *node_info.NodeInfo {
+			return &node_info.NodeInfo{
+				Name: name,
+				Node: &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"zone": zone}}},
+			}
+		}
+		nodes := []*node_info.NodeInfo{newNode("node-a", "a"), newNode("node-b", "b")}
+
+		Expect(plugin.nodePreOrderFn(task, nodes)).To(Succeed())
+		Expect(plugin.taskTerms).To(HaveKey(task.UID))
+
+		// Changes to the pod group after the pre-order are not parsed again
+		job.PodGroup.Spec.PreferredNodeAffinityTerms = []v1.PreferredSchedulingTerm{preferredTerm(10, "zone", "b")}
+
+		score, err := plugin.nodeOrderFn(task, nodes[0])
+		Expect(err).To(BeNil())
+		Expect(score).To(Equal(float64(scores.K8sPlugins)))
+		score, err = plugin.nodeOrderFn(task, nodes[1])
+		Expect(err).To(BeNil())
+		Expect(score).To(Equal(float64(0)))
+	})
+})
@@ -0,0 +1,43 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package proportion
+
+import (
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/burst_quota"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+)
+
+// burstBucketsKey is the key of the burst buckets in the plugin state of the scheduler
+const burstBucketsKey = "proportion/burst-buckets"
+
+// setBurstAllowances accounts the GPUs that queues with a burst allowance use over their deserved quota, and protects
+// them from reclaim while the allowance lasts
+func (pp *proportionPlugin) setBurstAllowances(ssn *framework.Session) {
+	buckets := ssn.PluginState(burstBucketsKey, func() any { return burst_quota.NewBuckets() }).(*burst_quota.Buckets)
+	// Shadow sessions account the allowances on a copy of the buckets, which are only spent by the primary session
+	if ssn.IsShadow() {
+		buckets = buckets.Clone()
+	}
+	buckets.Prune(ssn.ClusterInfo.Queues)
+	now := ssn.Clock().Now()
+	for queueID, queue := range ssn.ClusterInfo.Queues {
+		queueAttributes, found := pp.queues[queueID]
+		if queue.Burst == nil || !found {
+			continue
+		}
+		gpuShare := queueAttributes.ResourceShare(rs.GpuResource)
+		if gpuShare.Deserved == commonconstants.UnlimitedResourceQuantity {
+			continue
+		}
+		overQuotaGPUs := gpuShare.Allocated - gpuShare.Deserved
+		queueAttributes.BurstGPUs = buckets.Update(queueID, queue.Burst, overQuotaGPUs, now)
+		if overQuotaGPUs > 0 {
+			log.InfraLogger.V(4).Infof("Queue <%s> is <%v> GPUs over its deserved quota, <%v> GPUs are protected "+
+				"by its burst allowance", queue.Name, overQuotaGPUs, queueAttributes.BurstGPUs)
+		}
+	}
+}
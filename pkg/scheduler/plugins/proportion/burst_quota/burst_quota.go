@@ -0,0 +1,99 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package burst_quota
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+)
+
+type bucket struct {
+	capacity   float64
+	refillRate float64
+	tokens     float64
+	usedGPUs   float64
+	lastUpdate time.Time
+}
+
+// Buckets tracks the burst allowance of queues across scheduling sessions. The tokens of a bucket are GPU seconds:
+// the queue spends a token for every GPU over its deserved quota every second, and the bucket refills at a constant
+// rate while the queue is within its deserved quota.
+type Buckets struct {
+	mutex   sync.Mutex
+	buckets map[common_info.QueueID]*bucket
+}
+
+func NewBuckets() *Buckets {
+	return &Buckets{
+		buckets: map[common_info.QueueID]*bucket{},
+	}
+}
+
+// Update accounts the GPUs the queue used over its deserved quota since the previous update, and returns the number of
+// GPUs over the deserved quota that are protected from reclaim now. The bucket of a queue starts full, and is reset
+// when the burst configuration of the queue changes.
+func (b *Buckets) Update(
+	queueID common_info.QueueID, burst *enginev2.QueueBurst, overQuotaGPUs float64, now time.Time,
+) float64 {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	capacity := burst.Capacity()
+	refillRate := capacity
+	if refillDuration := burst.GetRefillDuration(); refillDuration > 0 {
+		refillRate = capacity / refillDuration.Seconds()
+	}
+
+	bkt, found := b.buckets[queueID]
+	if !found || bkt.capacity != capacity || bkt.refillRate != refillRate {
+		bkt = &bucket{capacity: capacity, refillRate: refillRate, tokens: capacity, lastUpdate: now}
+		b.buckets[queueID] = bkt
+	}
+
+	if elapsed := now.Sub(bkt.lastUpdate).Seconds(); elapsed > 0 {
+		if bkt.usedGPUs > 0 {
+			bkt.tokens -= bkt.usedGPUs * elapsed
+		} else {
+			bkt.tokens += bkt.refillRate * elapsed
+		}
+		bkt.tokens = math.Max(0, math.Min(bkt.tokens, bkt.capacity))
+	}
+	bkt.lastUpdate = now
+	bkt.usedGPUs = math.Max(0, math.Min(overQuotaGPUs, burst.GPUs))
+
+	if bkt.tokens <= 0 {
+		return 0
+	}
+	return burst.GPUs
+}
+
+// Clone returns a copy of the buckets
+func (b *Buckets) Clone() *Buckets {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	buckets := make(map[common_info.QueueID]*bucket, len(b.buckets))
+	for queueID, bkt := range b.buckets {
+		bucketCopy := *bkt
+		buckets[queueID] = &bucketCopy
+	}
+	return &Buckets{buckets: buckets}
+}
+
+// Prune removes the buckets of queues that no longer exist or no longer have a burst allowance
+func (b *Buckets) Prune(queues map[common_info.QueueID]*queue_info.QueueInfo) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for queueID := range b.buckets {
+		if queue, found := queues[queueID]; !found || queue.Burst == nil {
+			delete(b.buckets, queueID)
+		}
+	}
+}
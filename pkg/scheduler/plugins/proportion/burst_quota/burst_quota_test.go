@@ -0,0 +1,126 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package burst_quota
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+)
+
+func TestUpdate(t *testing.T) {
+	burst := &enginev2.QueueBurst{
+		GPUs:           2,
+		Duration:       metav1.Duration{Duration: 10 * time.Minute},
+		RefillDuration: &metav1.Duration{Duration: 20 * time.Minute},
+	}
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type update struct {
+		elapsed       time.Duration
+		overQuotaGPUs float64
+		expectedGPUs  float64
+	}
+	tests := []struct {
+		name    string
+		updates []update
+	}{
+		{
+			name: "full bucket protects the burst GPUs",
+			updates: []update{
+				{elapsed: 0, overQuotaGPUs: 2, expectedGPUs: 2},
+				{elapsed: 9 * time.Minute, overQuotaGPUs: 2, expectedGPUs: 2},
+			},
+		},
+		{
+			name: "bucket is empty after using all the burst GPUs for the duration",
+			updates: []update{
+				{elapsed: 0, overQuotaGPUs: 2, expectedGPUs: 2},
+				{elapsed: 10 * time.Minute, overQuotaGPUs: 2, expectedGPUs: 0},
+			},
+		},
+		{
+			name: "using fewer GPUs drains the bucket slower",
+			updates: []update{
+				{elapsed: 0, overQuotaGPUs: 1, expectedGPUs: 2},
+				{elapsed: 19 * time.Minute, overQuotaGPUs: 1, expectedGPUs: 2},
+				{elapsed: time.Minute, overQuotaGPUs: 1, expectedGPUs: 0},
+			},
+		},
+		{
+			name: "GPUs over the burst don't drain the bucket faster",
+			updates: []update{
+				{elapsed: 0, overQuotaGPUs: 4, expectedGPUs: 2},
+				{elapsed: 9 * time.Minute, overQuotaGPUs: 4, expectedGPUs: 2},
+			},
+		},
+		{
+			name: "bucket refills while within the deserved quota",
+			updates: []update{
+				{elapsed: 0, overQuotaGPUs: 2, expectedGPUs: 2},
+				{elapsed: 10 * time.Minute, overQuotaGPUs: -1, expectedGPUs: 0},
+				{elapsed: 10 * time.Minute, overQuotaGPUs: 2, expectedGPUs: 2},
+				{elapsed: 5 * time.Minute, overQuotaGPUs: 2, expectedGPUs: 0},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buckets := NewBuckets()
+			now := start
+			for i, u := range test.updates {
+				now = now.Add(u.elapsed)
+				assert.Equal(t, u.expectedGPUs, buckets.Update("queue", burst, u.overQuotaGPUs, now),
+					"update %d", i)
+			}
+		})
+	}
+}
+
+func TestUpdateResetsOnConfigChange(t *testing.T) {
+	burst := &enginev2.QueueBurst{GPUs: 1, Duration: metav1.Duration{Duration: time.Minute}}
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	buckets := NewBuckets()
+
+	buckets.Update("queue", burst, 1, start)
+	assert.Equal(t, float64(0), buckets.Update("queue", burst, 1, start.Add(time.Minute)))
+
+	longerBurst := &enginev2.QueueBurst{GPUs: 1, Duration: metav1.Duration{Duration: time.Hour}}
+	assert.Equal(t, float64(1), buckets.Update("queue", longerBurst, 1, start.Add(2*time.Minute)))
+}
+
+func TestPrune(t *testing.T) {
+	burst := &enginev2.QueueBurst{GPUs: 1, Duration: metav1.Duration{Duration: time.Minute}}
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	buckets := NewBuckets()
+	for _, queueID := range []common_info.QueueID{"with-burst", "without-burst", "deleted"} {
+		buckets.Update(queueID, burst, 1, now)
+	}
+
+	buckets.Prune(map[common_info.QueueID]*queue_info.QueueInfo{
+		"with-burst":    {UID: "with-burst", Burst: burst},
+		"without-burst": {UID: "without-burst"},
+	})
+	assert.Len(t, buckets.buckets, 1)
+	assert.Contains(t, buckets.buckets, common_info.QueueID("with-burst"))
+}
+
+func TestClone(t *testing.T) {
+	burst := &enginev2.QueueBurst{GPUs: 1, Duration: metav1.Duration{Duration: time.Minute}}
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	buckets := NewBuckets()
+	buckets.Update("queue", burst, 1, start)
+
+	clone := buckets.Clone()
+	assert.Equal(t, float64(0), clone.Update("queue", burst, 1, start.Add(time.Minute)))
+
+	assert.Equal(t, start, buckets.buckets["queue"].lastUpdate)
+	assert.Equal(t, float64(1), buckets.Update("queue", burst, 1, start.Add(30*time.Second)))
+}
@@ -0,0 +1,47 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package capacity_policy
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/utils"
+)
+
+// resultsOverBudget rejects GPU allocations of jobs whose queue, or one of its ancestors, consumed its GPU hours
+// budget for the current period
+func (cp *CapacityPolicy) resultsOverBudget(requestedShare rs.ResourceQuantities,
+	job *podgroup_info.PodGroupInfo) *api.SchedulableResult {
+	if requestedShare[rs.GpuResource] == 0 {
+		return Schedulable()
+	}
+
+	for queueAttributes, ok := cp.queues[job.Queue]; ok; queueAttributes, ok = cp.queues[queueAttributes.ParentQueue] {
+		if !queueAttributes.BudgetExhausted {
+			continue
+		}
+		return &api.SchedulableResult{
+			IsSchedulable: false,
+			Reason:        v2alpha2.OverBudget,
+			Message: fmt.Sprintf("%s consumed its GPU hours budget for the current period, "+
+				"workload requested %v GPUs.", queueAttributes.Name, requestedShare[rs.GpuResource]),
+			Details: &v2alpha2.UnschedulableExplanationDetails{
+				QueueDetails: &v2alpha2.QuotaDetails{
+					Name:                       string(queueAttributes.UID),
+					QueueRequestedResources:    utils.ResourceRequirementsFromQuantities(queueAttributes.GetRequestShare()).ToResourceList(),
+					QueueDeservedResources:     utils.ResourceRequirementsFromQuantities(queueAttributes.GetDeservedShare()).ToResourceList(),
+					QueueAllocatedResources:    utils.ResourceRequirementsFromQuantities(queueAttributes.GetAllocatedShare()).ToResourceList(),
+					QueueResourceLimits:        utils.ResourceRequirementsFromQuantities(queueAttributes.GetMaxAllowedShare()).ToResourceList(),
+					PodGroupRequestedResources: utils.ResourceRequirementsFromQuantities(requestedShare).ToResourceList(),
+				},
+			},
+		}
+	}
+
+	return Schedulable()
+}
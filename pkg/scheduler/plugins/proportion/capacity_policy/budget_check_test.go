@@ -0,0 +1,80 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package capacity_policy
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+)
+
+var _ = Describe("Budget Check", func() {
+	var (
+		parentAttributes *rs.QueueAttributes
+		queueAttributes  *rs.QueueAttributes
+		capacityPolicy   *CapacityPolicy
+	)
+
+	newQueueAttributes := func(name string, parent common_info.QueueID) *rs.QueueAttributes {
+		return &rs.QueueAttributes{
+			UID:         common_info.QueueID(name),
+			Name:        name,
+			ParentQueue: parent,
+			QueueResourceShare: rs.QueueResourceShare{
+				CPU:    rs.EmptyResource(),
+				Memory: rs.EmptyResource(),
+				GPU:    rs.EmptyResource(),
+			},
+		}
+	}
+
+	newJob := func() *podgroup_info.PodGroupInfo {
+		job := podgroup_info.NewPodGroupInfo("job-a")
+		job.SetPodGroup(&v2alpha2.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "job-a", Namespace: "ns"},
+			Spec:       v2alpha2.PodGroupSpec{Queue: "queue-a"},
+		})
+		return job
+	}
+
+	BeforeEach(func() {
+		parentAttributes = newQueueAttributes("department-a", "")
+		queueAttributes = newQueueAttributes("queue-a", "department-a")
+		capacityPolicy = New(map[common_info.QueueID]*rs.QueueAttributes{
+			"department-a": parentAttributes,
+			"queue-a":      queueAttributes,
+		})
+	})
+
+	It("allows jobs of queues within their budget", func() {
+		result := capacityPolicy.resultsOverBudget(rs.NewResourceQuantities(0, 0, 2), newJob())
+		Expect(result.IsSchedulable).To(BeTrue())
+	})
+
+	It("blocks gpu jobs of queues that exhausted their budget", func() {
+		queueAttributes.BudgetExhausted = true
+		result := capacityPolicy.resultsOverBudget(rs.NewResourceQuantities(0, 0, 2), newJob())
+		Expect(result.IsSchedulable).To(BeFalse())
+		Expect(result.Reason).To(Equal(v2alpha2.OverBudget))
+		Expect(result.Details.QueueDetails.Name).To(Equal("queue-a"))
+	})
+
+	It("blocks gpu jobs of queues whose parent exhausted its budget", func() {
+		parentAttributes.BudgetExhausted = true
+		result := capacityPolicy.resultsOverBudget(rs.NewResourceQuantities(0, 0, 1), newJob())
+		Expect(result.IsSchedulable).To(BeFalse())
+		Expect(result.Details.QueueDetails.Name).To(Equal("department-a"))
+	})
+
+	It("allows jobs without gpus of queues that exhausted their budget", func() {
+		queueAttributes.BudgetExhausted = true
+		result := capacityPolicy.resultsOverBudget(rs.NewResourceQuantities(1000, 1000, 0), newJob())
+		Expect(result.IsSchedulable).To(BeTrue())
+	})
+})
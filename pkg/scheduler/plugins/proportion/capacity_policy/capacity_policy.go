@@ -8,19 +8,28 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
 	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/utils"
 )
 
 type capacityCheckFn func(requestedShare rs.ResourceQuantities, job *podgroup_info.PodGroupInfo) *api.SchedulableResult
 
 type CapacityPolicy struct {
-	queues map[common_info.QueueID]*rs.QueueAttributes
+	queues            map[common_info.QueueID]*rs.QueueAttributes
+	replacedPodGroups map[common_info.PodGroupID]*podgroup_info.PodGroupInfo
 }
 
 func New(queues map[common_info.QueueID]*rs.QueueAttributes) *CapacityPolicy {
-	return &CapacityPolicy{queues}
+	return &CapacityPolicy{queues: queues}
+}
+
+// SetReplacedPodGroups sets the jobs replaced by new revisions of them. The resources allocated to a replaced job are
+// not counted against the quota and limit of the queue when its replacement is scheduled.
+func (cp *CapacityPolicy) SetReplacedPodGroups(replaced map[common_info.PodGroupID]*podgroup_info.PodGroupInfo) {
+	cp.replacedPodGroups = replaced
 }
 
 func (cp *CapacityPolicy) IsJobOverQueueCapacity(job *podgroup_info.PodGroupInfo,
@@ -31,7 +40,8 @@ func (cp *CapacityPolicy) IsJobOverQueueCapacity(job *podgroup_info.PodGroupInfo
 		requiredQuota.Memory,
 		requiredQuota.GPU)
 
-	checkFns := []capacityCheckFn{cp.resultsOverLimit, cp.resultsWithNonPreemptibleOverQuota}
+	checkFns := []capacityCheckFn{cp.resultsOverBudget, cp.resultsOverLimit, cp.resultsWithNonPreemptibleOverQuota,
+		cp.resultsOverPriorityQuotaCap}
 	return cp.isJobOverCapacity(requestedShareQuantities, job, checkFns)
 }
 
@@ -56,12 +66,14 @@ func (cp *CapacityPolicy) IsTaskAllocationOnNodeOverCapacity(task *pod_info.PodI
 		requiredInitQuota.Memory,
 		requiredInitQuota.GPU)
 
-	checkFns := []capacityCheckFn{cp.resultsOverLimit, cp.resultsWithNonPreemptibleOverQuota}
+	checkFns := []capacityCheckFn{cp.resultsOverBudget, cp.resultsOverLimit, cp.resultsWithNonPreemptibleOverQuota,
+		cp.resultsOverPriorityQuotaCap}
 	return cp.isJobOverCapacity(requestedShare, job, checkFns)
 }
 
 func (cp *CapacityPolicy) isJobOverCapacity(requestedShare rs.ResourceQuantities, job *podgroup_info.PodGroupInfo,
 	checkFns []capacityCheckFn) *api.SchedulableResult {
+	requestedShare = cp.excludeReplacedPodGroup(requestedShare, job)
 	for _, checkFn := range checkFns {
 		result := checkFn(requestedShare, job)
 		if !result.IsSchedulable {
@@ -73,10 +85,45 @@ func (cp *CapacityPolicy) isJobOverCapacity(requestedShare rs.ResourceQuantities
 	return Schedulable()
 }
 
+// excludeReplacedPodGroup reduces the share requested by a job by the share allocated to the job it replaces, so that
+// a new revision of a running gang can be scheduled next to it before the old revision is evicted. Revisions that
+// differ in preemptibility or priority class are accounted separately by the quota checks, so they get no exemption.
+func (cp *CapacityPolicy) excludeReplacedPodGroup(requestedShare rs.ResourceQuantities,
+	job *podgroup_info.PodGroupInfo) rs.ResourceQuantities {
+	replaced, found := cp.replacedPodGroups[job.UID]
+	if !found || replaced.IsPreemptibleJob() != job.IsPreemptibleJob() ||
+		replaced.GetPriorityClassName() != job.GetPriorityClassName() {
+		return requestedShare
+	}
+
+	replacedShare := rs.EmptyResourceQuantities()
+	for status, tasks := range replaced.PodStatusIndex {
+		if !pod_status.AllocatedStatus(status) {
+			continue
+		}
+		for _, task := range tasks {
+			replacedShare.Add(utils.QuantifyResourceRequirements(task.AcceptedResource))
+		}
+	}
+
+	remainingShare := rs.EmptyResourceQuantities()
+	for _, resource := range rs.AllResources {
+		remainingShare[resource] = max(requestedShare[resource]-replacedShare[resource], 0)
+	}
+	log.InfraLogger.V(5).Infof("Job: <%v/%v> replaces job <%v/%v>, excluding its allocated resources from the "+
+		"capacity checks", job.Namespace, job.Name, replaced.Namespace, replaced.Name)
+	return remainingShare
+}
+
 func getRequiredQuota(tasksToAllocate []*pod_info.PodInfo) *podgroup_info.JobRequirement {
 	quota := podgroup_info.JobRequirement{}
 	for _, pod := range tasksToAllocate {
-		quota.GPU += pod.ResReq.GetGpusQuota()
+		if pod.GpuCountRange != nil {
+			// Pods requesting a range of GPUs may be allocated with the minimal number of GPUs in the range
+			quota.GPU += float64(pod.GpuCountRange.Min)
+		} else {
+			quota.GPU += pod.ResReq.GetGpusQuota()
+		}
 		quota.MilliCPU += pod.ResReq.Cpu()
 		quota.Memory += pod.ResReq.Memory()
 	}
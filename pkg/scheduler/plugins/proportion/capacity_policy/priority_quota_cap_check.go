@@ -0,0 +1,78 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package capacity_policy
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/utils"
+)
+
+func (cp *CapacityPolicy) resultsOverPriorityQuotaCap(requestedShare rs.ResourceQuantities,
+	job *podgroup_info.PodGroupInfo) *api.SchedulableResult {
+
+	priorityClassName := job.GetPriorityClassName()
+	for queueAttributes, ok := cp.queues[job.Queue]; ok; queueAttributes, ok = cp.queues[queueAttributes.ParentQueue] {
+		quotaCap, found := queueAttributes.GetPriorityQuotaCap(priorityClassName)
+		if !found {
+			continue
+		}
+		overCap, exceedingResourceName := isOverPriorityQuotaCap(queueAttributes, priorityClassName, quotaCap,
+			requestedShare)
+		if overCap {
+			return &api.SchedulableResult{
+				IsSchedulable: false,
+				Reason:        v2alpha2.OverPriorityQuotaCap,
+				Message: getOverPriorityQuotaCapError(queueAttributes, priorityClassName, quotaCap,
+					requestedShare, exceedingResourceName),
+				Details: &v2alpha2.UnschedulableExplanationDetails{
+					QueueDetails: &v2alpha2.QuotaDetails{
+						Name:                       string(queueAttributes.UID),
+						QueueRequestedResources:    utils.ResourceRequirementsFromQuantities(queueAttributes.GetRequestShare()).ToResourceList(),
+						QueueDeservedResources:     utils.ResourceRequirementsFromQuantities(queueAttributes.GetDeservedShare()).ToResourceList(),
+						QueueAllocatedResources:    utils.ResourceRequirementsFromQuantities(queueAttributes.GetAllocatedShare()).ToResourceList(),
+						QueueResourceLimits:        utils.ResourceRequirementsFromQuantities(queueAttributes.GetMaxAllowedShare()).ToResourceList(),
+						PodGroupRequestedResources: utils.ResourceRequirementsFromQuantities(requestedShare).ToResourceList(),
+					},
+				},
+			}
+		}
+	}
+
+	return Schedulable()
+}
+
+func isOverPriorityQuotaCap(queueAttributes *rs.QueueAttributes, priorityClassName string, quotaCap float64,
+	requested rs.ResourceQuantities) (bool, rs.ResourceName) {
+	allocated := queueAttributes.GetPriorityClassAllocated(priorityClassName)
+	for _, resource := range rs.AllResources {
+		resourceShare := queueAttributes.ResourceShare(resource)
+		if resourceShare.Deserved == commonconstants.UnlimitedResourceQuantity {
+			continue
+		}
+		requestedQty, found := requested[resource]
+		if !found || requestedQty == 0 {
+			continue
+		}
+		if resourceShare.Deserved*quotaCap < allocated[resource]+requestedQty {
+			return true, resource
+		}
+	}
+	return false, ""
+}
+
+func getOverPriorityQuotaCapError(queueAttributes *rs.QueueAttributes, priorityClassName string, quotaCap float64,
+	requested rs.ResourceQuantities, resourceName rs.ResourceName) string {
+	deserved := queueAttributes.GetDeservedShare()[resourceName]
+	allocated := queueAttributes.GetPriorityClassAllocated(priorityClassName)[resourceName]
+	return fmt.Sprintf("Workloads of priority class %s can use up to %v%% of %s quota of %s (%v), "+
+		"currently %v allocated and workload requested %v.",
+		priorityClassName, quotaCap*100, queueAttributes.Name, resourceName, deserved*quotaCap, allocated,
+		requested[resourceName])
+}
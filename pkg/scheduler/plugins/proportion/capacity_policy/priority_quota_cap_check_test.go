@@ -0,0 +1,75 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package capacity_policy
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+)
+
+var _ = Describe("Priority Quota Cap Check", func() {
+	var (
+		queueAttributes *rs.QueueAttributes
+		capacityPolicy  *CapacityPolicy
+	)
+
+	newJob := func(priorityClassName string) *podgroup_info.PodGroupInfo {
+		job := podgroup_info.NewPodGroupInfo("job-a")
+		job.SetPodGroup(&v2alpha2.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "job-a", Namespace: "ns"},
+			Spec: v2alpha2.PodGroupSpec{
+				Queue:             "queue-a",
+				PriorityClassName: priorityClassName,
+			},
+		})
+		return job
+	}
+
+	BeforeEach(func() {
+		queueAttributes = &rs.QueueAttributes{
+			UID:               "queue-a",
+			Name:              "queue-a",
+			PriorityQuotaCaps: map[string]float64{"build": 0.3},
+			QueueResourceShare: rs.QueueResourceShare{
+				CPU:    rs.EmptyResource(),
+				Memory: rs.EmptyResource(),
+				GPU:    rs.EmptyResource(),
+			},
+		}
+		queueAttributes.SetQuotaResources(rs.GpuResource, 10, -1, 1)
+		capacityPolicy = New(map[common_info.QueueID]*rs.QueueAttributes{"queue-a": queueAttributes})
+	})
+
+	It("allows jobs within the priority class cap", func() {
+		result := capacityPolicy.resultsOverPriorityQuotaCap(rs.NewResourceQuantities(0, 0, 3), newJob("build"))
+		Expect(result.IsSchedulable).To(BeTrue())
+	})
+
+	It("blocks jobs exceeding the priority class cap", func() {
+		queueAttributes.AddPriorityClassAllocation("build", rs.NewResourceQuantities(0, 0, 2))
+		result := capacityPolicy.resultsOverPriorityQuotaCap(rs.NewResourceQuantities(0, 0, 2), newJob("build"))
+		Expect(result.IsSchedulable).To(BeFalse())
+		Expect(result.Reason).To(Equal(v2alpha2.OverPriorityQuotaCap))
+	})
+
+	It("accounts for released allocations", func() {
+		queueAttributes.AddPriorityClassAllocation("build", rs.NewResourceQuantities(0, 0, 3))
+		queueAttributes.RemovePriorityClassAllocation("build", rs.NewResourceQuantities(0, 0, 2))
+		result := capacityPolicy.resultsOverPriorityQuotaCap(rs.NewResourceQuantities(0, 0, 2), newJob("build"))
+		Expect(result.IsSchedulable).To(BeTrue())
+	})
+
+	It("ignores priority classes without a cap", func() {
+		queueAttributes.AddPriorityClassAllocation("train", rs.NewResourceQuantities(0, 0, 10))
+		Expect(queueAttributes.AllocatedByPriorityClass).NotTo(HaveKey("train"))
+		result := capacityPolicy.resultsOverPriorityQuotaCap(rs.NewResourceQuantities(0, 0, 10), newJob("train"))
+		Expect(result.IsSchedulable).To(BeTrue())
+	})
+})
@@ -0,0 +1,93 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package capacity_policy
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info/subgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+)
+
+var _ = Describe("Capacity Policy Replaced PodGroups", func() {
+	var (
+		queues      map[common_info.QueueID]*rs.QueueAttributes
+		oldRevision *podgroup_info.PodGroupInfo
+		newRevision *podgroup_info.PodGroupInfo
+	)
+
+	BeforeEach(func() {
+		queues = map[common_info.QueueID]*rs.QueueAttributes{
+			"queue-a": {
+				UID:  "queue-a",
+				Name: "queue-a",
+				QueueResourceShare: rs.QueueResourceShare{
+					GPU: rs.ResourceShare{MaxAllowed: 3, Allocated: 2},
+				},
+			},
+		}
+
+		runningTask := &pod_info.PodInfo{
+			UID: "old-task", Job: "old", Name: "old-task", Namespace: "team-a",
+			Status:           pod_status.Running,
+			ResReq:           resource_info.NewResourceRequirementsWithGpus(2),
+			AcceptedResource: resource_info.NewResourceRequirementsWithGpus(2),
+		}
+		oldRevision = &podgroup_info.PodGroupInfo{
+			UID: "old", Name: "old", Namespace: "team-a", Queue: "queue-a",
+			Preemptibility: v2alpha2.Preemptible,
+			PodStatusIndex: map[pod_status.PodStatus]pod_info.PodsMap{
+				pod_status.Running: {runningTask.UID: runningTask},
+			},
+		}
+		newRevision = &podgroup_info.PodGroupInfo{
+			UID: "new", Name: "new", Namespace: "team-a", Queue: "queue-a",
+			Preemptibility: v2alpha2.Preemptible,
+			JobFitErrors:   make([]common_info.JobFitError, 0),
+			PodSets: map[string]*subgroup_info.PodSet{
+				podgroup_info.DefaultSubGroup: subgroup_info.NewPodSet(podgroup_info.DefaultSubGroup, 1, nil).
+					WithPodInfos(map[common_info.PodID]*pod_info.PodInfo{
+						"new-task": {
+							UID: "new-task", Job: "new", Name: "new-task", Namespace: "team-a",
+							Status: pod_status.Pending,
+							ResReq: resource_info.NewResourceRequirementsWithGpus(2),
+						},
+					}),
+			},
+		}
+	})
+
+	isSchedulable := func(capacityPolicy *CapacityPolicy) bool {
+		tasksToAllocate := podgroup_info.GetTasksToAllocate(newRevision, dummyTasksLessThen, dummyTasksLessThen, true)
+		return capacityPolicy.IsJobOverQueueCapacity(newRevision, tasksToAllocate).IsSchedulable
+	}
+
+	It("is over the limit without replacing a podgroup", func() {
+		Expect(isSchedulable(New(queues))).To(BeFalse())
+	})
+
+	It("excludes the resources of the replaced podgroup", func() {
+		capacityPolicy := New(queues)
+		capacityPolicy.SetReplacedPodGroups(map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{
+			newRevision.UID: oldRevision,
+		})
+		Expect(isSchedulable(capacityPolicy)).To(BeTrue())
+	})
+
+	It("doesn't exclude the resources of a replaced podgroup with another preemptibility", func() {
+		oldRevision.Preemptibility = v2alpha2.NonPreemptible
+		capacityPolicy := New(queues)
+		capacityPolicy.SetReplacedPodGroups(map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{
+			newRevision.UID: oldRevision,
+		})
+		Expect(isSchedulable(capacityPolicy)).To(BeFalse())
+	})
+})
@@ -0,0 +1,119 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package proportion
+
+import (
+	"maps"
+	"sync"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+)
+
+// sharedFairShareCache outlives the plugin, which is created for every session
+var sharedFairShareCache = &fairShareCache{}
+
+// fairShareCache keeps the fair shares and entitlement deltas of the queues between sessions. The fair share of a
+// queue depends on the quotas and usage of every other queue, so the cache is valid only while none of them changed,
+// and is replaced as a whole once any of them does.
+type fairShareCache struct {
+	mutex             sync.Mutex
+	inputs            fairShareInputs
+	fairShares        map[common_info.QueueID]rs.ResourceQuantities
+	entitlementDeltas map[common_info.QueueID]rs.ResourceQuantities
+}
+
+// fairShareInputs are everything that the division of the resources between the queues, the entitlement deltas of
+// the queues and their reported metrics depend on
+type fairShareInputs struct {
+	totalResource rs.ResourceQuantities
+	kValue        float64
+	queues        map[common_info.QueueID]queueFairShareInputs
+}
+
+type queueFairShareInputs struct {
+	name              string
+	parentQueue       common_info.QueueID
+	priority          int
+	creationTimestamp int64
+	cpu               resourceFairShareInputs
+	memory            resourceFairShareInputs
+	gpu               resourceFairShareInputs
+}
+
+type resourceFairShareInputs struct {
+	deserved        float64
+	maxAllowed      float64
+	overQuotaWeight float64
+	request         float64
+	allocated       float64
+	usage           float64
+}
+
+func newFairShareInputs(totalResource rs.ResourceQuantities, kValue float64,
+	queues map[common_info.QueueID]*rs.QueueAttributes) fairShareInputs {
+	inputs := fairShareInputs{
+		totalResource: totalResource.Clone(),
+		kValue:        kValue,
+		queues:        make(map[common_info.QueueID]queueFairShareInputs, len(queues)),
+	}
+	for queueID, queue := range queues {
+		inputs.queues[queueID] = queueFairShareInputs{
+			name:              queue.Name,
+			parentQueue:       queue.ParentQueue,
+			priority:          queue.Priority,
+			creationTimestamp: queue.CreationTimestamp.UnixNano(),
+			cpu:               newResourceFairShareInputs(&queue.CPU),
+			memory:            newResourceFairShareInputs(&queue.Memory),
+			gpu:               newResourceFairShareInputs(&queue.GPU),
+		}
+	}
+	return inputs
+}
+
+func newResourceFairShareInputs(resourceShare *rs.ResourceShare) resourceFairShareInputs {
+	return resourceFairShareInputs{
+		deserved:        resourceShare.Deserved,
+		maxAllowed:      resourceShare.MaxAllowed,
+		overQuotaWeight: resourceShare.OverQuotaWeight,
+		request:         resourceShare.Request,
+		allocated:       resourceShare.Allocated,
+		usage:           resourceShare.Usage,
+	}
+}
+
+func (fsi fairShareInputs) equal(other fairShareInputs) bool {
+	return fsi.kValue == other.kValue && maps.Equal(fsi.totalResource, other.totalResource) &&
+		maps.Equal(fsi.queues, other.queues)
+}
+
+// restore sets the fair shares and entitlement deltas of the queues from the cache, if it was stored with the same
+// inputs
+func (c *fairShareCache) restore(inputs fairShareInputs, queues map[common_info.QueueID]*rs.QueueAttributes) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.fairShares == nil || !c.inputs.equal(inputs) {
+		return false
+	}
+	for queueID, queue := range queues {
+		queue.RestoreFairShare(c.fairShares[queueID], c.entitlementDeltas[queueID].Clone())
+	}
+	return true
+}
+
+// store replaces the cache with the fair shares and entitlement deltas that were computed from the inputs
+func (c *fairShareCache) store(inputs fairShareInputs, queues map[common_info.QueueID]*rs.QueueAttributes) {
+	fairShares := make(map[common_info.QueueID]rs.ResourceQuantities, len(queues))
+	entitlementDeltas := make(map[common_info.QueueID]rs.ResourceQuantities, len(queues))
+	for queueID, queue := range queues {
+		fairShares[queueID] = queue.GetFairShare().Clone()
+		entitlementDeltas[queueID] = queue.GetEntitlementDelta().Clone()
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.inputs = inputs
+	c.fairShares = fairShares
+	c.entitlementDeltas = entitlementDeltas
+}
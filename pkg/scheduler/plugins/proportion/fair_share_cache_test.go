@@ -0,0 +1,145 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package proportion
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+)
+
+var _ = Describe("Fair share cache", func() {
+	var (
+		cache         *fairShareCache
+		totalResource rs.ResourceQuantities
+	)
+
+	BeforeEach(func() {
+		cache = &fairShareCache{}
+		totalResource = rs.ResourceQuantities{rs.GpuResource: 8}
+	})
+
+	divide := func(queues map[common_info.QueueID]*rs.QueueAttributes) {
+		plugin := &proportionPlugin{totalResource: totalResource, kValue: 1, queues: queues}
+		plugin.setFairShareForQueues(totalResource, 1, plugin.getTopQueues())
+		cache.store(newFairShareInputs(totalResource, 1, queues), queues)
+	}
+
+	It("restores the fair shares and entitlement deltas of unchanged queues", func() {
+		divide(newBenchmarkQueues(2, 2))
+
+		queues := newBenchmarkQueues(2, 2)
+		Expect(cache.restore(newFairShareInputs(totalResource, 1, queues), queues)).To(BeTrue())
+
+		expectedQueues := newBenchmarkQueues(2, 2)
+		divide(expectedQueues)
+		for queueID, queue := range queues {
+			Expect(queue.GetFairShare()).To(Equal(expectedQueues[queueID].GetFairShare()), string(queueID))
+			Expect(queue.GetEntitlementDelta()).To(Equal(expectedQueues[queueID].GetEntitlementDelta()),
+				string(queueID))
+		}
+	})
+
+	It("doesn't share the entitlement deltas of restored queues", func() {
+		divide(newBenchmarkQueues(1, 1))
+
+		queues := newBenchmarkQueues(1, 1)
+		Expect(cache.restore(newFairShareInputs(totalResource, 1, queues), queues)).To(BeTrue())
+		delta := queues["team-0-0"].GetEntitlementDelta()[rs.GpuResource]
+		queues["team-0-0"].AddAllocatedShare(rs.ResourceQuantities{rs.GpuResource: 1}, true)
+		Expect(queues["team-0-0"].GetEntitlementDelta()[rs.GpuResource]).To(Equal(delta - 1))
+
+		queues = newBenchmarkQueues(1, 1)
+		Expect(cache.restore(newFairShareInputs(totalResource, 1, queues), queues)).To(BeTrue())
+		Expect(queues["team-0-0"].GetEntitlementDelta()[rs.GpuResource]).To(Equal(delta))
+	})
+
+	It("is invalidated when the quota, usage, names or total resources change", func() {
+		divide(newBenchmarkQueues(2, 2))
+
+		changes := map[string]func(queues map[common_info.QueueID]*rs.QueueAttributes){
+			"quota":     func(queues map[common_info.QueueID]*rs.QueueAttributes) { queues["team-0-1"].GPU.Deserved++ },
+			"request":   func(queues map[common_info.QueueID]*rs.QueueAttributes) { queues["team-1-0"].GPU.Request++ },
+			"allocated": func(queues map[common_info.QueueID]*rs.QueueAttributes) { queues["team-1-1"].GPU.Allocated++ },
+			"usage":     func(queues map[common_info.QueueID]*rs.QueueAttributes) { queues["department-0"].GPU.Usage = 0.5 },
+			"name":      func(queues map[common_info.QueueID]*rs.QueueAttributes) { queues["team-0-0"].Name = "renamed" },
+			"removed queue": func(queues map[common_info.QueueID]*rs.QueueAttributes) {
+				delete(queues, "team-0-0")
+			},
+		}
+		for change, apply := range changes {
+			queues := newBenchmarkQueues(2, 2)
+			apply(queues)
+			Expect(cache.restore(newFairShareInputs(totalResource, 1, queues), queues)).To(BeFalse(), change)
+		}
+
+		queues := newBenchmarkQueues(2, 2)
+		totalResource = rs.ResourceQuantities{rs.GpuResource: 9}
+		Expect(cache.restore(newFairShareInputs(totalResource, 1, queues), queues)).To(BeFalse())
+	})
+})
+
+// newBenchmarkQueues returns departments with teams that are allocated their deserved quota and request twice as much
+func newBenchmarkQueues(departments, teamsPerDepartment int) map[common_info.QueueID]*rs.QueueAttributes {
+	queues := map[common_info.QueueID]*rs.QueueAttributes{}
+	for d := 0; d < departments; d++ {
+		department := &rs.QueueAttributes{UID: common_info.QueueID(fmt.Sprintf("department-%d", d))}
+		queues[department.UID] = department
+		for t := 0; t < teamsPerDepartment; t++ {
+			team := &rs.QueueAttributes{
+				UID:         common_info.QueueID(fmt.Sprintf("team-%d-%d", d, t)),
+				ParentQueue: department.UID,
+			}
+			department.ChildQueues = append(department.ChildQueues, team.UID)
+			queues[team.UID] = team
+		}
+	}
+	for _, queue := range queues {
+		queue.Name = string(queue.UID)
+		deserved := float64(max(len(queue.ChildQueues), 1))
+		queue.SetQuotaResources(rs.CpuResource, 0, commonconstants.UnlimitedResourceQuantity, 1)
+		queue.SetQuotaResources(rs.MemoryResource, 0, commonconstants.UnlimitedResourceQuantity, 1)
+		queue.SetQuotaResources(rs.GpuResource, deserved, commonconstants.UnlimitedResourceQuantity, 1)
+		queue.GPU.Allocated = deserved
+		queue.GPU.Request = 2 * deserved
+	}
+	return queues
+}
+
+func BenchmarkSetFairShare(b *testing.B) {
+	const departments, teamsPerDepartment = 50, 40
+	totalResource := rs.ResourceQuantities{
+		rs.GpuResource:    departments * teamsPerDepartment * 1.5,
+		rs.CpuResource:    0,
+		rs.MemoryResource: 0,
+	}
+
+	run := func(b *testing.B, warm bool) {
+		sharedFairShareCache = &fairShareCache{}
+		if warm {
+			(&proportionPlugin{totalResource: totalResource, kValue: 1,
+				queues: newBenchmarkQueues(departments, teamsPerDepartment)}).setFairShare()
+		}
+		b.ResetTimer()
+		for range b.N {
+			b.StopTimer()
+			plugin := &proportionPlugin{totalResource: totalResource, kValue: 1,
+				queues: newBenchmarkQueues(departments, teamsPerDepartment)}
+			if !warm {
+				sharedFairShareCache = &fairShareCache{}
+			}
+			b.StartTimer()
+			plugin.setFairShare()
+		}
+	}
+
+	b.Run("recomputed", func(b *testing.B) { run(b, false) })
+	b.Run("restored", func(b *testing.B) { run(b, true) })
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package proportion
+
+import (
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/utils"
+)
+
+// recordLoans records on an allocated job the sibling queues with loan payback whose unused deserved quota it
+// borrows. A queue borrows when its allocation exceeds its deserved quota, at any level of the queue hierarchy.
+func (pp *proportionPlugin) recordLoans(job *podgroup_info.PodGroupInfo) {
+	jobResources := utils.QuantifyResource(job.Allocated)
+	for queue, ok := pp.queues[job.Queue]; ok; queue, ok = pp.queues[queue.ParentQueue] {
+		overQuotaResources := getOverQuotaResources(queue, jobResources)
+		if len(overQuotaResources) == 0 {
+			continue
+		}
+		for _, sibling := range pp.getSiblingQueues(queue) {
+			if sibling.LoanPaybackMultiplier > 0 && hasUnusedDeservedQuota(sibling, overQuotaResources) {
+				log.InfraLogger.V(4).Infof("Job <%s/%s> of queue <%s> borrows the deserved quota of queue <%s>",
+					job.Namespace, job.Name, queue.Name, sibling.Name)
+				job.AddLoanLenders(sibling.UID)
+			}
+		}
+	}
+}
+
+// boostLendersOverQuotaWeight multiplies the over-quota weight of queues with outstanding loans, giving them a
+// higher share of the resources that their borrowers leave idle. Loans of jobs that are no longer allocated were
+// paid back, and are cleared.
+func (pp *proportionPlugin) boostLendersOverQuotaWeight(ssn *framework.Session) {
+	lenders := map[common_info.QueueID]bool{}
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		if len(job.LoanLenders) == 0 {
+			continue
+		}
+		if job.GetActiveAllocatedTasksCount() == 0 {
+			log.InfraLogger.V(4).Infof("Job <%s/%s> paid back its loans from queues <%v>",
+				job.Namespace, job.Name, job.LoanLenders)
+			job.LoanLenders = nil
+			continue
+		}
+		for _, lender := range job.LoanLenders {
+			lenders[lender] = true
+		}
+	}
+
+	for lender := range lenders {
+		queue, found := pp.queues[lender]
+		if !found || queue.LoanPaybackMultiplier <= 0 {
+			continue
+		}
+		for _, resource := range rs.AllResources {
+			queue.ResourceShare(resource).OverQuotaWeight *= queue.LoanPaybackMultiplier
+		}
+		log.InfraLogger.V(4).Infof("Queue <%s> has outstanding loans, over-quota weight multiplied by <%v>",
+			queue.Name, queue.LoanPaybackMultiplier)
+	}
+}
+
+func (pp *proportionPlugin) getSiblingQueues(queue *rs.QueueAttributes) []*rs.QueueAttributes {
+	var siblings []*rs.QueueAttributes
+	if queue.IsTopQueue() {
+		for _, topQueue := range pp.getTopQueues() {
+			if topQueue.UID != queue.UID {
+				siblings = append(siblings, topQueue)
+			}
+		}
+		return siblings
+	}
+
+	parent, found := pp.queues[queue.ParentQueue]
+	if !found {
+		return nil
+	}
+	for _, childID := range parent.ChildQueues {
+		if child, found := pp.queues[childID]; found && childID != queue.UID {
+			siblings = append(siblings, child)
+		}
+	}
+	return siblings
+}
+
+func getOverQuotaResources(queue *rs.QueueAttributes, jobResources rs.ResourceQuantities) []rs.ResourceName {
+	var overQuotaResources []rs.ResourceName
+	for _, resource := range rs.AllResources {
+		resourceShare := queue.ResourceShare(resource)
+		if jobResources[resource] <= 0 || resourceShare.Deserved == commonconstants.UnlimitedResourceQuantity {
+			continue
+		}
+		if resourceShare.Allocated > resourceShare.Deserved {
+			overQuotaResources = append(overQuotaResources, resource)
+		}
+	}
+	return overQuotaResources
+}
+
+func hasUnusedDeservedQuota(queue *rs.QueueAttributes, resources []rs.ResourceName) bool {
+	for _, resource := range resources {
+		resourceShare := queue.ResourceShare(resource)
+		if resourceShare.Deserved != commonconstants.UnlimitedResourceQuantity &&
+			resourceShare.Allocated < resourceShare.Deserved {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package proportion
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+)
+
+var _ = Describe("Loan payback", func() {
+	newQueue := func(
+		uid common_info.QueueID, parent common_info.QueueID, deserved, allocated, multiplier float64,
+	) *rs.QueueAttributes {
+		return &rs.QueueAttributes{
+			UID:                   uid,
+			Name:                  string(uid),
+			ParentQueue:           parent,
+			LoanPaybackMultiplier: multiplier,
+			QueueResourceShare: rs.QueueResourceShare{
+				GPU: rs.ResourceShare{Deserved: deserved, Allocated: allocated, OverQuotaWeight: 1},
+			},
+		}
+	}
+	newJob := func(
+		uid common_info.PodGroupID, queue common_info.QueueID, status pod_status.PodStatus, gpus float64,
+	) *podgroup_info.PodGroupInfo {
+		job := podgroup_info.NewPodGroupInfo(uid, &pod_info.PodInfo{
+			UID:    common_info.PodID(uid),
+			Job:    uid,
+			Status: status,
+			ResReq: resource_info.NewResourceRequirementsWithGpus(gpus),
+		})
+		job.Queue = queue
+		return job
+	}
+
+	Context("recordLoans", func() {
+		It("should record siblings with loan payback and unused deserved quota as lenders", func() {
+			plugin := &proportionPlugin{
+				queues: map[common_info.QueueID]*rs.QueueAttributes{
+					"borrower":      newQueue("borrower", "", 2, 4, 0),
+					"lender":        newQueue("lender", "", 4, 1, 2),
+					"fully-used":    newQueue("fully-used", "", 2, 2, 2),
+					"no-loan-terms": newQueue("no-loan-terms", "", 4, 0, 0),
+				},
+			}
+			job := newJob("job", "borrower", pod_status.Running, 2)
+
+			plugin.recordLoans(job)
+
+			Expect(job.LoanLenders).To(ConsistOf(common_info.QueueID("lender")))
+		})
+
+		It("should not record loans for a queue within its deserved quota", func() {
+			plugin := &proportionPlugin{
+				queues: map[common_info.QueueID]*rs.QueueAttributes{
+					"borrower": newQueue("borrower", "", 4, 2, 0),
+					"lender":   newQueue("lender", "", 4, 1, 2),
+				},
+			}
+			job := newJob("job", "borrower", pod_status.Running, 2)
+
+			plugin.recordLoans(job)
+
+			Expect(job.LoanLenders).To(BeEmpty())
+		})
+
+		It("should record lenders at the level of the hierarchy where the quota is borrowed", func() {
+			plugin := &proportionPlugin{
+				queues: map[common_info.QueueID]*rs.QueueAttributes{
+					"department-a": newQueue("department-a", "", 2, 4, 0),
+					"department-b": newQueue("department-b", "", 4, 0, 2),
+					"team-a1":      newQueue("team-a1", "department-a", 2, 2, 0),
+					"team-a2":      newQueue("team-a2", "department-a", 2, 2, 2),
+				},
+			}
+			plugin.queues["department-a"].ChildQueues = []common_info.QueueID{"team-a1", "team-a2"}
+			job := newJob("job", "team-a1", pod_status.Running, 2)
+
+			plugin.recordLoans(job)
+
+			Expect(job.LoanLenders).To(ConsistOf(common_info.QueueID("department-b")))
+		})
+	})
+
+	Context("boostLendersOverQuotaWeight", func() {
+		It("should multiply the over-quota weight of lenders with outstanding loans", func() {
+			plugin := &proportionPlugin{
+				queues: map[common_info.QueueID]*rs.QueueAttributes{
+					"borrower": newQueue("borrower", "", 2, 4, 0),
+					"lender":   newQueue("lender", "", 4, 1, 3),
+					"other":    newQueue("other", "", 4, 1, 2),
+				},
+			}
+			job := newJob("job", "borrower", pod_status.Running, 2)
+			job.LoanLenders = []common_info.QueueID{"lender"}
+			ssn := &framework.Session{ClusterInfo: &api.ClusterInfo{
+				PodGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{job.UID: job},
+			}}
+
+			plugin.boostLendersOverQuotaWeight(ssn)
+
+			Expect(plugin.queues["lender"].GPU.OverQuotaWeight).To(Equal(3.0))
+			Expect(plugin.queues["other"].GPU.OverQuotaWeight).To(Equal(1.0))
+			Expect(job.LoanLenders).To(ConsistOf(common_info.QueueID("lender")))
+		})
+
+		It("should clear the loans of jobs that are no longer allocated", func() {
+			plugin := &proportionPlugin{
+				queues: map[common_info.QueueID]*rs.QueueAttributes{
+					"borrower": newQueue("borrower", "", 2, 0, 0),
+					"lender":   newQueue("lender", "", 4, 1, 3),
+				},
+			}
+			job := newJob("job", "borrower", pod_status.Pending, 2)
+			job.LoanLenders = []common_info.QueueID{"lender"}
+			ssn := &framework.Session{ClusterInfo: &api.ClusterInfo{
+				PodGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{job.UID: job},
+			}}
+
+			plugin.boostLendersOverQuotaWeight(ssn)
+
+			Expect(plugin.queues["lender"].GPU.OverQuotaWeight).To(Equal(1.0))
+			Expect(job.LoanLenders).To(BeEmpty())
+		})
+	})
+})
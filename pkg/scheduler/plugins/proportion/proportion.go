@@ -20,7 +20,9 @@ limitations under the License.
 package proportion
 
 import (
+	"maps"
 	"math"
+	"time"
 
 	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
@@ -50,9 +52,14 @@ const (
 )
 
 type proportionPlugin struct {
-	totalResource       rs.ResourceQuantities
-	queues              map[common_info.QueueID]*rs.QueueAttributes
+	totalResource rs.ResourceQuantities
+	queues        map[common_info.QueueID]*rs.QueueAttributes
+	// jobSimulationQueues are the queues as they were when the current job solution started. A queue is shared with
+	// the queues of the session until its allocation changes, and is cloned before it does.
 	jobSimulationQueues map[common_info.QueueID]*rs.QueueAttributes
+	// allocatedJobTasks counts the tasks of every job that were allocated in the session, so a job counts once in the
+	// allocated jobs of its queues
+	allocatedJobTasks map[common_info.PodGroupID]int
 	// Arguments given for the plugin
 	pluginArguments               framework.PluginArguments
 	subGroupOrderFn               common_info.LessFn
@@ -62,6 +69,8 @@ type proportionPlugin struct {
 	relcaimerSaturationMultiplier float64
 	kValue                        float64
 	minNodeGPUMemory              int64
+	queueOrderFn                  queue_order.OrderFn
+	maxQuotaReservationTimeout    time.Duration
 }
 
 func New(arguments framework.PluginArguments) framework.Plugin {
@@ -83,26 +92,62 @@ func New(arguments framework.PluginArguments) framework.Plugin {
 		kValue = 0.0
 	}
 
+	maxQuotaReservationTimeout, err := arguments.GetDuration("maxQuotaReservationTimeout",
+		defaultMaxQuotaReservationTimeout)
+	if err != nil {
+		log.InfraLogger.Warningf("Failed to parse maxQuotaReservationTimeout: %v. Using default value of %v",
+			err, defaultMaxQuotaReservationTimeout)
+		maxQuotaReservationTimeout = defaultMaxQuotaReservationTimeout
+	}
+
+	queueOrderFn, err := getQueueOrderFn(arguments)
+	if err != nil {
+		log.InfraLogger.Errorf("Failed to parse queueOrderStrategy: %v. Using default value of %s",
+			err, queue_order.ShareBasedStrategy)
+		queueOrderFn = queue_order.GetQueueOrderResult
+	}
+
 	return &proportionPlugin{
 		totalResource:                 rs.EmptyResourceQuantities(),
 		queues:                        map[common_info.QueueID]*rs.QueueAttributes{},
+		allocatedJobTasks:             map[common_info.PodGroupID]int{},
 		pluginArguments:               arguments,
 		relcaimerSaturationMultiplier: multiplier,
 		kValue:                        kValue,
+		queueOrderFn:                  queueOrderFn,
+		maxQuotaReservationTimeout:    maxQuotaReservationTimeout,
 	}
 }
 
+// ValidateArguments rejects proportion plugin arguments that can't be parsed
+func ValidateArguments(arguments framework.PluginArguments) error {
+	if _, err := arguments.GetDuration("maxQuotaReservationTimeout", defaultMaxQuotaReservationTimeout); err != nil {
+		return err
+	}
+	_, err := getQueueOrderFn(arguments)
+	return err
+}
+
+func getQueueOrderFn(arguments framework.PluginArguments) (queue_order.OrderFn, error) {
+	return queue_order.GetOrderFn(queue_order.Strategy(
+		arguments.GetString("queueOrderStrategy", string(queue_order.ShareBasedStrategy))))
+}
+
 func (pp *proportionPlugin) Name() string {
 	return "proportion"
 }
 
 func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 	pp.calculateResourcesProportion(ssn)
+	pp.setBurstAllowances(ssn)
+	pp.reportReclaimableResources(ssn)
 	pp.subGroupOrderFn = ssn.PodSetOrderFn
 	pp.taskOrderFunc = ssn.TaskOrderFn
 	pp.minNodeGPUMemory = ssn.ClusterInfo.MinNodeGPUMemory
 	pp.reclaimablePlugin = rec.New(pp.relcaimerSaturationMultiplier)
+	pp.allocatedJobTasks = map[common_info.PodGroupID]int{}
 	capacityPolicy := cp.New(pp.queues)
+	capacityPolicy.SetReplacedPodGroups(podgroup_info.GetReplacedPodGroups(ssn.ClusterInfo.PodGroupInfos))
 	ssn.AddQueueOrderFn(pp.queueOrder)
 	ssn.AddCanReclaimResourcesFn(pp.CanReclaimResourcesFn)
 	ssn.AddReclaimScenarioValidatorFn(pp.reclaimableFn)
@@ -110,6 +155,7 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 	ssn.AddIsNonPreemptibleJobOverQueueQuotaFns(capacityPolicy.IsNonPreemptibleJobOverQuota)
 	ssn.AddIsJobOverCapacityFn(capacityPolicy.IsJobOverQueueCapacity)
 	ssn.AddIsTaskAllocationOnNodeOverCapacityFn(capacityPolicy.IsTaskAllocationOnNodeOverCapacity)
+	ssn.AddPostJobAllocationFn(pp.recordLoans)
 
 	// Register event handlers.
 	ssn.AddEventHandler(&framework.EventHandler{
@@ -126,12 +172,18 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 func (pp *proportionPlugin) OnSessionClose(*framework.Session) {
 	pp.totalResource = nil
 	pp.queues = nil
+	pp.allocatedJobTasks = nil
 }
 
 func (pp *proportionPlugin) OnJobSolutionStartFn() {
-	pp.jobSimulationQueues = map[common_info.QueueID]*rs.QueueAttributes{}
-	for queueId, queue := range pp.queues {
-		pp.jobSimulationQueues[queueId] = queue.Clone()
+	pp.jobSimulationQueues = maps.Clone(pp.queues)
+}
+
+// detachJobSimulationQueue clones the queue into the job simulation queues before its allocation changes, if it is
+// still shared with them
+func (pp *proportionPlugin) detachJobSimulationQueue(queue *rs.QueueAttributes) {
+	if simulationQueue, found := pp.jobSimulationQueues[queue.UID]; found && simulationQueue == queue {
+		pp.jobSimulationQueues[queue.UID] = queue.Clone()
 	}
 }
 
@@ -250,14 +302,34 @@ func (pp *proportionPlugin) calculateResourcesProportion(ssn *framework.Session)
 }
 
 func (pp *proportionPlugin) setTotalResources(ssn *framework.Session) {
+	maintenanceCapacity := rs.EmptyResourceQuantities()
+	maintenanceUsage := rs.EmptyResourceQuantities()
 	for _, node := range ssn.ClusterInfo.Nodes {
-		pp.totalResource.Add(getNodeResources(ssn, node))
+		nodeResource := getNodeResources(ssn, node)
+		pp.totalResource.Add(nodeResource)
+		if node.IsCordoned() {
+			maintenanceCapacity.Add(utils.QuantifyResource(node.Allocatable))
+			maintenanceUsage.Add(nodeResource)
+		}
 	}
+
+	metrics.UpdateMaintenanceCapacity(
+		maintenanceCapacity[rs.CpuResource]/resource_info.MilliCPUToCores,
+		maintenanceCapacity[rs.MemoryResource]/resource_info.MemoryToGB,
+		maintenanceCapacity[rs.GpuResource],
+		maintenanceUsage[rs.CpuResource]/resource_info.MilliCPUToCores,
+		maintenanceUsage[rs.MemoryResource]/resource_info.MemoryToGB,
+		maintenanceUsage[rs.GpuResource],
+	)
 }
 
 func getNodeResources(ssn *framework.Session, node *node_info.NodeInfo) rs.ResourceQuantities {
 	nodeResource := rs.EmptyResourceQuantities()
 
+	if node.IsCordoned() {
+		return getCordonedNodeResources(ssn, node)
+	}
+
 	if !scheduler_util.ValidateIsNodeReady(node.Node) {
 		log.InfraLogger.V(2).Infof("Node <%v> is not ready, not counting resource for proportion calculations", node.Name)
 		return nodeResource
@@ -283,13 +355,36 @@ func getNodeResources(ssn *framework.Session, node *node_info.NodeInfo) rs.Resou
 			nodeResource.Sub(utils.QuantifyResourceRequirements(podInfo.ResReq))
 		}
 	}
+	if node.ReservedForOtherSchedulers != nil {
+		nodeResource.Sub(utils.QuantifyResource(node.ReservedForOtherSchedulers))
+	}
+
+	return nodeResource
+}
+
+// getCordonedNodeResources counts only the resources allocated to the scheduler's pods on a cordoned node. Their usage
+// counts against their queues, so it is part of the resources divided between the queues, while the rest of the node's
+// capacity is in maintenance and is not available to any queue.
+func getCordonedNodeResources(ssn *framework.Session, node *node_info.NodeInfo) rs.ResourceQuantities {
+	nodeResource := rs.EmptyResourceQuantities()
+
+	schedulerName := ssn.GetSchedulerName()
+	for _, podInfo := range node.PodInfos {
+		if podInfo.Pod.Spec.SchedulerName == schedulerName && pod_status.AllocatedStatus(podInfo.Status) {
+			nodeResource.Add(utils.QuantifyResourceRequirements(podInfo.AcceptedResource))
+		}
+	}
 
+	log.InfraLogger.V(2).Infof("Node <%v> is cordoned, counting only the resources of its running pods <%v> for "+
+		"proportion calculations", node.Name, nodeResource)
 	return nodeResource
 }
 
 func (pp *proportionPlugin) createQueueAttributes(ssn *framework.Session) {
 	pp.createQueueResourceAttrs(ssn)
 	pp.updateQueuesCurrentResourceUsage(ssn)
+	pp.reserveQuotaForAccumulatingGangs(ssn)
+	pp.boostLendersOverQuotaWeight(ssn)
 	pp.setFairShare()
 }
 
@@ -317,7 +412,10 @@ func (pp *proportionPlugin) createQueueResourceAttrs(ssn *framework.Session) {
 				CPU:    rs.ResourceShare{},
 				Memory: rs.ResourceShare{},
 			},
-			Priority: queue.Priority,
+			Priority:              queue.Priority,
+			PriorityQuotaCaps:     queue.PriorityQuotaCaps,
+			LoanPaybackMultiplier: queue.LoanPaybackMultiplier,
+			BudgetExhausted:       queue.IsBudgetExhausted(ssn.Clock().Now()),
 		}
 		deserved := queue.Resources.CPU.Quota
 		limit := queue.Resources.CPU.Limit
@@ -355,39 +453,57 @@ func (pp *proportionPlugin) updateQueuesCurrentResourceUsage(ssn *framework.Sess
 					resources := utils.QuantifyResourceRequirements(t.AcceptedResource)
 					isPreemptible := job.IsPreemptibleJob()
 					pp.updateQueuesResourceUsageForAllocatedJob(job.Queue, resources, isPreemptible)
+					pp.updateQueuesPriorityClassAllocation(job, resources)
 				}
 			} else if status == pod_status.Pending {
 				for _, t := range tasks {
-					resources := utils.QuantifyResourceRequirements(t.ResReq)
-					if t.IsMemoryRequest() {
-						resources.Add(rs.ResourceQuantities{
-							rs.GpuResource: float64(t.ResReq.GpuResourceRequirement.GetNumOfGpuDevices()) * (float64(t.ResReq.GpuMemory()) / float64(ssn.ClusterInfo.MinNodeGPUMemory)),
-						})
-					}
+					resources := pp.getPendingTaskResources(ssn, t)
 					pp.updateQueuesResourceUsageForPendingJob(job.Queue, resources)
 				}
 			}
 		}
 	}
+
+	// Jobs of other node pools that may reclaim in this node pool request their resources here too, so that their
+	// queues get a fair share to reclaim with.
+	for _, job := range ssn.ClusterInfo.CrossPoolReclaimJobs {
+		for _, t := range job.PodStatusIndex[pod_status.Pending] {
+			pp.updateQueuesResourceUsageForPendingJob(job.Queue, pp.getPendingTaskResources(ssn, t))
+		}
+	}
+}
+
+// getPendingTaskResources returns the resources that a pending task requests. A GPU memory request is counted as the
+// portion of a device with the minimal GPU memory in the cluster.
+func (pp *proportionPlugin) getPendingTaskResources(ssn *framework.Session, t *pod_info.PodInfo) rs.ResourceQuantities {
+	resources := utils.QuantifyResourceRequirements(t.ResReq)
+	if t.IsMemoryRequest() {
+		resources.Add(rs.ResourceQuantities{
+			rs.GpuResource: float64(t.ResReq.GpuResourceRequirement.GetNumOfGpuDevices()) * (float64(t.ResReq.GpuMemory()) / float64(ssn.ClusterInfo.MinNodeGPUMemory)),
+		})
+	}
+	return resources
 }
 
 func (pp *proportionPlugin) updateQueuesResourceUsageForAllocatedJob(queueId common_info.QueueID,
 	resourceQuantities rs.ResourceQuantities, preemptibleJob bool) {
 
 	for queueAttributes, ok := pp.queues[queueId]; ok; queueAttributes, ok = pp.queues[queueAttributes.ParentQueue] {
+		queueAttributes.AddAllocatedShare(resourceQuantities, preemptibleJob)
 		for _, resource := range rs.AllResources {
-			qResourceShare := queueAttributes.ResourceShare(resource)
-			resourceRequestedQuota := resourceQuantities[resource]
-
-			qResourceShare.Allocated += resourceRequestedQuota
-			qResourceShare.Request += resourceRequestedQuota
-			if !preemptibleJob {
-				qResourceShare.AllocatedNotPreemptible += resourceRequestedQuota
-			}
+			queueAttributes.ResourceShare(resource).Request += resourceQuantities[resource]
 		}
 	}
 }
 
+func (pp *proportionPlugin) updateQueuesPriorityClassAllocation(job *podgroup_info.PodGroupInfo,
+	resourceQuantities rs.ResourceQuantities) {
+	priorityClassName := job.GetPriorityClassName()
+	for queueAttributes, ok := pp.queues[job.Queue]; ok; queueAttributes, ok = pp.queues[queueAttributes.ParentQueue] {
+		queueAttributes.AddPriorityClassAllocation(priorityClassName, resourceQuantities)
+	}
+}
+
 func (pp *proportionPlugin) updateQueuesResourceUsageForPendingJob(queueId common_info.QueueID,
 	resourceQuantities rs.ResourceQuantities) {
 
@@ -400,11 +516,18 @@ func (pp *proportionPlugin) updateQueuesResourceUsageForPendingJob(queueId commo
 	}
 }
 
+// setFairShare divides the total resources between the queues, unless the fair shares of the previous session are
+// still valid. The fair share and usage metrics of restored queues are left as they were reported.
 func (pp *proportionPlugin) setFairShare() {
-	topQueues := pp.getTopQueues()
+	inputs := newFairShareInputs(pp.totalResource, pp.kValue, pp.queues)
+	if sharedFairShareCache.restore(inputs, pp.queues) {
+		log.InfraLogger.V(6).Infof("Restored the fair share of <%d> queues from the previous session", len(pp.queues))
+		return
+	}
 	metrics.ResetQueueUsage()
 	metrics.ResetQueueFairShare()
-	pp.setFairShareForQueues(pp.totalResource, pp.kValue, topQueues)
+	pp.setFairShareForQueues(pp.totalResource, pp.kValue, pp.getTopQueues())
+	sharedFairShareCache.store(inputs, pp.queues)
 }
 
 func (pp *proportionPlugin) setFairShareForQueues(totalResources rs.ResourceQuantities, kValue float64,
@@ -445,15 +568,15 @@ func (pp *proportionPlugin) allocateHandlerFn(ssn *framework.Session) func(event
 		job := ssn.ClusterInfo.PodGroupInfos[event.Task.Job]
 		isPreemptibleJob := job.IsPreemptibleJob()
 		taskResources := utils.QuantifyResourceRequirements(event.Task.AcceptedResource)
+		pp.allocatedJobTasks[job.UID]++
+		firstJobTask := pp.allocatedJobTasks[job.UID] == 1
 
 		for queue, ok := pp.queues[job.Queue]; ok; queue, ok = pp.queues[queue.ParentQueue] {
-			for _, resource := range rs.AllResources {
-				resourceShare := queue.ResourceShare(resource)
-				resourceShare.Allocated += taskResources[resource]
-
-				if !isPreemptibleJob {
-					resourceShare.AllocatedNotPreemptible += taskResources[resource]
-				}
+			pp.detachJobSimulationQueue(queue)
+			queue.AddAllocatedShare(taskResources, isPreemptibleJob)
+			queue.AddPriorityClassAllocation(job.GetPriorityClassName(), taskResources)
+			if firstJobTask {
+				queue.AllocatedJobs++
 			}
 		}
 
@@ -469,15 +592,19 @@ func (pp *proportionPlugin) deallocateHandlerFn(ssn *framework.Session) func(eve
 		job := ssn.ClusterInfo.PodGroupInfos[event.Task.Job]
 		isPreemptibleJob := job.IsPreemptibleJob()
 		taskResources := utils.QuantifyResourceRequirements(event.Task.AcceptedResource)
+		// Only the tasks allocated in the session count, the tasks of evicted jobs were allocated before it
+		lastJobTask := false
+		if pp.allocatedJobTasks[job.UID] > 0 {
+			pp.allocatedJobTasks[job.UID]--
+			lastJobTask = pp.allocatedJobTasks[job.UID] == 0
+		}
 
 		for queue, ok := pp.queues[job.Queue]; ok; queue, ok = pp.queues[queue.ParentQueue] {
-			for _, resource := range rs.AllResources {
-				resourceShare := queue.ResourceShare(resource)
-				resourceShare.Allocated -= taskResources[resource]
-
-				if !isPreemptibleJob {
-					resourceShare.AllocatedNotPreemptible -= taskResources[resource]
-				}
+			pp.detachJobSimulationQueue(queue)
+			queue.SubAllocatedShare(taskResources, isPreemptibleJob)
+			queue.RemovePriorityClassAllocation(job.GetPriorityClassName(), taskResources)
+			if lastJobTask {
+				queue.AllocatedJobs--
 			}
 		}
 
@@ -501,7 +628,7 @@ func (pp *proportionPlugin) queueOrder(lQ, rQ *queue_info.QueueInfo, lJob, rJob
 		return -1
 	}
 
-	return queue_order.GetQueueOrderResult(lQueueAttributes, rQueueAttributes, lJob, rJob, lVictims, rVictims,
+	return pp.queueOrderFn(lQueueAttributes, rQueueAttributes, lJob, rJob, lVictims, rVictims,
 		pp.subGroupOrderFn, pp.taskOrderFunc, pp.totalResource, minNodeGPUMemory)
 }
 
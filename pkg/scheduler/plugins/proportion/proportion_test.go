@@ -4,6 +4,7 @@
 package proportion
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -773,6 +774,48 @@ var _ = Describe("Set Fair Share in Proportion", func() {
 					rs.GpuResource:    0,
 				},
 			},
+			{
+				name: "Count only the resources of running pods on a cordoned node",
+				node: &node_info.NodeInfo{
+					Name:        "n1",
+					Node:        &v1.Node{Spec: v1.NodeSpec{Unschedulable: true}},
+					Allocatable: common_info.BuildResource("8000m", "10G"),
+					PodInfos: map[common_info.PodID]*pod_info.PodInfo{
+						"1": {
+							Pod: &v1.Pod{
+								Spec: v1.PodSpec{
+									SchedulerName: schedulerName,
+								},
+							},
+							Status:           pod_status.Running,
+							AcceptedResource: common_info.BuildResourceRequirements("2", "2G"),
+						},
+						"2": {
+							Pod: &v1.Pod{
+								Spec: v1.PodSpec{
+									SchedulerName: "default-scheduler",
+								},
+							},
+							Status:           pod_status.Running,
+							AcceptedResource: common_info.BuildResourceRequirements("1", "1G"),
+						},
+						"3": {
+							Pod: &v1.Pod{
+								Spec: v1.PodSpec{
+									SchedulerName: schedulerName,
+								},
+							},
+							Status:           pod_status.Succeeded,
+							AcceptedResource: common_info.BuildResourceRequirements("1", "1G"),
+						},
+					},
+				},
+				want: rs.ResourceQuantities{
+					rs.CpuResource:    2000,
+					rs.MemoryResource: 2000000000,
+					rs.GpuResource:    0,
+				},
+			},
 		}
 
 		for _, data := range tests {
@@ -783,13 +826,14 @@ var _ = Describe("Set Fair Share in Proportion", func() {
 				mockCache.EXPECT().Snapshot().Times(1).Return(api.NewClusterInfo(), nil)
 				mockCache.EXPECT().InternalK8sPlugins().AnyTimes().Return(&k8splugins.K8sPlugins{})
 				session, _ := framework.OpenSession(
+					context.Background(),
 					mockCache,
 					&conf.SchedulerConfiguration{Tiers: []conf.Tier{}},
 					&conf.SchedulerParams{
 						RestrictSchedulingNodes: testData.isRestrictNode,
 						SchedulerName:           schedulerName,
 					},
-					"1", nil)
+					"1", nil, framework.SessionOptions{})
 				if got := getNodeResources(session, testData.node); !reflect.DeepEqual(got, testData.want) {
 					Fail(fmt.Sprintf("getNodeResources() = %v, want %v", got, testData.want))
 				}
@@ -941,6 +985,69 @@ var _ = Describe("Set Fair Share in Proportion", func() {
 	})
 })
 
+var _ = Describe("Job simulation queues", func() {
+	var (
+		plugin *proportionPlugin
+		ssn    *framework.Session
+		task   *pod_info.PodInfo
+	)
+
+	BeforeEach(func() {
+		plugin = New(framework.PluginArguments{}).(*proportionPlugin)
+		for _, queue := range []*rs.QueueAttributes{
+			{UID: "department", ChildQueues: []common_info.QueueID{"team-a", "team-b"}},
+			{UID: "team-a", ParentQueue: "department"},
+			{UID: "team-b", ParentQueue: "department"},
+		} {
+			queue.GPU.FairShare = 4
+			plugin.queues[queue.UID] = queue
+		}
+		ssn = &framework.Session{ClusterInfo: &api.ClusterInfo{
+			PodGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{
+				"job": {UID: "job", Queue: "team-a"},
+			},
+		}}
+		task = &pod_info.PodInfo{Job: "job", AcceptedResource: resource_info.NewResourceRequirementsWithGpus(2)}
+	})
+
+	It("should keep the allocation of the queues when the job solution started", func() {
+		plugin.OnJobSolutionStartFn()
+		plugin.allocateHandlerFn(ssn)(&framework.Event{Task: task})
+
+		Expect(plugin.queues["team-a"].GPU.Allocated).To(Equal(2.0))
+		Expect(plugin.queues["department"].GPU.Allocated).To(Equal(2.0))
+		Expect(plugin.jobSimulationQueues["team-a"].GPU.Allocated).To(Equal(0.0))
+		Expect(plugin.jobSimulationQueues["department"].GPU.Allocated).To(Equal(0.0))
+		Expect(plugin.jobSimulationQueues["team-b"]).To(BeIdenticalTo(plugin.queues["team-b"]))
+	})
+
+	It("should update the entitlement delta of the queues on allocation changes", func() {
+		Expect(plugin.queues["team-a"].GetEntitlementDelta()[rs.GpuResource]).To(Equal(4.0))
+		plugin.allocateHandlerFn(ssn)(&framework.Event{Task: task})
+		Expect(plugin.queues["team-a"].GetEntitlementDelta()[rs.GpuResource]).To(Equal(2.0))
+		plugin.deallocateHandlerFn(ssn)(&framework.Event{Task: task})
+		Expect(plugin.queues["team-a"].GetEntitlementDelta()[rs.GpuResource]).To(Equal(4.0))
+	})
+
+	It("should count a job once in the allocated jobs of its queues", func() {
+		otherTask := &pod_info.PodInfo{Job: "job", AcceptedResource: resource_info.NewResourceRequirementsWithGpus(1)}
+		plugin.allocateHandlerFn(ssn)(&framework.Event{Task: task})
+		plugin.allocateHandlerFn(ssn)(&framework.Event{Task: otherTask})
+		Expect(plugin.queues["team-a"].AllocatedJobs).To(Equal(1))
+		Expect(plugin.queues["department"].AllocatedJobs).To(Equal(1))
+		Expect(plugin.queues["team-b"].AllocatedJobs).To(Equal(0))
+
+		plugin.deallocateHandlerFn(ssn)(&framework.Event{Task: otherTask})
+		Expect(plugin.queues["team-a"].AllocatedJobs).To(Equal(1))
+		plugin.deallocateHandlerFn(ssn)(&framework.Event{Task: task})
+		Expect(plugin.queues["team-a"].AllocatedJobs).To(Equal(0))
+		Expect(plugin.queues["department"].AllocatedJobs).To(Equal(0))
+
+		plugin.deallocateHandlerFn(ssn)(&framework.Event{Task: task})
+		Expect(plugin.queues["team-a"].AllocatedJobs).To(Equal(0), "tasks allocated before the session don't count")
+	})
+})
+
 var _ = Describe("New", func() {
 	Context("Initializing proportion plugin", func() {
 		var args framework.PluginArguments
@@ -245,29 +245,23 @@ func calculateDominantResourceShareWithJob(
 	subGroupOrderFn common_info.LessFn, taskOrderFn common_info.LessFn, totalResources rs.ResourceQuantities,
 	minNodeGPUMemory int64,
 ) float64 {
-	allocatedShare := queueAttributes.GetAllocatedShare()
+	// The allocation is changed on a copy, leaving the queue and its cached quantities as they are
+	queueShare := queueAttributes.QueueResourceShare
 
 	jobResources := podgroup_info.GetTasksToAllocateInitResource(jobInfo, subGroupOrderFn, taskOrderFn, false, minNodeGPUMemory)
 	initResQuantities := utils.QuantifyResource(jobResources)
 
 	for _, resource := range rs.AllResources {
-		resourceShare := queueAttributes.ResourceShare(resource)
+		resourceShare := queueShare.ResourceShare(resource)
 		resourceShare.Allocated += initResQuantities[resource]
 	}
 
 	for _, victim := range victims {
 		for _, resource := range rs.AllResources {
-			resourceShare := queueAttributes.ResourceShare(resource)
+			resourceShare := queueShare.ResourceShare(resource)
 			resourceShare.Allocated -= utils.QuantifyResource(victim.Allocated)[resource]
 		}
 	}
 
-	share := queueAttributes.GetDominantResourceShare(totalResources)
-
-	for _, resource := range rs.AllResources {
-		resourceShare := queueAttributes.ResourceShare(resource)
-		resourceShare.Allocated = allocatedShare[resource]
-	}
-
-	return share
+	return queueShare.GetDominantResourceShare(totalResources)
 }
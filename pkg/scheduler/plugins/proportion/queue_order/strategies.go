@@ -0,0 +1,172 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package queue_order
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/utils"
+)
+
+type Strategy string
+
+const (
+	// ShareBasedStrategy orders queues by fair share and quota starvation, then by priority and dominant resource share
+	ShareBasedStrategy Strategy = "shareBased"
+	// StrictPriorityStrategy always orders queues with higher priority first, falling back to share based ordering
+	// between queues of the same priority
+	StrictPriorityStrategy Strategy = "strictPriority"
+	// WeightedRoundRobinStrategy lets the queues take turns allocating jobs, each queue taking a number of turns per
+	// round in proportion to its over-quota weight
+	WeightedRoundRobinStrategy Strategy = "weightedRoundRobin"
+	// DeficitStrategy orders first the queue that is the furthest below its fair share
+	DeficitStrategy Strategy = "deficit"
+
+	noWeightPenalty = 1000
+)
+
+type OrderFn func(
+	lQueue *rs.QueueAttributes, rQueue *rs.QueueAttributes,
+	lJobInfo, rJobInfo *podgroup_info.PodGroupInfo,
+	lVictims, rVictims []*podgroup_info.PodGroupInfo,
+	subGroupOrderFn common_info.LessFn, taskOrderFn common_info.LessFn,
+	totalResources rs.ResourceQuantities, minNodeGPUMemory int64,
+) int
+
+var orderFns = map[Strategy]OrderFn{
+	ShareBasedStrategy:         GetQueueOrderResult,
+	StrictPriorityStrategy:     getStrictPriorityOrderResult,
+	WeightedRoundRobinStrategy: getWeightedRoundRobinOrderResult,
+	DeficitStrategy:            getDeficitOrderResult,
+}
+
+func GetOrderFn(strategy Strategy) (OrderFn, error) {
+	orderFn, found := orderFns[strategy]
+	if !found {
+		return nil, fmt.Errorf("unknown queue order strategy: %s", strategy)
+	}
+	return orderFn, nil
+}
+
+func getStrictPriorityOrderResult(
+	lQueue *rs.QueueAttributes, rQueue *rs.QueueAttributes,
+	lJobInfo, rJobInfo *podgroup_info.PodGroupInfo,
+	lVictims, rVictims []*podgroup_info.PodGroupInfo,
+	subGroupOrderFn common_info.LessFn, taskOrderFn common_info.LessFn,
+	totalResources rs.ResourceQuantities, minNodeGPUMemory int64,
+) int {
+	if result := prioritizePrioritized(lQueue, rQueue); result != equalPrioritization {
+		return result
+	}
+
+	return GetQueueOrderResult(lQueue, rQueue, lJobInfo, rJobInfo, lVictims, rVictims,
+		subGroupOrderFn, taskOrderFn, totalResources, minNodeGPUMemory)
+}
+
+// getWeightedRoundRobinOrderResult orders first the queue whose next turn comes first in the round. The turns of a
+// queue are spread evenly over the round by its weight, so a queue with weight 3 allocates three jobs for every job
+// of a queue with weight 1. Queues of equal turns are ordered by priority and creation time.
+func getWeightedRoundRobinOrderResult(
+	lQueue *rs.QueueAttributes, rQueue *rs.QueueAttributes,
+	_, _ *podgroup_info.PodGroupInfo,
+	_, _ []*podgroup_info.PodGroupInfo,
+	_ common_info.LessFn, _ common_info.LessFn,
+	_ rs.ResourceQuantities, _ int64,
+) int {
+	if result := prioritizeSmallerValue(nextTurn(lQueue), nextTurn(rQueue)); result != equalPrioritization {
+		return result
+	}
+
+	if result := prioritizePrioritized(lQueue, rQueue); result != equalPrioritization {
+		return result
+	}
+	return prioritizeBasedOnCreationTime(lQueue, rQueue)
+}
+
+func getDeficitOrderResult(
+	lQueue *rs.QueueAttributes, rQueue *rs.QueueAttributes,
+	lJobInfo, rJobInfo *podgroup_info.PodGroupInfo,
+	lVictims, rVictims []*podgroup_info.PodGroupInfo,
+	subGroupOrderFn common_info.LessFn, taskOrderFn common_info.LessFn,
+	_ rs.ResourceQuantities, minNodeGPUMemory int64,
+) int {
+	lRatio := fairShareRatio(lQueue,
+		allocatedShareWithJob(lQueue, lJobInfo, lVictims, subGroupOrderFn, taskOrderFn, minNodeGPUMemory))
+	rRatio := fairShareRatio(rQueue,
+		allocatedShareWithJob(rQueue, rJobInfo, rVictims, subGroupOrderFn, taskOrderFn, minNodeGPUMemory))
+	if result := prioritizeSmallerValue(lRatio, rRatio); result != equalPrioritization {
+		return result
+	}
+
+	if result := prioritizePrioritized(lQueue, rQueue); result != equalPrioritization {
+		return result
+	}
+	return prioritizeBasedOnCreationTime(lQueue, rQueue)
+}
+
+// allocatedShareWithJob returns the resources that would be allocated to the queue after allocating the job and
+// evicting the victims
+func allocatedShareWithJob(
+	queueAttributes *rs.QueueAttributes, jobInfo *podgroup_info.PodGroupInfo,
+	victims []*podgroup_info.PodGroupInfo,
+	subGroupOrderFn common_info.LessFn, taskOrderFn common_info.LessFn, minNodeGPUMemory int64,
+) rs.ResourceQuantities {
+	allocated := queueAttributes.GetAllocatedShare()
+	allocated.Add(utils.QuantifyResource(
+		podgroup_info.GetTasksToAllocateInitResource(jobInfo, subGroupOrderFn, taskOrderFn, false, minNodeGPUMemory)))
+	for _, victim := range victims {
+		allocated.Sub(utils.QuantifyResource(victim.Allocated))
+	}
+	return allocated
+}
+
+// nextTurn is the position in the round of the queue's next turn, after the turns of the jobs it already allocated
+// in the session. Queues without a weight take their turns after all the queues with a weight.
+func nextTurn(queue *rs.QueueAttributes) float64 {
+	weight := roundRobinWeight(queue)
+	if weight <= 0 {
+		return float64(queue.AllocatedJobs+1) * noWeightPenalty
+	}
+	return float64(queue.AllocatedJobs+1) / weight
+}
+
+// roundRobinWeight is the largest over-quota weight of the queue's resources
+func roundRobinWeight(queue *rs.QueueAttributes) float64 {
+	weight := 0.0
+	for _, resource := range rs.AllResources {
+		weight = math.Max(weight, queue.ResourceShare(resource).OverQuotaWeight)
+	}
+	return weight
+}
+
+// fairShareRatio is the dominant ratio between the resources allocated to the queue and its fair share
+func fairShareRatio(queue *rs.QueueAttributes, allocated rs.ResourceQuantities) float64 {
+	ratio := 0.0
+	fairShare := queue.GetFairShare()
+	for _, resource := range rs.AllResources {
+		if allocated[resource] == 0 {
+			continue
+		}
+		if fairShare[resource] == 0 {
+			ratio = math.Max(ratio, allocated[resource]*noWeightPenalty)
+			continue
+		}
+		ratio = math.Max(ratio, allocated[resource]/fairShare[resource])
+	}
+	return ratio
+}
+
+func prioritizeSmallerValue(lValue, rValue float64) int {
+	if lValue < rValue {
+		return lQueuePrioritized
+	}
+	if lValue > rValue {
+		return rQueuePrioritized
+	}
+	return equalPrioritization
+}
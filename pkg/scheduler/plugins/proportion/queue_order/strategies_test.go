@@ -0,0 +1,172 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package queue_order
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+)
+
+func newGpuQueue(name string, priority int, deserved, fairShare, overQuotaWeight, allocated float64) *resource_share.QueueAttributes {
+	return &resource_share.QueueAttributes{
+		Name:     name,
+		Priority: priority,
+		QueueResourceShare: resource_share.QueueResourceShare{
+			GPU: resource_share.ResourceShare{
+				Deserved:        deserved,
+				FairShare:       fairShare,
+				MaxAllowed:      -1,
+				OverQuotaWeight: overQuotaWeight,
+				Allocated:       allocated,
+				Request:         fairShare,
+			},
+		},
+	}
+}
+
+// newRoundRobinQueue returns a queue that allocated the given number of jobs, the queue with the fewest jobs having
+// the most GPUs
+func newRoundRobinQueue(name string, priority int, overQuotaWeight float64, allocatedJobs int) *resource_share.QueueAttributes {
+	queue := newGpuQueue(name, priority, 0, 40, overQuotaWeight, float64(40-allocatedJobs*10))
+	queue.AllocatedJobs = allocatedJobs
+	return queue
+}
+
+func newGpuVictim(allocatedGpus float64) *podgroup_info.PodGroupInfo {
+	return &podgroup_info.PodGroupInfo{Allocated: resource_info.NewResource(0, 0, allocatedGpus)}
+}
+
+func TestGetOrderFn(t *testing.T) {
+	for _, strategy := range []Strategy{
+		ShareBasedStrategy, StrictPriorityStrategy, WeightedRoundRobinStrategy, DeficitStrategy,
+	} {
+		orderFn, err := GetOrderFn(strategy)
+		assert.NoError(t, err, strategy)
+		assert.NotNil(t, orderFn, strategy)
+	}
+
+	_, err := GetOrderFn("unknown")
+	assert.Error(t, err)
+}
+
+func TestStrategies(t *testing.T) {
+	totalResources := resource_share.ResourceQuantities{resource_share.GpuResource: 100}
+	olderQueue := newGpuQueue("older", 0, 10, 20, 1, 10)
+	olderQueue.CreationTimestamp = metav1.Unix(0, 0)
+	newerQueue := newGpuQueue("newer", 0, 10, 20, 1, 10)
+	newerQueue.CreationTimestamp = metav1.Unix(100, 0)
+
+	tests := []struct {
+		name           string
+		strategy       Strategy
+		lqueue         *resource_share.QueueAttributes
+		rqueue         *resource_share.QueueAttributes
+		lVictims       []*podgroup_info.PodGroupInfo
+		rVictims       []*podgroup_info.PodGroupInfo
+		expectedResult int
+	}{
+		{
+			name:           "share based prioritizes starved queue over higher priority",
+			strategy:       ShareBasedStrategy,
+			lqueue:         newGpuQueue("l", 1, 2, 99, 1, 20),
+			rqueue:         newGpuQueue("r", 0, 2, 2, 1, 0),
+			expectedResult: rQueuePrioritized,
+		},
+		{
+			name:           "strict priority prioritizes higher priority over starved queue",
+			strategy:       StrictPriorityStrategy,
+			lqueue:         newGpuQueue("l", 1, 2, 99, 1, 20),
+			rqueue:         newGpuQueue("r", 0, 2, 2, 1, 0),
+			expectedResult: lQueuePrioritized,
+		},
+		{
+			name:           "strict priority falls back to share based for equal priority",
+			strategy:       StrictPriorityStrategy,
+			lqueue:         newGpuQueue("l", 1, 2, 99, 1, 20),
+			rqueue:         newGpuQueue("r", 1, 2, 2, 1, 0),
+			expectedResult: rQueuePrioritized,
+		},
+		{
+			name:           "weighted round-robin prioritizes the queue whose turn comes first",
+			strategy:       WeightedRoundRobinStrategy,
+			lqueue:         newRoundRobinQueue("l", 0, 3, 2),
+			rqueue:         newRoundRobinQueue("r", 0, 1, 1),
+			expectedResult: lQueuePrioritized,
+		},
+		{
+			name:           "weighted round-robin ignores the allocated resources",
+			strategy:       WeightedRoundRobinStrategy,
+			lqueue:         newRoundRobinQueue("l", 0, 1, 0),
+			rqueue:         newRoundRobinQueue("r", 0, 1, 1),
+			expectedResult: lQueuePrioritized,
+		},
+		{
+			name:           "weighted round-robin gives queues without weight the last turns",
+			strategy:       WeightedRoundRobinStrategy,
+			lqueue:         newRoundRobinQueue("l", 1, 0, 0),
+			rqueue:         newRoundRobinQueue("r", 0, 1, 5),
+			expectedResult: rQueuePrioritized,
+		},
+		{
+			name:           "weighted round-robin prioritizes higher priority on equal turns",
+			strategy:       WeightedRoundRobinStrategy,
+			lqueue:         newRoundRobinQueue("l", 0, 2, 1),
+			rqueue:         newRoundRobinQueue("r", 1, 1, 0),
+			expectedResult: rQueuePrioritized,
+		},
+		{
+			name:           "deficit prioritizes queue furthest below fair share",
+			strategy:       DeficitStrategy,
+			lqueue:         newGpuQueue("l", 1, 0, 10, 1, 8),
+			rqueue:         newGpuQueue("r", 0, 0, 40, 1, 20),
+			expectedResult: rQueuePrioritized,
+		},
+		{
+			name:           "deficit penalizes allocation without fair share",
+			strategy:       DeficitStrategy,
+			lqueue:         newGpuQueue("l", 0, 0, 0, 1, 1),
+			rqueue:         newGpuQueue("r", 0, 0, 10, 1, 10),
+			expectedResult: rQueuePrioritized,
+		},
+		{
+			name:           "deficit accounts for the victims of the queue",
+			strategy:       DeficitStrategy,
+			lqueue:         newGpuQueue("l", 0, 0, 10, 1, 8),
+			rqueue:         newGpuQueue("r", 0, 0, 40, 1, 20),
+			lVictims:       []*podgroup_info.PodGroupInfo{newGpuVictim(4)},
+			expectedResult: lQueuePrioritized,
+		},
+		{
+			name:           "strict priority accounts for the victims of the queue",
+			strategy:       StrictPriorityStrategy,
+			lqueue:         newGpuQueue("l", 0, 0, 40, 1, 30),
+			rqueue:         newGpuQueue("r", 0, 0, 40, 1, 20),
+			lVictims:       []*podgroup_info.PodGroupInfo{newGpuVictim(15)},
+			expectedResult: lQueuePrioritized,
+		},
+		{
+			name:           "deficit prioritizes older queue on equal deficit",
+			strategy:       DeficitStrategy,
+			lqueue:         newerQueue,
+			rqueue:         olderQueue,
+			expectedResult: rQueuePrioritized,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			orderFn, err := GetOrderFn(test.strategy)
+			assert.NoError(t, err)
+			result := orderFn(test.lqueue, test.rqueue, &podgroup_info.PodGroupInfo{}, &podgroup_info.PodGroupInfo{},
+				test.lVictims, test.rVictims, nil, nil, totalResources, 0)
+			assert.Equal(t, test.expectedResult, result)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package proportion
+
+import (
+	"time"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+)
+
+const defaultMaxQuotaReservationTimeout = 5 * time.Minute
+
+// reserveQuotaForAccumulatingGangs accounts the quota of the gangs whose pods are still being created as allocated
+// to their queues, so that other jobs don't take it before the gangs are complete. A gang reserves quota from the
+// creation of its first pod until it has enough pods to be scheduled, or until its reservation timeout passes. Only
+// quota is reserved, the gang is placed on nodes once it's complete like any other gang.
+func (pp *proportionPlugin) reserveQuotaForAccumulatingGangs(ssn *framework.Session) {
+	now := ssn.Clock().Now()
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		timeout, found := pp.getQuotaReservationTimeout(job)
+		if !found || job.IsReadyForScheduling() {
+			continue
+		}
+		firstPodCreation, found := getFirstPodCreationTime(job)
+		if !found {
+			continue
+		}
+		if now.Sub(firstPodCreation) >= timeout {
+			log.InfraLogger.V(4).Infof("The quota reservation of job <%s/%s> timed out after %v without enough pods",
+				job.Namespace, job.Name, timeout)
+			continue
+		}
+
+		reserved, missing := pp.getGangQuotaReservation(ssn, job)
+		log.InfraLogger.V(4).Infof("Job <%s/%s> reserves <%s> of the quota of queue <%s> until its gang is complete",
+			job.Namespace, job.Name, reserved, job.Queue)
+		isPreemptible := job.IsPreemptibleJob()
+		for queue, ok := pp.queues[job.Queue]; ok; queue, ok = pp.queues[queue.ParentQueue] {
+			queue.AddAllocatedShare(reserved, isPreemptible)
+			for _, resource := range rs.AllResources {
+				queue.ResourceShare(resource).Request += missing[resource]
+			}
+		}
+	}
+}
+
+// getQuotaReservationTimeout returns the quota reservation timeout of the job, capped by the maximum of the plugin. A
+// job without a valid timeout doesn't reserve quota.
+func (pp *proportionPlugin) getQuotaReservationTimeout(job *podgroup_info.PodGroupInfo) (time.Duration, bool) {
+	if job.PodGroup == nil {
+		return 0, false
+	}
+	value, found := job.PodGroup.Annotations[commonconstants.QuotaReservationTimeout]
+	if !found {
+		return 0, false
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		log.InfraLogger.Warningf("Job <%s/%s> has an invalid %s annotation <%s>, not reserving quota",
+			job.Namespace, job.Name, commonconstants.QuotaReservationTimeout, value)
+		return 0, false
+	}
+	return min(timeout, pp.maxQuotaReservationTimeout), true
+}
+
+// getGangQuotaReservation returns the resources that the job reserves, the requests of the pods its pod sets need
+// to satisfy their gangs, and the part of them that belongs to pods that weren't created yet. The pods that weren't
+// created yet are assumed to request as much as the largest pod of their pod set.
+func (pp *proportionPlugin) getGangQuotaReservation(ssn *framework.Session, job *podgroup_info.PodGroupInfo) (
+	rs.ResourceQuantities, rs.ResourceQuantities) {
+	reserved := rs.EmptyResourceQuantities()
+	missing := rs.EmptyResourceQuantities()
+	for _, podSet := range job.PodSets {
+		if podSet.IsReadyForScheduling() {
+			continue
+		}
+		podSetTasks := podSet.GetPodInfos()
+		if len(podSetTasks) == 0 {
+			podSetTasks = job.GetAllPodsMap()
+		}
+		largestPod := rs.EmptyResourceQuantities()
+		for _, task := range podSetTasks {
+			for resource, quantity := range pp.getPendingTaskResources(ssn, task) {
+				largestPod[resource] = max(largestPod[resource], quantity)
+			}
+		}
+
+		reservedPods := int(podSet.GetMinAvailable()) - podSet.GetNumActiveAllocatedTasks()
+		missingPods := reservedPods - podSet.GetNumPendingTasks()
+		for _, resource := range rs.AllResources {
+			reserved[resource] += largestPod[resource] * float64(max(reservedPods, 0))
+			missing[resource] += largestPod[resource] * float64(max(missingPods, 0))
+		}
+	}
+	return reserved, missing
+}
+
+// getFirstPodCreationTime returns the creation time of the first pod of the job
+func getFirstPodCreationTime(job *podgroup_info.PodGroupInfo) (time.Time, bool) {
+	var first time.Time
+	found := false
+	for _, task := range job.GetAllPodsMap() {
+		if task.Pod == nil {
+			continue
+		}
+		created := task.Pod.CreationTimestamp.Time
+		if !found || created.Before(first) {
+			first = created
+			found = true
+		}
+	}
+	return first, found
+}
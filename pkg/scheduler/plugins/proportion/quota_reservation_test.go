@@ -0,0 +1,102 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package proportion
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+)
+
+var _ = Describe("Quota reservation", func() {
+	now := time.Now()
+
+	newPlugin := func() *proportionPlugin {
+		return &proportionPlugin{
+			maxQuotaReservationTimeout: defaultMaxQuotaReservationTimeout,
+			queues: map[common_info.QueueID]*rs.QueueAttributes{
+				"department": {UID: "department", Name: "department"},
+				"queue-a":    {UID: "queue-a", Name: "queue-a", ParentQueue: "department"},
+			},
+		}
+	}
+	// newJob returns a gang of minMember pods of a GPU each, of which the given number of pods were created, the
+	// first of them at the given age
+	newJob := func(minMember int32, pods int, firstPodAge time.Duration, annotations map[string]string,
+	) *podgroup_info.PodGroupInfo {
+		job := podgroup_info.NewPodGroupInfo("job")
+		job.SetPodGroup(&v2alpha2.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "ns", Annotations: annotations},
+			Spec:       v2alpha2.PodGroupSpec{Queue: "queue-a", MinMember: minMember},
+		})
+		for i := range pods {
+			job.AddTaskInfo(&pod_info.PodInfo{
+				UID:    common_info.PodID(fmt.Sprintf("pod-%d", i)),
+				Job:    "job",
+				Status: pod_status.Pending,
+				ResReq: resource_info.NewResourceRequirementsWithGpus(1),
+				Pod: &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.NewTime(now.Add(-firstPodAge + time.Duration(i)*time.Second)),
+				}},
+			})
+		}
+		return job
+	}
+	newSession := func(job *podgroup_info.PodGroupInfo) *framework.Session {
+		return &framework.Session{
+			ClusterInfo: &api.ClusterInfo{
+				PodGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{job.UID: job},
+			},
+			SchedulerParams: conf.SchedulerParams{Clock: clocktesting.NewFakePassiveClock(now)},
+		}
+	}
+	withTimeout := func(timeout string) map[string]string {
+		return map[string]string{commonconstants.QuotaReservationTimeout: timeout}
+	}
+
+	It("should reserve the quota of the whole gang while its pods are created", func() {
+		plugin := newPlugin()
+		plugin.reserveQuotaForAccumulatingGangs(newSession(newJob(4, 1, 10*time.Second, withTimeout("1m"))))
+
+		for _, queue := range plugin.queues {
+			Expect(queue.GPU.Allocated).To(Equal(4.0), queue.Name)
+			Expect(queue.GPU.Request).To(Equal(3.0), queue.Name)
+		}
+	})
+
+	DescribeTable("should not reserve quota",
+		func(job *podgroup_info.PodGroupInfo) {
+			plugin := newPlugin()
+			plugin.reserveQuotaForAccumulatingGangs(newSession(job))
+
+			for _, queue := range plugin.queues {
+				Expect(queue.GPU.Allocated).To(BeZero(), queue.Name)
+				Expect(queue.GPU.Request).To(BeZero(), queue.Name)
+			}
+		},
+		Entry("for a job without a reservation timeout", newJob(4, 1, 10*time.Second, nil)),
+		Entry("for a job with an invalid reservation timeout", newJob(4, 1, 10*time.Second, withTimeout("soon"))),
+		Entry("for a complete gang", newJob(4, 4, 10*time.Second, withTimeout("1m"))),
+		Entry("for a gang without pods", newJob(4, 0, 0, withTimeout("1m"))),
+		Entry("after the reservation timed out", newJob(4, 1, 2*time.Minute, withTimeout("1m"))),
+		Entry("after the maximum reservation timeout", newJob(4, 1, 10*time.Minute, withTimeout("1h"))),
+	)
+})
@@ -33,9 +33,7 @@ func (r *Reclaimable) CanReclaimResources(
 	reclaimerQueue := queues[reclaimer.Queue]
 	requestedResources := utils.QuantifyResource(reclaimer.RequiredResources)
 
-	allocatedResources := reclaimerQueue.GetAllocatedShare()
-	allocatedResources.Add(requestedResources)
-	if !allocatedResources.LessEqual(reclaimerQueue.GetFairShare()) {
+	if !fitsEntitlementDelta(requestedResources, reclaimerQueue.GetEntitlementDelta()) {
 		return false
 	}
 
@@ -52,6 +50,17 @@ func (r *Reclaimable) CanReclaimResources(
 	return true
 }
 
+// fitsEntitlementDelta returns true if the requested resources fit in the resources the queue can still be allocated
+// within its fair share
+func fitsEntitlementDelta(requested, entitlementDelta rs.ResourceQuantities) bool {
+	for _, resource := range rs.AllResources {
+		if requested[resource] > entitlementDelta[resource] {
+			return false
+		}
+	}
+	return true
+}
+
 func (r *Reclaimable) Reclaimable(
 	queues map[common_info.QueueID]*rs.QueueAttributes,
 	reclaimer *ReclaimerInfo,
@@ -21,6 +21,12 @@ func FitsReclaimStrategy(
 	reclaimeeQueue *rs.QueueAttributes,
 	reclaimeeRemainingShare rs.ResourceQuantities,
 ) bool {
+	// A queue that exceeds its deserved quota within its burst allowance is not reclaimed
+	if reclaimeeQueue.IsWithinBurst(reclaimeeRemainingShare) {
+		log.InfraLogger.V(6).Infof("Queue <%s> is within its burst allowance of <%v> GPUs over its deserved quota, "+
+			"reclaimeeRemainingShare: <%s>", reclaimeeQueue.Name, reclaimeeQueue.BurstGPUs, reclaimeeRemainingShare)
+		return false
+	}
 	for _, strategy := range strategies {
 		if strategy.Reclaimable(
 			reclaimerResources, reclaimerQueue, reclaimeeQueue,
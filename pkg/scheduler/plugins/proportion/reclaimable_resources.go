@@ -0,0 +1,112 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package proportion
+
+import (
+	"math"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+)
+
+// reportReclaimableResources reports, in the queue metrics and the queue status, the resources that every queue
+// could get right now by reclaiming resources that other queues use over their allocatable share. The status is only
+// updated when the reclaimable resources of the node pool changed.
+func (pp *proportionPlugin) reportReclaimableResources(ssn *framework.Session) {
+	nodePool := ssn.NodePoolName()
+	if nodePool == "" {
+		nodePool = commonconstants.DefaultNodePoolName
+	}
+
+	metrics.ResetQueueReclaimable()
+	for queueID, queue := range pp.queues {
+		reclaimable := pp.getReclaimableResources(queue)
+		metrics.UpdateQueueReclaimable(queue.Name,
+			reclaimable[rs.CpuResource]/resource_info.MilliCPUToCores,
+			reclaimable[rs.MemoryResource]/resource_info.MemoryToGB,
+			reclaimable[rs.GpuResource],
+		)
+
+		if ssn.Cache == nil {
+			continue
+		}
+		resourceList := reclaimableResourceList(reclaimable)
+		if queueInfo, found := ssn.ClusterInfo.Queues[queueID]; found &&
+			resourceListsEqual(queueInfo.ReportedReclaimable[nodePool], resourceList) {
+			continue
+		}
+		ssn.Cache.UpdateQueueReclaimable(string(queueID), nodePool, resourceList)
+	}
+}
+
+// getReclaimableResources returns the resources that the queue could get by reclaiming resources from other queues.
+// The queue, and each of its parent queues, can reclaim the resources that their sibling queues use over their
+// allocatable share, and only up to its own fair share.
+func (pp *proportionPlugin) getReclaimableResources(queue *rs.QueueAttributes) rs.ResourceQuantities {
+	headroom := rs.NewResourceQuantities(math.Inf(1), math.Inf(1), math.Inf(1))
+	overAllocatable := rs.EmptyResourceQuantities()
+	for current, ok := pp.queues[queue.UID]; ok; current, ok = pp.queues[current.ParentQueue] {
+		for _, resource := range rs.AllResources {
+			share := current.ResourceShare(resource)
+			headroom[resource] = math.Min(headroom[resource], math.Max(share.FairShare-share.Allocated, 0))
+		}
+		for _, sibling := range pp.getSiblingQueues(current) {
+			for _, resource := range rs.AllResources {
+				overAllocatable[resource] += getReclaimableOverAllocatable(sibling, resource)
+			}
+		}
+	}
+
+	reclaimable := rs.EmptyResourceQuantities()
+	for _, resource := range rs.AllResources {
+		reclaimable[resource] = math.Min(headroom[resource], overAllocatable[resource])
+	}
+	return reclaimable
+}
+
+// getReclaimableOverAllocatable returns the preemptible allocation of a queue over its allocatable share, and over
+// the GPUs protected by its burst allowance
+func getReclaimableOverAllocatable(queue *rs.QueueAttributes, resource rs.ResourceName) float64 {
+	share := queue.ResourceShare(resource)
+	allocatable := share.GetAllocatableShare()
+	if allocatable == commonconstants.UnlimitedResourceQuantity {
+		return 0
+	}
+	if resource == rs.GpuResource && queue.BurstGPUs > 0 {
+		allocatable = math.Max(allocatable, share.Deserved+queue.BurstGPUs)
+	}
+	overAllocatable := math.Max(share.Allocated-allocatable, 0)
+	return math.Min(overAllocatable, math.Max(share.Allocated-share.AllocatedNotPreemptible, 0))
+}
+
+// reclaimableResourceList converts reclaimable resources to a resource list, with memory rounded down to megabytes
+// to avoid updating the queue status on insignificant changes
+func reclaimableResourceList(reclaimable rs.ResourceQuantities) v1.ResourceList {
+	memory := math.Floor(reclaimable[rs.MemoryResource]/mebibytes) * mebibytes
+	return v1.ResourceList{
+		v1.ResourceCPU:    *resource.NewMilliQuantity(int64(reclaimable[rs.CpuResource]), resource.DecimalSI),
+		v1.ResourceMemory: *resource.NewQuantity(int64(memory), resource.DecimalSI),
+		commonconstants.GpuResource: *resource.NewMilliQuantity(int64(reclaimable[rs.GpuResource]*1000),
+			resource.DecimalSI),
+	}
+}
+
+func resourceListsEqual(left, right v1.ResourceList) bool {
+	if len(left) != len(right) {
+		return false
+	}
+	for name, quantity := range left {
+		other, found := right[name]
+		if !found || quantity.Cmp(other) != 0 {
+			return false
+		}
+	}
+	return true
+}
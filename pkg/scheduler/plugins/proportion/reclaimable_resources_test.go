@@ -0,0 +1,105 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package proportion
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	rs "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/proportion/resource_share"
+)
+
+var _ = Describe("Reclaimable resources", func() {
+	newQueue := func(
+		uid common_info.QueueID, parent common_info.QueueID, children []common_info.QueueID,
+		deserved, fairShare, allocated, allocatedNotPreemptible float64,
+	) *rs.QueueAttributes {
+		return &rs.QueueAttributes{
+			UID:         uid,
+			Name:        string(uid),
+			ParentQueue: parent,
+			ChildQueues: children,
+			QueueResourceShare: rs.QueueResourceShare{
+				GPU: rs.ResourceShare{
+					Deserved:                deserved,
+					FairShare:               fairShare,
+					MaxAllowed:              commonconstants.UnlimitedResourceQuantity,
+					Allocated:               allocated,
+					AllocatedNotPreemptible: allocatedNotPreemptible,
+				},
+			},
+		}
+	}
+	newPlugin := func(queues ...*rs.QueueAttributes) *proportionPlugin {
+		plugin := &proportionPlugin{queues: map[common_info.QueueID]*rs.QueueAttributes{}}
+		for _, queue := range queues {
+			plugin.queues[queue.UID] = queue
+		}
+		return plugin
+	}
+
+	Context("getReclaimableResources", func() {
+		It("should reclaim the usage of sibling queues over their allocatable share", func() {
+			plugin := newPlugin(
+				newQueue("queue-a", "", nil, 4, 6, 1, 0),
+				newQueue("queue-b", "", nil, 4, 4, 7, 0),
+			)
+			reclaimable := plugin.getReclaimableResources(plugin.queues["queue-a"])
+			Expect(reclaimable[rs.GpuResource]).To(Equal(3.0))
+		})
+
+		It("should not reclaim more than the fair share of the queue", func() {
+			plugin := newPlugin(
+				newQueue("queue-a", "", nil, 4, 4, 3, 0),
+				newQueue("queue-b", "", nil, 4, 4, 9, 0),
+			)
+			reclaimable := plugin.getReclaimableResources(plugin.queues["queue-a"])
+			Expect(reclaimable[rs.GpuResource]).To(Equal(1.0))
+		})
+
+		It("should not reclaim non preemptible usage", func() {
+			plugin := newPlugin(
+				newQueue("queue-a", "", nil, 4, 6, 0, 0),
+				newQueue("queue-b", "", nil, 4, 4, 7, 5),
+			)
+			reclaimable := plugin.getReclaimableResources(plugin.queues["queue-a"])
+			Expect(reclaimable[rs.GpuResource]).To(Equal(2.0))
+		})
+
+		It("should not reclaim from queues with an unlimited allocatable share", func() {
+			plugin := newPlugin(
+				newQueue("queue-a", "", nil, 4, 6, 0, 0),
+				newQueue("queue-b", "", nil, commonconstants.UnlimitedResourceQuantity, 4, 7, 0),
+			)
+			reclaimable := plugin.getReclaimableResources(plugin.queues["queue-a"])
+			Expect(reclaimable[rs.GpuResource]).To(Equal(0.0))
+		})
+
+		It("should reclaim from the siblings of parent queues, up to the fair share of the parent queue", func() {
+			plugin := newPlugin(
+				newQueue("department-a", "", []common_info.QueueID{"queue-a1", "queue-a2"}, 4, 5, 2, 0),
+				newQueue("queue-a1", "department-a", nil, 2, 4, 0, 0),
+				newQueue("queue-a2", "department-a", nil, 2, 1, 2, 0),
+				newQueue("department-b", "", nil, 4, 4, 8, 0),
+			)
+			reclaimable := plugin.getReclaimableResources(plugin.queues["queue-a1"])
+			Expect(reclaimable[rs.GpuResource]).To(Equal(3.0))
+		})
+	})
+
+	Context("reclaimableResourceList", func() {
+		It("should convert the reclaimable resources and round memory down to megabytes", func() {
+			resourceList := reclaimableResourceList(rs.NewResourceQuantities(1500, 2500000123, 1.5))
+			Expect(resourceListsEqual(resourceList, v1.ResourceList{
+				v1.ResourceCPU:              resource.MustParse("1500m"),
+				v1.ResourceMemory:           resource.MustParse("2500M"),
+				commonconstants.GpuResource: resource.MustParse("1500m"),
+			})).To(BeTrue())
+		})
+	})
+})
@@ -353,5 +353,15 @@ var _ = Describe("QueueAttributes", func() {
 			Expect(orig.CreationTimestamp).To(Equal(clone.CreationTimestamp))
 			Expect(orig.QueueResourceShare).To(Equal(clone.QueueResourceShare))
 		})
+
+		It("does not share the entitlement delta with the clone", func() {
+			orig := &QueueAttributes{QueueResourceShare: *createQueueResourceShare()}
+			Expect(orig.GetEntitlementDelta()[GpuResource]).To(Equal(float64(-3)))
+			clone := orig.Clone()
+
+			clone.AddAllocatedShare(ResourceQuantities{GpuResource: 2}, true)
+			Expect(clone.GetEntitlementDelta()[GpuResource]).To(Equal(float64(-5)))
+			Expect(orig.GetEntitlementDelta()[GpuResource]).To(Equal(float64(-3)))
+		})
 	})
 })
@@ -26,21 +26,107 @@ type QueueAttributes struct {
 	ChildQueues       []common_info.QueueID
 	CreationTimestamp metav1.Time
 	Priority          int
+	// PriorityQuotaCaps maps a priority class name to the maximal fraction of the deserved quota it can be allocated
+	PriorityQuotaCaps map[string]float64
+	// AllocatedByPriorityClass tracks allocations only for priority classes that have a quota cap
+	AllocatedByPriorityClass map[string]ResourceQuantities
+	// LoanPaybackMultiplier multiplies the over-quota weight of the queue while it has outstanding loans.
+	// Zero when loan payback is disabled for the queue.
+	LoanPaybackMultiplier float64
+	// BurstGPUs is the number of GPUs over the deserved quota that are protected from reclaim while the burst
+	// allowance of the queue lasts. Zero when the queue has no burst allowance left.
+	BurstGPUs float64
+	// BudgetExhausted is true when the queue consumed its GPU hours budget for the current period
+	BudgetExhausted bool
+	// AllocatedJobs is the number of jobs of the queue and its child queues that were allocated in the session, which
+	// are the turns the queue took in weighted round-robin queue ordering
+	AllocatedJobs int
 	QueueResourceShare
 }
 
 func (q *QueueAttributes) Clone() *QueueAttributes {
 	return &QueueAttributes{
-		UID:                q.UID,
-		Name:               q.Name,
-		ParentQueue:        q.ParentQueue,
-		ChildQueues:        slices.Clone(q.ChildQueues),
-		CreationTimestamp:  q.CreationTimestamp,
-		Priority:           q.Priority,
-		QueueResourceShare: q.QueueResourceShare,
+		UID:                      q.UID,
+		Name:                     q.Name,
+		ParentQueue:              q.ParentQueue,
+		ChildQueues:              slices.Clone(q.ChildQueues),
+		CreationTimestamp:        q.CreationTimestamp,
+		Priority:                 q.Priority,
+		PriorityQuotaCaps:        q.PriorityQuotaCaps,
+		AllocatedByPriorityClass: cloneAllocatedByPriorityClass(q.AllocatedByPriorityClass),
+		LoanPaybackMultiplier:    q.LoanPaybackMultiplier,
+		BurstGPUs:                q.BurstGPUs,
+		BudgetExhausted:          q.BudgetExhausted,
+		AllocatedJobs:            q.AllocatedJobs,
+		QueueResourceShare:       q.QueueResourceShare.clone(),
 	}
 }
 
+func cloneAllocatedByPriorityClass(allocated map[string]ResourceQuantities) map[string]ResourceQuantities {
+	if allocated == nil {
+		return nil
+	}
+	cloned := make(map[string]ResourceQuantities, len(allocated))
+	for priorityClassName, quantities := range allocated {
+		cloned[priorityClassName] = quantities.Clone()
+	}
+	return cloned
+}
+
+// GetPriorityQuotaCap returns the maximal fraction of the deserved quota for the priority class, if one is set.
+func (q *QueueAttributes) GetPriorityQuotaCap(priorityClassName string) (float64, bool) {
+	quotaCap, found := q.PriorityQuotaCaps[priorityClassName]
+	return quotaCap, found
+}
+
+// AddPriorityClassAllocation records allocated resources for priority classes that have a quota cap.
+func (q *QueueAttributes) AddPriorityClassAllocation(priorityClassName string, quantities ResourceQuantities) {
+	if allocated := q.priorityClassAllocated(priorityClassName); allocated != nil {
+		allocated.Add(quantities)
+	}
+}
+
+// RemovePriorityClassAllocation releases resources recorded by AddPriorityClassAllocation.
+func (q *QueueAttributes) RemovePriorityClassAllocation(priorityClassName string, quantities ResourceQuantities) {
+	if allocated := q.priorityClassAllocated(priorityClassName); allocated != nil {
+		allocated.Sub(quantities)
+	}
+}
+
+func (q *QueueAttributes) priorityClassAllocated(priorityClassName string) ResourceQuantities {
+	if _, found := q.PriorityQuotaCaps[priorityClassName]; !found {
+		return nil
+	}
+	if q.AllocatedByPriorityClass == nil {
+		q.AllocatedByPriorityClass = map[string]ResourceQuantities{}
+	}
+	allocated, found := q.AllocatedByPriorityClass[priorityClassName]
+	if !found {
+		allocated = EmptyResourceQuantities()
+		q.AllocatedByPriorityClass[priorityClassName] = allocated
+	}
+	return allocated
+}
+
+// GetPriorityClassAllocated returns the resources allocated to workloads of the priority class.
+func (q *QueueAttributes) GetPriorityClassAllocated(priorityClassName string) ResourceQuantities {
+	if allocated, found := q.AllocatedByPriorityClass[priorityClassName]; found {
+		return allocated
+	}
+	return EmptyResourceQuantities()
+}
+
+// IsWithinBurst returns true if the allocation is within the deserved quota of the queue, exceeding it by no more
+// than the burst GPUs
+func (q *QueueAttributes) IsWithinBurst(allocated ResourceQuantities) bool {
+	if q.BurstGPUs <= 0 || q.GPU.Deserved == commonconstants.UnlimitedResourceQuantity {
+		return false
+	}
+	burstShare := q.GetDeservedShare().Clone()
+	burstShare[GpuResource] += q.BurstGPUs
+	return allocated.LessEqual(burstShare)
+}
+
 func (q *QueueAttributes) IsTopQueue() bool {
 	return q.ParentQueue == ""
 }
@@ -53,9 +139,19 @@ type QueueResourceShare struct {
 	// cache
 	lastDeservedShare ResourceQuantities
 	lastFairShare     ResourceQuantities
+	// entitlementDelta is updated in place when the allocation changes, so unlike the other caches it is not shared
+	// between clones
+	entitlementDelta ResourceQuantities
 }
 type resourceShareMapFunc func(rs *ResourceShare) float64
 
+func (qrs QueueResourceShare) clone() QueueResourceShare {
+	if qrs.entitlementDelta != nil {
+		qrs.entitlementDelta = qrs.entitlementDelta.Clone()
+	}
+	return qrs
+}
+
 func (qrs *QueueResourceShare) ResourceShare(resource ResourceName) *ResourceShare {
 	switch resource {
 	case CpuResource:
@@ -92,6 +188,22 @@ func (qrs *QueueResourceShare) GetAllocatedShare() ResourceQuantities {
 	return qrs.buildResourceQuantities(f)
 }
 
+// GetEntitlementDelta returns the resources the queue can still be allocated within its fair share. Resources with
+// an unlimited fair share have an infinite delta. The delta is updated incrementally when the allocation of the queue
+// changes and recomputed after its fair share changes, so the returned quantities must not be modified.
+func (qrs *QueueResourceShare) GetEntitlementDelta() ResourceQuantities {
+	if qrs.entitlementDelta == nil {
+		f := func(rs *ResourceShare) float64 {
+			if rs.FairShare == commonconstants.UnlimitedResourceQuantity {
+				return math.Inf(1)
+			}
+			return rs.FairShare - rs.Allocated
+		}
+		qrs.entitlementDelta = qrs.buildResourceQuantities(f)
+	}
+	return qrs.entitlementDelta
+}
+
 func (qrs *QueueResourceShare) GetAllocatedNonPreemptible() ResourceQuantities {
 	f := func(rs *ResourceShare) float64 {
 		return rs.AllocatedNotPreemptible
@@ -171,6 +283,47 @@ func (qrs *QueueResourceShare) AddResourceShare(resource ResourceName, amount fl
 
 	// invalidate fairshare cache
 	qrs.lastFairShare = nil
+	qrs.entitlementDelta = nil
+}
+
+// RestoreFairShare sets the fair share of the queue and its entitlement delta, as computed in an earlier session
+// from the same quotas and usage. The entitlement delta is then updated in place, so it must not be shared.
+func (qrs *QueueResourceShare) RestoreFairShare(fairShare, entitlementDelta ResourceQuantities) {
+	for _, resource := range AllResources {
+		qrs.ResourceShare(resource).FairShare = fairShare[resource]
+	}
+	qrs.lastFairShare = nil
+	qrs.entitlementDelta = entitlementDelta
+}
+
+// AddAllocatedShare adds the quantities to the allocated share of the queue, and to its non-preemptible allocated
+// share for non-preemptible allocations
+func (qrs *QueueResourceShare) AddAllocatedShare(quantities ResourceQuantities, preemptible bool) {
+	for _, resource := range AllResources {
+		resourceShare := qrs.ResourceShare(resource)
+		resourceShare.Allocated += quantities[resource]
+		if !preemptible {
+			resourceShare.AllocatedNotPreemptible += quantities[resource]
+		}
+		if qrs.entitlementDelta != nil {
+			qrs.entitlementDelta[resource] -= quantities[resource]
+		}
+	}
+}
+
+// SubAllocatedShare subtracts the quantities from the allocated share of the queue, and from its non-preemptible
+// allocated share for non-preemptible allocations
+func (qrs *QueueResourceShare) SubAllocatedShare(quantities ResourceQuantities, preemptible bool) {
+	for _, resource := range AllResources {
+		resourceShare := qrs.ResourceShare(resource)
+		resourceShare.Allocated -= quantities[resource]
+		if !preemptible {
+			resourceShare.AllocatedNotPreemptible -= quantities[resource]
+		}
+		if qrs.entitlementDelta != nil {
+			qrs.entitlementDelta[resource] += quantities[resource]
+		}
+	}
 }
 
 func (qrs *QueueResourceShare) SetQuotaResources(resource ResourceName, deserved float64, maxAllowed float64,
@@ -4,9 +4,12 @@
 package resource_share
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
 )
 
 func TestQueueResourceShare_ResourceShare(t *testing.T) {
@@ -118,6 +121,31 @@ func TestQueueResourceShare_SetQuotaResources(t *testing.T) {
 	assert.Equal(t, 1.1, qrs.CPU.OverQuotaWeight)
 }
 
+func TestQueueResourceShare_GetEntitlementDelta(t *testing.T) {
+	qrs := createQueueResourceShare()
+	qrs.CPU.FairShare = commonconstants.UnlimitedResourceQuantity
+	delta := qrs.GetEntitlementDelta()
+	assert.Equal(t, math.Inf(1), delta[CpuResource])
+	assert.Equal(t, float64(-3), delta[MemoryResource])
+	assert.Equal(t, float64(-3), delta[GpuResource])
+}
+
+func TestQueueResourceShare_GetEntitlementDelta_UpdatedOnChange(t *testing.T) {
+	qrs := createQueueResourceShare()
+	assert.Equal(t, float64(-3), qrs.GetEntitlementDelta()[GpuResource])
+
+	qrs.AddResourceShare(GpuResource, 10)
+	assert.Equal(t, float64(7), qrs.GetEntitlementDelta()[GpuResource])
+
+	qrs.AddAllocatedShare(ResourceQuantities{GpuResource: 4}, true)
+	assert.Equal(t, float64(3), qrs.GetEntitlementDelta()[GpuResource])
+	assert.Equal(t, float64(20), qrs.GPU.AllocatedNotPreemptible)
+
+	qrs.SubAllocatedShare(ResourceQuantities{GpuResource: 2}, false)
+	assert.Equal(t, float64(5), qrs.GetEntitlementDelta()[GpuResource])
+	assert.Equal(t, float64(18), qrs.GPU.AllocatedNotPreemptible)
+}
+
 func createQueueResourceShare() *QueueResourceShare {
 	return &QueueResourceShare{
 		CPU: ResourceShare{
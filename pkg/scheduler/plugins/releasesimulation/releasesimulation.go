@@ -0,0 +1,149 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package releasesimulation
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const (
+	pluginName = "releasesimulation"
+	// requestTimeout bounds the time a request waits for the end of the current scheduling cycle
+	requestTimeout = time.Minute
+)
+
+type simulationResult struct {
+	simulation *ReleaseSimulation
+	err        error
+}
+
+type simulationRequest struct {
+	namespace string
+	name      string
+	result    chan simulationResult
+}
+
+// requestQueue passes the requests of the http handler to the scheduling session. The session can't be accessed
+// while the actions run, so requests are answered once all actions of the session were executed. The queue is kept in
+// the plugin state of the scheduler, so that requests pushed during a session are answered by the next one.
+type requestQueue struct {
+	mutex   sync.Mutex
+	pending []*simulationRequest
+}
+
+func newRequestQueue() *requestQueue {
+	return &requestQueue{}
+}
+
+func (q *requestQueue) push(request *simulationRequest) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.pending = append(q.pending, request)
+}
+
+func (q *requestQueue) remove(request *simulationRequest) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	for i, pending := range q.pending {
+		if pending == request {
+			q.pending = append(q.pending[:i], q.pending[i+1:]...)
+			return
+		}
+	}
+}
+
+func (q *requestQueue) popAll() []*simulationRequest {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	pending := q.pending
+	q.pending = nil
+	return pending
+}
+
+type releaseSimulationPlugin struct {
+	requests *requestQueue
+}
+
+func New(_ framework.PluginArguments) framework.Plugin {
+	return &releaseSimulationPlugin{}
+}
+
+func (rsp *releaseSimulationPlugin) Name() string {
+	return pluginName
+}
+
+func (rsp *releaseSimulationPlugin) OnSessionOpen(ssn *framework.Session) {
+	// Simulation requests are answered by the primary session
+	if ssn.IsShadow() {
+		return
+	}
+	rsp.requests = ssn.PluginState(pluginName, func() any { return newRequestQueue() }).(*requestQueue)
+	ssn.AddPostActionsFn(func() { rsp.simulatePendingRequests(ssn) })
+	ssn.AddHttpHandler("/simulate-release", rsp.serveSimulation)
+}
+
+func (rsp *releaseSimulationPlugin) OnSessionClose(_ *framework.Session) {}
+
+func (rsp *releaseSimulationPlugin) simulatePendingRequests(ssn *framework.Session) {
+	for _, request := range rsp.requests.popAll() {
+		simulation, err := SimulateRelease(ssn, request.namespace, request.name)
+		if err != nil {
+			log.InfraLogger.V(3).Infof("Failed to simulate the release of job <%s/%s>: %v",
+				request.namespace, request.name, err)
+		}
+		request.result <- simulationResult{simulation: simulation, err: err}
+	}
+}
+
+func (rsp *releaseSimulationPlugin) serveSimulation(w http.ResponseWriter, r *http.Request) {
+	namespace := r.URL.Query().Get("namespace")
+	name := r.URL.Query().Get("name")
+	if namespace == "" || name == "" {
+		http.Error(w, "namespace and name query parameters are required", http.StatusBadRequest)
+		return
+	}
+
+	request := &simulationRequest{namespace: namespace, name: name, result: make(chan simulationResult, 1)}
+	rsp.requests.push(request)
+	timer := time.NewTimer(requestTimeout)
+	defer timer.Stop()
+
+	var result simulationResult
+	select {
+	case result = <-request.result:
+	case <-timer.C:
+		rsp.requests.remove(request)
+		http.Error(w, "Timed out waiting for the scheduling cycle", http.StatusServiceUnavailable)
+		return
+	case <-r.Context().Done():
+		rsp.requests.remove(request)
+		return
+	}
+
+	switch {
+	case errors.Is(result.err, ErrJobNotFound):
+		http.Error(w, result.err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(result.err, ErrJobNotRunning):
+		http.Error(w, result.err.Error(), http.StatusConflict)
+		return
+	case result.err != nil:
+		http.Error(w, result.err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(result.simulation); err != nil {
+		http.Error(w, "Failed to encode release simulation", http.StatusInternalServerError)
+	}
+}
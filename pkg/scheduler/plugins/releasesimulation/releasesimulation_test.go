@@ -0,0 +1,111 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package releasesimulation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "go.uber.org/mock/gomock"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/releasesimulation"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/jobs_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/nodes_fake"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/test_utils/tasks_fake"
+)
+
+func newTestTopology() test_utils.TestTopologyBasic {
+	return test_utils.TestTopologyBasic{
+		Name: "release simulation",
+		Jobs: []*jobs_fake.TestJobBasic{
+			{
+				Name:                "running_job",
+				Namespace:           "test",
+				RequiredGPUsPerTask: 2,
+				Priority:            constants.PriorityTrainNumber,
+				QueueName:           "queue0",
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{NodeName: "node0", State: pod_status.Running},
+				},
+			},
+			{
+				Name:                "fitting_job",
+				Namespace:           "test",
+				RequiredGPUsPerTask: 1,
+				Priority:            constants.PriorityTrainNumber,
+				QueueName:           "queue1",
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Pending},
+					{State: pod_status.Pending},
+				},
+			},
+			{
+				Name:                "too_large_job",
+				Namespace:           "test",
+				RequiredGPUsPerTask: 4,
+				Priority:            constants.PriorityTrainNumber,
+				QueueName:           "queue1",
+				Tasks: []*tasks_fake.TestTaskBasic{
+					{State: pod_status.Pending},
+				},
+			},
+		},
+		Nodes: map[string]nodes_fake.TestNodeBasic{
+			"node0": {GPUs: 2},
+		},
+		Queues: []test_utils.TestQueueBasic{
+			{Name: "queue0", DeservedGPUs: 1},
+			{Name: "queue1", DeservedGPUs: 1},
+		},
+	}
+}
+
+func TestSimulateRelease(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+	ssn := test_utils.BuildSession(newTestTopology(), controller)
+
+	simulation, err := releasesimulation.SimulateRelease(ssn, "test", "running_job")
+	require.NoError(t, err)
+
+	assert.Equal(t, 2.0, simulation.Released.GPUs)
+	assert.Equal(t, 2.0, simulation.ReleasedByNode["node0"].GPUs)
+	assert.Equal(t, 2.0, simulation.ReleasedByQueue["queue0"].GPUs)
+	assert.Equal(t, "default", simulation.NodePool)
+	var schedulable []string
+	for _, job := range simulation.SchedulableJobs {
+		schedulable = append(schedulable, job.Name)
+	}
+	assert.Equal(t, []string{"fitting_job"}, schedulable)
+
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		for _, task := range job.GetAllPodsMap() {
+			if job.Name == "running_job" {
+				assert.Equal(t, pod_status.Running, task.Status)
+			} else {
+				assert.Equal(t, pod_status.Pending, task.Status)
+			}
+		}
+		assert.Empty(t, job.JobFitErrors)
+		assert.Empty(t, job.TasksFitErrors)
+	}
+	assert.Equal(t, 0.0, ssn.ClusterInfo.Nodes["node0"].Releasing.GPUs())
+}
+
+func TestSimulateReleaseErrors(t *testing.T) {
+	test_utils.InitTestingInfrastructure()
+	controller := NewController(t)
+	defer controller.Finish()
+	ssn := test_utils.BuildSession(newTestTopology(), controller)
+
+	_, err := releasesimulation.SimulateRelease(ssn, "test", "missing_job")
+	assert.ErrorIs(t, err, releasesimulation.ErrJobNotFound)
+	_, err = releasesimulation.SimulateRelease(ssn, "test", "fitting_job")
+	assert.ErrorIs(t, err, releasesimulation.ErrJobNotRunning)
+}
@@ -0,0 +1,208 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package releasesimulation
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/common"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions/utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const simulationAction = "release-simulation"
+
+var (
+	ErrJobNotFound   = errors.New("job not found")
+	ErrJobNotRunning = errors.New("job has no allocated pods")
+)
+
+type Resources struct {
+	GPUs     float64 `json:"gpus"`
+	MilliCPU float64 `json:"milli_cpu"`
+	Memory   float64 `json:"memory"`
+}
+
+type SchedulableJob struct {
+	ID        common_info.PodGroupID `json:"id"`
+	Namespace string                 `json:"namespace"`
+	Name      string                 `json:"name"`
+	Queue     common_info.QueueID    `json:"queue"`
+}
+
+// ReleaseSimulation is the result of simulating the release of a running job's resources
+type ReleaseSimulation struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	NodePool  string `json:"node_pool"`
+	// Released are the resources that the job's pods would release
+	Released Resources `json:"released"`
+	// ReleasedByNode are the released resources of each node the job runs on
+	ReleasedByNode map[string]Resources `json:"released_by_node"`
+	// ReleasedByQueue are the resources that would be released from the allocation of the job's queue and its ancestors
+	ReleasedByQueue map[common_info.QueueID]Resources `json:"released_by_queue"`
+	// SchedulableJobs are the pending jobs that would be scheduled once the job is released, in their scheduling order
+	SchedulableJobs []SchedulableJob `json:"schedulable_jobs"`
+}
+
+// SimulateRelease evicts the pods of the job in a statement, and pipelines the pending jobs in their scheduling order
+// on the released resources. The statement is discarded, so the session is left as it was.
+func SimulateRelease(ssn *framework.Session, namespace, name string) (*ReleaseSimulation, error) {
+	job := findJob(ssn, namespace, name)
+	if job == nil {
+		return nil, fmt.Errorf("%w: %s/%s", ErrJobNotFound, namespace, name)
+	}
+	var tasks []*pod_info.PodInfo
+	for _, task := range job.GetAllPodsMap() {
+		if pod_status.IsActiveAllocatedStatus(task.Status) {
+			tasks = append(tasks, task)
+		}
+	}
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("%w: %s/%s", ErrJobNotRunning, namespace, name)
+	}
+
+	simulation := &ReleaseSimulation{
+		Namespace:       namespace,
+		Name:            name,
+		NodePool:        nodePoolName(ssn),
+		ReleasedByNode:  map[string]Resources{},
+		ReleasedByQueue: map[common_info.QueueID]Resources{},
+		SchedulableJobs: []SchedulableJob{},
+	}
+	released := resource_info.EmptyResource()
+	releasedByNode := map[string]*resource_info.Resource{}
+	for _, task := range tasks {
+		released.AddResourceRequirements(task.ResReq)
+		if _, found := releasedByNode[task.NodeName]; !found {
+			releasedByNode[task.NodeName] = resource_info.EmptyResource()
+		}
+		releasedByNode[task.NodeName].AddResourceRequirements(task.ResReq)
+	}
+	simulation.Released = toResources(released)
+	for nodeName, resources := range releasedByNode {
+		simulation.ReleasedByNode[nodeName] = toResources(resources)
+	}
+	for queueID := job.Queue; queueID != ""; {
+		queue, found := ssn.ClusterInfo.Queues[queueID]
+		if !found {
+			break
+		}
+		simulation.ReleasedByQueue[queueID] = simulation.Released
+		queueID = queue.ParentQueue
+	}
+
+	pendingJobs := utils.GetAllPendingJobs(ssn)
+	savedFitErrors := saveFitErrors(pendingJobs)
+	defer restoreFitErrors(pendingJobs, savedFitErrors)
+
+	ssn.OnJobSolutionStart()
+	stmt := ssn.Statement()
+	defer stmt.Discard()
+	for _, task := range tasks {
+		if err := stmt.Evict(task, "", eviction_info.EvictionMetadata{
+			Action:           simulationAction,
+			EvictionGangSize: len(tasks),
+		}); err != nil {
+			return nil, fmt.Errorf("failed to simulate the eviction of pod %s/%s: %w", task.Namespace, task.Name, err)
+		}
+	}
+
+	jobsOrderByQueues := utils.NewJobsOrderByQueues(ssn, utils.JobsOrderInitOptions{
+		FilterNonPending:  true,
+		FilterUnready:     true,
+		MaxJobsQueueDepth: ssn.GetJobsDepth(framework.Allocate),
+	})
+	jobsOrderByQueues.InitializeWithJobs(pendingJobs)
+	nodes := maps.Values(ssn.ClusterInfo.Nodes)
+	for !jobsOrderByQueues.IsEmpty() {
+		pendingJob := jobsOrderByQueues.PopNextJob()
+		if pendingJob.UID == job.UID {
+			continue
+		}
+		checkpoint := stmt.Checkpoint()
+		if !common.AllocateJob(ssn, stmt, nodes, pendingJob, true) {
+			if err := stmt.Rollback(checkpoint); err != nil {
+				return nil, fmt.Errorf("failed to roll back the simulated allocation of job %s/%s: %w",
+					pendingJob.Namespace, pendingJob.Name, err)
+			}
+			continue
+		}
+		log.InfraLogger.V(4).Infof("Job <%s/%s> would be scheduled once job <%s/%s> is released",
+			pendingJob.Namespace, pendingJob.Name, namespace, name)
+		simulation.SchedulableJobs = append(simulation.SchedulableJobs, SchedulableJob{
+			ID:        pendingJob.UID,
+			Namespace: pendingJob.Namespace,
+			Name:      pendingJob.Name,
+			Queue:     pendingJob.Queue,
+		})
+	}
+	return simulation, nil
+}
+
+func findJob(ssn *framework.Session, namespace, name string) *podgroup_info.PodGroupInfo {
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		if job.Namespace == namespace && job.Name == name {
+			return job
+		}
+	}
+	return nil
+}
+
+func nodePoolName(ssn *framework.Session) string {
+	if nodePool := ssn.NodePoolName(); nodePool != "" {
+		return nodePool
+	}
+	return constants.DefaultNodePoolName
+}
+
+func toResources(resource *resource_info.Resource) Resources {
+	return Resources{
+		GPUs:     resource.GPUs(),
+		MilliCPU: resource.Cpu(),
+		Memory:   resource.Memory(),
+	}
+}
+
+type fitErrors struct {
+	jobFitErrors   []common_info.JobFitError
+	tasksFitErrors map[common_info.PodID]*common_info.TasksFitErrors
+}
+
+// saveFitErrors copies the fit errors of the jobs, so the errors of the simulated allocations are not reported in
+// the jobs' status
+func saveFitErrors(jobs map[common_info.PodGroupID]*podgroup_info.PodGroupInfo) map[common_info.PodGroupID]fitErrors {
+	saved := map[common_info.PodGroupID]fitErrors{}
+	for jobID, job := range jobs {
+		tasksFitErrors := make(map[common_info.PodID]*common_info.TasksFitErrors, len(job.TasksFitErrors))
+		for taskID, taskFitErrors := range job.TasksFitErrors {
+			tasksFitErrors[taskID] = taskFitErrors.Clone()
+		}
+		saved[jobID] = fitErrors{
+			jobFitErrors:   append([]common_info.JobFitError(nil), job.JobFitErrors...),
+			tasksFitErrors: tasksFitErrors,
+		}
+	}
+	return saved
+}
+
+func restoreFitErrors(
+	jobs map[common_info.PodGroupID]*podgroup_info.PodGroupInfo, saved map[common_info.PodGroupID]fitErrors,
+) {
+	for jobID, job := range jobs {
+		job.JobFitErrors = saved[jobID].jobFitErrors
+		job.TasksFitErrors = saved[jobID].tasksFitErrors
+	}
+}
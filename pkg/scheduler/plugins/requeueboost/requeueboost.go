@@ -0,0 +1,135 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package requeueboost
+
+import (
+	"cmp"
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const (
+	pluginName              = "requeueboost"
+	defaultBoostDuration    = time.Hour
+	defaultPriorityBoost    = 0
+	defaultMaxPriorityBoost = 10
+)
+
+// requeueBoostPlugin orders pending jobs that were recently preempted or reclaimed ahead of other jobs, so that
+// they get back in when capacity returns instead of waiting behind jobs that were submitted after them.
+type requeueBoostPlugin struct {
+	boostDuration    time.Duration
+	priorityBoost    int
+	maxPriorityBoost int
+	now              time.Time
+}
+
+func New(arguments framework.PluginArguments) framework.Plugin {
+	boostDuration, err := arguments.GetDuration("boostDuration", defaultBoostDuration)
+	if err != nil || boostDuration <= 0 {
+		log.InfraLogger.Warningf("boostDuration must be a positive duration, got %q. Using default value of %s",
+			arguments["boostDuration"], defaultBoostDuration)
+		boostDuration = defaultBoostDuration
+	}
+	priorityBoost, err := arguments.GetInt("priorityBoost", defaultPriorityBoost)
+	if err != nil || priorityBoost < 0 {
+		log.InfraLogger.Warningf("priorityBoost must be a non-negative integer, got %q. Using default value of %d",
+			arguments["priorityBoost"], defaultPriorityBoost)
+		priorityBoost = defaultPriorityBoost
+	}
+	maxPriorityBoost, err := arguments.GetInt("maxPriorityBoost", defaultMaxPriorityBoost)
+	if err != nil || maxPriorityBoost < 0 {
+		log.InfraLogger.Warningf("maxPriorityBoost must be a non-negative integer, got %q. Using default value of %d",
+			arguments["maxPriorityBoost"], defaultMaxPriorityBoost)
+		maxPriorityBoost = defaultMaxPriorityBoost
+	}
+
+	return &requeueBoostPlugin{
+		boostDuration:    boostDuration,
+		priorityBoost:    priorityBoost,
+		maxPriorityBoost: maxPriorityBoost,
+	}
+}
+
+// ValidateArguments rejects requeueboost plugin arguments that can't be parsed
+func ValidateArguments(arguments framework.PluginArguments) error {
+	boostDuration, err := arguments.GetDuration("boostDuration", defaultBoostDuration)
+	if err != nil {
+		return fmt.Errorf("invalid boostDuration: %w", err)
+	}
+	if boostDuration <= 0 {
+		return fmt.Errorf("boostDuration must be positive, got %s", boostDuration)
+	}
+	for _, key := range []string{"priorityBoost", "maxPriorityBoost"} {
+		value, err := arguments.GetInt(key, 0)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", key, err)
+		}
+		if value < 0 {
+			return fmt.Errorf("%s must not be negative, got %d", key, value)
+		}
+	}
+	return nil
+}
+
+func (rbp *requeueBoostPlugin) Name() string {
+	return pluginName
+}
+
+func (rbp *requeueBoostPlugin) OnSessionOpen(ssn *framework.Session) {
+	rbp.now = ssn.Clock().Now()
+	ssn.AddJobOrderFn(rbp.jobOrderFn)
+}
+
+func (rbp *requeueBoostPlugin) OnSessionClose(_ *framework.Session) {}
+
+// jobOrderFn orders jobs by their priority with the boost of the boosted jobs, and then orders boosted jobs ahead of
+// other jobs, and boosted jobs that were preempted earlier ahead of those that were preempted later
+func (rbp *requeueBoostPlugin) jobOrderFn(l, r interface{}) int {
+	lv := l.(*podgroup_info.PodGroupInfo)
+	rv := r.(*podgroup_info.PodGroupInfo)
+
+	lBoosted := rbp.isBoosted(lv)
+	rBoosted := rbp.isBoosted(rv)
+
+	lPriority := int(lv.Priority)
+	if lBoosted {
+		lPriority += rbp.getPriorityBoost(lv)
+	}
+	rPriority := int(rv.Priority)
+	if rBoosted {
+		rPriority += rbp.getPriorityBoost(rv)
+	}
+	if lPriority != rPriority {
+		return cmp.Compare(rPriority, lPriority)
+	}
+
+	if lBoosted != rBoosted {
+		if lBoosted {
+			return -1
+		}
+		return 1
+	}
+	if !lBoosted {
+		return 0
+	}
+	return lv.Preemptions.LastPreemptionTime.Time.Compare(rv.Preemptions.LastPreemptionTime.Time)
+}
+
+// isBoosted returns true for jobs with pending pods that were preempted or reclaimed within the boost duration
+func (rbp *requeueBoostPlugin) isBoosted(job *podgroup_info.PodGroupInfo) bool {
+	if job.Preemptions == nil || job.Preemptions.LastPreemptionTime == nil || job.GetNumPendingTasks() == 0 {
+		return false
+	}
+	return rbp.now.Sub(job.Preemptions.LastPreemptionTime.Time) < rbp.boostDuration
+}
+
+// getPriorityBoost returns the priority boost of a job for its preemptions, bounded by the maximal boost
+func (rbp *requeueBoostPlugin) getPriorityBoost(job *podgroup_info.PodGroupInfo) int {
+	return min(int(job.Preemptions.Count)*rbp.priorityBoost, rbp.maxPriorityBoost)
+}
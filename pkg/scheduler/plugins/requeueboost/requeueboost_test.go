@@ -0,0 +1,157 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package requeueboost
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+type testJob struct {
+	priority       int32
+	status         pod_status.PodStatus
+	preemptions    int32
+	preemptedSince time.Duration
+}
+
+func Test_jobOrderFn(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		arguments framework.PluginArguments
+		left      testJob
+		right     testJob
+		expected  int
+	}{
+		{
+			name:     "jobs that were never preempted",
+			left:     testJob{priority: 50, status: pod_status.Pending},
+			right:    testJob{priority: 50, status: pod_status.Pending},
+			expected: 0,
+		},
+		{
+			name:     "higher priority first",
+			left:     testJob{priority: 50, status: pod_status.Pending},
+			right:    testJob{priority: 100, status: pod_status.Pending},
+			expected: 1,
+		},
+		{
+			name:     "recently preempted job first",
+			left:     testJob{priority: 50, status: pod_status.Pending, preemptions: 1, preemptedSince: time.Minute},
+			right:    testJob{priority: 50, status: pod_status.Pending},
+			expected: -1,
+		},
+		{
+			name:     "preempted job without a priority boost after higher priority jobs",
+			left:     testJob{priority: 50, status: pod_status.Pending, preemptions: 1, preemptedSince: time.Minute},
+			right:    testJob{priority: 55, status: pod_status.Pending},
+			expected: 1,
+		},
+		{
+			name:     "boost expired",
+			left:     testJob{priority: 50, status: pod_status.Pending, preemptions: 1, preemptedSince: 2 * time.Hour},
+			right:    testJob{priority: 50, status: pod_status.Pending},
+			expected: 0,
+		},
+		{
+			name:     "running job is not boosted",
+			left:     testJob{priority: 50, status: pod_status.Running, preemptions: 1, preemptedSince: time.Minute},
+			right:    testJob{priority: 50, status: pod_status.Running},
+			expected: 0,
+		},
+		{
+			name:     "earlier preempted job first",
+			left:     testJob{priority: 50, status: pod_status.Pending, preemptions: 1, preemptedSince: time.Minute},
+			right:    testJob{priority: 50, status: pod_status.Pending, preemptions: 1, preemptedSince: 10 * time.Minute},
+			expected: 1,
+		},
+		{
+			name:      "priority boost per preemption",
+			arguments: framework.PluginArguments{"priorityBoost": "3"},
+			left:      testJob{priority: 50, status: pod_status.Pending, preemptions: 2, preemptedSince: time.Minute},
+			right:     testJob{priority: 55, status: pod_status.Pending},
+			expected:  -1,
+		},
+		{
+			name:      "priority boost is bounded",
+			arguments: framework.PluginArguments{"priorityBoost": "3", "maxPriorityBoost": "4"},
+			left:      testJob{priority: 50, status: pod_status.Pending, preemptions: 2, preemptedSince: time.Minute},
+			right:     testJob{priority: 55, status: pod_status.Pending},
+			expected:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := New(tt.arguments).(*requeueBoostPlugin)
+			plugin.now = now
+
+			left := newJob("left", tt.left, now)
+			right := newJob("right", tt.right, now)
+			assert.Equal(t, tt.expected, plugin.jobOrderFn(left, right))
+			assert.Equal(t, -tt.expected, plugin.jobOrderFn(right, left))
+		})
+	}
+}
+
+func TestValidateArguments(t *testing.T) {
+	tests := []struct {
+		name      string
+		arguments framework.PluginArguments
+		expectErr bool
+	}{
+		{name: "defaults", arguments: framework.PluginArguments{}},
+		{name: "valid arguments", arguments: framework.PluginArguments{
+			"boostDuration": "30m", "priorityBoost": "5", "maxPriorityBoost": "20"}},
+		{name: "invalid boostDuration", arguments: framework.PluginArguments{"boostDuration": "soon"},
+			expectErr: true},
+		{name: "non positive boostDuration", arguments: framework.PluginArguments{"boostDuration": "0s"},
+			expectErr: true},
+		{name: "invalid priorityBoost", arguments: framework.PluginArguments{"priorityBoost": "high"},
+			expectErr: true},
+		{name: "negative maxPriorityBoost", arguments: framework.PluginArguments{"maxPriorityBoost": "-1"},
+			expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateArguments(tt.arguments)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func newJob(name string, job testJob, now time.Time) *podgroup_info.PodGroupInfo {
+	pgi := podgroup_info.NewPodGroupInfo(common_info.PodGroupID(name))
+	pgi.Priority = job.priority
+	pgi.AddTaskInfo(&pod_info.PodInfo{
+		UID:       common_info.PodID(name + "-0"),
+		Job:       common_info.PodGroupID(name),
+		Name:      name + "-0",
+		Namespace: "ns",
+		Status:    job.status,
+	})
+	if job.preemptions > 0 {
+		pgi.Preemptions = &enginev2alpha2.PodGroupPreemptions{
+			Count:              job.preemptions,
+			LastPreemptionTime: &metav1.Time{Time: now.Add(-job.preemptedSince)},
+		}
+	}
+	return pgi
+}
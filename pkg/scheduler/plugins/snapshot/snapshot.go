@@ -42,6 +42,7 @@ type RawKubernetesObjects struct {
 	CSIStorageCapacities   []*storage.CSIStorageCapacity     `json:"csiStorageCapacities"`
 	StorageClasses         []*storage.StorageClass           `json:"storageClasses"`
 	CSIDrivers             []*storage.CSIDriver              `json:"csiDrivers"`
+	CSINodes               []*storage.CSINode                `json:"csiNodes"`
 	ResourceClaims         []*resourceapi.ResourceClaim      `json:"resourceClaims"`
 	ResourceSlices         []*resourceapi.ResourceSlice      `json:"resourceSlices"`
 	DeviceClasses          []*resourceapi.DeviceClass        `json:"deviceClasses"`
@@ -142,6 +143,12 @@ func (sp *snapshotPlugin) serveSnapshot(writer http.ResponseWriter, request *htt
 		rawObjects.CSIDrivers = []*storage.CSIDriver{}
 	}
 
+	rawObjects.CSINodes, err = dataLister.ListCSINodes()
+	if err != nil {
+		log.InfraLogger.Errorf("Error getting raw CSI nodes: %v", err)
+		rawObjects.CSINodes = []*storage.CSINode{}
+	}
+
 	rawObjects.Topologies, err = dataLister.ListTopologies()
 	if err != nil {
 		log.InfraLogger.Errorf("Error getting raw topologies: %v", err)
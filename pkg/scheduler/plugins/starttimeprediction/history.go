@@ -0,0 +1,66 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package starttimeprediction
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+)
+
+// durationWeight is the weight of the latest job duration in the moving average of the queue's job durations
+const durationWeight = 0.2
+
+type runningJob struct {
+	queue     common_info.QueueID
+	startTime time.Time
+}
+
+// durationHistory tracks the durations of the jobs of each queue across scheduling sessions. A job is considered
+// finished when it was running in the previous session and is not running anymore.
+type durationHistory struct {
+	mutex            sync.Mutex
+	runningJobs      map[common_info.PodGroupID]runningJob
+	averageDurations map[common_info.QueueID]time.Duration
+}
+
+func newDurationHistory() *durationHistory {
+	return &durationHistory{
+		runningJobs:      map[common_info.PodGroupID]runningJob{},
+		averageDurations: map[common_info.QueueID]time.Duration{},
+	}
+}
+
+// update records the durations of the jobs that finished since the previous update, and tracks the running jobs
+func (h *durationHistory) update(running map[common_info.PodGroupID]runningJob, now time.Time) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for jobID, job := range h.runningJobs {
+		if _, found := running[jobID]; found {
+			continue
+		}
+		h.addDuration(job.queue, now.Sub(job.startTime))
+	}
+	h.runningJobs = running
+}
+
+func (h *durationHistory) addDuration(queue common_info.QueueID, duration time.Duration) {
+	average, found := h.averageDurations[queue]
+	if !found {
+		h.averageDurations[queue] = duration
+		return
+	}
+	h.averageDurations[queue] = time.Duration(durationWeight*float64(duration) + (1-durationWeight)*float64(average))
+}
+
+// averageDuration returns the moving average of the durations of the queue's finished jobs
+func (h *durationHistory) averageDuration(queue common_info.QueueID) (time.Duration, bool) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	average, found := h.averageDurations[queue]
+	return average, found
+}
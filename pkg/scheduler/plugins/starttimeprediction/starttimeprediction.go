@@ -0,0 +1,169 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package starttimeprediction
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const pluginName = "starttimeprediction"
+
+type startTimePredictionPlugin struct {
+	history *durationHistory
+}
+
+func New(_ framework.PluginArguments) framework.Plugin {
+	return &startTimePredictionPlugin{}
+}
+
+func (stp *startTimePredictionPlugin) Name() string {
+	return pluginName
+}
+
+func (stp *startTimePredictionPlugin) OnSessionOpen(_ *framework.Session) {}
+
+// OnSessionClose predicts the start times of the pending jobs once the session's allocations are known, so they are
+// written to the pod groups' status together with the rest of the session's results. Shadow sessions don't predict,
+// since their results are not written.
+func (stp *startTimePredictionPlugin) OnSessionClose(ssn *framework.Session) {
+	if ssn.IsShadow() {
+		return
+	}
+	stp.history = ssn.PluginState(pluginName, func() any { return newDurationHistory() }).(*durationHistory)
+	now := ssn.Clock().Now()
+	stp.history.update(runningJobs(ssn, now), now)
+
+	clusterIdle := resource_info.EmptyResource()
+	for _, node := range ssn.ClusterInfo.Nodes {
+		clusterIdle.Add(node.Idle)
+	}
+
+	queueJobs := map[common_info.QueueID][]*podgroup_info.PodGroupInfo{}
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		queueJobs[job.Queue] = append(queueJobs[job.Queue], job)
+	}
+	for queueID, jobs := range queueJobs {
+		queue, found := ssn.ClusterInfo.Queues[queueID]
+		if !found {
+			continue
+		}
+		averageDuration, hasHistory := stp.history.averageDuration(queueID)
+		predictions := predictQueue(now, queue, jobs, clusterIdle, averageDuration, hasHistory,
+			func(l, r *podgroup_info.PodGroupInfo) bool { return ssn.JobOrderFn(l, r) })
+		for _, job := range jobs {
+			job.StartTimePrediction = predictions[job.UID]
+		}
+		log.InfraLogger.V(6).Infof("Predicted the start times of %d pending jobs of queue <%s>",
+			len(predictions), queueID)
+	}
+}
+
+func runningJobs(ssn *framework.Session, now time.Time) map[common_info.PodGroupID]runningJob {
+	running := map[common_info.PodGroupID]runningJob{}
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		if !isRunning(job) {
+			continue
+		}
+		running[job.UID] = runningJob{queue: job.Queue, startTime: startTime(job, now)}
+	}
+	return running
+}
+
+// predictQueue simulates the allocation of the pending jobs of a queue in their scheduling order. A pending job
+// starts once the queue's unused deserved quota, or the idle resources of the cluster if they are larger, together
+// with the resources released by the jobs before it, can fit the job. Jobs are expected to run for the average
+// duration of the queue's past jobs.
+func predictQueue(
+	now time.Time, queue *queue_info.QueueInfo, jobs []*podgroup_info.PodGroupInfo, clusterIdle *resource_info.Resource,
+	averageDuration time.Duration, hasHistory bool, jobOrderFn func(l, r *podgroup_info.PodGroupInfo) bool,
+) map[common_info.PodGroupID]*enginev2alpha2.StartTimePrediction {
+	allocated := resource_info.EmptyResource()
+	var pending []*podgroup_info.PodGroupInfo
+	for _, job := range jobs {
+		allocated.Add(job.Allocated)
+		if isPending(job) {
+			pending = append(pending, job)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	sort.SliceStable(pending, func(i, j int) bool { return jobOrderFn(pending[i], pending[j]) })
+
+	gpuTimeline := newTimeline(now, headroom(queue.Resources.GPU.Quota, allocated.GPUs(), clusterIdle.GPUs()))
+	cpuTimeline := newTimeline(now, headroom(queue.Resources.CPU.Quota, allocated.Cpu(), clusterIdle.Cpu()))
+	if hasHistory {
+		for _, job := range jobs {
+			if !isRunning(job) {
+				continue
+			}
+			releaseTime := startTime(job, now).Add(averageDuration)
+			if releaseTime.Before(now) {
+				releaseTime = now
+			}
+			gpuTimeline.release(releaseTime, job.Allocated.GPUs())
+			cpuTimeline.release(releaseTime, job.Allocated.Cpu())
+		}
+	}
+
+	predictions := map[common_info.PodGroupID]*enginev2alpha2.StartTimePrediction{}
+	for position, job := range pending {
+		request := resource_info.EmptyResource()
+		for _, task := range job.GetPendingTasks() {
+			request.AddResourceRequirements(task.ResReq)
+		}
+
+		var expectedStartTime *time.Time
+		var reason enginev2alpha2.StartTimePredictionReason
+		if request.GPUs() > 0 {
+			expectedStartTime, reason = gpuTimeline.predict(request.GPUs(), averageDuration, hasHistory)
+		} else {
+			expectedStartTime, reason = cpuTimeline.predict(request.Cpu(), averageDuration, hasHistory)
+		}
+
+		prediction := &enginev2alpha2.StartTimePrediction{
+			QueuePosition: int32(position + 1),
+			Reason:        reason,
+		}
+		if expectedStartTime != nil {
+			prediction.ExpectedStartTime = &metav1.Time{Time: expectedStartTime.Truncate(time.Minute)}
+		}
+		predictions[job.UID] = prediction
+	}
+	return predictions
+}
+
+func headroom(quota, allocated, clusterIdle float64) float64 {
+	if quota < 0 {
+		return clusterIdle
+	}
+	return math.Max(quota-allocated, clusterIdle)
+}
+
+func isPending(job *podgroup_info.PodGroupInfo) bool {
+	return job.IsReadyForScheduling() && job.GetNumPendingTasks() > 0 && job.GetActiveAllocatedTasksCount() == 0
+}
+
+func isRunning(job *podgroup_info.PodGroupInfo) bool {
+	return job.GetActiveAllocatedTasksCount() > 0
+}
+
+func startTime(job *podgroup_info.PodGroupInfo, now time.Time) time.Time {
+	if job.LastStartTimestamp == nil {
+		return now
+	}
+	return *job.LastStartTimestamp
+}
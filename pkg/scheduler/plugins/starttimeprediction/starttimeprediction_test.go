@@ -0,0 +1,177 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package starttimeprediction
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+)
+
+type expectedPrediction struct {
+	startAfter *time.Duration
+	position   int32
+	reason     enginev2alpha2.StartTimePredictionReason
+}
+
+func Test_predictQueue(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	hour := time.Hour
+	threeHours := 3 * time.Hour
+	immediately := time.Duration(0)
+
+	tests := []struct {
+		name            string
+		quota           float64
+		clusterIdleGPUs float64
+		jobs            []*podgroup_info.PodGroupInfo
+		averageDuration time.Duration
+		hasHistory      bool
+		expected        map[common_info.PodGroupID]expectedPrediction
+	}{
+		{
+			name:  "pending jobs within the deserved quota",
+			quota: 4,
+			jobs: []*podgroup_info.PodGroupInfo{
+				newJob("running", pod_status.Running, 2, now.Add(-time.Hour)),
+				newJob("pending-1", pod_status.Pending, 1, time.Time{}),
+				newJob("pending-2", pod_status.Pending, 1, time.Time{}),
+			},
+			expected: map[common_info.PodGroupID]expectedPrediction{
+				"pending-1": {startAfter: &immediately, position: 1, reason: enginev2alpha2.WithinQuota},
+				"pending-2": {startAfter: &immediately, position: 2, reason: enginev2alpha2.WithinQuota},
+			},
+		},
+		{
+			name:            "idle cluster resources beyond the deserved quota",
+			quota:           0,
+			clusterIdleGPUs: 2,
+			jobs: []*podgroup_info.PodGroupInfo{
+				newJob("pending", pod_status.Pending, 2, time.Time{}),
+			},
+			expected: map[common_info.PodGroupID]expectedPrediction{
+				"pending": {startAfter: &immediately, position: 1, reason: enginev2alpha2.WithinQuota},
+			},
+		},
+		{
+			name:  "no history of the queue's job durations",
+			quota: 2,
+			jobs: []*podgroup_info.PodGroupInfo{
+				newJob("running", pod_status.Running, 2, now.Add(-time.Hour)),
+				newJob("pending", pod_status.Pending, 1, time.Time{}),
+			},
+			expected: map[common_info.PodGroupID]expectedPrediction{
+				"pending": {position: 1, reason: enginev2alpha2.NoHistory},
+			},
+		},
+		{
+			name:  "waiting for running jobs to finish",
+			quota: 2,
+			jobs: []*podgroup_info.PodGroupInfo{
+				newJob("running-1", pod_status.Running, 1, now.Add(-time.Hour)),
+				newJob("running-2", pod_status.Running, 1, now),
+				newJob("pending-1", pod_status.Pending, 1, time.Time{}),
+				newJob("pending-2", pod_status.Pending, 2, time.Time{}),
+			},
+			averageDuration: 2 * time.Hour,
+			hasHistory:      true,
+			expected: map[common_info.PodGroupID]expectedPrediction{
+				"pending-1": {startAfter: &hour, position: 1, reason: enginev2alpha2.WaitingForRunningJobs},
+				"pending-2": {startAfter: &threeHours, position: 2, reason: enginev2alpha2.WaitingForRunningJobs},
+			},
+		},
+		{
+			name:  "job larger than the queue can ever get",
+			quota: 2,
+			jobs: []*podgroup_info.PodGroupInfo{
+				newJob("running", pod_status.Running, 2, now),
+				newJob("pending", pod_status.Pending, 4, time.Time{}),
+			},
+			averageDuration: time.Hour,
+			hasHistory:      true,
+			expected: map[common_info.PodGroupID]expectedPrediction{
+				"pending": {position: 1, reason: enginev2alpha2.WaitingForRunningJobs},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := &queue_info.QueueInfo{UID: "queue", Name: "queue"}
+			queue.Resources.GPU.Quota = tt.quota
+			queue.Resources.CPU.Quota = -1
+			clusterIdle := resource_info.EmptyResource()
+			clusterIdle.SetGPUs(tt.clusterIdleGPUs)
+
+			predictions := predictQueue(now, queue, tt.jobs, clusterIdle, tt.averageDuration, tt.hasHistory,
+				func(l, r *podgroup_info.PodGroupInfo) bool { return l.Name < r.Name })
+
+			assert.Len(t, predictions, len(tt.expected))
+			for jobID, expected := range tt.expected {
+				prediction := predictions[jobID]
+				if !assert.NotNil(t, prediction, "missing prediction of job %s", jobID) {
+					continue
+				}
+				assert.Equal(t, expected.position, prediction.QueuePosition, "job %s", jobID)
+				assert.Equal(t, expected.reason, prediction.Reason, "job %s", jobID)
+				if expected.startAfter == nil {
+					assert.Nil(t, prediction.ExpectedStartTime, "job %s", jobID)
+					continue
+				}
+				if assert.NotNil(t, prediction.ExpectedStartTime, "job %s", jobID) {
+					assert.Equal(t, now.Add(*expected.startAfter), prediction.ExpectedStartTime.Time, "job %s", jobID)
+				}
+			}
+		})
+	}
+}
+
+func Test_durationHistory(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	h := newDurationHistory()
+
+	h.update(map[common_info.PodGroupID]runningJob{
+		"job-1": {queue: "queue", startTime: now.Add(-time.Hour)},
+		"job-2": {queue: "queue", startTime: now},
+	}, now)
+	_, found := h.averageDuration("queue")
+	assert.False(t, found)
+
+	h.update(map[common_info.PodGroupID]runningJob{
+		"job-2": {queue: "queue", startTime: now},
+	}, now.Add(time.Hour))
+	average, found := h.averageDuration("queue")
+	assert.True(t, found)
+	assert.Equal(t, 2*time.Hour, average)
+
+	h.update(map[common_info.PodGroupID]runningJob{}, now.Add(7*time.Hour))
+	average, _ = h.averageDuration("queue")
+	assert.Equal(t, 3*time.Hour, average)
+}
+
+func newJob(
+	name string, status pod_status.PodStatus, gpus float64, startTime time.Time,
+) *podgroup_info.PodGroupInfo {
+	job := podgroup_info.NewPodGroupInfo(common_info.PodGroupID(name), &pod_info.PodInfo{
+		UID:    common_info.PodID(name),
+		Job:    common_info.PodGroupID(name),
+		Name:   name,
+		Status: status,
+		ResReq: resource_info.NewResourceRequirementsWithGpus(gpus),
+	})
+	job.Name = name
+	job.Queue = "queue"
+	if !startTime.IsZero() {
+		job.LastStartTimestamp = &startTime
+	}
+	return job
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package starttimeprediction
+
+import (
+	"sort"
+	"time"
+
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+)
+
+type event struct {
+	time    time.Time
+	amount  float64
+	release bool
+}
+
+// timeline tracks the resources of a single kind available to a queue over time
+type timeline struct {
+	now       time.Time
+	headroom  float64
+	events    []event
+	notBefore time.Time
+
+	blocked       bool
+	blockedReason enginev2alpha2.StartTimePredictionReason
+}
+
+func newTimeline(now time.Time, headroom float64) *timeline {
+	return &timeline{now: now, headroom: headroom, notBefore: now}
+}
+
+// release records resources of a running job that are expected to be released at the given time
+func (tl *timeline) release(releaseTime time.Time, amount float64) {
+	if amount <= 0 {
+		return
+	}
+	tl.events = append(tl.events, event{time: releaseTime, amount: amount, release: true})
+}
+
+// predict returns the earliest time at which the requested resources are available, and reserves them for the
+// expected duration of the job. Jobs start in order, so a job never starts before the jobs predicted before it,
+// and once a job cannot be predicted neither can the jobs after it.
+func (tl *timeline) predict(
+	request float64, duration time.Duration, hasHistory bool,
+) (*time.Time, enginev2alpha2.StartTimePredictionReason) {
+	if tl.blocked {
+		return nil, tl.blockedReason
+	}
+
+	if tl.notBefore.Equal(tl.now) && tl.available(tl.now, false) >= request {
+		tl.reserve(tl.now, request, duration, hasHistory)
+		return &tl.now, enginev2alpha2.WithinQuota
+	}
+
+	if !hasHistory {
+		tl.blocked, tl.blockedReason = true, enginev2alpha2.NoHistory
+		return nil, tl.blockedReason
+	}
+
+	for _, candidate := range tl.candidateTimes() {
+		if tl.available(candidate, true) >= request {
+			tl.reserve(candidate, request, duration, hasHistory)
+			return &candidate, enginev2alpha2.WaitingForRunningJobs
+		}
+	}
+	tl.blocked, tl.blockedReason = true, enginev2alpha2.WaitingForRunningJobs
+	return nil, tl.blockedReason
+}
+
+func (tl *timeline) available(at time.Time, withReleases bool) float64 {
+	available := tl.headroom
+	for _, e := range tl.events {
+		if e.time.After(at) || (e.release && !withReleases) {
+			continue
+		}
+		available += e.amount
+	}
+	return available
+}
+
+func (tl *timeline) reserve(startTime time.Time, request float64, duration time.Duration, hasHistory bool) {
+	tl.events = append(tl.events, event{time: startTime, amount: -request})
+	if hasHistory {
+		tl.events = append(tl.events, event{time: startTime.Add(duration), amount: request, release: true})
+	}
+	tl.notBefore = startTime
+}
+
+func (tl *timeline) candidateTimes() []time.Time {
+	candidates := []time.Time{tl.notBefore}
+	for _, e := range tl.events {
+		if e.time.After(tl.notBefore) {
+			candidates = append(candidates, e.time)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Before(candidates[j]) })
+	return candidates
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package stickyplacement
+
+import (
+	"maps"
+	"sync"
+	"time"
+)
+
+// placement is the last placement of a recurring job: the nodes of its pods and, for jobs with a topology
+// constraint, the lowest topology domain that contains all of them
+type placement struct {
+	nodes       map[string]bool
+	domainLabel string
+	domainValue string
+	lastSeen    time.Time
+}
+
+// placementHistory keeps the last placement of recurring jobs across scheduling sessions, until it expires
+type placementHistory struct {
+	mutex      sync.Mutex
+	placements map[string]*placement
+}
+
+func newPlacementHistory() *placementHistory {
+	return &placementHistory{
+		placements: map[string]*placement{},
+	}
+}
+
+// record replaces the placement of the recurring job
+func (h *placementHistory) record(recurringJobID string, p *placement) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.placements[recurringJobID] = p
+}
+
+// get returns the last placement of the recurring job, or nil if there is none
+func (h *placementHistory) get(recurringJobID string) *placement {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	return h.placements[recurringJobID]
+}
+
+// clone returns a copy of the history. Placements are replaced and never changed, so they are shared by the copy.
+func (h *placementHistory) clone() *placementHistory {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	return &placementHistory{placements: maps.Clone(h.placements)}
+}
+
+// expire removes the placements that weren't seen for longer than the ttl
+func (h *placementHistory) expire(now time.Time, ttl time.Duration) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for recurringJobID, p := range h.placements {
+		if now.Sub(p.lastSeen) > ttl {
+			delete(h.placements, recurringJobID)
+		}
+	}
+}
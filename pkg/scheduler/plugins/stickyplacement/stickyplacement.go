@@ -0,0 +1,180 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package stickyplacement
+
+import (
+	"fmt"
+	"time"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
+)
+
+const (
+	pluginName = "stickyplacement"
+	defaultTTL = 24 * time.Hour
+
+	previousNodeScore   = scores.ResourceType
+	previousDomainScore = scores.ResourceType / 2
+)
+
+type stickyPlacementPlugin struct {
+	ttl     time.Duration
+	history *placementHistory
+
+	// jobPlacements holds the previous placement of the jobs with pending tasks in the session
+	jobPlacements map[common_info.PodGroupID]*placement
+}
+
+func New(arguments framework.PluginArguments) framework.Plugin {
+	ttl, err := arguments.GetDuration("ttl", defaultTTL)
+	if err != nil || ttl <= 0 {
+		log.InfraLogger.Warningf("ttl must be a positive duration, got %q. Using default value of %s",
+			arguments["ttl"], defaultTTL)
+		ttl = defaultTTL
+	}
+	return &stickyPlacementPlugin{
+		ttl: ttl,
+	}
+}
+
+// ValidateArguments rejects stickyplacement plugin arguments that can't be parsed
+func ValidateArguments(arguments framework.PluginArguments) error {
+	ttl, err := arguments.GetDuration("ttl", defaultTTL)
+	if err != nil {
+		return fmt.Errorf("invalid ttl: %w", err)
+	}
+	if ttl <= 0 {
+		return fmt.Errorf("ttl must be positive, got %s", ttl)
+	}
+	return nil
+}
+
+func (spp *stickyPlacementPlugin) Name() string {
+	return pluginName
+}
+
+func (spp *stickyPlacementPlugin) OnSessionOpen(ssn *framework.Session) {
+	spp.history = ssn.PluginState(pluginName, func() any { return newPlacementHistory() }).(*placementHistory)
+	// Shadow sessions record placements on a copy of the history, since they don't outlive the session
+	if ssn.IsShadow() {
+		spp.history = spp.history.clone()
+	}
+	now := ssn.Clock().Now()
+	spp.history.expire(now, spp.ttl)
+
+	spp.jobPlacements = map[common_info.PodGroupID]*placement{}
+	for _, job := range ssn.ClusterInfo.PodGroupInfos {
+		recurringJobID := recurringJobID(job)
+		if recurringJobID == "" {
+			continue
+		}
+		if nodes := placedNodes(job); len(nodes) > 0 {
+			p := &placement{nodes: nodes, lastSeen: now}
+			if topology := jobTopology(job, ssn.ClusterInfo.Topologies); topology != nil {
+				p.domainLabel, p.domainValue = commonDomain(topology, nodes, ssn.ClusterInfo.Nodes)
+			}
+			spp.history.record(recurringJobID, p)
+		}
+		if job.GetNumPendingTasks() == 0 {
+			continue
+		}
+		if p := spp.history.get(recurringJobID); p != nil {
+			spp.jobPlacements[job.UID] = p
+		}
+	}
+
+	ssn.AddNodeOrderFn(spp.nodeOrderFn)
+}
+
+func (spp *stickyPlacementPlugin) OnSessionClose(_ *framework.Session) {}
+
+// nodeOrderFn prefers the nodes of the previous run of a recurring job, and then the other nodes of the topology
+// domain the previous run was placed in
+func (spp *stickyPlacementPlugin) nodeOrderFn(task *pod_info.PodInfo, node *node_info.NodeInfo) (float64, error) {
+	p, found := spp.jobPlacements[task.Job]
+	if !found {
+		return 0, nil
+	}
+	if p.nodes[node.Name] {
+		return previousNodeScore, nil
+	}
+	if p.domainLabel != "" && node.Node != nil && node.Node.Labels[p.domainLabel] == p.domainValue {
+		return previousDomainScore, nil
+	}
+	return 0, nil
+}
+
+// recurringJobID returns the identity of the job across its runs, or an empty string if the job isn't recurring.
+// The identity is scoped to the namespace of the job.
+func recurringJobID(job *podgroup_info.PodGroupInfo) string {
+	if job.PodGroup == nil {
+		return ""
+	}
+	value := job.PodGroup.Annotations[constants.RecurringJob]
+	if value == "" {
+		return ""
+	}
+	return job.Namespace + "/" + value
+}
+
+// placedNodes returns the nodes of the job's pods that are bound to a node or are being bound
+func placedNodes(job *podgroup_info.PodGroupInfo) map[string]bool {
+	nodes := map[string]bool{}
+	for _, task := range job.GetAllPodsMap() {
+		if task.NodeName != "" && pod_status.IsActiveUsedStatus(task.Status) {
+			nodes[task.NodeName] = true
+		}
+	}
+	return nodes
+}
+
+func jobTopology(job *podgroup_info.PodGroupInfo, topologies []*kaiv1alpha1.Topology) *kaiv1alpha1.Topology {
+	if job.PodGroup == nil || job.PodGroup.Spec.TopologyConstraint.Topology == "" {
+		return nil
+	}
+	for _, topology := range topologies {
+		if topology.Name == job.PodGroup.Spec.TopologyConstraint.Topology {
+			return topology
+		}
+	}
+	return nil
+}
+
+// commonDomain returns the label and value of the lowest topology level whose domain contains all the nodes, or
+// empty strings if the nodes span the top level domains
+func commonDomain(topology *kaiv1alpha1.Topology, nodeNames map[string]bool, nodes map[string]*node_info.NodeInfo,
+) (string, string) {
+	for i := len(topology.Spec.Levels) - 1; i >= 0; i-- {
+		label := topology.Spec.Levels[i].NodeLabel
+		if value, found := commonLabelValue(label, nodeNames, nodes); found {
+			return label, value
+		}
+	}
+	return "", ""
+}
+
+func commonLabelValue(label string, nodeNames map[string]bool, nodes map[string]*node_info.NodeInfo) (string, bool) {
+	commonValue := ""
+	for nodeName := range nodeNames {
+		node, found := nodes[nodeName]
+		if !found || node.Node == nil {
+			return "", false
+		}
+		value := node.Node.Labels[label]
+		if value == "" || (commonValue != "" && value != commonValue) {
+			return "", false
+		}
+		commonValue = value
+	}
+	return commonValue, commonValue != ""
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package stickyplacement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	enginev2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+const (
+	rackLabel = "topology/rack"
+	zoneLabel = "topology/zone"
+)
+
+func TestCommonDomain(t *testing.T) {
+	topology := &kaiv1alpha1.Topology{
+		Spec: kaiv1alpha1.TopologySpec{
+			Levels: []kaiv1alpha1.TopologyLevel{{NodeLabel: zoneLabel}, {NodeLabel: rackLabel}},
+		},
+	}
+	nodes := map[string]*node_info.NodeInfo{
+		"node-a": newNode("node-a", "zone-1", "rack-1"),
+		"node-b": newNode("node-b", "zone-1", "rack-1"),
+		"node-c": newNode("node-c", "zone-1", "rack-2"),
+		"node-d": newNode("node-d", "zone-2", "rack-3"),
+	}
+
+	tests := []struct {
+		name          string
+		nodeNames     map[string]bool
+		expectedLabel string
+		expectedValue string
+	}{
+		{
+			name:          "nodes in the same rack",
+			nodeNames:     map[string]bool{"node-a": true, "node-b": true},
+			expectedLabel: rackLabel,
+			expectedValue: "rack-1",
+		},
+		{
+			name:          "nodes in the same zone",
+			nodeNames:     map[string]bool{"node-a": true, "node-c": true},
+			expectedLabel: zoneLabel,
+			expectedValue: "zone-1",
+		},
+		{
+			name:      "nodes in different zones",
+			nodeNames: map[string]bool{"node-a": true, "node-d": true},
+		},
+		{
+			name:      "node that no longer exists",
+			nodeNames: map[string]bool{"node-a": true, "node-e": true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			label, value := commonDomain(topology, tt.nodeNames, nodes)
+			assert.Equal(t, tt.expectedLabel, label)
+			assert.Equal(t, tt.expectedValue, value)
+		})
+	}
+}
+
+func TestNodeOrderFn(t *testing.T) {
+	spp := &stickyPlacementPlugin{
+		jobPlacements: map[common_info.PodGroupID]*placement{
+			"recurring": {
+				nodes:       map[string]bool{"node-a": true},
+				domainLabel: rackLabel,
+				domainValue: "rack-1",
+			},
+		},
+	}
+	recurringTask := &pod_info.PodInfo{UID: "pod", Job: "recurring"}
+	otherTask := &pod_info.PodInfo{UID: "other-pod", Job: "other"}
+
+	tests := []struct {
+		name     string
+		task     *pod_info.PodInfo
+		node     *node_info.NodeInfo
+		expected float64
+	}{
+		{name: "previous node", task: recurringTask, node: newNode("node-a", "zone-1", "rack-1"),
+			expected: previousNodeScore},
+		{name: "previous domain", task: recurringTask, node: newNode("node-b", "zone-1", "rack-1"),
+			expected: previousDomainScore},
+		{name: "other domain", task: recurringTask, node: newNode("node-c", "zone-1", "rack-2")},
+		{name: "job without a previous placement", task: otherTask, node: newNode("node-a", "zone-1", "rack-1")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, err := spp.nodeOrderFn(tt.task, tt.node)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, score)
+		})
+	}
+}
+
+func TestPlacementHistoryExpire(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	h := newPlacementHistory()
+	h.record("ns/recent", &placement{lastSeen: now.Add(-time.Hour)})
+	h.record("ns/old", &placement{lastSeen: now.Add(-3 * time.Hour)})
+
+	h.expire(now, 2*time.Hour)
+	assert.NotNil(t, h.get("ns/recent"))
+	assert.Nil(t, h.get("ns/old"))
+}
+
+func TestRecurringJobID(t *testing.T) {
+	job := podgroup_info.NewPodGroupInfo("job", &pod_info.PodInfo{UID: "pod", Job: "job", Status: pod_status.Pending})
+	assert.Equal(t, "", recurringJobID(job))
+
+	job.Namespace = "ns"
+	job.PodGroup = &enginev2alpha2.PodGroup{ObjectMeta: metav1.ObjectMeta{
+		Name: "job-run-2", Namespace: "ns", Annotations: map[string]string{constants.RecurringJob: "nightly-train"},
+	}}
+	assert.Equal(t, "ns/nightly-train", recurringJobID(job))
+}
+
+func TestPlacedNodes(t *testing.T) {
+	job := podgroup_info.NewPodGroupInfo("job",
+		&pod_info.PodInfo{UID: "running", Job: "job", NodeName: "node-a", Status: pod_status.Running},
+		&pod_info.PodInfo{UID: "binding", Job: "job", NodeName: "node-b", Status: pod_status.Binding},
+		&pod_info.PodInfo{UID: "pending", Job: "job", Status: pod_status.Pending},
+	)
+	assert.Equal(t, map[string]bool{"node-a": true, "node-b": true}, placedNodes(job))
+}
+
+func TestValidateArguments(t *testing.T) {
+	assert.NoError(t, ValidateArguments(framework.PluginArguments{}))
+	assert.NoError(t, ValidateArguments(framework.PluginArguments{"ttl": "72h"}))
+	assert.Error(t, ValidateArguments(framework.PluginArguments{"ttl": "tomorrow"}))
+	assert.Error(t, ValidateArguments(framework.PluginArguments{"ttl": "0s"}))
+}
+
+func newNode(name, zone, rack string) *node_info.NodeInfo {
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   name,
+		Labels: map[string]string{zoneLabel: zone, rackLabel: rack},
+	}}
+	return &node_info.NodeInfo{Name: name, Node: node}
+}
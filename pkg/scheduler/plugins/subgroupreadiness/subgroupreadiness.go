@@ -0,0 +1,115 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package subgroupreadiness
+
+import (
+	"cmp"
+	"fmt"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const (
+	pluginName                 = "subgroupreadiness"
+	defaultCreationGracePeriod = 30 * time.Second
+)
+
+// readiness is how far the controller of a job got in creating its pods
+type readiness struct {
+	unreadySubGroups int
+	stillCreating    bool
+}
+
+// subGroupReadinessPlugin orders jobs whose subgroups are all created ahead of jobs whose controllers are still
+// creating their pods, so that capacity isn't reserved for half-created gangs whose remaining pods may never arrive.
+type subGroupReadinessPlugin struct {
+	creationGracePeriod time.Duration
+	now                 time.Time
+	readinessByJob      map[common_info.PodGroupID]readiness
+}
+
+func New(arguments framework.PluginArguments) framework.Plugin {
+	creationGracePeriod, err := arguments.GetDuration("creationGracePeriod", defaultCreationGracePeriod)
+	if err != nil || creationGracePeriod < 0 {
+		log.InfraLogger.Warningf(
+			"creationGracePeriod must be a non-negative duration, got %q. Using default value of %s",
+			arguments["creationGracePeriod"], defaultCreationGracePeriod)
+		creationGracePeriod = defaultCreationGracePeriod
+	}
+	return &subGroupReadinessPlugin{creationGracePeriod: creationGracePeriod}
+}
+
+// ValidateArguments rejects subgroupreadiness plugin arguments that can't be parsed
+func ValidateArguments(arguments framework.PluginArguments) error {
+	creationGracePeriod, err := arguments.GetDuration("creationGracePeriod", defaultCreationGracePeriod)
+	if err != nil {
+		return fmt.Errorf("invalid creationGracePeriod: %w", err)
+	}
+	if creationGracePeriod < 0 {
+		return fmt.Errorf("creationGracePeriod must not be negative, got %s", creationGracePeriod)
+	}
+	return nil
+}
+
+func (sp *subGroupReadinessPlugin) Name() string {
+	return pluginName
+}
+
+func (sp *subGroupReadinessPlugin) OnSessionOpen(ssn *framework.Session) {
+	sp.now = ssn.Clock().Now()
+	sp.readinessByJob = map[common_info.PodGroupID]readiness{}
+	ssn.AddJobOrderFn(sp.jobOrderFn)
+}
+
+func (sp *subGroupReadinessPlugin) OnSessionClose(_ *framework.Session) {
+	sp.readinessByJob = nil
+}
+
+// jobOrderFn orders jobs with fewer subgroups that are missing pods first, e.g. jobs whose leader and worker pods
+// exist ahead of jobs with only a leader, and then jobs whose controllers stopped creating pods ahead of jobs that
+// got new pods within the creation grace period
+func (sp *subGroupReadinessPlugin) jobOrderFn(l, r interface{}) int {
+	lReadiness := sp.getReadiness(l.(*podgroup_info.PodGroupInfo))
+	rReadiness := sp.getReadiness(r.(*podgroup_info.PodGroupInfo))
+
+	if result := cmp.Compare(lReadiness.unreadySubGroups, rReadiness.unreadySubGroups); result != 0 {
+		return result
+	}
+	if lReadiness.stillCreating != rReadiness.stillCreating {
+		if rReadiness.stillCreating {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+func (sp *subGroupReadinessPlugin) getReadiness(job *podgroup_info.PodGroupInfo) readiness {
+	if jobReadiness, found := sp.readinessByJob[job.UID]; found {
+		return jobReadiness
+	}
+
+	jobReadiness := readiness{}
+	for _, podSet := range job.GetSubGroups() {
+		if !podSet.IsReadyForScheduling() {
+			jobReadiness.unreadySubGroups++
+		}
+	}
+	// A job with a single pod can't be a half-created gang
+	if tasks := job.GetAllPodsMap(); len(tasks) > 1 {
+		for _, task := range tasks {
+			if task.Pod != nil && sp.now.Sub(task.Pod.CreationTimestamp.Time) < sp.creationGracePeriod {
+				jobReadiness.stillCreating = true
+				break
+			}
+		}
+	}
+
+	sp.readinessByJob[job.UID] = jobReadiness
+	return jobReadiness
+}
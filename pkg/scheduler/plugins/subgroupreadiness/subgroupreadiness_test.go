@@ -0,0 +1,156 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package subgroupreadiness
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info/subgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+type testJob struct {
+	leaders       int
+	workers       int
+	newestPodAge  time.Duration
+	singlePodJobs bool
+}
+
+func Test_jobOrderFn(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		arguments framework.PluginArguments
+		left      testJob
+		right     testJob
+		expected  int
+	}{
+		{
+			name:     "created jobs",
+			left:     testJob{leaders: 1, workers: 2, newestPodAge: time.Hour},
+			right:    testJob{leaders: 1, workers: 2, newestPodAge: time.Hour},
+			expected: 0,
+		},
+		{
+			name:     "job with created leader and workers first",
+			left:     testJob{leaders: 1, workers: 1, newestPodAge: time.Hour},
+			right:    testJob{leaders: 1, workers: 2, newestPodAge: time.Hour},
+			expected: 1,
+		},
+		{
+			name:     "job with a created leader before job without a leader",
+			left:     testJob{leaders: 1, workers: 1, newestPodAge: time.Hour},
+			right:    testJob{leaders: 0, workers: 1, newestPodAge: time.Hour},
+			expected: -1,
+		},
+		{
+			name:     "job that stopped getting pods first",
+			left:     testJob{leaders: 1, workers: 3, newestPodAge: 5 * time.Second},
+			right:    testJob{leaders: 1, workers: 2, newestPodAge: time.Minute},
+			expected: 1,
+		},
+		{
+			name:      "creation grace period argument",
+			arguments: framework.PluginArguments{"creationGracePeriod": "2m"},
+			left:      testJob{leaders: 1, workers: 3, newestPodAge: 5 * time.Second},
+			right:     testJob{leaders: 1, workers: 2, newestPodAge: time.Minute},
+			expected:  0,
+		},
+		{
+			name:     "single pod jobs are never still being created",
+			left:     testJob{singlePodJobs: true, newestPodAge: time.Second},
+			right:    testJob{singlePodJobs: true, newestPodAge: time.Hour},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := New(tt.arguments).(*subGroupReadinessPlugin)
+			plugin.now = now
+			plugin.readinessByJob = map[common_info.PodGroupID]readiness{}
+
+			left := newJob("left", tt.left, now)
+			right := newJob("right", tt.right, now)
+			assert.Equal(t, tt.expected, plugin.jobOrderFn(left, right))
+			assert.Equal(t, -tt.expected, plugin.jobOrderFn(right, left))
+		})
+	}
+}
+
+func TestValidateArguments(t *testing.T) {
+	tests := []struct {
+		name      string
+		arguments framework.PluginArguments
+		expectErr bool
+	}{
+		{name: "defaults", arguments: framework.PluginArguments{}},
+		{name: "valid creationGracePeriod", arguments: framework.PluginArguments{"creationGracePeriod": "1m"}},
+		{name: "disabled creationGracePeriod", arguments: framework.PluginArguments{"creationGracePeriod": "0s"}},
+		{name: "invalid creationGracePeriod", arguments: framework.PluginArguments{"creationGracePeriod": "soon"},
+			expectErr: true},
+		{name: "negative creationGracePeriod", arguments: framework.PluginArguments{"creationGracePeriod": "-1m"},
+			expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateArguments(tt.arguments)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func newJob(name string, job testJob, now time.Time) *podgroup_info.PodGroupInfo {
+	pgi := podgroup_info.NewPodGroupInfo(common_info.PodGroupID(name))
+	if job.singlePodJobs {
+		addPod(pgi, name, podgroup_info.DefaultSubGroup, 0, now.Add(-job.newestPodAge))
+		return pgi
+	}
+
+	pgi.PodSets = map[string]*subgroup_info.PodSet{
+		"leader":  subgroup_info.NewPodSet("leader", 1, nil),
+		"workers": subgroup_info.NewPodSet("workers", 2, nil),
+	}
+	for i := 0; i < job.leaders; i++ {
+		addPod(pgi, name, "leader", i, now.Add(-time.Hour))
+	}
+	// The last worker is the newest pod of the job
+	for i := 0; i < job.workers; i++ {
+		creationTime := now.Add(-time.Hour)
+		if i == job.workers-1 {
+			creationTime = now.Add(-job.newestPodAge)
+		}
+		addPod(pgi, name, "workers", i, creationTime)
+	}
+	return pgi
+}
+
+func addPod(pgi *podgroup_info.PodGroupInfo, jobName, subGroup string, index int, creationTime time.Time) {
+	name := fmt.Sprintf("%s-%s-%d", jobName, subGroup, index)
+	pgi.AddTaskInfo(&pod_info.PodInfo{
+		UID:          common_info.PodID(name),
+		Job:          pgi.UID,
+		Name:         name,
+		Namespace:    "ns",
+		SubGroupName: subGroup,
+		Status:       pod_status.Pending,
+		Pod:          &v1.Pod{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(creationTime)}},
+	})
+}
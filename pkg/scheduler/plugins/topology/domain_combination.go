@@ -0,0 +1,154 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+)
+
+// maxCombinationSearchSteps bounds the combinations visited by the search for every domain, to keep the scheduling
+// cycle short for large trees. The best combination found when the bound is reached is used.
+const maxCombinationSearchSteps = 10000
+
+// combinationSearch looks for the fewest preferred level domains that can allocate the job together, for jobs that
+// don't fit in a single preferred level domain. Among combinations of the same size, the one leaving the least free
+// capacity is chosen, for a tight packing.
+type combinationSearch struct {
+	candidates     []*DomainInfo
+	tasksResources *resource_info.Resource
+	tasksCount     int
+	byPods         bool
+
+	steps      int
+	best       []*DomainInfo
+	bestExcess float64
+	chosen     []*DomainInfo
+}
+
+// findDomainCombination returns the preferred level domains under the domain that can allocate the job together with
+// the fewest domains crossed, or nil if there are none or a single preferred level domain fits the job.
+func findDomainCombination(
+	domain *DomainInfo, preferredLevel DomainLevel, tasksResources *resource_info.Resource, tasksCount int,
+) []*DomainInfo {
+	if preferredLevel == "" || domain.Level == preferredLevel {
+		return nil
+	}
+	search := newCombinationSearch(getLevelDomains(domain, preferredLevel), tasksResources, tasksCount)
+	return search.run()
+}
+
+func newCombinationSearch(
+	domains []*DomainInfo, tasksResources *resource_info.Resource, tasksCount int,
+) *combinationSearch {
+	search := &combinationSearch{
+		tasksResources: tasksResources,
+		tasksCount:     tasksCount,
+		byPods:         true,
+	}
+	for _, domain := range domains {
+		if domain.AllocatablePods == allocatablePodsNotSet {
+			search.byPods = false
+		}
+	}
+	for _, domain := range domains {
+		if search.capacity(domain) > 0 {
+			search.candidates = append(search.candidates, domain)
+		}
+	}
+	// Trying the largest domains first finds the smallest combinations first, and makes the pruning effective. Like in
+	// the node scoring, domains of the same capacity that are later in the sorted tree are preferred.
+	slices.Reverse(search.candidates)
+	slices.SortStableFunc(search.candidates, func(i, j *DomainInfo) int {
+		return cmp.Compare(search.capacity(j), search.capacity(i))
+	})
+	return search
+}
+
+func (s *combinationSearch) run() []*DomainInfo {
+	if len(s.candidates) < 2 || s.fits(s.candidates[:1]) {
+		return nil
+	}
+	for size := 2; size <= len(s.candidates) && s.steps < maxCombinationSearchSteps; size++ {
+		s.search(0, size)
+		if s.best != nil {
+			return s.best
+		}
+	}
+	return nil
+}
+
+// search backtracks over the combinations of the given size that extend the chosen domains with candidates from the
+// given index on
+func (s *combinationSearch) search(from int, size int) {
+	s.steps++
+	if len(s.chosen) == size {
+		if excess, fits := s.excess(s.chosen); fits && (s.best == nil || excess < s.bestExcess) {
+			s.best = slices.Clone(s.chosen)
+			s.bestExcess = excess
+		}
+		return
+	}
+
+	missing := size - len(s.chosen)
+	for i := from; i <= len(s.candidates)-missing; i++ {
+		if s.steps >= maxCombinationSearchSteps {
+			return
+		}
+		// The candidates are sorted by capacity, so if the largest remaining ones don't fit, no others will
+		if s.byPods && !s.fits(append(slices.Clone(s.chosen), s.candidates[i:i+missing]...)) {
+			return
+		}
+		s.chosen = append(s.chosen, s.candidates[i])
+		s.search(i+1, size)
+		s.chosen = s.chosen[:len(s.chosen)-1]
+	}
+}
+
+func (s *combinationSearch) fits(domains []*DomainInfo) bool {
+	_, fits := s.excess(domains)
+	return fits
+}
+
+// excess returns how much capacity the domains have over the job, and whether they can allocate it. With pod
+// accounting, it is the number of pods, and otherwise the free share of the dominant resource.
+func (s *combinationSearch) excess(domains []*DomainInfo) (float64, bool) {
+	if s.byPods {
+		allocatablePods := 0
+		for _, domain := range domains {
+			allocatablePods += domain.AllocatablePods
+		}
+		return float64(allocatablePods - s.tasksCount), allocatablePods >= s.tasksCount
+	}
+
+	combined := NewDomainInfo("", "")
+	for _, domain := range domains {
+		combined.IdleOrReleasingResources.Add(domain.IdleOrReleasingResources)
+	}
+	ratio := getJobRatioToFreeResources(s.tasksResources, combined)
+	return 1 - ratio, ratio <= maxAllocatableTasksRatio
+}
+
+func (s *combinationSearch) capacity(domain *DomainInfo) float64 {
+	if s.byPods {
+		return float64(domain.AllocatablePods)
+	}
+	ratio := getJobRatioToFreeResources(s.tasksResources, domain)
+	if ratio == 0 {
+		return 0
+	}
+	return 1 / ratio
+}
+
+func domainCombinationKey(domains []*DomainInfo) string {
+	ids := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		ids = append(ids, string(domain.ID))
+	}
+	slices.Sort(ids)
+	return strings.Join(ids, ",")
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+)
+
+func TestFindDomainCombination(t *testing.T) {
+	tests := []struct {
+		name           string
+		racksPods      map[DomainID]int
+		racksGPUs      map[DomainID]float64
+		preferredLevel DomainLevel
+		tasksResources *resource_info.Resource
+		tasksCount     int
+		expectedIDs    []DomainID
+	}{
+		{
+			name:           "single rack fits the job",
+			racksPods:      map[DomainID]int{"rack1": 4, "rack2": 8},
+			preferredLevel: "rack",
+			tasksCount:     6,
+		},
+		{
+			name:       "no preferred level",
+			racksPods:  map[DomainID]int{"rack1": 4, "rack2": 4},
+			tasksCount: 6,
+		},
+		{
+			name:           "fewest racks are crossed",
+			racksPods:      map[DomainID]int{"rack1": 2, "rack2": 2, "rack3": 6, "rack4": 3},
+			preferredLevel: "rack",
+			tasksCount:     8,
+			expectedIDs:    []DomainID{"rack3", "rack2"},
+		},
+		{
+			name:           "tightest combination of the fewest racks",
+			racksPods:      map[DomainID]int{"rack1": 5, "rack2": 4, "rack3": 3},
+			preferredLevel: "rack",
+			tasksCount:     7,
+			expectedIDs:    []DomainID{"rack2", "rack3"},
+		},
+		{
+			name:           "backtracks to three racks",
+			racksPods:      map[DomainID]int{"rack1": 3, "rack2": 3, "rack3": 3, "rack4": 0},
+			preferredLevel: "rack",
+			tasksCount:     9,
+			expectedIDs:    []DomainID{"rack3", "rack2", "rack1"},
+		},
+		{
+			name:           "racks can't allocate the job together",
+			racksPods:      map[DomainID]int{"rack1": 3, "rack2": 3},
+			preferredLevel: "rack",
+			tasksCount:     7,
+		},
+		{
+			name:           "heterogeneous tasks by free resources",
+			racksGPUs:      map[DomainID]float64{"rack1": 4, "rack2": 2, "rack3": 3},
+			preferredLevel: "rack",
+			tasksResources: resource_info.NewResource(0, 0, 5),
+			expectedIDs:    []DomainID{"rack3", "rack2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zone := NewDomainInfo("zone1", "zone")
+			for id, pods := range tt.racksPods {
+				rack := NewDomainInfo(id, "rack")
+				rack.AllocatablePods = pods
+				zone.Children = append(zone.Children, rack)
+			}
+			for id, gpus := range tt.racksGPUs {
+				rack := NewDomainInfo(id, "rack")
+				rack.IdleOrReleasingResources = resource_info.NewResource(0, 0, gpus)
+				zone.Children = append(zone.Children, rack)
+			}
+			// The sorted tree order, in which later domains are preferred between domains of the same capacity
+			slices.SortFunc(zone.Children, func(i, j *DomainInfo) int { return cmp.Compare(i.ID, j.ID) })
+			tasksResources := tt.tasksResources
+			if tasksResources == nil {
+				tasksResources = resource_info.EmptyResource()
+			}
+
+			combination := findDomainCombination(zone, tt.preferredLevel, tasksResources, tt.tasksCount)
+			var ids []DomainID
+			for _, domain := range combination {
+				ids = append(ids, domain.ID)
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info/subgroup_info"
+)
+
+// nvlinkDomainLabel is the label set by GPU feature discovery on the nodes of a multi-node NVLink domain
+const nvlinkDomainLabel = "nvidia.com/gpu.clique"
+
+type nvlinkDomain struct {
+	id       string
+	nodes    node_info.NodeSet
+	freeGPUs float64
+}
+
+// gpuInterconnectSubsetNodesFn splits the node set into the NVLink domains of its nodes when the subgroup requires
+// its pods to share an NVLink domain. A node that isn't labeled with an NVLink domain is a domain of its own. Once
+// pods of the subgroup are allocated, only the domain of their nodes is returned.
+func (t *topologyPlugin) gpuInterconnectSubsetNodesFn(
+	job *podgroup_info.PodGroupInfo, subGroup *subgroup_info.SubGroupInfo, podSets map[string]*subgroup_info.PodSet,
+	tasks []*pod_info.PodInfo, nodeSet node_info.NodeSet,
+) ([]node_info.NodeSet, error) {
+	if subGroup == nil || subGroup.GetGPUInterconnect() != v2alpha2.NVLinkDomain {
+		return []node_info.NodeSet{nodeSet}, nil
+	}
+
+	allocatedDomains := t.getAllocatedNVLinkDomains(podSets)
+	if len(allocatedDomains) > 1 {
+		job.AddSimpleJobFitError(
+			podgroup_info.PodSchedulingErrors,
+			fmt.Sprintf("the pods of subgroup %s are already allocated on %d NVLink domains",
+				subGroup.GetName(), len(allocatedDomains)))
+		return []node_info.NodeSet{}, nil
+	}
+
+	domainsByID := map[string]*nvlinkDomain{}
+	var domains []*nvlinkDomain
+	for _, node := range nodeSet {
+		id := getNVLinkDomainID(node)
+		if len(allocatedDomains) > 0 && !allocatedDomains[id] {
+			continue
+		}
+		domain, found := domainsByID[id]
+		if !found {
+			domain = &nvlinkDomain{id: id}
+			domainsByID[id] = domain
+			domains = append(domains, domain)
+		}
+		domain.nodes = append(domain.nodes, node)
+		domain.freeGPUs += node.Idle.GPUs() + node.Releasing.GPUs()
+	}
+	if len(domains) == 0 {
+		job.AddSimpleJobFitError(
+			podgroup_info.PodSchedulingErrors,
+			fmt.Sprintf("the NVLink domain of the allocated pods of subgroup %s has no available nodes",
+				subGroup.GetName()))
+		return []node_info.NodeSet{}, nil
+	}
+
+	sortNVLinkDomains(domains, getTasksGPUs(tasks))
+	nodeSets := make([]node_info.NodeSet, 0, len(domains))
+	for _, domain := range domains {
+		nodeSets = append(nodeSets, domain.nodes)
+	}
+	return nodeSets, nil
+}
+
+// getAllocatedNVLinkDomains returns the NVLink domains of the nodes of the active pods of the subgroup
+func (t *topologyPlugin) getAllocatedNVLinkDomains(podSets map[string]*subgroup_info.PodSet) map[string]bool {
+	domains := map[string]bool{}
+	for _, podSet := range podSets {
+		for _, pod := range podSet.GetPodInfos() {
+			if !pod_status.IsActiveAllocatedStatus(pod.Status) || pod.NodeName == "" {
+				continue
+			}
+			node, found := t.session.ClusterInfo.Nodes[pod.NodeName]
+			if !found {
+				continue
+			}
+			domains[getNVLinkDomainID(node)] = true
+		}
+	}
+	return domains
+}
+
+func getNVLinkDomainID(node *node_info.NodeInfo) string {
+	if node.Node != nil {
+		if domain, found := node.Node.Labels[nvlinkDomainLabel]; found && domain != "" {
+			return domain
+		}
+	}
+	return node.Name
+}
+
+func getTasksGPUs(tasks []*pod_info.PodInfo) float64 {
+	gpus := 0.0
+	for _, task := range tasks {
+		gpus += task.ResReq.GPUs()
+	}
+	return gpus
+}
+
+// sortNVLinkDomains orders the domains that can fit the requested GPUs first, from the tightest fit, so that larger
+// domains remain available for larger subgroups. The domains that can't fit them follow, from the largest.
+func sortNVLinkDomains(domains []*nvlinkDomain, requestedGPUs float64) {
+	slices.SortStableFunc(domains, func(a, b *nvlinkDomain) int {
+		aFits, bFits := a.freeGPUs >= requestedGPUs, b.freeGPUs >= requestedGPUs
+		switch {
+		case aFits && !bFits:
+			return -1
+		case !aFits && bFits:
+			return 1
+		case aFits:
+			return cmp.Or(cmp.Compare(a.freeGPUs, b.freeGPUs), cmp.Compare(a.id, b.id))
+		default:
+			return cmp.Or(cmp.Compare(b.freeGPUs, a.freeGPUs), cmp.Compare(a.id, b.id))
+		}
+	})
+}
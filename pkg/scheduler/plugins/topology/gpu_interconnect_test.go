@@ -0,0 +1,133 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info/subgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+)
+
+func newGPUNodeInfo(name string, labels map[string]string, idleGPUs float64) *node_info.NodeInfo {
+	nodeInfo := newNodeInfo(name, labels)
+	nodeInfo.Idle = resource_info.NewResource(0, 0, idleGPUs)
+	nodeInfo.Releasing = resource_info.EmptyResource()
+	return nodeInfo
+}
+
+func TestTopologyPlugin_gpuInterconnectSubsetNodesFn(t *testing.T) {
+	nodes := map[string]*node_info.NodeInfo{
+		"node-1": newGPUNodeInfo("node-1", map[string]string{nvlinkDomainLabel: "clique-a"}, 4),
+		"node-2": newGPUNodeInfo("node-2", map[string]string{nvlinkDomainLabel: "clique-a"}, 4),
+		"node-3": newGPUNodeInfo("node-3", map[string]string{nvlinkDomainLabel: "clique-b"}, 4),
+		"node-4": newGPUNodeInfo("node-4", map[string]string{nvlinkDomainLabel: "clique-b"}, 8),
+		"node-5": newGPUNodeInfo("node-5", nil, 8),
+	}
+	allNodes := node_info.NodeSet{nodes["node-1"], nodes["node-2"], nodes["node-3"], nodes["node-4"], nodes["node-5"]}
+
+	tests := []struct {
+		name                string
+		gpuInterconnect     v2alpha2.GPUInterconnect
+		allocatedPodsNodes  []string
+		requestedGPUs       float64
+		nodeSet             node_info.NodeSet
+		expectedNodeSets    [][]string
+		expectFitErrorCount int
+	}{
+		{
+			name:             "no GPU interconnect requirement",
+			requestedGPUs:    8,
+			nodeSet:          allNodes,
+			expectedNodeSets: [][]string{{"node-1", "node-2", "node-3", "node-4", "node-5"}},
+		},
+		{
+			name:            "tightest fitting domain first",
+			gpuInterconnect: v2alpha2.NVLinkDomain,
+			requestedGPUs:   8,
+			nodeSet:         allNodes,
+			expectedNodeSets: [][]string{
+				{"node-1", "node-2"}, {"node-5"}, {"node-3", "node-4"},
+			},
+		},
+		{
+			name:            "domains that can't fit the request last",
+			gpuInterconnect: v2alpha2.NVLinkDomain,
+			requestedGPUs:   10,
+			nodeSet:         allNodes,
+			expectedNodeSets: [][]string{
+				{"node-3", "node-4"}, {"node-1", "node-2"}, {"node-5"},
+			},
+		},
+		{
+			name:               "domain of the allocated pods",
+			gpuInterconnect:    v2alpha2.NVLinkDomain,
+			allocatedPodsNodes: []string{"node-3"},
+			requestedGPUs:      4,
+			nodeSet:            allNodes,
+			expectedNodeSets:   [][]string{{"node-3", "node-4"}},
+		},
+		{
+			name:                "domain of the allocated pods isn't in the node set",
+			gpuInterconnect:     v2alpha2.NVLinkDomain,
+			allocatedPodsNodes:  []string{"node-5"},
+			requestedGPUs:       4,
+			nodeSet:             node_info.NodeSet{nodes["node-1"], nodes["node-2"]},
+			expectedNodeSets:    [][]string{},
+			expectFitErrorCount: 1,
+		},
+		{
+			name:                "allocated pods on different domains",
+			gpuInterconnect:     v2alpha2.NVLinkDomain,
+			allocatedPodsNodes:  []string{"node-1", "node-3"},
+			requestedGPUs:       4,
+			nodeSet:             allNodes,
+			expectedNodeSets:    [][]string{},
+			expectFitErrorCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := &topologyPlugin{session: &framework.Session{ClusterInfo: &api.ClusterInfo{Nodes: nodes}}}
+
+			podSet := subgroup_info.NewPodSet("shard", 1, nil)
+			podSet.SetGPUInterconnect(tt.gpuInterconnect)
+			for _, nodeName := range tt.allocatedPodsNodes {
+				podSet.AssignTask(&pod_info.PodInfo{
+					UID: common_info.PodID("running-" + nodeName), Status: pod_status.Running, NodeName: nodeName,
+				})
+			}
+			task := &pod_info.PodInfo{UID: "pending", Status: pod_status.Pending,
+				ResReq: resource_info.NewResourceRequirementsWithGpus(tt.requestedGPUs)}
+
+			job := &podgroup_info.PodGroupInfo{Name: "job"}
+			nodeSets, err := plugin.gpuInterconnectSubsetNodesFn(job, &podSet.SubGroupInfo,
+				map[string]*subgroup_info.PodSet{podSet.GetName(): podSet}, []*pod_info.PodInfo{task}, tt.nodeSet)
+			require.NoError(t, err)
+
+			nodeSetsNames := [][]string{}
+			for _, nodeSet := range nodeSets {
+				var nodeNames []string
+				for _, node := range nodeSet {
+					nodeNames = append(nodeNames, node.Name)
+				}
+				nodeSetsNames = append(nodeSetsNames, nodeNames)
+			}
+			assert.Equal(t, tt.expectedNodeSets, nodeSetsNames)
+			assert.Len(t, job.JobFitErrors, tt.expectFitErrorCount)
+		})
+	}
+}
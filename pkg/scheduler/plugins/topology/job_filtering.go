@@ -35,6 +35,11 @@ func (t *topologyPlugin) subSetNodesFn(
 	job *podgroup_info.PodGroupInfo, subGroup *subgroup_info.SubGroupInfo, podSets map[string]*subgroup_info.PodSet,
 	tasks []*pod_info.PodInfo, nodeSet node_info.NodeSet,
 ) ([]node_info.NodeSet, error) {
+	nodeSet, spreadable := t.filterSubGroupSpreadNodes(job, subGroup, podSets, nodeSet)
+	if !spreadable {
+		return []node_info.NodeSet{}, nil
+	}
+
 	topologyTree, found := t.getJobTopology(subGroup)
 	if !found {
 		job.AddSimpleJobFitError(
@@ -97,18 +102,32 @@ func (t *topologyPlugin) subSetNodesFn(
 	jobAllocatableDomains = sortDomainInfos(topologyTree, jobAllocatableDomains)
 
 	var domainNodeSets []node_info.NodeSet
+	triedCombinations := map[string]bool{}
 	for _, jobAllocatableDomain := range jobAllocatableDomains {
-		var domainNodeSet node_info.NodeSet
-		for _, node := range jobAllocatableDomain.Nodes {
+		// Before spreading the job over a domain above the preferred level, try the fewest preferred level domains in
+		// it that can allocate the job together
+		combination := findDomainCombination(jobAllocatableDomain, preferredLevel, tasksResources, tasksCount)
+		if key := domainCombinationKey(combination); len(combination) > 0 && !triedCombinations[key] {
+			triedCombinations[key] = true
+			domainNodeSets = append(domainNodeSets, getDomainsNodeSet(combination, validNodes))
+		}
+		domainNodeSets = append(domainNodeSets, getDomainsNodeSet([]*DomainInfo{jobAllocatableDomain}, validNodes))
+	}
+
+	return domainNodeSets, nil
+}
+
+func getDomainsNodeSet(domains []*DomainInfo, validNodes map[string]*node_info.NodeInfo) node_info.NodeSet {
+	var domainNodeSet node_info.NodeSet
+	for _, domain := range domains {
+		for _, node := range domain.Nodes {
 			if _, ok := validNodes[node.Name]; !ok {
 				continue
 			}
 			domainNodeSet = append(domainNodeSet, node)
 		}
-		domainNodeSets = append(domainNodeSets, domainNodeSet)
 	}
-
-	return domainNodeSets, nil
+	return domainNodeSet
 }
 
 func getTasksAllocationMetadata(tasks []*pod_info.PodInfo) (*resource_info.Resource, int) {
@@ -536,23 +555,29 @@ func sortDomainInfos(topologyTree *Info, domainInfos []*DomainInfo) []*DomainInf
 }
 
 // useRepresentorPodsAccounting checks if the tasks are using representor pods accounting.
-// If all the tasks are homogeneous, i.e. all the tasks have the same type of resource requirements, then use representor pods accounting (AllocatablePods).
-// If the tasks are heterogeneous, i.e. some of the tasks require resources that other tasks do not require,
-// then use the job resources sum to see if a domain can allocate the job.
+// If all the tasks are homogeneous, i.e. all the tasks have the same type of resource requirements and request the same
+// amount of GPUs, then use representor pods accounting (AllocatablePods).
+// If the tasks are heterogeneous, i.e. some of the tasks require resources that other tasks do not require or request a
+// different amount of GPUs, then use the job resources sum to see if a domain can allocate the job.
 func useRepresentorPodsAccounting(tasks []*pod_info.PodInfo) bool {
 	extendedResources := map[v1.ResourceName]int{}
 	podsUsingGpu := 0
+	gpuRequests := map[float64]bool{}
 	for _, task := range tasks {
 		for resourceName := range task.ResReq.BaseResource.ScalarResources() {
 			extendedResources[resourceName] += 1
 		}
 		if task.ResReq.GPUs() > 0 {
 			podsUsingGpu += 1
+			gpuRequests[task.ResReq.GPUs()] = true
 		}
 	}
 	if podsUsingGpu != len(tasks) && podsUsingGpu != 0 {
 		return false
 	}
+	if len(gpuRequests) > 1 {
+		return false
+	}
 	for _, count := range extendedResources {
 		if count != len(tasks) {
 			return false
@@ -2162,3 +2162,49 @@ func TestTopologyPlugin_getJobAllocatableDomains(t *testing.T) {
 		})
 	}
 }
+
+func TestUseRepresentorPodsAccounting(t *testing.T) {
+	newTask := func(gpus float64) *pod_info.PodInfo {
+		return &pod_info.PodInfo{
+			ResReq: resource_info.NewResourceRequirementsWithGpus(gpus),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		tasks    []*pod_info.PodInfo
+		expected bool
+	}{
+		{
+			name:     "no gpu tasks",
+			tasks:    []*pod_info.PodInfo{newTask(0), newTask(0)},
+			expected: true,
+		},
+		{
+			name:     "same gpu count",
+			tasks:    []*pod_info.PodInfo{newTask(4), newTask(4), newTask(4)},
+			expected: true,
+		},
+		{
+			name:     "mixed gpu and non-gpu tasks",
+			tasks:    []*pod_info.PodInfo{newTask(4), newTask(0)},
+			expected: false,
+		},
+		{
+			name:     "different gpu counts",
+			tasks:    []*pod_info.PodInfo{newTask(8), newTask(4), newTask(4), newTask(4), newTask(4)},
+			expected: false,
+		},
+		{
+			name:     "whole and fractional gpu",
+			tasks:    []*pod_info.PodInfo{newTask(1), newTask(0.5)},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, useRepresentorPodsAccounting(tt.tasks))
+		})
+	}
+}
@@ -31,7 +31,13 @@ func (t *topologyPlugin) nodeOrderFn(task *pod_info.PodInfo, node *node_info.Nod
 		return 0, fmt.Errorf("node %s not found in relevant node scores for sub-group %s", node.Name, taskSubGroupInfo.GetName())
 	}
 
-	return score, nil
+	return applyPreferredWeight(score, getPreferredWeight(taskSubGroupInfo)), nil
+}
+
+// applyPreferredWeight scales a node score by a preferred topology weight, keeping it a whole multiple of the
+// topology score
+func applyPreferredWeight(score float64, weight int32) float64 {
+	return math.Floor(score/scores.Topology*float64(weight)/100) * scores.Topology
 }
 
 func calculateNodeScores(domain *DomainInfo, preferredLevel DomainLevel) map[string]float64 {
@@ -52,6 +58,20 @@ func calculateNodeScores(domain *DomainInfo, preferredLevel DomainLevel) map[str
 	return nodeScores
 }
 
+// getPreferredWeight returns the preferred topology weight of the sub-group, inherited from its closest ancestor
+// that defines one
+func getPreferredWeight(sgi *subgroup_info.SubGroupInfo) int32 {
+	if weight := sgi.GetTopologyConstraint().GetPreferredWeight(); weight != nil {
+		return *weight
+	}
+
+	if sgi.GetParent() == nil {
+		return defaultPreferredWeight
+	}
+
+	return getPreferredWeight(&sgi.GetParent().SubGroupInfo)
+}
+
 func getLevelDomains(root *DomainInfo, level DomainLevel) []*DomainInfo {
 	if root.Level == level {
 		return []*DomainInfo{root}
@@ -7,12 +7,15 @@ import (
 	"testing"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info/subgroup_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/topology_info"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/plugins/scores"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestGetLevelDomains(t *testing.T) {
@@ -256,6 +259,76 @@ func TestCalculateNodeScores(t *testing.T) {
 	}
 }
 
+func TestApplyPreferredWeight(t *testing.T) {
+	tests := []struct {
+		name          string
+		score         float64
+		weight        int32
+		expectedScore float64
+	}{
+		{
+			name:          "full weight",
+			score:         7 * scores.Topology,
+			weight:        100,
+			expectedScore: 7 * scores.Topology,
+		},
+		{
+			name:          "half weight rounds down",
+			score:         7 * scores.Topology,
+			weight:        50,
+			expectedScore: 3 * scores.Topology,
+		},
+		{
+			name:          "zero weight",
+			score:         10 * scores.Topology,
+			weight:        0,
+			expectedScore: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedScore, applyPreferredWeight(tt.score, tt.weight))
+		})
+	}
+}
+
+func TestGetPreferredWeight(t *testing.T) {
+	tests := []struct {
+		name            string
+		rootConstraint  *topology_info.TopologyConstraintInfo
+		childConstraint *topology_info.TopologyConstraintInfo
+		expectedWeight  int32
+	}{
+		{
+			name:           "no weight defined",
+			expectedWeight: defaultPreferredWeight,
+		},
+		{
+			name:            "weight defined on the sub-group",
+			rootConstraint:  &topology_info.TopologyConstraintInfo{PreferredWeight: ptr.To(int32(80))},
+			childConstraint: &topology_info.TopologyConstraintInfo{PreferredWeight: ptr.To(int32(0))},
+			expectedWeight:  0,
+		},
+		{
+			name:            "weight inherited from the parent",
+			rootConstraint:  &topology_info.TopologyConstraintInfo{PreferredWeight: ptr.To(int32(80))},
+			childConstraint: &topology_info.TopologyConstraintInfo{PreferredLevel: "rack"},
+			expectedWeight:  80,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := subgroup_info.NewSubGroupSet(subgroup_info.RootSubGroupSetName, tt.rootConstraint)
+			child := subgroup_info.NewPodSet("workers", 1, tt.childConstraint)
+			root.AddPodSet(child)
+
+			assert.Equal(t, tt.expectedWeight, getPreferredWeight(&child.SubGroupInfo))
+		})
+	}
+}
+
 func TestSortTree(t *testing.T) {
 	tests := []struct {
 		name           string
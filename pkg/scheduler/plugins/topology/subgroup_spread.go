@@ -0,0 +1,93 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info/subgroup_info"
+)
+
+// filterSubGroupSpreadNodes removes from the node set the domains, at the spread level of the parent subgroup,
+// that are already used by the active pods of the sibling subgroups. It returns false if no node is left for the
+// subgroup.
+func (t *topologyPlugin) filterSubGroupSpreadNodes(
+	job *podgroup_info.PodGroupInfo, subGroup *subgroup_info.SubGroupInfo, podSets map[string]*subgroup_info.PodSet,
+	nodeSet node_info.NodeSet,
+) (node_info.NodeSet, bool) {
+	parent := subGroup.GetParent()
+	if parent == nil {
+		return nodeSet, true
+	}
+	parentConstraint := parent.GetTopologyConstraint()
+	if parentConstraint == nil || parentConstraint.SubGroupSpreadLevel == "" {
+		return nodeSet, true
+	}
+	topologyTree, found := t.TopologyTrees[parentConstraint.Topology]
+	if !found {
+		job.AddSimpleJobFitError(
+			podgroup_info.PodSchedulingErrors,
+			fmt.Sprintf("Requested topology %s does not exist", parentConstraint.Topology))
+		return nil, false
+	}
+
+	spreadLevel := DomainLevel(parentConstraint.SubGroupSpreadLevel)
+	siblingNodes := getSiblingsNodes(parent, podSets)
+	takenNodes := map[string]bool{}
+	for _, domain := range topologyTree.DomainsByLevel[spreadLevel] {
+		if !isDomainUsedByNodes(domain, siblingNodes) {
+			continue
+		}
+		for nodeName := range domain.Nodes {
+			takenNodes[nodeName] = true
+		}
+	}
+	if len(takenNodes) == 0 {
+		return nodeSet, true
+	}
+
+	filteredNodeSet := node_info.NodeSet{}
+	for _, node := range nodeSet {
+		if !takenNodes[node.Name] {
+			filteredNodeSet = append(filteredNodeSet, node)
+		}
+	}
+	if len(filteredNodeSet) == 0 {
+		job.AddSimpleJobFitError(
+			podgroup_info.PodSchedulingErrors,
+			fmt.Sprintf("no %s domain of topology %s is left for subgroup %s after spreading its sibling subgroups",
+				spreadLevel, topologyTree.Name, subGroup.GetName()))
+		return nil, false
+	}
+	return filteredNodeSet, true
+}
+
+// getSiblingsNodes returns the nodes of the active pods of all the parent's pod sets that do not belong to the
+// current subgroup.
+func getSiblingsNodes(parent *subgroup_info.SubGroupSet, podSets map[string]*subgroup_info.PodSet) map[string]bool {
+	siblingNodes := map[string]bool{}
+	for name, podSet := range parent.GetAllPodSets() {
+		if _, isOwnPodSet := podSets[name]; isOwnPodSet {
+			continue
+		}
+		for _, pod := range podSet.GetPodInfos() {
+			if pod_status.IsActiveAllocatedStatus(pod.Status) && pod.NodeName != "" {
+				siblingNodes[pod.NodeName] = true
+			}
+		}
+	}
+	return siblingNodes
+}
+
+func isDomainUsedByNodes(domain *DomainInfo, nodeNames map[string]bool) bool {
+	for nodeName := range nodeNames {
+		if _, found := domain.Nodes[nodeName]; found {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info/subgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/topology_info"
+)
+
+const (
+	spreadTestTopology = "cluster-topology"
+	zoneLabel          = "topology/zone"
+	rackLabel          = "topology/rack"
+)
+
+func TestTopologyPlugin_filterSubGroupSpreadNodes(t *testing.T) {
+	nodes := map[string]*node_info.NodeInfo{
+		"node-1": newNodeInfo("node-1", map[string]string{zoneLabel: "zone-a", rackLabel: "rack-1"}),
+		"node-2": newNodeInfo("node-2", map[string]string{zoneLabel: "zone-a", rackLabel: "rack-2"}),
+		"node-3": newNodeInfo("node-3", map[string]string{zoneLabel: "zone-b", rackLabel: "rack-3"}),
+		"node-4": newNodeInfo("node-4", map[string]string{zoneLabel: "zone-c", rackLabel: "rack-4"}),
+	}
+	allNodes := node_info.NodeSet{nodes["node-1"], nodes["node-2"], nodes["node-3"], nodes["node-4"]}
+
+	tests := []struct {
+		name                string
+		spreadLevel         string
+		spreadTopology      string
+		siblingPodsNodes    map[pod_status.PodStatus]string
+		nodeSet             node_info.NodeSet
+		expectedNodes       []string
+		expectedSpreadable  bool
+		expectFitErrorCount int
+	}{
+		{
+			name:               "no spread constraint",
+			spreadTopology:     spreadTestTopology,
+			siblingPodsNodes:   map[pod_status.PodStatus]string{pod_status.Allocated: "node-1"},
+			nodeSet:            allNodes,
+			expectedNodes:      []string{"node-1", "node-2", "node-3", "node-4"},
+			expectedSpreadable: true,
+		},
+		{
+			name:               "siblings without active pods",
+			spreadLevel:        zoneLabel,
+			spreadTopology:     spreadTestTopology,
+			siblingPodsNodes:   map[pod_status.PodStatus]string{pod_status.Pending: ""},
+			nodeSet:            allNodes,
+			expectedNodes:      []string{"node-1", "node-2", "node-3", "node-4"},
+			expectedSpreadable: true,
+		},
+		{
+			name:               "filter the zones of allocated and running siblings",
+			spreadLevel:        zoneLabel,
+			spreadTopology:     spreadTestTopology,
+			siblingPodsNodes:   map[pod_status.PodStatus]string{pod_status.Allocated: "node-2", pod_status.Running: "node-3"},
+			nodeSet:            allNodes,
+			expectedNodes:      []string{"node-4"},
+			expectedSpreadable: true,
+		},
+		{
+			name:               "filter only the rack of the sibling",
+			spreadLevel:        rackLabel,
+			spreadTopology:     spreadTestTopology,
+			siblingPodsNodes:   map[pod_status.PodStatus]string{pod_status.Allocated: "node-2"},
+			nodeSet:            allNodes,
+			expectedNodes:      []string{"node-1", "node-3", "node-4"},
+			expectedSpreadable: true,
+		},
+		{
+			name:                "no domain left",
+			spreadLevel:         zoneLabel,
+			spreadTopology:      spreadTestTopology,
+			siblingPodsNodes:    map[pod_status.PodStatus]string{pod_status.Allocated: "node-1"},
+			nodeSet:             node_info.NodeSet{nodes["node-1"], nodes["node-2"]},
+			expectedSpreadable:  false,
+			expectFitErrorCount: 1,
+		},
+		{
+			name:                "missing topology",
+			spreadLevel:         zoneLabel,
+			spreadTopology:      "missing-topology",
+			siblingPodsNodes:    map[pod_status.PodStatus]string{pod_status.Allocated: "node-1"},
+			nodeSet:             allNodes,
+			expectedSpreadable:  false,
+			expectFitErrorCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := &topologyPlugin{TopologyTrees: map[topologyName]*Info{}}
+			plugin.initializeTopologyTree([]*kaiv1alpha1.Topology{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: spreadTestTopology},
+					Spec: kaiv1alpha1.TopologySpec{
+						Levels: []kaiv1alpha1.TopologyLevel{{NodeLabel: zoneLabel}, {NodeLabel: rackLabel}},
+					},
+				},
+			}, nodes)
+
+			root := subgroup_info.NewSubGroupSet(subgroup_info.RootSubGroupSetName, &topology_info.TopologyConstraintInfo{
+				Topology:            tt.spreadTopology,
+				SubGroupSpreadLevel: tt.spreadLevel,
+			})
+			sibling := subgroup_info.NewPodSet("replica-0", 1, nil)
+			for status, nodeName := range tt.siblingPodsNodes {
+				sibling.AssignTask(&pod_info.PodInfo{UID: common_info.PodID(fmt.Sprintf("sibling-%v", status)), Status: status, NodeName: nodeName})
+			}
+			current := subgroup_info.NewPodSet("replica-1", 1, nil)
+			root.AddPodSet(sibling)
+			root.AddPodSet(current)
+
+			job := &podgroup_info.PodGroupInfo{Name: "job", RootSubGroupSet: root}
+			nodeSet, spreadable := plugin.filterSubGroupSpreadNodes(job, &current.SubGroupInfo,
+				map[string]*subgroup_info.PodSet{current.GetName(): current}, tt.nodeSet)
+
+			assert.Equal(t, tt.expectedSpreadable, spreadable)
+			var nodeNames []string
+			for _, node := range nodeSet {
+				nodeNames = append(nodeNames, node.Name)
+			}
+			assert.Equal(t, tt.expectedNodes, nodeNames)
+			assert.Len(t, job.JobFitErrors, tt.expectFitErrorCount)
+		})
+	}
+}
@@ -15,6 +15,8 @@ const (
 	topologyPluginName = "topology"
 	rootLevel          = "root"
 	rootDomainId       = rootLevel
+
+	defaultPreferredWeight = 100
 )
 
 type topologyName = string
@@ -44,6 +46,7 @@ func (t *topologyPlugin) OnSessionOpen(ssn *framework.Session) {
 	t.session = ssn
 	t.initializeTopologyTree(ssn.ClusterInfo.Topologies, ssn.ClusterInfo.Nodes)
 
+	ssn.AddSubsetNodesFn(t.gpuInterconnectSubsetNodesFn)
 	ssn.AddSubsetNodesFn(t.subSetNodesFn)
 	ssn.AddNodeOrderFn(t.nodeOrderFn)
 	ssn.AddPreJobAllocationFn(t.preJobAllocationFn)
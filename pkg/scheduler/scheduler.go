@@ -20,17 +20,27 @@ limitations under the License.
 package scheduler
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
 	"math/rand"
 	"net/http"
+	"slices"
+	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
 	kubeaischedulerver "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/clientset/versioned"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/tracing"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
 	schedcache "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache/usagedb"
 	api "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache/usagedb/api"
@@ -40,6 +50,9 @@ import (
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/shadow"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/stats"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/triggers"
 )
 
 type Scheduler struct {
@@ -48,6 +61,27 @@ type Scheduler struct {
 	schedulerParams *conf.SchedulerParams
 	schedulePeriod  time.Duration
 	mux             *http.ServeMux
+
+	// actionLastRun is the start time of the last run of every action that has a period. It is only accessed by
+	// the scheduling cycles, which never run concurrently.
+	actionLastRun map[framework.ActionType]time.Time
+	// shadowActionLastRun is the start time of the last run of every action of the shadow sessions that has a period
+	shadowActionLastRun map[framework.ActionType]time.Time
+	// triggers watches for the events that trigger micro-cycles, nil if no event triggers are configured
+	triggers *triggers.Triggers
+	// lastDryRunReport holds the decisions of the last scheduling cycle in dry-run mode
+	lastDryRunReport atomic.Pointer[shadow.DryRunReport]
+	// stats records the scheduling state of the cycles for the stats endpoint, nil if it isn't enabled
+	stats *stats.Recorder
+	// preemptionHistory holds the evictions for the queues with a preemption limit across the scheduling cycles
+	preemptionHistory *queue_info.PreemptionHistory
+	// pluginStates holds the state of plugins across the scheduling cycles
+	pluginStates *framework.PluginStates
+
+	running     atomic.Bool
+	stopCh      <-chan struct{}
+	cacheStopCh chan struct{}
+	cyclesDone  chan struct{}
 }
 
 func NewScheduler(
@@ -87,6 +121,7 @@ func NewScheduler(
 		NumOfStatusRecordingWorkers: schedulerParams.NumOfStatusRecordingWorkers,
 		UpdatePodEvictionCondition:  schedulerParams.UpdatePodEvictionCondition,
 		DiscoveryClient:             discoveryClient,
+		Clock:                       schedulerParams.Clock,
 	}
 
 	scheduler := &Scheduler{
@@ -95,20 +130,122 @@ func NewScheduler(
 		cache:           schedcache.New(schedulerCacheParams),
 		schedulePeriod:  schedulerParams.SchedulePeriod,
 		mux:             mux,
+		cacheStopCh:     make(chan struct{}),
+		cyclesDone:      make(chan struct{}),
+		actionLastRun:   map[framework.ActionType]time.Time{},
+
+		shadowActionLastRun: map[framework.ActionType]time.Time{},
+		preemptionHistory:   queue_info.NewPreemptionHistory(),
+		pluginStates:        framework.NewPluginStates(),
+	}
+
+	if schedulerParams.DryRun {
+		log.InfraLogger.V(1).Infof("Running in dry-run mode, the decisions of the scheduling cycles are not executed")
+		if mux != nil {
+			mux.HandleFunc("/dry-run", scheduler.serveDryRunReport)
+		}
+	}
+
+	if schedulerParams.StatsEndpoint {
+		scheduler.stats = stats.NewRecorder(nodePoolName(schedulerParams), schedulerParams.DryRun)
+		if mux != nil {
+			mux.Handle("/stats", scheduler.stats)
+		}
+	}
+
+	if schedulerConf.EventTriggers != nil {
+		scheduler.triggers, err = triggers.New(schedulerConf.EventTriggers, schedulerParams.SchedulerName,
+			schedulerParams.PartitionParams)
+		if err != nil {
+			return nil, fmt.Errorf("error creating event triggers: %v", err)
+		}
+		err = scheduler.triggers.Register(scheduler.cache.KubeInformerFactory(),
+			scheduler.cache.KAISchedulerInformerFactory())
+		if err != nil {
+			return nil, fmt.Errorf("error registering event triggers: %v", err)
+		}
 	}
 
 	return scheduler, nil
 }
 
+// Run starts the scheduling cycles, which stop once stopCh is closed. The cache is kept running until Shutdown
+// is called, so that a cycle that is still running can finish committing its statements.
 func (s *Scheduler) Run(stopCh <-chan struct{}) {
-	s.cache.Run(stopCh)
+	s.running.Store(true)
+	s.stopCh = stopCh
+	s.cache.Run(s.cacheStopCh)
 	s.cache.WaitForCacheSync(stopCh)
 
 	go func() {
-		wait.Until(s.runOnce, s.schedulePeriod, stopCh)
+		defer close(s.cyclesDone)
+		if s.triggers == nil {
+			wait.Until(s.runOnce, s.schedulePeriod, stopCh)
+			return
+		}
+		s.runCycles(stopCh)
 	}()
 }
 
+// runCycles runs a scheduling cycle every schedule period, and micro-cycles for the events of the triggers in between,
+// until stopCh is closed. The events triggered until a cycle starts are covered by its snapshot, and events triggered
+// within the minimal interval of the previous cycle are merged into a single micro-cycle.
+func (s *Scheduler) runCycles(stopCh <-chan struct{}) {
+	nextCycle := time.NewTimer(0)
+	defer nextCycle.Stop()
+	var microCycle <-chan time.Time
+	var lastCycleStart time.Time
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-nextCycle.C:
+			s.triggers.Take()
+			microCycle = nil
+			lastCycleStart = time.Now()
+			s.runOnce()
+			nextCycle.Reset(s.schedulePeriod)
+		case <-s.triggers.Triggered():
+			if microCycle == nil {
+				wait := s.config.EventTriggers.MinInterval.Duration - time.Since(lastCycleStart)
+				microCycle = time.After(max(wait, 0))
+			}
+		case <-microCycle:
+			microCycle = nil
+			if scope := s.triggers.Take(); !scope.IsEmpty() {
+				lastCycleStart = time.Now()
+				s.runMicroCycle(scope)
+			}
+		}
+	}
+}
+
+// Shutdown waits up to the given timeout for the running scheduling cycle to finish, and then stops the cache.
+// It should be called after the stop channel given to Run was closed.
+func (s *Scheduler) Shutdown(timeout time.Duration) error {
+	defer close(s.cacheStopCh)
+	if !s.running.Load() {
+		return nil
+	}
+
+	select {
+	case <-s.cyclesDone:
+		log.InfraLogger.V(1).Infof("Scheduling cycles stopped")
+		return nil
+	case <-time.After(timeout):
+		return fmt.Errorf("timed out after %v waiting for the scheduling cycle to finish", timeout)
+	}
+}
+
+func (s *Scheduler) isStopping() bool {
+	select {
+	case <-s.stopCh:
+		return true
+	default:
+		return false
+	}
+}
+
 func (s *Scheduler) runOnce() {
 	sessionId := generateSessionID(6)
 	log.InfraLogger.SetSessionID(string(sessionId))
@@ -119,24 +256,232 @@ func (s *Scheduler) runOnce() {
 
 	defer metrics.UpdateE2eDuration(scheduleStartTime)
 
-	ssn, err := framework.OpenSession(s.cache, s.config, s.schedulerParams, sessionId, s.mux)
+	ctx, span := tracing.Tracer().Start(context.Background(), "scheduler.Cycle",
+		trace.WithAttributes(attribute.String("session", sessionId)))
+	defer span.End()
+
+	if s.schedulerParams.DryRun {
+		s.runDryRunSession(ctx, sessionId, s.config, s.actionLastRun, nil)
+		return
+	}
+
+	cache := s.cache
+	var primaryDecisions, shadowDecisions *shadow.Decisions
+	if s.config.Shadow != nil && !s.isStopping() {
+		shadowDecisions = s.runShadowSession(ctx, sessionId)
+		if shadowDecisions != nil {
+			primaryDecisions = shadow.NewDecisions()
+			cache = shadow.NewRecordingCache(s.cache, primaryDecisions)
+		}
+	}
+
+	cycle := s.stats.StartCycle()
+	ssn, err := framework.OpenSession(ctx, cycle.RecordingCache(cache), s.config, s.schedulerParams, sessionId, s.mux,
+		s.sessionOptions(false))
 	if err != nil {
 		log.InfraLogger.Errorf("Error while opening session, will try again next cycle. \nCause: %+v", err)
 		return
 	}
 	defer framework.CloseSession(ssn)
+	ssn.SetPreemptionHistory(s.preemptionHistory)
+
+	s.runActions(ctx, ssn, s.config, s.actionLastRun, cycle)
+	ssn.PostActions()
+	s.stats.Finish(cycle, sessionId, ssn.Clock().Now(), ssn.ClusterInfo)
+
+	if shadowDecisions != nil {
+		shadow.Compare(primaryDecisions, shadowDecisions).Report(s.config.Shadow.Name)
+	}
+}
+
+// runMicroCycle runs the allocate action for the jobs of the queues in the scope of the triggered events. Micro-cycles
+// aren't limited by the period of the allocate action, and don't evaluate the shadow configuration.
+func (s *Scheduler) runMicroCycle(scope triggers.Scope) {
+	sessionId := generateSessionID(6)
+	log.InfraLogger.SetSessionID(sessionId)
+
+	triggered := slices.Sorted(maps.Keys(scope.Triggers))
+	log.InfraLogger.V(1).Infof("Start micro-cycle of %v ...", triggered)
+	startTime := time.Now()
+	defer log.InfraLogger.V(1).Infof("End micro-cycle ...")
+	defer metrics.RecordMicroCycle(triggered, startTime)
+
+	ctx, span := tracing.Tracer().Start(context.Background(), "scheduler.MicroCycle",
+		trace.WithAttributes(attribute.String("session", sessionId), attribute.StringSlice("triggers", triggered)))
+	defer span.End()
+
+	microCycleConfig := *s.config
+	microCycleConfig.Actions = string(framework.Allocate)
+	if s.schedulerParams.DryRun {
+		s.runDryRunSession(ctx, sessionId, &microCycleConfig, map[framework.ActionType]time.Time{}, &scope)
+		return
+	}
+
+	ssn, err := framework.OpenSession(ctx, s.cache, s.config, s.schedulerParams, sessionId, s.mux,
+		s.sessionOptions(true))
+	if err != nil {
+		log.InfraLogger.Errorf("Error while opening the session of a micro-cycle, its events are left to the next "+
+			"cycle. \nCause: %+v", err)
+		return
+	}
+	defer framework.CloseSession(ssn)
+	ssn.SetPreemptionHistory(s.preemptionHistory)
+	if !scope.AllQueues {
+		ssn.LimitToQueues(scope.QueueIDs())
+	}
+
+	s.runActions(ctx, ssn, &microCycleConfig, map[framework.ActionType]time.Time{}, nil)
+	ssn.PostActions()
+}
+
+// runDryRunSession runs the actions of the configuration in a session whose binds and evictions are recorded instead
+// of executed, and whose plugins don't change the cluster, and reports its decisions. A micro-cycle session is limited
+// to the queues of its scope, and isn't recorded in the scheduling stats.
+func (s *Scheduler) runDryRunSession(ctx context.Context, sessionId string, config *conf.SchedulerConfiguration,
+	actionLastRun map[framework.ActionType]time.Time, scope *triggers.Scope) {
+	var cycle *stats.Cycle
+	if scope == nil {
+		cycle = s.stats.StartCycle()
+	}
+	decisions := shadow.NewDecisions()
+	ssn, err := framework.OpenShadowSession(ctx, cycle.RecordingCache(shadow.NewDryRunCache(s.cache, decisions)),
+		config, s.schedulerParams, sessionId, s.sessionOptions(scope != nil))
+	if err != nil {
+		log.InfraLogger.Errorf("Error while opening the dry-run session, will try again next cycle. \nCause: %+v", err)
+		return
+	}
+	defer framework.CloseSession(ssn)
+	ssn.SetPreemptionHistory(s.preemptionHistory)
+	if scope != nil && !scope.AllQueues {
+		ssn.LimitToQueues(scope.QueueIDs())
+	}
+
+	s.runActions(ctx, ssn, config, actionLastRun, cycle)
+	ssn.PostActions()
+	s.stats.Finish(cycle, sessionId, ssn.Clock().Now(), ssn.ClusterInfo)
+
+	report := shadow.NewDryRunReport(sessionId, ssn.Clock().Now(), decisions, ssn.ClusterInfo.PodGroupInfos)
+	report.Report()
+	s.lastDryRunReport.Store(report)
+}
+
+// serveDryRunReport serves the decisions of the last scheduling cycle in dry-run mode as JSON
+func (s *Scheduler) serveDryRunReport(w http.ResponseWriter, _ *http.Request) {
+	report := s.lastDryRunReport.Load()
+	if report == nil {
+		http.Error(w, "no scheduling cycle ran yet", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		log.InfraLogger.Errorf("Failed to write the dry-run report: %v", err)
+	}
+}
+
+// runShadowSession evaluates the shadow configuration on a snapshot of the cluster, before the primary session takes
+// its own snapshot, so that both sessions decide on the same state. The decisions of the shadow session are recorded
+// and not executed, and the scheduling metrics are muted while it runs. Returns nil if the session failed to open.
+func (s *Scheduler) runShadowSession(ctx context.Context, sessionId string) *shadow.Decisions {
+	shadowName := s.config.Shadow.Name
+	startTime := time.Now()
+	defer metrics.UpdateShadowEvaluationDuration(shadowName, startTime)
+	resumeMetrics := metrics.SuspendUpdates()
+	defer resumeMetrics()
+
+	ctx, span := tracing.Tracer().Start(ctx, "scheduler.ShadowSession",
+		trace.WithAttributes(attribute.String("shadow", shadowName)))
+	defer span.End()
+
+	shadowSessionId := sessionId + "-shadow"
+	log.InfraLogger.SetSessionID(shadowSessionId)
+	defer log.InfraLogger.SetSessionID(sessionId)
+
+	decisions := shadow.NewDecisions()
+	shadowConfig := s.config.ShadowSchedulerConfiguration()
+	ssn, err := framework.OpenShadowSession(ctx, shadow.NewDryRunCache(s.cache, decisions), shadowConfig,
+		s.schedulerParams, shadowSessionId, s.sessionOptions(false))
+	if err != nil {
+		log.InfraLogger.Errorf("Error while opening the session of shadow configuration %s, skipping its evaluation "+
+			"this cycle. \nCause: %+v", shadowName, err)
+		return nil
+	}
+	defer framework.CloseSession(ssn)
+	ssn.SetPreemptionHistory(s.preemptionHistory)
+
+	s.runActions(ctx, ssn, shadowConfig, s.shadowActionLastRun, nil)
+	ssn.PostActions()
+	return decisions
+}
+
+// sessionOptions returns the state of the scheduler that is passed to the sessions it opens
+func (s *Scheduler) sessionOptions(microCycle bool) framework.SessionOptions {
+	return framework.SessionOptions{
+		PluginStates: s.pluginStates,
+		MicroCycle:   microCycle,
+	}
+}
 
-	actions, _ := conf_util.GetActionsFromConfig(s.config)
+// runActions runs the due actions of the configuration in the session, and records them in the cycle of the scheduling
+// stats unless it is nil
+func (s *Scheduler) runActions(ctx context.Context, ssn *framework.Session, config *conf.SchedulerConfiguration,
+	actionLastRun map[framework.ActionType]time.Time, cycle *stats.Cycle) {
+	actions, _ := conf_util.GetActionsFromConfig(config)
 	for _, action := range actions {
+		// Statements are committed by the action that created them, so stopping between actions leaves no
+		// gang partially bound
+		if s.isStopping() {
+			log.InfraLogger.V(1).Infof("Scheduler is shutting down, skipping the remaining actions of the cycle")
+			break
+		}
+		actionStartTime := time.Now()
+		now := ssn.Clock().Now()
+		if !isActionDue(config, actionLastRun, action.Name(), now) {
+			log.InfraLogger.V(4).Infof("Skipping action %s, its period since the last run has not passed",
+				action.Name())
+			continue
+		}
+		if freeze := ssn.SchedulingFreezeOf(action.Name()); freeze != nil {
+			log.InfraLogger.V(1).Infof("Skipping action %s, scheduling is frozen by %s: %s",
+				action.Name(), freeze.Name, freeze.Spec.Reason)
+			continue
+		}
+		actionLastRun[action.Name()] = now
 		log.InfraLogger.SetAction(string(action.Name()))
 		metrics.SetCurrentAction(string(action.Name()))
-		actionStartTime := time.Now()
+		actionCtx, actionSpan := tracing.Tracer().Start(ctx, "action.Execute",
+			trace.WithAttributes(attribute.String("action", string(action.Name()))))
+		ssn.SetContext(actionCtx)
+		cycle.StartAction(string(action.Name()))
 		action.Execute(ssn)
+		actionSpan.End()
+		cycle.EndAction(metrics.Duration(actionStartTime))
 		metrics.UpdateActionDuration(string(action.Name()), metrics.Duration(actionStartTime))
 	}
+	ssn.SetContext(ctx)
 	log.InfraLogger.RemoveActionLogger()
 }
 
+// isActionDue returns whether the action should run in a cycle that starts at now, by the period of the action in
+// the configuration and the last time the action ran. Actions without a configured period are always due.
+func isActionDue(config *conf.SchedulerConfiguration, actionLastRun map[framework.ActionType]time.Time,
+	actionName framework.ActionType, now time.Time,
+) bool {
+	period, found := config.ActionPeriods[string(actionName)]
+	if !found || period.Duration <= 0 {
+		return true
+	}
+	lastRun, ran := actionLastRun[actionName]
+	return !ran || now.Sub(lastRun) >= period.Duration
+}
+
+// nodePoolName returns the name of the node pool that the scheduler schedules
+func nodePoolName(schedulerParams *conf.SchedulerParams) string {
+	if schedulerParams.PartitionParams == nil || schedulerParams.PartitionParams.NodePoolLabelValue == "" {
+		return commonconstants.DefaultNodePoolName
+	}
+	return schedulerParams.PartitionParams.NodePoolLabelValue
+}
+
 func newClients(config *rest.Config) (kubernetes.Interface, kubeaischedulerver.Interface) {
 	k8cClientConfig := rest.CopyConfig(config)
 
@@ -0,0 +1,173 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	schedcache "github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/triggers"
+)
+
+func newTestScheduler(cache schedcache.Cache) *Scheduler {
+	return &Scheduler{
+		cache:          cache,
+		schedulePeriod: time.Hour,
+		cacheStopCh:    make(chan struct{}),
+		cyclesDone:     make(chan struct{}),
+		config:         &conf.SchedulerConfiguration{},
+		actionLastRun:  map[framework.ActionType]time.Time{},
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cache := schedcache.NewMockCache(ctrl)
+
+	var cacheStopCh <-chan struct{}
+	cache.EXPECT().Run(gomock.Any()).Do(func(stopCh <-chan struct{}) { cacheStopCh = stopCh })
+	cache.EXPECT().WaitForCacheSync(gomock.Any())
+
+	s := newTestScheduler(cache)
+	stopCh := make(chan struct{})
+	close(stopCh)
+	s.Run(stopCh)
+
+	assert.NoError(t, s.Shutdown(time.Second))
+	assert.True(t, s.isStopping())
+	select {
+	case <-cacheStopCh:
+	default:
+		t.Fatal("expected the cache to be stopped")
+	}
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	s := newTestScheduler(nil)
+	s.running.Store(true)
+
+	assert.Error(t, s.Shutdown(time.Millisecond))
+}
+
+func TestShutdownNotRunning(t *testing.T) {
+	s := newTestScheduler(nil)
+
+	assert.NoError(t, s.Shutdown(time.Millisecond))
+}
+
+func TestIsActionDue(t *testing.T) {
+	config := &conf.SchedulerConfiguration{
+		ActionPeriods: map[string]metav1.Duration{
+			string(framework.Consolidation): {Duration: 5 * time.Minute},
+		},
+	}
+	start := time.Now()
+	actionLastRun := map[framework.ActionType]time.Time{
+		framework.Allocate:      start,
+		framework.Consolidation: start,
+	}
+
+	assert.True(t, isActionDue(config, actionLastRun, framework.Allocate, start.Add(time.Second)))
+	assert.True(t, isActionDue(config, map[framework.ActionType]time.Time{}, framework.Consolidation, start),
+		"an action that didn't run yet is due")
+	assert.False(t, isActionDue(config, actionLastRun, framework.Consolidation, start.Add(time.Minute)))
+	assert.True(t, isActionDue(config, actionLastRun, framework.Consolidation, start.Add(5*time.Minute)))
+	assert.True(t, isActionDue(&conf.SchedulerConfiguration{}, actionLastRun, framework.Consolidation,
+		start.Add(time.Minute)), "the periods are taken from the given configuration")
+}
+
+var initLoggersOnce sync.Once
+
+func initTestLoggers(t *testing.T) {
+	initLoggersOnce.Do(func() {
+		assert.NoError(t, log.InitLoggers(0))
+	})
+}
+
+type countingAction struct {
+	name       framework.ActionType
+	executions int
+}
+
+func (a *countingAction) Name() framework.ActionType { return a.name }
+
+func (a *countingAction) Execute(_ *framework.Session) { a.executions++ }
+
+func TestRunActionsFrozenActionIsNotRecorded(t *testing.T) {
+	initTestLoggers(t)
+	log.InfraLogger.SetSessionID("test")
+	action := &countingAction{name: framework.Consolidation}
+	framework.RegisterAction(action)
+	s := newTestScheduler(nil)
+	config := &conf.SchedulerConfiguration{
+		Actions: string(framework.Consolidation),
+		ActionPeriods: map[string]metav1.Duration{
+			string(framework.Consolidation): {Duration: 5 * time.Minute},
+		},
+	}
+	ssn := &framework.Session{ClusterInfo: &api.ClusterInfo{
+		SchedulingFreezes: []*kaiv1alpha1.SchedulingFreeze{
+			{Spec: kaiv1alpha1.SchedulingFreezeSpec{IncludePreemption: true}},
+		},
+	}}
+	actionLastRun := map[framework.ActionType]time.Time{}
+
+	s.runActions(context.Background(), ssn, config, actionLastRun, nil)
+	assert.Equal(t, 0, action.executions)
+	assert.Empty(t, actionLastRun, "an action skipped by a freeze doesn't count as run")
+
+	ssn.ClusterInfo.SchedulingFreezes = nil
+	s.runActions(context.Background(), ssn, config, actionLastRun, nil)
+	assert.Equal(t, 1, action.executions, "the action runs once the freeze is lifted, without waiting for its period")
+	assert.Contains(t, actionLastRun, framework.Consolidation)
+}
+
+func TestRunCyclesMicroCycle(t *testing.T) {
+	initTestLoggers(t)
+	ctrl := gomock.NewController(t)
+	cache := schedcache.NewMockCache(ctrl)
+	s := newTestScheduler(cache)
+	s.schedulerParams = &conf.SchedulerParams{}
+	s.config.EventTriggers = &conf.EventTriggers{QuotaRaised: true}
+	var err error
+	s.triggers, err = triggers.New(s.config.EventTriggers, "kai-scheduler", &conf.SchedulingNodePoolParams{})
+	assert.NoError(t, err)
+
+	stopCh := make(chan struct{})
+	s.stopCh = stopCh
+	microCycleRan := make(chan struct{})
+	gomock.InOrder(
+		// The full cycle, during which a queue's quota is raised
+		cache.EXPECT().Snapshot().Do(func() {
+			s.triggers.Trigger(triggers.QuotaRaised, "team-a")
+		}).Return(nil, fmt.Errorf("no snapshot")),
+		// The micro-cycle of the raised quota
+		cache.EXPECT().Snapshot().Do(func() { close(microCycleRan) }).Return(nil, fmt.Errorf("no snapshot")),
+	)
+
+	go func() {
+		defer close(s.cyclesDone)
+		s.runCycles(stopCh)
+	}()
+	select {
+	case <-microCycleRan:
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected a micro-cycle of the triggered event")
+	}
+	close(stopCh)
+	<-s.cyclesDone
+}
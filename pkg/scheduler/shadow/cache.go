@@ -0,0 +1,80 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package shadow
+
+import (
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+)
+
+// recordingCache records the binds and evictions of the primary session that succeed
+type recordingCache struct {
+	cache.Cache
+	decisions *Decisions
+}
+
+// NewRecordingCache returns a cache that executes the binds and evictions of a session, and records them in the
+// decisions
+func NewRecordingCache(inner cache.Cache, decisions *Decisions) cache.Cache {
+	return &recordingCache{Cache: inner, decisions: decisions}
+}
+
+func (rc *recordingCache) Bind(podInfo *pod_info.PodInfo, hostname string, bindRequestAnnotations map[string]string,
+) error {
+	if err := rc.Cache.Bind(podInfo, hostname, bindRequestAnnotations); err != nil {
+		return err
+	}
+	rc.decisions.recordPlacement(podInfo.UID, hostname)
+	return nil
+}
+
+func (rc *recordingCache) Evict(ssnPod *v1.Pod, job *podgroup_info.PodGroupInfo,
+	evictionMetadata eviction_info.EvictionMetadata, message string) error {
+	if err := rc.Cache.Evict(ssnPod, job, evictionMetadata, message); err != nil {
+		return err
+	}
+	rc.decisions.recordEviction(common_info.PodID(ssnPod.UID), evictionMetadata.Action)
+	return nil
+}
+
+// dryRunCache records the binds and evictions of a shadow session instead of executing them, and drops the updates
+// of the session to the status of jobs, pods and queues
+type dryRunCache struct {
+	cache.Cache
+	decisions *Decisions
+}
+
+// NewDryRunCache returns a cache that takes its snapshots from the inner cache, and records the binds and evictions
+// of a session in the decisions without executing them
+func NewDryRunCache(inner cache.Cache, decisions *Decisions) cache.Cache {
+	return &dryRunCache{Cache: inner, decisions: decisions}
+}
+
+func (dc *dryRunCache) Bind(podInfo *pod_info.PodInfo, hostname string, _ map[string]string) error {
+	dc.decisions.recordPlacement(podInfo.UID, hostname)
+	return nil
+}
+
+func (dc *dryRunCache) Evict(ssnPod *v1.Pod, _ *podgroup_info.PodGroupInfo,
+	evictionMetadata eviction_info.EvictionMetadata, _ string) error {
+	dc.decisions.recordEviction(common_info.PodID(ssnPod.UID), evictionMetadata.Action)
+	return nil
+}
+
+func (dc *dryRunCache) RecordJobStatusEvent(_ *podgroup_info.PodGroupInfo) error {
+	return nil
+}
+
+func (dc *dryRunCache) TaskPipelined(_ *pod_info.PodInfo, _ string) {}
+
+func (dc *dryRunCache) UpdateQueueReclaimable(_, _ string, _ v1.ResourceList) {}
+
+func (dc *dryRunCache) UpdateCrossPoolReclaimCost(_ *podgroup_info.PodGroupInfo, _ string, _ *int) {}
+
+func (dc *dryRunCache) WaitForWorkers(_ <-chan struct{}) {}
@@ -0,0 +1,59 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package shadow
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+)
+
+func TestRecordingCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	inner := cache.NewMockCache(ctrl)
+	decisions := NewDecisions()
+	recording := NewRecordingCache(inner, decisions)
+
+	inner.EXPECT().Bind(gomock.Any(), "node-1", gomock.Any()).Return(nil)
+	inner.EXPECT().Bind(gomock.Any(), "node-2", gomock.Any()).Return(errors.New("bind failed"))
+	inner.EXPECT().Evict(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	assert.NoError(t, recording.Bind(&pod_info.PodInfo{UID: "bound"}, "node-1", nil))
+	assert.Error(t, recording.Bind(&pod_info.PodInfo{UID: "failed"}, "node-2", nil))
+	assert.NoError(t, recording.Evict(&v1.Pod{ObjectMeta: metav1.ObjectMeta{UID: "evicted"}},
+		&podgroup_info.PodGroupInfo{}, eviction_info.EvictionMetadata{Action: "reclaim"}, ""))
+
+	assert.Equal(t, map[common_info.PodID]string{"bound": "node-1"}, decisions.Placements)
+	assert.Equal(t, map[common_info.PodID]string{"evicted": "reclaim"}, decisions.Evictions)
+}
+
+func TestDryRunCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	// The mock fails the test on any call that changes the cluster
+	inner := cache.NewMockCache(ctrl)
+	decisions := NewDecisions()
+	dryRun := NewDryRunCache(inner, decisions)
+
+	assert.NoError(t, dryRun.Bind(&pod_info.PodInfo{UID: "bound"}, "node-1", nil))
+	assert.NoError(t, dryRun.Evict(&v1.Pod{ObjectMeta: metav1.ObjectMeta{UID: "evicted"}},
+		&podgroup_info.PodGroupInfo{}, eviction_info.EvictionMetadata{Action: "preempt"}, ""))
+	assert.NoError(t, dryRun.RecordJobStatusEvent(&podgroup_info.PodGroupInfo{}))
+	dryRun.TaskPipelined(&pod_info.PodInfo{}, "")
+	dryRun.UpdateQueueReclaimable("queue", "", v1.ResourceList{})
+	dryRun.UpdateCrossPoolReclaimCost(&podgroup_info.PodGroupInfo{}, "", nil)
+	dryRun.WaitForWorkers(nil)
+
+	assert.Equal(t, map[common_info.PodID]string{"bound": "node-1"}, decisions.Placements)
+	assert.Equal(t, map[common_info.PodID]string{"evicted": "preempt"}, decisions.Evictions)
+}
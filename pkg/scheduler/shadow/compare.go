@@ -0,0 +1,81 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package shadow
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
+)
+
+const (
+	// WouldHavePlaced is a pod that only the shadow configuration bound
+	WouldHavePlaced = "would-have-placed"
+	// WouldNotHavePlaced is a pod that only the primary configuration bound
+	WouldNotHavePlaced = "would-not-have-placed"
+	// PlacedOnOtherNode is a pod that both configurations bound, to different nodes
+	PlacedOnOtherNode = "placed-on-other-node"
+	// WouldHaveEvicted is a pod that only the shadow configuration evicted
+	WouldHaveEvicted = "would-have-evicted"
+	// WouldNotHaveEvicted is a pod that only the primary configuration evicted
+	WouldNotHaveEvicted = "would-not-have-evicted"
+)
+
+// EvictionDifference is a difference in the evictions of the configurations, by the action of the configuration that
+// evicted the pods
+type EvictionDifference struct {
+	Difference string
+	Action     string
+}
+
+// Differences counts the pods that the shadow configuration decided on differently than the primary configuration
+type Differences struct {
+	Placements map[string]int
+	Evictions  map[EvictionDifference]int
+}
+
+// Compare returns the differences of the shadow decisions from the primary decisions
+func Compare(primary, shadow *Decisions) *Differences {
+	differences := &Differences{
+		Placements: map[string]int{},
+		Evictions:  map[EvictionDifference]int{},
+	}
+	for podID, shadowNode := range shadow.Placements {
+		primaryNode, placed := primary.Placements[podID]
+		switch {
+		case !placed:
+			differences.Placements[WouldHavePlaced]++
+		case primaryNode != shadowNode:
+			differences.Placements[PlacedOnOtherNode]++
+		}
+	}
+	for podID := range primary.Placements {
+		if _, placed := shadow.Placements[podID]; !placed {
+			differences.Placements[WouldNotHavePlaced]++
+		}
+	}
+
+	for podID, action := range shadow.Evictions {
+		if _, evicted := primary.Evictions[podID]; !evicted {
+			differences.Evictions[EvictionDifference{Difference: WouldHaveEvicted, Action: action}]++
+		}
+	}
+	for podID, action := range primary.Evictions {
+		if _, evicted := shadow.Evictions[podID]; !evicted {
+			differences.Evictions[EvictionDifference{Difference: WouldNotHaveEvicted, Action: action}]++
+		}
+	}
+	return differences
+}
+
+// Report exports the differences of the shadow configuration as metrics
+func (d *Differences) Report(shadowName string) {
+	for difference, count := range d.Placements {
+		metrics.RecordShadowPlacementDifferences(shadowName, difference, count)
+	}
+	for difference, count := range d.Evictions {
+		metrics.RecordShadowEvictionDifferences(shadowName, difference.Difference, difference.Action, count)
+	}
+	log.InfraLogger.V(3).Infof("Shadow configuration %s decided differently on pods: placements %v, evictions %v",
+		shadowName, d.Placements, d.Evictions)
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package shadow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+)
+
+func TestCompare(t *testing.T) {
+	primary := &Decisions{
+		Placements: map[common_info.PodID]string{
+			"same-node":    "node-1",
+			"other-node":   "node-1",
+			"only-primary": "node-2",
+		},
+		Evictions: map[common_info.PodID]string{
+			"evicted-by-both":    "reclaim",
+			"evicted-by-primary": "consolidation",
+		},
+	}
+	shadow := &Decisions{
+		Placements: map[common_info.PodID]string{
+			"same-node":     "node-1",
+			"other-node":    "node-2",
+			"only-shadow":   "node-3",
+			"only-shadow-2": "node-3",
+		},
+		Evictions: map[common_info.PodID]string{
+			"evicted-by-both":   "reclaim",
+			"evicted-by-shadow": "preempt",
+		},
+	}
+
+	differences := Compare(primary, shadow)
+
+	assert.Equal(t, map[string]int{
+		WouldHavePlaced:    2,
+		WouldNotHavePlaced: 1,
+		PlacedOnOtherNode:  1,
+	}, differences.Placements)
+	assert.Equal(t, map[EvictionDifference]int{
+		{Difference: WouldHaveEvicted, Action: "preempt"}:          1,
+		{Difference: WouldNotHaveEvicted, Action: "consolidation"}: 1,
+	}, differences.Evictions)
+}
+
+func TestCompareSameDecisions(t *testing.T) {
+	decisions := &Decisions{
+		Placements: map[common_info.PodID]string{"pod-1": "node-1"},
+		Evictions:  map[common_info.PodID]string{"pod-2": "reclaim"},
+	}
+
+	differences := Compare(decisions, decisions)
+
+	assert.Empty(t, differences.Placements)
+	assert.Empty(t, differences.Evictions)
+}
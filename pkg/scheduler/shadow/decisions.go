@@ -0,0 +1,31 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package shadow
+
+import (
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+)
+
+// Decisions are the binds and evictions of the pods in a scheduling session
+type Decisions struct {
+	// Placements are the nodes that the pods were bound to, by pod
+	Placements map[common_info.PodID]string
+	// Evictions are the actions that evicted the pods, by pod
+	Evictions map[common_info.PodID]string
+}
+
+func NewDecisions() *Decisions {
+	return &Decisions{
+		Placements: map[common_info.PodID]string{},
+		Evictions:  map[common_info.PodID]string{},
+	}
+}
+
+func (d *Decisions) recordPlacement(podID common_info.PodID, nodeName string) {
+	d.Placements[podID] = nodeName
+}
+
+func (d *Decisions) recordEviction(podID common_info.PodID, action string) {
+	d.Evictions[podID] = action
+}
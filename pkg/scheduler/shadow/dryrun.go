@@ -0,0 +1,77 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package shadow
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/metrics"
+)
+
+// DryRunDecision is a bind or an eviction of a pod that a scheduling cycle would have executed
+type DryRunDecision struct {
+	Pod    string `json:"pod"`
+	Job    string `json:"job,omitempty"`
+	Node   string `json:"node,omitempty"`
+	Action string `json:"action,omitempty"`
+}
+
+// DryRunReport holds the decisions of a scheduling cycle in dry-run mode
+type DryRunReport struct {
+	Session    string           `json:"session"`
+	Time       time.Time        `json:"time"`
+	Placements []DryRunDecision `json:"placements"`
+	Evictions  []DryRunDecision `json:"evictions"`
+}
+
+// NewDryRunReport returns the report of the decisions of a session, with the names of the pods and jobs taken from
+// the jobs of the session
+func NewDryRunReport(session string, now time.Time, decisions *Decisions,
+	jobs map[common_info.PodGroupID]*podgroup_info.PodGroupInfo) *DryRunReport {
+	report := &DryRunReport{
+		Session:    session,
+		Time:       now,
+		Placements: []DryRunDecision{},
+		Evictions:  []DryRunDecision{},
+	}
+	for _, job := range jobs {
+		for podID, task := range job.GetAllPodsMap() {
+			pod := task.Namespace + "/" + task.Name
+			if nodeName, placed := decisions.Placements[podID]; placed {
+				report.Placements = append(report.Placements,
+					DryRunDecision{Pod: pod, Job: job.NamespacedName, Node: nodeName})
+			}
+			if action, evicted := decisions.Evictions[podID]; evicted {
+				report.Evictions = append(report.Evictions,
+					DryRunDecision{Pod: pod, Job: job.NamespacedName, Node: task.NodeName, Action: action})
+			}
+		}
+	}
+	byPod := func(a, b DryRunDecision) int { return cmp.Compare(a.Pod, b.Pod) }
+	slices.SortFunc(report.Placements, byPod)
+	slices.SortFunc(report.Evictions, byPod)
+	return report
+}
+
+// Report logs the decisions and exports their number as metrics
+func (r *DryRunReport) Report() {
+	evictions := map[string]int{}
+	for _, eviction := range r.Evictions {
+		evictions[eviction.Action]++
+		log.InfraLogger.V(2).Infof("Dry run: would evict pod <%s> of job <%s> from node <%s> by %s",
+			eviction.Pod, eviction.Job, eviction.Node, eviction.Action)
+	}
+	for _, placement := range r.Placements {
+		log.InfraLogger.V(2).Infof("Dry run: would bind pod <%s> of job <%s> to node <%s>",
+			placement.Pod, placement.Job, placement.Node)
+	}
+	metrics.UpdateDryRunDecisions(len(r.Placements), evictions)
+	log.InfraLogger.V(1).Infof("Dry run: the cycle would have bound %d pods and evicted %d pods",
+		len(r.Placements), len(r.Evictions))
+}
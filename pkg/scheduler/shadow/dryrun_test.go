@@ -0,0 +1,73 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package shadow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+)
+
+func TestNewDryRunReport(t *testing.T) {
+	pending := newTask("pending", "train-1", "", pod_status.Pending)
+	otherPending := newTask("other-pending", "train-0", "", pod_status.Pending)
+	notPlaced := newTask("not-placed", "train-2", "", pod_status.Pending)
+	running := newTask("running", "serve-0", "node-3", pod_status.Running)
+
+	training := podgroup_info.NewPodGroupInfo("training", pending, otherPending, notPlaced)
+	training.NamespacedName = "team/training"
+	serving := podgroup_info.NewPodGroupInfo("serving", running)
+	serving.NamespacedName = "team/serving"
+
+	decisions := &Decisions{
+		Placements: map[common_info.PodID]string{"pending": "node-1", "other-pending": "node-2"},
+		Evictions:  map[common_info.PodID]string{"running": "reclaim"},
+	}
+	now := time.Now()
+
+	report := NewDryRunReport("session", now, decisions, map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{
+		training.UID: training,
+		serving.UID:  serving,
+	})
+
+	assert.Equal(t, &DryRunReport{
+		Session: "session",
+		Time:    now,
+		Placements: []DryRunDecision{
+			{Pod: "team/train-0", Job: "team/training", Node: "node-2"},
+			{Pod: "team/train-1", Job: "team/training", Node: "node-1"},
+		},
+		Evictions: []DryRunDecision{
+			{Pod: "team/serve-0", Job: "team/serving", Node: "node-3", Action: "reclaim"},
+		},
+	}, report)
+}
+
+func TestNewDryRunReportWithoutDecisions(t *testing.T) {
+	job := podgroup_info.NewPodGroupInfo("job", newTask("pending", "pod", "", pod_status.Pending))
+
+	report := NewDryRunReport("session", time.Now(), NewDecisions(),
+		map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{job.UID: job})
+
+	assert.Empty(t, report.Placements)
+	assert.Empty(t, report.Evictions)
+}
+
+func newTask(uid, name, nodeName string, status pod_status.PodStatus) *pod_info.PodInfo {
+	return &pod_info.PodInfo{
+		UID:       common_info.PodID(uid),
+		Name:      name,
+		Namespace: "team",
+		NodeName:  nodeName,
+		Status:    status,
+		ResReq:    resource_info.EmptyResourceRequirements(),
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// maxRecentPreemptions is the number of the latest evictions kept across cycles
+const maxRecentPreemptions = 100
+
+// Recorder keeps the snapshot of the last scheduling cycle and the latest evictions, and serves them as JSON. A nil
+// recorder records nothing.
+type Recorder struct {
+	nodePool string
+	dryRun   bool
+
+	snapshot atomic.Pointer[Snapshot]
+	// recentPreemptions are the latest evictions, oldest first. It is only accessed by the scheduling cycles, which
+	// never run concurrently.
+	recentPreemptions []Preemption
+}
+
+func NewRecorder(nodePool string, dryRun bool) *Recorder {
+	return &Recorder{nodePool: nodePool, dryRun: dryRun}
+}
+
+// StartCycle returns the recording of the actions of a new scheduling cycle
+func (r *Recorder) StartCycle() *Cycle {
+	if r == nil {
+		return nil
+	}
+	return &Cycle{}
+}
+
+// Finish takes the snapshot of the cycle from the state of its session after the actions ran
+func (r *Recorder) Finish(cycle *Cycle, session string, now time.Time, clusterInfo *api.ClusterInfo) {
+	if r == nil || cycle == nil {
+		return
+	}
+	for _, eviction := range cycle.evictions {
+		preemption := eviction.preemption
+		preemption.Time = now
+		preemption.Queue = string(eviction.queue)
+		if queue, found := clusterInfo.Queues[eviction.queue]; found {
+			preemption.Queue = queue.Name
+		}
+		r.recentPreemptions = append(r.recentPreemptions, preemption)
+	}
+	if excess := len(r.recentPreemptions) - maxRecentPreemptions; excess > 0 {
+		r.recentPreemptions = slices.Delete(r.recentPreemptions, 0, excess)
+	}
+
+	snapshot := newSnapshot(session, now, r.nodePool, clusterInfo)
+	snapshot.DryRun = r.dryRun
+	snapshot.Actions = cycle.actions
+	if snapshot.Actions == nil {
+		snapshot.Actions = []ActionStats{}
+	}
+	snapshot.RecentPreemptions = slices.Clone(r.recentPreemptions)
+	slices.Reverse(snapshot.RecentPreemptions)
+	if snapshot.RecentPreemptions == nil {
+		snapshot.RecentPreemptions = []Preemption{}
+	}
+	r.snapshot.Store(snapshot)
+}
+
+// ServeHTTP serves the snapshot of the last scheduling cycle, with the latest evictions first
+func (r *Recorder) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	snapshot := r.snapshot.Load()
+	if snapshot == nil {
+		http.Error(w, "no scheduling cycle ran yet", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(snapshot); err != nil {
+		log.InfraLogger.Errorf("Failed to write the scheduling stats: %v", err)
+	}
+}
+
+// Cycle records the duration, binds and evictions of the actions of a scheduling cycle. A nil cycle records nothing.
+type Cycle struct {
+	actions   []ActionStats
+	evictions []eviction
+}
+
+type eviction struct {
+	preemption Preemption
+	queue      common_info.QueueID
+}
+
+// StartAction records that the binds and evictions that follow are of the action
+func (c *Cycle) StartAction(name string) {
+	if c == nil {
+		return
+	}
+	c.actions = append(c.actions, ActionStats{Name: name})
+}
+
+// EndAction records the duration of the current action
+func (c *Cycle) EndAction(duration time.Duration) {
+	if c == nil || len(c.actions) == 0 {
+		return
+	}
+	c.actions[len(c.actions)-1].DurationSeconds = duration.Seconds()
+}
+
+// RecordingCache returns a cache that records the binds and evictions of a session that succeed in the cycle, or
+// the inner cache for a nil cycle
+func (c *Cycle) RecordingCache(inner cache.Cache) cache.Cache {
+	if c == nil {
+		return inner
+	}
+	return &recordingCache{Cache: inner, cycle: c}
+}
+
+func (c *Cycle) currentAction() *ActionStats {
+	if len(c.actions) == 0 {
+		return nil
+	}
+	return &c.actions[len(c.actions)-1]
+}
+
+type recordingCache struct {
+	cache.Cache
+	cycle *Cycle
+}
+
+func (rc *recordingCache) Bind(podInfo *pod_info.PodInfo, hostname string, bindRequestAnnotations map[string]string,
+) error {
+	if err := rc.Cache.Bind(podInfo, hostname, bindRequestAnnotations); err != nil {
+		return err
+	}
+	if action := rc.cycle.currentAction(); action != nil {
+		action.Placements++
+	}
+	return nil
+}
+
+func (rc *recordingCache) Evict(ssnPod *v1.Pod, job *podgroup_info.PodGroupInfo,
+	evictionMetadata eviction_info.EvictionMetadata, message string) error {
+	if err := rc.Cache.Evict(ssnPod, job, evictionMetadata, message); err != nil {
+		return err
+	}
+	if action := rc.cycle.currentAction(); action != nil {
+		action.Evictions++
+	}
+	preemption := Preemption{
+		Pod:    ssnPod.Namespace + "/" + ssnPod.Name,
+		Job:    job.NamespacedName,
+		Node:   ssnPod.Spec.NodeName,
+		Action: evictionMetadata.Action,
+	}
+	if evictionMetadata.Preemptor != nil {
+		preemption.Preemptor = evictionMetadata.Preemptor.String()
+	}
+	rc.cycle.evictions = append(rc.cycle.evictions, eviction{preemption: preemption, queue: job.Queue})
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/eviction_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/cache"
+)
+
+func TestRecorder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	inner := cache.NewMockCache(ctrl)
+	inner.EXPECT().Bind(gomock.Any(), "node-1", gomock.Any()).Return(nil)
+	inner.EXPECT().Bind(gomock.Any(), "node-2", gomock.Any()).Return(errors.New("bind failed"))
+	inner.EXPECT().Evict(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	recorder := NewRecorder("pool-a", false)
+	cycle := recorder.StartCycle()
+	recording := cycle.RecordingCache(inner)
+
+	cycle.StartAction("allocate")
+	assert.NoError(t, recording.Bind(&pod_info.PodInfo{UID: "bound"}, "node-1", nil))
+	assert.Error(t, recording.Bind(&pod_info.PodInfo{UID: "failed"}, "node-2", nil))
+	cycle.EndAction(2 * time.Second)
+	cycle.StartAction("reclaim")
+	victim := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "serve-0", Namespace: "team"},
+		Spec:       v1.PodSpec{NodeName: "node-3"},
+	}
+	assert.NoError(t, recording.Evict(victim, &podgroup_info.PodGroupInfo{NamespacedName: "team/serving", Queue: "q-b"},
+		eviction_info.EvictionMetadata{Action: "reclaim", Preemptor: &types.NamespacedName{Namespace: "team",
+			Name: "training"}}, ""))
+	cycle.EndAction(time.Second)
+
+	now := time.Now()
+	recorder.Finish(cycle, "session", now, &api.ClusterInfo{
+		Queues: map[common_info.QueueID]*queue_info.QueueInfo{"q-b": {UID: "q-b", Name: "team-b"}},
+	})
+
+	response := httptest.NewRecorder()
+	recorder.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/stats", nil))
+	assert.Equal(t, http.StatusOK, response.Code)
+	snapshot := Snapshot{}
+	assert.NoError(t, json.Unmarshal(response.Body.Bytes(), &snapshot))
+
+	assert.Equal(t, "pool-a", snapshot.NodePool.Name)
+	assert.Equal(t, []ActionStats{
+		{Name: "allocate", DurationSeconds: 2, Placements: 1},
+		{Name: "reclaim", DurationSeconds: 1, Evictions: 1},
+	}, snapshot.Actions)
+	assert.Len(t, snapshot.RecentPreemptions, 1)
+	assert.True(t, now.Equal(snapshot.RecentPreemptions[0].Time))
+	snapshot.RecentPreemptions[0].Time = time.Time{}
+	assert.Equal(t, Preemption{
+		Pod: "team/serve-0", Job: "team/serving", Queue: "team-b", Node: "node-3", Action: "reclaim",
+		Preemptor: "team/training",
+	}, snapshot.RecentPreemptions[0])
+}
+
+func TestRecorderKeepsRecentPreemptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	inner := cache.NewMockCache(ctrl)
+	inner.EXPECT().Evict(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	recorder := NewRecorder("default", false)
+
+	for i := 0; i < maxRecentPreemptions+10; i++ {
+		cycle := recorder.StartCycle()
+		cycle.StartAction("preempt")
+		victim := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: string(rune('a' + i%26)), Namespace: "team"}}
+		assert.NoError(t, cycle.RecordingCache(inner).Evict(victim, &podgroup_info.PodGroupInfo{},
+			eviction_info.EvictionMetadata{Action: "preempt"}, ""))
+		recorder.Finish(cycle, "session", time.Unix(int64(i), 0), &api.ClusterInfo{})
+	}
+
+	snapshot := recorder.snapshot.Load()
+	assert.Len(t, snapshot.RecentPreemptions, maxRecentPreemptions)
+	assert.Equal(t, time.Unix(maxRecentPreemptions+9, 0), snapshot.RecentPreemptions[0].Time,
+		"the latest eviction is listed first")
+	assert.Equal(t, time.Unix(10, 0), snapshot.RecentPreemptions[maxRecentPreemptions-1].Time)
+}
+
+func TestRecorderBeforeFirstCycle(t *testing.T) {
+	response := httptest.NewRecorder()
+	NewRecorder("default", false).ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/stats", nil))
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func TestNilRecorder(t *testing.T) {
+	var recorder *Recorder
+	cycle := recorder.StartCycle()
+	assert.Nil(t, cycle)
+
+	ctrl := gomock.NewController(t)
+	inner := cache.NewMockCache(ctrl)
+	assert.Equal(t, cache.Cache(inner), cycle.RecordingCache(inner))
+	cycle.StartAction("allocate")
+	cycle.EndAction(time.Second)
+	recorder.Finish(cycle, "session", time.Now(), &api.ClusterInfo{})
+}
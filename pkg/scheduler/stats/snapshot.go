@@ -0,0 +1,191 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+)
+
+// topPendingJobs is the number of pending jobs listed in a snapshot
+const topPendingJobs = 20
+
+// Snapshot is the scheduling state at the end of a scheduling cycle, in a flat form that dashboards can query
+type Snapshot struct {
+	Session           string        `json:"session"`
+	Time              time.Time     `json:"time"`
+	DryRun            bool          `json:"dryRun,omitempty"`
+	NodePool          NodePoolStats `json:"nodePool"`
+	Queues            []QueueStats  `json:"queues"`
+	Actions           []ActionStats `json:"actions"`
+	TopPendingJobs    []PendingJob  `json:"topPendingJobs"`
+	RecentPreemptions []Preemption  `json:"recentPreemptions"`
+}
+
+// NodePoolStats are the nodes and jobs of the node pool of the scheduler
+type NodePoolStats struct {
+	Name          string  `json:"name"`
+	Nodes         int     `json:"nodes"`
+	GPUs          float64 `json:"gpus"`
+	IdleGPUs      float64 `json:"idleGPUs"`
+	ReleasingGPUs float64 `json:"releasingGPUs"`
+	RunningJobs   int     `json:"runningJobs"`
+	PendingJobs   int     `json:"pendingJobs"`
+}
+
+// QueueStats are the quota of a queue and the jobs of the queue and of its child queues
+type QueueStats struct {
+	Name          string  `json:"name"`
+	Parent        string  `json:"parent,omitempty"`
+	DeservedGPUs  float64 `json:"deservedGPUs"`
+	GPULimit      float64 `json:"gpuLimit"`
+	AllocatedGPUs float64 `json:"allocatedGPUs"`
+	PendingGPUs   float64 `json:"pendingGPUs"`
+	RunningJobs   int     `json:"runningJobs"`
+	PendingJobs   int     `json:"pendingJobs"`
+}
+
+// ActionStats are the duration and the decisions of an action in the cycle
+type ActionStats struct {
+	Name            string  `json:"name"`
+	DurationSeconds float64 `json:"durationSeconds"`
+	Placements      int     `json:"placements"`
+	Evictions       int     `json:"evictions"`
+}
+
+// PendingJob is a job with pending pods, with the reason it wasn't scheduled in the cycle
+type PendingJob struct {
+	Name           string  `json:"name"`
+	Queue          string  `json:"queue"`
+	Priority       int32   `json:"priority"`
+	PendingPods    int     `json:"pendingPods"`
+	PendingGPUs    float64 `json:"pendingGPUs"`
+	WaitingSeconds float64 `json:"waitingSeconds"`
+	Reason         string  `json:"reason,omitempty"`
+}
+
+// Preemption is the eviction of a pod by the preempt, reclaim or consolidation actions
+type Preemption struct {
+	Time      time.Time `json:"time"`
+	Pod       string    `json:"pod"`
+	Job       string    `json:"job"`
+	Queue     string    `json:"queue"`
+	Node      string    `json:"node,omitempty"`
+	Action    string    `json:"action"`
+	Preemptor string    `json:"preemptor,omitempty"`
+}
+
+// newSnapshot returns the state of the jobs, queues and nodes of a session at the end of its cycle
+func newSnapshot(session string, now time.Time, nodePool string, clusterInfo *api.ClusterInfo) *Snapshot {
+	snapshot := &Snapshot{
+		Session:  session,
+		Time:     now,
+		NodePool: NodePoolStats{Name: nodePool},
+	}
+	for _, node := range clusterInfo.Nodes {
+		snapshot.NodePool.Nodes++
+		snapshot.NodePool.GPUs += node.Allocatable.GPUs()
+		snapshot.NodePool.IdleGPUs += node.Idle.GPUs()
+		snapshot.NodePool.ReleasingGPUs += node.Releasing.GPUs()
+	}
+
+	queues := map[common_info.QueueID]*QueueStats{}
+	for queueID, queue := range clusterInfo.Queues {
+		queueStats := &QueueStats{
+			Name:         queue.Name,
+			DeservedGPUs: queue.Resources.GPU.Quota,
+			GPULimit:     queue.Resources.GPU.Limit,
+		}
+		if parent, found := clusterInfo.Queues[queue.ParentQueue]; found {
+			queueStats.Parent = parent.Name
+		}
+		queues[queueID] = queueStats
+	}
+
+	var pendingJobs []*podgroup_info.PodGroupInfo
+	for _, job := range clusterInfo.PodGroupInfos {
+		running := job.GetActiveAllocatedTasksCount() > 0
+		pending := job.GetNumPendingTasks() > 0
+		allocatedGPUs := job.GetTasksActiveAllocatedReqResource().GPUs()
+		pendingGPUs := pendingTasksGPUs(job)
+		if running {
+			snapshot.NodePool.RunningJobs++
+		}
+		if pending {
+			snapshot.NodePool.PendingJobs++
+			pendingJobs = append(pendingJobs, job)
+		}
+		for queueID := job.Queue; queues[queueID] != nil; queueID = clusterInfo.Queues[queueID].ParentQueue {
+			queueStats := queues[queueID]
+			queueStats.AllocatedGPUs += allocatedGPUs
+			queueStats.PendingGPUs += pendingGPUs
+			if running {
+				queueStats.RunningJobs++
+			}
+			if pending {
+				queueStats.PendingJobs++
+			}
+		}
+	}
+
+	snapshot.Queues = make([]QueueStats, 0, len(queues))
+	for _, queueStats := range queues {
+		snapshot.Queues = append(snapshot.Queues, *queueStats)
+	}
+	slices.SortFunc(snapshot.Queues, func(a, b QueueStats) int { return cmp.Compare(a.Name, b.Name) })
+
+	snapshot.TopPendingJobs = topPending(pendingJobs, clusterInfo, now)
+	return snapshot
+}
+
+// topPending returns the pending jobs of the highest priority, and the longest waiting among jobs of the same
+// priority
+func topPending(jobs []*podgroup_info.PodGroupInfo, clusterInfo *api.ClusterInfo, now time.Time) []PendingJob {
+	slices.SortFunc(jobs, func(a, b *podgroup_info.PodGroupInfo) int {
+		if a.Priority != b.Priority {
+			return cmp.Compare(b.Priority, a.Priority)
+		}
+		if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+			return a.CreationTimestamp.Compare(b.CreationTimestamp.Time)
+		}
+		return cmp.Compare(a.NamespacedName, b.NamespacedName)
+	})
+	if len(jobs) > topPendingJobs {
+		jobs = jobs[:topPendingJobs]
+	}
+
+	pendingJobs := make([]PendingJob, 0, len(jobs))
+	for _, job := range jobs {
+		pendingJob := PendingJob{
+			Name:           job.NamespacedName,
+			Queue:          string(job.Queue),
+			Priority:       job.Priority,
+			PendingPods:    job.GetNumPendingTasks(),
+			PendingGPUs:    pendingTasksGPUs(job),
+			WaitingSeconds: now.Sub(job.CreationTimestamp.Time).Seconds(),
+		}
+		if queue, found := clusterInfo.Queues[job.Queue]; found {
+			pendingJob.Queue = queue.Name
+		}
+		if len(job.JobFitErrors) > 0 {
+			pendingJob.Reason = string(job.JobFitErrors[0].Reason())
+		}
+		pendingJobs = append(pendingJobs, pendingJob)
+	}
+	return pendingJobs
+}
+
+func pendingTasksGPUs(job *podgroup_info.PodGroupInfo) float64 {
+	gpus := 0.0
+	for _, task := range job.PodStatusIndex[pod_status.Pending] {
+		gpus += task.ResReq.GPUs()
+	}
+	return gpus
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/node_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/podgroup_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/resource_info"
+)
+
+func TestNewSnapshot(t *testing.T) {
+	now := time.Now()
+	training := newJob("training", "team-a", 100, now.Add(-time.Hour),
+		newTask("train-0", pod_status.Running, 2), newTask("train-1", pod_status.Pending, 2))
+	serving := newJob("serving", "team-b", 100, now.Add(-time.Minute), newTask("serve-0", pod_status.Running, 1))
+	notebook := newJob("notebook", "team-b", 200, now.Add(-time.Second), newTask("nb-0", pod_status.Pending, 1))
+	notebook.JobFitErrors = []common_info.JobFitError{
+		common_info.NewJobFitError(notebook.Name, "", "", "NotEnoughResources", []string{"no GPUs"}),
+	}
+
+	clusterInfo := &api.ClusterInfo{
+		Nodes: map[string]*node_info.NodeInfo{
+			"node-1": newNode(8, 3, 0),
+			"node-2": newNode(8, 0, 2),
+		},
+		Queues: map[common_info.QueueID]*queue_info.QueueInfo{
+			"research": newQueue("research", "", 8),
+			"team-a":   newQueue("team-a", "research", 4),
+			"team-b":   newQueue("team-b", "research", 4),
+		},
+		PodGroupInfos: map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{
+			training.UID: training,
+			serving.UID:  serving,
+			notebook.UID: notebook,
+		},
+	}
+
+	snapshot := newSnapshot("session", now, "pool-a", clusterInfo)
+
+	assert.Equal(t, NodePoolStats{
+		Name: "pool-a", Nodes: 2, GPUs: 16, IdleGPUs: 3, ReleasingGPUs: 2, RunningJobs: 2, PendingJobs: 2,
+	}, snapshot.NodePool)
+	assert.Equal(t, []QueueStats{
+		{Name: "research", DeservedGPUs: 8, GPULimit: -1, AllocatedGPUs: 3, PendingGPUs: 3, RunningJobs: 2,
+			PendingJobs: 2},
+		{Name: "team-a", Parent: "research", DeservedGPUs: 4, GPULimit: -1, AllocatedGPUs: 2, PendingGPUs: 2,
+			RunningJobs: 1, PendingJobs: 1},
+		{Name: "team-b", Parent: "research", DeservedGPUs: 4, GPULimit: -1, AllocatedGPUs: 1, PendingGPUs: 1,
+			RunningJobs: 1, PendingJobs: 1},
+	}, snapshot.Queues)
+	assert.Equal(t, []PendingJob{
+		{Name: "team/notebook", Queue: "team-b", Priority: 200, PendingPods: 1, PendingGPUs: 1, WaitingSeconds: 1,
+			Reason: "NotEnoughResources"},
+		{Name: "team/training", Queue: "team-a", Priority: 100, PendingPods: 1, PendingGPUs: 2, WaitingSeconds: 3600},
+	}, snapshot.TopPendingJobs)
+}
+
+func TestNewSnapshotLimitsPendingJobs(t *testing.T) {
+	now := time.Now()
+	jobs := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{}
+	for i := 0; i < topPendingJobs+5; i++ {
+		job := newJob(string(rune('a'+i)), "team-a", 100, now.Add(-time.Duration(i)*time.Minute),
+			newTask(string(rune('a'+i)), pod_status.Pending, 1))
+		jobs[job.UID] = job
+	}
+
+	snapshot := newSnapshot("session", now, "default", &api.ClusterInfo{PodGroupInfos: jobs})
+
+	assert.Len(t, snapshot.TopPendingJobs, topPendingJobs)
+	assert.Equal(t, "team/y", snapshot.TopPendingJobs[0].Name, "the longest waiting job is listed first")
+	assert.Equal(t, topPendingJobs+5, snapshot.NodePool.PendingJobs)
+}
+
+func newJob(name, queue string, priority int32, created time.Time,
+	tasks ...*pod_info.PodInfo) *podgroup_info.PodGroupInfo {
+	for _, task := range tasks {
+		task.Job = common_info.PodGroupID(name)
+	}
+	job := podgroup_info.NewPodGroupInfo(common_info.PodGroupID(name), tasks...)
+	job.Name = name
+	job.NamespacedName = "team/" + name
+	job.Queue = common_info.QueueID(queue)
+	job.Priority = priority
+	job.CreationTimestamp = metav1.NewTime(created)
+	return job
+}
+
+func newTask(name string, status pod_status.PodStatus, gpus float64) *pod_info.PodInfo {
+	return &pod_info.PodInfo{
+		UID:       common_info.PodID(name),
+		Name:      name,
+		Namespace: "team",
+		Status:    status,
+		ResReq:    resource_info.NewResourceRequirementsWithGpus(gpus),
+	}
+}
+
+func newNode(gpus, idleGPUs, releasingGPUs float64) *node_info.NodeInfo {
+	return &node_info.NodeInfo{
+		Allocatable: resource_info.NewResource(0, 0, gpus),
+		Idle:        resource_info.NewResource(0, 0, idleGPUs),
+		Releasing:   resource_info.NewResource(0, 0, releasingGPUs),
+	}
+}
+
+func newQueue(name, parent string, deservedGPUs float64) *queue_info.QueueInfo {
+	return &queue_info.QueueInfo{
+		UID:         common_info.QueueID(name),
+		Name:        name,
+		ParentQueue: common_info.QueueID(parent),
+		Resources: queue_info.QueueQuota{
+			GPU: queue_info.ResourceQuota{Quota: deservedGPUs, Limit: -1},
+		},
+	}
+}
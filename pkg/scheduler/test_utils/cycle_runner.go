@@ -0,0 +1,127 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package test_utils
+
+import (
+	"fmt"
+	"time"
+
+	. "go.uber.org/mock/gomock"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/pod_status"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/queue_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/framework"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+// CycleRunner runs scheduling cycles on a topology with a fake clock, so time dependent behavior (backoffs,
+// stale gang eviction, min runtime, etc.) can be tested deterministically. The results of every cycle are written
+// back to the topology, so the next cycle starts from the state the previous one left the cluster in.
+type CycleRunner struct {
+	Topology *TestTopologyBasic
+	Clock    *clocktesting.FakeClock
+	Actions  []framework.Action
+
+	controller *Controller
+	// preemptionHistory holds the evictions for the queues with a preemption limit across the cycles, as the
+	// scheduler does
+	preemptionHistory *queue_info.PreemptionHistory
+}
+
+// NewCycleRunner returns a runner of the actions on the topology. The topology's clock is replaced by a fake clock
+// that starts at the current time, and the plugins keep their state across the cycles.
+func NewCycleRunner(topology *TestTopologyBasic, actions []framework.Action, controller *Controller) *CycleRunner {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	topology.Clock = fakeClock
+	topology.PluginStates = framework.NewPluginStates()
+	return &CycleRunner{
+		Topology:   topology,
+		Clock:      fakeClock,
+		Actions:    actions,
+		controller: controller,
+
+		preemptionHistory: queue_info.NewPreemptionHistory(),
+	}
+}
+
+// Step advances the clock of the next cycles by the given duration
+func (r *CycleRunner) Step(d time.Duration) {
+	r.Clock.Step(d)
+}
+
+// RunCycle builds a session from the topology, runs the actions on it and writes the results back to the topology.
+// It returns the session of the cycle.
+func (r *CycleRunner) RunCycle() *framework.Session {
+	ssn := BuildSession(*r.Topology, r.controller)
+	ssn.SetPreemptionHistory(r.preemptionHistory)
+	for _, action := range r.Actions {
+		log.InfraLogger.SetAction(string(action.Name()))
+		action.Execute(ssn)
+	}
+	r.applyResults(ssn)
+	return ssn
+}
+
+// RunCycles runs the given number of cycles, advancing the clock by the interval after each one, and returns the
+// session of the last cycle
+func (r *CycleRunner) RunCycles(cycles int, interval time.Duration) *framework.Session {
+	var ssn *framework.Session
+	for i := 0; i < cycles; i++ {
+		ssn = r.RunCycle()
+		r.Step(interval)
+	}
+	return ssn
+}
+
+func (r *CycleRunner) applyResults(ssn *framework.Session) {
+	for _, jobMetadata := range r.Topology.Jobs {
+		jobId := common_info.PodGroupID(jobMetadata.Name)
+		job := ssn.ClusterInfo.PodGroupInfos[jobId]
+		for taskId, taskMetadata := range jobMetadata.Tasks {
+			task := job.GetAllPodsMap()[common_info.PodID(fmt.Sprintf("%s-%d", jobId, taskId))]
+			switch task.Status {
+			case pod_status.Releasing:
+				if jobMetadata.DeleteJobInTest {
+					taskMetadata.NodeName = task.NodeName
+					taskMetadata.GPUGroups = task.GPUGroups
+					taskMetadata.State = pod_status.Releasing
+				} else {
+					taskMetadata.NodeName = ""
+					taskMetadata.State = pod_status.Pending
+				}
+
+			case pod_status.Pipelined:
+				taskMetadata.NodeName = ""
+				taskMetadata.State = pod_status.Pending
+
+			case pod_status.Binding:
+				taskMetadata.State = pod_status.Running
+				taskMetadata.NodeName = task.NodeName
+				taskMetadata.GPUGroups = task.GPUGroups
+
+			default:
+				taskMetadata.State = task.Status
+				taskMetadata.NodeName = task.NodeName
+				taskMetadata.GPUGroups = task.GPUGroups
+			}
+
+		}
+	}
+	if len(r.Topology.TestDRAObjects.ResourceClaims) > 0 {
+		draManager := ssn.InternalK8sPlugins().FrameworkHandle.SharedDRAManager()
+		for _, claim := range r.Topology.TestDRAObjects.ResourceClaims {
+			clusterClaim, err := draManager.ResourceClaims().Get(claim.Namespace, claim.Name)
+			if err != nil {
+				log.InfraLogger.Errorf("Failed to get resource claim %s: %v", claim.Name, err)
+				continue
+			}
+			clusterClaimStatus := clusterClaim.Status
+			if clusterClaimStatus.Allocation != nil || clusterClaimStatus.ReservedFor != nil || clusterClaimStatus.Devices != nil {
+				claim.ClaimStatus = clusterClaimStatus.DeepCopy()
+			}
+		}
+	}
+}
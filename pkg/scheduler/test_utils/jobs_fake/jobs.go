@@ -48,9 +48,19 @@ type TestJobBasic struct {
 	Tasks                               []*tasks_fake.TestTaskBasic
 	RootSubGroupSet                     *subgroup_info.SubGroupSet
 	StaleDuration                       *time.Duration
+	LoanLenders                         []common_info.QueueID
+	Replaces                            string
+	DoNotConsolidate                    bool
 }
 
 func BuildJobsAndTasksMaps(Jobs []*TestJobBasic, draClaims ...runtime.Object) (
+	map[common_info.PodGroupID]*podgroup_info.PodGroupInfo, map[string]pod_info.PodsMap, map[string]map[string]bool) {
+	return BuildJobsAndTasksMapsAt(time.Now(), Jobs, draClaims...)
+}
+
+// BuildJobsAndTasksMapsAt builds the jobs like BuildJobsAndTasksMaps, with creation, staleness and start times
+// relative to the given time instead of the current time
+func BuildJobsAndTasksMapsAt(now time.Time, Jobs []*TestJobBasic, draClaims ...runtime.Object) (
 	map[common_info.PodGroupID]*podgroup_info.PodGroupInfo, map[string]pod_info.PodsMap, map[string]map[string]bool) {
 	jobsInfoMap := map[common_info.PodGroupID]*podgroup_info.PodGroupInfo{}
 	usedSharedGPUs := map[string]map[string]bool{}
@@ -80,17 +90,20 @@ func BuildJobsAndTasksMaps(Jobs []*TestJobBasic, draClaims ...runtime.Object) (
 		numberOfJobs := len(Jobs)
 		var jobCreationTime time.Time
 		if job.JobAgeInMinutes != 0 {
-			jobCreationTime = time.Now().Add(time.Minute * time.Duration(job.JobAgeInMinutes) * (-1))
+			jobCreationTime = now.Add(time.Minute * time.Duration(job.JobAgeInMinutes) * (-1))
 		} else {
-			jobCreationTime = time.Now().Add(time.Minute * time.Duration(numberOfJobs-jobIndex) * (-1))
+			jobCreationTime = now.Add(time.Minute * time.Duration(numberOfJobs-jobIndex) * (-1))
 		}
 
 		job.Preemptibility = pg.CalculatePreemptibility(job.Preemptibility, job.Priority)
 
-		jobInfo := BuildJobInfo(
-			jobName, job.Namespace, jobUID, jobAllocatedResource, job.RootSubGroupSet, taskInfos,
+		jobInfo := buildJobInfo(
+			now, jobName, job.Namespace, jobUID, jobAllocatedResource, job.RootSubGroupSet, taskInfos,
 			job.Priority, job.Preemptibility, queueUID, jobCreationTime, job.StaleDuration,
 		)
+		jobInfo.LoanLenders = job.LoanLenders
+		jobInfo.PodGroup.Spec.Replaces = job.Replaces
+		jobInfo.DoNotConsolidate = job.DoNotConsolidate
 		jobsInfoMap[common_info.PodGroupID(job.Name)] = jobInfo
 	}
 
@@ -102,6 +115,16 @@ func BuildJobInfo(
 	rootSubGroupSet *subgroup_info.SubGroupSet, taskInfos []*pod_info.PodInfo,
 	priority int32, preemptibility enginev2alpha2.Preemptibility, queueUID common_info.QueueID,
 	jobCreationTime time.Time, staleDuration *time.Duration,
+) *podgroup_info.PodGroupInfo {
+	return buildJobInfo(time.Now(), name, namespace, uid, allocatedResource, rootSubGroupSet, taskInfos, priority,
+		preemptibility, queueUID, jobCreationTime, staleDuration)
+}
+
+func buildJobInfo(
+	now time.Time, name, namespace string, uid common_info.PodGroupID, allocatedResource *resource_info.Resource,
+	rootSubGroupSet *subgroup_info.SubGroupSet, taskInfos []*pod_info.PodInfo,
+	priority int32, preemptibility enginev2alpha2.Preemptibility, queueUID common_info.QueueID,
+	jobCreationTime time.Time, staleDuration *time.Duration,
 ) *podgroup_info.PodGroupInfo {
 	allTasks := pod_info.PodsMap{}
 	taskStatusIndex := map[pod_status.PodStatus]pod_info.PodsMap{}
@@ -161,12 +184,12 @@ func BuildJobInfo(
 
 	_ = result.GetActiveAllocatedTasksCount()
 	if staleDuration != nil {
-		staleTime := time.Now().Add(-1 * *staleDuration)
+		staleTime := now.Add(-1 * *staleDuration)
 		result.StalenessInfo.TimeStamp = &staleTime
 		result.StalenessInfo.Stale = true
 	}
 	if result.LastStartTimestamp == nil && result.GetNumAllocatedTasks() > 0 {
-		startTime := time.Now().Add(-1 * time.Minute * 1)
+		startTime := now.Add(-1 * time.Minute * 1)
 		result.LastStartTimestamp = &startTime
 	}
 	return result
@@ -25,6 +25,7 @@ const (
 
 type TestTaskBasic struct {
 	Name                       string
+	Labels                     map[string]string
 	GPUGroups                  []string
 	SubGroupName               string
 	RequiredGPUs               *int64
@@ -39,6 +40,7 @@ type TestTaskBasic struct {
 	IsLegacyMigTask            bool
 	ResourceClaimTemplates     map[string]string
 	ResourceClaimNames         []string
+	GPUCountRange              *pod_info.GpuCountRange
 }
 
 func BuildPod(
@@ -62,6 +64,7 @@ func BuildPod(
 				baseLabels := map[string]string{
 					"job-name": name,
 				}
+				maps.Copy(baseLabels, task.Labels)
 				maps.Copy(baseLabels, task.PodAffinityLabels)
 				return baseLabels
 			}(),
@@ -121,6 +124,11 @@ func BuildPod(
 		pod.Annotations[migInstance.String()] = fmt.Sprintf("%d", count)
 	}
 
+	if task.GPUCountRange != nil {
+		pod.Annotations[commonconstants.GpuCountMin] = fmt.Sprintf("%d", task.GPUCountRange.Min)
+		pod.Annotations[commonconstants.GpuCountMax] = fmt.Sprintf("%d", task.GPUCountRange.Max)
+	}
+
 	for _, claimName := range task.ResourceClaimNames {
 		pod.Spec.ResourceClaims = append(pod.Spec.ResourceClaims, v1.PodResourceClaim{
 			Name:              claimName,
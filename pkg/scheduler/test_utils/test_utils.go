@@ -14,11 +14,14 @@ import (
 	// lint:ignore ST1001 we want to use gomock here
 	. "go.uber.org/mock/gomock"
 	"golang.org/x/exp/slices"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/clock"
 
 	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
 
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/actions"
 	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
@@ -52,6 +55,22 @@ type TestTopologyBasic struct {
 
 	dra_fake.TestDRAObjects
 	Topologies []*kaiv1alpha1.Topology
+
+	PodDisruptionBudgets []*policyv1.PodDisruptionBudget
+
+	// Clock is the time source of the sessions built from the topology, and the job and queue times are relative
+	// to it. The real clock is used when it isn't set.
+	Clock clock.PassiveClock
+	// PluginStates holds the state of plugins across the sessions built from the topology, as the scheduler does.
+	// Every session starts from an empty state when it isn't set.
+	PluginStates *framework.PluginStates
+}
+
+func topologyNow(testMetadata TestTopologyBasic) time.Time {
+	if testMetadata.Clock == nil {
+		return time.Now()
+	}
+	return testMetadata.Clock.Now()
 }
 
 type TestMock struct {
@@ -85,6 +104,11 @@ type TestQueueBasic struct {
 	InteractiveTimeoutInMinutes int64
 	UseOnlyFreeCPUResources     bool
 	V1                          bool
+	Burst                       *enginev2.QueueBurst
+	Budget                      *enginev2.QueueBudget
+	BudgetStatus                *enginev2.QueueBudgetStatus
+	PreemptionLimit             *enginev2.QueuePreemptionLimit
+	NodePools                   []string
 }
 
 type TestDepartmentBasic struct {
@@ -175,7 +199,7 @@ func MatchExpectedAndRealTasks(t *testing.T, testNumber int, testMetadata TestTo
 			if job.LastStartTimestamp == nil {
 				t.Errorf("Test number: %d, name: %v, has failed. Task name: %v, actual last start timestamp is not set expecting pod_status.%v", testNumber, testMetadata.Name, jobName, jobExpectedResult.Status.String())
 			} else if jobExpectedResult.LastStartTimestampOlderThan != nil {
-				now := time.Now()
+				now := ssn.Clock().Now()
 				if now.Sub(*job.LastStartTimestamp) < *jobExpectedResult.LastStartTimestampOlderThan {
 					t.Errorf("Test number: %d, name: %v, has failed. Task name: %v, actual last start timestamp is not older than %v", testNumber, testMetadata.Name, jobName,
 						*jobExpectedResult.LastStartTimestampOlderThan)
@@ -262,7 +286,7 @@ func MatchExpectedAndRealTasks(t *testing.T, testNumber int, testMetadata TestTo
 					if job.LastStartTimestamp == nil {
 						t.Errorf("Test number: %d, name: %v, has failed. Task name: %v, actual last start timestamp is not set expecting pod_status.%v", testNumber, testMetadata.Name, taskId, taskExpectedResult.Status.String())
 					} else if taskExpectedResult.LastStartTimestampOlderThan != nil {
-						now := time.Now()
+						now := ssn.Clock().Now()
 						if now.Sub(*job.LastStartTimestamp) < *taskExpectedResult.LastStartTimestampOlderThan {
 							t.Errorf("Test number: %d, name: %v, has failed. Task name: %v, actual last start timestamp is not older than %v", testNumber, testMetadata.Name, taskId,
 								*taskExpectedResult.LastStartTimestampOlderThan)
@@ -347,6 +371,8 @@ func GetTestCacheMock(
 		cacheMock.KubeClient(), cacheMock.KubeInformerFactory(), cacheMock.SnapshotSharedLister(),
 	)
 	cacheMock.EXPECT().InternalK8sPlugins().AnyTimes().Return(k8sPlugins)
+	cacheMock.EXPECT().UpdateQueueReclaimable(Any(), Any(), Any()).AnyTimes()
+	cacheMock.EXPECT().UpdateCrossPoolReclaimCost(Any(), Any(), Any()).AnyTimes()
 
 	if cacheRequirements.NumberOfCacheEvictions != 0 {
 		cacheMock.EXPECT().Evict(Any(), Any(), Any(), Any()).
@@ -62,20 +62,23 @@ func CreateFakeSession(schedulerConfig *TestSessionConfig,
 			},
 		},
 		ClusterInfo: &api.ClusterInfo{
-			Nodes:            nodesInfoMap,
-			Queues:           queueInfoMap,
-			PodGroupInfos:    jobInfoMap,
-			ResourceClaims:   getResourceClaims(testMetadata),
-			Topologies:       topologies,
-			MinNodeGPUMemory: node_info.DefaultGpuMemory,
+			Nodes:                nodesInfoMap,
+			Queues:               queueInfoMap,
+			PodGroupInfos:        jobInfoMap,
+			ResourceClaims:       getResourceClaims(testMetadata),
+			Topologies:           topologies,
+			PodDisruptionBudgets: testMetadata.PodDisruptionBudgets,
+			MinNodeGPUMemory:     node_info.DefaultGpuMemory,
 		},
 		SchedulerParams: conf.SchedulerParams{
 			QueueLabelKey: constants.DefaultQueueLabel,
+			Clock:         testMetadata.Clock,
 		},
 	}
 	ssn.OverrideMaxNumberConsolidationPreemptees(-1)
 	ssn.OverrideAllowConsolidatingReclaim(true)
 	ssn.OverrideSchedulerName(schedulerName)
+	ssn.SetPluginStates(testMetadata.PluginStates)
 
 	if controller != nil || createCacheMockIfNotExists {
 		ssn.Cache = GetTestCacheMock(controller, testMetadata.Mocks, getDRAObjects(testMetadata), clusterPodAffinityInfo)
@@ -104,7 +107,7 @@ func BuildQueueInfoMap(testMetadata TestTopologyBasic) map[common_info.QueueID]*
 			ObjectMeta: metav1.ObjectMeta{
 				Name:              queue.Name,
 				UID:               types.UID(queue.Name),
-				CreationTimestamp: metav1.Time{Time: time.Now().Add(time.Minute * time.Duration(queueIndex))},
+				CreationTimestamp: metav1.Time{Time: topologyNow(testMetadata).Add(time.Minute * time.Duration(queueIndex))},
 			},
 			Spec: enginev2.QueueSpec{
 				DisplayName: queue.Name,
@@ -148,6 +151,11 @@ func BuildQueueInfoMap(testMetadata TestTopologyBasic) map[common_info.QueueID]*
 		if queue.V1 {
 			queueResource.Spec.Resources = nil
 		}
+		queueResource.Spec.Burst = queue.Burst
+		queueResource.Spec.Budget = queue.Budget
+		queueResource.Status.Budget = queue.BudgetStatus
+		queueResource.Spec.PreemptionLimit = queue.PreemptionLimit
+		queueResource.Spec.NodePools = queue.NodePools
 
 		queueInfo := queue_info.NewQueueInfo(&queueResource)
 		queueInfoMap[queueInfo.UID] = queueInfo
@@ -186,7 +194,7 @@ func BuildDepartmentInfoMap(testMetadata TestTopologyBasic) map[common_info.Queu
 			ObjectMeta: metav1.ObjectMeta{
 				Name:              department.Name,
 				UID:               types.UID(department.Name),
-				CreationTimestamp: metav1.Time{Time: time.Now().Add(time.Minute * time.Duration(departmentIndex))},
+				CreationTimestamp: metav1.Time{Time: topologyNow(testMetadata).Add(time.Minute * time.Duration(departmentIndex))},
 			},
 			Spec: enginev2.QueueSpec{
 				Resources: &enginev2.QueueResources{
@@ -266,7 +274,8 @@ func BuildSession(testMetadata TestTopologyBasic, controller *Controller) *frame
 	}
 
 	addDefaultDepartmentIfNeeded(&testMetadata)
-	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMaps(testMetadata.Jobs, getDRAObjects(testMetadata)...)
+	jobsInfoMap, tasksToNodeMap, _ := jobs_fake.BuildJobsAndTasksMapsAt(topologyNow(testMetadata), testMetadata.Jobs,
+		getDRAObjects(testMetadata)...)
 
 	clusterPodAffinityInfo := cache.NewK8sClusterPodAffinityInfo()
 	nodesInfoMap := nodes_fake.BuildNodesInfoMap(testMetadata.Nodes, tasksToNodeMap, clusterPodAffinityInfo, getDRAObjects(testMetadata)...)
@@ -0,0 +1,121 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package triggers
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	commonconstants "github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/resources"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+)
+
+func (t *Triggers) onNodeAdd(obj interface{}, isInInitialList bool) {
+	if isInInitialList {
+		return
+	}
+	node, ok := obj.(*v1.Node)
+	if !ok || !t.nodePool.Matches(labels.Set(node.Labels)) {
+		return
+	}
+	t.Trigger(NodeAdded, "")
+}
+
+func (t *Triggers) onPodUpdate(oldObj, newObj interface{}) {
+	oldPod, oldOk := oldObj.(*v1.Pod)
+	newPod, newOk := newObj.(*v1.Pod)
+	if !oldOk || !newOk || isTerminated(oldPod) || !isTerminated(newPod) {
+		return
+	}
+	if t.isPodOfNodePool(newPod) {
+		t.release(podGPUs(newPod))
+	}
+}
+
+func (t *Triggers) onPodDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	pod, ok := obj.(*v1.Pod)
+	// The GPUs of terminated pods were released when they terminated
+	if !ok || isTerminated(pod) {
+		return
+	}
+	if t.isPodOfNodePool(pod) {
+		t.release(podGPUs(pod))
+	}
+}
+
+func (t *Triggers) onQueueUpdate(oldObj, newObj interface{}) {
+	oldQueue, oldOk := oldObj.(*enginev2.Queue)
+	newQueue, newOk := newObj.(*enginev2.Queue)
+	if !oldOk || !newOk || !isQuotaRaised(oldQueue.Spec.Resources, newQueue.Spec.Resources) {
+		return
+	}
+	t.Trigger(QuotaRaised, common_info.QueueID(newQueue.Name))
+}
+
+func (t *Triggers) isPodOfNodePool(pod *v1.Pod) bool {
+	if pod.Spec.SchedulerName != t.schedulerName || pod.Spec.NodeName == "" || t.nodeLister == nil {
+		return false
+	}
+	node, err := t.nodeLister.Get(pod.Spec.NodeName)
+	if err != nil {
+		return false
+	}
+	return t.nodePool.Matches(labels.Set(node.Labels))
+}
+
+func isTerminated(pod *v1.Pod) bool {
+	return pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed
+}
+
+// podGPUs returns the whole and fractional GPUs requested by the pod. GPUs requested by memory aren't counted.
+func podGPUs(pod *v1.Pod) float64 {
+	gpus := 0.0
+	for _, container := range pod.Spec.Containers {
+		for _, resourceName := range resources.AcceleratorResourceNames() {
+			if quantity, found := container.Resources.Requests[resourceName]; found {
+				gpus += float64(quantity.Value())
+			}
+		}
+	}
+	if fraction, err := resources.GetGPUFraction(pod); err == nil {
+		devices, err := resources.GetNumGPUFractionDevices(pod)
+		if err != nil {
+			devices = 1
+		}
+		gpus += fraction * float64(devices)
+	}
+	return gpus
+}
+
+func isQuotaRaised(oldResources, newResources *enginev2.QueueResources) bool {
+	if newResources == nil {
+		return false
+	}
+	if oldResources == nil {
+		oldResources = &enginev2.QueueResources{}
+	}
+	for _, resource := range []struct{ old, new enginev2.QueueResource }{
+		{oldResources.GPU, newResources.GPU},
+		{oldResources.CPU, newResources.CPU},
+		{oldResources.Memory, newResources.Memory},
+	} {
+		if isRaised(resource.old.Quota, resource.new.Quota) || isRaised(resource.old.Limit, resource.new.Limit) {
+			return true
+		}
+	}
+	return false
+}
+
+func isRaised(oldValue, newValue float64) bool {
+	if oldValue == commonconstants.UnlimitedResourceQuantity {
+		return false
+	}
+	return newValue == commonconstants.UnlimitedResourceQuantity || newValue > oldValue
+}
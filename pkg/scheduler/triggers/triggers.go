@@ -0,0 +1,176 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package triggers
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	listv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	kubeaischedulerinfo "github.com/NVIDIA/KAI-scheduler/pkg/apis/client/informers/externalversions"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/log"
+)
+
+const (
+	NodeAdded    = "node-added"
+	GPUsReleased = "gpus-released"
+	QuotaRaised  = "quota-raised"
+)
+
+// Scope is the part of the cluster affected by the events that triggered a micro-cycle
+type Scope struct {
+	// AllQueues is true if the jobs of all queues may be scheduled following the events
+	AllQueues bool
+	// Queues are the queues whose jobs, or the jobs of their descendant queues, may be scheduled following the events
+	Queues map[common_info.QueueID]bool
+	// Triggers are the triggers of the events
+	Triggers map[string]bool
+}
+
+// IsEmpty returns true if no event was triggered
+func (s Scope) IsEmpty() bool {
+	return !s.AllQueues && len(s.Queues) == 0
+}
+
+// QueueIDs returns the queues of the scope, or nil if it covers all queues
+func (s Scope) QueueIDs() []common_info.QueueID {
+	if s.AllQueues {
+		return nil
+	}
+	queues := make([]common_info.QueueID, 0, len(s.Queues))
+	for queueID := range s.Queues {
+		queues = append(queues, queueID)
+	}
+	return queues
+}
+
+// Triggers watches the cluster for the events configured to trigger micro-cycles, and merges the scopes of the
+// events that arrive between micro-cycles
+type Triggers struct {
+	config        conf.EventTriggers
+	schedulerName string
+	nodePool      labels.Selector
+	nodeLister    listv1.NodeLister
+
+	mutex        sync.Mutex
+	scope        Scope
+	releasedGPUs float64
+	triggered    chan struct{}
+}
+
+func New(
+	config *conf.EventTriggers, schedulerName string, nodePoolParams *conf.SchedulingNodePoolParams,
+) (*Triggers, error) {
+	nodePool, err := nodePoolParams.GetLabelSelector()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the label selector of the node pool: %w", err)
+	}
+	return &Triggers{
+		config:        *config,
+		schedulerName: schedulerName,
+		nodePool:      nodePool,
+		triggered:     make(chan struct{}, 1),
+	}, nil
+}
+
+// Register adds the event handlers of the configured triggers to the informers. It must be called before the
+// informers are started.
+func (t *Triggers) Register(
+	kubeInformers informers.SharedInformerFactory, kaiInformers kubeaischedulerinfo.SharedInformerFactory,
+) error {
+	nodeInformer := kubeInformers.Core().V1().Nodes()
+	t.nodeLister = nodeInformer.Lister()
+
+	if t.config.NodeAdded {
+		if _, err := nodeInformer.Informer().AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+			AddFunc: t.onNodeAdd,
+		}); err != nil {
+			return fmt.Errorf("failed to watch nodes: %w", err)
+		}
+	}
+	if t.config.MinReleasedGPUs > 0 {
+		if _, err := kubeInformers.Core().V1().Pods().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: t.onPodUpdate,
+			DeleteFunc: t.onPodDelete,
+		}); err != nil {
+			return fmt.Errorf("failed to watch pods: %w", err)
+		}
+	}
+	if t.config.QuotaRaised {
+		if _, err := kaiInformers.Scheduling().V2().Queues().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: t.onQueueUpdate,
+		}); err != nil {
+			return fmt.Errorf("failed to watch queues: %w", err)
+		}
+	}
+	return nil
+}
+
+// Triggered returns a channel that receives once an event is triggered, until the scope of the event is taken
+func (t *Triggers) Triggered() <-chan struct{} {
+	return t.triggered
+}
+
+// Take returns the merged scope of the events triggered since the previous call and resets it
+func (t *Triggers) Take() Scope {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	select {
+	case <-t.triggered:
+	default:
+	}
+	scope := t.scope
+	t.scope = Scope{}
+	t.releasedGPUs = 0
+	return scope
+}
+
+// Trigger merges the scope of an event, which affects the given queue, or all queues if queueID is empty
+func (t *Triggers) Trigger(trigger string, queueID common_info.QueueID) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	log.InfraLogger.V(3).Infof("Event %s triggered a micro-cycle of queue <%s>", trigger, queueID)
+	if t.scope.Triggers == nil {
+		t.scope.Triggers = map[string]bool{}
+	}
+	t.scope.Triggers[trigger] = true
+	if queueID == "" {
+		t.scope.AllQueues = true
+	} else {
+		if t.scope.Queues == nil {
+			t.scope.Queues = map[common_info.QueueID]bool{}
+		}
+		t.scope.Queues[queueID] = true
+	}
+
+	select {
+	case t.triggered <- struct{}{}:
+	default:
+	}
+}
+
+// release accounts for GPUs released by pods of the node pool, and triggers a micro-cycle of all queues once they
+// reach the configured minimum
+func (t *Triggers) release(gpus float64) {
+	if gpus <= 0 {
+		return
+	}
+	t.mutex.Lock()
+	t.releasedGPUs += gpus
+	reached := t.releasedGPUs >= t.config.MinReleasedGPUs
+	if reached {
+		t.releasedGPUs = 0
+	}
+	t.mutex.Unlock()
+
+	if reached {
+		t.Trigger(GPUsReleased, "")
+	}
+}
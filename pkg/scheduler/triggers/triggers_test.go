@@ -0,0 +1,195 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package triggers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	listv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	enginev2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/api/common_info"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+)
+
+const (
+	schedulerName     = "kai-scheduler"
+	nodePoolLabelKey  = "kai.scheduler/node-pool"
+	nodePoolLabelName = "pool-a"
+)
+
+func newTestTriggers(t *testing.T, config *conf.EventTriggers, nodes ...*v1.Node) *Triggers {
+	triggers, err := New(config, schedulerName, &conf.SchedulingNodePoolParams{
+		NodePoolLabelKey:   nodePoolLabelKey,
+		NodePoolLabelValue: nodePoolLabelName,
+	})
+	require.NoError(t, err)
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, node := range nodes {
+		require.NoError(t, indexer.Add(node))
+	}
+	triggers.nodeLister = listv1.NewNodeLister(indexer)
+	return triggers
+}
+
+func newNode(name, nodePool string) *v1.Node {
+	return &v1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   name,
+		Labels: map[string]string{nodePoolLabelKey: nodePool},
+	}}
+}
+
+func newGPUPod(name, nodeName string, gpus int64, phase v1.PodPhase) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: v1.PodSpec{
+			SchedulerName: schedulerName,
+			NodeName:      nodeName,
+			Containers: []v1.Container{{
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{constants.GpuResource: *resource.NewQuantity(gpus, resource.DecimalSI)},
+				},
+			}},
+		},
+		Status: v1.PodStatus{Phase: phase},
+	}
+}
+
+func isTriggered(triggers *Triggers) bool {
+	select {
+	case <-triggers.Triggered():
+		return true
+	default:
+		return false
+	}
+}
+
+func TestNodeAdded(t *testing.T) {
+	triggers := newTestTriggers(t, &conf.EventTriggers{NodeAdded: true})
+
+	triggers.onNodeAdd(newNode("node-1", nodePoolLabelName), true)
+	assert.True(t, triggers.Take().IsEmpty(), "nodes of the initial list must not trigger")
+
+	triggers.onNodeAdd(newNode("node-2", "pool-b"), false)
+	assert.True(t, triggers.Take().IsEmpty(), "nodes of other node pools must not trigger")
+
+	triggers.onNodeAdd(newNode("node-3", nodePoolLabelName), false)
+	assert.True(t, isTriggered(triggers))
+	scope := triggers.Take()
+	assert.True(t, scope.AllQueues)
+	assert.Nil(t, scope.QueueIDs())
+	assert.Equal(t, map[string]bool{NodeAdded: true}, scope.Triggers)
+	assert.True(t, triggers.Take().IsEmpty(), "the scope must be reset once taken")
+}
+
+func TestGPUsReleased(t *testing.T) {
+	triggers := newTestTriggers(t, &conf.EventTriggers{MinReleasedGPUs: 8},
+		newNode("node-a", nodePoolLabelName), newNode("node-b", "pool-b"))
+
+	running := newGPUPod("pod-1", "node-a", 4, v1.PodRunning)
+	succeeded := running.DeepCopy()
+	succeeded.Status.Phase = v1.PodSucceeded
+	triggers.onPodUpdate(running, succeeded)
+	assert.False(t, isTriggered(triggers), "4 GPUs are fewer than the minimum")
+
+	triggers.onPodDelete(newGPUPod("pod-2", "node-b", 8, v1.PodRunning))
+	assert.False(t, isTriggered(triggers), "pods of other node pools must not count")
+
+	triggers.onPodDelete(succeeded)
+	assert.False(t, isTriggered(triggers), "the GPUs of terminated pods must not count twice")
+
+	otherScheduler := newGPUPod("pod-3", "node-a", 8, v1.PodRunning)
+	otherScheduler.Spec.SchedulerName = "default-scheduler"
+	triggers.onPodDelete(otherScheduler)
+	assert.False(t, isTriggered(triggers), "pods of other schedulers must not count")
+
+	triggers.onPodDelete(cache.DeletedFinalStateUnknown{Obj: newGPUPod("pod-4", "node-a", 4, v1.PodRunning)})
+	assert.True(t, isTriggered(triggers))
+	scope := triggers.Take()
+	assert.True(t, scope.AllQueues)
+	assert.Equal(t, map[string]bool{GPUsReleased: true}, scope.Triggers)
+}
+
+func TestQuotaRaised(t *testing.T) {
+	newQueue := func(gpuQuota, gpuLimit float64) *enginev2.Queue {
+		return &enginev2.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+			Spec: enginev2.QueueSpec{Resources: &enginev2.QueueResources{
+				GPU: enginev2.QueueResource{Quota: gpuQuota, Limit: gpuLimit},
+			}},
+		}
+	}
+	tests := []struct {
+		name      string
+		oldQueue  *enginev2.Queue
+		newQueue  *enginev2.Queue
+		triggered bool
+	}{
+		{
+			name:      "quota raised",
+			oldQueue:  newQueue(2, 4),
+			newQueue:  newQueue(3, 4),
+			triggered: true,
+		},
+		{
+			name:      "limit removed",
+			oldQueue:  newQueue(2, 4),
+			newQueue:  newQueue(2, constants.UnlimitedResourceQuantity),
+			triggered: true,
+		},
+		{
+			name:      "quota lowered",
+			oldQueue:  newQueue(2, 4),
+			newQueue:  newQueue(1, 4),
+			triggered: false,
+		},
+		{
+			name:      "unlimited quota set to a value",
+			oldQueue:  newQueue(constants.UnlimitedResourceQuantity, 4),
+			newQueue:  newQueue(8, 4),
+			triggered: false,
+		},
+		{
+			name:      "resources set",
+			oldQueue:  &enginev2.Queue{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+			newQueue:  newQueue(1, 0),
+			triggered: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			triggers := newTestTriggers(t, &conf.EventTriggers{QuotaRaised: true})
+			triggers.onQueueUpdate(tt.oldQueue, tt.newQueue)
+			assert.Equal(t, tt.triggered, isTriggered(triggers))
+			scope := triggers.Take()
+			if tt.triggered {
+				assert.False(t, scope.AllQueues)
+				assert.Equal(t, []common_info.QueueID{"team-a"}, scope.QueueIDs())
+			} else {
+				assert.True(t, scope.IsEmpty())
+			}
+		})
+	}
+}
+
+func TestMergedScope(t *testing.T) {
+	triggers := newTestTriggers(t, &conf.EventTriggers{QuotaRaised: true, NodeAdded: true})
+	triggers.Trigger(QuotaRaised, "team-a")
+	triggers.Trigger(QuotaRaised, "team-b")
+	scope := triggers.Take()
+	assert.ElementsMatch(t, []common_info.QueueID{"team-a", "team-b"}, scope.QueueIDs())
+
+	triggers.Trigger(QuotaRaised, "team-a")
+	triggers.Trigger(NodeAdded, "")
+	scope = triggers.Take()
+	assert.True(t, scope.AllQueues)
+	assert.Equal(t, map[string]bool{QuotaRaised: true, NodeAdded: true}, scope.Triggers)
+}
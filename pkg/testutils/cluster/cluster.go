@@ -0,0 +1,221 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cluster is a test harness for end-to-end scenario tests against the KAI scheduler. It starts an envtest
+// API server with the KAI CRDs installed, runs the scheduler, binder, podgrouper, queue controller and podgroup
+// controller in-process against it, and creates kwok nodes with GPUs to schedule on. Projects integrating their
+// own CRDs with KAI can import it to test their integration against the real KAI behaviors.
+//
+// Only one cluster can run in a process at a time, as the scheduler binds fixed ports.
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/flowcontrol"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	"github.com/NVIDIA/KAI-scheduler/deployments/kai-scheduler/crds"
+	kaiv1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1"
+	kaiv1alpha1 "github.com/NVIDIA/KAI-scheduler/pkg/apis/kai/v1alpha1"
+	kaiv1alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v1alpha2"
+	schedulingv2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2"
+	schedulingv2alpha2 "github.com/NVIDIA/KAI-scheduler/pkg/apis/scheduling/v2alpha2"
+	"github.com/NVIDIA/KAI-scheduler/pkg/env-tests/binder"
+	"github.com/NVIDIA/KAI-scheduler/pkg/env-tests/podgroupcontroller"
+	"github.com/NVIDIA/KAI-scheduler/pkg/env-tests/podgrouper"
+	"github.com/NVIDIA/KAI-scheduler/pkg/env-tests/queuecontroller"
+	"github.com/NVIDIA/KAI-scheduler/pkg/env-tests/scheduler"
+	"github.com/NVIDIA/KAI-scheduler/pkg/env-tests/utils"
+	"github.com/NVIDIA/KAI-scheduler/pkg/scheduler/conf"
+)
+
+const (
+	defaultWaitTimeout  = 10 * time.Second
+	defaultWaitInterval = 10 * time.Millisecond
+)
+
+// Options configures the cluster. The zero value runs all the KAI components with their default configuration.
+type Options struct {
+	// CRDDirectoryPaths are directories of additional CRDs to install, such as the CRDs of the integration under test
+	CRDDirectoryPaths []string
+	// Scheme of the cluster client. Defaults to the client-go scheme with the KAI types added.
+	Scheme *runtime.Scheme
+	// SchedulerConf is the scheduler configuration. Defaults to the default scheduler configuration.
+	SchedulerConf *conf.SchedulerConfiguration
+	// KwokBinaryPath is the path of a kwok binary. If set, kwok runs against the cluster and manages the kwok
+	// nodes, moving the pods bound to them to running. Otherwise, the bound pods stay pending.
+	KwokBinaryPath string
+
+	DisableScheduler          bool
+	DisableBinder             bool
+	DisablePodGrouper         bool
+	DisableQueueController    bool
+	DisablePodGroupController bool
+	WaitTimeout, WaitInterval time.Duration
+}
+
+// Cluster is a running test cluster
+type Cluster struct {
+	Config *rest.Config
+	Client client.Client
+
+	options         Options
+	testEnv         *envtest.Environment
+	cancel          context.CancelFunc
+	schedulerStopCh chan struct{}
+	kwok            *kwokController
+}
+
+// Start starts the API server and the KAI components. Stop must be called to release them, even if Start failed.
+func Start(ctx context.Context, options Options) (*Cluster, error) {
+	options.setDefaults()
+	cluster := &Cluster{options: options}
+
+	embeddedCRDs, err := crds.LoadEmbeddedCRDs()
+	if err != nil {
+		return cluster, fmt.Errorf("failed to load embedded CRDs: %w", err)
+	}
+	cluster.testEnv = &envtest.Environment{
+		CRDs:                  embeddedCRDs,
+		CRDDirectoryPaths:     options.CRDDirectoryPaths,
+		ErrorIfCRDPathMissing: true,
+	}
+	cluster.testEnv.ControlPlane.GetAPIServer().Configure().Append("feature-gates", "DynamicResourceAllocation=true")
+	cluster.testEnv.ControlPlane.GetAPIServer().Configure().Append("runtime-config", "api/all=true")
+
+	cluster.Config, err = cluster.testEnv.Start()
+	if err != nil {
+		return cluster, fmt.Errorf("failed to start test env: %w", err)
+	}
+	cluster.Config.ContentType = "application/json"
+	// Effectively disable rate limiting
+	cluster.Config.RateLimiter = flowcontrol.NewFakeAlwaysRateLimiter()
+
+	cluster.Client, err = client.New(cluster.Config, client.Options{Scheme: options.Scheme})
+	if err != nil {
+		return cluster, fmt.Errorf("failed to create cluster client: %w", err)
+	}
+
+	componentsCtx, cancel := context.WithCancel(ctx)
+	cluster.cancel = cancel
+	if err = cluster.startComponents(componentsCtx); err != nil {
+		return cluster, err
+	}
+
+	if options.KwokBinaryPath != "" {
+		cluster.kwok, err = startKwokController(componentsCtx, cluster.testEnv, options.KwokBinaryPath)
+		if err != nil {
+			return cluster, err
+		}
+	}
+
+	return cluster, nil
+}
+
+func (c *Cluster) startComponents(ctx context.Context) error {
+	if !c.options.DisableQueueController {
+		if err := queuecontroller.RunQueueController(c.Config, ctx); err != nil {
+			return fmt.Errorf("failed to run queue controller: %w", err)
+		}
+	}
+	if !c.options.DisablePodGroupController {
+		if err := podgroupcontroller.RunPodGroupController(c.Config, ctx); err != nil {
+			return fmt.Errorf("failed to run podgroup controller: %w", err)
+		}
+	}
+	if !c.options.DisablePodGrouper {
+		if err := podgrouper.RunPodGrouper(c.Config, ctx); err != nil {
+			return fmt.Errorf("failed to run podgrouper: %w", err)
+		}
+	}
+	if !c.options.DisableBinder {
+		if err := binder.RunBinder(c.Config, ctx); err != nil {
+			return fmt.Errorf("failed to run binder: %w", err)
+		}
+	}
+	if !c.options.DisableScheduler {
+		c.schedulerStopCh = make(chan struct{})
+		if err := scheduler.RunScheduler(c.Config, c.options.SchedulerConf, c.schedulerStopCh); err != nil {
+			return fmt.Errorf("failed to run scheduler: %w", err)
+		}
+	}
+	return nil
+}
+
+// Stop stops the KAI components, kwok and the API server
+func (c *Cluster) Stop() error {
+	if c.schedulerStopCh != nil {
+		close(c.schedulerStopCh)
+		c.schedulerStopCh = nil
+	}
+	if c.cancel != nil {
+		c.cancel()
+	}
+
+	var errs []error
+	if c.kwok != nil {
+		errs = append(errs, c.kwok.stop())
+	}
+	if c.testEnv != nil {
+		errs = append(errs, c.testEnv.Stop())
+	}
+	return errors.Join(errs...)
+}
+
+// AddNode creates a kwok node with the given configuration
+func (c *Cluster) AddNode(ctx context.Context, config utils.NodeConfig) (*corev1.Node, error) {
+	node := NewKwokNode(config)
+	if err := c.Client.Create(ctx, node); err != nil {
+		return nil, fmt.Errorf("failed to create node %s: %w", config.Name, err)
+	}
+	return node, nil
+}
+
+// AddQueue creates a queue with unlimited quota under the given parent queue
+func (c *Cluster) AddQueue(ctx context.Context, name, parentName string) (*schedulingv2.Queue, error) {
+	queue := utils.CreateQueueObject(name, parentName)
+	if err := c.Client.Create(ctx, queue); err != nil {
+		return nil, fmt.Errorf("failed to create queue %s: %w", name, err)
+	}
+	return queue, nil
+}
+
+// WaitForPodBound waits for the binder to bind the pod to a node
+func (c *Cluster) WaitForPodBound(ctx context.Context, pod *corev1.Pod) error {
+	return utils.WaitForPodBound(ctx, c.Client, pod.Name, pod.Namespace, c.options.WaitTimeout, c.options.WaitInterval)
+}
+
+// WaitForPodUnschedulable waits for the scheduler to mark the pod as unschedulable
+func (c *Cluster) WaitForPodUnschedulable(ctx context.Context, pod *corev1.Pod) error {
+	return utils.WaitForPodUnschedulable(ctx, c.Client, pod.Name, pod.Namespace,
+		c.options.WaitTimeout, c.options.WaitInterval)
+}
+
+func (o *Options) setDefaults() {
+	if o.Scheme == nil {
+		o.Scheme = clientgoscheme.Scheme
+		utilruntime.Must(schedulingv2.AddToScheme(o.Scheme))
+		utilruntime.Must(kaiv1alpha2.AddToScheme(o.Scheme))
+		utilruntime.Must(schedulingv2alpha2.AddToScheme(o.Scheme))
+		utilruntime.Must(resourceapi.AddToScheme(o.Scheme))
+		utilruntime.Must(kaiv1.AddToScheme(o.Scheme))
+		utilruntime.Must(kaiv1alpha1.AddToScheme(o.Scheme))
+	}
+	if o.WaitTimeout == 0 {
+		o.WaitTimeout = defaultWaitTimeout
+	}
+	if o.WaitInterval == 0 {
+		o.WaitInterval = defaultWaitInterval
+	}
+}
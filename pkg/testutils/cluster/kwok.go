@@ -0,0 +1,124 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/env-tests/utils"
+)
+
+const (
+	KwokNodeAnnotation = "kwok.x-k8s.io/node"
+	KwokNodeValue      = "fake"
+	KwokNodeTypeLabel  = "type"
+	KwokNodeType       = "kwok"
+
+	maxPodsPerNode = "110"
+)
+
+// NewKwokNode returns a ready node managed by kwok, with the capacity of the given configuration
+func NewKwokNode(config utils.NodeConfig) *corev1.Node {
+	labels := map[string]string{
+		KwokNodeTypeLabel:      KwokNodeType,
+		corev1.LabelHostname:   config.Name,
+		"kubernetes.io/role":   "agent",
+		corev1.LabelOSStable:   "linux",
+		corev1.LabelArchStable: "amd64",
+		"node.kubernetes.io/exclude-from-external-load-balancers": "true",
+	}
+	maps.Copy(labels, config.Labels)
+	annotations := map[string]string{
+		KwokNodeAnnotation: KwokNodeValue,
+	}
+	maps.Copy(annotations, config.Annotations)
+
+	capacity := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse(config.CPUs),
+		corev1.ResourceMemory: resource.MustParse(config.Memory),
+		corev1.ResourcePods:   resource.MustParse(maxPodsPerNode),
+	}
+	if config.GPUs > 0 {
+		capacity[constants.GpuResource] = *resource.NewQuantity(int64(config.GPUs), resource.DecimalSI)
+	}
+
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        config.Name,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Status: corev1.NodeStatus{
+			Capacity:    capacity,
+			Allocatable: capacity.DeepCopy(),
+			Conditions: []corev1.NodeCondition{
+				{
+					Type:   corev1.NodeReady,
+					Status: corev1.ConditionTrue,
+				},
+			},
+			Phase: corev1.NodeRunning,
+		},
+	}
+}
+
+type kwokController struct {
+	cmd     *exec.Cmd
+	workDir string
+}
+
+// startKwokController runs kwok against the cluster, managing only the nodes created by NewKwokNode
+func startKwokController(ctx context.Context, testEnv *envtest.Environment, binaryPath string) (*kwokController, error) {
+	user, err := testEnv.AddUser(envtest.User{Name: "kwok", Groups: []string{"system:masters"}}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kwok user: %w", err)
+	}
+	kubeConfig, err := user.KubeConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kwok kubeconfig: %w", err)
+	}
+
+	workDir, err := os.MkdirTemp("", "kai-kwok-")
+	if err != nil {
+		return nil, err
+	}
+	kubeConfigPath := filepath.Join(workDir, "kubeconfig")
+	if err = os.WriteFile(kubeConfigPath, kubeConfig, 0600); err != nil {
+		_ = os.RemoveAll(workDir)
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, binaryPath,
+		"--kubeconfig="+kubeConfigPath,
+		"--manage-all-nodes=false",
+		fmt.Sprintf("--manage-nodes-with-annotation-selector=%s=%s", KwokNodeAnnotation, KwokNodeValue),
+	)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err = cmd.Start(); err != nil {
+		_ = os.RemoveAll(workDir)
+		return nil, fmt.Errorf("failed to start kwok: %w", err)
+	}
+
+	return &kwokController{cmd: cmd, workDir: workDir}, nil
+}
+
+func (k *kwokController) stop() error {
+	if k.cmd.Process != nil {
+		_ = k.cmd.Process.Kill()
+		_ = k.cmd.Wait()
+	}
+	return os.RemoveAll(k.workDir)
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 NVIDIA CORPORATION
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/NVIDIA/KAI-scheduler/pkg/common/constants"
+	"github.com/NVIDIA/KAI-scheduler/pkg/env-tests/utils"
+)
+
+func TestNewKwokNode(t *testing.T) {
+	config := utils.DefaultNodeConfig("node-a")
+	config.Labels = map[string]string{"topology/rack": "rack-1", KwokNodeTypeLabel: "custom"}
+	node := NewKwokNode(config)
+
+	assert.Equal(t, "node-a", node.Name)
+	assert.Equal(t, KwokNodeValue, node.Annotations[KwokNodeAnnotation])
+	assert.Equal(t, "rack-1", node.Labels["topology/rack"])
+	assert.Equal(t, "custom", node.Labels[KwokNodeTypeLabel], "configured labels override the defaults")
+	assert.Equal(t, "node-a", node.Labels[corev1.LabelHostname])
+	assert.Empty(t, node.Spec.Taints)
+
+	gpus := node.Status.Allocatable[constants.GpuResource]
+	assert.True(t, gpus.Equal(resource.MustParse("4")))
+	cpus := node.Status.Capacity[corev1.ResourceCPU]
+	assert.True(t, cpus.Equal(resource.MustParse("8")))
+	assert.Equal(t, corev1.ConditionTrue, node.Status.Conditions[0].Status)
+}
+
+func TestNewKwokNodeWithoutGPUs(t *testing.T) {
+	config := utils.DefaultNodeConfig("cpu-node")
+	config.GPUs = 0
+	node := NewKwokNode(config)
+
+	_, found := node.Status.Capacity[constants.GpuResource]
+	assert.False(t, found)
+}